@@ -62,9 +62,14 @@ import (
 	poolincentives "github.com/osmosis-labs/osmosis/v12/x/pool-incentives"
 	poolincentiveskeeper "github.com/osmosis-labs/osmosis/v12/x/pool-incentives/keeper"
 	poolincentivestypes "github.com/osmosis-labs/osmosis/v12/x/pool-incentives/types"
+	streamswapkeeper "github.com/osmosis-labs/osmosis/v12/x/streamswap/keeper"
+	streamswaptypes "github.com/osmosis-labs/osmosis/v12/x/streamswap/types"
 	"github.com/osmosis-labs/osmosis/v12/x/superfluid"
 	superfluidkeeper "github.com/osmosis-labs/osmosis/v12/x/superfluid/keeper"
 	superfluidtypes "github.com/osmosis-labs/osmosis/v12/x/superfluid/types"
+	"github.com/osmosis-labs/osmosis/v12/x/swaprouter"
+	swaprouterkeeper "github.com/osmosis-labs/osmosis/v12/x/swaprouter/keeper"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v12/x/swaprouter/types"
 	tokenfactorykeeper "github.com/osmosis-labs/osmosis/v12/x/tokenfactory/keeper"
 	tokenfactorytypes "github.com/osmosis-labs/osmosis/v12/x/tokenfactory/types"
 	"github.com/osmosis-labs/osmosis/v12/x/twap"
@@ -72,6 +77,8 @@ import (
 	"github.com/osmosis-labs/osmosis/v12/x/txfees"
 	txfeeskeeper "github.com/osmosis-labs/osmosis/v12/x/txfees/keeper"
 	txfeestypes "github.com/osmosis-labs/osmosis/v12/x/txfees/types"
+	valpref "github.com/osmosis-labs/osmosis/v12/x/validator-preference"
+	valpreftypes "github.com/osmosis-labs/osmosis/v12/x/validator-preference/types"
 )
 
 type AppKeepers struct {
@@ -89,32 +96,38 @@ type AppKeepers struct {
 	ScopedWasmKeeper     capabilitykeeper.ScopedKeeper
 
 	// "Normal" keepers
-	AccountKeeper        *authkeeper.AccountKeeper
-	BankKeeper           *bankkeeper.BaseKeeper
-	AuthzKeeper          *authzkeeper.Keeper
-	StakingKeeper        *stakingkeeper.Keeper
-	DistrKeeper          *distrkeeper.Keeper
-	SlashingKeeper       *slashingkeeper.Keeper
-	IBCKeeper            *ibckeeper.Keeper
-	ICAHostKeeper        *icahostkeeper.Keeper
-	TransferKeeper       *ibctransferkeeper.Keeper
-	EvidenceKeeper       *evidencekeeper.Keeper
-	GAMMKeeper           *gammkeeper.Keeper
-	TwapKeeper           *twap.Keeper
-	LockupKeeper         *lockupkeeper.Keeper
-	EpochsKeeper         *epochskeeper.Keeper
-	IncentivesKeeper     *incentiveskeeper.Keeper
-	MintKeeper           *mintkeeper.Keeper
-	PoolIncentivesKeeper *poolincentiveskeeper.Keeper
-	TxFeesKeeper         *txfeeskeeper.Keeper
-	SuperfluidKeeper     *superfluidkeeper.Keeper
-	GovKeeper            *govkeeper.Keeper
-	WasmKeeper           *wasm.Keeper
-	TokenFactoryKeeper   *tokenfactorykeeper.Keeper
+	AccountKeeper                *authkeeper.AccountKeeper
+	BankKeeper                   *bankkeeper.BaseKeeper
+	AuthzKeeper                  *authzkeeper.Keeper
+	StakingKeeper                *stakingkeeper.Keeper
+	DistrKeeper                  *distrkeeper.Keeper
+	SlashingKeeper               *slashingkeeper.Keeper
+	IBCKeeper                    *ibckeeper.Keeper
+	ICAHostKeeper                *icahostkeeper.Keeper
+	TransferKeeper               *ibctransferkeeper.Keeper
+	EvidenceKeeper               *evidencekeeper.Keeper
+	GAMMKeeper                   *gammkeeper.Keeper
+	TwapKeeper                   *twap.Keeper
+	LockupKeeper                 *lockupkeeper.Keeper
+	EpochsKeeper                 *epochskeeper.Keeper
+	IncentivesKeeper             *incentiveskeeper.Keeper
+	MintKeeper                   *mintkeeper.Keeper
+	PoolIncentivesKeeper         *poolincentiveskeeper.Keeper
+	TxFeesKeeper                 *txfeeskeeper.Keeper
+	SuperfluidKeeper             *superfluidkeeper.Keeper
+	GovKeeper                    *govkeeper.Keeper
+	WasmKeeper                   *wasm.Keeper
+	TokenFactoryKeeper           *tokenfactorykeeper.Keeper
+	StreamSwapKeeper             *streamswapkeeper.Keeper
+	SwapRouterKeeper             *swaprouterkeeper.Keeper
+	ValidatorSetPreferenceKeeper *valpref.Keeper
 	// IBC modules
 	// transfer module
 	TransferModule transfer.AppModule
 
+	// copy of the bank keeper restricted to minting tokenfactory denoms, which calls the same bank hooks
+	tokenFactoryBankKeeper *bankkeeper.BaseKeeper
+
 	// keys to access the substores
 	keys    map[string]*sdk.KVStoreKey
 	tkeys   map[string]*sdk.TransientStoreKey
@@ -253,6 +266,7 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 	appKeepers.LockupKeeper = lockupkeeper.NewKeeper(
 		appCodec,
 		appKeepers.keys[lockuptypes.StoreKey],
+		appKeepers.GetSubspace(lockuptypes.ModuleName),
 		// TODO: Visit why this needs to be deref'd
 		*appKeepers.AccountKeeper,
 		appKeepers.BankKeeper,
@@ -285,7 +299,7 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 
 	appKeepers.SuperfluidKeeper = superfluidkeeper.NewKeeper(
 		appCodec, appKeepers.keys[superfluidtypes.StoreKey], appKeepers.GetSubspace(superfluidtypes.ModuleName),
-		*appKeepers.AccountKeeper, appKeepers.BankKeeper, appKeepers.StakingKeeper, appKeepers.DistrKeeper, appKeepers.EpochsKeeper, appKeepers.LockupKeeper, appKeepers.GAMMKeeper, appKeepers.IncentivesKeeper,
+		*appKeepers.AccountKeeper, appKeepers.BankKeeper, appKeepers.StakingKeeper, appKeepers.DistrKeeper, appKeepers.EpochsKeeper, appKeepers.LockupKeeper, appKeepers.GAMMKeeper, appKeepers.TwapKeeper, appKeepers.IncentivesKeeper,
 		lockupkeeper.NewMsgServerImpl(appKeepers.LockupKeeper))
 
 	mintKeeper := mintkeeper.NewKeeper(
@@ -309,23 +323,52 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 		appKeepers.IncentivesKeeper,
 		appKeepers.DistrKeeper,
 		appKeepers.GAMMKeeper,
+		appKeepers.LockupKeeper,
 	)
 	appKeepers.PoolIncentivesKeeper = &poolIncentivesKeeper
 
+	tokenFactoryBankKeeper := appKeepers.BankKeeper.WithMintCoinsRestriction(tokenfactorytypes.NewTokenFactoryDenomMintCoinsRestriction())
+	appKeepers.tokenFactoryBankKeeper = &tokenFactoryBankKeeper
 	tokenFactoryKeeper := tokenfactorykeeper.NewKeeper(
 		appKeepers.keys[tokenfactorytypes.StoreKey],
 		appKeepers.GetSubspace(tokenfactorytypes.ModuleName),
 		appKeepers.AccountKeeper,
-		appKeepers.BankKeeper.WithMintCoinsRestriction(tokenfactorytypes.NewTokenFactoryDenomMintCoinsRestriction()),
+		appKeepers.tokenFactoryBankKeeper,
 		appKeepers.DistrKeeper,
+		appKeepers.TxFeesKeeper,
+		appKeepers.TwapKeeper,
 	)
 	appKeepers.TokenFactoryKeeper = &tokenFactoryKeeper
 
+	streamSwapKeeper := streamswapkeeper.NewKeeper(
+		appCodec,
+		appKeepers.keys[streamswaptypes.StoreKey],
+		appKeepers.GetSubspace(streamswaptypes.ModuleName),
+		appKeepers.AccountKeeper,
+		appKeepers.BankKeeper,
+		appKeepers.DistrKeeper,
+	)
+	appKeepers.StreamSwapKeeper = &streamSwapKeeper
+
+	swapRouterKeeper := swaprouterkeeper.NewKeeper(
+		appCodec,
+		appKeepers.keys[swaproutertypes.StoreKey],
+		appKeepers.GAMMKeeper,
+	)
+	appKeepers.SwapRouterKeeper = &swapRouterKeeper
+
+	validatorSetPreferenceKeeper := valpref.NewKeeper(
+		appKeepers.keys[valpreftypes.StoreKey],
+		appKeepers.StakingKeeper,
+		appKeepers.DistrKeeper,
+	)
+	appKeepers.ValidatorSetPreferenceKeeper = &validatorSetPreferenceKeeper
+
 	// The last arguments can contain custom message handlers, and custom query handlers,
 	// if we want to allow any custom callbacks
 	supportedFeatures := "iterator,staking,stargate,osmosis"
 
-	wasmOpts = append(owasm.RegisterCustomPlugins(appKeepers.GAMMKeeper, appKeepers.BankKeeper, appKeepers.TwapKeeper, appKeepers.TokenFactoryKeeper), wasmOpts...)
+	wasmOpts = append(owasm.RegisterCustomPlugins(appKeepers.GAMMKeeper, appKeepers.BankKeeper, appKeepers.TwapKeeper, appKeepers.TokenFactoryKeeper, appKeepers.LockupKeeper, appKeepers.SuperfluidKeeper, appKeepers.IncentivesKeeper), wasmOpts...)
 	wasmOpts = append(owasm.RegisterStargateQueries(*bApp.GRPCQueryRouter(), appCodec), wasmOpts...)
 
 	wasmKeeper := wasm.NewKeeper(
@@ -348,6 +391,7 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 		wasmOpts...,
 	)
 	appKeepers.WasmKeeper = &wasmKeeper
+	appKeepers.TokenFactoryKeeper.SetContractKeeper(appKeepers.WasmKeeper)
 
 	// wire up x/wasm to IBC
 	ibcRouter.AddRoute(wasm.ModuleName, wasm.NewIBCHandler(appKeepers.WasmKeeper, appKeepers.IBCKeeper.ChannelKeeper))
@@ -363,7 +407,8 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 		AddRoute(ibchost.RouterKey, ibcclient.NewClientProposalHandler(appKeepers.IBCKeeper.ClientKeeper)).
 		AddRoute(poolincentivestypes.RouterKey, poolincentives.NewPoolIncentivesProposalHandler(*appKeepers.PoolIncentivesKeeper)).
 		AddRoute(txfeestypes.RouterKey, txfees.NewUpdateFeeTokenProposalHandler(*appKeepers.TxFeesKeeper)).
-		AddRoute(superfluidtypes.RouterKey, superfluid.NewSuperfluidProposalHandler(*appKeepers.SuperfluidKeeper, *appKeepers.EpochsKeeper))
+		AddRoute(superfluidtypes.RouterKey, superfluid.NewSuperfluidProposalHandler(*appKeepers.SuperfluidKeeper, *appKeepers.EpochsKeeper)).
+		AddRoute(swaproutertypes.RouterKey, swaprouter.NewSwapRouterProposalHandler(*appKeepers.SwapRouterKeeper))
 
 	// The gov proposal types can be individually enabled
 	if len(wasmEnabledProposals) != 0 {
@@ -434,6 +479,7 @@ func (appKeepers *AppKeepers) initParamsKeeper(appCodec codec.BinaryCodec, legac
 	paramsKeeper.Subspace(ibctransfertypes.ModuleName)
 	paramsKeeper.Subspace(ibchost.ModuleName)
 	paramsKeeper.Subspace(icahosttypes.SubModuleName)
+	paramsKeeper.Subspace(lockuptypes.ModuleName)
 	paramsKeeper.Subspace(incentivestypes.ModuleName)
 	paramsKeeper.Subspace(poolincentivestypes.ModuleName)
 	paramsKeeper.Subspace(superfluidtypes.ModuleName)
@@ -441,6 +487,7 @@ func (appKeepers *AppKeepers) initParamsKeeper(appCodec codec.BinaryCodec, legac
 	paramsKeeper.Subspace(wasm.ModuleName)
 	paramsKeeper.Subspace(tokenfactorytypes.ModuleName)
 	paramsKeeper.Subspace(twaptypes.ModuleName)
+	paramsKeeper.Subspace(streamswaptypes.ModuleName)
 
 	return paramsKeeper
 }
@@ -472,6 +519,8 @@ func (appKeepers *AppKeepers) SetupHooks() {
 		lockuptypes.NewMultiLockupHooks(
 			// insert lockup hooks receivers here
 			appKeepers.SuperfluidKeeper.Hooks(),
+			appKeepers.IncentivesKeeper.LockupHooks(),
+			appKeepers.PoolIncentivesKeeper.LockupHooks(),
 		),
 	)
 
@@ -504,6 +553,15 @@ func (appKeepers *AppKeepers) SetupHooks() {
 		// insert governance hooks receivers here
 		),
 	)
+
+	bankHooks := banktypes.NewMultiBankHooks(
+		// insert bank hooks receivers here
+		appKeepers.TokenFactoryKeeper.Hooks(),
+		appKeepers.IncentivesKeeper.BankHooks(),
+	)
+	appKeepers.BankKeeper.SetHooks(bankHooks)
+	// the tokenfactory keeper sends through its own copy of the bank keeper, taken before the hooks are set
+	appKeepers.tokenFactoryBankKeeper.SetHooks(bankHooks)
 }
 
 // TODO: We need to automate this, by bundling with a module struct...
@@ -534,5 +592,8 @@ func KVStoreKeys() []string {
 		superfluidtypes.StoreKey,
 		wasm.StoreKey,
 		tokenfactorytypes.StoreKey,
+		streamswaptypes.StoreKey,
+		swaproutertypes.StoreKey,
+		valpreftypes.StoreKey,
 	}
 }
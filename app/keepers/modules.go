@@ -35,11 +35,15 @@ import (
 	"github.com/osmosis-labs/osmosis/v12/x/mint"
 	poolincentives "github.com/osmosis-labs/osmosis/v12/x/pool-incentives"
 	poolincentivesclient "github.com/osmosis-labs/osmosis/v12/x/pool-incentives/client"
+	"github.com/osmosis-labs/osmosis/v12/x/streamswap"
 	superfluid "github.com/osmosis-labs/osmosis/v12/x/superfluid"
 	superfluidclient "github.com/osmosis-labs/osmosis/v12/x/superfluid/client"
+	"github.com/osmosis-labs/osmosis/v12/x/swaprouter"
+	swaprouterclient "github.com/osmosis-labs/osmosis/v12/x/swaprouter/client"
 	"github.com/osmosis-labs/osmosis/v12/x/tokenfactory"
 	"github.com/osmosis-labs/osmosis/v12/x/twap/twapmodule"
 	"github.com/osmosis-labs/osmosis/v12/x/txfees"
+	"github.com/osmosis-labs/osmosis/v12/x/validator-preference/valprefmodule"
 )
 
 // AppModuleBasics returns ModuleBasics for the module BasicManager.
@@ -64,6 +68,8 @@ var AppModuleBasics = []module.AppModuleBasic{
 			ibcclientclient.UpgradeProposalHandler,
 			superfluidclient.SetSuperfluidAssetsProposalHandler,
 			superfluidclient.RemoveSuperfluidAssetsProposalHandler,
+			swaprouterclient.SetPreferredRoutesProposalHandler,
+			swaprouterclient.RemovePreferredRoutesProposalHandler,
 		)...,
 	),
 	params.AppModuleBasic{},
@@ -84,6 +90,9 @@ var AppModuleBasics = []module.AppModuleBasic{
 	epochs.AppModuleBasic{},
 	superfluid.AppModuleBasic{},
 	tokenfactory.AppModuleBasic{},
+	streamswap.AppModuleBasic{},
+	swaprouter.AppModuleBasic{},
+	valprefmodule.AppModuleBasic{},
 	wasm.AppModuleBasic{},
 	ica.AppModuleBasic{},
 }
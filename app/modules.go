@@ -55,14 +55,20 @@ import (
 	minttypes "github.com/osmosis-labs/osmosis/v12/x/mint/types"
 	poolincentives "github.com/osmosis-labs/osmosis/v12/x/pool-incentives"
 	poolincentivestypes "github.com/osmosis-labs/osmosis/v12/x/pool-incentives/types"
+	"github.com/osmosis-labs/osmosis/v12/x/streamswap"
+	streamswaptypes "github.com/osmosis-labs/osmosis/v12/x/streamswap/types"
 	superfluid "github.com/osmosis-labs/osmosis/v12/x/superfluid"
 	superfluidtypes "github.com/osmosis-labs/osmosis/v12/x/superfluid/types"
+	"github.com/osmosis-labs/osmosis/v12/x/swaprouter"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v12/x/swaprouter/types"
 	"github.com/osmosis-labs/osmosis/v12/x/tokenfactory"
 	tokenfactorytypes "github.com/osmosis-labs/osmosis/v12/x/tokenfactory/types"
 	"github.com/osmosis-labs/osmosis/v12/x/twap/twapmodule"
 	twaptypes "github.com/osmosis-labs/osmosis/v12/x/twap/types"
 	"github.com/osmosis-labs/osmosis/v12/x/txfees"
 	txfeestypes "github.com/osmosis-labs/osmosis/v12/x/txfees/types"
+	valpreftypes "github.com/osmosis-labs/osmosis/v12/x/validator-preference/types"
+	"github.com/osmosis-labs/osmosis/v12/x/validator-preference/valprefmodule"
 )
 
 // moduleAccountPermissions defines module account permissions
@@ -86,6 +92,7 @@ var moduleAccountPermissions = map[string][]string{
 	txfeestypes.NonNativeFeeCollectorName:    nil,
 	wasm.ModuleName:                          {authtypes.Burner},
 	tokenfactorytypes.ModuleName:             {authtypes.Minter, authtypes.Burner},
+	streamswaptypes.ModuleName:               nil,
 }
 
 // appModules return modules to initialize module manager.
@@ -138,6 +145,9 @@ func appModules(
 			app.EpochsKeeper,
 		),
 		tokenfactory.NewAppModule(*app.TokenFactoryKeeper, app.AccountKeeper, app.BankKeeper),
+		streamswap.NewAppModule(*app.StreamSwapKeeper),
+		swaprouter.NewAppModule(*app.SwapRouterKeeper),
+		valprefmodule.NewAppModule(*app.ValidatorSetPreferenceKeeper),
 	}
 }
 
@@ -192,6 +202,8 @@ func OrderInitGenesis(allModuleNames []string) []string {
 		icatypes.ModuleName,
 		gammtypes.ModuleName,
 		twaptypes.ModuleName,
+		// swaprouter after gamm, as the pools of the genesis routes must exist
+		swaproutertypes.ModuleName,
 		txfeestypes.ModuleName,
 		genutiltypes.ModuleName,
 		evidencetypes.ModuleName,
@@ -202,6 +214,8 @@ func OrderInitGenesis(allModuleNames []string) []string {
 		poolincentivestypes.ModuleName,
 		superfluidtypes.ModuleName,
 		tokenfactorytypes.ModuleName,
+		streamswaptypes.ModuleName,
+		valpreftypes.ModuleName,
 		incentivestypes.ModuleName,
 		epochstypes.ModuleName,
 		lockuptypes.ModuleName,
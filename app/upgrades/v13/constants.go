@@ -0,0 +1,22 @@
+package v13
+
+import (
+	store "github.com/cosmos/cosmos-sdk/store/types"
+
+	"github.com/osmosis-labs/osmosis/v12/app/upgrades"
+	streamswaptypes "github.com/osmosis-labs/osmosis/v12/x/streamswap/types"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v12/x/swaprouter/types"
+	valpreftypes "github.com/osmosis-labs/osmosis/v12/x/validator-preference/types"
+)
+
+// UpgradeName defines the on-chain upgrade name for the Osmosis v13 upgrade.
+const UpgradeName = "v13"
+
+var Upgrade = upgrades.Upgrade{
+	UpgradeName:          UpgradeName,
+	CreateUpgradeHandler: CreateUpgradeHandler,
+	StoreUpgrades: store.StoreUpgrades{
+		Added:   []string{streamswaptypes.StoreKey, valpreftypes.StoreKey, swaproutertypes.StoreKey},
+		Deleted: []string{},
+	},
+}
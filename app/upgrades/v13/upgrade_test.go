@@ -0,0 +1,94 @@
+package v13_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/osmosis-labs/osmosis/v12/app/apptesting"
+	v13 "github.com/osmosis-labs/osmosis/v12/app/upgrades/v13"
+	gammtypes "github.com/osmosis-labs/osmosis/v12/x/gamm/types"
+	incentivestypes "github.com/osmosis-labs/osmosis/v12/x/incentives/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/v12/x/lockup/types"
+)
+
+type UpgradeTestSuite struct {
+	apptesting.KeeperTestHelper
+}
+
+func (suite *UpgradeTestSuite) SetupTest() {
+	suite.Setup()
+}
+
+func TestUpgradeTestSuite(t *testing.T) {
+	suite.Run(t, new(UpgradeTestSuite))
+}
+
+const dummyUpgradeHeight = 5
+
+func (suite *UpgradeTestSuite) runUpgrade() {
+	suite.Ctx = suite.Ctx.WithBlockHeight(dummyUpgradeHeight - 1)
+	plan := upgradetypes.Plan{Name: v13.UpgradeName, Height: dummyUpgradeHeight}
+	err := suite.App.UpgradeKeeper.ScheduleUpgrade(suite.Ctx, plan)
+	suite.Require().NoError(err)
+	_, exists := suite.App.UpgradeKeeper.GetUpgradePlan(suite.Ctx)
+	suite.Require().True(exists)
+
+	suite.Ctx = suite.Ctx.WithBlockHeight(dummyUpgradeHeight)
+	suite.Require().NotPanics(func() {
+		suite.App.BeginBlocker(suite.Ctx, abci.RequestBeginBlock{})
+	})
+}
+
+// TestLPShareHolderMigration tests that the accounts holding unlocked pool shares before the upgrade,
+// which the bank hooks never saw, are rewarded by LP share gauges after the upgrade.
+func (suite *UpgradeTestSuite) TestLPShareHolderMigration() {
+	suite.SetupTest()
+
+	poolId := suite.PrepareBalancerPool()
+	shareDenom := gammtypes.GetPoolShareDenom(poolId)
+	holder := suite.TestAccs[0]
+	holderShares := suite.App.BankKeeper.GetBalance(suite.Ctx, holder, shareDenom)
+	suite.Require().True(holderShares.IsPositive())
+
+	// pre-upgrade state, with no holders tracked
+	store := prefix.NewStore(suite.Ctx.KVStore(suite.App.GetKey(incentivestypes.StoreKey)), incentivestypes.KeyPrefixLPShareHolders)
+	iterator := store.Iterator(nil, nil)
+	keys := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	suite.Require().Empty(suite.App.IncentivesKeeper.GetLPShareHolders(suite.Ctx, shareDenom))
+
+	suite.runUpgrade()
+
+	// the holder is tracked with its whole balance, and module accounts such as the pool's are not
+	holders := suite.App.IncentivesKeeper.GetAllLPShareHolders(suite.Ctx)
+	suite.Require().Equal([]incentivestypes.LPShareHolder{{
+		Denom:            shareDenom,
+		Address:          holder.String(),
+		QualifyingShares: holderShares.Amount,
+		EpochNumber:      holders[0].EpochNumber,
+	}}, holders)
+
+	// the holder is rewarded by a gauge distributing to unlocked shares
+	rewards := sdk.NewCoins(sdk.NewInt64Coin("reward", 100))
+	gaugeOwner := suite.TestAccs[1]
+	suite.FundAcc(gaugeOwner, apptesting.DefaultAcctFunds.Add(rewards...))
+	distrTo := lockuptypes.QueryCondition{LockQueryType: lockuptypes.NoLock, Denom: shareDenom}
+	_, err := suite.App.IncentivesKeeper.CreateGauge(suite.Ctx, false, gaugeOwner, rewards, distrTo, suite.Ctx.BlockTime(), 1)
+	suite.Require().NoError(err)
+
+	distrEpochIdentifier := suite.App.IncentivesKeeper.GetParams(suite.Ctx).DistrEpochIdentifier
+	err = suite.App.IncentivesKeeper.AfterEpochEnd(suite.Ctx, distrEpochIdentifier, holders[0].EpochNumber)
+	suite.Require().NoError(err)
+	suite.Require().Equal(rewards.AmountOf("reward"), suite.App.BankKeeper.GetBalance(suite.Ctx, holder, "reward").Amount)
+}
@@ -0,0 +1,66 @@
+package v13
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	"github.com/osmosis-labs/osmosis/v12/app/keepers"
+	"github.com/osmosis-labs/osmosis/v12/app/upgrades"
+	lockuptypes "github.com/osmosis-labs/osmosis/v12/x/lockup/types"
+	poolincentivestypes "github.com/osmosis-labs/osmosis/v12/x/pool-incentives/types"
+	superfluidtypes "github.com/osmosis-labs/osmosis/v12/x/superfluid/types"
+	tokenfactorytypes "github.com/osmosis-labs/osmosis/v12/x/tokenfactory/types"
+)
+
+func CreateUpgradeHandler(
+	mm *module.Manager,
+	configurator module.Configurator,
+	bpm upgrades.BaseAppParamManager,
+	keepers *keepers.AppKeepers,
+) upgradetypes.UpgradeHandler {
+	return func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		// Records stored before the geometric twap accumulator was introduced do not have it set.
+		if err := keepers.TwapKeeper.MigrateGeometricTwapAccumulators(ctx); err != nil {
+			return nil, err
+		}
+
+		// Rewards of native locks are accumulated for the lock owners to claim from this upgrade on,
+		// so the existing locks start accruing them from their state at the upgrade.
+		if err := keepers.IncentivesKeeper.MigrateLocksToRewardCheckpoints(ctx); err != nil {
+			return nil, err
+		}
+
+		// Unlocked pool shares are tracked by the bank hooks from this upgrade on, so the existing
+		// holders are tracked from their balances at the upgrade.
+		if err := keepers.IncentivesKeeper.MigrateLPShareHolders(ctx); err != nil {
+			return nil, err
+		}
+
+		// Superfluid params added in this upgrade are not in the param store yet.
+		superfluidParams := superfluidtypes.DefaultParams()
+		superfluidSubspace := keepers.GetSubspace(superfluidtypes.ModuleName)
+		superfluidSubspace.Set(ctx, superfluidtypes.KeyMultiplierTwapType, superfluidParams.MultiplierTwapType)
+		superfluidSubspace.Set(ctx, superfluidtypes.KeyMultiplierFallback, superfluidParams.MultiplierFallback)
+		superfluidSubspace.Set(ctx, superfluidtypes.KeyMaxMultiplierChange, superfluidParams.MaxMultiplierChange)
+
+		// Pool-incentives gauge voting params added in this upgrade are not in the param store yet.
+		poolIncentivesParams := poolincentivestypes.DefaultParams()
+		poolIncentivesSubspace := keepers.GetSubspace(poolincentivestypes.ModuleName)
+		poolIncentivesSubspace.Set(ctx, poolincentivestypes.KeyGaugeVotingEnabled, poolIncentivesParams.GaugeVotingEnabled)
+		poolIncentivesSubspace.Set(ctx, poolincentivestypes.KeyMinVoteLockDuration, poolIncentivesParams.MinVoteLockDuration)
+		poolIncentivesSubspace.Set(ctx, poolincentivestypes.KeyMaxGaugeWeight, poolIncentivesParams.MaxGaugeWeight)
+		poolIncentivesSubspace.Set(ctx, poolincentivestypes.KeyMinVotingPower, poolIncentivesParams.MinVotingPower)
+
+		// The tokenfactory denom creation fee burn fraction is not in the param store yet.
+		keepers.GetSubspace(tokenfactorytypes.ModuleName).Set(ctx, tokenfactorytypes.KeyDenomCreationFeeBurnFraction, tokenfactorytypes.DefaultParams().DenomCreationFeeBurnFraction)
+
+		// The lockup module has no params before this upgrade. Early unlock is
+		// disabled by default, for governance to enable it.
+		keepers.LockupKeeper.SetParams(ctx, lockuptypes.DefaultParams())
+
+		// Modules added in this upgrade are not in fromVM, so RunMigrations
+		// runs their InitGenesis with the default genesis state.
+		return mm.RunMigrations(ctx, configurator, fromVM)
+	}
+}
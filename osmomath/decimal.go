@@ -30,6 +30,9 @@ const (
 
 	// max number of iterations in ApproxRoot function
 	maxApproxRootIterations = 100
+
+	// max number of iterations in LogBase2 function
+	maxLog2Iterations = 300
 )
 
 var (
@@ -414,6 +417,47 @@ func (d BigDec) ApproxSqrt() (BigDec, error) {
 	return d.ApproxRoot(2)
 }
 
+// LogBase2 returns the logarithm base 2 of a positive decimal.
+// It panics if the input is not positive.
+//
+// The integer part is found by shifting the input into [1, 2).
+// Every following binary digit of the result is then found by repeated
+// squaring: if x^2 >= 2, the next digit is 1 and x^2 is halved.
+// This stops once the digit value can no longer be represented at
+// Precision, or after maxLog2Iterations.
+func (d BigDec) LogBase2() BigDec {
+	if !d.IsPositive() {
+		panic(fmt.Errorf("log is not defined at <= 0, given (%s)", d))
+	}
+
+	x := BigDec{new(big.Int).Set(d.i)}
+	y := ZeroDec()
+	two := NewBigDec(2)
+
+	// normalize x into [1, 2), accumulating the integer part of the result in y.
+	for x.GTE(two) {
+		x = x.QuoInt64(2)
+		y = y.Add(OneDec())
+	}
+	for x.LT(OneDec()) {
+		x = x.MulInt64(2)
+		y = y.Sub(OneDec())
+	}
+
+	// compute the fractional part, one binary digit per iteration.
+	b := OneDec().QuoInt64(2)
+	for i := 0; i < maxLog2Iterations && b.IsPositive(); i++ {
+		x = x.Mul(x)
+		if x.GTE(two) {
+			x = x.QuoInt64(2)
+			y = y.Add(b)
+		}
+		b = b.QuoInt64(2)
+	}
+
+	return y
+}
+
 // is integer, e.g. decimals are zero
 func (d BigDec) IsInteger() bool {
 	return new(big.Int).Rem(d.i, precisionReuse).Sign() == 0
@@ -526,6 +526,33 @@ func (s *decimalTestSuite) TestApproxSqrt() {
 	}
 }
 
+func (s *decimalTestSuite) TestLogBase2() {
+	// errTolerance is the maximum absolute error allowed between the expected and the actual result.
+	errTolerance := MustNewDecFromStr("0.000000000000000000000000000000001")
+
+	testCases := []struct {
+		input    BigDec
+		expected BigDec
+	}{
+		{OneDec(), ZeroDec()},                                                                     // log2(1) = 0
+		{NewBigDec(2), OneDec()},                                                                  // log2(2) = 1
+		{NewBigDec(1024), NewBigDec(10)},                                                          // log2(1024) = 10
+		{MustNewDecFromStr("0.25"), NewBigDec(-2)},                                                // log2(0.25) = -2
+		{NewBigDec(3), MustNewDecFromStr("1.584962500721156181453738943947816509")},               // log2(3) ≈ 1.584962500721156181453738943947816509
+		{MustNewDecFromStr("0.1"), MustNewDecFromStr("-3.321928094887362347870319429489390175")},  // log2(0.1) ≈ -3.321928094887362347870319429489390175
+		{NewDecWithPrec(1, 18), MustNewDecFromStr("-59.794705707972522261665749730809023166")},    // log2(1e-18) ≈ -59.794705707972522261665749730809023166
+		{NewBigDec(2).Power(128), NewBigDec(128)},                                                 // log2(2^128) = 128
+	}
+
+	for i, tc := range testCases {
+		res := tc.input.LogBase2()
+		s.Require().True(tc.expected.Sub(res).Abs().LTE(errTolerance), "unexpected result for test case %d, input: %v, got: %v", i, tc.input, res)
+	}
+
+	s.Require().Panics(func() { ZeroDec().LogBase2() })
+	s.Require().Panics(func() { NewBigDec(-1).LogBase2() })
+}
+
 func (s *decimalTestSuite) TestDecSortableBytes() {
 	tests := []struct {
 		d    BigDec
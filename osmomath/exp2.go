@@ -0,0 +1,43 @@
+package osmomath
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// ln2 is the natural logarithm of 2, rounded to Precision.
+var ln2 = MustNewDecFromStr("0.693147180559945309417232121458176568")
+
+// maxExp2Exponent bounds the absolute value of the exponent accepted by Exp2,
+// so that the result always fits comfortably in a BigDec.
+var maxExp2Exponent = MustNewDecFromStr("1024")
+
+// Exp2 returns 2^exponent.
+// It panics if |exponent| is greater than 1024.
+//
+// The exponent is split into an integer and a fractional part.
+// 2 to the integer part is computed with a bit shift, and 2 to the
+// fractional part as e^(fractional * ln(2)) via its Taylor series, which
+// converges quickly since the argument is in [0, ln(2)).
+// Negative exponents are computed as 1 / 2^|exponent|.
+func Exp2(exponent BigDec) BigDec {
+	if exponent.Abs().GT(maxExp2Exponent) {
+		panic(fmt.Errorf("integer exponent %s is too large, max (%s)", exponent, maxExp2Exponent))
+	}
+	if exponent.IsNegative() {
+		return OneDec().Quo(Exp2(exponent.Neg()))
+	}
+
+	integer := exponent.TruncateDec()
+	fractional := exponent.Sub(integer)
+
+	x := fractional.Mul(ln2)
+	term, sum := OneDec(), OneDec()
+	for n := int64(1); !term.IsZero(); n++ {
+		term = term.Mul(x).QuoInt64(n)
+		sum = sum.Add(term)
+	}
+
+	shift := uint(integer.TruncateInt64())
+	return BigDec{new(big.Int).Lsh(sum.i, shift)}
+}
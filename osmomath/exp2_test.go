@@ -0,0 +1,41 @@
+package osmomath
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExp2(t *testing.T) {
+	// errTolerance is the maximum absolute error allowed between the expected and the actual result.
+	errTolerance := MustNewDecFromStr("0.000000000000000000000000000000001")
+
+	tests := map[string]struct {
+		exponent    BigDec
+		expected    BigDec
+		expectPanic bool
+	}{
+		"0":            {exponent: ZeroDec(), expected: OneDec()},
+		"1":            {exponent: OneDec(), expected: NewBigDec(2)},
+		"10":           {exponent: NewBigDec(10), expected: NewBigDec(1024)},
+		"0.5":          {exponent: MustNewDecFromStr("0.5"), expected: MustNewDecFromStr("1.414213562373095048801688724209698079")},
+		"3.5":          {exponent: MustNewDecFromStr("3.5"), expected: MustNewDecFromStr("11.313708498984760390413509793677584628")},
+		"0.000001":     {exponent: MustNewDecFromStr("0.000001"), expected: MustNewDecFromStr("1.000000693147420786507772636227407030")},
+		"-1":           {exponent: NewBigDec(-1), expected: MustNewDecFromStr("0.5")},
+		"-0.5":         {exponent: MustNewDecFromStr("-0.5"), expected: MustNewDecFromStr("0.707106781186547524400844362104849039")},
+		"max":          {exponent: NewBigDec(1024), expected: NewBigDec(2).Power(1024)},
+		"max plus one": {exponent: NewBigDec(1025), expectPanic: true},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if tc.expectPanic {
+				require.Panics(t, func() { Exp2(tc.exponent) })
+				return
+			}
+			result := Exp2(tc.exponent)
+			require.True(t, tc.expected.Sub(result).Abs().LTE(errTolerance.Mul(MaxDec(OneDec(), tc.expected))),
+				"expected %s, got %s", tc.expected, result)
+		})
+	}
+}
@@ -47,3 +47,17 @@ func ParseSdkIntFromString(s string, separator string) ([]sdk.Int, error) {
 	}
 	return parsedInts, nil
 }
+
+func ParseSdkDecFromString(s string, separator string) ([]sdk.Dec, error) {
+	var parsedDecs []sdk.Dec
+	for _, weightStr := range strings.Split(s, separator) {
+		weightStr = strings.TrimSpace(weightStr)
+
+		parsed, err := sdk.NewDecFromStr(weightStr)
+		if err != nil {
+			return parsedDecs, err
+		}
+		parsedDecs = append(parsedDecs, parsed)
+	}
+	return parsedDecs, nil
+}
@@ -326,9 +326,8 @@ func updatePoolIncentiveGenesis(pooliGenState *poolitypes.GenesisState) {
 		time.Second * 180,
 		time.Second * 240,
 	}
-	pooliGenState.Params = poolitypes.Params{
-		MintedDenom: OsmoDenom,
-	}
+	pooliGenState.Params = poolitypes.DefaultParams()
+	pooliGenState.Params.MintedDenom = OsmoDenom
 }
 
 func updateIncentivesGenesis(incentivesGenState *incentivestypes.GenesisState) {
@@ -1,6 +1,9 @@
 package bindings
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 type OsmosisMsg struct {
 	/// Contracts can create denoms, namespaced under the contract's address.
@@ -15,8 +18,38 @@ type OsmosisMsg struct {
 	/// that they are the admin of.
 	/// Currently, the burn from address must be the admin contract.
 	BurnTokens *BurnTokens `json:"burn_tokens,omitempty"`
+	/// Contracts can force transfer native tokens for an existing factory denom
+	/// that they are the admin of, if the denom has the force_transfer capability.
+	ForceTransfer *ForceTransfer `json:"force_transfer,omitempty"`
+	/// Contracts can freeze and unfreeze accounts for an existing factory denom
+	/// that they are the admin of, if the denom has the freeze capability.
+	Freeze *Freeze `json:"freeze,omitempty"`
 	/// Swap over one or more pools
 	Swap *SwapMsg `json:"swap,omitempty"`
+	/// Contracts can join a pool for an exact amount of LP shares.
+	/// The shares received and tokens spent are returned in the response data.
+	JoinPool *JoinPool `json:"join_pool,omitempty"`
+	/// Contracts can exit a pool for an exact amount of LP shares.
+	/// The tokens received are returned in the response data.
+	ExitPool *ExitPool `json:"exit_pool,omitempty"`
+	/// Contracts can join a pool with an exact amount of a single pool asset.
+	/// The shares received are returned in the response data.
+	JoinSwapExternAmountIn *JoinSwapExternAmountIn `json:"join_swap_extern_amount_in,omitempty"`
+	/// Contracts can exit a pool with an exact amount of LP shares into a single pool asset.
+	/// The tokens received are returned in the response data.
+	ExitSwapShareAmountIn *ExitSwapShareAmountIn `json:"exit_swap_share_amount_in,omitempty"`
+	/// Contracts can lock tokens, such as LP shares, for a duration.
+	/// The ID of the created lock is returned in the response data.
+	LockTokens *LockTokens `json:"lock_tokens,omitempty"`
+	/// Contracts can begin unlocking a lock that they own.
+	BeginUnlocking *BeginUnlocking `json:"begin_unlocking,omitempty"`
+	/// Contracts can superfluid delegate a lock of a superfluid asset that they own.
+	SuperfluidDelegate *SuperfluidDelegate `json:"superfluid_delegate,omitempty"`
+	/// Contracts can superfluid undelegate a lock that they own.
+	SuperfluidUndelegate *SuperfluidUndelegate `json:"superfluid_undelegate,omitempty"`
+	/// Contracts can create gauges that distribute coins to the locks of a denom.
+	/// The ID of the created gauge is returned in the response data.
+	CreateGauge *CreateGauge `json:"create_gauge,omitempty"`
 }
 
 // CreateDenom creates a new factory denom, of denomination:
@@ -25,8 +58,12 @@ type OsmosisMsg struct {
 // The (creating contract address, subdenom) pair must be unique.
 // The created denom's admin is the creating contract address,
 // but this admin can be changed using the ChangeAdmin binding.
+// Capabilities can be any of "force_transfer" and "freeze", and can not be changed later.
 type CreateDenom struct {
-	Subdenom string `json:"subdenom"`
+	Subdenom     string   `json:"subdenom"`
+	Capabilities []string `json:"capabilities,omitempty"`
+	// FeeDenom is the fee token the denom creation fee is paid in, if set.
+	FeeDenom string `json:"fee_denom,omitempty"`
 }
 
 // ChangeAdmin changes the admin for a factory denom.
@@ -49,8 +86,128 @@ type BurnTokens struct {
 	BurnFromAddress string `json:"burn_from_address"`
 }
 
+// ForceTransfer transfers tokens of a factory denom from any account to any other account,
+// including frozen accounts.
+type ForceTransfer struct {
+	Denom       string  `json:"denom"`
+	Amount      sdk.Int `json:"amount"`
+	FromAddress string  `json:"from_address"`
+	ToAddress   string  `json:"to_address"`
+}
+
+// Freeze freezes or unfreezes an account for a factory denom.
+// A frozen account can neither send nor receive the denom.
+type Freeze struct {
+	Denom   string `json:"denom"`
+	Address string `json:"address"`
+	Frozen  bool   `json:"frozen"`
+}
+
 type SwapMsg struct {
 	First  Swap                `json:"first"`
 	Route  []Step              `json:"route"`
 	Amount SwapAmountWithLimit `json:"amount"`
+	// Splits are additional routes starting from First.DenomIn, swapped atomically along with First and Route.
+	// They are only supported for exact in swaps, for which ExactIn.Input is swapped through First and Route,
+	// and ExactIn.MinOutput is checked against the total output of all of the routes.
+	Splits []SplitRoute `json:"splits,omitempty"`
+}
+
+// SplitRoute swaps Input through Route, as part of a split route swap.
+type SplitRoute struct {
+	Route []Step  `json:"route"`
+	Input sdk.Int `json:"input"`
+}
+
+// JoinPool joins a pool for ShareOutAmount LP shares, spending at most TokenInMaxs.
+// If TokenInMaxs is empty, any amount of the pool assets is spent.
+type JoinPool struct {
+	PoolId         uint64            `json:"pool_id"`
+	ShareOutAmount sdk.Int           `json:"share_out_amount"`
+	TokenInMaxs    wasmvmtypes.Coins `json:"token_in_maxs"`
+}
+
+type JoinPoolResponse struct {
+	ShareOutAmount sdk.Int           `json:"share_out_amount"`
+	TokenIn        wasmvmtypes.Coins `json:"token_in"`
+}
+
+// ExitPool exits a pool with ShareInAmount LP shares, receiving at least TokenOutMins.
+type ExitPool struct {
+	PoolId        uint64            `json:"pool_id"`
+	ShareInAmount sdk.Int           `json:"share_in_amount"`
+	TokenOutMins  wasmvmtypes.Coins `json:"token_out_mins"`
+}
+
+type ExitPoolResponse struct {
+	TokenOut wasmvmtypes.Coins `json:"token_out"`
+}
+
+// JoinSwapExternAmountIn joins a pool with exactly TokenIn, swapping part of it into the other
+// pool assets, for at least ShareOutMinAmount LP shares.
+type JoinSwapExternAmountIn struct {
+	PoolId            uint64           `json:"pool_id"`
+	TokenIn           wasmvmtypes.Coin `json:"token_in"`
+	ShareOutMinAmount sdk.Int          `json:"share_out_min_amount"`
+}
+
+type JoinSwapExternAmountInResponse struct {
+	ShareOutAmount sdk.Int `json:"share_out_amount"`
+}
+
+// ExitSwapShareAmountIn exits a pool with exactly ShareInAmount LP shares, swapping the other
+// pool assets into TokenOutDenom, for at least TokenOutMinAmount.
+type ExitSwapShareAmountIn struct {
+	PoolId            uint64  `json:"pool_id"`
+	TokenOutDenom     string  `json:"token_out_denom"`
+	ShareInAmount     sdk.Int `json:"share_in_amount"`
+	TokenOutMinAmount sdk.Int `json:"token_out_min_amount"`
+}
+
+type ExitSwapShareAmountInResponse struct {
+	TokenOutAmount sdk.Int `json:"token_out_amount"`
+}
+
+// LockTokens locks Coins of the contract for Duration.
+type LockTokens struct {
+	// NOTE: Duration is expected to be in seconds.
+	Duration uint64            `json:"duration"`
+	Coins    wasmvmtypes.Coins `json:"coins"`
+}
+
+type LockTokensResponse struct {
+	LockId uint64 `json:"lock_id"`
+}
+
+// BeginUnlocking begins unlocking Coins of the lock, or the whole lock if Coins is empty.
+type BeginUnlocking struct {
+	LockId uint64            `json:"lock_id"`
+	Coins  wasmvmtypes.Coins `json:"coins,omitempty"`
+}
+
+// SuperfluidDelegate superfluid delegates the lock to the validator.
+type SuperfluidDelegate struct {
+	LockId    uint64 `json:"lock_id"`
+	Validator string `json:"validator"`
+}
+
+// SuperfluidUndelegate superfluid undelegates the lock, which starts superfluid unbonding.
+type SuperfluidUndelegate struct {
+	LockId uint64 `json:"lock_id"`
+}
+
+// CreateGauge creates a gauge distributing Coins to the locks of Denom that are at least Duration long.
+type CreateGauge struct {
+	IsPerpetual bool   `json:"is_perpetual"`
+	Denom       string `json:"denom"`
+	// NOTE: Duration is expected to be in seconds.
+	Duration uint64            `json:"duration"`
+	Coins    wasmvmtypes.Coins `json:"coins"`
+	// NOTE: StartTime is expected to be in Unix time milliseconds, with zero starting the gauge now.
+	StartTime         int64  `json:"start_time"`
+	NumEpochsPaidOver uint64 `json:"num_epochs_paid_over"`
+}
+
+type CreateGaugeResponse struct {
+	GaugeId uint64 `json:"gauge_id"`
 }
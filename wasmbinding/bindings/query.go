@@ -2,6 +2,7 @@ package bindings
 
 import (
 	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // OsmosisQuery contains osmosis custom queries.
@@ -19,8 +20,32 @@ type OsmosisQuery struct {
 	SpotPrice *SpotPrice `json:"spot_price,omitempty"`
 	/// Return current spot price swapping In for Out on given pool ID.
 	EstimateSwap *EstimateSwap `json:"estimate_swap,omitempty"`
+	/// Return the LP shares joining the given pool with the given tokens would currently give,
+	/// along with the tokens it would spend.
+	CalcJoinPoolShares *CalcJoinPoolShares `json:"calc_join_pool_shares,omitempty"`
+	/// Return the tokens exiting the given pool with the given LP shares would currently give.
+	CalcExitPoolCoinsFromShares *CalcExitPoolCoinsFromShares `json:"calc_exit_pool_coins_from_shares,omitempty"`
 	/// Returns the admin of a denom, if the denom is a Token Factory denom.
 	DenomAdmin *DenomAdmin `json:"denom_admin,omitempty"`
+	/// Return the arithmetic TWAP of the given pool and assets over the given time range,
+	/// along with metadata about the price data it is computed from.
+	ArithmeticTwap *ArithmeticTwap `json:"arithmetic_twap,omitempty"`
+	/// Return the arithmetic TWAP of the given pool and assets from the given start time to now.
+	ArithmeticTwapToNow *ArithmeticTwapToNow `json:"arithmetic_twap_to_now,omitempty"`
+	/// Return the geometric TWAP of the given pool and assets over the given time range,
+	/// along with metadata about the price data it is computed from.
+	/// The TWAP is the price of the base asset in units of the quote asset.
+	GeometricTwap *GeometricTwap `json:"geometric_twap,omitempty"`
+	/// Return the geometric TWAP of the given pool and assets from the given start time to now.
+	GeometricTwapToNow *GeometricTwapToNow `json:"geometric_twap_to_now,omitempty"`
+	/// Return the lock with the given ID.
+	Lock *Lock `json:"lock,omitempty"`
+	/// Return all of the locks of the given owner.
+	AccountLocks *AccountLocks `json:"account_locks,omitempty"`
+	/// Return the validator the given lock is superfluid delegated to, if any.
+	SuperfluidDelegation *SuperfluidDelegation `json:"superfluid_delegation,omitempty"`
+	/// Return the gauge with the given ID.
+	Gauge *Gauge `json:"gauge,omitempty"`
 }
 
 type FullDenom struct {
@@ -60,6 +85,9 @@ type ArithmeticTwap struct {
 	StartTime int64 `json:"start_time"`
 	// NOTE: EndTime is expected to be in Unix time milliseconds.
 	EndTime int64 `json:"end_time"`
+	// NOTE: If Lenient is set, the query returns the twap with Metadata.WindowHasError set
+	// if a spot price error occurred within the time range, instead of erroring.
+	Lenient bool `json:"lenient"`
 }
 
 type ArithmeticTwapToNow struct {
@@ -68,6 +96,59 @@ type ArithmeticTwapToNow struct {
 	BaseAssetDenom  string `json:"base_asset_denom"`
 	// NOTE: StartTime is expected to be in Unix time milliseconds.
 	StartTime int64 `json:"start_time"`
+	// NOTE: If Lenient is set, the query returns the twap with Metadata.WindowHasError set
+	// if a spot price error occurred within the time range, instead of erroring.
+	Lenient bool `json:"lenient"`
+}
+
+type GeometricTwap struct {
+	PoolId          uint64 `json:"id"`
+	QuoteAssetDenom string `json:"quote_asset_denom"`
+	BaseAssetDenom  string `json:"base_asset_denom"`
+	// NOTE: StartTime is expected to be in Unix time milliseconds.
+	StartTime int64 `json:"start_time"`
+	// NOTE: EndTime is expected to be in Unix time milliseconds.
+	EndTime int64 `json:"end_time"`
+	// NOTE: If Lenient is set, the query returns the twap with Metadata.WindowHasError set
+	// if a spot price error occurred within the time range, instead of erroring.
+	Lenient bool `json:"lenient"`
+}
+
+type GeometricTwapToNow struct {
+	PoolId          uint64 `json:"id"`
+	QuoteAssetDenom string `json:"quote_asset_denom"`
+	BaseAssetDenom  string `json:"base_asset_denom"`
+	// NOTE: StartTime is expected to be in Unix time milliseconds.
+	StartTime int64 `json:"start_time"`
+	// NOTE: If Lenient is set, the query returns the twap with Metadata.WindowHasError set
+	// if a spot price error occurred within the time range, instead of erroring.
+	Lenient bool `json:"lenient"`
+}
+
+type CalcJoinPoolShares struct {
+	PoolId   uint64            `json:"pool_id"`
+	TokensIn wasmvmtypes.Coins `json:"tokens_in"`
+}
+
+type CalcExitPoolCoinsFromShares struct {
+	PoolId        uint64  `json:"pool_id"`
+	ShareInAmount sdk.Int `json:"share_in_amount"`
+}
+
+type Lock struct {
+	LockId uint64 `json:"id"`
+}
+
+type AccountLocks struct {
+	Owner string `json:"owner"`
+}
+
+type SuperfluidDelegation struct {
+	LockId uint64 `json:"lock_id"`
+}
+
+type Gauge struct {
+	GaugeId uint64 `json:"id"`
 }
 
 func (e *EstimateSwap) ToSwapMsg() *SwapMsg {
@@ -99,3 +180,70 @@ type EstimatePriceResponse struct {
 	// If you query with SwapAmount::Output, this is SwapAmount::Input.
 	Amount SwapAmount `json:"swap_amount"`
 }
+
+type CalcJoinPoolSharesResponse struct {
+	ShareOutAmount sdk.Int           `json:"share_out_amount"`
+	TokensIn       wasmvmtypes.Coins `json:"tokens_in"`
+}
+
+type CalcExitPoolCoinsFromSharesResponse struct {
+	TokensOut wasmvmtypes.Coins `json:"tokens_out"`
+}
+
+type TwapResponse struct {
+	Twap     string       `json:"twap"`
+	Metadata TwapMetadata `json:"metadata"`
+}
+
+type TwapMetadata struct {
+	/// The height of the most recent twap record, i.e. the last block in which the pool's price could have changed.
+	LastRecordHeight int64 `json:"last_record_height"`
+	// NOTE: LastRecordTime is in Unix time milliseconds.
+	LastRecordTime int64 `json:"last_record_time"`
+	// NOTE: LastErrorTime is in Unix time milliseconds, and is zero if no spot price error ever occurred.
+	LastErrorTime int64 `json:"last_error_time"`
+	/// Whether a spot price error occurred within the time range, in which case the twap may be faulty.
+	WindowHasError bool `json:"window_has_error"`
+}
+
+type LockResponse struct {
+	Lock LockInfo `json:"lock"`
+}
+
+type AccountLocksResponse struct {
+	Locks []LockInfo `json:"locks"`
+}
+
+type LockInfo struct {
+	Id    uint64 `json:"id"`
+	Owner string `json:"owner"`
+	// NOTE: Duration is in seconds.
+	Duration uint64 `json:"duration"`
+	// NOTE: EndTime is in Unix time milliseconds, and is zero if the lock is not unlocking.
+	EndTime int64             `json:"end_time"`
+	Coins   wasmvmtypes.Coins `json:"coins"`
+}
+
+type SuperfluidDelegationResponse struct {
+	// The validator the lock is superfluid delegated to, empty if it is not superfluid delegated.
+	Validator string `json:"validator"`
+}
+
+type GaugeResponse struct {
+	Gauge GaugeInfo `json:"gauge"`
+}
+
+type GaugeInfo struct {
+	Id          uint64 `json:"id"`
+	Owner       string `json:"owner"`
+	IsPerpetual bool   `json:"is_perpetual"`
+	Denom       string `json:"denom"`
+	// NOTE: Duration is in seconds.
+	Duration         uint64            `json:"duration"`
+	Coins            wasmvmtypes.Coins `json:"coins"`
+	DistributedCoins wasmvmtypes.Coins `json:"distributed_coins"`
+	// NOTE: StartTime is in Unix time milliseconds.
+	StartTime         int64  `json:"start_time"`
+	NumEpochsPaidOver uint64 `json:"num_epochs_paid_over"`
+	FilledEpochs      uint64 `json:"filled_epochs"`
+}
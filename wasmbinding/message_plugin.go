@@ -2,6 +2,9 @@ package wasmbinding
 
 import (
 	"encoding/json"
+	"fmt"
+	"math"
+	"time"
 
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
@@ -12,28 +15,40 @@ import (
 	"github.com/osmosis-labs/osmosis/v12/wasmbinding/bindings"
 	gammkeeper "github.com/osmosis-labs/osmosis/v12/x/gamm/keeper"
 	gammtypes "github.com/osmosis-labs/osmosis/v12/x/gamm/types"
+	incentiveskeeper "github.com/osmosis-labs/osmosis/v12/x/incentives/keeper"
+	incentivestypes "github.com/osmosis-labs/osmosis/v12/x/incentives/types"
+	lockupkeeper "github.com/osmosis-labs/osmosis/v12/x/lockup/keeper"
+	lockuptypes "github.com/osmosis-labs/osmosis/v12/x/lockup/types"
+	superfluidkeeper "github.com/osmosis-labs/osmosis/v12/x/superfluid/keeper"
+	superfluidtypes "github.com/osmosis-labs/osmosis/v12/x/superfluid/types"
 
 	tokenfactorykeeper "github.com/osmosis-labs/osmosis/v12/x/tokenfactory/keeper"
 	tokenfactorytypes "github.com/osmosis-labs/osmosis/v12/x/tokenfactory/types"
 )
 
 // CustomMessageDecorator returns decorator for custom CosmWasm bindings messages
-func CustomMessageDecorator(gammKeeper *gammkeeper.Keeper, bank *bankkeeper.BaseKeeper, tokenFactory *tokenfactorykeeper.Keeper) func(wasmkeeper.Messenger) wasmkeeper.Messenger {
+func CustomMessageDecorator(gammKeeper *gammkeeper.Keeper, bank *bankkeeper.BaseKeeper, tokenFactory *tokenfactorykeeper.Keeper, lockupKeeper *lockupkeeper.Keeper, superfluidKeeper *superfluidkeeper.Keeper, incentivesKeeper *incentiveskeeper.Keeper) func(wasmkeeper.Messenger) wasmkeeper.Messenger {
 	return func(old wasmkeeper.Messenger) wasmkeeper.Messenger {
 		return &CustomMessenger{
-			wrapped:      old,
-			bank:         bank,
-			gammKeeper:   gammKeeper,
-			tokenFactory: tokenFactory,
+			wrapped:          old,
+			bank:             bank,
+			gammKeeper:       gammKeeper,
+			tokenFactory:     tokenFactory,
+			lockupKeeper:     lockupKeeper,
+			superfluidKeeper: superfluidKeeper,
+			incentivesKeeper: incentivesKeeper,
 		}
 	}
 }
 
 type CustomMessenger struct {
-	wrapped      wasmkeeper.Messenger
-	bank         *bankkeeper.BaseKeeper
-	gammKeeper   *gammkeeper.Keeper
-	tokenFactory *tokenfactorykeeper.Keeper
+	wrapped          wasmkeeper.Messenger
+	bank             *bankkeeper.BaseKeeper
+	gammKeeper       *gammkeeper.Keeper
+	tokenFactory     *tokenfactorykeeper.Keeper
+	lockupKeeper     *lockupkeeper.Keeper
+	superfluidKeeper *superfluidkeeper.Keeper
+	incentivesKeeper *incentiveskeeper.Keeper
 }
 
 var _ wasmkeeper.Messenger = (*CustomMessenger)(nil)
@@ -59,9 +74,42 @@ func (m *CustomMessenger) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddre
 		if contractMsg.BurnTokens != nil {
 			return m.burnTokens(ctx, contractAddr, contractMsg.BurnTokens)
 		}
+		if contractMsg.ForceTransfer != nil {
+			return m.forceTransfer(ctx, contractAddr, contractMsg.ForceTransfer)
+		}
+		if contractMsg.Freeze != nil {
+			return m.freeze(ctx, contractAddr, contractMsg.Freeze)
+		}
 		if contractMsg.Swap != nil {
 			return m.swapTokens(ctx, contractAddr, contractMsg.Swap)
 		}
+		if contractMsg.JoinPool != nil {
+			return m.joinPool(ctx, contractAddr, contractMsg.JoinPool)
+		}
+		if contractMsg.ExitPool != nil {
+			return m.exitPool(ctx, contractAddr, contractMsg.ExitPool)
+		}
+		if contractMsg.JoinSwapExternAmountIn != nil {
+			return m.joinSwapExternAmountIn(ctx, contractAddr, contractMsg.JoinSwapExternAmountIn)
+		}
+		if contractMsg.ExitSwapShareAmountIn != nil {
+			return m.exitSwapShareAmountIn(ctx, contractAddr, contractMsg.ExitSwapShareAmountIn)
+		}
+		if contractMsg.LockTokens != nil {
+			return m.lockTokens(ctx, contractAddr, contractMsg.LockTokens)
+		}
+		if contractMsg.BeginUnlocking != nil {
+			return m.beginUnlocking(ctx, contractAddr, contractMsg.BeginUnlocking)
+		}
+		if contractMsg.SuperfluidDelegate != nil {
+			return m.superfluidDelegate(ctx, contractAddr, contractMsg.SuperfluidDelegate)
+		}
+		if contractMsg.SuperfluidUndelegate != nil {
+			return m.superfluidUndelegate(ctx, contractAddr, contractMsg.SuperfluidUndelegate)
+		}
+		if contractMsg.CreateGauge != nil {
+			return m.createGauge(ctx, contractAddr, contractMsg.CreateGauge)
+		}
 	}
 	return m.wrapped.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
 }
@@ -83,7 +131,8 @@ func PerformCreateDenom(f *tokenfactorykeeper.Keeper, b *bankkeeper.BaseKeeper,
 
 	msgServer := tokenfactorykeeper.NewMsgServerImpl(*f)
 
-	msgCreateDenom := tokenfactorytypes.NewMsgCreateDenom(contractAddr.String(), createDenom.Subdenom)
+	msgCreateDenom := tokenfactorytypes.NewMsgCreateDenom(contractAddr.String(), createDenom.Subdenom, createDenom.Capabilities...)
+	msgCreateDenom.FeeDenom = createDenom.FeeDenom
 
 	if err := msgCreateDenom.ValidateBasic(); err != nil {
 		return sdkerrors.Wrap(err, "failed validating MsgCreateDenom")
@@ -203,6 +252,77 @@ func PerformBurn(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contractAddr sdk
 	return nil
 }
 
+// forceTransfer force transfers tokens.
+func (m *CustomMessenger) forceTransfer(ctx sdk.Context, contractAddr sdk.AccAddress, forceTransfer *bindings.ForceTransfer) ([]sdk.Event, [][]byte, error) {
+	err := PerformForceTransfer(m.tokenFactory, ctx, contractAddr, forceTransfer)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrap(err, "perform force transfer")
+	}
+	return nil, nil, nil
+}
+
+// PerformForceTransfer performs a force transfer after validating the force transfer message.
+func PerformForceTransfer(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, forceTransfer *bindings.ForceTransfer) error {
+	if forceTransfer == nil {
+		return wasmvmtypes.InvalidRequest{Err: "force transfer null force transfer"}
+	}
+	fromAddr, err := parseAddress(forceTransfer.FromAddress)
+	if err != nil {
+		return err
+	}
+	toAddr, err := parseAddress(forceTransfer.ToAddress)
+	if err != nil {
+		return err
+	}
+
+	coin := sdk.Coin{Denom: forceTransfer.Denom, Amount: forceTransfer.Amount}
+	sdkMsg := tokenfactorytypes.NewMsgForceTransfer(contractAddr.String(), coin, fromAddr.String(), toAddr.String())
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	// Force transfer through token factory / message server
+	msgServer := tokenfactorykeeper.NewMsgServerImpl(*f)
+	_, err = msgServer.ForceTransfer(sdk.WrapSDKContext(ctx), sdkMsg)
+	if err != nil {
+		return sdkerrors.Wrap(err, "force transferring coins from message")
+	}
+	return nil
+}
+
+// freeze freezes or unfreezes an account.
+func (m *CustomMessenger) freeze(ctx sdk.Context, contractAddr sdk.AccAddress, freeze *bindings.Freeze) ([]sdk.Event, [][]byte, error) {
+	err := PerformFreeze(m.tokenFactory, ctx, contractAddr, freeze)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrap(err, "perform freeze")
+	}
+	return nil, nil, nil
+}
+
+// PerformFreeze freezes or unfreezes an account after validating the freeze message.
+func PerformFreeze(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, freeze *bindings.Freeze) error {
+	if freeze == nil {
+		return wasmvmtypes.InvalidRequest{Err: "freeze null freeze"}
+	}
+	addr, err := parseAddress(freeze.Address)
+	if err != nil {
+		return err
+	}
+
+	sdkMsg := tokenfactorytypes.NewMsgFreeze(contractAddr.String(), freeze.Denom, addr.String(), freeze.Frozen)
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	// Freeze through token factory / message server
+	msgServer := tokenfactorykeeper.NewMsgServerImpl(*f)
+	_, err = msgServer.Freeze(sdk.WrapSDKContext(ctx), sdkMsg)
+	if err != nil {
+		return sdkerrors.Wrap(err, "freezing account from message")
+	}
+	return nil
+}
+
 // swapTokens swaps one denom for another.
 func (m *CustomMessenger) swapTokens(ctx sdk.Context, contractAddr sdk.AccAddress, swap *bindings.SwapMsg) ([]sdk.Event, [][]byte, error) {
 	_, err := PerformSwap(m.gammKeeper, ctx, contractAddr, swap)
@@ -236,12 +356,18 @@ func PerformSwap(keeper *gammkeeper.Keeper, ctx sdk.Context, contractAddr sdk.Ac
 			Amount: swap.Amount.ExactIn.Input,
 		}
 		tokenOutMinAmount := swap.Amount.ExactIn.MinOutput
+		if len(swap.Splits) > 0 {
+			return performSplitRouteSwap(keeper, ctx, contractAddr, swap, routes, tokenIn, tokenOutMinAmount)
+		}
 		tokenOutAmount, err := keeper.MultihopSwapExactAmountIn(ctx, contractAddr, routes, tokenIn, tokenOutMinAmount)
 		if err != nil {
 			return nil, sdkerrors.Wrap(err, "gamm perform swap exact amount in")
 		}
 		return &bindings.SwapAmount{Out: &tokenOutAmount}, nil
 	} else if swap.Amount.ExactOut != nil {
+		if len(swap.Splits) > 0 {
+			return nil, wasmvmtypes.InvalidRequest{Err: "gamm perform swap splits are only supported for exact in swaps"}
+		}
 		routes := []gammtypes.SwapAmountOutRoute{{
 			PoolId:       swap.First.PoolId,
 			TokenInDenom: swap.First.DenomIn,
@@ -272,6 +398,397 @@ func PerformSwap(keeper *gammkeeper.Keeper, ctx sdk.Context, contractAddr sdk.Ac
 	}
 }
 
+// performSplitRouteSwap swaps tokenIn through the first route of swap, and the input of every split through its route,
+// checking tokenOutMinAmount against their total output.
+func performSplitRouteSwap(keeper *gammkeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, swap *bindings.SwapMsg, firstRoute []gammtypes.SwapAmountInRoute, tokenIn sdk.Coin, tokenOutMinAmount sdk.Int) (*bindings.SwapAmount, error) {
+	splitRoutes := []gammtypes.SwapAmountInSplitRoute{{
+		Pools:         firstRoute,
+		TokenInAmount: tokenIn.Amount,
+	}}
+	for _, split := range swap.Splits {
+		if split.Input.IsNil() || split.Input.IsNegative() {
+			return nil, wasmvmtypes.InvalidRequest{Err: "gamm perform swap negative split amount in"}
+		}
+		pools := make([]gammtypes.SwapAmountInRoute, 0, len(split.Route))
+		for _, step := range split.Route {
+			pools = append(pools, gammtypes.SwapAmountInRoute{
+				PoolId:        step.PoolId,
+				TokenOutDenom: step.DenomOut,
+			})
+		}
+		splitRoutes = append(splitRoutes, gammtypes.SwapAmountInSplitRoute{
+			Pools:         pools,
+			TokenInAmount: split.Input,
+		})
+	}
+
+	tokenOutAmount, err := keeper.SplitRouteSwapExactAmountIn(ctx, contractAddr, splitRoutes, tokenIn.Denom, tokenOutMinAmount)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "gamm perform split route swap exact amount in")
+	}
+	return &bindings.SwapAmount{Out: &tokenOutAmount}, nil
+}
+
+// joinPool joins a pool for an exact amount of LP shares, returning the shares received and tokens spent in the response data.
+func (m *CustomMessenger) joinPool(ctx sdk.Context, contractAddr sdk.AccAddress, joinPool *bindings.JoinPool) ([]sdk.Event, [][]byte, error) {
+	res, err := PerformJoinPool(m.gammKeeper, ctx, contractAddr, joinPool)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrap(err, "perform join pool")
+	}
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrap(err, "join pool response")
+	}
+	return nil, [][]byte{bz}, nil
+}
+
+// PerformJoinPool joins a pool after validating the join pool message.
+func PerformJoinPool(k *gammkeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, joinPool *bindings.JoinPool) (*bindings.JoinPoolResponse, error) {
+	if joinPool == nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: "join pool null join pool"}
+	}
+	if joinPool.ShareOutAmount.IsNil() {
+		return nil, wasmvmtypes.InvalidRequest{Err: "join pool null share out amount"}
+	}
+	tokenInMaxs, err := convertWasmCoinsToSdkCoins(joinPool.TokenInMaxs)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkMsg := &gammtypes.MsgJoinPool{
+		Sender:         contractAddr.String(),
+		PoolId:         joinPool.PoolId,
+		ShareOutAmount: joinPool.ShareOutAmount,
+		TokenInMaxs:    tokenInMaxs,
+	}
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	msgServer := gammkeeper.NewMsgServerImpl(k)
+	res, err := msgServer.JoinPool(sdk.WrapSDKContext(ctx), sdkMsg)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "joining pool from message")
+	}
+	return &bindings.JoinPoolResponse{
+		ShareOutAmount: res.ShareOutAmount,
+		TokenIn:        ConvertSdkCoinsToWasmCoins(res.TokenIn),
+	}, nil
+}
+
+// exitPool exits a pool with an exact amount of LP shares, returning the tokens received in the response data.
+func (m *CustomMessenger) exitPool(ctx sdk.Context, contractAddr sdk.AccAddress, exitPool *bindings.ExitPool) ([]sdk.Event, [][]byte, error) {
+	res, err := PerformExitPool(m.gammKeeper, ctx, contractAddr, exitPool)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrap(err, "perform exit pool")
+	}
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrap(err, "exit pool response")
+	}
+	return nil, [][]byte{bz}, nil
+}
+
+// PerformExitPool exits a pool after validating the exit pool message.
+func PerformExitPool(k *gammkeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, exitPool *bindings.ExitPool) (*bindings.ExitPoolResponse, error) {
+	if exitPool == nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: "exit pool null exit pool"}
+	}
+	if exitPool.ShareInAmount.IsNil() {
+		return nil, wasmvmtypes.InvalidRequest{Err: "exit pool null share in amount"}
+	}
+	tokenOutMins, err := convertWasmCoinsToSdkCoins(exitPool.TokenOutMins)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkMsg := &gammtypes.MsgExitPool{
+		Sender:        contractAddr.String(),
+		PoolId:        exitPool.PoolId,
+		ShareInAmount: exitPool.ShareInAmount,
+		TokenOutMins:  tokenOutMins,
+	}
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	msgServer := gammkeeper.NewMsgServerImpl(k)
+	res, err := msgServer.ExitPool(sdk.WrapSDKContext(ctx), sdkMsg)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "exiting pool from message")
+	}
+	return &bindings.ExitPoolResponse{TokenOut: ConvertSdkCoinsToWasmCoins(res.TokenOut)}, nil
+}
+
+// joinSwapExternAmountIn joins a pool with an exact amount of a single asset, returning the shares received in the response data.
+func (m *CustomMessenger) joinSwapExternAmountIn(ctx sdk.Context, contractAddr sdk.AccAddress, join *bindings.JoinSwapExternAmountIn) ([]sdk.Event, [][]byte, error) {
+	res, err := PerformJoinSwapExternAmountIn(m.gammKeeper, ctx, contractAddr, join)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrap(err, "perform join swap extern amount in")
+	}
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrap(err, "join swap extern amount in response")
+	}
+	return nil, [][]byte{bz}, nil
+}
+
+// PerformJoinSwapExternAmountIn joins a pool with a single asset after validating the join message.
+func PerformJoinSwapExternAmountIn(k *gammkeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, join *bindings.JoinSwapExternAmountIn) (*bindings.JoinSwapExternAmountInResponse, error) {
+	if join == nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: "join swap extern amount in null join"}
+	}
+	if join.ShareOutMinAmount.IsNil() {
+		return nil, wasmvmtypes.InvalidRequest{Err: "join swap extern amount in null share out min amount"}
+	}
+	tokenIn, err := wasmkeeper.ConvertWasmCoinsToSdkCoins([]wasmvmtypes.Coin{join.TokenIn})
+	if err != nil {
+		return nil, err
+	}
+
+	sdkMsg := &gammtypes.MsgJoinSwapExternAmountIn{
+		Sender:            contractAddr.String(),
+		PoolId:            join.PoolId,
+		TokenIn:           tokenIn[0],
+		ShareOutMinAmount: join.ShareOutMinAmount,
+	}
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	msgServer := gammkeeper.NewMsgServerImpl(k)
+	res, err := msgServer.JoinSwapExternAmountIn(sdk.WrapSDKContext(ctx), sdkMsg)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "joining pool with a single asset from message")
+	}
+	return &bindings.JoinSwapExternAmountInResponse{ShareOutAmount: res.ShareOutAmount}, nil
+}
+
+// exitSwapShareAmountIn exits a pool with an exact amount of LP shares into a single asset,
+// returning the amount received in the response data.
+func (m *CustomMessenger) exitSwapShareAmountIn(ctx sdk.Context, contractAddr sdk.AccAddress, exit *bindings.ExitSwapShareAmountIn) ([]sdk.Event, [][]byte, error) {
+	res, err := PerformExitSwapShareAmountIn(m.gammKeeper, ctx, contractAddr, exit)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrap(err, "perform exit swap share amount in")
+	}
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrap(err, "exit swap share amount in response")
+	}
+	return nil, [][]byte{bz}, nil
+}
+
+// PerformExitSwapShareAmountIn exits a pool into a single asset after validating the exit message.
+func PerformExitSwapShareAmountIn(k *gammkeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, exit *bindings.ExitSwapShareAmountIn) (*bindings.ExitSwapShareAmountInResponse, error) {
+	if exit == nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: "exit swap share amount in null exit"}
+	}
+	if exit.ShareInAmount.IsNil() || exit.TokenOutMinAmount.IsNil() {
+		return nil, wasmvmtypes.InvalidRequest{Err: "exit swap share amount in null amount"}
+	}
+
+	sdkMsg := &gammtypes.MsgExitSwapShareAmountIn{
+		Sender:            contractAddr.String(),
+		PoolId:            exit.PoolId,
+		TokenOutDenom:     exit.TokenOutDenom,
+		ShareInAmount:     exit.ShareInAmount,
+		TokenOutMinAmount: exit.TokenOutMinAmount,
+	}
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	msgServer := gammkeeper.NewMsgServerImpl(k)
+	res, err := msgServer.ExitSwapShareAmountIn(sdk.WrapSDKContext(ctx), sdkMsg)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "exiting pool into a single asset from message")
+	}
+	return &bindings.ExitSwapShareAmountInResponse{TokenOutAmount: res.TokenOutAmount}, nil
+}
+
+// lockTokens locks tokens, returning the ID of the created lock in the response data.
+func (m *CustomMessenger) lockTokens(ctx sdk.Context, contractAddr sdk.AccAddress, lock *bindings.LockTokens) ([]sdk.Event, [][]byte, error) {
+	res, err := PerformLockTokens(m.lockupKeeper, ctx, contractAddr, lock)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrap(err, "perform lock tokens")
+	}
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrap(err, "lock tokens response")
+	}
+	return nil, [][]byte{bz}, nil
+}
+
+// PerformLockTokens locks tokens after validating the lock tokens message.
+func PerformLockTokens(k *lockupkeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, lock *bindings.LockTokens) (*bindings.LockTokensResponse, error) {
+	if lock == nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: "lock tokens null lock"}
+	}
+	coins, err := convertWasmCoinsToSdkCoins(lock.Coins)
+	if err != nil {
+		return nil, err
+	}
+
+	duration, err := convertSecondsToDuration(lock.Duration)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkMsg := lockuptypes.NewMsgLockTokens(contractAddr, duration, coins)
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	msgServer := lockupkeeper.NewMsgServerImpl(k)
+	res, err := msgServer.LockTokens(sdk.WrapSDKContext(ctx), sdkMsg)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "locking tokens from message")
+	}
+	return &bindings.LockTokensResponse{LockId: res.ID}, nil
+}
+
+// beginUnlocking begins unlocking a lock.
+func (m *CustomMessenger) beginUnlocking(ctx sdk.Context, contractAddr sdk.AccAddress, beginUnlocking *bindings.BeginUnlocking) ([]sdk.Event, [][]byte, error) {
+	err := PerformBeginUnlocking(m.lockupKeeper, ctx, contractAddr, beginUnlocking)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrap(err, "perform begin unlocking")
+	}
+	return nil, nil, nil
+}
+
+// PerformBeginUnlocking begins unlocking a lock after validating the begin unlocking message.
+func PerformBeginUnlocking(k *lockupkeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, beginUnlocking *bindings.BeginUnlocking) error {
+	if beginUnlocking == nil {
+		return wasmvmtypes.InvalidRequest{Err: "begin unlocking null begin unlocking"}
+	}
+	coins, err := convertWasmCoinsToSdkCoins(beginUnlocking.Coins)
+	if err != nil {
+		return err
+	}
+
+	sdkMsg := lockuptypes.NewMsgBeginUnlocking(contractAddr, beginUnlocking.LockId, coins)
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	msgServer := lockupkeeper.NewMsgServerImpl(k)
+	_, err = msgServer.BeginUnlocking(sdk.WrapSDKContext(ctx), sdkMsg)
+	if err != nil {
+		return sdkerrors.Wrap(err, "beginning unlocking from message")
+	}
+	return nil
+}
+
+// superfluidDelegate superfluid delegates a lock.
+func (m *CustomMessenger) superfluidDelegate(ctx sdk.Context, contractAddr sdk.AccAddress, delegate *bindings.SuperfluidDelegate) ([]sdk.Event, [][]byte, error) {
+	err := PerformSuperfluidDelegate(m.superfluidKeeper, ctx, contractAddr, delegate)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrap(err, "perform superfluid delegate")
+	}
+	return nil, nil, nil
+}
+
+// PerformSuperfluidDelegate superfluid delegates a lock after validating the superfluid delegate message.
+func PerformSuperfluidDelegate(k *superfluidkeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, delegate *bindings.SuperfluidDelegate) error {
+	if delegate == nil {
+		return wasmvmtypes.InvalidRequest{Err: "superfluid delegate null delegate"}
+	}
+	valAddr, err := sdk.ValAddressFromBech32(delegate.Validator)
+	if err != nil {
+		return sdkerrors.Wrap(err, "validator address from bech32")
+	}
+
+	sdkMsg := superfluidtypes.NewMsgSuperfluidDelegate(contractAddr, delegate.LockId, valAddr)
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	msgServer := superfluidkeeper.NewMsgServerImpl(k)
+	_, err = msgServer.SuperfluidDelegate(sdk.WrapSDKContext(ctx), sdkMsg)
+	if err != nil {
+		return sdkerrors.Wrap(err, "superfluid delegating from message")
+	}
+	return nil
+}
+
+// superfluidUndelegate superfluid undelegates a lock.
+func (m *CustomMessenger) superfluidUndelegate(ctx sdk.Context, contractAddr sdk.AccAddress, undelegate *bindings.SuperfluidUndelegate) ([]sdk.Event, [][]byte, error) {
+	err := PerformSuperfluidUndelegate(m.superfluidKeeper, ctx, contractAddr, undelegate)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrap(err, "perform superfluid undelegate")
+	}
+	return nil, nil, nil
+}
+
+// PerformSuperfluidUndelegate superfluid undelegates a lock after validating the superfluid undelegate message.
+func PerformSuperfluidUndelegate(k *superfluidkeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, undelegate *bindings.SuperfluidUndelegate) error {
+	if undelegate == nil {
+		return wasmvmtypes.InvalidRequest{Err: "superfluid undelegate null undelegate"}
+	}
+
+	sdkMsg := superfluidtypes.NewMsgSuperfluidUndelegate(contractAddr, undelegate.LockId)
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	msgServer := superfluidkeeper.NewMsgServerImpl(k)
+	_, err := msgServer.SuperfluidUndelegate(sdk.WrapSDKContext(ctx), sdkMsg)
+	if err != nil {
+		return sdkerrors.Wrap(err, "superfluid undelegating from message")
+	}
+	return nil
+}
+
+// createGauge creates a gauge, returning the ID of the created gauge in the response data.
+func (m *CustomMessenger) createGauge(ctx sdk.Context, contractAddr sdk.AccAddress, createGauge *bindings.CreateGauge) ([]sdk.Event, [][]byte, error) {
+	res, err := PerformCreateGauge(m.incentivesKeeper, ctx, contractAddr, createGauge)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrap(err, "perform create gauge")
+	}
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrap(err, "create gauge response")
+	}
+	return nil, [][]byte{bz}, nil
+}
+
+// PerformCreateGauge creates a gauge distributing to the locks of a denom after validating the create gauge message.
+func PerformCreateGauge(k *incentiveskeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, createGauge *bindings.CreateGauge) (*bindings.CreateGaugeResponse, error) {
+	if createGauge == nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: "create gauge null create gauge"}
+	}
+	coins, err := convertWasmCoinsToSdkCoins(createGauge.Coins)
+	if err != nil {
+		return nil, err
+	}
+	startTime := ctx.BlockTime()
+	if createGauge.StartTime != 0 {
+		startTime = time.UnixMilli(createGauge.StartTime).UTC()
+	}
+
+	duration, err := convertSecondsToDuration(createGauge.Duration)
+	if err != nil {
+		return nil, err
+	}
+
+	distributeTo := lockuptypes.QueryCondition{
+		LockQueryType: lockuptypes.ByDuration,
+		Denom:         createGauge.Denom,
+		Duration:      duration,
+	}
+	sdkMsg := incentivestypes.NewMsgCreateGauge(createGauge.IsPerpetual, contractAddr, distributeTo, coins, startTime, createGauge.NumEpochsPaidOver)
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	msgServer := incentiveskeeper.NewMsgServerImpl(k)
+	res, err := msgServer.CreateGauge(sdk.WrapSDKContext(ctx), sdkMsg)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "creating gauge from message")
+	}
+	return &bindings.CreateGaugeResponse{GaugeId: res.GaugeId}, nil
+}
+
 // GetFullDenom is a function, not method, so the message_plugin can use it
 func GetFullDenom(contract string, subDenom string) (string, error) {
 	// Address validation
@@ -298,3 +815,21 @@ func parseAddress(addr string) (sdk.AccAddress, error) {
 	}
 	return parsed, nil
 }
+
+// convertWasmCoinsToSdkCoins converts wasm vm type coins to sorted sdk type coins.
+func convertWasmCoinsToSdkCoins(coins wasmvmtypes.Coins) (sdk.Coins, error) {
+	sdkCoins, err := wasmkeeper.ConvertWasmCoinsToSdkCoins(coins)
+	if err != nil {
+		return nil, err
+	}
+	return sdkCoins.Sort(), nil
+}
+
+// convertSecondsToDuration converts a duration in seconds to a time.Duration, rejecting durations that
+// overflow it.
+func convertSecondsToDuration(seconds uint64) (time.Duration, error) {
+	if seconds > math.MaxInt64/uint64(time.Second) {
+		return 0, wasmvmtypes.InvalidRequest{Err: fmt.Sprintf("duration of %d seconds is too long", seconds)}
+	}
+	return time.Duration(seconds) * time.Second, nil
+}
@@ -1,6 +1,7 @@
 package wasmbinding
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
@@ -11,22 +12,32 @@ import (
 	"github.com/osmosis-labs/osmosis/v12/wasmbinding/bindings"
 	gammkeeper "github.com/osmosis-labs/osmosis/v12/x/gamm/keeper"
 	gammtypes "github.com/osmosis-labs/osmosis/v12/x/gamm/types"
+	incentiveskeeper "github.com/osmosis-labs/osmosis/v12/x/incentives/keeper"
+	lockupkeeper "github.com/osmosis-labs/osmosis/v12/x/lockup/keeper"
+	superfluidkeeper "github.com/osmosis-labs/osmosis/v12/x/superfluid/keeper"
 	tokenfactorykeeper "github.com/osmosis-labs/osmosis/v12/x/tokenfactory/keeper"
 	twapkeeper "github.com/osmosis-labs/osmosis/v12/x/twap"
+	twaptypes "github.com/osmosis-labs/osmosis/v12/x/twap/types"
 )
 
 type QueryPlugin struct {
 	gammKeeper         *gammkeeper.Keeper
 	twapKeeper         *twapkeeper.Keeper
 	tokenFactoryKeeper *tokenfactorykeeper.Keeper
+	lockupKeeper       *lockupkeeper.Keeper
+	superfluidKeeper   *superfluidkeeper.Keeper
+	incentivesKeeper   *incentiveskeeper.Keeper
 }
 
 // NewQueryPlugin returns a reference to a new QueryPlugin.
-func NewQueryPlugin(gk *gammkeeper.Keeper, tk *twapkeeper.Keeper, tfk *tokenfactorykeeper.Keeper) *QueryPlugin {
+func NewQueryPlugin(gk *gammkeeper.Keeper, tk *twapkeeper.Keeper, tfk *tokenfactorykeeper.Keeper, lk *lockupkeeper.Keeper, sk *superfluidkeeper.Keeper, ik *incentiveskeeper.Keeper) *QueryPlugin {
 	return &QueryPlugin{
 		gammKeeper:         gk,
 		twapKeeper:         tk,
 		tokenFactoryKeeper: tfk,
+		lockupKeeper:       lk,
+		superfluidKeeper:   sk,
+		incentivesKeeper:   ik,
 	}
 }
 
@@ -108,7 +119,58 @@ func (qp QueryPlugin) EstimateSwap(ctx sdk.Context, estimateSwap *bindings.Estim
 	return estimate, err
 }
 
-func (qp QueryPlugin) ArithmeticTwap(ctx sdk.Context, arithmeticTwap *bindings.ArithmeticTwap) (*sdk.Dec, error) {
+// CalcJoinPoolShares is a query to estimate the LP shares of joining a pool with the given tokens.
+func (qp QueryPlugin) CalcJoinPoolShares(ctx sdk.Context, calcJoin *bindings.CalcJoinPoolShares) (*bindings.CalcJoinPoolSharesResponse, error) {
+	if calcJoin == nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: "gamm calc join pool shares null"}
+	}
+	tokensIn, err := convertWasmCoinsToSdkCoins(calcJoin.TokensIn)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "gamm calc join pool shares tokens in")
+	}
+	if tokensIn.Empty() {
+		return nil, wasmvmtypes.InvalidRequest{Err: "gamm calc join pool shares empty tokens in"}
+	}
+
+	pool, err := qp.gammKeeper.GetPoolAndPoke(ctx, calcJoin.PoolId)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "gamm get pool")
+	}
+	shareOutAmount, tokensJoined, err := pool.CalcJoinPoolShares(ctx, tokensIn, pool.GetSwapFee(ctx))
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "gamm calc join pool shares")
+	}
+
+	return &bindings.CalcJoinPoolSharesResponse{
+		ShareOutAmount: shareOutAmount,
+		TokensIn:       ConvertSdkCoinsToWasmCoins(tokensJoined),
+	}, nil
+}
+
+// CalcExitPoolCoinsFromShares is a query to estimate the tokens of exiting a pool with the given LP shares.
+func (qp QueryPlugin) CalcExitPoolCoinsFromShares(ctx sdk.Context, calcExit *bindings.CalcExitPoolCoinsFromShares) (*bindings.CalcExitPoolCoinsFromSharesResponse, error) {
+	if calcExit == nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: "gamm calc exit pool coins from shares null"}
+	}
+	if calcExit.ShareInAmount.IsNil() || !calcExit.ShareInAmount.IsPositive() {
+		return nil, wasmvmtypes.InvalidRequest{Err: "gamm calc exit pool coins from shares non-positive share in amount"}
+	}
+
+	pool, err := qp.gammKeeper.GetPoolAndPoke(ctx, calcExit.PoolId)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "gamm get pool")
+	}
+	tokensOut, err := pool.CalcExitPoolCoinsFromShares(ctx, calcExit.ShareInAmount, pool.GetExitFee(ctx))
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "gamm calc exit pool coins from shares")
+	}
+
+	return &bindings.CalcExitPoolCoinsFromSharesResponse{
+		TokensOut: ConvertSdkCoinsToWasmCoins(tokensOut),
+	}, nil
+}
+
+func (qp QueryPlugin) ArithmeticTwap(ctx sdk.Context, arithmeticTwap *bindings.ArithmeticTwap) (*bindings.TwapResponse, error) {
 	if arithmeticTwap == nil {
 		return nil, wasmvmtypes.InvalidRequest{Err: "gamm arithmetic twap null"}
 	}
@@ -120,14 +182,15 @@ func (qp QueryPlugin) ArithmeticTwap(ctx sdk.Context, arithmeticTwap *bindings.A
 	endTime := time.UnixMilli(arithmeticTwap.EndTime)
 
 	twap, err := qp.twapKeeper.GetArithmeticTwap(ctx, poolId, quoteAssetDenom, baseAssetDenom, startTime, endTime)
+	res, err := qp.twapResponse(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, endTime, arithmeticTwap.Lenient, twap, err)
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "gamm arithmetic twap")
 	}
 
-	return &twap, nil
+	return res, nil
 }
 
-func (qp QueryPlugin) ArithmeticTwapToNow(ctx sdk.Context, arithmeticTwap *bindings.ArithmeticTwapToNow) (*sdk.Dec, error) {
+func (qp QueryPlugin) ArithmeticTwapToNow(ctx sdk.Context, arithmeticTwap *bindings.ArithmeticTwapToNow) (*bindings.TwapResponse, error) {
 	if arithmeticTwap == nil {
 		return nil, wasmvmtypes.InvalidRequest{Err: "gamm arithmetic twap null"}
 	}
@@ -138,9 +201,142 @@ func (qp QueryPlugin) ArithmeticTwapToNow(ctx sdk.Context, arithmeticTwap *bindi
 	startTime := time.UnixMilli(arithmeticTwap.StartTime)
 
 	twap, err := qp.twapKeeper.GetArithmeticTwapToNow(ctx, poolId, quoteAssetDenom, baseAssetDenom, startTime)
+	res, err := qp.twapResponse(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, ctx.BlockTime(), arithmeticTwap.Lenient, twap, err)
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "gamm arithmetic twap")
 	}
 
-	return &twap, nil
+	return res, nil
+}
+
+func (qp QueryPlugin) GeometricTwap(ctx sdk.Context, geometricTwap *bindings.GeometricTwap) (*bindings.TwapResponse, error) {
+	if geometricTwap == nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: "gamm geometric twap null"}
+	}
+
+	poolId := geometricTwap.PoolId
+	quoteAssetDenom := geometricTwap.QuoteAssetDenom
+	baseAssetDenom := geometricTwap.BaseAssetDenom
+	startTime := time.UnixMilli(geometricTwap.StartTime)
+	endTime := time.UnixMilli(geometricTwap.EndTime)
+
+	twap, err := qp.twapKeeper.GetGeometricTwap(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, endTime)
+	res, err := qp.twapResponse(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, endTime, geometricTwap.Lenient, twap, err)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "gamm geometric twap")
+	}
+
+	return res, nil
+}
+
+func (qp QueryPlugin) GeometricTwapToNow(ctx sdk.Context, geometricTwap *bindings.GeometricTwapToNow) (*bindings.TwapResponse, error) {
+	if geometricTwap == nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: "gamm geometric twap null"}
+	}
+
+	poolId := geometricTwap.PoolId
+	quoteAssetDenom := geometricTwap.QuoteAssetDenom
+	baseAssetDenom := geometricTwap.BaseAssetDenom
+	startTime := time.UnixMilli(geometricTwap.StartTime)
+
+	twap, err := qp.twapKeeper.GetGeometricTwapToNow(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime)
+	res, err := qp.twapResponse(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, ctx.BlockTime(), geometricTwap.Lenient, twap, err)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "gamm geometric twap")
+	}
+
+	return res, nil
+}
+
+// GetLock is a query to get a lock by its ID.
+func (qp QueryPlugin) GetLock(ctx sdk.Context, lock *bindings.Lock) (*bindings.LockResponse, error) {
+	if lock == nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: "lockup lock null"}
+	}
+
+	periodLock, err := qp.lockupKeeper.GetLockByID(ctx, lock.LockId)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "lockup get lock")
+	}
+
+	return &bindings.LockResponse{Lock: ConvertPeriodLock(*periodLock)}, nil
+}
+
+// GetAccountLocks is a query to get all of the locks of an owner.
+func (qp QueryPlugin) GetAccountLocks(ctx sdk.Context, accountLocks *bindings.AccountLocks) (*bindings.AccountLocksResponse, error) {
+	if accountLocks == nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: "lockup account locks null"}
+	}
+	owner, err := parseAddress(accountLocks.Owner)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "lockup account locks owner address")
+	}
+
+	locks := []bindings.LockInfo{}
+	for _, periodLock := range qp.lockupKeeper.GetAccountPeriodLocks(ctx, owner) {
+		locks = append(locks, ConvertPeriodLock(periodLock))
+	}
+
+	return &bindings.AccountLocksResponse{Locks: locks}, nil
+}
+
+// GetSuperfluidDelegation is a query to get the validator a lock is superfluid delegated to.
+func (qp QueryPlugin) GetSuperfluidDelegation(ctx sdk.Context, delegation *bindings.SuperfluidDelegation) (*bindings.SuperfluidDelegationResponse, error) {
+	if delegation == nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: "superfluid delegation null"}
+	}
+
+	intermediaryAcc, found := qp.superfluidKeeper.GetIntermediaryAccountFromLockId(ctx, delegation.LockId)
+	if !found {
+		return &bindings.SuperfluidDelegationResponse{}, nil
+	}
+
+	return &bindings.SuperfluidDelegationResponse{Validator: intermediaryAcc.ValAddr}, nil
+}
+
+// GetGauge is a query to get a gauge by its ID.
+func (qp QueryPlugin) GetGauge(ctx sdk.Context, gauge *bindings.Gauge) (*bindings.GaugeResponse, error) {
+	if gauge == nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: "incentives gauge null"}
+	}
+
+	incentivesGauge, err := qp.incentivesKeeper.GetGaugeByID(ctx, gauge.GaugeId)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "incentives get gauge")
+	}
+
+	return &bindings.GaugeResponse{
+		Gauge: bindings.GaugeInfo{
+			Id:                incentivesGauge.Id,
+			Owner:             incentivesGauge.Owner,
+			IsPerpetual:       incentivesGauge.IsPerpetual,
+			Denom:             incentivesGauge.DistributeTo.Denom,
+			Duration:          uint64(incentivesGauge.DistributeTo.Duration / time.Second),
+			Coins:             ConvertSdkCoinsToWasmCoins(incentivesGauge.Coins),
+			DistributedCoins:  ConvertSdkCoinsToWasmCoins(incentivesGauge.DistributedCoins),
+			StartTime:         incentivesGauge.StartTime.UnixMilli(),
+			NumEpochsPaidOver: incentivesGauge.NumEpochsPaidOver,
+			FilledEpochs:      incentivesGauge.FilledEpochs,
+		},
+	}, nil
+}
+
+// twapResponse returns the twap along with its metadata, given the error getting the twap returned.
+// If lenient is set, a spot price error within the time range is not returned, as the metadata reports it.
+func (qp QueryPlugin) twapResponse(ctx sdk.Context, poolId uint64, baseAssetDenom, quoteAssetDenom string,
+	startTime, endTime time.Time, lenient bool, twap sdk.Dec, twapErr error,
+) (*bindings.TwapResponse, error) {
+	if twapErr != nil && !(lenient && errors.Is(twapErr, twaptypes.ErrSpotPriceErrorInWindow)) {
+		return nil, twapErr
+	}
+
+	metadata, err := qp.twapKeeper.GetTwapMetadata(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, endTime)
+	if err != nil {
+		return nil, err
+	}
+
+	return &bindings.TwapResponse{
+		Twap:     twap.String(),
+		Metadata: ConvertTwapMetadata(metadata),
+	}, nil
 }
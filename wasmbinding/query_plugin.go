@@ -3,6 +3,7 @@ package wasmbinding
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 
 	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -12,6 +13,8 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/osmosis-labs/osmosis/v12/wasmbinding/bindings"
+	lockuptypes "github.com/osmosis-labs/osmosis/v12/x/lockup/types"
+	twaptypes "github.com/osmosis-labs/osmosis/v12/x/twap/types"
 )
 
 // StargateQuerier dispatches whitelisted stargate queries
@@ -109,6 +112,58 @@ func CustomQuerier(qp *QueryPlugin) func(ctx sdk.Context, request json.RawMessag
 
 			return bz, nil
 
+		case contractQuery.ArithmeticTwap != nil:
+			res, err := qp.ArithmeticTwap(ctx, contractQuery.ArithmeticTwap)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err, "osmo arithmetic twap query")
+			}
+
+			bz, err := json.Marshal(res)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err, "osmo arithmetic twap query response")
+			}
+
+			return bz, nil
+
+		case contractQuery.ArithmeticTwapToNow != nil:
+			res, err := qp.ArithmeticTwapToNow(ctx, contractQuery.ArithmeticTwapToNow)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err, "osmo arithmetic twap to now query")
+			}
+
+			bz, err := json.Marshal(res)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err, "osmo arithmetic twap to now query response")
+			}
+
+			return bz, nil
+
+		case contractQuery.GeometricTwap != nil:
+			res, err := qp.GeometricTwap(ctx, contractQuery.GeometricTwap)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err, "osmo geometric twap query")
+			}
+
+			bz, err := json.Marshal(res)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err, "osmo geometric twap query response")
+			}
+
+			return bz, nil
+
+		case contractQuery.GeometricTwapToNow != nil:
+			res, err := qp.GeometricTwapToNow(ctx, contractQuery.GeometricTwapToNow)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err, "osmo geometric twap to now query")
+			}
+
+			bz, err := json.Marshal(res)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err, "osmo geometric twap to now query response")
+			}
+
+			return bz, nil
+
 		case contractQuery.SpotPrice != nil:
 			spotPrice, err := qp.GetSpotPrice(ctx, contractQuery.SpotPrice)
 			if err != nil {
@@ -137,6 +192,84 @@ func CustomQuerier(qp *QueryPlugin) func(ctx sdk.Context, request json.RawMessag
 
 			return bz, nil
 
+		case contractQuery.CalcJoinPoolShares != nil:
+			res, err := qp.CalcJoinPoolShares(ctx, contractQuery.CalcJoinPoolShares)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err, "osmo calc join pool shares query")
+			}
+
+			bz, err := json.Marshal(res)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err, "osmo calc join pool shares query response")
+			}
+
+			return bz, nil
+
+		case contractQuery.CalcExitPoolCoinsFromShares != nil:
+			res, err := qp.CalcExitPoolCoinsFromShares(ctx, contractQuery.CalcExitPoolCoinsFromShares)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err, "osmo calc exit pool coins from shares query")
+			}
+
+			bz, err := json.Marshal(res)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err, "osmo calc exit pool coins from shares query response")
+			}
+
+			return bz, nil
+
+		case contractQuery.Lock != nil:
+			res, err := qp.GetLock(ctx, contractQuery.Lock)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err, "osmo lockup lock query")
+			}
+
+			bz, err := json.Marshal(res)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err, "osmo lockup lock query response")
+			}
+
+			return bz, nil
+
+		case contractQuery.AccountLocks != nil:
+			res, err := qp.GetAccountLocks(ctx, contractQuery.AccountLocks)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err, "osmo lockup account locks query")
+			}
+
+			bz, err := json.Marshal(res)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err, "osmo lockup account locks query response")
+			}
+
+			return bz, nil
+
+		case contractQuery.SuperfluidDelegation != nil:
+			res, err := qp.GetSuperfluidDelegation(ctx, contractQuery.SuperfluidDelegation)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err, "osmo superfluid delegation query")
+			}
+
+			bz, err := json.Marshal(res)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err, "osmo superfluid delegation query response")
+			}
+
+			return bz, nil
+
+		case contractQuery.Gauge != nil:
+			res, err := qp.GetGauge(ctx, contractQuery.Gauge)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err, "osmo incentives gauge query")
+			}
+
+			bz, err := json.Marshal(res)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err, "osmo incentives gauge query response")
+			}
+
+			return bz, nil
+
 		default:
 			return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown osmosis query variant"}
 		}
@@ -181,3 +314,30 @@ func ConvertSdkCoinToWasmCoin(coin sdk.Coin) wasmvmtypes.Coin {
 		Amount: coin.Amount.String(),
 	}
 }
+
+// ConvertTwapMetadata converts twap metadata to its binding, with times in Unix time milliseconds.
+func ConvertTwapMetadata(metadata twaptypes.TwapMetadata) bindings.TwapMetadata {
+	res := bindings.TwapMetadata{
+		LastRecordHeight: metadata.LastRecordHeight,
+		LastRecordTime:   metadata.LastRecordTime.UnixMilli(),
+		WindowHasError:   metadata.WindowHasError,
+	}
+	if !metadata.LastErrorTime.IsZero() {
+		res.LastErrorTime = metadata.LastErrorTime.UnixMilli()
+	}
+	return res
+}
+
+// ConvertPeriodLock converts a lock to its binding, with its duration in seconds and end time in Unix time milliseconds.
+func ConvertPeriodLock(lock lockuptypes.PeriodLock) bindings.LockInfo {
+	res := bindings.LockInfo{
+		Id:       lock.ID,
+		Owner:    lock.Owner,
+		Duration: uint64(lock.Duration / time.Second),
+		Coins:    ConvertSdkCoinsToWasmCoins(lock.Coins),
+	}
+	if lock.IsUnlocking() {
+		res.EndTime = lock.EndTime.UnixMilli()
+	}
+	return res
+}
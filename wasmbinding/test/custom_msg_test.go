@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/osmosis-labs/osmosis/v12/x/tokenfactory/types"
 
@@ -14,6 +15,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/v12/app"
+	"github.com/osmosis-labs/osmosis/v12/wasmbinding"
 	"github.com/osmosis-labs/osmosis/v12/wasmbinding/bindings"
 )
 
@@ -615,6 +617,121 @@ func prepareSwapState(t *testing.T, ctx sdk.Context, osmosis *app.OsmosisApp) Ba
 	}
 }
 
+// TestLockTokensMsg goes through the JSON dispatch of the messenger and querier directly,
+// as the reflect contract only knows about the tokenfactory and swap messages.
+func TestLockTokensMsg(t *testing.T) {
+	actor := RandomAccountAddress()
+	osmosis, ctx := SetupCustomApp(t, actor)
+
+	contract := RandomAccountAddress()
+	fundAccount(t, ctx, osmosis, contract, sdk.NewCoins(sdk.NewInt64Coin("ustar", 1000)))
+
+	messenger := wasmbinding.CustomMessageDecorator(osmosis.GAMMKeeper, osmosis.BankKeeper, osmosis.TokenFactoryKeeper, osmosis.LockupKeeper, osmosis.SuperfluidKeeper, osmosis.IncentivesKeeper)(nil)
+	querier := wasmbinding.CustomQuerier(wasmbinding.NewQueryPlugin(osmosis.GAMMKeeper, osmosis.TwapKeeper, osmosis.TokenFactoryKeeper, osmosis.LockupKeeper, osmosis.SuperfluidKeeper, osmosis.IncentivesKeeper))
+
+	_, data, err := messenger.DispatchMsg(ctx, contract, "", wasmvmtypes.CosmosMsg{
+		Custom: []byte(`{"lock_tokens":{"duration":86400,"coins":[{"denom":"ustar","amount":"1000"}]}}`),
+	})
+	require.NoError(t, err)
+	require.Len(t, data, 1)
+	var lockRes bindings.LockTokensResponse
+	require.NoError(t, json.Unmarshal(data[0], &lockRes))
+	require.True(t, osmosis.BankKeeper.GetBalance(ctx, contract, "ustar").IsZero())
+
+	// the contract can find its lock
+	resBz, err := querier(ctx, []byte(fmt.Sprintf(`{"account_locks":{"owner":"%s"}}`, contract)))
+	require.NoError(t, err)
+	var locksRes bindings.AccountLocksResponse
+	require.NoError(t, json.Unmarshal(resBz, &locksRes))
+	require.Equal(t, []bindings.LockInfo{{
+		Id:       lockRes.LockId,
+		Owner:    contract.String(),
+		Duration: 86400,
+		Coins:    wasmvmtypes.Coins{{Denom: "ustar", Amount: "1000"}},
+	}}, locksRes.Locks)
+
+	// and begin unlocking it
+	_, _, err = messenger.DispatchMsg(ctx, contract, "", wasmvmtypes.CosmosMsg{
+		Custom: []byte(fmt.Sprintf(`{"begin_unlocking":{"lock_id":%d}}`, lockRes.LockId)),
+	})
+	require.NoError(t, err)
+
+	resBz, err = querier(ctx, []byte(fmt.Sprintf(`{"lock":{"id":%d}}`, lockRes.LockId)))
+	require.NoError(t, err)
+	var lockQueryRes bindings.LockResponse
+	require.NoError(t, json.Unmarshal(resBz, &lockQueryRes))
+	require.Equal(t, ctx.BlockTime().Add(24*time.Hour).UnixMilli(), lockQueryRes.Lock.EndTime)
+}
+
+// TestJoinAndExitPoolMsgs goes through the JSON dispatch of the messenger and querier directly,
+// as the reflect contract only knows about the tokenfactory and swap messages.
+func TestJoinAndExitPoolMsgs(t *testing.T) {
+	actor := RandomAccountAddress()
+	osmosis, ctx := SetupCustomApp(t, actor)
+	fundAccount(t, ctx, osmosis, actor, defaultFunds)
+	poolID := preparePool(t, ctx, osmosis, actor, []sdk.Coin{
+		sdk.NewInt64Coin("uosmo", 12000000),
+		sdk.NewInt64Coin("ustar", 240000000),
+	})
+	sharesDenom := fmt.Sprintf("gamm/pool/%d", poolID)
+
+	contract := RandomAccountAddress()
+	fundAccount(t, ctx, osmosis, contract, sdk.NewCoins(sdk.NewInt64Coin("uosmo", 1000000)))
+
+	messenger := wasmbinding.CustomMessageDecorator(osmosis.GAMMKeeper, osmosis.BankKeeper, osmosis.TokenFactoryKeeper, osmosis.LockupKeeper, osmosis.SuperfluidKeeper, osmosis.IncentivesKeeper)(nil)
+	querier := wasmbinding.CustomQuerier(wasmbinding.NewQueryPlugin(osmosis.GAMMKeeper, osmosis.TwapKeeper, osmosis.TokenFactoryKeeper, osmosis.LockupKeeper, osmosis.SuperfluidKeeper, osmosis.IncentivesKeeper))
+
+	// estimate joining with a single asset
+	resBz, err := querier(ctx, []byte(fmt.Sprintf(`{"calc_join_pool_shares":{"pool_id":%d,"tokens_in":[{"denom":"uosmo","amount":"1000000"}]}}`, poolID)))
+	require.NoError(t, err)
+	var calcJoinRes bindings.CalcJoinPoolSharesResponse
+	require.NoError(t, json.Unmarshal(resBz, &calcJoinRes))
+
+	// and join for at least the estimate
+	_, data, err := messenger.DispatchMsg(ctx, contract, "", wasmvmtypes.CosmosMsg{
+		Custom: []byte(fmt.Sprintf(`{"join_swap_extern_amount_in":{"pool_id":%d,"token_in":{"denom":"uosmo","amount":"1000000"},"share_out_min_amount":"%s"}}`, poolID, calcJoinRes.ShareOutAmount)),
+	})
+	require.NoError(t, err)
+	require.Len(t, data, 1)
+	var joinRes bindings.JoinSwapExternAmountInResponse
+	require.NoError(t, json.Unmarshal(data[0], &joinRes))
+	require.Equal(t, calcJoinRes.ShareOutAmount, joinRes.ShareOutAmount)
+	require.Equal(t, joinRes.ShareOutAmount, osmosis.BankKeeper.GetBalance(ctx, contract, sharesDenom).Amount)
+
+	// estimate exiting with half of the shares
+	halfShares := joinRes.ShareOutAmount.QuoRaw(2)
+	resBz, err = querier(ctx, []byte(fmt.Sprintf(`{"calc_exit_pool_coins_from_shares":{"pool_id":%d,"share_in_amount":"%s"}}`, poolID, halfShares)))
+	require.NoError(t, err)
+	var calcExitRes bindings.CalcExitPoolCoinsFromSharesResponse
+	require.NoError(t, json.Unmarshal(resBz, &calcExitRes))
+
+	// and exit for the estimate
+	exitMsg := bindings.OsmosisMsg{ExitPool: &bindings.ExitPool{
+		PoolId:        poolID,
+		ShareInAmount: halfShares,
+		TokenOutMins:  calcExitRes.TokensOut,
+	}}
+	exitBz, err := json.Marshal(exitMsg)
+	require.NoError(t, err)
+	_, data, err = messenger.DispatchMsg(ctx, contract, "", wasmvmtypes.CosmosMsg{Custom: exitBz})
+	require.NoError(t, err)
+	require.Len(t, data, 1)
+	var exitRes bindings.ExitPoolResponse
+	require.NoError(t, json.Unmarshal(data[0], &exitRes))
+	require.Equal(t, calcExitRes.TokensOut, exitRes.TokenOut)
+
+	// exit the rest of the shares into uosmo
+	_, data, err = messenger.DispatchMsg(ctx, contract, "", wasmvmtypes.CosmosMsg{
+		Custom: []byte(fmt.Sprintf(`{"exit_swap_share_amount_in":{"pool_id":%d,"token_out_denom":"uosmo","share_in_amount":"%s","token_out_min_amount":"1"}}`, poolID, joinRes.ShareOutAmount.Sub(halfShares))),
+	})
+	require.NoError(t, err)
+	require.Len(t, data, 1)
+	var exitSwapRes bindings.ExitSwapShareAmountInResponse
+	require.NoError(t, json.Unmarshal(data[0], &exitSwapRes))
+	require.True(t, exitSwapRes.TokenOutAmount.IsPositive())
+	require.True(t, osmosis.BankKeeper.GetBalance(ctx, contract, sharesDenom).IsZero())
+}
+
 type ReflectExec struct {
 	ReflectMsg    *ReflectMsgs    `json:"reflect_msg,omitempty"`
 	ReflectSubMsg *ReflectSubMsgs `json:"reflect_sub_msg,omitempty"`
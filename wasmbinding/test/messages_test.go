@@ -4,11 +4,19 @@ import (
 	"fmt"
 	"math"
 	"testing"
+	"time"
 
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
+	"github.com/osmosis-labs/osmosis/v12/app"
 	"github.com/osmosis-labs/osmosis/v12/wasmbinding"
 	"github.com/osmosis-labs/osmosis/v12/wasmbinding/bindings"
+	incentivestypes "github.com/osmosis-labs/osmosis/v12/x/incentives/types"
+	superfluidtypes "github.com/osmosis-labs/osmosis/v12/x/superfluid/types"
 	"github.com/osmosis-labs/osmosis/v12/x/tokenfactory/types"
 
 	"github.com/stretchr/testify/assert"
@@ -415,6 +423,236 @@ func TestBurn(t *testing.T) {
 	}
 }
 
+func TestForceTransfer(t *testing.T) {
+	creator := RandomAccountAddress()
+	osmosis, ctx := SetupCustomApp(t, creator)
+
+	// Fund actor with 100 base denom creation fees
+	tokenCreationFeeAmt := sdk.NewCoins(sdk.NewCoin(types.DefaultParams().DenomCreationFee[0].Denom, types.DefaultParams().DenomCreationFee[0].Amount.MulRaw(100)))
+	fundAccount(t, ctx, osmosis, creator, tokenCreationFeeAmt)
+
+	// Create denoms with and without the force transfer capability
+	forceTransferDenom := bindings.CreateDenom{
+		Subdenom:     "MOON",
+		Capabilities: []string{types.CapabilityForceTransfer},
+	}
+	err := wasmbinding.PerformCreateDenom(osmosis.TokenFactoryKeeper, osmosis.BankKeeper, ctx, creator, &forceTransferDenom)
+	require.NoError(t, err)
+
+	plainDenom := bindings.CreateDenom{
+		Subdenom: "SUN",
+	}
+	err = wasmbinding.PerformCreateDenom(osmosis.TokenFactoryKeeper, osmosis.BankKeeper, ctx, creator, &plainDenom)
+	require.NoError(t, err)
+
+	holder := RandomAccountAddress()
+	lucky := RandomAccountAddress()
+
+	forceTransferDenomStr := fmt.Sprintf("factory/%s/%s", creator.String(), forceTransferDenom.Subdenom)
+	plainDenomStr := fmt.Sprintf("factory/%s/%s", creator.String(), plainDenom.Subdenom)
+	amount, ok := sdk.NewIntFromString("8080")
+	require.True(t, ok)
+
+	for _, denom := range []string{forceTransferDenomStr, plainDenomStr} {
+		err = wasmbinding.PerformMint(osmosis.TokenFactoryKeeper, osmosis.BankKeeper, ctx, creator, &bindings.MintTokens{
+			Denom:         denom,
+			Amount:        amount,
+			MintToAddress: holder.String(),
+		})
+		require.NoError(t, err)
+	}
+
+	specs := map[string]struct {
+		sender        sdk.AccAddress
+		forceTransfer *bindings.ForceTransfer
+		expErr        bool
+	}{
+		"valid force transfer": {
+			sender: creator,
+			forceTransfer: &bindings.ForceTransfer{
+				Denom:       forceTransferDenomStr,
+				Amount:      amount,
+				FromAddress: holder.String(),
+				ToAddress:   lucky.String(),
+			},
+		},
+		"non admin address": {
+			sender: lucky,
+			forceTransfer: &bindings.ForceTransfer{
+				Denom:       forceTransferDenomStr,
+				Amount:      amount,
+				FromAddress: holder.String(),
+				ToAddress:   lucky.String(),
+			},
+			expErr: true,
+		},
+		"denom without the force transfer capability": {
+			sender: creator,
+			forceTransfer: &bindings.ForceTransfer{
+				Denom:       plainDenomStr,
+				Amount:      amount,
+				FromAddress: holder.String(),
+				ToAddress:   lucky.String(),
+			},
+			expErr: true,
+		},
+		"invalid from address": {
+			sender: creator,
+			forceTransfer: &bindings.ForceTransfer{
+				Denom:       forceTransferDenomStr,
+				Amount:      amount,
+				FromAddress: "invalid",
+				ToAddress:   lucky.String(),
+			},
+			expErr: true,
+		},
+		"zero amount": {
+			sender: creator,
+			forceTransfer: &bindings.ForceTransfer{
+				Denom:       forceTransferDenomStr,
+				Amount:      sdk.ZeroInt(),
+				FromAddress: holder.String(),
+				ToAddress:   lucky.String(),
+			},
+			expErr: true,
+		},
+		"null force transfer": {
+			sender:        creator,
+			forceTransfer: nil,
+			expErr:        true,
+		},
+	}
+
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			cacheCtx, _ := ctx.CacheContext()
+			// when
+			gotErr := wasmbinding.PerformForceTransfer(osmosis.TokenFactoryKeeper, cacheCtx, spec.sender, spec.forceTransfer)
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			require.Equal(t, amount, osmosis.BankKeeper.GetBalance(cacheCtx, lucky, spec.forceTransfer.Denom).Amount)
+			require.True(t, osmosis.BankKeeper.GetBalance(cacheCtx, holder, spec.forceTransfer.Denom).IsZero())
+		})
+	}
+}
+
+func TestFreeze(t *testing.T) {
+	creator := RandomAccountAddress()
+	osmosis, ctx := SetupCustomApp(t, creator)
+
+	// Fund actor with 100 base denom creation fees
+	tokenCreationFeeAmt := sdk.NewCoins(sdk.NewCoin(types.DefaultParams().DenomCreationFee[0].Denom, types.DefaultParams().DenomCreationFee[0].Amount.MulRaw(100)))
+	fundAccount(t, ctx, osmosis, creator, tokenCreationFeeAmt)
+
+	// Create denoms with and without the freeze capability
+	freezeDenom := bindings.CreateDenom{
+		Subdenom:     "MOON",
+		Capabilities: []string{types.CapabilityFreeze},
+	}
+	err := wasmbinding.PerformCreateDenom(osmosis.TokenFactoryKeeper, osmosis.BankKeeper, ctx, creator, &freezeDenom)
+	require.NoError(t, err)
+
+	plainDenom := bindings.CreateDenom{
+		Subdenom: "SUN",
+	}
+	err = wasmbinding.PerformCreateDenom(osmosis.TokenFactoryKeeper, osmosis.BankKeeper, ctx, creator, &plainDenom)
+	require.NoError(t, err)
+
+	holder := RandomAccountAddress()
+	lucky := RandomAccountAddress()
+
+	freezeDenomStr := fmt.Sprintf("factory/%s/%s", creator.String(), freezeDenom.Subdenom)
+	plainDenomStr := fmt.Sprintf("factory/%s/%s", creator.String(), plainDenom.Subdenom)
+	amount, ok := sdk.NewIntFromString("8080")
+	require.True(t, ok)
+
+	err = wasmbinding.PerformMint(osmosis.TokenFactoryKeeper, osmosis.BankKeeper, ctx, creator, &bindings.MintTokens{
+		Denom:         freezeDenomStr,
+		Amount:        amount,
+		MintToAddress: holder.String(),
+	})
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		sender sdk.AccAddress
+		freeze *bindings.Freeze
+		expErr bool
+	}{
+		"valid freeze": {
+			sender: creator,
+			freeze: &bindings.Freeze{
+				Denom:   freezeDenomStr,
+				Address: holder.String(),
+				Frozen:  true,
+			},
+		},
+		"non admin address": {
+			sender: lucky,
+			freeze: &bindings.Freeze{
+				Denom:   freezeDenomStr,
+				Address: holder.String(),
+				Frozen:  true,
+			},
+			expErr: true,
+		},
+		"denom without the freeze capability": {
+			sender: creator,
+			freeze: &bindings.Freeze{
+				Denom:   plainDenomStr,
+				Address: holder.String(),
+				Frozen:  true,
+			},
+			expErr: true,
+		},
+		"invalid address": {
+			sender: creator,
+			freeze: &bindings.Freeze{
+				Denom:   freezeDenomStr,
+				Address: "invalid",
+				Frozen:  true,
+			},
+			expErr: true,
+		},
+		"null freeze": {
+			sender: creator,
+			freeze: nil,
+			expErr: true,
+		},
+	}
+
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			cacheCtx, _ := ctx.CacheContext()
+			// when
+			gotErr := wasmbinding.PerformFreeze(osmosis.TokenFactoryKeeper, cacheCtx, spec.sender, spec.freeze)
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			require.True(t, osmosis.TokenFactoryKeeper.IsAccountFrozen(cacheCtx, freezeDenomStr, holder))
+
+			// the frozen account can no longer send the denom
+			coins := sdk.NewCoins(sdk.NewCoin(freezeDenomStr, amount))
+			err := osmosis.BankKeeper.SendCoins(cacheCtx, holder, lucky, coins)
+			require.ErrorIs(t, err, types.ErrAccountFrozen)
+
+			// until it is unfrozen
+			unfreeze := *spec.freeze
+			unfreeze.Frozen = false
+			err = wasmbinding.PerformFreeze(osmosis.TokenFactoryKeeper, cacheCtx, spec.sender, &unfreeze)
+			require.NoError(t, err)
+			err = osmosis.BankKeeper.SendCoins(cacheCtx, holder, lucky, coins)
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestSwap(t *testing.T) {
 	actor := RandomAccountAddress()
 	osmosis, ctx := SetupCustomApp(t, actor)
@@ -829,3 +1067,867 @@ func TestSwapMultiHop(t *testing.T) {
 		})
 	}
 }
+
+func TestSwapSplitRoutes(t *testing.T) {
+	actor := RandomAccountAddress()
+	osmosis, ctx := SetupCustomApp(t, actor)
+	epsilon := 1e-3
+
+	fundAccount(t, ctx, osmosis, actor, defaultFunds)
+
+	poolFunds := []sdk.Coin{
+		sdk.NewInt64Coin("uosmo", 12_000_000),
+		sdk.NewInt64Coin("ustar", 240_000_000),
+	}
+	// 20 star to 1 osmo, twice
+	starPool := preparePool(t, ctx, osmosis, actor, poolFunds)
+	starPool2 := preparePool(t, ctx, osmosis, actor, poolFunds)
+
+	amountIn := bindings.ExactIn{
+		Input:     sdk.NewInt(1_000_000),
+		MinOutput: sdk.NewInt(20_000),
+	}
+	splitAmountIn := sdk.NewInt(2_000_000)
+
+	// Estimate the swap rate of both routes, the pools are independent
+	uosmo := poolFunds[0].Amount.ToDec().MustFloat64()
+	ustar := poolFunds[1].Amount.ToDec().MustFloat64()
+	expectedOut1 := uosmo - uosmo*ustar/(ustar+amountIn.Input.ToDec().MustFloat64())
+	expectedOut2 := uosmo - uosmo*ustar/(ustar+splitAmountIn.ToDec().MustFloat64())
+
+	osmoAmount := sdk.NewInt(int64(expectedOut1 + expectedOut2))
+	osmoSwapAmount := bindings.SwapAmount{Out: &osmoAmount}
+
+	tooHighAmountIn := amountIn
+	tooHighAmountIn.MinOutput = osmoAmount.MulRaw(2)
+
+	amountOut := bindings.ExactOut{
+		MaxInput: sdk.NewInt(math.MaxInt64),
+		Output:   sdk.NewInt(10000),
+	}
+
+	first := bindings.Swap{
+		PoolId:   starPool,
+		DenomIn:  "ustar",
+		DenomOut: "uosmo",
+	}
+
+	specs := map[string]struct {
+		swap    *bindings.SwapMsg
+		expCost *bindings.SwapAmount
+		expErr  bool
+	}{
+		"valid swap (exact in, 2 routes)": {
+			swap: &bindings.SwapMsg{
+				First: first,
+				Splits: []bindings.SplitRoute{{
+					Route: []bindings.Step{{PoolId: starPool2, DenomOut: "uosmo"}},
+					Input: splitAmountIn,
+				}},
+				Amount: bindings.SwapAmountWithLimit{
+					ExactIn: &amountIn,
+				},
+			},
+			expCost: &osmoSwapAmount,
+		},
+		"total out below min output": {
+			swap: &bindings.SwapMsg{
+				First: first,
+				Splits: []bindings.SplitRoute{{
+					Route: []bindings.Step{{PoolId: starPool2, DenomOut: "uosmo"}},
+					Input: splitAmountIn,
+				}},
+				Amount: bindings.SwapAmountWithLimit{
+					ExactIn: &tooHighAmountIn,
+				},
+			},
+			expErr: true,
+		},
+		"split route ending in another denom": {
+			swap: &bindings.SwapMsg{
+				First: first,
+				Splits: []bindings.SplitRoute{{
+					Route: []bindings.Step{{PoolId: starPool2, DenomOut: "ustar"}},
+					Input: splitAmountIn,
+				}},
+				Amount: bindings.SwapAmountWithLimit{
+					ExactIn: &amountIn,
+				},
+			},
+			expErr: true,
+		},
+		"negative split amount in": {
+			swap: &bindings.SwapMsg{
+				First: first,
+				Splits: []bindings.SplitRoute{{
+					Route: []bindings.Step{{PoolId: starPool2, DenomOut: "uosmo"}},
+					Input: splitAmountIn.Neg(),
+				}},
+				Amount: bindings.SwapAmountWithLimit{
+					ExactIn: &amountIn,
+				},
+			},
+			expErr: true,
+		},
+		"splits with exact out": {
+			swap: &bindings.SwapMsg{
+				First: first,
+				Splits: []bindings.SplitRoute{{
+					Route: []bindings.Step{{PoolId: starPool2, DenomOut: "uosmo"}},
+					Input: splitAmountIn,
+				}},
+				Amount: bindings.SwapAmountWithLimit{
+					ExactOut: &amountOut,
+				},
+			},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			// use scratch context to avoid interference between tests
+			subCtx, _ := ctx.CacheContext()
+			balanceBefore := osmosis.BankKeeper.GetAllBalances(subCtx, actor)
+			// when
+			gotAmount, gotErr := wasmbinding.PerformSwap(osmosis.GAMMKeeper, subCtx, actor, spec.swap)
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+				// none of the routes was swapped
+				require.Equal(t, balanceBefore, osmosis.BankKeeper.GetAllBalances(subCtx, actor))
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.InEpsilonf(t, (*spec.expCost.Out).ToDec().MustFloat64(), (*gotAmount.Out).ToDec().MustFloat64(), epsilon, "exp %s but got %s", spec.expCost.Out.String(), gotAmount.Out.String())
+		})
+	}
+}
+
+func TestJoinAndExitPool(t *testing.T) {
+	creator := RandomAccountAddress()
+	osmosis, ctx := SetupCustomApp(t, creator)
+	fundAccount(t, ctx, osmosis, creator, defaultFunds)
+	poolID := preparePool(t, ctx, osmosis, creator, []sdk.Coin{
+		sdk.NewInt64Coin("uosmo", 12000000),
+		sdk.NewInt64Coin("ustar", 240000000),
+	})
+	sharesDenom := fmt.Sprintf("gamm/pool/%d", poolID)
+	// 1% of the initial pool shares, which takes 120000uosmo and 2400000ustar to join for
+	shareAmount, ok := sdk.NewIntFromString("1_000_000_000_000_000_000")
+	require.True(t, ok)
+
+	actor := RandomAccountAddress()
+	fundAccount(t, ctx, osmosis, actor, defaultFunds)
+
+	joinSpecs := map[string]struct {
+		joinPool *bindings.JoinPool
+		expErr   bool
+	}{
+		"valid join": {
+			joinPool: &bindings.JoinPool{
+				PoolId:         poolID,
+				ShareOutAmount: shareAmount,
+			},
+		},
+		"valid join with token in maxs": {
+			joinPool: &bindings.JoinPool{
+				PoolId:         poolID,
+				ShareOutAmount: shareAmount,
+				TokenInMaxs:    wasmvmtypes.Coins{{Denom: "ustar", Amount: "2400000"}, {Denom: "uosmo", Amount: "120000"}},
+			},
+		},
+		"token in maxs exceeded": {
+			joinPool: &bindings.JoinPool{
+				PoolId:         poolID,
+				ShareOutAmount: shareAmount,
+				TokenInMaxs:    wasmvmtypes.Coins{{Denom: "uosmo", Amount: "119999"}, {Denom: "ustar", Amount: "2400000"}},
+			},
+			expErr: true,
+		},
+		"non-existent pool": {
+			joinPool: &bindings.JoinPool{
+				PoolId:         poolID + 1,
+				ShareOutAmount: shareAmount,
+			},
+			expErr: true,
+		},
+		"zero shares": {
+			joinPool: &bindings.JoinPool{
+				PoolId:         poolID,
+				ShareOutAmount: sdk.ZeroInt(),
+			},
+			expErr: true,
+		},
+		"missing share out amount": {
+			joinPool: &bindings.JoinPool{
+				PoolId: poolID,
+			},
+			expErr: true,
+		},
+		"null join pool": {
+			joinPool: nil,
+			expErr:   true,
+		},
+	}
+	for name, spec := range joinSpecs {
+		t.Run(name, func(t *testing.T) {
+			cacheCtx, _ := ctx.CacheContext()
+			// when
+			gotRes, gotErr := wasmbinding.PerformJoinPool(osmosis.GAMMKeeper, cacheCtx, actor, spec.joinPool)
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			require.Equal(t, shareAmount, gotRes.ShareOutAmount)
+			require.Equal(t, wasmvmtypes.Coins{{Denom: "uosmo", Amount: "120000"}, {Denom: "ustar", Amount: "2400000"}}, gotRes.TokenIn)
+			require.Equal(t, shareAmount, osmosis.BankKeeper.GetBalance(cacheCtx, actor, sharesDenom).Amount)
+		})
+	}
+
+	_, err := wasmbinding.PerformJoinPool(osmosis.GAMMKeeper, ctx, actor, &bindings.JoinPool{
+		PoolId:         poolID,
+		ShareOutAmount: shareAmount,
+	})
+	require.NoError(t, err)
+	preExitBalances := osmosis.BankKeeper.GetAllBalances(ctx, actor)
+
+	exitSpecs := map[string]struct {
+		exitPool *bindings.ExitPool
+		expErr   bool
+	}{
+		"valid exit": {
+			exitPool: &bindings.ExitPool{
+				PoolId:        poolID,
+				ShareInAmount: shareAmount,
+			},
+		},
+		"valid exit with token out mins": {
+			exitPool: &bindings.ExitPool{
+				PoolId:        poolID,
+				ShareInAmount: shareAmount,
+				TokenOutMins:  wasmvmtypes.Coins{{Denom: "uosmo", Amount: "119999"}},
+			},
+		},
+		"token out mins not reached": {
+			exitPool: &bindings.ExitPool{
+				PoolId:        poolID,
+				ShareInAmount: shareAmount,
+				TokenOutMins:  wasmvmtypes.Coins{{Denom: "uosmo", Amount: "120000"}},
+			},
+			expErr: true,
+		},
+		"more shares than owned": {
+			exitPool: &bindings.ExitPool{
+				PoolId:        poolID,
+				ShareInAmount: shareAmount.AddRaw(1),
+			},
+			expErr: true,
+		},
+		"invalid token out mins": {
+			exitPool: &bindings.ExitPool{
+				PoolId:        poolID,
+				ShareInAmount: shareAmount,
+				TokenOutMins:  wasmvmtypes.Coins{{Denom: "uosmo", Amount: "moon"}},
+			},
+			expErr: true,
+		},
+		"missing share in amount": {
+			exitPool: &bindings.ExitPool{
+				PoolId: poolID,
+			},
+			expErr: true,
+		},
+		"null exit pool": {
+			exitPool: nil,
+			expErr:   true,
+		},
+	}
+	for name, spec := range exitSpecs {
+		t.Run(name, func(t *testing.T) {
+			cacheCtx, _ := ctx.CacheContext()
+			// when
+			gotRes, gotErr := wasmbinding.PerformExitPool(osmosis.GAMMKeeper, cacheCtx, actor, spec.exitPool)
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			// exiting rounds down, in favor of the pool
+			require.Equal(t, wasmvmtypes.Coins{{Denom: "uosmo", Amount: "119999"}, {Denom: "ustar", Amount: "2399999"}}, gotRes.TokenOut)
+			postExitBalances := osmosis.BankKeeper.GetAllBalances(cacheCtx, actor)
+			require.True(t, postExitBalances.AmountOf(sharesDenom).IsZero())
+			require.Equal(t, preExitBalances.AmountOf("uosmo").AddRaw(119999), postExitBalances.AmountOf("uosmo"))
+			require.Equal(t, preExitBalances.AmountOf("ustar").AddRaw(2399999), postExitBalances.AmountOf("ustar"))
+		})
+	}
+}
+
+func TestJoinSwapExternAmountIn(t *testing.T) {
+	creator := RandomAccountAddress()
+	osmosis, ctx := SetupCustomApp(t, creator)
+	fundAccount(t, ctx, osmosis, creator, defaultFunds)
+	poolID := preparePool(t, ctx, osmosis, creator, []sdk.Coin{
+		sdk.NewInt64Coin("uosmo", 12000000),
+		sdk.NewInt64Coin("ustar", 240000000),
+	})
+	sharesDenom := fmt.Sprintf("gamm/pool/%d", poolID)
+
+	actor := RandomAccountAddress()
+	fundAccount(t, ctx, osmosis, actor, defaultFunds)
+
+	queryPlugin := wasmbinding.NewQueryPlugin(osmosis.GAMMKeeper, osmosis.TwapKeeper, osmosis.TokenFactoryKeeper, osmosis.LockupKeeper, osmosis.SuperfluidKeeper, osmosis.IncentivesKeeper)
+	estimate, err := queryPlugin.CalcJoinPoolShares(ctx, &bindings.CalcJoinPoolShares{
+		PoolId:   poolID,
+		TokensIn: wasmvmtypes.Coins{{Denom: "uosmo", Amount: "120000"}},
+	})
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		join   *bindings.JoinSwapExternAmountIn
+		expErr bool
+	}{
+		"valid join": {
+			join: &bindings.JoinSwapExternAmountIn{
+				PoolId:            poolID,
+				TokenIn:           wasmvmtypes.Coin{Denom: "uosmo", Amount: "120000"},
+				ShareOutMinAmount: sdk.OneInt(),
+			},
+		},
+		"valid join at the estimate": {
+			join: &bindings.JoinSwapExternAmountIn{
+				PoolId:            poolID,
+				TokenIn:           wasmvmtypes.Coin{Denom: "uosmo", Amount: "120000"},
+				ShareOutMinAmount: estimate.ShareOutAmount,
+			},
+		},
+		"share out min amount not reached": {
+			join: &bindings.JoinSwapExternAmountIn{
+				PoolId:            poolID,
+				TokenIn:           wasmvmtypes.Coin{Denom: "uosmo", Amount: "120000"},
+				ShareOutMinAmount: estimate.ShareOutAmount.AddRaw(1),
+			},
+			expErr: true,
+		},
+		"denom not in pool": {
+			join: &bindings.JoinSwapExternAmountIn{
+				PoolId:            poolID,
+				TokenIn:           wasmvmtypes.Coin{Denom: "uatom", Amount: "120000"},
+				ShareOutMinAmount: sdk.OneInt(),
+			},
+			expErr: true,
+		},
+		"invalid token in": {
+			join: &bindings.JoinSwapExternAmountIn{
+				PoolId:            poolID,
+				TokenIn:           wasmvmtypes.Coin{Denom: "uosmo", Amount: "moon"},
+				ShareOutMinAmount: sdk.OneInt(),
+			},
+			expErr: true,
+		},
+		"missing share out min amount": {
+			join: &bindings.JoinSwapExternAmountIn{
+				PoolId:  poolID,
+				TokenIn: wasmvmtypes.Coin{Denom: "uosmo", Amount: "120000"},
+			},
+			expErr: true,
+		},
+		"null join": {
+			join:   nil,
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			cacheCtx, _ := ctx.CacheContext()
+			// when
+			gotRes, gotErr := wasmbinding.PerformJoinSwapExternAmountIn(osmosis.GAMMKeeper, cacheCtx, actor, spec.join)
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			require.Equal(t, estimate.ShareOutAmount, gotRes.ShareOutAmount)
+			require.Equal(t, gotRes.ShareOutAmount, osmosis.BankKeeper.GetBalance(cacheCtx, actor, sharesDenom).Amount)
+		})
+	}
+}
+
+func TestExitSwapShareAmountIn(t *testing.T) {
+	actor := RandomAccountAddress()
+	osmosis, ctx := SetupCustomApp(t, actor)
+	fundAccount(t, ctx, osmosis, actor, defaultFunds)
+	poolID := preparePool(t, ctx, osmosis, actor, []sdk.Coin{
+		sdk.NewInt64Coin("uosmo", 12000000),
+		sdk.NewInt64Coin("ustar", 240000000),
+	})
+	// 1% of the initial pool shares
+	shareAmount, ok := sdk.NewIntFromString("1_000_000_000_000_000_000")
+	require.True(t, ok)
+
+	specs := map[string]struct {
+		exit   *bindings.ExitSwapShareAmountIn
+		expErr bool
+	}{
+		"valid exit": {
+			exit: &bindings.ExitSwapShareAmountIn{
+				PoolId:            poolID,
+				TokenOutDenom:     "uosmo",
+				ShareInAmount:     shareAmount,
+				TokenOutMinAmount: sdk.OneInt(),
+			},
+		},
+		"token out min amount not reached": {
+			exit: &bindings.ExitSwapShareAmountIn{
+				PoolId:            poolID,
+				TokenOutDenom:     "uosmo",
+				ShareInAmount:     shareAmount,
+				TokenOutMinAmount: sdk.NewInt(240000),
+			},
+			expErr: true,
+		},
+		"denom not in pool": {
+			exit: &bindings.ExitSwapShareAmountIn{
+				PoolId:            poolID,
+				TokenOutDenom:     "uatom",
+				ShareInAmount:     shareAmount,
+				TokenOutMinAmount: sdk.OneInt(),
+			},
+			expErr: true,
+		},
+		"zero shares": {
+			exit: &bindings.ExitSwapShareAmountIn{
+				PoolId:            poolID,
+				TokenOutDenom:     "uosmo",
+				ShareInAmount:     sdk.ZeroInt(),
+				TokenOutMinAmount: sdk.OneInt(),
+			},
+			expErr: true,
+		},
+		"missing share in amount": {
+			exit: &bindings.ExitSwapShareAmountIn{
+				PoolId:            poolID,
+				TokenOutDenom:     "uosmo",
+				TokenOutMinAmount: sdk.OneInt(),
+			},
+			expErr: true,
+		},
+		"missing token out min amount": {
+			exit: &bindings.ExitSwapShareAmountIn{
+				PoolId:        poolID,
+				TokenOutDenom: "uosmo",
+				ShareInAmount: shareAmount,
+			},
+			expErr: true,
+		},
+		"null exit": {
+			exit:   nil,
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			cacheCtx, _ := ctx.CacheContext()
+			preExitBalance := osmosis.BankKeeper.GetBalance(cacheCtx, actor, "uosmo").Amount
+			// when
+			gotRes, gotErr := wasmbinding.PerformExitSwapShareAmountIn(osmosis.GAMMKeeper, cacheCtx, actor, spec.exit)
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			// the exited ustar is swapped into less than the exited uosmo, as the pool is left with less liquidity
+			require.True(t, gotRes.TokenOutAmount.GT(sdk.NewInt(120000)))
+			require.True(t, gotRes.TokenOutAmount.LT(sdk.NewInt(240000)))
+			require.Equal(t, preExitBalance.Add(gotRes.TokenOutAmount), osmosis.BankKeeper.GetBalance(cacheCtx, actor, "uosmo").Amount)
+		})
+	}
+}
+
+func TestLockTokens(t *testing.T) {
+	actor := RandomAccountAddress()
+	osmosis, ctx := SetupCustomApp(t, actor)
+	fundAccount(t, ctx, osmosis, actor, defaultFunds)
+
+	specs := map[string]struct {
+		lock   *bindings.LockTokens
+		expErr bool
+	}{
+		"valid lock": {
+			lock: &bindings.LockTokens{
+				Duration: 86400,
+				Coins:    wasmvmtypes.Coins{{Denom: "ustar", Amount: "1000"}},
+			},
+		},
+		"multiple denoms": {
+			lock: &bindings.LockTokens{
+				Duration: 86400,
+				Coins:    wasmvmtypes.Coins{{Denom: "ustar", Amount: "1000"}, {Denom: "uatom", Amount: "1000"}},
+			},
+			expErr: true,
+		},
+		"insufficient funds": {
+			lock: &bindings.LockTokens{
+				Duration: 86400,
+				Coins:    wasmvmtypes.Coins{{Denom: "ustar", Amount: "999000001"}},
+			},
+			expErr: true,
+		},
+		"no coins": {
+			lock: &bindings.LockTokens{
+				Duration: 86400,
+			},
+			expErr: true,
+		},
+		"zero duration": {
+			lock: &bindings.LockTokens{
+				Coins: wasmvmtypes.Coins{{Denom: "ustar", Amount: "1000"}},
+			},
+			expErr: true,
+		},
+		"duration overflowing time.Duration": {
+			lock: &bindings.LockTokens{
+				Duration: math.MaxInt64/uint64(time.Second) + 1,
+				Coins:    wasmvmtypes.Coins{{Denom: "ustar", Amount: "1000"}},
+			},
+			expErr: true,
+		},
+		"duration wrapping around to a day": {
+			lock: &bindings.LockTokens{
+				// 2^55 seconds are a multiple of 2^64 nanoseconds
+				Duration: 1<<55 + 86400,
+				Coins:    wasmvmtypes.Coins{{Denom: "ustar", Amount: "1000"}},
+			},
+			expErr: true,
+		},
+		"null lock": {
+			lock:   nil,
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			cacheCtx, _ := ctx.CacheContext()
+			// when
+			gotRes, gotErr := wasmbinding.PerformLockTokens(osmosis.LockupKeeper, cacheCtx, actor, spec.lock)
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			lock, err := osmosis.LockupKeeper.GetLockByID(cacheCtx, gotRes.LockId)
+			require.NoError(t, err)
+			require.Equal(t, actor.String(), lock.Owner)
+			require.Equal(t, time.Duration(spec.lock.Duration)*time.Second, lock.Duration)
+			require.Equal(t, spec.lock.Coins, wasmbinding.ConvertSdkCoinsToWasmCoins(lock.Coins))
+		})
+	}
+}
+
+func TestBeginUnlocking(t *testing.T) {
+	actor := RandomAccountAddress()
+	osmosis, ctx := SetupCustomApp(t, actor)
+	fundAccount(t, ctx, osmosis, actor, defaultFunds)
+
+	res, err := wasmbinding.PerformLockTokens(osmosis.LockupKeeper, ctx, actor, &bindings.LockTokens{
+		Duration: 86400,
+		Coins:    wasmvmtypes.Coins{{Denom: "ustar", Amount: "1000"}},
+	})
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		sender            sdk.AccAddress
+		beginUnlocking    *bindings.BeginUnlocking
+		expUnlockingCoins sdk.Coins
+		expErr            bool
+	}{
+		"unlock the whole lock": {
+			sender:            actor,
+			beginUnlocking:    &bindings.BeginUnlocking{LockId: res.LockId},
+			expUnlockingCoins: sdk.NewCoins(sdk.NewInt64Coin("ustar", 1000)),
+		},
+		"unlock part of the lock": {
+			sender: actor,
+			beginUnlocking: &bindings.BeginUnlocking{
+				LockId: res.LockId,
+				Coins:  wasmvmtypes.Coins{{Denom: "ustar", Amount: "400"}},
+			},
+			expUnlockingCoins: sdk.NewCoins(sdk.NewInt64Coin("ustar", 400)),
+		},
+		"not the lock owner": {
+			sender:         RandomAccountAddress(),
+			beginUnlocking: &bindings.BeginUnlocking{LockId: res.LockId},
+			expErr:         true,
+		},
+		"non-existent lock": {
+			sender:         actor,
+			beginUnlocking: &bindings.BeginUnlocking{LockId: res.LockId + 1},
+			expErr:         true,
+		},
+		"null begin unlocking": {
+			sender:         actor,
+			beginUnlocking: nil,
+			expErr:         true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			cacheCtx, _ := ctx.CacheContext()
+			// when
+			gotErr := wasmbinding.PerformBeginUnlocking(osmosis.LockupKeeper, cacheCtx, spec.sender, spec.beginUnlocking)
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			require.Equal(t, spec.expUnlockingCoins, osmosis.LockupKeeper.GetAccountUnlockingCoins(cacheCtx, actor))
+		})
+	}
+}
+
+func TestSuperfluidDelegate(t *testing.T) {
+	actor := RandomAccountAddress()
+	osmosis, ctx := SetupCustomApp(t, actor)
+	fundAccount(t, ctx, osmosis, actor, defaultFunds)
+
+	bondDenom := osmosis.StakingKeeper.BondDenom(ctx)
+	fundAccount(t, ctx, osmosis, actor, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 12000000)))
+	poolID := preparePool(t, ctx, osmosis, actor, []sdk.Coin{
+		sdk.NewInt64Coin(bondDenom, 12000000),
+		sdk.NewInt64Coin("ustar", 240000000),
+	})
+	sharesDenom := fmt.Sprintf("gamm/pool/%d", poolID)
+	err := osmosis.SuperfluidKeeper.AddNewSuperfluidAsset(ctx, superfluidtypes.SuperfluidAsset{
+		Denom:     sharesDenom,
+		AssetType: superfluidtypes.SuperfluidAssetTypeLPShare,
+	})
+	require.NoError(t, err)
+	valAddr := createValidator(t, ctx, osmosis)
+
+	// superfluid delegated locks must be at least as long as the unbonding time
+	unbondingTime := osmosis.StakingKeeper.GetParams(ctx).UnbondingTime
+	// and the intermediary account gauges are created with the unbonding time as duration
+	osmosis.IncentivesKeeper.SetLockableDurations(ctx, append(osmosis.IncentivesKeeper.GetLockableDurations(ctx), unbondingTime))
+	lockTokens := func(denom string) uint64 {
+		res, err := wasmbinding.PerformLockTokens(osmosis.LockupKeeper, ctx, actor, &bindings.LockTokens{
+			Duration: uint64(unbondingTime / time.Second),
+			Coins:    wasmvmtypes.Coins{{Denom: denom, Amount: "1000000000000000000"}},
+		})
+		require.NoError(t, err)
+		return res.LockId
+	}
+	sharesLockID := lockTokens(sharesDenom)
+	fundAccount(t, ctx, osmosis, actor, sdk.NewCoins(sdk.NewCoin("ustar", sdk.NewInt(1000000000000000000))))
+	starLockID := lockTokens("ustar")
+
+	specs := map[string]struct {
+		sender   sdk.AccAddress
+		delegate *bindings.SuperfluidDelegate
+		expErr   bool
+	}{
+		"valid delegation": {
+			sender:   actor,
+			delegate: &bindings.SuperfluidDelegate{LockId: sharesLockID, Validator: valAddr.String()},
+		},
+		"not the lock owner": {
+			sender:   RandomAccountAddress(),
+			delegate: &bindings.SuperfluidDelegate{LockId: sharesLockID, Validator: valAddr.String()},
+			expErr:   true,
+		},
+		"not a superfluid asset": {
+			sender:   actor,
+			delegate: &bindings.SuperfluidDelegate{LockId: starLockID, Validator: valAddr.String()},
+			expErr:   true,
+		},
+		"invalid validator address": {
+			sender:   actor,
+			delegate: &bindings.SuperfluidDelegate{LockId: sharesLockID, Validator: actor.String()},
+			expErr:   true,
+		},
+		"null delegate": {
+			sender:   actor,
+			delegate: nil,
+			expErr:   true,
+		},
+	}
+	queryPlugin := wasmbinding.NewQueryPlugin(osmosis.GAMMKeeper, osmosis.TwapKeeper, osmosis.TokenFactoryKeeper, osmosis.LockupKeeper, osmosis.SuperfluidKeeper, osmosis.IncentivesKeeper)
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			cacheCtx, _ := ctx.CacheContext()
+			// when
+			gotErr := wasmbinding.PerformSuperfluidDelegate(osmosis.SuperfluidKeeper, cacheCtx, spec.sender, spec.delegate)
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			res, err := queryPlugin.GetSuperfluidDelegation(cacheCtx, &bindings.SuperfluidDelegation{LockId: spec.delegate.LockId})
+			require.NoError(t, err)
+			require.Equal(t, valAddr.String(), res.Validator)
+		})
+	}
+
+	err = wasmbinding.PerformSuperfluidDelegate(osmosis.SuperfluidKeeper, ctx, actor, &bindings.SuperfluidDelegate{LockId: sharesLockID, Validator: valAddr.String()})
+	require.NoError(t, err)
+
+	undelegateSpecs := map[string]struct {
+		sender     sdk.AccAddress
+		undelegate *bindings.SuperfluidUndelegate
+		expErr     bool
+	}{
+		"valid undelegation": {
+			sender:     actor,
+			undelegate: &bindings.SuperfluidUndelegate{LockId: sharesLockID},
+		},
+		"not the lock owner": {
+			sender:     RandomAccountAddress(),
+			undelegate: &bindings.SuperfluidUndelegate{LockId: sharesLockID},
+			expErr:     true,
+		},
+		"not superfluid delegated": {
+			sender:     actor,
+			undelegate: &bindings.SuperfluidUndelegate{LockId: starLockID},
+			expErr:     true,
+		},
+		"null undelegate": {
+			sender:     actor,
+			undelegate: nil,
+			expErr:     true,
+		},
+	}
+	for name, spec := range undelegateSpecs {
+		t.Run(name, func(t *testing.T) {
+			cacheCtx, _ := ctx.CacheContext()
+			// when
+			gotErr := wasmbinding.PerformSuperfluidUndelegate(osmosis.SuperfluidKeeper, cacheCtx, spec.sender, spec.undelegate)
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			res, err := queryPlugin.GetSuperfluidDelegation(cacheCtx, &bindings.SuperfluidDelegation{LockId: spec.undelegate.LockId})
+			require.NoError(t, err)
+			require.Empty(t, res.Validator)
+		})
+	}
+}
+
+func TestCreateGauge(t *testing.T) {
+	actor := RandomAccountAddress()
+	osmosis, ctx := SetupCustomApp(t, actor)
+	fundAccount(t, ctx, osmosis, actor, defaultFunds)
+
+	// gauge creation is charged a fee in the base denom
+	fundAccount(t, ctx, osmosis, actor, sdk.NewCoins(sdk.NewCoin(osmosis.StakingKeeper.BondDenom(ctx), incentivestypes.CreateGaugeFee)))
+
+	lockableDuration := uint64(osmosis.IncentivesKeeper.GetLockableDurations(ctx)[0] / time.Second)
+	startTime := ctx.BlockTime().Add(time.Hour)
+
+	specs := map[string]struct {
+		createGauge  *bindings.CreateGauge
+		expStartTime time.Time
+		expErr       bool
+	}{
+		"valid gauge starting now": {
+			createGauge: &bindings.CreateGauge{
+				Denom:             "ustar",
+				Duration:          lockableDuration,
+				Coins:             wasmvmtypes.Coins{{Denom: "uatom", Amount: "1000"}},
+				NumEpochsPaidOver: 10,
+			},
+			expStartTime: ctx.BlockTime(),
+		},
+		"valid perpetual gauge with a start time": {
+			createGauge: &bindings.CreateGauge{
+				IsPerpetual:       true,
+				Denom:             "ustar",
+				Duration:          lockableDuration,
+				Coins:             wasmvmtypes.Coins{{Denom: "uatom", Amount: "1000"}},
+				StartTime:         startTime.UnixMilli(),
+				NumEpochsPaidOver: 1,
+			},
+			expStartTime: time.UnixMilli(startTime.UnixMilli()).UTC(),
+		},
+		"duration that is not lockable": {
+			createGauge: &bindings.CreateGauge{
+				Denom:             "ustar",
+				Duration:          lockableDuration + 1,
+				Coins:             wasmvmtypes.Coins{{Denom: "uatom", Amount: "1000"}},
+				NumEpochsPaidOver: 10,
+			},
+			expErr: true,
+		},
+		"duration overflowing time.Duration": {
+			createGauge: &bindings.CreateGauge{
+				Denom:             "ustar",
+				Duration:          math.MaxInt64/uint64(time.Second) + 1,
+				Coins:             wasmvmtypes.Coins{{Denom: "uatom", Amount: "1000"}},
+				NumEpochsPaidOver: 10,
+			},
+			expErr: true,
+		},
+		"zero epochs paid over": {
+			createGauge: &bindings.CreateGauge{
+				Denom:    "ustar",
+				Duration: lockableDuration,
+				Coins:    wasmvmtypes.Coins{{Denom: "uatom", Amount: "1000"}},
+			},
+			expErr: true,
+		},
+		"insufficient funds": {
+			createGauge: &bindings.CreateGauge{
+				Denom:             "ustar",
+				Duration:          lockableDuration,
+				Coins:             wasmvmtypes.Coins{{Denom: "uatom", Amount: "333000001"}},
+				NumEpochsPaidOver: 10,
+			},
+			expErr: true,
+		},
+		"null create gauge": {
+			createGauge: nil,
+			expErr:      true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			cacheCtx, _ := ctx.CacheContext()
+			// when
+			gotRes, gotErr := wasmbinding.PerformCreateGauge(osmosis.IncentivesKeeper, cacheCtx, actor, spec.createGauge)
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			gauge, err := osmosis.IncentivesKeeper.GetGaugeByID(cacheCtx, gotRes.GaugeId)
+			require.NoError(t, err)
+			require.Equal(t, spec.createGauge.IsPerpetual, gauge.IsPerpetual)
+			require.Equal(t, spec.createGauge.Denom, gauge.DistributeTo.Denom)
+			require.Equal(t, spec.createGauge.Coins, wasmbinding.ConvertSdkCoinsToWasmCoins(gauge.Coins))
+			require.Equal(t, spec.expStartTime, gauge.StartTime)
+		})
+	}
+}
+
+// createValidator creates a validator with zero commission, for superfluid delegations.
+func createValidator(t *testing.T, ctx sdk.Context, osmosis *app.OsmosisApp) sdk.ValAddress {
+	valPub := secp256k1.GenPrivKey().PubKey()
+	valAddr := sdk.ValAddress(valPub.Address())
+	selfBond := sdk.NewInt64Coin(osmosis.StakingKeeper.BondDenom(ctx), 1000000)
+	fundAccount(t, ctx, osmosis, sdk.AccAddress(valAddr), sdk.NewCoins(selfBond))
+
+	zeroCommission := stakingtypes.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
+	msg, err := stakingtypes.NewMsgCreateValidator(valAddr, valPub, selfBond, stakingtypes.Description{}, zeroCommission, sdk.OneInt())
+	require.NoError(t, err)
+	_, err = stakingkeeper.NewMsgServerImpl(*osmosis.StakingKeeper).CreateValidator(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+	return valAddr
+}
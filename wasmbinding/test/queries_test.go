@@ -1,16 +1,21 @@
 package wasmbinding
 
 import (
+	"encoding/json"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/v12/wasmbinding"
 	"github.com/osmosis-labs/osmosis/v12/wasmbinding/bindings"
+	incentivestypes "github.com/osmosis-labs/osmosis/v12/x/incentives/types"
+	twaptypes "github.com/osmosis-labs/osmosis/v12/x/twap/types"
 )
 
 func TestFullDenom(t *testing.T) {
@@ -74,11 +79,11 @@ func TestDenomAdmin(t *testing.T) {
 
 	// create a subdenom via the token factory
 	admin := sdk.AccAddress([]byte("addr1_______________"))
-	tfDenom, err := app.TokenFactoryKeeper.CreateDenom(ctx, admin.String(), "subdenom")
+	tfDenom, err := app.TokenFactoryKeeper.CreateDenom(ctx, admin.String(), "subdenom", nil, "")
 	require.NoError(t, err)
 	require.NotEmpty(t, tfDenom)
 
-	queryPlugin := wasmbinding.NewQueryPlugin(app.GAMMKeeper, app.TwapKeeper, app.TokenFactoryKeeper)
+	queryPlugin := wasmbinding.NewQueryPlugin(app.GAMMKeeper, app.TwapKeeper, app.TokenFactoryKeeper, app.LockupKeeper, app.SuperfluidKeeper, app.IncentivesKeeper)
 
 	testCases := []struct {
 		name        string
@@ -132,7 +137,7 @@ func TestPoolState(t *testing.T) {
 	starSharesDenom := fmt.Sprintf("gamm/pool/%d", starPool)
 	starSharedAmount, _ := sdk.NewIntFromString("100_000_000_000_000_000_000")
 
-	queryPlugin := wasmbinding.NewQueryPlugin(osmosis.GAMMKeeper, osmosis.TwapKeeper, osmosis.TokenFactoryKeeper)
+	queryPlugin := wasmbinding.NewQueryPlugin(osmosis.GAMMKeeper, osmosis.TwapKeeper, osmosis.TokenFactoryKeeper, osmosis.LockupKeeper, osmosis.SuperfluidKeeper, osmosis.IncentivesKeeper)
 
 	specs := map[string]struct {
 		poolId       uint64
@@ -192,7 +197,7 @@ func TestSpotPrice(t *testing.T) {
 	starFee := sdk.MustNewDecFromStr(fmt.Sprintf("%f", swapFee))
 	starPriceWithFee := starPrice.Add(starFee)
 
-	queryPlugin := wasmbinding.NewQueryPlugin(osmosis.GAMMKeeper, osmosis.TwapKeeper, osmosis.TokenFactoryKeeper)
+	queryPlugin := wasmbinding.NewQueryPlugin(osmosis.GAMMKeeper, osmosis.TwapKeeper, osmosis.TokenFactoryKeeper, osmosis.LockupKeeper, osmosis.SuperfluidKeeper, osmosis.IncentivesKeeper)
 
 	specs := map[string]struct {
 		spotPrice *bindings.SpotPrice
@@ -335,7 +340,7 @@ func TestEstimateSwap(t *testing.T) {
 
 	starSwapAmount := bindings.SwapAmount{Out: &starAmount}
 
-	queryPlugin := wasmbinding.NewQueryPlugin(osmosis.GAMMKeeper, osmosis.TwapKeeper, osmosis.TokenFactoryKeeper)
+	queryPlugin := wasmbinding.NewQueryPlugin(osmosis.GAMMKeeper, osmosis.TwapKeeper, osmosis.TokenFactoryKeeper, osmosis.LockupKeeper, osmosis.SuperfluidKeeper, osmosis.IncentivesKeeper)
 
 	specs := map[string]struct {
 		estimateSwap *bindings.EstimateSwap
@@ -539,3 +544,520 @@ func TestEstimateSwap(t *testing.T) {
 		})
 	}
 }
+
+func TestGeometricTwap(t *testing.T) {
+	actor := RandomAccountAddress()
+	osmosis, ctx := SetupCustomApp(t, actor)
+
+	fundAccount(t, ctx, osmosis, actor, defaultFunds)
+
+	poolFunds := []sdk.Coin{
+		sdk.NewInt64Coin("uosmo", 12000000),
+		sdk.NewInt64Coin("ustar", 240000000),
+	}
+	// twap binding times are in milliseconds.
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Truncate(time.Millisecond))
+	// 20 star to 1 osmo
+	starPool := preparePool(t, ctx, osmosis, actor, poolFunds)
+	poolCreationTime := ctx.BlockTime()
+	ctx = ctx.WithBlockTime(poolCreationTime.Add(time.Minute))
+
+	querier := wasmbinding.CustomQuerier(wasmbinding.NewQueryPlugin(osmosis.GAMMKeeper, osmosis.TwapKeeper, osmosis.TokenFactoryKeeper, osmosis.LockupKeeper, osmosis.SuperfluidKeeper, osmosis.IncentivesKeeper))
+	epsilon := 1e-9
+
+	// the geometric twap is the price of the base asset in units of the quote asset, as for the keeper.
+	starTwap, err := osmosis.TwapKeeper.GetGeometricTwap(ctx, starPool, "ustar", "uosmo", poolCreationTime, poolCreationTime.Add(30*time.Second))
+	require.NoError(t, err)
+	starTwapToNow, err := osmosis.TwapKeeper.GetGeometricTwapToNow(ctx, starPool, "ustar", "uosmo", poolCreationTime)
+	require.NoError(t, err)
+	assert.InEpsilon(t, 0.05, starTwap.MustFloat64(), epsilon)
+
+	specs := map[string]struct {
+		request string
+		expTwap sdk.Dec
+		expErr  bool
+	}{
+		"geometric twap": {
+			request: fmt.Sprintf(`{"geometric_twap":{"id":%d,"quote_asset_denom":"uosmo","base_asset_denom":"ustar","start_time":%d,"end_time":%d}}`,
+				starPool, poolCreationTime.UnixMilli(), poolCreationTime.Add(30*time.Second).UnixMilli()),
+			expTwap: starTwap,
+		},
+		"geometric twap to now": {
+			request: fmt.Sprintf(`{"geometric_twap_to_now":{"id":%d,"quote_asset_denom":"uosmo","base_asset_denom":"ustar","start_time":%d}}`,
+				starPool, poolCreationTime.UnixMilli()),
+			expTwap: starTwapToNow,
+		},
+		"geometric twap start time after end time": {
+			request: fmt.Sprintf(`{"geometric_twap":{"id":%d,"quote_asset_denom":"uosmo","base_asset_denom":"ustar","start_time":%d,"end_time":%d}}`,
+				starPool, ctx.BlockTime().UnixMilli(), poolCreationTime.UnixMilli()),
+			expErr: true,
+		},
+		"geometric twap to now of a denom not in the pool": {
+			request: fmt.Sprintf(`{"geometric_twap_to_now":{"id":%d,"quote_asset_denom":"uatom","base_asset_denom":"ustar","start_time":%d}}`,
+				starPool, poolCreationTime.UnixMilli()),
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			// when
+			gotBz, gotErr := querier(ctx, []byte(spec.request))
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			var gotTwap bindings.TwapResponse
+			require.NoError(t, json.Unmarshal(gotBz, &gotTwap))
+			twap, err := sdk.NewDecFromStr(gotTwap.Twap)
+			require.NoError(t, err)
+			require.Equal(t, spec.expTwap, twap)
+		})
+	}
+}
+
+func TestArithmeticTwap(t *testing.T) {
+	actor := RandomAccountAddress()
+	osmosis, ctx := SetupCustomApp(t, actor)
+
+	fundAccount(t, ctx, osmosis, actor, defaultFunds)
+
+	poolFunds := []sdk.Coin{
+		sdk.NewInt64Coin("uosmo", 12000000),
+		sdk.NewInt64Coin("ustar", 240000000),
+	}
+	// twap binding times are in milliseconds.
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Truncate(time.Millisecond))
+	// 20 star to 1 osmo
+	starPool := preparePool(t, ctx, osmosis, actor, poolFunds)
+	poolCreationTime := ctx.BlockTime()
+
+	// record a spot price error 30 seconds after pool creation, the price recovering 10 seconds later.
+	errTime := poolCreationTime.Add(30 * time.Second)
+	recoveryTime := errTime.Add(10 * time.Second)
+	ctx = ctx.WithBlockTime(errTime)
+	errRecord, err := osmosis.TwapKeeper.GetBeginBlockAccumulatorRecord(ctx, starPool, "uosmo", "ustar")
+	require.NoError(t, err)
+	recoveredRecord := errRecord
+	recoveredRecord.Time = recoveryTime
+	recoveredRecord.LastErrorTime = errTime
+	errRecord.P0LastSpotPrice = sdk.ZeroDec()
+	errRecord.P1LastSpotPrice = sdk.ZeroDec()
+	errRecord.LastErrorTime = errTime
+	osmosis.TwapKeeper.InitGenesis(ctx, &twaptypes.GenesisState{
+		Params: osmosis.TwapKeeper.GetParams(ctx),
+		Twaps:  []twaptypes.TwapRecord{errRecord, recoveredRecord},
+	})
+	ctx = ctx.WithBlockTime(poolCreationTime.Add(time.Minute))
+
+	queryPlugin := wasmbinding.NewQueryPlugin(osmosis.GAMMKeeper, osmosis.TwapKeeper, osmosis.TokenFactoryKeeper, osmosis.LockupKeeper, osmosis.SuperfluidKeeper, osmosis.IncentivesKeeper)
+
+	starPrice := sdk.NewDec(20)
+	// the price is zero for the 10 seconds of the error.
+	starPriceWithErr := starPrice.MulInt64(50).QuoInt64(60)
+	noErrMetadata := bindings.TwapMetadata{
+		LastRecordHeight: recoveredRecord.Height,
+		LastRecordTime:   recoveryTime.UnixMilli(),
+	}
+	afterErrMetadata := bindings.TwapMetadata{
+		LastRecordHeight: recoveredRecord.Height,
+		LastRecordTime:   recoveryTime.UnixMilli(),
+		LastErrorTime:    errTime.UnixMilli(),
+	}
+	errMetadata := afterErrMetadata
+	errMetadata.WindowHasError = true
+
+	specs := map[string]struct {
+		startTime   time.Time
+		endTime     time.Time
+		lenient     bool
+		expTwap     sdk.Dec
+		expMetadata bindings.TwapMetadata
+		expErr      bool
+	}{
+		"window before spot price error": {
+			startTime:   poolCreationTime,
+			endTime:     poolCreationTime.Add(10 * time.Second),
+			expTwap:     starPrice,
+			expMetadata: noErrMetadata,
+		},
+		"window after spot price error": {
+			startTime:   recoveryTime,
+			endTime:     ctx.BlockTime(),
+			expTwap:     starPrice,
+			expMetadata: afterErrMetadata,
+		},
+		"window with spot price error": {
+			startTime: poolCreationTime,
+			endTime:   ctx.BlockTime(),
+			expErr:    true,
+		},
+		"window with spot price error, lenient": {
+			startTime:   poolCreationTime,
+			endTime:     ctx.BlockTime(),
+			lenient:     true,
+			expTwap:     starPriceWithErr,
+			expMetadata: errMetadata,
+		},
+		"start time after end time, lenient": {
+			startTime: ctx.BlockTime(),
+			endTime:   poolCreationTime,
+			lenient:   true,
+			expErr:    true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			// when
+			gotTwap, gotErr := queryPlugin.ArithmeticTwap(ctx, &bindings.ArithmeticTwap{
+				PoolId:          starPool,
+				QuoteAssetDenom: "uosmo",
+				BaseAssetDenom:  "ustar",
+				StartTime:       spec.startTime.UnixMilli(),
+				EndTime:         spec.endTime.UnixMilli(),
+				Lenient:         spec.lenient,
+			})
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expTwap.String(), gotTwap.Twap)
+			assert.Equal(t, spec.expMetadata, gotTwap.Metadata)
+		})
+	}
+}
+
+func TestCalcJoinPoolShares(t *testing.T) {
+	actor := RandomAccountAddress()
+	osmosis, ctx := SetupCustomApp(t, actor)
+	fundAccount(t, ctx, osmosis, actor, defaultFunds)
+	poolID := preparePool(t, ctx, osmosis, actor, []sdk.Coin{
+		sdk.NewInt64Coin("uosmo", 12000000),
+		sdk.NewInt64Coin("ustar", 240000000),
+	})
+	// 1% of the initial pool shares
+	onePercentShares, ok := sdk.NewIntFromString("1_000_000_000_000_000_000")
+	require.True(t, ok)
+
+	queryPlugin := wasmbinding.NewQueryPlugin(osmosis.GAMMKeeper, osmosis.TwapKeeper, osmosis.TokenFactoryKeeper, osmosis.LockupKeeper, osmosis.SuperfluidKeeper, osmosis.IncentivesKeeper)
+
+	specs := map[string]struct {
+		calcJoin    *bindings.CalcJoinPoolShares
+		expShares   sdk.Int
+		expTokensIn wasmvmtypes.Coins
+		expErr      bool
+	}{
+		"proportional join": {
+			calcJoin: &bindings.CalcJoinPoolShares{
+				PoolId:   poolID,
+				TokensIn: wasmvmtypes.Coins{{Denom: "uosmo", Amount: "120000"}, {Denom: "ustar", Amount: "2400000"}},
+			},
+			expShares:   onePercentShares,
+			expTokensIn: wasmvmtypes.Coins{{Denom: "uosmo", Amount: "120000"}, {Denom: "ustar", Amount: "2400000"}},
+		},
+		"single asset join": {
+			calcJoin: &bindings.CalcJoinPoolShares{
+				PoolId:   poolID,
+				TokensIn: wasmvmtypes.Coins{{Denom: "uosmo", Amount: "120000"}},
+			},
+			expTokensIn: wasmvmtypes.Coins{{Denom: "uosmo", Amount: "120000"}},
+		},
+		"denom not in pool": {
+			calcJoin: &bindings.CalcJoinPoolShares{
+				PoolId:   poolID,
+				TokensIn: wasmvmtypes.Coins{{Denom: "uatom", Amount: "120000"}},
+			},
+			expErr: true,
+		},
+		"non-existent pool": {
+			calcJoin: &bindings.CalcJoinPoolShares{
+				PoolId:   poolID + 1,
+				TokensIn: wasmvmtypes.Coins{{Denom: "uosmo", Amount: "120000"}},
+			},
+			expErr: true,
+		},
+		"no tokens in": {
+			calcJoin: &bindings.CalcJoinPoolShares{
+				PoolId: poolID,
+			},
+			expErr: true,
+		},
+		"nil calc join pool shares": {
+			calcJoin: nil,
+			expErr:   true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			// when
+			gotRes, gotErr := queryPlugin.CalcJoinPoolShares(ctx, spec.calcJoin)
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expTokensIn, gotRes.TokensIn)
+			if !spec.expShares.IsNil() {
+				assert.Equal(t, spec.expShares, gotRes.ShareOutAmount)
+			} else {
+				// single asset joins are charged for swapping half of the tokens in
+				assert.True(t, gotRes.ShareOutAmount.IsPositive())
+				assert.True(t, gotRes.ShareOutAmount.LT(onePercentShares.QuoRaw(2)))
+			}
+		})
+	}
+
+	// the single asset estimate matches an actual join
+	estimate, err := queryPlugin.CalcJoinPoolShares(ctx, &bindings.CalcJoinPoolShares{
+		PoolId:   poolID,
+		TokensIn: wasmvmtypes.Coins{{Denom: "uosmo", Amount: "120000"}},
+	})
+	require.NoError(t, err)
+	shares, err := osmosis.GAMMKeeper.JoinSwapExactAmountIn(ctx, actor, poolID, sdk.NewCoins(sdk.NewInt64Coin("uosmo", 120000)), sdk.OneInt())
+	require.NoError(t, err)
+	assert.Equal(t, estimate.ShareOutAmount, shares)
+}
+
+func TestCalcExitPoolCoinsFromShares(t *testing.T) {
+	actor := RandomAccountAddress()
+	osmosis, ctx := SetupCustomApp(t, actor)
+	fundAccount(t, ctx, osmosis, actor, defaultFunds)
+	poolID := preparePool(t, ctx, osmosis, actor, []sdk.Coin{
+		sdk.NewInt64Coin("uosmo", 12000000),
+		sdk.NewInt64Coin("ustar", 240000000),
+	})
+	// 1% of the initial pool shares
+	onePercentShares, ok := sdk.NewIntFromString("1_000_000_000_000_000_000")
+	require.True(t, ok)
+	totalShares := osmosis.BankKeeper.GetSupply(ctx, fmt.Sprintf("gamm/pool/%d", poolID)).Amount
+
+	queryPlugin := wasmbinding.NewQueryPlugin(osmosis.GAMMKeeper, osmosis.TwapKeeper, osmosis.TokenFactoryKeeper, osmosis.LockupKeeper, osmosis.SuperfluidKeeper, osmosis.IncentivesKeeper)
+
+	specs := map[string]struct {
+		calcExit     *bindings.CalcExitPoolCoinsFromShares
+		expTokensOut wasmvmtypes.Coins
+		expErr       bool
+	}{
+		"valid exit": {
+			calcExit: &bindings.CalcExitPoolCoinsFromShares{
+				PoolId:        poolID,
+				ShareInAmount: onePercentShares,
+			},
+			expTokensOut: wasmvmtypes.Coins{{Denom: "uosmo", Amount: "120000"}, {Denom: "ustar", Amount: "2400000"}},
+		},
+		"all shares": {
+			calcExit: &bindings.CalcExitPoolCoinsFromShares{
+				PoolId:        poolID,
+				ShareInAmount: totalShares,
+			},
+			expErr: true,
+		},
+		"zero shares": {
+			calcExit: &bindings.CalcExitPoolCoinsFromShares{
+				PoolId:        poolID,
+				ShareInAmount: sdk.ZeroInt(),
+			},
+			expErr: true,
+		},
+		"nil shares": {
+			calcExit: &bindings.CalcExitPoolCoinsFromShares{
+				PoolId: poolID,
+			},
+			expErr: true,
+		},
+		"non-existent pool": {
+			calcExit: &bindings.CalcExitPoolCoinsFromShares{
+				PoolId:        poolID + 1,
+				ShareInAmount: onePercentShares,
+			},
+			expErr: true,
+		},
+		"nil calc exit pool coins from shares": {
+			calcExit: nil,
+			expErr:   true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			// when
+			gotRes, gotErr := queryPlugin.CalcExitPoolCoinsFromShares(ctx, spec.calcExit)
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expTokensOut, gotRes.TokensOut)
+		})
+	}
+}
+
+func TestLockQueries(t *testing.T) {
+	actor := RandomAccountAddress()
+	osmosis, ctx := SetupCustomApp(t, actor)
+	fundAccount(t, ctx, osmosis, actor, defaultFunds)
+	// lock end times are reported in milliseconds.
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Truncate(time.Millisecond))
+
+	// locks of the same owner, denom and duration are merged, so use different durations.
+	lockTokens := func(duration uint64, amount string) uint64 {
+		res, err := wasmbinding.PerformLockTokens(osmosis.LockupKeeper, ctx, actor, &bindings.LockTokens{
+			Duration: duration,
+			Coins:    wasmvmtypes.Coins{{Denom: "ustar", Amount: amount}},
+		})
+		require.NoError(t, err)
+		return res.LockId
+	}
+	lockedID := lockTokens(86400, "1000")
+	unlockingID := lockTokens(3600, "2000")
+	err := wasmbinding.PerformBeginUnlocking(osmosis.LockupKeeper, ctx, actor, &bindings.BeginUnlocking{LockId: unlockingID})
+	require.NoError(t, err)
+
+	expLocked := bindings.LockInfo{
+		Id:       lockedID,
+		Owner:    actor.String(),
+		Duration: 86400,
+		Coins:    wasmvmtypes.Coins{{Denom: "ustar", Amount: "1000"}},
+	}
+	expUnlocking := bindings.LockInfo{
+		Id:       unlockingID,
+		Owner:    actor.String(),
+		Duration: 3600,
+		EndTime:  ctx.BlockTime().Add(time.Hour).UnixMilli(),
+		Coins:    wasmvmtypes.Coins{{Denom: "ustar", Amount: "2000"}},
+	}
+
+	queryPlugin := wasmbinding.NewQueryPlugin(osmosis.GAMMKeeper, osmosis.TwapKeeper, osmosis.TokenFactoryKeeper, osmosis.LockupKeeper, osmosis.SuperfluidKeeper, osmosis.IncentivesKeeper)
+
+	lockSpecs := map[string]struct {
+		lock    *bindings.Lock
+		expLock bindings.LockInfo
+		expErr  bool
+	}{
+		"locked": {
+			lock:    &bindings.Lock{LockId: lockedID},
+			expLock: expLocked,
+		},
+		"unlocking": {
+			lock:    &bindings.Lock{LockId: unlockingID},
+			expLock: expUnlocking,
+		},
+		"non-existent lock": {
+			lock:   &bindings.Lock{LockId: unlockingID + 1},
+			expErr: true,
+		},
+		"nil lock": {
+			lock:   nil,
+			expErr: true,
+		},
+	}
+	for name, spec := range lockSpecs {
+		t.Run(name, func(t *testing.T) {
+			// when
+			gotLock, gotErr := queryPlugin.GetLock(ctx, spec.lock)
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expLock, gotLock.Lock)
+		})
+	}
+
+	accountLocksSpecs := map[string]struct {
+		owner    string
+		expLocks []bindings.LockInfo
+		expErr   bool
+	}{
+		"owner with locks": {
+			owner:    actor.String(),
+			expLocks: []bindings.LockInfo{expLocked, expUnlocking},
+		},
+		"owner without locks": {
+			owner:    RandomAccountAddress().String(),
+			expLocks: []bindings.LockInfo{},
+		},
+		"invalid owner": {
+			owner:  "invalid",
+			expErr: true,
+		},
+	}
+	for name, spec := range accountLocksSpecs {
+		t.Run(name, func(t *testing.T) {
+			// when
+			gotLocks, gotErr := queryPlugin.GetAccountLocks(ctx, &bindings.AccountLocks{Owner: spec.owner})
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expLocks, gotLocks.Locks)
+		})
+	}
+}
+
+func TestGaugeQuery(t *testing.T) {
+	actor := RandomAccountAddress()
+	osmosis, ctx := SetupCustomApp(t, actor)
+	fundAccount(t, ctx, osmosis, actor, defaultFunds)
+	fundAccount(t, ctx, osmosis, actor, sdk.NewCoins(sdk.NewCoin(osmosis.StakingKeeper.BondDenom(ctx), incentivestypes.CreateGaugeFee)))
+	// gauge start times are reported in milliseconds.
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Truncate(time.Millisecond))
+
+	lockableDuration := osmosis.IncentivesKeeper.GetLockableDurations(ctx)[0]
+	res, err := wasmbinding.PerformCreateGauge(osmosis.IncentivesKeeper, ctx, actor, &bindings.CreateGauge{
+		Denom:             "ustar",
+		Duration:          uint64(lockableDuration / time.Second),
+		Coins:             wasmvmtypes.Coins{{Denom: "uatom", Amount: "1000"}},
+		NumEpochsPaidOver: 10,
+	})
+	require.NoError(t, err)
+
+	queryPlugin := wasmbinding.NewQueryPlugin(osmosis.GAMMKeeper, osmosis.TwapKeeper, osmosis.TokenFactoryKeeper, osmosis.LockupKeeper, osmosis.SuperfluidKeeper, osmosis.IncentivesKeeper)
+
+	specs := map[string]struct {
+		gauge    *bindings.Gauge
+		expGauge bindings.GaugeInfo
+		expErr   bool
+	}{
+		"existing gauge": {
+			gauge: &bindings.Gauge{GaugeId: res.GaugeId},
+			expGauge: bindings.GaugeInfo{
+				Id:                res.GaugeId,
+				Owner:             actor.String(),
+				Denom:             "ustar",
+				Duration:          uint64(lockableDuration / time.Second),
+				Coins:             wasmvmtypes.Coins{{Denom: "uatom", Amount: "1000"}},
+				StartTime:         ctx.BlockTime().UnixMilli(),
+				NumEpochsPaidOver: 10,
+			},
+		},
+		"non-existent gauge": {
+			gauge:  &bindings.Gauge{GaugeId: res.GaugeId + 1},
+			expErr: true,
+		},
+		"nil gauge": {
+			gauge:  nil,
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			// when
+			gotGauge, gotErr := queryPlugin.GetGauge(ctx, spec.gauge)
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expGauge, gotGauge.Gauge)
+		})
+	}
+}
@@ -10,6 +10,9 @@ import (
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 
 	gammkeeper "github.com/osmosis-labs/osmosis/v12/x/gamm/keeper"
+	incentiveskeeper "github.com/osmosis-labs/osmosis/v12/x/incentives/keeper"
+	lockupkeeper "github.com/osmosis-labs/osmosis/v12/x/lockup/keeper"
+	superfluidkeeper "github.com/osmosis-labs/osmosis/v12/x/superfluid/keeper"
 	tokenfactorykeeper "github.com/osmosis-labs/osmosis/v12/x/tokenfactory/keeper"
 	twap "github.com/osmosis-labs/osmosis/v12/x/twap"
 )
@@ -19,14 +22,17 @@ func RegisterCustomPlugins(
 	bank *bankkeeper.BaseKeeper,
 	twap *twap.Keeper,
 	tokenFactory *tokenfactorykeeper.Keeper,
+	lockupKeeper *lockupkeeper.Keeper,
+	superfluidKeeper *superfluidkeeper.Keeper,
+	incentivesKeeper *incentiveskeeper.Keeper,
 ) []wasmkeeper.Option {
-	wasmQueryPlugin := NewQueryPlugin(gammKeeper, twap, tokenFactory)
+	wasmQueryPlugin := NewQueryPlugin(gammKeeper, twap, tokenFactory, lockupKeeper, superfluidKeeper, incentivesKeeper)
 
 	queryPluginOpt := wasmkeeper.WithQueryPlugins(&wasmkeeper.QueryPlugins{
 		Custom: CustomQuerier(wasmQueryPlugin),
 	})
 	messengerDecoratorOpt := wasmkeeper.WithMessageHandlerDecorator(
-		CustomMessageDecorator(gammKeeper, bank, tokenFactory),
+		CustomMessageDecorator(gammKeeper, bank, tokenFactory, lockupKeeper, superfluidKeeper, incentivesKeeper),
 	)
 
 	return []wasm.Option{
@@ -37,6 +37,16 @@ const (
 	FlagSwapRouteAmounts = "swap-route-amounts"
 	// Will be parsed to []string.
 	FlagSwapRouteDenoms = "swap-route-denoms"
+
+	// Will be parsed to string.
+	FlagSplitRoutesFile = "routes-file"
+
+	// Will be parsed to string.
+	FlagFutureGovernor = "future-governor"
+	// Will be parsed to string.
+	FlagOwner = "owner"
+	// Will be parsed to sdk.Coins.
+	FlagTokensMin = "tokens-min"
 )
 
 type createPoolInputs struct {
@@ -70,6 +80,24 @@ func FlagSetSwapAmountOutRoutes() *flag.FlagSet {
 	return fs
 }
 
+// splitRouteSwapInputs is the content of the routes file of a split route swap.
+type splitRouteSwapInputs struct {
+	Routes []splitRouteInputs `json:"routes"`
+}
+
+type splitRouteInputs struct {
+	PoolIds       []uint64 `json:"pool-ids"`
+	Denoms        []string `json:"denoms"`
+	TokenInAmount string   `json:"token-in-amount"`
+}
+
+func FlagSetSplitRouteSwap() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagSplitRoutesFile, "", "Routes json file path, listing the pool ids, token out denoms and token in amount of every route")
+	return fs
+}
+
 func FlagSetCreatePool() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
 
@@ -77,6 +105,41 @@ func FlagSetCreatePool() *flag.FlagSet {
 	return fs
 }
 
+func FlagSetCreateOrderbookPool() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagFutureGovernor, "", "Future governor of the pool, in the same format as in create-pool")
+	return fs
+}
+
+func FlagSetQueryLimitOrders() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagOwner, "", "Only return the limit orders of this address")
+	return fs
+}
+
+func FlagSetCreateConcentratedPool() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagFutureGovernor, "", "Future governor of the pool, in the same format as in create-pool")
+	return fs
+}
+
+func FlagSetCreatePosition() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagTokensMin, "", "Minimum amount of each token to deposit in the position")
+	return fs
+}
+
+func FlagSetQueryPositions() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagOwner, "", "Only return the positions of this address")
+	return fs
+}
+
 func FlagSetJoinPool() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
 
@@ -51,3 +51,26 @@ func parseCreatePoolFlags(fs *pflag.FlagSet) (*createPoolInputs, error) {
 
 	return pool, nil
 }
+
+func parseSplitRouteSwapFlags(fs *pflag.FlagSet) (*splitRouteSwapInputs, error) {
+	routes := &splitRouteSwapInputs{}
+	routesFile, _ := fs.GetString(FlagSplitRoutesFile)
+
+	if routesFile == "" {
+		return nil, fmt.Errorf("must pass in a routes json using the --%s flag", FlagSplitRoutesFile)
+	}
+
+	contents, err := os.ReadFile(routesFile)
+	if err != nil {
+		return nil, err
+	}
+
+	// make exception if unknown field exists
+	dec := json.NewDecoder(bytes.NewReader(contents))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(routes); err != nil {
+		return nil, err
+	}
+
+	return routes, nil
+}
@@ -13,6 +13,8 @@ import (
 	"gopkg.in/yaml.v2"
 
 	"github.com/osmosis-labs/osmosis/v12/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/pool-models/concentrated"
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/pool-models/orderbook"
 	"github.com/osmosis-labs/osmosis/v12/x/gamm/types"
 )
 
@@ -38,6 +40,10 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdEstimateSwapExactAmountIn(),
 		GetCmdEstimateSwapExactAmountOut(),
 		GetCmdTotalPoolLiquidity(),
+		GetCmdLimitOrder(),
+		GetCmdLimitOrders(),
+		GetCmdPosition(),
+		GetCmdPositions(),
 	)
 
 	return cmd
@@ -504,3 +510,197 @@ $ %s query gamm estimate-swap-exact-amount-out 1 osm11vmx8jtggpd9u7qr0t8vxclycz8
 
 	return cmd
 }
+
+// GetCmdLimitOrder returns a limit order of an orderbook pool.
+func GetCmdLimitOrder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "limit-order <poolID> <orderID>",
+		Short: "Query a limit order of an orderbook pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a limit order of an orderbook pool.
+Example:
+$ %s query gamm limit-order 1 5
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := orderbook.NewQueryClient(clientCtx)
+
+			poolID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			orderID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.LimitOrder(cmd.Context(), &orderbook.QueryLimitOrderRequest{
+				PoolId:  poolID,
+				OrderId: orderID,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdLimitOrders returns the limit orders of an orderbook pool.
+func GetCmdLimitOrders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "limit-orders <poolID>",
+		Short: "Query the limit orders of an orderbook pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the limit orders of an orderbook pool, optionally filtered by owner.
+Example:
+$ %s query gamm limit-orders 1 --owner osmo1...
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := orderbook.NewQueryClient(clientCtx)
+
+			poolID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			owner, err := cmd.Flags().GetString(FlagOwner)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.LimitOrders(cmd.Context(), &orderbook.QueryLimitOrdersRequest{
+				PoolId: poolID,
+				Owner:  owner,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetQueryLimitOrders())
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdPosition returns a position of a concentrated liquidity pool.
+func GetCmdPosition() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "position <poolID> <positionID>",
+		Short: "Query a position of a concentrated liquidity pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a position of a concentrated liquidity pool.
+Example:
+$ %s query gamm position 1 5
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := concentrated.NewQueryClient(clientCtx)
+
+			poolID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			positionID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Position(cmd.Context(), &concentrated.QueryPositionRequest{
+				PoolId:     poolID,
+				PositionId: positionID,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdPositions returns the positions of a concentrated liquidity pool.
+func GetCmdPositions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "positions <poolID>",
+		Short: "Query the positions of a concentrated liquidity pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the positions of a concentrated liquidity pool, optionally filtered by owner.
+Example:
+$ %s query gamm positions 1 --owner osmo1...
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := concentrated.NewQueryClient(clientCtx)
+
+			poolID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			owner, err := cmd.Flags().GetString(FlagOwner)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Positions(cmd.Context(), &concentrated.QueryPositionsRequest{
+				PoolId: poolID,
+				Owner:  owner,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetQueryPositions())
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -10,6 +10,8 @@ import (
 	flag "github.com/spf13/pflag"
 
 	"github.com/osmosis-labs/osmosis/v12/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/pool-models/concentrated"
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/pool-models/orderbook"
 	"github.com/osmosis-labs/osmosis/v12/x/gamm/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -33,10 +35,19 @@ func NewTxCmd() *cobra.Command {
 		NewExitPoolCmd(),
 		NewSwapExactAmountInCmd(),
 		NewSwapExactAmountOutCmd(),
+		NewSplitRouteSwapExactAmountInCmd(),
 		NewJoinSwapExternAmountIn(),
 		NewJoinSwapShareAmountOut(),
 		NewExitSwapExternAmountOut(),
 		NewExitSwapShareAmountIn(),
+		NewCreateOrderbookPoolCmd(),
+		NewPlaceLimitOrderCmd(),
+		NewCancelLimitOrderCmd(),
+		NewClaimLimitOrderCmd(),
+		NewCreateConcentratedPoolCmd(),
+		NewCreatePositionCmd(),
+		NewWithdrawPositionCmd(),
+		NewCollectFeesCmd(),
 	)
 
 	return txCmd
@@ -206,6 +217,44 @@ func NewSwapExactAmountOutCmd() *cobra.Command {
 	return cmd
 }
 
+func NewSplitRouteSwapExactAmountInCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "split-route-swap-exact-amount-in [token-in-denom] [token-out-min-amount]",
+		Short: "swap exact amounts in through several routes, with a minimum total amount out",
+		Long:  `Must provide path to a routes JSON file (--routes-file) describing the routes to swap through`,
+		Example: `Sample routes JSON file contents:
+{
+	"routes": [
+		{"pool-ids": [1, 2], "denoms": ["uosmo", "uatom"], "token-in-amount": "1000000"},
+		{"pool-ids": [3], "denoms": ["uatom"], "token-in-amount": "500000"}
+	]
+}
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			txf, msg, err := NewBuildSplitRouteSwapExactAmountInMsg(clientCtx, args[0], args[1], txf, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetSplitRouteSwap())
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(FlagSplitRoutesFile)
+
+	return cmd
+}
+
 func NewJoinSwapExternAmountIn() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "join-swap-extern-amount-in [token-in] [share-out-min-amount]",
@@ -322,6 +371,261 @@ func NewExitSwapShareAmountIn() *cobra.Command {
 	return cmd
 }
 
+func NewCreateOrderbookPoolCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "create-orderbook-pool [initial-deposit] [base-denom] [tick-size] [min-order-size] [swap-fee] [exit-fee]",
+		Short:   "create a new orderbook pool and provide the liquidity to it",
+		Long:    `The min-order-size is the minimum value of a limit order, denominated in the quote denom of the pool.`,
+		Example: "create-orderbook-pool 1000000uatom,10000000uosmo uatom 0.01 1000 0.002 0",
+		Args:    cobra.ExactArgs(6),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			txf, msg, err := NewBuildCreateOrderbookPoolMsg(clientCtx, args[0], args[1], args[2], args[3], args[4], args[5], txf, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetCreateOrderbookPool())
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewPlaceLimitOrderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "place-limit-order [pool-id] [tick] [token-in]",
+		Short: "place a limit order in an orderbook pool",
+		Long:  `Depositing the base denom of the pool places an ask, depositing the quote denom places a bid. The price of the order is tick * tick-size.`,
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			txf, msg, err := NewBuildPlaceLimitOrderMsg(clientCtx, args[0], args[1], args[2], txf, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewCancelLimitOrderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-limit-order [pool-id] [order-id]",
+		Short: "cancel a limit order, returning its unfilled deposit and unclaimed proceeds",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			poolId, orderId, err := parsePoolAndOrderIds(args[0], args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := &orderbook.MsgCancelLimitOrder{
+				Sender:  clientCtx.GetFromAddress().String(),
+				PoolId:  poolId,
+				OrderId: orderId,
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewClaimLimitOrderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-limit-order [pool-id] [order-id]",
+		Short: "claim the proceeds of the filled part of a limit order",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			poolId, orderId, err := parsePoolAndOrderIds(args[0], args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := &orderbook.MsgClaimLimitOrder{
+				Sender:  clientCtx.GetFromAddress().String(),
+				PoolId:  poolId,
+				OrderId: orderId,
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewCreateConcentratedPoolCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "create-concentrated-pool [initial-deposit] [tick-spacing] [swap-fee] [exit-fee]",
+		Short:   "create a new concentrated liquidity pool and provide full range liquidity to it",
+		Example: "create-concentrated-pool 1000000uatom,10000000uosmo 10 0.003 0",
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			txf, msg, err := NewBuildCreateConcentratedPoolMsg(clientCtx, args[0], args[1], args[2], args[3], txf, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetCreateConcentratedPool())
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewCreatePositionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "create-position [pool-id] [lower-tick] [upper-tick] [tokens-desired]",
+		Short:   "provide liquidity over a price range of a concentrated liquidity pool",
+		Long:    `Adds the largest liquidity that tokens-desired can provide over [lower-tick, upper-tick] at the current price of the pool. The price of tick i is 1.0001^i.`,
+		Example: "create-position 1 22000 24000 1000000uatom,10000000uosmo --tokens-min 900000uatom",
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			txf, msg, err := NewBuildCreatePositionMsg(clientCtx, args[0], args[1], args[2], args[3], txf, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetCreatePosition())
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewWithdrawPositionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-position [pool-id] [position-id] [liquidity]",
+		Short: "withdraw liquidity from a position, along with its uncollected fees",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			poolId, positionId, err := parsePoolAndOrderIds(args[0], args[1])
+			if err != nil {
+				return err
+			}
+
+			liquidity, err := sdk.NewDecFromStr(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := &concentrated.MsgWithdrawPosition{
+				Sender:     clientCtx.GetFromAddress().String(),
+				PoolId:     poolId,
+				PositionId: positionId,
+				Liquidity:  liquidity,
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewCollectFeesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "collect-fees [pool-id] [position-id]",
+		Short: "collect the uncollected fees of a position",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			poolId, positionId, err := parsePoolAndOrderIds(args[0], args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := &concentrated.MsgCollectFees{
+				Sender:     clientCtx.GetFromAddress().String(),
+				PoolId:     poolId,
+				PositionId: positionId,
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func NewBuildCreateBalancerPoolMsg(clientCtx client.Context, txf tx.Factory, fs *flag.FlagSet) (tx.Factory, sdk.Msg, error) {
 	pool, err := parseCreatePoolFlags(fs)
 	if err != nil {
@@ -584,6 +888,52 @@ func NewBuildSwapExactAmountInMsg(clientCtx client.Context, tokenInStr, tokenOut
 	return txf, msg, nil
 }
 
+func NewBuildSplitRouteSwapExactAmountInMsg(clientCtx client.Context, tokenInDenom, tokenOutMinAmtStr string, txf tx.Factory, fs *flag.FlagSet) (tx.Factory, sdk.Msg, error) {
+	inputs, err := parseSplitRouteSwapFlags(fs)
+	if err != nil {
+		return txf, nil, fmt.Errorf("failed to parse routes: %w", err)
+	}
+
+	routes := []types.SwapAmountInSplitRoute{}
+	for _, route := range inputs.Routes {
+		if len(route.PoolIds) != len(route.Denoms) {
+			return txf, nil, errors.New("swap route pool ids and denoms mismatch")
+		}
+
+		tokenInAmount, ok := sdk.NewIntFromString(route.TokenInAmount)
+		if !ok {
+			return txf, nil, fmt.Errorf("invalid token in amount, %s", route.TokenInAmount)
+		}
+
+		pools := []types.SwapAmountInRoute{}
+		for index, poolId := range route.PoolIds {
+			pools = append(pools, types.SwapAmountInRoute{
+				PoolId:        poolId,
+				TokenOutDenom: route.Denoms[index],
+			})
+		}
+
+		routes = append(routes, types.SwapAmountInSplitRoute{
+			Pools:         pools,
+			TokenInAmount: tokenInAmount,
+		})
+	}
+
+	tokenOutMinAmt, ok := sdk.NewIntFromString(tokenOutMinAmtStr)
+	if !ok {
+		return txf, nil, fmt.Errorf("invalid token out min amount, %s", tokenOutMinAmtStr)
+	}
+
+	msg := &types.MsgSplitRouteSwapExactAmountIn{
+		Sender:            clientCtx.GetFromAddress().String(),
+		Routes:            routes,
+		TokenInDenom:      tokenInDenom,
+		TokenOutMinAmount: tokenOutMinAmt,
+	}
+
+	return txf, msg, nil
+}
+
 func NewBuildSwapExactAmountOutMsg(clientCtx client.Context, tokenOutStr, tokenInMaxAmountStr string, txf tx.Factory, fs *flag.FlagSet) (tx.Factory, sdk.Msg, error) {
 	routes, err := swapAmountOutRoutes(fs)
 	if err != nil {
@@ -713,3 +1063,166 @@ func NewBuildExitSwapShareAmountInMsg(clientCtx client.Context, tokenOutDenom, s
 
 	return txf, msg, nil
 }
+
+func NewBuildCreateOrderbookPoolMsg(clientCtx client.Context, initialDepositStr, baseDenom, tickSizeStr, minOrderSizeStr, swapFeeStr, exitFeeStr string, txf tx.Factory, fs *flag.FlagSet) (tx.Factory, sdk.Msg, error) {
+	initialDeposit, err := sdk.ParseCoinsNormalized(initialDepositStr)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	tickSize, err := sdk.NewDecFromStr(tickSizeStr)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	minOrderSize, ok := sdk.NewIntFromString(minOrderSizeStr)
+	if !ok {
+		return txf, nil, fmt.Errorf("invalid min order size: %s", minOrderSizeStr)
+	}
+
+	swapFee, err := sdk.NewDecFromStr(swapFeeStr)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	exitFee, err := sdk.NewDecFromStr(exitFeeStr)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	futureGovernor, err := fs.GetString(FlagFutureGovernor)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	poolParams := orderbook.PoolParams{
+		SwapFee:      swapFee,
+		ExitFee:      exitFee,
+		TickSize:     tickSize,
+		MinOrderSize: minOrderSize,
+	}
+
+	msg := orderbook.NewMsgCreateOrderbookPool(clientCtx.GetFromAddress(), poolParams, initialDeposit, baseDenom, futureGovernor)
+
+	return txf, &msg, nil
+}
+
+func NewBuildPlaceLimitOrderMsg(clientCtx client.Context, poolIdStr, tickStr, tokenInStr string, txf tx.Factory, fs *flag.FlagSet) (tx.Factory, sdk.Msg, error) {
+	poolId, err := strconv.ParseUint(poolIdStr, 10, 64)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	tick, err := strconv.ParseInt(tickStr, 10, 64)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	tokenIn, err := sdk.ParseCoinNormalized(tokenInStr)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	msg := &orderbook.MsgPlaceLimitOrder{
+		Sender:  clientCtx.GetFromAddress().String(),
+		PoolId:  poolId,
+		Tick:    tick,
+		TokenIn: tokenIn,
+	}
+
+	return txf, msg, nil
+}
+
+func NewBuildCreateConcentratedPoolMsg(clientCtx client.Context, initialDepositStr, tickSpacingStr, swapFeeStr, exitFeeStr string, txf tx.Factory, fs *flag.FlagSet) (tx.Factory, sdk.Msg, error) {
+	initialDeposit, err := sdk.ParseCoinsNormalized(initialDepositStr)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	tickSpacing, err := strconv.ParseUint(tickSpacingStr, 10, 64)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	swapFee, err := sdk.NewDecFromStr(swapFeeStr)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	exitFee, err := sdk.NewDecFromStr(exitFeeStr)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	futureGovernor, err := fs.GetString(FlagFutureGovernor)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	poolParams := concentrated.PoolParams{
+		SwapFee:     swapFee,
+		ExitFee:     exitFee,
+		TickSpacing: tickSpacing,
+	}
+
+	msg := concentrated.NewMsgCreateConcentratedPool(clientCtx.GetFromAddress(), poolParams, initialDeposit, futureGovernor)
+
+	return txf, &msg, nil
+}
+
+func NewBuildCreatePositionMsg(clientCtx client.Context, poolIdStr, lowerTickStr, upperTickStr, tokensDesiredStr string, txf tx.Factory, fs *flag.FlagSet) (tx.Factory, sdk.Msg, error) {
+	poolId, err := strconv.ParseUint(poolIdStr, 10, 64)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	lowerTick, err := strconv.ParseInt(lowerTickStr, 10, 64)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	upperTick, err := strconv.ParseInt(upperTickStr, 10, 64)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	tokensDesired, err := sdk.ParseCoinsNormalized(tokensDesiredStr)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	tokensMinStr, err := fs.GetString(FlagTokensMin)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	tokensMin, err := sdk.ParseCoinsNormalized(tokensMinStr)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	msg := &concentrated.MsgCreatePosition{
+		Sender:        clientCtx.GetFromAddress().String(),
+		PoolId:        poolId,
+		LowerTick:     lowerTick,
+		UpperTick:     upperTick,
+		TokensDesired: tokensDesired,
+		TokensMin:     tokensMin,
+	}
+
+	return txf, msg, nil
+}
+
+func parsePoolAndOrderIds(poolIdStr, orderIdStr string) (poolId uint64, orderId uint64, err error) {
+	poolId, err = strconv.ParseUint(poolIdStr, 10, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	orderId, err = strconv.ParseUint(orderIdStr, 10, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return poolId, orderId, nil
+}
@@ -0,0 +1,194 @@
+package keeper
+
+import (
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/keeper/internal/events"
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/pool-models/concentrated"
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/types"
+)
+
+// positionStore holds the positions of a concentrated liquidity pool, kept in the gamm store apart from the pool.
+type positionStore struct {
+	store  sdk.KVStore
+	cdc    codec.BinaryCodec
+	poolId uint64
+}
+
+var _ concentrated.PositionStore = positionStore{}
+
+func (k Keeper) positionStore(ctx sdk.Context, poolId uint64) positionStore {
+	return positionStore{store: ctx.KVStore(k.storeKey), cdc: k.cdc, poolId: poolId}
+}
+
+func (s positionStore) GetPosition(positionId uint64) (concentrated.Position, bool) {
+	bz := s.store.Get(types.GetKeyPosition(s.poolId, positionId))
+	if bz == nil {
+		return concentrated.Position{}, false
+	}
+	position := concentrated.Position{}
+	s.cdc.MustUnmarshal(bz, &position)
+	return position, true
+}
+
+func (s positionStore) SetPosition(position concentrated.Position) {
+	s.store.Set(types.GetKeyPosition(s.poolId, position.PositionId), s.cdc.MustMarshal(&position))
+}
+
+func (s positionStore) DeletePosition(positionId uint64) {
+	s.store.Delete(types.GetKeyPosition(s.poolId, positionId))
+}
+
+func (s positionStore) IteratePositions(cb func(position concentrated.Position) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(s.store, types.GetKeyPrefixPositions(s.poolId))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		position := concentrated.Position{}
+		s.cdc.MustUnmarshal(iter.Value(), &position)
+		if cb(position) {
+			return
+		}
+	}
+}
+
+// initPositionsGenesis moves the positions of a concentrated liquidity pool imported from genesis to the store.
+func (k Keeper) initPositionsGenesis(ctx sdk.Context, pool *concentrated.Pool) {
+	positions := k.positionStore(ctx, pool.Id)
+	sort.Slice(pool.Positions, func(i, j int) bool { return pool.Positions[i].PositionId < pool.Positions[j].PositionId })
+	for _, position := range pool.Positions {
+		positions.SetPosition(position)
+	}
+	pool.Positions = nil
+}
+
+// exportPositionsGenesis sets the positions of a concentrated liquidity pool from the store, to export it in genesis.
+// Positions are exported as stored, without accruing their fees.
+func (k Keeper) exportPositionsGenesis(ctx sdk.Context, pool *concentrated.Pool) {
+	pool.Positions = []concentrated.Position{}
+	k.positionStore(ctx, pool.Id).IteratePositions(func(position concentrated.Position) bool {
+		pool.Positions = append(pool.Positions, position)
+		return false
+	})
+}
+
+// getConcentratedPool returns the concentrated liquidity pool with the given id,
+// and errors if it does not exist or is of another pool type.
+func (k Keeper) getConcentratedPool(ctx sdk.Context, poolId uint64) (*concentrated.Pool, error) {
+	pool, err := k.GetPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+
+	concentratedPool, ok := pool.(*concentrated.Pool)
+	if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrNotConcentratedPool, "pool %d is of type %T", poolId, pool)
+	}
+	return concentratedPool, nil
+}
+
+// CreatePosition provides liquidity over the range [lowerTick, upperTick] of a concentrated liquidity pool,
+// depositing at most tokensDesired and at least tokensMin from the sender.
+// Returns the id of the created position, its liquidity and the deposited tokens.
+func (k Keeper) CreatePosition(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, lowerTick, upperTick int64, tokensDesired, tokensMin sdk.Coins) (uint64, sdk.Dec, sdk.Coins, error) {
+	pool, err := k.getConcentratedPool(ctx, poolId)
+	if err != nil {
+		return 0, sdk.Dec{}, sdk.Coins{}, err
+	}
+
+	position, tokensIn, err := pool.CreatePosition(k.positionStore(ctx, poolId), sender, lowerTick, upperTick, tokensDesired, tokensMin)
+	if err != nil {
+		return 0, sdk.Dec{}, sdk.Coins{}, err
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, sender, pool.GetAddress(), tokensIn); err != nil {
+		return 0, sdk.Dec{}, sdk.Coins{}, err
+	}
+
+	if err := k.setPool(ctx, pool); err != nil {
+		return 0, sdk.Dec{}, sdk.Coins{}, err
+	}
+
+	events.EmitPositionCreatedEvent(ctx, sender, poolId, position.PositionId, lowerTick, upperTick, position.Liquidity, tokensIn)
+	k.RecordTotalLiquidityIncrease(ctx, tokensIn)
+	// positions do not mint LP shares.
+	k.hooks.AfterJoinPool(ctx, sender, poolId, tokensIn, sdk.ZeroInt())
+
+	return position.PositionId, position.Liquidity, tokensIn, nil
+}
+
+// WithdrawPosition withdraws liquidity from a position of a concentrated liquidity pool,
+// and sends the withdrawn tokens along with the uncollected fees of the position to its owner.
+func (k Keeper) WithdrawPosition(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, positionId uint64, liquidity sdk.Dec) (sdk.Coins, error) {
+	pool, err := k.getConcentratedPool(ctx, poolId)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+
+	tokensOut, err := pool.WithdrawPosition(k.positionStore(ctx, poolId), sender, positionId, liquidity)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, pool.GetAddress(), sender, tokensOut); err != nil {
+		return sdk.Coins{}, err
+	}
+
+	if err := k.setPool(ctx, pool); err != nil {
+		return sdk.Coins{}, err
+	}
+
+	events.EmitPositionWithdrawnEvent(ctx, sender, poolId, positionId, liquidity, tokensOut)
+	k.RecordTotalLiquidityDecrease(ctx, tokensOut)
+	k.hooks.AfterExitPool(ctx, sender, poolId, sdk.ZeroInt(), tokensOut)
+
+	return tokensOut, nil
+}
+
+// CollectFees sends the uncollected fees of a position of a concentrated liquidity pool to its owner.
+func (k Keeper) CollectFees(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, positionId uint64) (sdk.Coins, error) {
+	pool, err := k.getConcentratedPool(ctx, poolId)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+
+	tokensOut, err := pool.CollectFees(k.positionStore(ctx, poolId), sender, positionId)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, pool.GetAddress(), sender, tokensOut); err != nil {
+		return sdk.Coins{}, err
+	}
+
+	if err := k.setPool(ctx, pool); err != nil {
+		return sdk.Coins{}, err
+	}
+
+	events.EmitFeesCollectedEvent(ctx, sender, poolId, positionId, tokensOut)
+	k.RecordTotalLiquidityDecrease(ctx, tokensOut)
+
+	return tokensOut, nil
+}
+
+// GetPosition returns a position of a concentrated liquidity pool.
+func (k Keeper) GetPosition(ctx sdk.Context, poolId uint64, positionId uint64) (concentrated.Position, error) {
+	pool, err := k.getConcentratedPool(ctx, poolId)
+	if err != nil {
+		return concentrated.Position{}, err
+	}
+	return pool.GetPosition(k.positionStore(ctx, poolId), positionId)
+}
+
+// GetPositions returns the positions of a concentrated liquidity pool.
+// If owner is not empty, only the positions of owner are returned.
+func (k Keeper) GetPositions(ctx sdk.Context, poolId uint64, owner string) ([]concentrated.Position, error) {
+	pool, err := k.getConcentratedPool(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+	return pool.GetPositions(k.positionStore(ctx, poolId), owner), nil
+}
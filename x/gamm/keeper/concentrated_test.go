@@ -0,0 +1,253 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/pool-models/concentrated"
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/types"
+)
+
+// prepareConcentratedPool creates a concentrated liquidity pool of uatom against uosmo, with a spot price of 10 uosmo per uatom,
+// and funds the test accounts.
+func (suite *KeeperTestSuite) prepareConcentratedPool() uint64 {
+	for _, acc := range suite.TestAccs {
+		suite.FundAcc(acc, sdk.NewCoins(
+			sdk.NewInt64Coin("uatom", 10_000_000),
+			sdk.NewInt64Coin("uosmo", 10_000_000_000),
+		))
+	}
+
+	poolParams := concentrated.PoolParams{
+		SwapFee:     sdk.MustNewDecFromStr("0.003"),
+		ExitFee:     sdk.ZeroDec(),
+		TickSpacing: 100,
+	}
+	initialLiquidity := sdk.NewCoins(sdk.NewInt64Coin("uatom", 1_000_000), sdk.NewInt64Coin("uosmo", 10_000_000))
+	msg := concentrated.NewMsgCreateConcentratedPool(suite.TestAccs[0], poolParams, initialLiquidity, "")
+	poolId, err := suite.App.GAMMKeeper.CreatePool(suite.Ctx, msg)
+	suite.Require().NoError(err)
+
+	return poolId
+}
+
+func (suite *KeeperTestSuite) TestPositionLifecycle() {
+	suite.SetupTest()
+	keeper := suite.App.GAMMKeeper
+	poolId := suite.prepareConcentratedPool()
+	lp, trader := suite.TestAccs[1], suite.TestAccs[2]
+
+	pool, err := keeper.GetPoolAndPoke(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	poolAddr := pool.GetAddress()
+	poolBalance := suite.App.BankKeeper.GetAllBalances(suite.Ctx, poolAddr)
+
+	poolType, err := keeper.GetPoolType(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	suite.Require().Equal("Concentrated", poolType)
+
+	// the pool is recorded by the twap module on creation
+	_, err = suite.App.TwapKeeper.GetBeginBlockAccumulatorRecord(suite.Ctx, poolId, "uatom", "uosmo")
+	suite.Require().NoError(err)
+
+	// provide liquidity around the current price of 10
+	lpBalance := suite.App.BankKeeper.GetAllBalances(suite.Ctx, lp)
+	tokensDesired := sdk.NewCoins(sdk.NewInt64Coin("uatom", 1_000_000), sdk.NewInt64Coin("uosmo", 10_000_000))
+	positionId, liquidity, tokensIn, err := keeper.CreatePosition(suite.Ctx, lp, poolId, 22000, 24000, tokensDesired, sdk.Coins{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), positionId)
+	suite.Require().True(liquidity.IsPositive())
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("uatom", 948_685), sdk.NewInt64Coin("uosmo", 10_000_000)), tokensIn)
+	suite.Require().Equal(lpBalance.Sub(tokensIn), suite.App.BankKeeper.GetAllBalances(suite.Ctx, lp))
+	suite.Require().Equal(poolBalance.Add(tokensIn...), suite.App.BankKeeper.GetAllBalances(suite.Ctx, poolAddr))
+
+	// the minimum amounts are enforced
+	_, _, _, err = keeper.CreatePosition(suite.Ctx, lp, poolId, 22000, 24000, tokensDesired, tokensDesired)
+	suite.Require().ErrorIs(err, types.ErrLimitMinAmount)
+
+	// swap back and forth within the range of the position, which earns most of the fees
+	_, err = keeper.SwapExactAmountIn(suite.Ctx, trader, poolId, sdk.NewInt64Coin("uatom", 10_000), "uosmo", sdk.OneInt())
+	suite.Require().NoError(err)
+	_, err = keeper.SwapExactAmountIn(suite.Ctx, trader, poolId, sdk.NewInt64Coin("uosmo", 100_000), "uatom", sdk.OneInt())
+	suite.Require().NoError(err)
+
+	position, err := keeper.GetPosition(suite.Ctx, poolId, positionId)
+	suite.Require().NoError(err)
+	suite.Require().True(position.TokensOwed.AmountOf("uatom").IsPositive())
+	suite.Require().True(position.TokensOwed.AmountOf("uosmo").IsPositive())
+
+	// only the owner can collect the fees
+	_, err = keeper.CollectFees(suite.Ctx, trader, poolId, positionId)
+	suite.Require().ErrorIs(err, types.ErrNotPositionOwner)
+	fees, err := keeper.CollectFees(suite.Ctx, lp, poolId, positionId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(position.TokensOwed.AmountOf("uatom").TruncateInt(), fees.AmountOf("uatom"))
+	suite.Require().Equal(position.TokensOwed.AmountOf("uosmo").TruncateInt(), fees.AmountOf("uosmo"))
+
+	// withdrawing all of the liquidity removes the position
+	lpBalance = suite.App.BankKeeper.GetAllBalances(suite.Ctx, lp)
+	tokensOut, err := keeper.WithdrawPosition(suite.Ctx, lp, poolId, positionId, liquidity)
+	suite.Require().NoError(err)
+	suite.Require().Equal(lpBalance.Add(tokensOut...), suite.App.BankKeeper.GetAllBalances(suite.Ctx, lp))
+	_, err = keeper.GetPosition(suite.Ctx, poolId, positionId)
+	suite.Require().ErrorIs(err, types.ErrPositionNotFound)
+
+	// the pool still holds enough to back its shares
+	pool, err = keeper.GetPoolAndPoke(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	poolBalance = suite.App.BankKeeper.GetAllBalances(suite.Ctx, poolAddr)
+	suite.Require().True(poolBalance.IsAllGTE(pool.GetTotalPoolLiquidity(suite.Ctx)))
+}
+
+func (suite *KeeperTestSuite) TestPositionHooks() {
+	suite.SetupTest()
+	keeper := suite.App.GAMMKeeper
+	poolId := suite.prepareConcentratedPool()
+	lp := suite.TestAccs[1]
+
+	// the twap module listens to joins and exits, and records the pool at the end of the block they happen in.
+	// Each step runs in a new block, so that the pool is not tracked as changed by the previous ones.
+	hasTwapRecordAt := func(t time.Time) bool {
+		for _, record := range suite.App.TwapKeeper.ExportGenesis(suite.Ctx).Twaps {
+			if record.PoolId == poolId && record.Time.Equal(t) {
+				return true
+			}
+		}
+		return false
+	}
+
+	suite.Commit()
+	tokensDesired := sdk.NewCoins(sdk.NewInt64Coin("uatom", 1_000_000), sdk.NewInt64Coin("uosmo", 10_000_000))
+	positionId, liquidity, _, err := keeper.CreatePosition(suite.Ctx, lp, poolId, 22000, 24000, tokensDesired, sdk.Coins{})
+	suite.Require().NoError(err)
+	suite.App.TwapKeeper.EndBlock(suite.Ctx)
+	suite.Require().True(hasTwapRecordAt(suite.Ctx.BlockTime()))
+
+	suite.Commit()
+	_, err = keeper.WithdrawPosition(suite.Ctx, lp, poolId, positionId, liquidity)
+	suite.Require().NoError(err)
+	suite.App.TwapKeeper.EndBlock(suite.Ctx)
+	suite.Require().True(hasTwapRecordAt(suite.Ctx.BlockTime()))
+}
+
+func (suite *KeeperTestSuite) TestPositionsGenesis() {
+	suite.SetupTest()
+	keeper := suite.App.GAMMKeeper
+	poolId := suite.prepareConcentratedPool()
+
+	tokensDesired := sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000), sdk.NewInt64Coin("uosmo", 10_000))
+	_, _, _, err := keeper.CreatePosition(suite.Ctx, suite.TestAccs[0], poolId, 22000, 24000, tokensDesired, sdk.Coins{})
+	suite.Require().NoError(err)
+	_, _, _, err = keeper.CreatePosition(suite.Ctx, suite.TestAccs[1], poolId, 20000, 26000, tokensDesired, sdk.Coins{})
+	suite.Require().NoError(err)
+	positions, err := keeper.GetPositions(suite.Ctx, poolId, "")
+	suite.Require().NoError(err)
+	suite.Require().Len(positions, 2)
+
+	// the positions are exported within the pool, and are not part of the pool in state
+	genesis := keeper.ExportGenesis(suite.Ctx)
+	suite.Require().Len(genesis.Pools, 1)
+	var exported types.PoolI
+	suite.Require().NoError(suite.App.AppCodec().UnpackAny(genesis.Pools[0], &exported))
+	suite.Require().Equal(positions, exported.(*concentrated.Pool).Positions)
+	pool, err := keeper.GetPoolAndPoke(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	suite.Require().Empty(pool.(*concentrated.Pool).Positions)
+
+	suite.SetupTest()
+	keeper = suite.App.GAMMKeeper
+	keeper.InitGenesis(suite.Ctx, *genesis, suite.App.AppCodec())
+
+	importedPositions, err := keeper.GetPositions(suite.Ctx, poolId, "")
+	suite.Require().NoError(err)
+	suite.Require().Equal(positions, importedPositions)
+	pool, err = keeper.GetPoolAndPoke(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	suite.Require().Empty(pool.(*concentrated.Pool).Positions)
+	suite.Require().Equal(uint64(3), pool.(*concentrated.Pool).NextPositionId)
+}
+
+func (suite *KeeperTestSuite) TestConcentratedPoolJoinExit() {
+	suite.SetupTest()
+	keeper := suite.App.GAMMKeeper
+	poolId := suite.prepareConcentratedPool()
+	joiner := suite.TestAccs[1]
+
+	// join for 10% of the shares
+	shareOutAmount := types.InitPoolSharesSupply.QuoRaw(10)
+	tokensIn, sharesOut, err := keeper.JoinPoolNoSwap(suite.Ctx, joiner, poolId, shareOutAmount, sdk.Coins{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(shareOutAmount, sharesOut)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("uatom", 100_000), sdk.NewInt64Coin("uosmo", 1_000_000)), tokensIn)
+
+	// exiting the shares returns the tokens that were put in, rounded down in favor of the pool
+	tokensOut, err := keeper.ExitPool(suite.Ctx, joiner, poolId, shareOutAmount, sdk.Coins{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("uatom", 99_999), sdk.NewInt64Coin("uosmo", 999_999)), tokensOut)
+
+	// single asset joins are not supported
+	_, err = keeper.JoinSwapExactAmountIn(suite.Ctx, joiner, poolId, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000)), sdk.OneInt())
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestConcentratedPoolMultihop() {
+	suite.SetupTest()
+	keeper := suite.App.GAMMKeeper
+	concentratedPoolId := suite.prepareConcentratedPool()
+	balancerPoolId := suite.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin("uosmo", 10_000_000), sdk.NewInt64Coin("foo", 10_000_000))
+
+	routes := []types.SwapAmountInRoute{
+		{PoolId: concentratedPoolId, TokenOutDenom: "uosmo"},
+		{PoolId: balancerPoolId, TokenOutDenom: "foo"},
+	}
+	tokenIn := sdk.NewInt64Coin("uatom", 1000)
+
+	fooBalance := suite.App.BankKeeper.GetBalance(suite.Ctx, suite.TestAccs[2], "foo")
+	tokenOut, err := keeper.MultihopSwapExactAmountIn(suite.Ctx, suite.TestAccs[2], routes, tokenIn, sdk.OneInt())
+	suite.Require().NoError(err)
+	suite.Require().True(tokenOut.IsPositive())
+	suite.Require().Equal(fooBalance.Amount.Add(tokenOut), suite.App.BankKeeper.GetBalance(suite.Ctx, suite.TestAccs[2], "foo").Amount)
+}
+
+func (suite *KeeperTestSuite) TestCreatePositionNotConcentratedPool() {
+	suite.SetupTest()
+	poolId := suite.PrepareBalancerPool()
+
+	_, _, _, err := suite.App.GAMMKeeper.CreatePosition(suite.Ctx, suite.TestAccs[0], poolId, -100, 100, sdk.NewCoins(sdk.NewInt64Coin("foo", 10)), sdk.Coins{})
+	suite.Require().ErrorIs(err, types.ErrNotConcentratedPool)
+}
+
+func (suite *KeeperTestSuite) TestQueryPositions() {
+	suite.SetupTest()
+	queryClient := concentrated.NewQueryClient(suite.QueryHelper)
+	poolId := suite.prepareConcentratedPool()
+
+	tokensDesired := sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000), sdk.NewInt64Coin("uosmo", 10_000))
+	_, _, _, err := suite.App.GAMMKeeper.CreatePosition(suite.Ctx, suite.TestAccs[0], poolId, 22000, 24000, tokensDesired, sdk.Coins{})
+	suite.Require().NoError(err)
+	_, _, _, err = suite.App.GAMMKeeper.CreatePosition(suite.Ctx, suite.TestAccs[1], poolId, 20000, 26000, tokensDesired, sdk.Coins{})
+	suite.Require().NoError(err)
+
+	positionRes, err := queryClient.Position(suite.Ctx.Context(), &concentrated.QueryPositionRequest{PoolId: poolId, PositionId: 2})
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.TestAccs[1].String(), positionRes.Position.Owner)
+	suite.Require().Equal(int64(20000), positionRes.Position.LowerTick)
+
+	_, err = queryClient.Position(suite.Ctx.Context(), &concentrated.QueryPositionRequest{PoolId: poolId, PositionId: 3})
+	suite.Require().Error(err)
+
+	positionsRes, err := queryClient.Positions(suite.Ctx.Context(), &concentrated.QueryPositionsRequest{PoolId: poolId})
+	suite.Require().NoError(err)
+	suite.Require().Len(positionsRes.Positions, 2)
+
+	positionsRes, err = queryClient.Positions(suite.Ctx.Context(), &concentrated.QueryPositionsRequest{PoolId: poolId, Owner: suite.TestAccs[0].String()})
+	suite.Require().NoError(err)
+	suite.Require().Len(positionsRes.Positions, 1)
+	suite.Require().Equal(uint64(1), positionsRes.Positions[0].PositionId)
+
+	// concentrated liquidity pools are returned by the pools query
+	poolsRes, err := suite.queryClient.Pools(suite.Ctx.Context(), &types.QueryPoolsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(poolsRes.Pools, 1)
+}
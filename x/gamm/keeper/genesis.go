@@ -4,6 +4,8 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/pool-models/concentrated"
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/pool-models/orderbook"
 	"github.com/osmosis-labs/osmosis/v12/x/gamm/types"
 )
 
@@ -22,6 +24,13 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState, unpack
 		if err != nil {
 			panic(err)
 		}
+		// the limit orders of orderbook pools are stored apart from the pool, and are part of the total liquidity.
+		if orderbookPool, ok := pool.(*orderbook.Pool); ok {
+			liquidity = liquidity.Add(k.initLimitOrdersGenesis(ctx, orderbookPool)...)
+		}
+		if concentratedPool, ok := pool.(*concentrated.Pool); ok {
+			k.initPositionsGenesis(ctx, concentratedPool)
+		}
 		err = k.setPool(ctx, pool)
 		if err != nil {
 			panic(err)
@@ -44,6 +53,12 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	}
 	poolAnys := []*codectypes.Any{}
 	for _, poolI := range pools {
+		if orderbookPool, ok := poolI.(*orderbook.Pool); ok {
+			k.exportLimitOrdersGenesis(ctx, orderbookPool)
+		}
+		if concentratedPool, ok := poolI.(*concentrated.Pool); ok {
+			k.exportPositionsGenesis(ctx, concentratedPool)
+		}
 		any, err := codectypes.NewAnyWithValue(poolI)
 		if err != nil {
 			panic(err)
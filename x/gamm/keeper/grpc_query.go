@@ -15,6 +15,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/osmosis-labs/osmosis/v12/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/pool-models/concentrated"
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/pool-models/orderbook"
 	"github.com/osmosis-labs/osmosis/v12/x/gamm/types"
 )
 
@@ -32,7 +34,11 @@ func init() {
 	sdkIntMaxValue = _sdkIntMaxValue
 }
 
-var _ types.QueryServer = Querier{}
+var (
+	_ types.QueryServer        = Querier{}
+	_ orderbook.QueryServer    = Querier{}
+	_ concentrated.QueryServer = Querier{}
+)
 
 // Querier defines a wrapper around the x/gamm keeper providing gRPC method
 // handlers.
@@ -94,13 +100,7 @@ func (q Querier) Pools(
 			return err
 		}
 
-		// TODO: pools query should not be balancer specific
-		pool, ok := poolI.(*balancer.Pool)
-		if !ok {
-			return fmt.Errorf("pool (%d) is not basic pool", pool.GetId())
-		}
-
-		any, err := codectypes.NewAnyWithValue(pool)
+		any, err := codectypes.NewAnyWithValue(poolI)
 		if err != nil {
 			return err
 		}
@@ -164,6 +164,26 @@ func (q Querier) PoolParams(ctx context.Context, req *types.QueryPoolParamsReque
 			Params: any,
 		}, nil
 
+	case *orderbook.Pool:
+		any, err := codectypes.NewAnyWithValue(&pool.PoolParams)
+		if err != nil {
+			return nil, err
+		}
+
+		return &types.QueryPoolParamsResponse{
+			Params: any,
+		}, nil
+
+	case *concentrated.Pool:
+		any, err := codectypes.NewAnyWithValue(&pool.PoolParams)
+		if err != nil {
+			return nil, err
+		}
+
+		return &types.QueryPoolParamsResponse{
+			Params: any,
+		}, nil
+
 	default:
 		errMsg := fmt.Sprintf("unrecognized %s pool type: %T", types.ModuleName, pool)
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnpackAny, errMsg)
@@ -322,3 +342,75 @@ func (q Querier) EstimateSwapExactAmountOut(ctx context.Context, req *types.Quer
 		TokenInAmount: tokenInAmount,
 	}, nil
 }
+
+// LimitOrder returns a limit order of an orderbook pool.
+func (q Querier) LimitOrder(ctx context.Context, req *orderbook.QueryLimitOrderRequest) (*orderbook.QueryLimitOrderResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	order, err := q.Keeper.GetLimitOrder(sdkCtx, req.PoolId, req.OrderId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &orderbook.QueryLimitOrderResponse{Order: order}, nil
+}
+
+// LimitOrders returns the limit orders of an orderbook pool, optionally filtered by owner.
+func (q Querier) LimitOrders(ctx context.Context, req *orderbook.QueryLimitOrdersRequest) (*orderbook.QueryLimitOrdersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Owner != "" {
+		if _, err := sdk.AccAddressFromBech32(req.Owner); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	orders, err := q.Keeper.GetLimitOrders(sdkCtx, req.PoolId, req.Owner)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &orderbook.QueryLimitOrdersResponse{Orders: orders}, nil
+}
+
+// Position returns a position of a concentrated liquidity pool.
+func (q Querier) Position(ctx context.Context, req *concentrated.QueryPositionRequest) (*concentrated.QueryPositionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	position, err := q.Keeper.GetPosition(sdkCtx, req.PoolId, req.PositionId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &concentrated.QueryPositionResponse{Position: position}, nil
+}
+
+// Positions returns the positions of a concentrated liquidity pool, optionally filtered by owner.
+func (q Querier) Positions(ctx context.Context, req *concentrated.QueryPositionsRequest) (*concentrated.QueryPositionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Owner != "" {
+		if _, err := sdk.AccAddressFromBech32(req.Owner); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	positions, err := q.Keeper.GetPositions(sdkCtx, req.PoolId, req.Owner)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &concentrated.QueryPositionsResponse{Positions: positions}, nil
+}
@@ -2,6 +2,7 @@ package events
 
 import (
 	"strconv"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -29,6 +30,33 @@ func newSwapEvent(sender sdk.AccAddress, poolId uint64, input sdk.Coins, output
 	)
 }
 
+func EmitSplitRouteSwapEvent(ctx sdk.Context, sender sdk.AccAddress, routeIndex int, poolIds []uint64, input sdk.Coins, output sdk.Coins) {
+	if ctx.EventManager() == nil {
+		return
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		newSplitRouteSwapEvent(sender, routeIndex, poolIds, input, output),
+	})
+}
+
+func newSplitRouteSwapEvent(sender sdk.AccAddress, routeIndex int, poolIds []uint64, input sdk.Coins, output sdk.Coins) sdk.Event {
+	poolIdStrs := make([]string, 0, len(poolIds))
+	for _, poolId := range poolIds {
+		poolIdStrs = append(poolIdStrs, strconv.FormatUint(poolId, 10))
+	}
+
+	return sdk.NewEvent(
+		types.TypeEvtSplitRouteSwapped,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		sdk.NewAttribute(types.AttributeKeyRoute, strconv.Itoa(routeIndex)),
+		sdk.NewAttribute(types.AttributeKeyPoolIds, strings.Join(poolIdStrs, ",")),
+		sdk.NewAttribute(types.AttributeKeyTokensIn, input.String()),
+		sdk.NewAttribute(types.AttributeKeyTokensOut, output.String()),
+	)
+}
+
 func EmitAddLiquidityEvent(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, liquidity sdk.Coins) {
 	if ctx.EventManager() == nil {
 		return
@@ -68,3 +96,123 @@ func newRemoveLiquidityEvent(sender sdk.AccAddress, poolId uint64, liquidity sdk
 		sdk.NewAttribute(types.AttributeKeyTokensOut, liquidity.String()),
 	)
 }
+
+func EmitLimitOrderPlacedEvent(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, orderId uint64, tick int64, tokenIn sdk.Coin) {
+	if ctx.EventManager() == nil {
+		return
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		newLimitOrderPlacedEvent(sender, poolId, orderId, tick, tokenIn),
+	})
+}
+
+func newLimitOrderPlacedEvent(sender sdk.AccAddress, poolId uint64, orderId uint64, tick int64, tokenIn sdk.Coin) sdk.Event {
+	return sdk.NewEvent(
+		types.TypeEvtLimitOrderPlaced,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+		sdk.NewAttribute(types.AttributeKeyOrderId, strconv.FormatUint(orderId, 10)),
+		sdk.NewAttribute(types.AttributeKeyTick, strconv.FormatInt(tick, 10)),
+		sdk.NewAttribute(types.AttributeKeyTokensIn, tokenIn.String()),
+	)
+}
+
+func EmitLimitOrderCancelledEvent(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, orderId uint64, tokensOut sdk.Coins) {
+	if ctx.EventManager() == nil {
+		return
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		newLimitOrderOutEvent(types.TypeEvtLimitOrderCancelled, sender, poolId, orderId, tokensOut),
+	})
+}
+
+func EmitLimitOrderClaimedEvent(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, orderId uint64, tokensOut sdk.Coins) {
+	if ctx.EventManager() == nil {
+		return
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		newLimitOrderOutEvent(types.TypeEvtLimitOrderClaimed, sender, poolId, orderId, tokensOut),
+	})
+}
+
+func newLimitOrderOutEvent(eventType string, sender sdk.AccAddress, poolId uint64, orderId uint64, tokensOut sdk.Coins) sdk.Event {
+	return sdk.NewEvent(
+		eventType,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+		sdk.NewAttribute(types.AttributeKeyOrderId, strconv.FormatUint(orderId, 10)),
+		sdk.NewAttribute(types.AttributeKeyTokensOut, tokensOut.String()),
+	)
+}
+
+func EmitPositionCreatedEvent(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, positionId uint64, lowerTick, upperTick int64, liquidity sdk.Dec, tokensIn sdk.Coins) {
+	if ctx.EventManager() == nil {
+		return
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		newPositionCreatedEvent(sender, poolId, positionId, lowerTick, upperTick, liquidity, tokensIn),
+	})
+}
+
+func newPositionCreatedEvent(sender sdk.AccAddress, poolId uint64, positionId uint64, lowerTick, upperTick int64, liquidity sdk.Dec, tokensIn sdk.Coins) sdk.Event {
+	return sdk.NewEvent(
+		types.TypeEvtPositionCreated,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+		sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(positionId, 10)),
+		sdk.NewAttribute(types.AttributeKeyLowerTick, strconv.FormatInt(lowerTick, 10)),
+		sdk.NewAttribute(types.AttributeKeyUpperTick, strconv.FormatInt(upperTick, 10)),
+		sdk.NewAttribute(types.AttributeKeyLiquidity, liquidity.String()),
+		sdk.NewAttribute(types.AttributeKeyTokensIn, tokensIn.String()),
+	)
+}
+
+func EmitPositionWithdrawnEvent(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, positionId uint64, liquidity sdk.Dec, tokensOut sdk.Coins) {
+	if ctx.EventManager() == nil {
+		return
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		newPositionWithdrawnEvent(sender, poolId, positionId, liquidity, tokensOut),
+	})
+}
+
+func newPositionWithdrawnEvent(sender sdk.AccAddress, poolId uint64, positionId uint64, liquidity sdk.Dec, tokensOut sdk.Coins) sdk.Event {
+	return sdk.NewEvent(
+		types.TypeEvtPositionWithdrawn,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+		sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(positionId, 10)),
+		sdk.NewAttribute(types.AttributeKeyLiquidity, liquidity.String()),
+		sdk.NewAttribute(types.AttributeKeyTokensOut, tokensOut.String()),
+	)
+}
+
+func EmitFeesCollectedEvent(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, positionId uint64, tokensOut sdk.Coins) {
+	if ctx.EventManager() == nil {
+		return
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		newFeesCollectedEvent(sender, poolId, positionId, tokensOut),
+	})
+}
+
+func newFeesCollectedEvent(sender sdk.AccAddress, poolId uint64, positionId uint64, tokensOut sdk.Coins) sdk.Event {
+	return sdk.NewEvent(
+		types.TypeEvtFeesCollected,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+		sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(positionId, 10)),
+		sdk.NewAttribute(types.AttributeKeyTokensOut, tokensOut.String()),
+	)
+}
@@ -86,6 +86,71 @@ func (suite *GammEventsTestSuite) TestEmitSwapEvent() {
 	}
 }
 
+func (suite *GammEventsTestSuite) TestEmitSplitRouteSwapEvent() {
+	testcases := map[string]struct {
+		ctx               sdk.Context
+		testAccountAddr   sdk.AccAddress
+		routeIndex        int
+		poolIds           []uint64
+		tokensIn          sdk.Coins
+		tokensOut         sdk.Coins
+		expectedPoolIdStr string
+	}{
+		"basic valid": {
+			ctx:               suite.CreateTestContext(),
+			testAccountAddr:   sdk.AccAddress([]byte(addressString)),
+			routeIndex:        0,
+			poolIds:           []uint64{1},
+			tokensIn:          sdk.NewCoins(sdk.NewCoin(testDenomA, sdk.NewInt(1234))),
+			tokensOut:         sdk.NewCoins(sdk.NewCoin(testDenomB, sdk.NewInt(5678))),
+			expectedPoolIdStr: "1",
+		},
+		"context with no event manager": {
+			ctx: sdk.Context{},
+		},
+		"valid with multiple pools": {
+			ctx:               suite.CreateTestContext(),
+			testAccountAddr:   sdk.AccAddress([]byte(addressString)),
+			routeIndex:        3,
+			poolIds:           []uint64{200, 4, 17},
+			tokensIn:          sdk.NewCoins(sdk.NewCoin(testDenomA, sdk.NewInt(12))),
+			tokensOut:         sdk.NewCoins(sdk.NewCoin(testDenomD, sdk.NewInt(34))),
+			expectedPoolIdStr: "200,4,17",
+		},
+	}
+
+	for name, tc := range testcases {
+		suite.Run(name, func() {
+			expectedEvents := sdk.Events{
+				sdk.NewEvent(
+					types.TypeEvtSplitRouteSwapped,
+					sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+					sdk.NewAttribute(sdk.AttributeKeySender, tc.testAccountAddr.String()),
+					sdk.NewAttribute(types.AttributeKeyRoute, strconv.Itoa(tc.routeIndex)),
+					sdk.NewAttribute(types.AttributeKeyPoolIds, tc.expectedPoolIdStr),
+					sdk.NewAttribute(types.AttributeKeyTokensIn, tc.tokensIn.String()),
+					sdk.NewAttribute(types.AttributeKeyTokensOut, tc.tokensOut.String()),
+				),
+			}
+
+			hasNoEventManager := tc.ctx.EventManager() == nil
+
+			// System under test.
+			events.EmitSplitRouteSwapEvent(tc.ctx, tc.testAccountAddr, tc.routeIndex, tc.poolIds, tc.tokensIn, tc.tokensOut)
+
+			// Assertions
+			if hasNoEventManager {
+				// If there is no event manager on context, this is a no-op.
+				return
+			}
+
+			eventManager := tc.ctx.EventManager()
+			actualEvents := eventManager.Events()
+			suite.Equal(expectedEvents, actualEvents)
+		})
+	}
+}
+
 func (suite *GammEventsTestSuite) TestEmitAddLiquidityEvent() {
 	testcases := map[string]struct {
 		ctx             sdk.Context
@@ -7,6 +7,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/v12/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/pool-models/concentrated"
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/pool-models/orderbook"
 	"github.com/osmosis-labs/osmosis/v12/x/gamm/types"
 )
 
@@ -26,6 +28,18 @@ func NewBalancerMsgServerImpl(keeper *Keeper) balancer.MsgServer {
 	}
 }
 
+func NewOrderbookMsgServerImpl(keeper *Keeper) orderbook.MsgServer {
+	return &msgServer{
+		keeper: keeper,
+	}
+}
+
+func NewConcentratedMsgServerImpl(keeper *Keeper) concentrated.MsgServer {
+	return &msgServer{
+		keeper: keeper,
+	}
+}
+
 // func NewStableswapMsgServerImpl(keeper *Keeper) stableswap.MsgServer {
 // 	return &msgServer{
 // 		keeper: keeper,
@@ -33,8 +47,10 @@ func NewBalancerMsgServerImpl(keeper *Keeper) balancer.MsgServer {
 // }
 
 var (
-	_ types.MsgServer    = msgServer{}
-	_ balancer.MsgServer = msgServer{}
+	_ types.MsgServer        = msgServer{}
+	_ balancer.MsgServer     = msgServer{}
+	_ orderbook.MsgServer    = msgServer{}
+	_ concentrated.MsgServer = msgServer{}
 	// _ stableswap.MsgServer = msgServer{}
 )
 
@@ -44,6 +60,168 @@ func (server msgServer) CreateBalancerPool(goCtx context.Context, msg *balancer.
 	return &balancer.MsgCreateBalancerPoolResponse{PoolID: poolId}, err
 }
 
+// CreateOrderbookPool is a create orderbook pool message.
+func (server msgServer) CreateOrderbookPool(goCtx context.Context, msg *orderbook.MsgCreateOrderbookPool) (*orderbook.MsgCreateOrderbookPoolResponse, error) {
+	poolId, err := server.CreatePool(goCtx, msg)
+	return &orderbook.MsgCreateOrderbookPoolResponse{PoolID: poolId}, err
+}
+
+// PlaceLimitOrder places a limit order in an orderbook pool.
+func (server msgServer) PlaceLimitOrder(goCtx context.Context, msg *orderbook.MsgPlaceLimitOrder) (*orderbook.MsgPlaceLimitOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	orderId, err := server.keeper.PlaceLimitOrder(ctx, sender, msg.PoolId, msg.Tick, msg.TokenIn)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &orderbook.MsgPlaceLimitOrderResponse{OrderId: orderId}, nil
+}
+
+// CancelLimitOrder cancels a limit order of an orderbook pool.
+func (server msgServer) CancelLimitOrder(goCtx context.Context, msg *orderbook.MsgCancelLimitOrder) (*orderbook.MsgCancelLimitOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	tokensOut, err := server.keeper.CancelLimitOrder(ctx, sender, msg.PoolId, msg.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &orderbook.MsgCancelLimitOrderResponse{TokensOut: tokensOut}, nil
+}
+
+// ClaimLimitOrder claims the proceeds of a limit order of an orderbook pool.
+func (server msgServer) ClaimLimitOrder(goCtx context.Context, msg *orderbook.MsgClaimLimitOrder) (*orderbook.MsgClaimLimitOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenOut, err := server.keeper.ClaimLimitOrder(ctx, sender, msg.PoolId, msg.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &orderbook.MsgClaimLimitOrderResponse{TokenOut: tokenOut}, nil
+}
+
+// CreateConcentratedPool is a create concentrated liquidity pool message.
+func (server msgServer) CreateConcentratedPool(goCtx context.Context, msg *concentrated.MsgCreateConcentratedPool) (*concentrated.MsgCreateConcentratedPoolResponse, error) {
+	poolId, err := server.CreatePool(goCtx, msg)
+	return &concentrated.MsgCreateConcentratedPoolResponse{PoolID: poolId}, err
+}
+
+// CreatePosition creates a position in a concentrated liquidity pool.
+func (server msgServer) CreatePosition(goCtx context.Context, msg *concentrated.MsgCreatePosition) (*concentrated.MsgCreatePositionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	positionId, liquidity, tokensIn, err := server.keeper.CreatePosition(ctx, sender, msg.PoolId, msg.LowerTick, msg.UpperTick, msg.TokensDesired, msg.TokensMin)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &concentrated.MsgCreatePositionResponse{PositionId: positionId, Liquidity: liquidity, TokensIn: tokensIn}, nil
+}
+
+// WithdrawPosition withdraws liquidity from a position of a concentrated liquidity pool.
+func (server msgServer) WithdrawPosition(goCtx context.Context, msg *concentrated.MsgWithdrawPosition) (*concentrated.MsgWithdrawPositionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	tokensOut, err := server.keeper.WithdrawPosition(ctx, sender, msg.PoolId, msg.PositionId, msg.Liquidity)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &concentrated.MsgWithdrawPositionResponse{TokensOut: tokensOut}, nil
+}
+
+// CollectFees collects the fees of a position of a concentrated liquidity pool.
+func (server msgServer) CollectFees(goCtx context.Context, msg *concentrated.MsgCollectFees) (*concentrated.MsgCollectFeesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	tokensOut, err := server.keeper.CollectFees(ctx, sender, msg.PoolId, msg.PositionId)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &concentrated.MsgCollectFeesResponse{TokensOut: tokensOut}, nil
+}
+
 // func (server msgServer) CreateStableswapPool(goCtx context.Context, msg *stableswap.MsgCreateStableswapPool) (*stableswap.MsgCreateStableswapPoolResponse, error) {
 // 	poolId, err := server.CreatePool(goCtx, msg)
 // 	if err != nil {
@@ -182,6 +360,31 @@ func (server msgServer) SwapExactAmountIn(goCtx context.Context, msg *types.MsgS
 	return &types.MsgSwapExactAmountInResponse{TokenOutAmount: tokenOutAmount}, nil
 }
 
+func (server msgServer) SplitRouteSwapExactAmountIn(goCtx context.Context, msg *types.MsgSplitRouteSwapExactAmountIn) (*types.MsgSplitRouteSwapExactAmountInResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenOutAmount, err := server.keeper.SplitRouteSwapExactAmountIn(ctx, sender, msg.Routes, msg.TokenInDenom, msg.TokenOutMinAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	// Swap events are handled elsewhere
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgSplitRouteSwapExactAmountInResponse{TokenOutAmount: tokenOutAmount}, nil
+}
+
 func (server msgServer) SwapExactAmountOut(goCtx context.Context, msg *types.MsgSwapExactAmountOut) (*types.MsgSwapExactAmountOutResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
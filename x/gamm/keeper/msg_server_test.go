@@ -112,6 +112,80 @@ func (suite *KeeperTestSuite) TestSwapExactAmountIn_Events() {
 	}
 }
 
+// TestSplitRouteSwapExactAmountIn_Events tests that events are correctly emitted
+// when calling SplitRouteSwapExactAmountIn.
+func (suite *KeeperTestSuite) TestSplitRouteSwapExactAmountIn_Events() {
+	testcases := map[string]struct {
+		routes                  []types.SwapAmountInSplitRoute
+		tokenInDenom            string
+		expectError             bool
+		expectedSwapEvents      int
+		expectedSplitSwapEvents int
+		expectedMessageEvents   int
+	}{
+		"one route": {
+			routes: []types.SwapAmountInSplitRoute{
+				{Pools: []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "bar"}}, TokenInAmount: sdk.NewInt(5)},
+			},
+			tokenInDenom:            "foo",
+			expectedSwapEvents:      1,
+			expectedSplitSwapEvents: 1,
+			expectedMessageEvents:   3, // 1 gamm + 2 events emitted by other keeper methods.
+		},
+		"two routes, one of them with two hops": {
+			routes: []types.SwapAmountInSplitRoute{
+				{Pools: []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "bar"}}, TokenInAmount: sdk.NewInt(5)},
+				{Pools: []types.SwapAmountInRoute{{PoolId: 2, TokenOutDenom: "baz"}, {PoolId: 1, TokenOutDenom: "bar"}}, TokenInAmount: sdk.NewInt(5)},
+			},
+			tokenInDenom:            "foo",
+			expectedSwapEvents:      3,
+			expectedSplitSwapEvents: 2,
+			expectedMessageEvents:   7, // 1 gamm + 6 events emitted by other keeper methods.
+		},
+		"invalid - denom does not exist": {
+			routes: []types.SwapAmountInSplitRoute{
+				{Pools: []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "bar"}}, TokenInAmount: sdk.NewInt(5)},
+			},
+			tokenInDenom: doesNotExistDenom,
+			expectError:  true,
+		},
+	}
+
+	for name, tc := range testcases {
+		suite.Run(name, func() {
+			suite.Setup()
+			ctx := suite.Ctx
+
+			suite.PrepareBalancerPool()
+			suite.PrepareBalancerPool()
+
+			msgServer := keeper.NewMsgServerImpl(suite.App.GAMMKeeper)
+
+			// Reset event counts to 0 by creating a new manager.
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			suite.Equal(0, len(ctx.EventManager().Events()))
+
+			response, err := msgServer.SplitRouteSwapExactAmountIn(sdk.WrapSDKContext(ctx), &types.MsgSplitRouteSwapExactAmountIn{
+				Sender:            suite.TestAccs[0].String(),
+				Routes:            tc.routes,
+				TokenInDenom:      tc.tokenInDenom,
+				TokenOutMinAmount: sdk.OneInt(),
+			})
+
+			if !tc.expectError {
+				suite.NoError(err)
+				suite.NotNil(response)
+			} else {
+				suite.Error(err)
+			}
+
+			suite.AssertEventEmitted(ctx, types.TypeEvtTokenSwapped, tc.expectedSwapEvents)
+			suite.AssertEventEmitted(ctx, types.TypeEvtSplitRouteSwapped, tc.expectedSplitSwapEvents)
+			suite.AssertEventEmitted(ctx, sdk.EventTypeMessage, tc.expectedMessageEvents)
+		})
+	}
+}
+
 // TestSwapExactAmountOut_Events tests that events are correctly emitted
 // when calling SwapExactAmountOut.
 func (suite *KeeperTestSuite) TestSwapExactAmountOut_Events() {
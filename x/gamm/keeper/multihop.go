@@ -2,7 +2,9 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/keeper/internal/events"
 	"github.com/osmosis-labs/osmosis/v12/x/gamm/types"
 )
 
@@ -30,7 +32,7 @@ func (k Keeper) MultihopSwapExactAmountIn(
 		}
 
 		// Execute the expected swap on the current routed pool
-		pool, poolErr := k.getPoolForSwap(ctx, route.PoolId)
+		pool, poolErr := k.GetPoolForSwap(ctx, route.PoolId)
 		if poolErr != nil {
 			return sdk.Int{}, poolErr
 		}
@@ -47,6 +49,52 @@ func (k Keeper) MultihopSwapExactAmountIn(
 	return tokenOutAmount, err
 }
 
+// SplitRouteSwapExactAmountIn swaps tokenInDenom through several routes at once, each with its own amount in,
+// and returns the total amount out over all of the routes, which must all end in the same denom.
+// The routes are swapped atomically: the swap succeeds only when the total amount out is at least
+// tokenOutMinAmount, and otherwise none of the routes is swapped.
+func (k Keeper) SplitRouteSwapExactAmountIn(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	routes []types.SwapAmountInSplitRoute,
+	tokenInDenom string,
+	tokenOutMinAmount sdk.Int,
+) (tokenOutAmount sdk.Int, err error) {
+	if err := types.SwapAmountInSplitRoutes(routes).Validate(); err != nil {
+		return sdk.Int{}, err
+	}
+	tokenOutDenom := types.SwapAmountInSplitRoutes(routes).TokenOutDenom()
+
+	// The routes are swapped in a cache context, which is only written once all of them succeeded.
+	cacheCtx, write := ctx.CacheContext()
+	tokenOutAmount = sdk.ZeroInt()
+	for i, route := range routes {
+		// Only the total amount out is checked against tokenOutMinAmount,
+		// so every route only has to return a positive amount.
+		tokenIn := sdk.NewCoin(tokenInDenom, route.TokenInAmount)
+		routeOutAmount, err := k.MultihopSwapExactAmountIn(cacheCtx, sender, route.Pools, tokenIn, sdk.OneInt())
+		if err != nil {
+			return sdk.Int{}, err
+		}
+
+		poolIds := make([]uint64, 0, len(route.Pools))
+		for _, pool := range route.Pools {
+			poolIds = append(poolIds, pool.PoolId)
+		}
+		events.EmitSplitRouteSwapEvent(cacheCtx, sender, i, poolIds, sdk.Coins{tokenIn}, sdk.Coins{sdk.NewCoin(tokenOutDenom, routeOutAmount)})
+
+		tokenOutAmount = tokenOutAmount.Add(routeOutAmount)
+	}
+
+	if tokenOutAmount.LT(tokenOutMinAmount) {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrLimitMinAmount,
+			"total amount out %s over all routes is lesser than min amount %s", tokenOutAmount, tokenOutMinAmount)
+	}
+
+	write()
+	return tokenOutAmount, nil
+}
+
 // MultihopSwapExactAmountOut defines the output denom and output amount for the last pool.
 // Calculation starts by providing the tokenOutAmount of the final pool to calculate the required tokenInAmount
 // the calculated tokenInAmount is used as defined tokenOutAmount of the previous pool, calculating in reverse order of the swap
@@ -88,7 +136,7 @@ func (k Keeper) MultihopSwapExactAmountOut(
 		}
 
 		// Execute the expected swap on the current routed pool
-		pool, poolErr := k.getPoolForSwap(ctx, route.PoolId)
+		pool, poolErr := k.GetPoolForSwap(ctx, route.PoolId)
 		if poolErr != nil {
 			return sdk.Int{}, poolErr
 		}
@@ -123,7 +171,7 @@ func (k Keeper) createMultihopExpectedSwapOuts(
 	for i := len(routes) - 1; i >= 0; i-- {
 		route := routes[i]
 
-		pool, err := k.getPoolForSwap(ctx, route.PoolId)
+		pool, err := k.GetPoolForSwap(ctx, route.PoolId)
 		if err != nil {
 			return nil, err
 		}
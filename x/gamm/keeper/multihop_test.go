@@ -308,6 +308,109 @@ func (suite *KeeperTestSuite) TestBalancerPoolSimpleMultihopSwapExactAmountOut()
 	}
 }
 
+func (suite *KeeperTestSuite) TestSplitRouteSwapExactAmountIn() {
+	fooToBarRoute := func(poolIds ...uint64) []types.SwapAmountInRoute {
+		if len(poolIds) == 1 {
+			return []types.SwapAmountInRoute{{PoolId: poolIds[0], TokenOutDenom: "bar"}}
+		}
+		return []types.SwapAmountInRoute{{PoolId: poolIds[0], TokenOutDenom: "baz"}, {PoolId: poolIds[1], TokenOutDenom: "bar"}}
+	}
+
+	tests := []struct {
+		name              string
+		routes            []types.SwapAmountInSplitRoute
+		tokenOutMinAmount sdk.Int
+		expectError       bool
+		expectedErr       error
+	}{
+		{
+			name: "two parallel routes",
+			routes: []types.SwapAmountInSplitRoute{
+				{Pools: fooToBarRoute(1), TokenInAmount: sdk.NewInt(100000)},
+				{Pools: fooToBarRoute(2), TokenInAmount: sdk.NewInt(50000)},
+			},
+			tokenOutMinAmount: sdk.NewInt(1),
+		},
+		{
+			name: "direct route and multihop route",
+			routes: []types.SwapAmountInSplitRoute{
+				{Pools: fooToBarRoute(1), TokenInAmount: sdk.NewInt(100000)},
+				{Pools: fooToBarRoute(2, 1), TokenInAmount: sdk.NewInt(100000)},
+			},
+			tokenOutMinAmount: sdk.NewInt(1),
+		},
+		{
+			name: "total amount out lesser than min amount",
+			routes: []types.SwapAmountInSplitRoute{
+				{Pools: fooToBarRoute(1), TokenInAmount: sdk.NewInt(100000)},
+				{Pools: fooToBarRoute(2), TokenInAmount: sdk.NewInt(50000)},
+			},
+			tokenOutMinAmount: sdk.NewInt(1_000_000),
+			expectError:       true,
+			expectedErr:       types.ErrLimitMinAmount,
+		},
+		{
+			name: "routes ending in different denoms",
+			routes: []types.SwapAmountInSplitRoute{
+				{Pools: fooToBarRoute(1), TokenInAmount: sdk.NewInt(100000)},
+				{Pools: []types.SwapAmountInRoute{{PoolId: 2, TokenOutDenom: "baz"}}, TokenInAmount: sdk.NewInt(50000)},
+			},
+			tokenOutMinAmount: sdk.NewInt(1),
+			expectError:       true,
+			expectedErr:       types.ErrInvalidSplitRoutes,
+		},
+		{
+			name: "last route through a non existent pool",
+			routes: []types.SwapAmountInSplitRoute{
+				{Pools: fooToBarRoute(1), TokenInAmount: sdk.NewInt(100000)},
+				{Pools: fooToBarRoute(3), TokenInAmount: sdk.NewInt(50000)},
+			},
+			tokenOutMinAmount: sdk.NewInt(1),
+			expectError:       true,
+		},
+	}
+
+	for _, test := range tests {
+		suite.Run(test.name, func() {
+			suite.SetupTest()
+			keeper := suite.App.GAMMKeeper
+			suite.PrepareBalancerPool()
+			suite.PrepareBalancerPool()
+			sender := suite.TestAccs[0]
+
+			// Calculate the expected amount out by swapping every route on its own, in a cache context.
+			cacheCtx, _ := suite.Ctx.CacheContext()
+			expectedTokenOutAmount := sdk.ZeroInt()
+			for _, route := range test.routes {
+				routeOutAmount, err := keeper.MultihopSwapExactAmountIn(cacheCtx, sender, route.Pools, sdk.NewCoin("foo", route.TokenInAmount), sdk.OneInt())
+				if err == nil {
+					expectedTokenOutAmount = expectedTokenOutAmount.Add(routeOutAmount)
+				}
+			}
+
+			balancesBefore := suite.App.BankKeeper.GetAllBalances(suite.Ctx, sender)
+			tokenOutAmount, err := keeper.SplitRouteSwapExactAmountIn(suite.Ctx, sender, test.routes, "foo", test.tokenOutMinAmount)
+
+			if test.expectError {
+				suite.Require().Error(err)
+				if test.expectedErr != nil {
+					suite.Require().ErrorIs(err, test.expectedErr)
+				}
+				// none of the routes was swapped
+				suite.Require().Equal(balancesBefore, suite.App.BankKeeper.GetAllBalances(suite.Ctx, sender))
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(expectedTokenOutAmount, tokenOutAmount)
+
+			tokenInAmount := types.SwapAmountInSplitRoutes(test.routes).TokenInAmount()
+			expectedBalances := balancesBefore.Sub(sdk.NewCoins(sdk.NewCoin("foo", tokenInAmount))).Add(sdk.NewCoin("bar", tokenOutAmount))
+			suite.Require().Equal(expectedBalances, suite.App.BankKeeper.GetAllBalances(suite.Ctx, sender))
+		})
+	}
+}
+
 func (s *KeeperTestSuite) updatePoolSwapFee(ctx sdk.Context, poolId uint64, adjustedPoolSwapFee sdk.Dec) error {
 	pool, err := s.App.GAMMKeeper.GetPoolAndPoke(ctx, poolId)
 	if err != nil {
@@ -0,0 +1,230 @@
+package keeper
+
+import (
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/keeper/internal/events"
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/pool-models/orderbook"
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/types"
+)
+
+// orderBook is the book of an orderbook pool, kept in the gamm store apart from the pool.
+type orderBook struct {
+	store  sdk.KVStore
+	cdc    codec.BinaryCodec
+	poolId uint64
+}
+
+var _ orderbook.Book = orderBook{}
+
+func (k Keeper) orderBook(ctx sdk.Context, poolId uint64) orderBook {
+	return orderBook{store: ctx.KVStore(k.storeKey), cdc: k.cdc, poolId: poolId}
+}
+
+func (b orderBook) GetLimitOrder(orderId uint64) (orderbook.LimitOrder, bool) {
+	bz := b.store.Get(types.GetKeyLimitOrder(b.poolId, orderId))
+	if bz == nil {
+		return orderbook.LimitOrder{}, false
+	}
+	order := orderbook.LimitOrder{}
+	b.cdc.MustUnmarshal(bz, &order)
+	return order, true
+}
+
+func (b orderBook) SetLimitOrder(order orderbook.LimitOrder) {
+	b.store.Set(types.GetKeyLimitOrder(b.poolId, order.OrderId), b.cdc.MustMarshal(&order))
+}
+
+func (b orderBook) DeleteLimitOrder(orderId uint64) {
+	b.store.Delete(types.GetKeyLimitOrder(b.poolId, orderId))
+}
+
+func (b orderBook) IterateLimitOrders(cb func(order orderbook.LimitOrder) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(b.store, types.GetKeyPrefixLimitOrders(b.poolId))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		order := orderbook.LimitOrder{}
+		b.cdc.MustUnmarshal(iter.Value(), &order)
+		if cb(order) {
+			return
+		}
+	}
+}
+
+func (b orderBook) GetTick(direction orderbook.OrderDirection, index int64) orderbook.Tick {
+	tick := orderbook.Tick{Index: index, Direction: direction}
+	bz := b.store.Get(types.GetKeyOrderbookTick(b.poolId, int32(direction), index))
+	if bz != nil {
+		b.cdc.MustUnmarshal(bz, &tick)
+	}
+	return tick
+}
+
+func (b orderBook) SetTick(tick orderbook.Tick) {
+	key := types.GetKeyOrderbookTick(b.poolId, int32(tick.Direction), tick.Index)
+	if len(tick.OrderIds) == 0 {
+		b.store.Delete(key)
+		return
+	}
+	b.store.Set(key, b.cdc.MustMarshal(&tick))
+}
+
+func (b orderBook) IterateTicks(direction orderbook.OrderDirection, cb func(tick orderbook.Tick) (stop bool)) {
+	prefix := types.GetKeyPrefixOrderbookTicks(b.poolId, int32(direction))
+	var iter sdk.Iterator
+	if direction == orderbook.Ask {
+		iter = sdk.KVStorePrefixIterator(b.store, prefix)
+	} else {
+		iter = sdk.KVStoreReversePrefixIterator(b.store, prefix)
+	}
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		tick := orderbook.Tick{}
+		b.cdc.MustUnmarshal(iter.Value(), &tick)
+		if cb(tick) {
+			return
+		}
+	}
+}
+
+// initLimitOrdersGenesis moves the limit orders of an orderbook pool imported from genesis to its book,
+// queueing the unfilled ones at their tick by ascending id. Returns the tokens escrowed by the orders.
+func (k Keeper) initLimitOrdersGenesis(ctx sdk.Context, pool *orderbook.Pool) sdk.Coins {
+	book := k.orderBook(ctx, pool.Id)
+	escrowed := sdk.Coins{}
+	sort.Slice(pool.LimitOrders, func(i, j int) bool { return pool.LimitOrders[i].OrderId < pool.LimitOrders[j].OrderId })
+	for _, order := range pool.LimitOrders {
+		book.SetLimitOrder(order)
+		if !order.IsFilled() {
+			tick := book.GetTick(order.Direction, order.Tick)
+			tick.OrderIds = append(tick.OrderIds, order.OrderId)
+			book.SetTick(tick)
+		}
+		escrowed = escrowed.Add(pool.LimitOrderEscrow(order)...)
+	}
+	pool.LimitOrders = nil
+	return escrowed
+}
+
+// exportLimitOrdersGenesis sets the limit orders of an orderbook pool from its book, to export it in genesis.
+func (k Keeper) exportLimitOrdersGenesis(ctx sdk.Context, pool *orderbook.Pool) {
+	pool.LimitOrders = pool.GetLimitOrders(k.orderBook(ctx, pool.Id), "")
+}
+
+// getOrderbookPool returns the orderbook pool with the given id,
+// and errors if it does not exist or is of another pool type.
+func (k Keeper) getOrderbookPool(ctx sdk.Context, poolId uint64) (*orderbook.Pool, error) {
+	pool, err := k.GetPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+
+	orderbookPool, ok := pool.(*orderbook.Pool)
+	if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrNotOrderbookPool, "pool %d is of type %T", poolId, pool)
+	}
+	return orderbookPool, nil
+}
+
+// PlaceLimitOrder places a limit order at the given tick of an orderbook pool, escrowing tokenIn in the pool.
+// Returns the id of the placed order.
+func (k Keeper) PlaceLimitOrder(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, tick int64, tokenIn sdk.Coin) (uint64, error) {
+	pool, err := k.getOrderbookPool(ctx, poolId)
+	if err != nil {
+		return 0, err
+	}
+
+	order, err := pool.PlaceLimitOrder(ctx, k.orderBook(ctx, poolId), sender, tick, tokenIn)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, sender, pool.GetAddress(), sdk.Coins{tokenIn}); err != nil {
+		return 0, err
+	}
+
+	if err := k.setPool(ctx, pool); err != nil {
+		return 0, err
+	}
+
+	events.EmitLimitOrderPlacedEvent(ctx, sender, poolId, order.OrderId, tick, tokenIn)
+	k.RecordTotalLiquidityIncrease(ctx, sdk.Coins{tokenIn})
+
+	return order.OrderId, nil
+}
+
+// CancelLimitOrder cancels a limit order of an orderbook pool, and sends its unfilled deposit
+// and unclaimed proceeds back to the owner.
+func (k Keeper) CancelLimitOrder(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, orderId uint64) (sdk.Coins, error) {
+	pool, err := k.getOrderbookPool(ctx, poolId)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+
+	tokensOut, err := pool.CancelLimitOrder(k.orderBook(ctx, poolId), sender, orderId)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, pool.GetAddress(), sender, tokensOut); err != nil {
+		return sdk.Coins{}, err
+	}
+
+	if err := k.setPool(ctx, pool); err != nil {
+		return sdk.Coins{}, err
+	}
+
+	events.EmitLimitOrderCancelledEvent(ctx, sender, poolId, orderId, tokensOut)
+	k.RecordTotalLiquidityDecrease(ctx, tokensOut)
+
+	return tokensOut, nil
+}
+
+// ClaimLimitOrder sends the unclaimed proceeds of a limit order of an orderbook pool to its owner.
+func (k Keeper) ClaimLimitOrder(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, orderId uint64) (sdk.Coin, error) {
+	pool, err := k.getOrderbookPool(ctx, poolId)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	tokenOut, err := pool.ClaimLimitOrder(k.orderBook(ctx, poolId), sender, orderId)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, pool.GetAddress(), sender, sdk.Coins{tokenOut}); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.setPool(ctx, pool); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	events.EmitLimitOrderClaimedEvent(ctx, sender, poolId, orderId, sdk.Coins{tokenOut})
+	k.RecordTotalLiquidityDecrease(ctx, sdk.Coins{tokenOut})
+
+	return tokenOut, nil
+}
+
+// GetLimitOrder returns a limit order of an orderbook pool.
+func (k Keeper) GetLimitOrder(ctx sdk.Context, poolId uint64, orderId uint64) (orderbook.LimitOrder, error) {
+	pool, err := k.getOrderbookPool(ctx, poolId)
+	if err != nil {
+		return orderbook.LimitOrder{}, err
+	}
+	return pool.GetLimitOrder(k.orderBook(ctx, poolId), orderId)
+}
+
+// GetLimitOrders returns the limit orders of an orderbook pool.
+// If owner is not empty, only the orders of owner are returned.
+func (k Keeper) GetLimitOrders(ctx sdk.Context, poolId uint64, owner string) ([]orderbook.LimitOrder, error) {
+	pool, err := k.getOrderbookPool(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+	return pool.GetLimitOrders(k.orderBook(ctx, poolId), owner), nil
+}
@@ -0,0 +1,245 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/pool-models/orderbook"
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/types"
+)
+
+// prepareOrderbookPool creates an orderbook pool of uatom against uosmo, with a spot price of 10 uosmo per uatom,
+// and funds the test accounts.
+func (suite *KeeperTestSuite) prepareOrderbookPool() uint64 {
+	for _, acc := range suite.TestAccs {
+		suite.FundAcc(acc, sdk.NewCoins(
+			sdk.NewInt64Coin("uatom", 10_000_000),
+			sdk.NewInt64Coin("uosmo", 10_000_000_000),
+		))
+	}
+
+	poolParams := orderbook.PoolParams{
+		SwapFee:      sdk.ZeroDec(),
+		ExitFee:      sdk.ZeroDec(),
+		TickSize:     sdk.MustNewDecFromStr("0.1"),
+		MinOrderSize: sdk.NewInt(100),
+	}
+	initialLiquidity := sdk.NewCoins(sdk.NewInt64Coin("uatom", 1_000_000), sdk.NewInt64Coin("uosmo", 10_000_000))
+	msg := orderbook.NewMsgCreateOrderbookPool(suite.TestAccs[0], poolParams, initialLiquidity, "uatom", "")
+	poolId, err := suite.App.GAMMKeeper.CreatePool(suite.Ctx, msg)
+	suite.Require().NoError(err)
+
+	return poolId
+}
+
+func (suite *KeeperTestSuite) TestLimitOrderLifecycle() {
+	suite.SetupTest()
+	keeper := suite.App.GAMMKeeper
+	poolId := suite.prepareOrderbookPool()
+	maker, taker := suite.TestAccs[1], suite.TestAccs[2]
+
+	pool, err := keeper.GetPoolAndPoke(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	poolAddr := pool.GetAddress()
+
+	poolType, err := keeper.GetPoolType(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	suite.Require().Equal("Orderbook", poolType)
+
+	// place an ask at a price of 11 and a bid at a price of 9
+	makerBalance := suite.App.BankKeeper.GetAllBalances(suite.Ctx, maker)
+	askId, err := keeper.PlaceLimitOrder(suite.Ctx, maker, poolId, 110, sdk.NewInt64Coin("uatom", 1000))
+	suite.Require().NoError(err)
+	bidId, err := keeper.PlaceLimitOrder(suite.Ctx, maker, poolId, 90, sdk.NewInt64Coin("uosmo", 9000))
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), askId)
+	suite.Require().Equal(uint64(2), bidId)
+
+	deposits := sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000), sdk.NewInt64Coin("uosmo", 9000))
+	suite.Require().Equal(makerBalance.Sub(deposits), suite.App.BankKeeper.GetAllBalances(suite.Ctx, maker))
+	suite.Require().Equal(
+		sdk.NewCoins(sdk.NewInt64Coin("uatom", 1_000_000), sdk.NewInt64Coin("uosmo", 10_000_000)).Add(deposits...),
+		suite.App.BankKeeper.GetAllBalances(suite.Ctx, poolAddr))
+
+	// fill the ask
+	tokenOut, err := keeper.SwapExactAmountIn(suite.Ctx, taker, poolId, sdk.NewInt64Coin("uosmo", 600_000), "uatom", sdk.OneInt())
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(56_623), tokenOut)
+
+	res, err := keeper.GetLimitOrder(suite.Ctx, poolId, askId)
+	suite.Require().NoError(err)
+	suite.Require().True(res.IsFilled())
+	suite.Require().Equal(sdk.NewInt(11_000), res.Proceeds)
+
+	// claim the ask, which removes it
+	claimed, err := keeper.ClaimLimitOrder(suite.Ctx, maker, poolId, askId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin("uosmo", 11_000), claimed)
+	_, err = keeper.ClaimLimitOrder(suite.Ctx, maker, poolId, askId)
+	suite.Require().ErrorIs(err, types.ErrLimitOrderNotFound)
+
+	// only the owner can cancel the bid
+	_, err = keeper.CancelLimitOrder(suite.Ctx, taker, poolId, bidId)
+	suite.Require().ErrorIs(err, types.ErrNotLimitOrderOwner)
+	cancelled, err := keeper.CancelLimitOrder(suite.Ctx, maker, poolId, bidId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("uosmo", 9000)), cancelled)
+
+	orders, err := keeper.GetLimitOrders(suite.Ctx, poolId, "")
+	suite.Require().NoError(err)
+	suite.Require().Empty(orders)
+
+	// the maker sold 1000 uatom for 11_000 uosmo
+	suite.Require().Equal(
+		makerBalance.Sub(sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000))).Add(sdk.NewInt64Coin("uosmo", 11_000)),
+		suite.App.BankKeeper.GetAllBalances(suite.Ctx, maker))
+
+	// with an empty book, the pool only holds the LP reserves
+	pool, err = keeper.GetPoolAndPoke(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(pool.GetTotalPoolLiquidity(suite.Ctx), suite.App.BankKeeper.GetAllBalances(suite.Ctx, poolAddr))
+}
+
+// TestOrderbookSingleAssetJoinExit tests that joins and exits swapping a single asset, which would trade against
+// the reserves without filling the orders of the book, are rejected, and that other joins leave the book untouched.
+func (suite *KeeperTestSuite) TestOrderbookSingleAssetJoinExit() {
+	suite.SetupTest()
+	keeper := suite.App.GAMMKeeper
+	poolId := suite.prepareOrderbookPool()
+	maker, joiner := suite.TestAccs[1], suite.TestAccs[2]
+
+	// an ask at a price of 11, which a swap of 600_000 uosmo fills
+	askId, err := keeper.PlaceLimitOrder(suite.Ctx, maker, poolId, 110, sdk.NewInt64Coin("uatom", 1000))
+	suite.Require().NoError(err)
+
+	_, err = keeper.JoinSwapExactAmountIn(suite.Ctx, joiner, poolId, sdk.NewCoins(sdk.NewInt64Coin("uosmo", 600_000)), sdk.OneInt())
+	suite.Require().ErrorIs(err, types.ErrNotImplemented)
+	_, err = keeper.JoinSwapShareAmountOut(suite.Ctx, joiner, poolId, "uosmo", types.InitPoolSharesSupply.QuoRaw(10), sdk.NewInt(10_000_000))
+	suite.Require().Error(err)
+	_, err = keeper.ExitSwapExactAmountOut(suite.Ctx, suite.TestAccs[0], poolId, sdk.NewInt64Coin("uatom", 1000), types.InitPoolSharesSupply)
+	suite.Require().Error(err)
+
+	// a join with both assets adds the tokens in excess of the reserve ratio to the reserves
+	joinerBalance := suite.App.BankKeeper.GetAllBalances(suite.Ctx, joiner)
+	tokensIn := sdk.NewCoins(sdk.NewInt64Coin("uatom", 100_000), sdk.NewInt64Coin("uosmo", 1_200_000))
+	shares, err := keeper.JoinSwapExactAmountIn(suite.Ctx, joiner, poolId, tokensIn, sdk.OneInt())
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.InitPoolSharesSupply.QuoRaw(10), shares)
+	suite.Require().Equal(joinerBalance.Sub(tokensIn).Add(sdk.NewCoin(types.GetPoolShareDenom(poolId), shares)), suite.App.BankKeeper.GetAllBalances(suite.Ctx, joiner))
+
+	pool, err := keeper.GetPoolAndPoke(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("uatom", 1_100_000), sdk.NewInt64Coin("uosmo", 11_200_000)), pool.GetTotalPoolLiquidity(suite.Ctx))
+
+	// the ask was never filled
+	order, err := keeper.GetLimitOrder(suite.Ctx, poolId, askId)
+	suite.Require().NoError(err)
+	suite.Require().False(order.IsFilled())
+	suite.Require().True(order.Proceeds.IsZero())
+}
+
+func (suite *KeeperTestSuite) TestPlaceLimitOrderNotOrderbookPool() {
+	suite.SetupTest()
+	poolId := suite.PrepareBalancerPool()
+
+	_, err := suite.App.GAMMKeeper.PlaceLimitOrder(suite.Ctx, suite.TestAccs[0], poolId, 10, sdk.NewInt64Coin("foo", 10))
+	suite.Require().ErrorIs(err, types.ErrNotOrderbookPool)
+}
+
+func (suite *KeeperTestSuite) TestQueryLimitOrders() {
+	suite.SetupTest()
+	queryClient := orderbook.NewQueryClient(suite.QueryHelper)
+	poolId := suite.prepareOrderbookPool()
+
+	_, err := suite.App.GAMMKeeper.PlaceLimitOrder(suite.Ctx, suite.TestAccs[0], poolId, 110, sdk.NewInt64Coin("uatom", 1000))
+	suite.Require().NoError(err)
+	_, err = suite.App.GAMMKeeper.PlaceLimitOrder(suite.Ctx, suite.TestAccs[1], poolId, 120, sdk.NewInt64Coin("uatom", 1000))
+	suite.Require().NoError(err)
+
+	orderRes, err := queryClient.LimitOrder(suite.Ctx.Context(), &orderbook.QueryLimitOrderRequest{PoolId: poolId, OrderId: 2})
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.TestAccs[1].String(), orderRes.Order.Owner)
+	suite.Require().Equal(int64(120), orderRes.Order.Tick)
+
+	_, err = queryClient.LimitOrder(suite.Ctx.Context(), &orderbook.QueryLimitOrderRequest{PoolId: poolId, OrderId: 3})
+	suite.Require().Error(err)
+
+	ordersRes, err := queryClient.LimitOrders(suite.Ctx.Context(), &orderbook.QueryLimitOrdersRequest{PoolId: poolId})
+	suite.Require().NoError(err)
+	suite.Require().Len(ordersRes.Orders, 2)
+
+	ordersRes, err = queryClient.LimitOrders(suite.Ctx.Context(), &orderbook.QueryLimitOrdersRequest{PoolId: poolId, Owner: suite.TestAccs[0].String()})
+	suite.Require().NoError(err)
+	suite.Require().Len(ordersRes.Orders, 1)
+	suite.Require().Equal(uint64(1), ordersRes.Orders[0].OrderId)
+
+	// orderbook pools are returned by the pools query
+	poolsRes, err := suite.queryClient.Pools(suite.Ctx.Context(), &types.QueryPoolsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(poolsRes.Pools, 1)
+}
+
+func (suite *KeeperTestSuite) TestLimitOrderMinOrderSize() {
+	suite.SetupTest()
+	poolId := suite.prepareOrderbookPool()
+
+	_, err := suite.App.GAMMKeeper.PlaceLimitOrder(suite.Ctx, suite.TestAccs[0], poolId, 90, sdk.NewInt64Coin("uosmo", 99))
+	suite.Require().ErrorIs(err, types.ErrLimitOrderTooSmall)
+	_, err = suite.App.GAMMKeeper.PlaceLimitOrder(suite.Ctx, suite.TestAccs[0], poolId, 90, sdk.NewInt64Coin("uosmo", 100))
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestOrderbookGenesis() {
+	suite.SetupTest()
+	keeper := suite.App.GAMMKeeper
+	poolId := suite.prepareOrderbookPool()
+	maker, taker := suite.TestAccs[1], suite.TestAccs[2]
+
+	// a partially filled ask and an unfilled ask behind it
+	_, err := keeper.PlaceLimitOrder(suite.Ctx, maker, poolId, 110, sdk.NewInt64Coin("uatom", 1000))
+	suite.Require().NoError(err)
+	_, err = keeper.PlaceLimitOrder(suite.Ctx, maker, poolId, 110, sdk.NewInt64Coin("uatom", 1000))
+	suite.Require().NoError(err)
+	_, err = keeper.SwapExactAmountIn(suite.Ctx, taker, poolId, sdk.NewInt64Coin("uosmo", 488_088+5500), "uatom", sdk.OneInt())
+	suite.Require().NoError(err)
+	orders, err := keeper.GetLimitOrders(suite.Ctx, poolId, "")
+	suite.Require().NoError(err)
+	totalLiquidity := keeper.GetTotalLiquidity(suite.Ctx)
+	pool, err := keeper.GetPoolAndPoke(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	poolBalances := suite.App.BankKeeper.GetAllBalances(suite.Ctx, pool.GetAddress())
+	suite.Require().Equal(totalLiquidity, poolBalances)
+
+	// the orders are exported within the pool, and are not part of the pool in state
+	genesis := keeper.ExportGenesis(suite.Ctx)
+	suite.Require().Len(genesis.Pools, 1)
+	var exported types.PoolI
+	suite.Require().NoError(suite.App.AppCodec().UnpackAny(genesis.Pools[0], &exported))
+	suite.Require().Equal(orders, exported.(*orderbook.Pool).LimitOrders)
+	pool, err = keeper.GetPoolAndPoke(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	suite.Require().Empty(pool.(*orderbook.Pool).LimitOrders)
+
+	suite.SetupTest()
+	keeper = suite.App.GAMMKeeper
+	keeper.InitGenesis(suite.Ctx, *genesis, suite.App.AppCodec())
+	suite.FundAcc(pool.GetAddress(), poolBalances)
+
+	importedOrders, err := keeper.GetLimitOrders(suite.Ctx, poolId, "")
+	suite.Require().NoError(err)
+	suite.Require().Equal(orders, importedOrders)
+	suite.Require().Equal(totalLiquidity, keeper.GetTotalLiquidity(suite.Ctx))
+	pool, err = keeper.GetPoolAndPoke(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	suite.Require().Empty(pool.(*orderbook.Pool).LimitOrders)
+
+	// the imported book is filled by swaps, the partially filled ask first
+	suite.FundAcc(taker, sdk.NewCoins(sdk.NewInt64Coin("uosmo", 10_000)))
+	_, err = keeper.SwapExactAmountIn(suite.Ctx, taker, poolId, sdk.NewInt64Coin("uosmo", 10_000), "uatom", sdk.OneInt())
+	suite.Require().NoError(err)
+	first, err := keeper.GetLimitOrder(suite.Ctx, poolId, orders[0].OrderId)
+	suite.Require().NoError(err)
+	suite.Require().True(first.IsFilled())
+	second, err := keeper.GetLimitOrder(suite.Ctx, poolId, orders[1].OrderId)
+	suite.Require().NoError(err)
+	suite.Require().True(second.Filled.IsPositive())
+}
@@ -10,6 +10,8 @@ import (
 
 	"github.com/osmosis-labs/osmosis/v12/osmoutils"
 	"github.com/osmosis-labs/osmosis/v12/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/pool-models/concentrated"
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/pool-models/orderbook"
 	"github.com/osmosis-labs/osmosis/v12/x/gamm/types"
 )
 
@@ -44,8 +46,9 @@ func (k Keeper) GetPoolAndPoke(ctx sdk.Context, poolId uint64) (types.PoolI, err
 	return pool, nil
 }
 
-// Get pool and check if the pool is active, i.e. allowed to be swapped against.
-func (k Keeper) getPoolForSwap(ctx sdk.Context, poolId uint64) (types.PoolI, error) {
+// GetPoolForSwap gets a pool and checks that it is active, i.e. allowed to be swapped against.
+// Orderbook pools are returned bound to their order book, which their swaps fill.
+func (k Keeper) GetPoolForSwap(ctx sdk.Context, poolId uint64) (types.PoolI, error) {
 	pool, err := k.GetPoolAndPoke(ctx, poolId)
 	if err != nil {
 		return &balancer.Pool{}, err
@@ -54,6 +57,10 @@ func (k Keeper) getPoolForSwap(ctx sdk.Context, poolId uint64) (types.PoolI, err
 	if !pool.IsActive(ctx) {
 		return &balancer.Pool{}, sdkerrors.Wrapf(types.ErrPoolLocked, "swap on inactive pool")
 	}
+
+	if orderbookPool, ok := pool.(*orderbook.Pool); ok {
+		return orderbookPool.WithBook(k.orderBook(ctx, poolId)), nil
+	}
 	return pool, nil
 }
 
@@ -82,6 +89,11 @@ func (k Keeper) GetPoolsAndPoke(ctx sdk.Context) (res []types.PoolI, err error)
 }
 
 func (k Keeper) setPool(ctx sdk.Context, pool types.PoolI) error {
+	// the book of an orderbook pool is stored apart from the pool.
+	if bookPool, ok := pool.(*orderbook.BookPool); ok {
+		pool = bookPool.Pool
+	}
+
 	bz, err := k.MarshalPool(pool)
 	if err != nil {
 		return err
@@ -246,6 +258,10 @@ func (k Keeper) GetPoolType(ctx sdk.Context, poolId uint64) (string, error) {
 	switch pool := pool.(type) {
 	case *balancer.Pool:
 		return "Balancer", nil
+	case *orderbook.Pool:
+		return "Orderbook", nil
+	case *concentrated.Pool:
+		return "Concentrated", nil
 	default:
 		errMsg := fmt.Sprintf("unrecognized %s pool type: %T", types.ModuleName, pool)
 		return "", sdkerrors.Wrap(sdkerrors.ErrUnpackAny, errMsg)
@@ -282,7 +282,7 @@ func (k Keeper) JoinSwapExactAmountIn(
 	tokensIn sdk.Coins,
 	shareOutMinAmount sdk.Int,
 ) (sdk.Int, error) {
-	pool, err := k.getPoolForSwap(ctx, poolId)
+	pool, err := k.GetPoolForSwap(ctx, poolId)
 	if err != nil {
 		return sdk.Int{}, err
 	}
@@ -318,7 +318,7 @@ func (k Keeper) JoinSwapShareAmountOut(
 	shareOutAmount sdk.Int,
 	tokenInMaxAmount sdk.Int,
 ) (tokenInAmount sdk.Int, err error) {
-	pool, err := k.getPoolForSwap(ctx, poolId)
+	pool, err := k.GetPoolForSwap(ctx, poolId)
 	if err != nil {
 		return sdk.Int{}, err
 	}
@@ -425,7 +425,7 @@ func (k Keeper) ExitSwapExactAmountOut(
 	tokenOut sdk.Coin,
 	shareInMaxAmount sdk.Int,
 ) (shareInAmount sdk.Int, err error) {
-	pool, err := k.getPoolForSwap(ctx, poolId)
+	pool, err := k.GetPoolForSwap(ctx, poolId)
 	if err != nil {
 		return sdk.Int{}, err
 	}
@@ -23,7 +23,7 @@ func (k Keeper) SwapExactAmountIn(
 	tokenOutDenom string,
 	tokenOutMinAmount sdk.Int,
 ) (sdk.Int, error) {
-	pool, err := k.getPoolForSwap(ctx, poolId)
+	pool, err := k.GetPoolForSwap(ctx, poolId)
 	if err != nil {
 		return sdk.Int{}, err
 	}
@@ -84,7 +84,7 @@ func (k Keeper) SwapExactAmountOut(
 	tokenInMaxAmount sdk.Int,
 	tokenOut sdk.Coin,
 ) (tokenInAmount sdk.Int, err error) {
-	pool, err := k.getPoolForSwap(ctx, poolId)
+	pool, err := k.GetPoolForSwap(ctx, poolId)
 	if err != nil {
 		return sdk.Int{}, err
 	}
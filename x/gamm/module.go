@@ -29,6 +29,8 @@ import (
 	"github.com/osmosis-labs/osmosis/v12/x/gamm/client/cli"
 	"github.com/osmosis-labs/osmosis/v12/x/gamm/keeper"
 	"github.com/osmosis-labs/osmosis/v12/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/pool-models/concentrated"
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/pool-models/orderbook"
 	simulation "github.com/osmosis-labs/osmosis/v12/x/gamm/simulation"
 	"github.com/osmosis-labs/osmosis/v12/x/gamm/types"
 )
@@ -49,6 +51,8 @@ func (AppModuleBasic) Name() string { return types.ModuleName }
 func (AppModuleBasic) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	types.RegisterLegacyAminoCodec(cdc)
 	balancer.RegisterLegacyAminoCodec(cdc)
+	orderbook.RegisterLegacyAminoCodec(cdc)
+	concentrated.RegisterLegacyAminoCodec(cdc)
 	// stableswap.RegisterLegacyAminoCodec(cdc)
 }
 
@@ -73,7 +77,9 @@ func (b AppModuleBasic) RegisterRESTRoutes(ctx client.Context, r *mux.Router) {
 }
 
 func (b AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
-	types.RegisterQueryHandlerClient(context.Background(), mux, types.NewQueryClient(clientCtx)) //nolint:errcheck
+	types.RegisterQueryHandlerClient(context.Background(), mux, types.NewQueryClient(clientCtx))               //nolint:errcheck
+	orderbook.RegisterQueryHandlerClient(context.Background(), mux, orderbook.NewQueryClient(clientCtx))       //nolint:errcheck
+	concentrated.RegisterQueryHandlerClient(context.Background(), mux, concentrated.NewQueryClient(clientCtx)) //nolint:errcheck
 }
 
 func (b AppModuleBasic) GetTxCmd() *cobra.Command {
@@ -88,6 +94,8 @@ func (b AppModuleBasic) GetQueryCmd() *cobra.Command {
 func (AppModuleBasic) RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	types.RegisterInterfaces(registry)
 	balancer.RegisterInterfaces(registry)
+	orderbook.RegisterInterfaces(registry)
+	concentrated.RegisterInterfaces(registry)
 	// stableswap.RegisterInterfaces(registry)
 }
 
@@ -102,8 +110,12 @@ type AppModule struct {
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(&am.keeper))
 	balancer.RegisterMsgServer(cfg.MsgServer(), keeper.NewBalancerMsgServerImpl(&am.keeper))
+	orderbook.RegisterMsgServer(cfg.MsgServer(), keeper.NewOrderbookMsgServerImpl(&am.keeper))
+	concentrated.RegisterMsgServer(cfg.MsgServer(), keeper.NewConcentratedMsgServerImpl(&am.keeper))
 	// stableswap.RegisterMsgServer(cfg.MsgServer(), keeper.NewStableswapMsgServerImpl(&am.keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQuerier(am.keeper))
+	orderbook.RegisterQueryServer(cfg.QueryServer(), keeper.NewQuerier(am.keeper))
+	concentrated.RegisterQueryServer(cfg.QueryServer(), keeper.NewQuerier(am.keeper))
 }
 
 func NewAppModule(cdc codec.Codec, keeper keeper.Keeper,
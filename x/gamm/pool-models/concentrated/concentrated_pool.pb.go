@@ -0,0 +1,2143 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/gamm/pool-models/concentrated/concentrated_pool.proto
+
+package concentrated
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PoolParams defined the parameters that will be managed by the pool
+// governance in the future. This params are not managed by the chain
+// governance. Instead they will be managed by the token holders of the pool.
+// The pool's token holders are specified in future_pool_governor.
+type PoolParams struct {
+	SwapFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=swap_fee,json=swapFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_fee" yaml:"swap_fee"`
+	ExitFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=exit_fee,json=exitFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exit_fee" yaml:"exit_fee"`
+	// tick_spacing is the distance between two ticks that positions can be
+	// bounded by. Position ticks must be multiples of it.
+	TickSpacing uint64 `protobuf:"varint,3,opt,name=tick_spacing,json=tickSpacing,proto3" json:"tick_spacing,omitempty" yaml:"tick_spacing"`
+}
+
+func (m *PoolParams) Reset()         { *m = PoolParams{} }
+func (m *PoolParams) String() string { return proto.CompactTextString(m) }
+func (*PoolParams) ProtoMessage()    {}
+func (*PoolParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f6d4f20db5d256d, []int{0}
+}
+func (m *PoolParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolParams.Merge(m, src)
+}
+func (m *PoolParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolParams proto.InternalMessageInfo
+
+func (m *PoolParams) GetTickSpacing() uint64 {
+	if m != nil {
+		return m.TickSpacing
+	}
+	return 0
+}
+
+// TickInfo is the liquidity state of an initialized tick, i.e. a tick that
+// bounds at least one position.
+type TickInfo struct {
+	Index int64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty" yaml:"index"`
+	// liquidity_gross is the total liquidity of the positions bounded by this
+	// tick.
+	LiquidityGross github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=liquidity_gross,json=liquidityGross,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidity_gross" yaml:"liquidity_gross"`
+	// liquidity_net is the liquidity added to the active liquidity when the
+	// price crosses this tick upwards, and removed when it crosses downwards.
+	LiquidityNet github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=liquidity_net,json=liquidityNet,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidity_net" yaml:"liquidity_net"`
+	// fee_growth_outside0 and fee_growth_outside1 are the fees per unit of
+	// liquidity collected on the other side of this tick, relative to the
+	// current tick, in token0 and token1 respectively.
+	FeeGrowthOutside0 github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=fee_growth_outside0,json=feeGrowthOutside0,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_growth_outside0" yaml:"fee_growth_outside0"`
+	FeeGrowthOutside1 github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=fee_growth_outside1,json=feeGrowthOutside1,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_growth_outside1" yaml:"fee_growth_outside1"`
+}
+
+func (m *TickInfo) Reset()         { *m = TickInfo{} }
+func (m *TickInfo) String() string { return proto.CompactTextString(m) }
+func (*TickInfo) ProtoMessage()    {}
+func (*TickInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f6d4f20db5d256d, []int{1}
+}
+func (m *TickInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TickInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TickInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TickInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TickInfo.Merge(m, src)
+}
+func (m *TickInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *TickInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_TickInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TickInfo proto.InternalMessageInfo
+
+func (m *TickInfo) GetIndex() int64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+// Position is liquidity provided over the price range between two ticks.
+type Position struct {
+	PositionId uint64                                 `protobuf:"varint,1,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty" yaml:"position_id"`
+	Owner      string                                 `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	LowerTick  int64                                  `protobuf:"varint,3,opt,name=lower_tick,json=lowerTick,proto3" json:"lower_tick,omitempty" yaml:"lower_tick"`
+	UpperTick  int64                                  `protobuf:"varint,4,opt,name=upper_tick,json=upperTick,proto3" json:"upper_tick,omitempty" yaml:"upper_tick"`
+	Liquidity  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=liquidity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidity" yaml:"liquidity"`
+	// fee_growth_inside_last0 and fee_growth_inside_last1 are the fees per unit
+	// of liquidity collected inside the position range as of the last time the
+	// position was updated.
+	FeeGrowthInsideLast0 github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=fee_growth_inside_last0,json=feeGrowthInsideLast0,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_growth_inside_last0" yaml:"fee_growth_inside_last0"`
+	FeeGrowthInsideLast1 github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=fee_growth_inside_last1,json=feeGrowthInsideLast1,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_growth_inside_last1" yaml:"fee_growth_inside_last1"`
+	// tokens_owed are the tokens owed to the position that are not part of its
+	// liquidity, i.e. the uncollected swap fees.
+	TokensOwed github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,8,rep,name=tokens_owed,json=tokensOwed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"tokens_owed" yaml:"tokens_owed"`
+}
+
+func (m *Position) Reset()         { *m = Position{} }
+func (m *Position) String() string { return proto.CompactTextString(m) }
+func (*Position) ProtoMessage()    {}
+func (*Position) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f6d4f20db5d256d, []int{2}
+}
+func (m *Position) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Position) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Position.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Position) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Position.Merge(m, src)
+}
+func (m *Position) XXX_Size() int {
+	return m.Size()
+}
+func (m *Position) XXX_DiscardUnknown() {
+	xxx_messageInfo_Position.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Position proto.InternalMessageInfo
+
+func (m *Position) GetPositionId() uint64 {
+	if m != nil {
+		return m.PositionId
+	}
+	return 0
+}
+
+func (m *Position) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *Position) GetLowerTick() int64 {
+	if m != nil {
+		return m.LowerTick
+	}
+	return 0
+}
+
+func (m *Position) GetUpperTick() int64 {
+	if m != nil {
+		return m.UpperTick
+	}
+	return 0
+}
+
+func (m *Position) GetTokensOwed() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.TokensOwed
+	}
+	return nil
+}
+
+// Pool is the concentrated liquidity Pool struct. LPs provide liquidity over
+// price ranges through positions, and the LP shares of the pool represent a
+// full range position owned by all shareholders.
+type Pool struct {
+	Address    string     `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	Id         uint64     `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	PoolParams PoolParams `protobuf:"bytes,3,opt,name=pool_params,json=poolParams,proto3" json:"pool_params" yaml:"concentrated_pool_params"`
+	// This string specifies who will govern the pool in the future.
+	// Valid forms of this are:
+	// {token name},{duration}
+	// {duration}
+	// where {token name} if specified is the token which determines the
+	// governor, and if not specified is the LP token for this pool.duration is
+	// a time specified as 0w,1w,2w, etc. which specifies how long the token
+	// would need to be locked up to count in governance. 0w means no lockup.
+	FuturePoolGovernor string `protobuf:"bytes,4,opt,name=future_pool_governor,json=futurePoolGovernor,proto3" json:"future_pool_governor,omitempty" yaml:"future_pool_governor"`
+	// sum of all LP shares
+	TotalShares types.Coin `protobuf:"bytes,5,opt,name=total_shares,json=totalShares,proto3" json:"total_shares" yaml:"total_shares"`
+	// token0 and token1 are the denoms of the pool, sorted. The price of the
+	// pool is denominated in token1 per token0.
+	Token0           string                                 `protobuf:"bytes,6,opt,name=token0,proto3" json:"token0,omitempty" yaml:"token0"`
+	Token1           string                                 `protobuf:"bytes,7,opt,name=token1,proto3" json:"token1,omitempty" yaml:"token1"`
+	CurrentSqrtPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=current_sqrt_price,json=currentSqrtPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"current_sqrt_price" yaml:"current_sqrt_price"`
+	// current_tick is the largest tick whose price is lower or equal to the
+	// current price.
+	CurrentTick int64 `protobuf:"varint,9,opt,name=current_tick,json=currentTick,proto3" json:"current_tick,omitempty" yaml:"current_tick"`
+	// current_liquidity is the liquidity of the positions whose range contains
+	// the current price.
+	CurrentLiquidity github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=current_liquidity,json=currentLiquidity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"current_liquidity" yaml:"current_liquidity"`
+	// fee_growth_global0 and fee_growth_global1 are the total fees per unit of
+	// liquidity collected by the pool, in token0 and token1 respectively.
+	FeeGrowthGlobal0 github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=fee_growth_global0,json=feeGrowthGlobal0,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_growth_global0" yaml:"fee_growth_global0"`
+	FeeGrowthGlobal1 github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=fee_growth_global1,json=feeGrowthGlobal1,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_growth_global1" yaml:"fee_growth_global1"`
+	// initialized ticks, sorted by ascending index.
+	Ticks []TickInfo `protobuf:"bytes,13,rep,name=ticks,proto3" json:"ticks" yaml:"ticks"`
+	// shares_position is the full range position backing the LP shares. Its
+	// tokens_owed also hold the rounding dust of share joins and exits.
+	SharesPosition Position `protobuf:"bytes,14,opt,name=shares_position,json=sharesPosition,proto3" json:"shares_position" yaml:"shares_position"`
+	// positions is only set in genesis. Positions opened by LPs are stored apart
+	// from the pool while it is in state, so that loading the pool does not load
+	// all of its positions.
+	Positions      []Position `protobuf:"bytes,15,rep,name=positions,proto3" json:"positions" yaml:"positions"`
+	NextPositionId uint64     `protobuf:"varint,16,opt,name=next_position_id,json=nextPositionId,proto3" json:"next_position_id,omitempty" yaml:"next_position_id"`
+}
+
+func (m *Pool) Reset()      { *m = Pool{} }
+func (*Pool) ProtoMessage() {}
+func (*Pool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f6d4f20db5d256d, []int{3}
+}
+func (m *Pool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Pool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Pool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Pool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Pool.Merge(m, src)
+}
+func (m *Pool) XXX_Size() int {
+	return m.Size()
+}
+func (m *Pool) XXX_DiscardUnknown() {
+	xxx_messageInfo_Pool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Pool proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*PoolParams)(nil), "osmosis.gamm.poolmodels.concentrated.v1beta1.PoolParams")
+	proto.RegisterType((*TickInfo)(nil), "osmosis.gamm.poolmodels.concentrated.v1beta1.TickInfo")
+	proto.RegisterType((*Position)(nil), "osmosis.gamm.poolmodels.concentrated.v1beta1.Position")
+	proto.RegisterType((*Pool)(nil), "osmosis.gamm.poolmodels.concentrated.v1beta1.Pool")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/gamm/pool-models/concentrated/concentrated_pool.proto", fileDescriptor_5f6d4f20db5d256d)
+}
+
+var fileDescriptor_5f6d4f20db5d256d = []byte{
+	// 1149 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xb7, 0x13, 0xa7, 0x89, 0xc7, 0xae, 0xe3, 0x4c, 0x43, 0xbb, 0x49, 0x90, 0x37, 0x9a, 0x43,
+	0x09, 0xa2, 0xb1, 0xb3, 0x01, 0x01, 0x8a, 0xc4, 0x81, 0x2d, 0x34, 0x04, 0x22, 0x6a, 0x26, 0x5c,
+	0x40, 0xa0, 0x65, 0xed, 0x9d, 0x38, 0xab, 0xac, 0x77, 0x9c, 0x9d, 0x71, 0x9c, 0x48, 0x48, 0x48,
+	0x08, 0x89, 0x9e, 0x10, 0x47, 0x8e, 0x15, 0x47, 0xce, 0x7c, 0x88, 0x8a, 0x53, 0x8f, 0x15, 0x87,
+	0x05, 0x25, 0xdf, 0xc0, 0x9f, 0x00, 0xcd, 0x9f, 0x5d, 0x6f, 0x1c, 0xab, 0xc2, 0xb4, 0x9c, 0xbc,
+	0xef, 0xdf, 0xef, 0xf7, 0x66, 0xe6, 0xcd, 0x7b, 0x63, 0xf0, 0x1e, 0x65, 0x5d, 0xca, 0x7c, 0xd6,
+	0xe8, 0xb8, 0xdd, 0x6e, 0xa3, 0x47, 0x69, 0xb0, 0xd9, 0xa5, 0x1e, 0x09, 0x58, 0xa3, 0x4d, 0xc3,
+	0x36, 0x09, 0x79, 0xe4, 0x72, 0xe2, 0x5d, 0x11, 0x1c, 0xe1, 0x55, 0xef, 0x45, 0x94, 0x53, 0x78,
+	0x4f, 0x87, 0xd7, 0x45, 0x78, 0x5d, 0x18, 0x54, 0x74, 0x3d, 0x1b, 0x50, 0x3f, 0xb5, 0x5a, 0x84,
+	0xbb, 0xd6, 0xea, 0x4a, 0x5b, 0xba, 0x3b, 0x32, 0xb6, 0xa1, 0x04, 0x05, 0xb4, 0xba, 0xdc, 0xa1,
+	0x1d, 0xaa, 0xf4, 0xe2, 0x4b, 0x6b, 0x6b, 0xca, 0xa7, 0xd1, 0x72, 0x19, 0x69, 0x68, 0x94, 0x46,
+	0x9b, 0xfa, 0xa1, 0xb2, 0xa3, 0x1f, 0x66, 0x00, 0x68, 0x52, 0x1a, 0x34, 0xdd, 0xc8, 0xed, 0x32,
+	0xf8, 0x15, 0x58, 0x60, 0x03, 0xb7, 0xe7, 0x1c, 0x12, 0x62, 0xe4, 0xd7, 0xf3, 0x1b, 0x45, 0xfb,
+	0xfd, 0x27, 0xb1, 0x99, 0xfb, 0x33, 0x36, 0xef, 0x76, 0x7c, 0x7e, 0xd4, 0x6f, 0xd5, 0xdb, 0xb4,
+	0xab, 0x79, 0xf5, 0xcf, 0x26, 0xf3, 0x8e, 0x1b, 0xfc, 0xbc, 0x47, 0x58, 0xfd, 0x03, 0xd2, 0x1e,
+	0xc6, 0xe6, 0xe2, 0xb9, 0xdb, 0x0d, 0x76, 0x50, 0x82, 0x83, 0xf0, 0xbc, 0xf8, 0x7c, 0x40, 0x88,
+	0x40, 0x27, 0x67, 0x3e, 0x97, 0xe8, 0x33, 0x2f, 0x86, 0x9e, 0xe0, 0x20, 0x3c, 0x2f, 0x3e, 0x05,
+	0xfa, 0x0e, 0x28, 0x73, 0xbf, 0x7d, 0xec, 0xb0, 0x9e, 0xdb, 0xf6, 0xc3, 0x8e, 0x31, 0xbb, 0x9e,
+	0xdf, 0x28, 0xd8, 0x77, 0x86, 0xb1, 0x79, 0x4b, 0xc5, 0x64, 0xad, 0x08, 0x97, 0x84, 0x78, 0xa0,
+	0xa5, 0x9f, 0x0a, 0x60, 0xe1, 0x73, 0xbf, 0x7d, 0xbc, 0x17, 0x1e, 0x52, 0x78, 0x17, 0xcc, 0xf9,
+	0xa1, 0x47, 0xce, 0xe4, 0x0e, 0xcc, 0xda, 0xd5, 0x61, 0x6c, 0x96, 0x15, 0x82, 0x54, 0x23, 0xac,
+	0xcc, 0xf0, 0x04, 0x2c, 0x06, 0xfe, 0x49, 0xdf, 0xf7, 0x7c, 0x7e, 0xee, 0x74, 0x22, 0xca, 0x98,
+	0x5e, 0xd5, 0x47, 0x53, 0xaf, 0xea, 0xb6, 0xc2, 0x1f, 0x83, 0x43, 0xb8, 0x92, 0x6a, 0x76, 0x85,
+	0x02, 0x1e, 0x83, 0x9b, 0x23, 0x9f, 0x90, 0x70, 0xb9, 0xc8, 0xa2, 0xfd, 0x60, 0x6a, 0xc2, 0xe5,
+	0x71, 0xc2, 0x90, 0x70, 0x84, 0xcb, 0xa9, 0xfc, 0x29, 0xe1, 0xf0, 0x5b, 0x70, 0xeb, 0x90, 0x10,
+	0x91, 0xca, 0x80, 0x1f, 0x39, 0xb4, 0xcf, 0x99, 0xef, 0x91, 0x2d, 0xa3, 0x20, 0x29, 0xf7, 0xa7,
+	0xa6, 0x5c, 0x55, 0x94, 0x13, 0x20, 0x11, 0x5e, 0x3a, 0x24, 0x64, 0x57, 0x2a, 0x1f, 0x6a, 0xdd,
+	0x64, 0x76, 0xcb, 0x98, 0x7b, 0xd9, 0xec, 0xd6, 0x04, 0x76, 0x0b, 0x3d, 0x9b, 0x03, 0x0b, 0x4d,
+	0xca, 0x7c, 0xee, 0xd3, 0x10, 0xbe, 0x03, 0x4a, 0x3d, 0xfd, 0xed, 0xf8, 0x9e, 0x2c, 0x8b, 0x82,
+	0x7d, 0x7b, 0x18, 0x9b, 0x50, 0x81, 0x66, 0x8c, 0x08, 0x83, 0x44, 0xda, 0xf3, 0x44, 0x25, 0xd1,
+	0x41, 0x48, 0x22, 0x5d, 0x17, 0x99, 0x4a, 0x92, 0x6a, 0x84, 0x95, 0x19, 0xbe, 0x05, 0x40, 0x40,
+	0x07, 0x24, 0x72, 0x44, 0x4d, 0xca, 0x33, 0x9d, 0xb5, 0x5f, 0x19, 0xc6, 0xe6, 0x92, 0x3e, 0xa5,
+	0xd4, 0x86, 0x70, 0x51, 0x0a, 0xa2, 0x56, 0x45, 0x54, 0xbf, 0xd7, 0x4b, 0xa2, 0x0a, 0xe3, 0x51,
+	0x23, 0x1b, 0xc2, 0x45, 0x29, 0xc8, 0xa8, 0x6f, 0x40, 0x31, 0x3d, 0x65, 0xbd, 0x9b, 0xf6, 0xd4,
+	0xbb, 0x59, 0x1d, 0x2b, 0x1f, 0x91, 0x57, 0xf2, 0x0d, 0x7f, 0xcc, 0x83, 0x3b, 0x99, 0x7d, 0xf6,
+	0x43, 0xb1, 0xa5, 0x4e, 0xe0, 0x32, 0xbe, 0x65, 0xdc, 0x90, 0x84, 0xcd, 0xa9, 0x09, 0x6b, 0xd7,
+	0x8e, 0x2f, 0x0b, 0x8b, 0xf0, 0x72, 0x7a, 0x84, 0x7b, 0x52, 0xbf, 0x2f, 0xd4, 0xcf, 0xc9, 0xc4,
+	0x32, 0xe6, 0xff, 0x8f, 0x4c, 0xac, 0xc9, 0x99, 0x58, 0xf0, 0x51, 0x1e, 0x94, 0x38, 0x3d, 0x26,
+	0x21, 0x73, 0xe8, 0x80, 0x78, 0xc6, 0xc2, 0xfa, 0xec, 0x46, 0x69, 0xfb, 0xd5, 0xba, 0x6e, 0xe1,
+	0xa2, 0x3d, 0x27, 0x4d, 0x5e, 0xf0, 0xdc, 0xa7, 0x7e, 0x68, 0xef, 0x89, 0xdc, 0x46, 0x55, 0x96,
+	0x09, 0x47, 0xbf, 0xfd, 0x65, 0xbe, 0xf1, 0xef, 0x32, 0x16, 0x48, 0x0c, 0x03, 0x15, 0xfc, 0x50,
+	0xc4, 0xfe, 0x5a, 0x02, 0x05, 0xd1, 0xf2, 0xe1, 0x3d, 0x30, 0xef, 0x7a, 0x5e, 0x44, 0x18, 0xd3,
+	0xbd, 0x1e, 0x0e, 0x63, 0xb3, 0xa2, 0xc8, 0xb4, 0x01, 0xe1, 0xc4, 0x05, 0x56, 0xc0, 0x8c, 0xef,
+	0xc9, 0x42, 0x2e, 0xe0, 0x19, 0xdf, 0x83, 0xdf, 0xe7, 0xc5, 0xad, 0xa0, 0x81, 0xd3, 0x93, 0xa3,
+	0x43, 0x56, 0x6d, 0x69, 0xfb, 0xdd, 0xfa, 0x34, 0xf3, 0xac, 0x3e, 0x1a, 0x3d, 0xf6, 0x6b, 0x7a,
+	0xb5, 0xa6, 0x4a, 0xe0, 0xda, 0xbc, 0xd4, 0x3c, 0xf2, 0x82, 0xa5, 0xf3, 0xea, 0x33, 0xb0, 0x7c,
+	0xd8, 0xe7, 0xfd, 0x88, 0x28, 0x97, 0x0e, 0x3d, 0x25, 0x51, 0x48, 0x23, 0xdd, 0xa3, 0xcc, 0x61,
+	0x6c, 0xae, 0xe9, 0xe3, 0x9a, 0xe0, 0x85, 0x30, 0x54, 0x6a, 0x91, 0xc5, 0xae, 0x56, 0xc2, 0x2f,
+	0x40, 0x99, 0x53, 0xee, 0x06, 0x0e, 0x3b, 0x72, 0x23, 0xc2, 0xe4, 0x15, 0x29, 0x6d, 0xaf, 0x4c,
+	0x3c, 0x29, 0x79, 0x4c, 0x6b, 0x3a, 0xf1, 0x64, 0xca, 0x64, 0x82, 0xc5, 0x94, 0x11, 0xe2, 0x81,
+	0x94, 0xe0, 0xeb, 0xe0, 0x86, 0x3c, 0x87, 0xe4, 0x1a, 0x2c, 0x0d, 0x63, 0xf3, 0x66, 0xe6, 0x70,
+	0xb7, 0x10, 0xd6, 0x0e, 0xa9, 0x6b, 0x52, 0xa7, 0xe3, 0xae, 0x56, 0xe2, 0x6a, 0xc1, 0x73, 0x00,
+	0xdb, 0xfd, 0x28, 0x22, 0x21, 0x77, 0xd8, 0x49, 0xc4, 0x9d, 0x5e, 0xe4, 0xb7, 0x89, 0xb1, 0x20,
+	0xc3, 0x3e, 0x99, 0xba, 0xbc, 0x57, 0xf4, 0xf6, 0x5f, 0x43, 0x44, 0xb8, 0xaa, 0x95, 0x07, 0x27,
+	0x11, 0x6f, 0x0a, 0x95, 0x18, 0xb9, 0x89, 0xa3, 0xec, 0x41, 0x45, 0xd9, 0x83, 0x32, 0x23, 0x37,
+	0x6b, 0x45, 0xb8, 0xa4, 0x45, 0xd9, 0x87, 0x06, 0x60, 0x29, 0xb1, 0x8e, 0xfa, 0x11, 0x90, 0x59,
+	0x7f, 0x3c, 0x75, 0xd6, 0xc6, 0x55, 0xba, 0x4c, 0x5f, 0x4a, 0x92, 0xde, 0x4f, 0x54, 0x62, 0xbf,
+	0x32, 0x97, 0xb7, 0x13, 0xd0, 0x96, 0x1b, 0x6c, 0x19, 0xa5, 0x17, 0xdb, 0xaf, 0xeb, 0x88, 0x08,
+	0x57, 0xd3, 0x4e, 0xb0, 0xab, 0x54, 0x13, 0xa9, 0x2d, 0xa3, 0xfc, 0x92, 0xa9, 0xad, 0xeb, 0xd4,
+	0x16, 0x6c, 0x81, 0x39, 0x71, 0x08, 0xcc, 0xb8, 0x29, 0x3b, 0xcf, 0xdb, 0xd3, 0xdd, 0xd3, 0xe4,
+	0x6d, 0x64, 0x2f, 0xeb, 0x62, 0x2f, 0x8f, 0x9e, 0x54, 0x0c, 0x61, 0x05, 0x0d, 0xbf, 0x03, 0x8b,
+	0xaa, 0xee, 0x9d, 0x64, 0x06, 0x1a, 0x95, 0xf5, 0xfc, 0xf4, 0x6c, 0xc9, 0xe0, 0xb5, 0x6b, 0x9a,
+	0x4d, 0x3f, 0x8f, 0xc6, 0xc0, 0x11, 0xae, 0x28, 0x4d, 0x3a, 0xa8, 0x43, 0x50, 0x4c, 0x8c, 0xcc,
+	0x58, 0xfc, 0x2f, 0x0b, 0x4d, 0xa9, 0x0d, 0x4d, 0x5d, 0xbd, 0x3a, 0xe2, 0x19, 0xc2, 0x23, 0x0a,
+	0xf8, 0x21, 0xa8, 0x86, 0xe4, 0x8c, 0x3b, 0xd9, 0xd7, 0x41, 0x55, 0xbe, 0x0e, 0xd6, 0x86, 0xb1,
+	0x79, 0x47, 0x85, 0x8e, 0x7b, 0x20, 0x5c, 0x11, 0xaa, 0x66, 0xfa, 0x4c, 0xd8, 0x59, 0x7a, 0xf4,
+	0xd8, 0xcc, 0xfd, 0xf2, 0xd8, 0xcc, 0xfd, 0xf1, 0xfb, 0xe6, 0x9c, 0x68, 0x46, 0x7b, 0xf6, 0xd7,
+	0x4f, 0x2e, 0x6a, 0xf9, 0xa7, 0x17, 0xb5, 0xfc, 0xdf, 0x17, 0xb5, 0xfc, 0xcf, 0x97, 0xb5, 0xdc,
+	0xd3, 0xcb, 0x5a, 0xee, 0xd9, 0x65, 0x2d, 0xf7, 0xe5, 0xfd, 0x4c, 0x7d, 0xe8, 0xa5, 0x6d, 0x06,
+	0x6e, 0x8b, 0x25, 0x42, 0xe3, 0xd4, 0xda, 0x6e, 0x9c, 0x3d, 0xff, 0xdf, 0x48, 0xeb, 0x86, 0x7c,
+	0xfd, 0xbf, 0xf9, 0xcf, 0x00, 0xb0, 0x75, 0x3d, 0xf7, 0xbd, 0x0c, 0x00, 0x00,
+}
+
+func (m *PoolParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TickSpacing != 0 {
+		i = encodeVarintConcentratedPool(dAtA, i, uint64(m.TickSpacing))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.ExitFee.Size()
+		i -= size
+		if _, err := m.ExitFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintConcentratedPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.SwapFee.Size()
+		i -= size
+		if _, err := m.SwapFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintConcentratedPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TickInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TickInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TickInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.FeeGrowthOutside1.Size()
+		i -= size
+		if _, err := m.FeeGrowthOutside1.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintConcentratedPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.FeeGrowthOutside0.Size()
+		i -= size
+		if _, err := m.FeeGrowthOutside0.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintConcentratedPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.LiquidityNet.Size()
+		i -= size
+		if _, err := m.LiquidityNet.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintConcentratedPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.LiquidityGross.Size()
+		i -= size
+		if _, err := m.LiquidityGross.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintConcentratedPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Index != 0 {
+		i = encodeVarintConcentratedPool(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Position) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Position) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Position) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokensOwed) > 0 {
+		for iNdEx := len(m.TokensOwed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokensOwed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintConcentratedPool(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	{
+		size := m.FeeGrowthInsideLast1.Size()
+		i -= size
+		if _, err := m.FeeGrowthInsideLast1.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintConcentratedPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.FeeGrowthInsideLast0.Size()
+		i -= size
+		if _, err := m.FeeGrowthInsideLast0.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintConcentratedPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Liquidity.Size()
+		i -= size
+		if _, err := m.Liquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintConcentratedPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.UpperTick != 0 {
+		i = encodeVarintConcentratedPool(dAtA, i, uint64(m.UpperTick))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LowerTick != 0 {
+		i = encodeVarintConcentratedPool(dAtA, i, uint64(m.LowerTick))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintConcentratedPool(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PositionId != 0 {
+		i = encodeVarintConcentratedPool(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Pool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Pool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextPositionId != 0 {
+		i = encodeVarintConcentratedPool(dAtA, i, uint64(m.NextPositionId))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.Positions) > 0 {
+		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintConcentratedPool(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	{
+		size, err := m.SharesPosition.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintConcentratedPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	if len(m.Ticks) > 0 {
+		for iNdEx := len(m.Ticks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ticks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintConcentratedPool(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	{
+		size := m.FeeGrowthGlobal1.Size()
+		i -= size
+		if _, err := m.FeeGrowthGlobal1.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintConcentratedPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.FeeGrowthGlobal0.Size()
+		i -= size
+		if _, err := m.FeeGrowthGlobal0.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintConcentratedPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.CurrentLiquidity.Size()
+		i -= size
+		if _, err := m.CurrentLiquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintConcentratedPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if m.CurrentTick != 0 {
+		i = encodeVarintConcentratedPool(dAtA, i, uint64(m.CurrentTick))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.CurrentSqrtPrice.Size()
+		i -= size
+		if _, err := m.CurrentSqrtPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintConcentratedPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.Token1) > 0 {
+		i -= len(m.Token1)
+		copy(dAtA[i:], m.Token1)
+		i = encodeVarintConcentratedPool(dAtA, i, uint64(len(m.Token1)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Token0) > 0 {
+		i -= len(m.Token0)
+		copy(dAtA[i:], m.Token0)
+		i = encodeVarintConcentratedPool(dAtA, i, uint64(len(m.Token0)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size, err := m.TotalShares.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintConcentratedPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.FuturePoolGovernor) > 0 {
+		i -= len(m.FuturePoolGovernor)
+		copy(dAtA[i:], m.FuturePoolGovernor)
+		i = encodeVarintConcentratedPool(dAtA, i, uint64(len(m.FuturePoolGovernor)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.PoolParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintConcentratedPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Id != 0 {
+		i = encodeVarintConcentratedPool(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintConcentratedPool(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintConcentratedPool(dAtA []byte, offset int, v uint64) int {
+	offset -= sovConcentratedPool(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PoolParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SwapFee.Size()
+	n += 1 + l + sovConcentratedPool(uint64(l))
+	l = m.ExitFee.Size()
+	n += 1 + l + sovConcentratedPool(uint64(l))
+	if m.TickSpacing != 0 {
+		n += 1 + sovConcentratedPool(uint64(m.TickSpacing))
+	}
+	return n
+}
+
+func (m *TickInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovConcentratedPool(uint64(m.Index))
+	}
+	l = m.LiquidityGross.Size()
+	n += 1 + l + sovConcentratedPool(uint64(l))
+	l = m.LiquidityNet.Size()
+	n += 1 + l + sovConcentratedPool(uint64(l))
+	l = m.FeeGrowthOutside0.Size()
+	n += 1 + l + sovConcentratedPool(uint64(l))
+	l = m.FeeGrowthOutside1.Size()
+	n += 1 + l + sovConcentratedPool(uint64(l))
+	return n
+}
+
+func (m *Position) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		n += 1 + sovConcentratedPool(uint64(m.PositionId))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovConcentratedPool(uint64(l))
+	}
+	if m.LowerTick != 0 {
+		n += 1 + sovConcentratedPool(uint64(m.LowerTick))
+	}
+	if m.UpperTick != 0 {
+		n += 1 + sovConcentratedPool(uint64(m.UpperTick))
+	}
+	l = m.Liquidity.Size()
+	n += 1 + l + sovConcentratedPool(uint64(l))
+	l = m.FeeGrowthInsideLast0.Size()
+	n += 1 + l + sovConcentratedPool(uint64(l))
+	l = m.FeeGrowthInsideLast1.Size()
+	n += 1 + l + sovConcentratedPool(uint64(l))
+	if len(m.TokensOwed) > 0 {
+		for _, e := range m.TokensOwed {
+			l = e.Size()
+			n += 1 + l + sovConcentratedPool(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Pool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovConcentratedPool(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovConcentratedPool(uint64(m.Id))
+	}
+	l = m.PoolParams.Size()
+	n += 1 + l + sovConcentratedPool(uint64(l))
+	l = len(m.FuturePoolGovernor)
+	if l > 0 {
+		n += 1 + l + sovConcentratedPool(uint64(l))
+	}
+	l = m.TotalShares.Size()
+	n += 1 + l + sovConcentratedPool(uint64(l))
+	l = len(m.Token0)
+	if l > 0 {
+		n += 1 + l + sovConcentratedPool(uint64(l))
+	}
+	l = len(m.Token1)
+	if l > 0 {
+		n += 1 + l + sovConcentratedPool(uint64(l))
+	}
+	l = m.CurrentSqrtPrice.Size()
+	n += 1 + l + sovConcentratedPool(uint64(l))
+	if m.CurrentTick != 0 {
+		n += 1 + sovConcentratedPool(uint64(m.CurrentTick))
+	}
+	l = m.CurrentLiquidity.Size()
+	n += 1 + l + sovConcentratedPool(uint64(l))
+	l = m.FeeGrowthGlobal0.Size()
+	n += 1 + l + sovConcentratedPool(uint64(l))
+	l = m.FeeGrowthGlobal1.Size()
+	n += 1 + l + sovConcentratedPool(uint64(l))
+	if len(m.Ticks) > 0 {
+		for _, e := range m.Ticks {
+			l = e.Size()
+			n += 1 + l + sovConcentratedPool(uint64(l))
+		}
+	}
+	l = m.SharesPosition.Size()
+	n += 1 + l + sovConcentratedPool(uint64(l))
+	if len(m.Positions) > 0 {
+		for _, e := range m.Positions {
+			l = e.Size()
+			n += 1 + l + sovConcentratedPool(uint64(l))
+		}
+	}
+	if m.NextPositionId != 0 {
+		n += 2 + sovConcentratedPool(uint64(m.NextPositionId))
+	}
+	return n
+}
+
+func sovConcentratedPool(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozConcentratedPool(x uint64) (n int) {
+	return sovConcentratedPool(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PoolParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConcentratedPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExitFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickSpacing", wireType)
+			}
+			m.TickSpacing = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TickSpacing |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConcentratedPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TickInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConcentratedPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TickInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TickInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityGross", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityGross.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityNet", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityNet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeGrowthOutside0", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeGrowthOutside0.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeGrowthOutside1", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeGrowthOutside1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConcentratedPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Position) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConcentratedPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Position: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Position: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerTick", wireType)
+			}
+			m.LowerTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LowerTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperTick", wireType)
+			}
+			m.UpperTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpperTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Liquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeGrowthInsideLast0", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeGrowthInsideLast0.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeGrowthInsideLast1", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeGrowthInsideLast1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokensOwed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokensOwed = append(m.TokensOwed, types.DecCoin{})
+			if err := m.TokensOwed[len(m.TokensOwed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConcentratedPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Pool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConcentratedPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PoolParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FuturePoolGovernor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FuturePoolGovernor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalShares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token0", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token0 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token1", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token1 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentSqrtPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentSqrtPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentTick", wireType)
+			}
+			m.CurrentTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentLiquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentLiquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeGrowthGlobal0", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeGrowthGlobal0.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeGrowthGlobal1", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeGrowthGlobal1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ticks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ticks = append(m.Ticks, TickInfo{})
+			if err := m.Ticks[len(m.Ticks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharesPosition", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SharesPosition.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Positions = append(m.Positions, Position{})
+			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPositionId", wireType)
+			}
+			m.NextPositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextPositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConcentratedPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipConcentratedPool(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowConcentratedPool
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthConcentratedPool
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupConcentratedPool
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthConcentratedPool
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthConcentratedPool        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowConcentratedPool          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupConcentratedPool = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,91 @@
+package concentrated
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// MinTick and MaxTick bound the ticks of a pool. The price of tick i is 1.0001^i,
+	// so pools support prices between roughly 1e-13 and 1e13.
+	MinTick int64 = -300_000
+	MaxTick int64 = 300_000
+)
+
+// sqrtTickBase is the square root of 1.0001, the price ratio between two adjacent ticks.
+var sqrtTickBase = sdk.MustNewDecFromStr("1.000049998750062496")
+
+// TickToSqrtPrice returns the square root of the price of the given tick, 1.0001^(tick/2).
+// The tick is assumed to be within [MinTick, MaxTick].
+func TickToSqrtPrice(tick int64) sdk.Dec {
+	if tick < 0 {
+		return sdk.OneDec().Quo(sqrtTickBase.Power(uint64(-tick)))
+	}
+	return sqrtTickBase.Power(uint64(tick))
+}
+
+// SqrtPriceToTick returns the largest tick whose square root price is lower or equal to sqrtPrice,
+// bounded to [MinTick, MaxTick].
+func SqrtPriceToTick(sqrtPrice sdk.Dec) int64 {
+	low, high := MinTick, MaxTick
+	for low < high {
+		// round up, so that the loop always makes progress.
+		mid := low + (high-low+1)/2
+		if TickToSqrtPrice(mid).LTE(sqrtPrice) {
+			low = mid
+		} else {
+			high = mid - 1
+		}
+	}
+	return low
+}
+
+// getAmount0 returns the amount of token0 held by liquidity over the range [sqrtPriceA, sqrtPriceB].
+// amount0 = liquidity * (sqrtPriceB - sqrtPriceA) / (sqrtPriceA * sqrtPriceB)
+func getAmount0(liquidity, sqrtPriceA, sqrtPriceB sdk.Dec) sdk.Dec {
+	return liquidity.Mul(sqrtPriceB.Sub(sqrtPriceA)).Quo(sqrtPriceB).Quo(sqrtPriceA)
+}
+
+// getAmount1 returns the amount of token1 held by liquidity over the range [sqrtPriceA, sqrtPriceB].
+// amount1 = liquidity * (sqrtPriceB - sqrtPriceA)
+func getAmount1(liquidity, sqrtPriceA, sqrtPriceB sdk.Dec) sdk.Dec {
+	return liquidity.Mul(sqrtPriceB.Sub(sqrtPriceA))
+}
+
+// getLiquidity0 returns the liquidity that amount0 of token0 provides over the range [sqrtPriceA, sqrtPriceB],
+// rounding down.
+func getLiquidity0(amount0, sqrtPriceA, sqrtPriceB sdk.Dec) sdk.Dec {
+	return amount0.MulTruncate(sqrtPriceA).MulTruncate(sqrtPriceB).QuoTruncate(sqrtPriceB.Sub(sqrtPriceA))
+}
+
+// getLiquidity1 returns the liquidity that amount1 of token1 provides over the range [sqrtPriceA, sqrtPriceB],
+// rounding down.
+func getLiquidity1(amount1, sqrtPriceA, sqrtPriceB sdk.Dec) sdk.Dec {
+	return amount1.QuoTruncate(sqrtPriceB.Sub(sqrtPriceA))
+}
+
+// getAmountsForLiquidity returns the amounts of token0 and token1 held by liquidity over the range
+// [sqrtPriceA, sqrtPriceB], when the pool is at sqrtPrice.
+// Below the range, the liquidity is only held in token0, and above it only in token1.
+func getAmountsForLiquidity(liquidity, sqrtPrice, sqrtPriceA, sqrtPriceB sdk.Dec) (amount0, amount1 sdk.Dec) {
+	switch {
+	case sqrtPrice.LTE(sqrtPriceA):
+		return getAmount0(liquidity, sqrtPriceA, sqrtPriceB), sdk.ZeroDec()
+	case sqrtPrice.GTE(sqrtPriceB):
+		return sdk.ZeroDec(), getAmount1(liquidity, sqrtPriceA, sqrtPriceB)
+	default:
+		return getAmount0(liquidity, sqrtPrice, sqrtPriceB), getAmount1(liquidity, sqrtPriceA, sqrtPrice)
+	}
+}
+
+// getLiquidityForAmounts returns the largest liquidity over the range [sqrtPriceA, sqrtPriceB] that can be
+// provided with amount0 of token0 and amount1 of token1, when the pool is at sqrtPrice.
+func getLiquidityForAmounts(sqrtPrice, sqrtPriceA, sqrtPriceB, amount0, amount1 sdk.Dec) sdk.Dec {
+	switch {
+	case sqrtPrice.LTE(sqrtPriceA):
+		return getLiquidity0(amount0, sqrtPriceA, sqrtPriceB)
+	case sqrtPrice.GTE(sqrtPriceB):
+		return getLiquidity1(amount1, sqrtPriceA, sqrtPriceB)
+	default:
+		return sdk.MinDec(getLiquidity0(amount0, sqrtPrice, sqrtPriceB), getLiquidity1(amount1, sqrtPriceA, sqrtPrice))
+	}
+}
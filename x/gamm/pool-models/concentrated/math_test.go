@@ -0,0 +1,65 @@
+package concentrated
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestTickToSqrtPrice(t *testing.T) {
+	require.Equal(t, sdk.OneDec(), TickToSqrtPrice(0))
+
+	// 1.0001^23027 <= 10 < 1.0001^23028
+	ten := sdk.NewDec(10)
+	require.True(t, TickToSqrtPrice(23027).Power(2).LTE(ten))
+	require.True(t, TickToSqrtPrice(23028).Power(2).GT(ten))
+
+	// negative ticks are the inverse of positive ones
+	require.Equal(t, sdk.OneDec().Quo(TickToSqrtPrice(23027)), TickToSqrtPrice(-23027))
+
+	// the bounds of the pool cover prices from 1e-13 to 1e13
+	require.True(t, TickToSqrtPrice(MaxTick).Power(2).GT(sdk.NewDec(10_000_000_000_000)))
+	require.True(t, TickToSqrtPrice(MinTick).Power(2).LT(sdk.NewDecWithPrec(1, 13)))
+}
+
+func TestSqrtPriceToTick(t *testing.T) {
+	for _, tick := range []int64{MinTick, -123_457, -1, 0, 1, 23_027, 98_765, MaxTick} {
+		sqrtPrice := TickToSqrtPrice(tick)
+		require.Equal(t, tick, SqrtPriceToTick(sqrtPrice))
+		// prices between two ticks belong to the lower one
+		require.Equal(t, tick, SqrtPriceToTick(sqrtPrice.Add(sdk.SmallestDec())))
+	}
+
+	// prices out of the bounds are clamped
+	require.Equal(t, MinTick, SqrtPriceToTick(sdk.SmallestDec()))
+	require.Equal(t, MaxTick, SqrtPriceToTick(sdk.NewDec(1_000_000_000)))
+}
+
+func TestLiquidityForAmounts(t *testing.T) {
+	sqrtPriceA, sqrtPriceB := TickToSqrtPrice(22000), TickToSqrtPrice(24000)
+	amount0, amount1 := sdk.NewDec(1_000_000), sdk.NewDec(10_000_000)
+
+	tests := map[string]struct {
+		sqrtPrice sdk.Dec
+	}{
+		"below range":  {sqrtPrice: TickToSqrtPrice(21000)},
+		"in range":     {sqrtPrice: TickToSqrtPrice(23027)},
+		"above range":  {sqrtPrice: TickToSqrtPrice(25000)},
+		"at the lower": {sqrtPrice: sqrtPriceA},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			liquidity := getLiquidityForAmounts(tc.sqrtPrice, sqrtPriceA, sqrtPriceB, amount0, amount1)
+			require.True(t, liquidity.IsPositive())
+
+			// the liquidity never requires more than the given amounts, and fully uses one of them
+			gotAmount0, gotAmount1 := getAmountsForLiquidity(liquidity, tc.sqrtPrice, sqrtPriceA, sqrtPriceB)
+			require.True(t, gotAmount0.Ceil().LTE(amount0))
+			require.True(t, gotAmount1.Ceil().LTE(amount1))
+			require.True(t, gotAmount0.Ceil().Equal(amount0) || gotAmount1.Ceil().Equal(amount1))
+		})
+	}
+}
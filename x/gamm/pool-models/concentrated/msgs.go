@@ -0,0 +1,191 @@
+package concentrated
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/types"
+)
+
+const (
+	TypeMsgCreateConcentratedPool = "create_concentrated_pool"
+	TypeMsgCreatePosition         = "create_position"
+	TypeMsgWithdrawPosition       = "withdraw_position"
+	TypeMsgCollectFees            = "collect_fees"
+)
+
+var (
+	_ sdk.Msg             = &MsgCreateConcentratedPool{}
+	_ types.CreatePoolMsg = &MsgCreateConcentratedPool{}
+)
+
+func NewMsgCreateConcentratedPool(
+	sender sdk.AccAddress,
+	poolParams PoolParams,
+	initialLiquidity sdk.Coins,
+	futurePoolGovernor string,
+) MsgCreateConcentratedPool {
+	return MsgCreateConcentratedPool{
+		Sender:               sender.String(),
+		PoolParams:           poolParams,
+		InitialPoolLiquidity: initialLiquidity,
+		FuturePoolGovernor:   futurePoolGovernor,
+	}
+}
+
+func (msg MsgCreateConcentratedPool) Route() string { return types.RouterKey }
+func (msg MsgCreateConcentratedPool) Type() string  { return TypeMsgCreateConcentratedPool }
+func (msg MsgCreateConcentratedPool) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	err = msg.PoolParams.Validate()
+	if err != nil {
+		return err
+	}
+
+	// validation for pool initial liquidity
+	if err = validateInitialLiquidity(msg.InitialPoolLiquidity); err != nil {
+		return err
+	}
+
+	// validation for future governor
+	if err = types.ValidateFutureGovernor(msg.FuturePoolGovernor); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (msg MsgCreateConcentratedPool) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCreateConcentratedPool) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+/// Implement the CreatePoolMsg interface
+
+func (msg MsgCreateConcentratedPool) PoolCreator() sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return sender
+}
+
+func (msg MsgCreateConcentratedPool) Validate(ctx sdk.Context) error {
+	return msg.ValidateBasic()
+}
+
+func (msg MsgCreateConcentratedPool) InitialLiquidity() sdk.Coins {
+	return msg.InitialPoolLiquidity
+}
+
+func (msg MsgCreateConcentratedPool) CreatePool(ctx sdk.Context, poolId uint64) (types.PoolI, error) {
+	concentratedPool, err := NewConcentratedPool(poolId, msg.PoolParams, msg.InitialPoolLiquidity,
+		msg.FuturePoolGovernor)
+	if err != nil {
+		return nil, err
+	}
+
+	return &concentratedPool, nil
+}
+
+var _ sdk.Msg = &MsgCreatePosition{}
+
+func (msg MsgCreatePosition) Route() string { return types.RouterKey }
+func (msg MsgCreatePosition) Type() string  { return TypeMsgCreatePosition }
+func (msg MsgCreatePosition) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if msg.LowerTick >= msg.UpperTick {
+		return sdkerrors.Wrapf(types.ErrInvalidPositionTicks, "lower tick %d must be less than upper tick %d", msg.LowerTick, msg.UpperTick)
+	}
+
+	if !msg.TokensDesired.IsValid() || !msg.TokensDesired.IsAllPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.TokensDesired.String())
+	}
+
+	if !msg.TokensMin.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.TokensMin.String())
+	}
+
+	return nil
+}
+
+func (msg MsgCreatePosition) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCreatePosition) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgWithdrawPosition{}
+
+func (msg MsgWithdrawPosition) Route() string { return types.RouterKey }
+func (msg MsgWithdrawPosition) Type() string  { return TypeMsgWithdrawPosition }
+func (msg MsgWithdrawPosition) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if msg.Liquidity.IsNil() || !msg.Liquidity.IsPositive() {
+		return sdkerrors.Wrapf(types.ErrInvalidLiquidity, "liquidity to withdraw must be positive, got %s", msg.Liquidity)
+	}
+
+	return nil
+}
+
+func (msg MsgWithdrawPosition) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgWithdrawPosition) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgCollectFees{}
+
+func (msg MsgCollectFees) Route() string { return types.RouterKey }
+func (msg MsgCollectFees) Type() string  { return TypeMsgCollectFees }
+func (msg MsgCollectFees) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	return nil
+}
+
+func (msg MsgCollectFees) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCollectFees) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
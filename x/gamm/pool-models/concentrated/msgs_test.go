@@ -0,0 +1,230 @@
+package concentrated_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	appParams "github.com/osmosis-labs/osmosis/v12/app/params"
+	concentrated "github.com/osmosis-labs/osmosis/v12/x/gamm/pool-models/concentrated"
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/types"
+)
+
+func TestMsgCreateConcentratedPool(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	createMsg := func(after func(msg concentrated.MsgCreateConcentratedPool) concentrated.MsgCreateConcentratedPool) concentrated.MsgCreateConcentratedPool {
+		testPoolAsset := sdk.Coins{
+			sdk.NewCoin("atom", sdk.NewInt(100)),
+			sdk.NewCoin("osmo", sdk.NewInt(100)),
+		}
+
+		poolParams := concentrated.PoolParams{
+			SwapFee:     sdk.NewDecWithPrec(1, 2),
+			ExitFee:     sdk.NewDecWithPrec(1, 2),
+			TickSpacing: 10,
+		}
+
+		msg := &concentrated.MsgCreateConcentratedPool{
+			Sender:               addr1,
+			PoolParams:           poolParams,
+			InitialPoolLiquidity: testPoolAsset,
+			FuturePoolGovernor:   "",
+		}
+
+		return after(*msg)
+	}
+
+	default_msg := createMsg(func(msg concentrated.MsgCreateConcentratedPool) concentrated.MsgCreateConcentratedPool {
+		// Do nothing
+		return msg
+	})
+
+	require.Equal(t, default_msg.Route(), types.RouterKey)
+	require.Equal(t, default_msg.Type(), "create_concentrated_pool")
+	signers := default_msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	tests := []struct {
+		name       string
+		msg        concentrated.MsgCreateConcentratedPool
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg concentrated.MsgCreateConcentratedPool) concentrated.MsgCreateConcentratedPool {
+				// Do nothing
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: createMsg(func(msg concentrated.MsgCreateConcentratedPool) concentrated.MsgCreateConcentratedPool {
+				msg.Sender = invalidAddr.String()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "has one coin in InitialPoolLiquidity",
+			msg: createMsg(func(msg concentrated.MsgCreateConcentratedPool) concentrated.MsgCreateConcentratedPool {
+				msg.InitialPoolLiquidity = sdk.Coins{
+					sdk.NewCoin("atom", sdk.NewInt(100)),
+				}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "has three coins in InitialPoolLiquidity",
+			msg: createMsg(func(msg concentrated.MsgCreateConcentratedPool) concentrated.MsgCreateConcentratedPool {
+				msg.InitialPoolLiquidity = sdk.Coins{
+					sdk.NewCoin("atom", sdk.NewInt(100)),
+					sdk.NewCoin("osmo", sdk.NewInt(100)),
+					sdk.NewCoin("usdc", sdk.NewInt(100)),
+				}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "negative swap fee",
+			msg: createMsg(func(msg concentrated.MsgCreateConcentratedPool) concentrated.MsgCreateConcentratedPool {
+				msg.PoolParams.SwapFee = sdk.NewDecWithPrec(-1, 2)
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero tick spacing",
+			msg: createMsg(func(msg concentrated.MsgCreateConcentratedPool) concentrated.MsgCreateConcentratedPool {
+				msg.PoolParams.TickSpacing = 0
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "tick spacing larger than the max tick",
+			msg: createMsg(func(msg concentrated.MsgCreateConcentratedPool) concentrated.MsgCreateConcentratedPool {
+				msg.PoolParams.TickSpacing = uint64(concentrated.MaxTick) + 1
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "invalid governor",
+			msg: createMsg(func(msg concentrated.MsgCreateConcentratedPool) concentrated.MsgCreateConcentratedPool {
+				msg.FuturePoolGovernor = "invalid_cosmos_address"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "valid governor: just lock duration for pool token",
+			msg: createMsg(func(msg concentrated.MsgCreateConcentratedPool) concentrated.MsgCreateConcentratedPool {
+				msg.FuturePoolGovernor = "1000h"
+				return msg
+			}),
+			expectPass: true,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgCreatePosition(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	tokens := sdk.NewCoins(sdk.NewInt64Coin("atom", 10), sdk.NewInt64Coin("osmo", 10))
+
+	tests := []struct {
+		name       string
+		msg        concentrated.MsgCreatePosition
+		expectPass bool
+	}{
+		{
+			name:       "proper msg",
+			msg:        concentrated.MsgCreatePosition{Sender: addr1, PoolId: 1, LowerTick: -10, UpperTick: 10, TokensDesired: tokens},
+			expectPass: true,
+		},
+		{
+			name:       "invalid sender",
+			msg:        concentrated.MsgCreatePosition{Sender: "", PoolId: 1, LowerTick: -10, UpperTick: 10, TokensDesired: tokens},
+			expectPass: false,
+		},
+		{
+			name:       "no tokens desired",
+			msg:        concentrated.MsgCreatePosition{Sender: addr1, PoolId: 1, LowerTick: -10, UpperTick: 10},
+			expectPass: false,
+		},
+		{
+			name:       "lower tick above upper tick",
+			msg:        concentrated.MsgCreatePosition{Sender: addr1, PoolId: 1, LowerTick: 10, UpperTick: -10, TokensDesired: tokens},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgWithdrawPosition(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+
+	tests := []struct {
+		name       string
+		msg        concentrated.MsgWithdrawPosition
+		expectPass bool
+	}{
+		{
+			name:       "proper msg",
+			msg:        concentrated.MsgWithdrawPosition{Sender: addr1, PoolId: 1, PositionId: 1, Liquidity: sdk.OneDec()},
+			expectPass: true,
+		},
+		{
+			name:       "invalid sender",
+			msg:        concentrated.MsgWithdrawPosition{Sender: "", PoolId: 1, PositionId: 1, Liquidity: sdk.OneDec()},
+			expectPass: false,
+		},
+		{
+			name:       "zero liquidity",
+			msg:        concentrated.MsgWithdrawPosition{Sender: addr1, PoolId: 1, PositionId: 1, Liquidity: sdk.ZeroDec()},
+			expectPass: false,
+		},
+		{
+			name:       "nil liquidity",
+			msg:        concentrated.MsgWithdrawPosition{Sender: addr1, PoolId: 1, PositionId: 1},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
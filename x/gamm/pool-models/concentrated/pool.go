@@ -0,0 +1,288 @@
+package concentrated
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/types"
+)
+
+var _ types.PoolI = &Pool{}
+
+// SharesPositionId is the id of the full range position backing the LP shares of a pool.
+// Positions opened by LPs are numbered from 1.
+const SharesPositionId = 0
+
+const errMsgFormatSharesLargerThanMax = "%s resulted shares is larger than the max amount of %s"
+
+// NewConcentratedPool returns a concentrated liquidity pool whose initial price is the ratio of the
+// initial liquidity. The initial liquidity is provided as a full range position backing the LP shares.
+// Invariants that are assumed to be satisfied and not checked:
+// * FutureGovernor is valid
+// * poolID doesn't already exist
+func NewConcentratedPool(poolId uint64,
+	concentratedPoolParams PoolParams, initialLiquidity sdk.Coins,
+	futureGovernor string,
+) (Pool, error) {
+	if err := concentratedPoolParams.Validate(); err != nil {
+		return Pool{}, err
+	}
+
+	if err := validateInitialLiquidity(initialLiquidity); err != nil {
+		return Pool{}, err
+	}
+
+	// coins are sorted by denom, so the first coin is token0.
+	amount0, amount1 := initialLiquidity[0].Amount.ToDec(), initialLiquidity[1].Amount.ToDec()
+	sqrtPrice, err := amount1.Quo(amount0).ApproxSqrt()
+	if err != nil {
+		return Pool{}, err
+	}
+
+	pool := Pool{
+		Address:            types.NewPoolAddress(poolId).String(),
+		Id:                 poolId,
+		PoolParams:         concentratedPoolParams,
+		FuturePoolGovernor: futureGovernor,
+		TotalShares:        sdk.NewCoin(types.GetPoolShareDenom(poolId), types.InitPoolSharesSupply),
+		Token0:             initialLiquidity[0].Denom,
+		Token1:             initialLiquidity[1].Denom,
+		CurrentSqrtPrice:   sqrtPrice,
+		CurrentTick:        SqrtPriceToTick(sqrtPrice),
+		CurrentLiquidity:   sdk.ZeroDec(),
+		FeeGrowthGlobal0:   sdk.ZeroDec(),
+		FeeGrowthGlobal1:   sdk.ZeroDec(),
+		Ticks:              []TickInfo{},
+		NextPositionId:     SharesPositionId + 1,
+	}
+
+	lowerTick, upperTick := pool.fullRangeTicks()
+	if !sqrtPrice.GT(TickToSqrtPrice(lowerTick)) || !sqrtPrice.LT(TickToSqrtPrice(upperTick)) {
+		return Pool{}, sdkerrors.Wrapf(types.ErrInvalidPool,
+			"initial price %s is out of the supported price range", sqrtPrice.Power(2))
+	}
+
+	pool.SharesPosition = newPosition(SharesPositionId, pool.Address, lowerTick, upperTick)
+	liquidity := pool.liquidityForAmounts(pool.SharesPosition, amount0, amount1)
+	if !liquidity.IsPositive() {
+		return Pool{}, sdkerrors.Wrapf(types.ErrInvalidLiquidity, "initial liquidity %s is too small", initialLiquidity)
+	}
+	pool.updatePosition(&pool.SharesPosition, liquidity)
+
+	// the part of the initial liquidity that is not used by the position is owed to the shareholders,
+	// so that the tokens backing the shares are exactly the initial liquidity.
+	pool.setSharesReserves(amount0, amount1)
+
+	return pool, nil
+}
+
+// validateInitialLiquidity checks that the initial liquidity consists of exactly two positive coins.
+func validateInitialLiquidity(initialLiquidity sdk.Coins) error {
+	if len(initialLiquidity) < 2 {
+		return types.ErrTooFewPoolAssets
+	} else if len(initialLiquidity) > 2 {
+		return types.ErrTooManyPoolAssets
+	}
+
+	return initialLiquidity.Validate()
+}
+
+func (p Pool) GetAddress() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(p.Address)
+	if err != nil {
+		panic(fmt.Sprintf("could not bech32 decode address of pool with id: %d", p.GetId()))
+	}
+	return addr
+}
+
+func (p Pool) String() string {
+	out, err := json.Marshal(p)
+	if err != nil {
+		panic(err)
+	}
+	return string(out)
+}
+
+func (p Pool) GetId() uint64 {
+	return p.Id
+}
+
+func (p Pool) GetSwapFee(ctx sdk.Context) sdk.Dec {
+	return p.PoolParams.SwapFee
+}
+
+func (p Pool) GetExitFee(ctx sdk.Context) sdk.Dec {
+	return p.PoolParams.ExitFee
+}
+
+func (p Pool) GetTickSpacing() int64 {
+	return int64(p.PoolParams.TickSpacing)
+}
+
+func (p Pool) IsActive(ctx sdk.Context) bool {
+	return true
+}
+
+// Returns the coins backing the LP shares, i.e. the tokens of the full range position owned by
+// the shareholders. The liquidity of positions opened by LPs is not included.
+func (p Pool) GetTotalPoolLiquidity(ctx sdk.Context) sdk.Coins {
+	amount0, amount1 := p.sharesReserves()
+	return sdk.NewCoins(
+		sdk.NewCoin(p.Token0, amount0.TruncateInt()),
+		sdk.NewCoin(p.Token1, amount1.TruncateInt()),
+	)
+}
+
+func (p Pool) GetTotalShares() sdk.Int {
+	return p.TotalShares.Amount
+}
+
+func (p Pool) NumAssets() int {
+	return 2
+}
+
+// PokePool is a no-op, concentrated liquidity pools have no time dependent parameters.
+func (p *Pool) PokePool(blockTime time.Time) {}
+
+// SpotPrice returns the current price of the pool.
+func (p Pool) SpotPrice(ctx sdk.Context, baseAsset, quoteAsset string) (sdk.Dec, error) {
+	price := p.CurrentSqrtPrice.Power(2)
+	switch {
+	case baseAsset == quoteAsset:
+		return sdk.Dec{}, errors.New("base and quote asset must be different")
+	case baseAsset == p.Token0 && quoteAsset == p.Token1:
+		return price, nil
+	case baseAsset == p.Token1 && quoteAsset == p.Token0:
+		return sdk.OneDec().Quo(price), nil
+	default:
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrDenomNotFoundInPool, "one of denom (%s, %s) not found in pool liquidity", baseAsset, quoteAsset)
+	}
+}
+
+// fullRangeTicks returns the widest range of ticks that positions of the pool can use.
+func (p Pool) fullRangeTicks() (lowerTick, upperTick int64) {
+	upperTick = MaxTick / p.GetTickSpacing() * p.GetTickSpacing()
+	return -upperTick, upperTick
+}
+
+// sharesReserves returns the amounts of token0 and token1 backing the LP shares.
+func (p Pool) sharesReserves() (amount0, amount1 sdk.Dec) {
+	amount0, amount1 = p.amountsForLiquidity(p.SharesPosition, p.SharesPosition.Liquidity)
+	tokensOwed := p.feesOwed(p.SharesPosition)
+	return amount0.Add(tokensOwed.AmountOf(p.Token0)), amount1.Add(tokensOwed.AmountOf(p.Token1))
+}
+
+// setSharesReserves sets the tokens owed to the shares position, so that the amounts backing the
+// LP shares are amount0 and amount1. The difference between these amounts and the tokens held by
+// the liquidity of the shares position is the rounding dust of joins and exits.
+func (p *Pool) setSharesReserves(amount0, amount1 sdk.Dec) {
+	p.SharesPosition = p.withFeesAccrued(p.SharesPosition)
+	liquidity0, liquidity1 := p.amountsForLiquidity(p.SharesPosition, p.SharesPosition.Liquidity)
+	p.SharesPosition.TokensOwed = sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec(p.Token0, sdk.MaxDec(amount0.Sub(liquidity0), sdk.ZeroDec())),
+		sdk.NewDecCoinFromDec(p.Token1, sdk.MaxDec(amount1.Sub(liquidity1), sdk.ZeroDec())),
+	)
+}
+
+// joinShares adds the tokens in to the reserves backing the LP shares, in exchange for numShares
+// newly issued shares. The liquidity of the shares position grows by the same ratio as the shares.
+func (p *Pool) joinShares(tokensIn sdk.Coins, numShares sdk.Int) {
+	amount0, amount1 := p.sharesReserves()
+	totalShares := p.GetTotalShares()
+	liquidityDelta := p.SharesPosition.Liquidity.MulInt(numShares).QuoInt(totalShares)
+	p.updatePosition(&p.SharesPosition, liquidityDelta)
+	p.setSharesReserves(
+		amount0.Add(tokensIn.AmountOf(p.Token0).ToDec()),
+		amount1.Add(tokensIn.AmountOf(p.Token1).ToDec()),
+	)
+	p.TotalShares = sdk.NewCoin(p.TotalShares.Denom, totalShares.Add(numShares))
+}
+
+// calcJoinShares returns the number of shares that tokensIn can buy, at the ratio of the reserves
+// backing the LP shares.
+func (p Pool) calcJoinShares(tokensIn sdk.Coins) (sdk.Int, error) {
+	if !tokensIn.DenomsSubsetOf(sdk.NewCoins(sdk.NewInt64Coin(p.Token0, 1), sdk.NewInt64Coin(p.Token1, 1))) {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrDenomNotFoundInPool, "tokens in %s are not in pool %d", tokensIn, p.Id)
+	}
+	if tokensIn.Len() != 2 {
+		return sdk.Int{}, sdkerrors.Wrap(types.ErrNotImplemented, "concentrated liquidity pools only support joining with both assets")
+	}
+
+	amount0, amount1 := p.sharesReserves()
+	totalShares := p.GetTotalShares().ToDec()
+	numShares := sdk.MinDec(
+		tokensIn.AmountOf(p.Token0).ToDec().Mul(totalShares).QuoTruncate(amount0),
+		tokensIn.AmountOf(p.Token1).ToDec().Mul(totalShares).QuoTruncate(amount1),
+	).TruncateInt()
+	if !numShares.IsPositive() {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrInvalidMathApprox, "share amount is zero or negative")
+	}
+	return numShares, nil
+}
+
+// JoinPool joins the pool with both assets. Single asset joins are not supported. Tokens in excess
+// of the ratio of the reserves backing the LP shares are added to them without issuing more shares.
+func (p *Pool) JoinPool(ctx sdk.Context, tokensIn sdk.Coins, swapFee sdk.Dec) (numShares sdk.Int, err error) {
+	numShares, err = p.calcJoinShares(tokensIn)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	p.joinShares(tokensIn, numShares)
+	return numShares, nil
+}
+
+func (p *Pool) JoinPoolNoSwap(ctx sdk.Context, tokensIn sdk.Coins, swapFee sdk.Dec) (numShares sdk.Int, err error) {
+	return p.JoinPool(ctx, tokensIn, swapFee)
+}
+
+func (p *Pool) CalcJoinPoolShares(ctx sdk.Context, tokensIn sdk.Coins, swapFee sdk.Dec) (numShares sdk.Int, newLiquidity sdk.Coins, err error) {
+	numShares, err = p.calcJoinShares(tokensIn)
+	if err != nil {
+		return sdk.Int{}, sdk.Coins{}, err
+	}
+	return numShares, tokensIn, nil
+}
+
+func (p *Pool) CalcJoinPoolNoSwapShares(ctx sdk.Context, tokensIn sdk.Coins, swapFee sdk.Dec) (numShares sdk.Int, newLiquidity sdk.Coins, err error) {
+	return p.CalcJoinPoolShares(ctx, tokensIn, swapFee)
+}
+
+func (p *Pool) ExitPool(ctx sdk.Context, exitingShares sdk.Int, exitFee sdk.Dec) (exitingCoins sdk.Coins, err error) {
+	exitingCoins, err = p.CalcExitPoolCoinsFromShares(ctx, exitingShares, exitFee)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+
+	amount0, amount1 := p.sharesReserves()
+	totalShares := p.GetTotalShares()
+	liquidityDelta := p.SharesPosition.Liquidity.MulInt(exitingShares).QuoInt(totalShares)
+	p.updatePosition(&p.SharesPosition, liquidityDelta.Neg())
+	// the exit fee stays in the reserves backing the remaining shares.
+	p.setSharesReserves(
+		amount0.Sub(exitingCoins.AmountOf(p.Token0).ToDec()),
+		amount1.Sub(exitingCoins.AmountOf(p.Token1).ToDec()),
+	)
+	p.TotalShares = sdk.NewCoin(p.TotalShares.Denom, totalShares.Sub(exitingShares))
+	return exitingCoins, nil
+}
+
+func (p *Pool) CalcExitPoolCoinsFromShares(ctx sdk.Context, exitingShares sdk.Int, exitFee sdk.Dec) (exitedCoins sdk.Coins, err error) {
+	totalShares := p.GetTotalShares()
+	if exitingShares.GTE(totalShares) {
+		return sdk.Coins{}, sdkerrors.Wrapf(types.ErrLimitMaxAmount, errMsgFormatSharesLargerThanMax, exitingShares, totalShares)
+	}
+
+	// refundedShares = exitingShares * (1 - exit fee)
+	refundedShares := exitingShares.ToDec().Mul(sdk.OneDec().Sub(exitFee))
+	shareOutRatio := refundedShares.QuoInt(totalShares)
+	amount0, amount1 := p.sharesReserves()
+	return sdk.NewCoins(
+		sdk.NewCoin(p.Token0, amount0.Mul(shareOutRatio).TruncateInt()),
+		sdk.NewCoin(p.Token1, amount1.Mul(shareOutRatio).TruncateInt()),
+	), nil
+}
@@ -0,0 +1,31 @@
+package concentrated
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/types"
+)
+
+func (params PoolParams) Validate() error {
+	if params.ExitFee.IsNegative() {
+		return types.ErrNegativeExitFee
+	}
+
+	if params.ExitFee.GTE(sdk.OneDec()) {
+		return types.ErrTooMuchExitFee
+	}
+
+	if params.SwapFee.IsNegative() {
+		return types.ErrNegativeSwapFee
+	}
+
+	if params.SwapFee.GTE(sdk.OneDec()) {
+		return types.ErrTooMuchSwapFee
+	}
+
+	if params.TickSpacing == 0 || params.TickSpacing > uint64(MaxTick) {
+		return sdkerrors.Wrapf(types.ErrInvalidTickSpacing, "tick spacing must be between 1 and %d, got %d", MaxTick, params.TickSpacing)
+	}
+	return nil
+}
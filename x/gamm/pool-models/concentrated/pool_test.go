@@ -0,0 +1,418 @@
+package concentrated
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/types"
+)
+
+var (
+	defaultPoolId                 = uint64(1)
+	defaultConcentratedPoolParams = PoolParams{
+		SwapFee:     sdk.ZeroDec(),
+		ExitFee:     sdk.ZeroDec(),
+		TickSpacing: 100,
+	}
+	// spot price of 10 uosmo per uatom
+	defaultPoolLiquidity = sdk.NewCoins(
+		sdk.NewInt64Coin("uatom", 1_000_000),
+		sdk.NewInt64Coin("uosmo", 10_000_000),
+	)
+	owner      = sdk.AccAddress([]byte("addr1---------------"))
+	otherOwner = sdk.AccAddress([]byte("addr2---------------"))
+)
+
+func newTestPool(t *testing.T, swapFee sdk.Dec) Pool {
+	params := defaultConcentratedPoolParams
+	params.SwapFee = swapFee
+	pool, err := NewConcentratedPool(defaultPoolId, params, defaultPoolLiquidity, "")
+	require.NoError(t, err)
+	return pool
+}
+
+// memPositions is an in memory PositionStore.
+type memPositions map[uint64]Position
+
+func (m memPositions) GetPosition(positionId uint64) (Position, bool) {
+	position, found := m[positionId]
+	return position, found
+}
+
+func (m memPositions) SetPosition(position Position) {
+	m[position.PositionId] = position
+}
+
+func (m memPositions) DeletePosition(positionId uint64) {
+	delete(m, positionId)
+}
+
+func (m memPositions) IteratePositions(cb func(position Position) (stop bool)) {
+	ids := make([]uint64, 0, len(m))
+	for id := range m {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	for _, id := range ids {
+		if cb(m[id]) {
+			return
+		}
+	}
+}
+
+func createPosition(t *testing.T, pool *Pool, positions memPositions, lowerTick, upperTick int64, tokensDesired sdk.Coins) (Position, sdk.Coins) {
+	position, tokensIn, err := pool.CreatePosition(positions, owner, lowerTick, upperTick, tokensDesired, sdk.Coins{})
+	require.NoError(t, err)
+	return position, tokensIn
+}
+
+func TestNewConcentratedPool(t *testing.T) {
+	tests := map[string]struct {
+		liquidity sdk.Coins
+		params    PoolParams
+		expErr    error
+	}{
+		"valid pool": {
+			liquidity: defaultPoolLiquidity,
+			params:    defaultConcentratedPoolParams,
+		},
+		"single asset": {
+			liquidity: sdk.NewCoins(sdk.NewInt64Coin("uatom", 100)),
+			params:    defaultConcentratedPoolParams,
+			expErr:    types.ErrTooFewPoolAssets,
+		},
+		"three assets": {
+			liquidity: defaultPoolLiquidity.Add(sdk.NewInt64Coin("uion", 100)),
+			params:    defaultConcentratedPoolParams,
+			expErr:    types.ErrTooManyPoolAssets,
+		},
+		"zero tick spacing": {
+			liquidity: defaultPoolLiquidity,
+			params:    PoolParams{SwapFee: sdk.ZeroDec(), ExitFee: sdk.ZeroDec(), TickSpacing: 0},
+			expErr:    types.ErrInvalidTickSpacing,
+		},
+		"price out of range": {
+			liquidity: sdk.NewCoins(sdk.NewInt64Coin("uatom", 1), sdk.NewInt64Coin("uosmo", 100_000_000_000_000)),
+			params:    defaultConcentratedPoolParams,
+			expErr:    types.ErrInvalidPool,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			pool, err := NewConcentratedPool(defaultPoolId, tc.params, tc.liquidity, "")
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+
+			// the initial liquidity is exactly the one backing the shares
+			require.Equal(t, tc.liquidity, pool.GetTotalPoolLiquidity(sdk.Context{}))
+			require.Equal(t, types.InitPoolSharesSupply, pool.GetTotalShares())
+			require.Equal(t, "uatom", pool.Token0)
+			require.Equal(t, "uosmo", pool.Token1)
+
+			spotPrice, err := pool.SpotPrice(sdk.Context{}, "uatom", "uosmo")
+			require.NoError(t, err)
+			require.Equal(t, sdk.NewDec(10), spotPrice)
+			// 1.0001^23027 <= 10 < 1.0001^23028
+			require.Equal(t, int64(23027), pool.CurrentTick)
+
+			// the shares position spans the full range, and is the only active liquidity
+			require.Equal(t, []int64{-300_000, 300_000}, []int64{pool.SharesPosition.LowerTick, pool.SharesPosition.UpperTick})
+			require.Equal(t, pool.SharesPosition.Liquidity, pool.CurrentLiquidity)
+			require.Len(t, pool.Ticks, 2)
+		})
+	}
+}
+
+func TestSpotPrice(t *testing.T) {
+	pool := newTestPool(t, sdk.ZeroDec())
+
+	spotPrice, err := pool.SpotPrice(sdk.Context{}, "uosmo", "uatom")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("0.1"), spotPrice)
+
+	_, err = pool.SpotPrice(sdk.Context{}, "uatom", "uion")
+	require.ErrorIs(t, err, types.ErrDenomNotFoundInPool)
+
+	_, err = pool.SpotPrice(sdk.Context{}, "uatom", "uatom")
+	require.Error(t, err)
+}
+
+func TestCreatePosition(t *testing.T) {
+	tests := map[string]struct {
+		lowerTick     int64
+		upperTick     int64
+		tokensDesired sdk.Coins
+		tokensMin     sdk.Coins
+		expTokensIn   sdk.Coins
+		expActive     bool
+		expErr        error
+	}{
+		"range around the current price": {
+			lowerTick:     22000,
+			upperTick:     24000,
+			tokensDesired: sdk.NewCoins(sdk.NewInt64Coin("uatom", 1_000_000), sdk.NewInt64Coin("uosmo", 10_000_000)),
+			expTokensIn:   sdk.NewCoins(sdk.NewInt64Coin("uatom", 948_685), sdk.NewInt64Coin("uosmo", 10_000_000)),
+			expActive:     true,
+		},
+		"range above the current price only takes token0": {
+			lowerTick:     24000,
+			upperTick:     25000,
+			tokensDesired: sdk.NewCoins(sdk.NewInt64Coin("uatom", 1_000_000), sdk.NewInt64Coin("uosmo", 10_000_000)),
+			expTokensIn:   sdk.NewCoins(sdk.NewInt64Coin("uatom", 1_000_000)),
+		},
+		"range below the current price only takes token1": {
+			lowerTick:     21000,
+			upperTick:     22000,
+			tokensDesired: sdk.NewCoins(sdk.NewInt64Coin("uosmo", 10_000_000)),
+			expTokensIn:   sdk.NewCoins(sdk.NewInt64Coin("uosmo", 10_000_000)),
+		},
+		"only token1 for a range above the current price": {
+			lowerTick:     24000,
+			upperTick:     25000,
+			tokensDesired: sdk.NewCoins(sdk.NewInt64Coin("uosmo", 10_000_000)),
+			expErr:        types.ErrInvalidLiquidity,
+		},
+		"tokens min not met": {
+			lowerTick:     22000,
+			upperTick:     24000,
+			tokensDesired: sdk.NewCoins(sdk.NewInt64Coin("uatom", 1_000_000), sdk.NewInt64Coin("uosmo", 10_000_000)),
+			tokensMin:     sdk.NewCoins(sdk.NewInt64Coin("uatom", 1_000_000)),
+			expErr:        types.ErrLimitMinAmount,
+		},
+		"tick not a multiple of the tick spacing": {
+			lowerTick:     22050,
+			upperTick:     24000,
+			tokensDesired: sdk.NewCoins(sdk.NewInt64Coin("uatom", 1_000_000), sdk.NewInt64Coin("uosmo", 10_000_000)),
+			expErr:        types.ErrInvalidPositionTicks,
+		},
+		"empty range": {
+			lowerTick:     24000,
+			upperTick:     24000,
+			tokensDesired: sdk.NewCoins(sdk.NewInt64Coin("uatom", 1_000_000), sdk.NewInt64Coin("uosmo", 10_000_000)),
+			expErr:        types.ErrInvalidPositionTicks,
+		},
+		"tick out of bounds": {
+			lowerTick:     22000,
+			upperTick:     MaxTick + 100,
+			tokensDesired: sdk.NewCoins(sdk.NewInt64Coin("uatom", 1_000_000), sdk.NewInt64Coin("uosmo", 10_000_000)),
+			expErr:        types.ErrInvalidPositionTicks,
+		},
+		"denom not in pool": {
+			lowerTick:     22000,
+			upperTick:     24000,
+			tokensDesired: sdk.NewCoins(sdk.NewInt64Coin("uion", 1_000_000)),
+			expErr:        types.ErrDenomNotFoundInPool,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			pool := newTestPool(t, sdk.ZeroDec())
+			positions := memPositions{}
+			liquidityBefore := pool.CurrentLiquidity
+
+			position, tokensIn, err := pool.CreatePosition(positions, owner, tc.lowerTick, tc.upperTick, tc.tokensDesired, tc.tokensMin)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expTokensIn, tokensIn)
+			require.Equal(t, uint64(1), position.PositionId)
+			require.Equal(t, uint64(2), pool.NextPositionId)
+			require.Equal(t, []Position{position}, pool.GetPositions(positions, owner.String()))
+
+			if tc.expActive {
+				require.Equal(t, liquidityBefore.Add(position.Liquidity), pool.CurrentLiquidity)
+			} else {
+				require.Equal(t, liquidityBefore, pool.CurrentLiquidity)
+			}
+
+			// positions are not part of the liquidity backing the shares
+			require.Equal(t, defaultPoolLiquidity, pool.GetTotalPoolLiquidity(sdk.Context{}))
+		})
+	}
+}
+
+func TestSwapWithinRange(t *testing.T) {
+	pool := newTestPool(t, sdk.ZeroDec())
+	positions := memPositions{}
+
+	// a full range position trades like a constant product pool:
+	// 10_000_000 - 10_000_000 * 1_000_000 / 1_010_000 = 99_009.9
+	tokenOut, err := pool.CalcOutAmtGivenIn(sdk.Context{}, sdk.NewCoins(sdk.NewInt64Coin("uatom", 10_000)), "uosmo", sdk.ZeroDec())
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("uosmo", 99_009), tokenOut)
+
+	// adding a position around the current price concentrates liquidity, so the same swap gets a better price
+	createPosition(t, &pool, positions, 22000, 24000, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1_000_000), sdk.NewInt64Coin("uosmo", 10_000_000)))
+	tokenOut, err = pool.CalcOutAmtGivenIn(sdk.Context{}, sdk.NewCoins(sdk.NewInt64Coin("uatom", 10_000)), "uosmo", sdk.ZeroDec())
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("uosmo", 99_952), tokenOut)
+
+	// swapping back the tokens out requires the tokens in, up to rounding
+	poolCopy := pool.copy()
+	_, err = poolCopy.SwapOutAmtGivenIn(sdk.Context{}, sdk.NewCoins(sdk.NewInt64Coin("uatom", 10_000)), "uosmo", sdk.ZeroDec())
+	require.NoError(t, err)
+	tokenIn, err := poolCopy.CalcInAmtGivenOut(sdk.Context{}, sdk.NewCoins(sdk.NewInt64Coin("uatom", 10_000)), "uosmo", sdk.ZeroDec())
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("uosmo", 99_953), tokenIn)
+
+	// calculations don't mutate the pool
+	require.Equal(t, int64(23027), pool.CurrentTick)
+}
+
+func TestSwapCrossesTicks(t *testing.T) {
+	pool := newTestPool(t, sdk.ZeroDec())
+	positions := memPositions{}
+	position, _ := createPosition(t, &pool, positions, 22000, 24000, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1_000_000), sdk.NewInt64Coin("uosmo", 10_000_000)))
+	sharesLiquidity := pool.SharesPosition.Liquidity
+
+	// selling enough uatom pushes the price below the position, leaving only the shares position active
+	_, err := pool.SwapOutAmtGivenIn(sdk.Context{}, sdk.NewCoins(sdk.NewInt64Coin("uatom", 2_000_000)), "uosmo", sdk.ZeroDec())
+	require.NoError(t, err)
+	require.Less(t, pool.CurrentTick, position.LowerTick)
+	require.Equal(t, sharesLiquidity, pool.CurrentLiquidity)
+
+	// the position is now only made of uatom
+	position, err = pool.GetPosition(positions, position.PositionId)
+	require.NoError(t, err)
+	amount0, amount1 := pool.amountsForLiquidity(position, position.Liquidity)
+	require.True(t, amount0.IsPositive())
+	require.True(t, amount1.IsZero())
+
+	// buying it back crosses the lower tick of the position again
+	_, err = pool.SwapInAmtGivenOut(sdk.Context{}, sdk.NewCoins(sdk.NewInt64Coin("uatom", 2_000_000)), "uosmo", sdk.ZeroDec())
+	require.NoError(t, err)
+	require.True(t, position.IsActive(pool.CurrentTick))
+	require.Equal(t, sharesLiquidity.Add(position.Liquidity), pool.CurrentLiquidity)
+}
+
+func TestSwapNotEnoughLiquidity(t *testing.T) {
+	pool := newTestPool(t, sdk.ZeroDec())
+
+	// the full range position can't give out all of its tokens
+	_, err := pool.CalcInAmtGivenOut(sdk.Context{}, sdk.NewCoins(sdk.NewInt64Coin("uosmo", 10_000_000)), "uatom", sdk.ZeroDec())
+	require.ErrorIs(t, err, types.ErrNotEnoughLiquidity)
+
+	_, err = pool.CalcOutAmtGivenIn(sdk.Context{}, sdk.NewCoins(sdk.NewInt64Coin("uion", 100)), "uatom", sdk.ZeroDec())
+	require.ErrorIs(t, err, types.ErrDenomNotFoundInPool)
+}
+
+func TestPositionFees(t *testing.T) {
+	pool := newTestPool(t, sdk.MustNewDecFromStr("0.01"))
+	positions := memPositions{}
+	position, tokensIn := createPosition(t, &pool, positions, 22000, 24000, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1_000_000), sdk.NewInt64Coin("uosmo", 10_000_000)))
+	// a position outside of the swapped range collects no fees
+	outOfRange, _ := createPosition(t, &pool, positions, 30000, 31000, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1_000_000)))
+
+	_, err := pool.SwapOutAmtGivenIn(sdk.Context{}, sdk.NewCoins(sdk.NewInt64Coin("uatom", 10_000)), "uosmo", pool.GetSwapFee(sdk.Context{}))
+	require.NoError(t, err)
+	_, err = pool.SwapOutAmtGivenIn(sdk.Context{}, sdk.NewCoins(sdk.NewInt64Coin("uosmo", 100_000)), "uatom", pool.GetSwapFee(sdk.Context{}))
+	require.NoError(t, err)
+
+	// the 100 uatom and 1000 uosmo of fees are split between the active positions pro rata of their liquidity
+	position, err = pool.GetPosition(positions, position.PositionId)
+	require.NoError(t, err)
+	sharesFees := pool.feesOwed(pool.SharesPosition)
+	totalLiquidity := position.Liquidity.Add(pool.SharesPosition.Liquidity)
+	require.Equal(t, sdk.NewDec(100).Mul(position.Liquidity).Quo(totalLiquidity).TruncateInt(), position.TokensOwed.AmountOf("uatom").TruncateInt())
+	require.Equal(t, sdk.NewDec(1000).Mul(position.Liquidity).Quo(totalLiquidity).TruncateInt(), position.TokensOwed.AmountOf("uosmo").TruncateInt())
+	require.True(t, sharesFees.AmountOf("uosmo").IsPositive())
+
+	outOfRange, err = pool.GetPosition(positions, outOfRange.PositionId)
+	require.NoError(t, err)
+	require.True(t, outOfRange.TokensOwed.IsZero())
+
+	// only the owner can collect fees
+	_, err = pool.CollectFees(positions, otherOwner, position.PositionId)
+	require.ErrorIs(t, err, types.ErrNotPositionOwner)
+	fees, err := pool.CollectFees(positions, owner, position.PositionId)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(
+		sdk.NewCoin("uatom", position.TokensOwed.AmountOf("uatom").TruncateInt()),
+		sdk.NewCoin("uosmo", position.TokensOwed.AmountOf("uosmo").TruncateInt()),
+	), fees)
+
+	// fees can't be collected twice
+	fees, err = pool.CollectFees(positions, owner, position.PositionId)
+	require.NoError(t, err)
+	require.True(t, fees.IsZero())
+
+	// withdrawing the whole position returns its tokens, and removes it along with its ticks
+	tokensOut, err := pool.WithdrawPosition(positions, owner, position.PositionId, position.Liquidity)
+	require.NoError(t, err)
+	require.True(t, tokensOut.AmountOf("uosmo").GT(tokensIn.AmountOf("uosmo")))
+	require.True(t, tokensOut.AmountOf("uatom").LT(tokensIn.AmountOf("uatom")))
+	_, err = pool.GetPosition(positions, position.PositionId)
+	require.ErrorIs(t, err, types.ErrPositionNotFound)
+	require.Len(t, pool.Ticks, 4)
+	require.Equal(t, pool.SharesPosition.Liquidity, pool.CurrentLiquidity)
+}
+
+func TestWithdrawPosition(t *testing.T) {
+	pool := newTestPool(t, sdk.ZeroDec())
+	positions := memPositions{}
+	position, tokensIn := createPosition(t, &pool, positions, 22000, 24000, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1_000_000), sdk.NewInt64Coin("uosmo", 10_000_000)))
+
+	_, err := pool.WithdrawPosition(positions, otherOwner, position.PositionId, position.Liquidity)
+	require.ErrorIs(t, err, types.ErrNotPositionOwner)
+	_, err = pool.WithdrawPosition(positions, owner, position.PositionId, position.Liquidity.Add(sdk.OneDec()))
+	require.ErrorIs(t, err, types.ErrInvalidLiquidity)
+	_, err = pool.WithdrawPosition(positions, owner, 2, position.Liquidity)
+	require.ErrorIs(t, err, types.ErrPositionNotFound)
+
+	// withdrawing half of the liquidity keeps the position, and returns half of the tokens, rounded down
+	half := position.Liquidity.QuoInt64(2)
+	tokensOut, err := pool.WithdrawPosition(positions, owner, position.PositionId, half)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatom", 474_342), sdk.NewInt64Coin("uosmo", 5_000_000)), tokensOut)
+	require.Len(t, positions, 1)
+
+	tokensOut, err = pool.WithdrawPosition(positions, owner, position.PositionId, position.Liquidity.Sub(half))
+	require.NoError(t, err)
+	require.True(t, tokensOut.IsAllLTE(tokensIn))
+	require.Empty(t, positions)
+	require.Len(t, pool.Ticks, 2)
+}
+
+func TestJoinExitShares(t *testing.T) {
+	pool := newTestPool(t, sdk.ZeroDec())
+	positions := memPositions{}
+	createPosition(t, &pool, positions, 22000, 24000, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1_000_000), sdk.NewInt64Coin("uosmo", 10_000_000)))
+	sharesLiquidity := pool.SharesPosition.Liquidity
+
+	// single asset joins are not supported
+	_, err := pool.JoinPool(sdk.Context{}, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000)), sdk.ZeroDec())
+	require.ErrorIs(t, err, types.ErrNotImplemented)
+
+	// joining with the tokens backing the shares doubles them
+	numShares, err := pool.JoinPoolNoSwap(sdk.Context{}, defaultPoolLiquidity, sdk.ZeroDec())
+	require.NoError(t, err)
+	require.Equal(t, types.InitPoolSharesSupply, numShares)
+	require.Equal(t, defaultPoolLiquidity.Add(defaultPoolLiquidity...), pool.GetTotalPoolLiquidity(sdk.Context{}))
+	require.Equal(t, sharesLiquidity.MulInt64(2), pool.SharesPosition.Liquidity)
+
+	exitCoins, err := pool.ExitPool(sdk.Context{}, numShares, sdk.ZeroDec())
+	require.NoError(t, err)
+	require.Equal(t, defaultPoolLiquidity, exitCoins)
+	require.Equal(t, defaultPoolLiquidity, pool.GetTotalPoolLiquidity(sdk.Context{}))
+	require.Equal(t, types.InitPoolSharesSupply, pool.GetTotalShares())
+
+	// the exit fee stays in the pool
+	exitCoins, err = pool.ExitPool(sdk.Context{}, numShares.QuoRaw(2), sdk.MustNewDecFromStr("0.1"))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatom", 450_000), sdk.NewInt64Coin("uosmo", 4_500_000)), exitCoins)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatom", 550_000), sdk.NewInt64Coin("uosmo", 5_500_000)), pool.GetTotalPoolLiquidity(sdk.Context{}))
+
+	_, err = pool.ExitPool(sdk.Context{}, pool.GetTotalShares(), sdk.ZeroDec())
+	require.ErrorIs(t, err, types.ErrLimitMaxAmount)
+}
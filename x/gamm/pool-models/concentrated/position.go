@@ -0,0 +1,325 @@
+package concentrated
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/types"
+)
+
+// PositionStore gives access to the positions opened by LPs in a concentrated liquidity pool,
+// which are kept in state apart from the pool.
+type PositionStore interface {
+	// GetPosition returns the position with the given id, and false if there is none.
+	GetPosition(positionId uint64) (Position, bool)
+	// SetPosition stores the position.
+	SetPosition(position Position)
+	// DeletePosition removes the position with the given id.
+	DeletePosition(positionId uint64)
+	// IteratePositions calls cb on the positions by ascending id, until cb returns true.
+	IteratePositions(cb func(position Position) (stop bool))
+}
+
+func newPosition(positionId uint64, owner string, lowerTick, upperTick int64) Position {
+	return Position{
+		PositionId:           positionId,
+		Owner:                owner,
+		LowerTick:            lowerTick,
+		UpperTick:            upperTick,
+		Liquidity:            sdk.ZeroDec(),
+		FeeGrowthInsideLast0: sdk.ZeroDec(),
+		FeeGrowthInsideLast1: sdk.ZeroDec(),
+		TokensOwed:           sdk.DecCoins{},
+	}
+}
+
+// IsActive returns true if the range of the position contains the given tick.
+func (position Position) IsActive(currentTick int64) bool {
+	return position.LowerTick <= currentTick && currentTick < position.UpperTick
+}
+
+// GetPosition returns the position with the given id, with its fees accrued up to the current state of the pool.
+func (p Pool) GetPosition(positions PositionStore, positionId uint64) (Position, error) {
+	position, err := p.findPosition(positions, positionId)
+	if err != nil {
+		return Position{}, err
+	}
+	return p.withFeesAccrued(position), nil
+}
+
+// GetPositions returns the positions opened by LPs in the pool, with their fees accrued up to the current state
+// of the pool. If owner is not empty, only the positions of owner are returned.
+func (p Pool) GetPositions(positions PositionStore, owner string) []Position {
+	res := []Position{}
+	positions.IteratePositions(func(position Position) bool {
+		if owner == "" || position.Owner == owner {
+			res = append(res, p.withFeesAccrued(position))
+		}
+		return false
+	})
+	return res
+}
+
+// CreatePosition provides liquidity over the range [lowerTick, upperTick], owned by owner.
+// The largest liquidity that tokensDesired can provide at the current price is added, and it errors
+// if the tokens required for it are less than tokensMin.
+// Returns the created position, and the tokens that the owner has to deposit in the pool.
+func (p *Pool) CreatePosition(positions PositionStore, owner sdk.AccAddress, lowerTick, upperTick int64, tokensDesired, tokensMin sdk.Coins) (Position, sdk.Coins, error) {
+	if err := p.validatePositionTicks(lowerTick, upperTick); err != nil {
+		return Position{}, sdk.Coins{}, err
+	}
+
+	poolDenoms := sdk.NewCoins(sdk.NewInt64Coin(p.Token0, 1), sdk.NewInt64Coin(p.Token1, 1))
+	if !tokensDesired.DenomsSubsetOf(poolDenoms) || !tokensMin.DenomsSubsetOf(poolDenoms) {
+		return Position{}, sdk.Coins{}, sdkerrors.Wrapf(types.ErrDenomNotFoundInPool,
+			"tokens desired %s or tokens min %s are not in pool %d", tokensDesired, tokensMin, p.Id)
+	}
+
+	position := newPosition(p.NextPositionId, owner.String(), lowerTick, upperTick)
+	amount0Desired, amount1Desired := tokensDesired.AmountOf(p.Token0), tokensDesired.AmountOf(p.Token1)
+	liquidity := p.liquidityForAmounts(position, amount0Desired.ToDec(), amount1Desired.ToDec())
+	if !liquidity.IsPositive() {
+		return Position{}, sdk.Coins{}, sdkerrors.Wrapf(types.ErrInvalidLiquidity,
+			"tokens desired %s provide no liquidity over [%d, %d]", tokensDesired, lowerTick, upperTick)
+	}
+
+	// round the tokens in up, but never above the desired amounts, which the liquidity is rounded down from.
+	amount0, amount1 := p.amountsForLiquidity(position, liquidity)
+	tokensIn := sdk.NewCoins(
+		sdk.NewCoin(p.Token0, sdk.MinInt(amount0.Ceil().TruncateInt(), amount0Desired)),
+		sdk.NewCoin(p.Token1, sdk.MinInt(amount1.Ceil().TruncateInt(), amount1Desired)),
+	)
+	for _, tokenMin := range tokensMin {
+		if tokensIn.AmountOf(tokenMin.Denom).LT(tokenMin.Amount) {
+			return Position{}, sdk.Coins{}, sdkerrors.Wrapf(types.ErrLimitMinAmount,
+				"position requires %s, less than the minimum of %s", tokensIn, tokensMin)
+		}
+	}
+
+	p.updatePosition(&position, liquidity)
+	positions.SetPosition(position)
+	p.NextPositionId++
+
+	return position, tokensIn, nil
+}
+
+// WithdrawPosition withdraws liquidity from the position with the given id, along with all of its uncollected fees.
+// The position is removed once all of its liquidity is withdrawn.
+// Returns the tokens to send to the owner.
+func (p *Pool) WithdrawPosition(positions PositionStore, owner sdk.AccAddress, positionId uint64, liquidity sdk.Dec) (sdk.Coins, error) {
+	position, err := p.findOwnedPosition(positions, owner, positionId)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+
+	if liquidity.IsNil() || !liquidity.IsPositive() || liquidity.GT(position.Liquidity) {
+		return sdk.Coins{}, sdkerrors.Wrapf(types.ErrInvalidLiquidity,
+			"liquidity to withdraw must be positive and at most %s, got %s", position.Liquidity, liquidity)
+	}
+
+	p.updatePosition(&position, liquidity.Neg())
+	amount0, amount1 := p.amountsForLiquidity(position, liquidity)
+	tokensOut := sdk.NewCoins(
+		sdk.NewCoin(p.Token0, amount0.TruncateInt()),
+		sdk.NewCoin(p.Token1, amount1.TruncateInt()),
+	).Add(collectFees(&position)...)
+
+	if position.Liquidity.IsZero() {
+		positions.DeletePosition(positionId)
+	} else {
+		positions.SetPosition(position)
+	}
+	return tokensOut, nil
+}
+
+// CollectFees withdraws the uncollected fees of the position with the given id.
+// Returns the tokens to send to the owner.
+func (p *Pool) CollectFees(positions PositionStore, owner sdk.AccAddress, positionId uint64) (sdk.Coins, error) {
+	position, err := p.findOwnedPosition(positions, owner, positionId)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+
+	position = p.withFeesAccrued(position)
+	fees := collectFees(&position)
+	positions.SetPosition(position)
+	return fees, nil
+}
+
+// collectFees removes the whole part of the tokens owed to the position, and returns it.
+func collectFees(position *Position) sdk.Coins {
+	fees, change := position.TokensOwed.TruncateDecimal()
+	position.TokensOwed = change
+	return fees
+}
+
+func (p Pool) findPosition(positions PositionStore, positionId uint64) (Position, error) {
+	position, found := positions.GetPosition(positionId)
+	if !found {
+		return Position{}, sdkerrors.Wrapf(types.ErrPositionNotFound, "position %d not found in pool %d", positionId, p.Id)
+	}
+	return position, nil
+}
+
+func (p Pool) findOwnedPosition(positions PositionStore, owner sdk.AccAddress, positionId uint64) (Position, error) {
+	position, err := p.findPosition(positions, positionId)
+	if err != nil {
+		return Position{}, err
+	}
+	if position.Owner != owner.String() {
+		return Position{}, sdkerrors.Wrapf(types.ErrNotPositionOwner, "position %d is owned by %s", positionId, position.Owner)
+	}
+	return position, nil
+}
+
+// validatePositionTicks checks that [lowerTick, upperTick] is a non empty range of ticks within the pool bounds,
+// and that both ticks are multiples of the tick spacing.
+func (p Pool) validatePositionTicks(lowerTick, upperTick int64) error {
+	if lowerTick >= upperTick {
+		return sdkerrors.Wrapf(types.ErrInvalidPositionTicks, "lower tick %d must be less than upper tick %d", lowerTick, upperTick)
+	}
+	if lowerTick < MinTick || upperTick > MaxTick {
+		return sdkerrors.Wrapf(types.ErrInvalidPositionTicks, "ticks must be between %d and %d", MinTick, MaxTick)
+	}
+	if lowerTick%p.GetTickSpacing() != 0 || upperTick%p.GetTickSpacing() != 0 {
+		return sdkerrors.Wrapf(types.ErrInvalidPositionTicks, "ticks must be multiples of the tick spacing %d", p.GetTickSpacing())
+	}
+	return nil
+}
+
+// amountsForLiquidity returns the amounts of token0 and token1 held by the given liquidity over the range of the position.
+func (p Pool) amountsForLiquidity(position Position, liquidity sdk.Dec) (amount0, amount1 sdk.Dec) {
+	return getAmountsForLiquidity(liquidity, p.CurrentSqrtPrice,
+		TickToSqrtPrice(position.LowerTick), TickToSqrtPrice(position.UpperTick))
+}
+
+// liquidityForAmounts returns the largest liquidity over the range of the position that amount0 and amount1 can provide.
+func (p Pool) liquidityForAmounts(position Position, amount0, amount1 sdk.Dec) sdk.Dec {
+	return getLiquidityForAmounts(p.CurrentSqrtPrice,
+		TickToSqrtPrice(position.LowerTick), TickToSqrtPrice(position.UpperTick), amount0, amount1)
+}
+
+// updatePosition adds liquidityDelta to the liquidity of the position, accruing its fees beforehand.
+// The ticks bounding the position and the active liquidity of the pool are updated accordingly.
+func (p *Pool) updatePosition(position *Position, liquidityDelta sdk.Dec) {
+	if !liquidityDelta.IsZero() {
+		p.updateTick(position.LowerTick, liquidityDelta, false)
+		p.updateTick(position.UpperTick, liquidityDelta, true)
+	}
+
+	*position = p.withFeesAccrued(*position)
+	position.Liquidity = position.Liquidity.Add(liquidityDelta)
+	if position.IsActive(p.CurrentTick) {
+		p.CurrentLiquidity = p.CurrentLiquidity.Add(liquidityDelta)
+	}
+
+	// ticks are only removed once the fees of the position are accrued, since they depend on them.
+	if liquidityDelta.IsNegative() {
+		p.removeTickIfEmpty(position.LowerTick)
+		p.removeTickIfEmpty(position.UpperTick)
+	}
+}
+
+// withFeesAccrued returns the position with the fees collected inside its range since its last update
+// added to the tokens owed to it.
+func (p Pool) withFeesAccrued(position Position) Position {
+	feeGrowthInside0, feeGrowthInside1 := p.feeGrowthInside(position.LowerTick, position.UpperTick)
+	position.TokensOwed = p.feesOwed(position)
+	position.FeeGrowthInsideLast0 = feeGrowthInside0
+	position.FeeGrowthInsideLast1 = feeGrowthInside1
+	return position
+}
+
+// feesOwed returns the fees owed to the position, including the ones collected since its last update.
+func (p Pool) feesOwed(position Position) sdk.DecCoins {
+	if position.Liquidity.IsZero() {
+		return position.TokensOwed
+	}
+
+	feeGrowthInside0, feeGrowthInside1 := p.feeGrowthInside(position.LowerTick, position.UpperTick)
+	fees0 := position.Liquidity.MulTruncate(feeGrowthInside0.Sub(position.FeeGrowthInsideLast0))
+	fees1 := position.Liquidity.MulTruncate(feeGrowthInside1.Sub(position.FeeGrowthInsideLast1))
+
+	tokensOwed := position.TokensOwed
+	if fees0.IsPositive() {
+		tokensOwed = tokensOwed.Add(sdk.NewDecCoinFromDec(p.Token0, fees0))
+	}
+	if fees1.IsPositive() {
+		tokensOwed = tokensOwed.Add(sdk.NewDecCoinFromDec(p.Token1, fees1))
+	}
+	return tokensOwed
+}
+
+// feeGrowthInside returns the fees per unit of liquidity collected inside the range [lowerTick, upperTick],
+// which must both be initialized.
+func (p Pool) feeGrowthInside(lowerTick, upperTick int64) (sdk.Dec, sdk.Dec) {
+	lower, upper := p.Ticks[p.mustFindTick(lowerTick)], p.Ticks[p.mustFindTick(upperTick)]
+
+	// the fee growth outside of a tick is relative to the current tick, flip it to get the one below the lower
+	// tick and above the upper tick.
+	below0, below1 := lower.FeeGrowthOutside0, lower.FeeGrowthOutside1
+	if p.CurrentTick < lowerTick {
+		below0, below1 = p.FeeGrowthGlobal0.Sub(below0), p.FeeGrowthGlobal1.Sub(below1)
+	}
+	above0, above1 := upper.FeeGrowthOutside0, upper.FeeGrowthOutside1
+	if p.CurrentTick >= upperTick {
+		above0, above1 = p.FeeGrowthGlobal0.Sub(above0), p.FeeGrowthGlobal1.Sub(above1)
+	}
+
+	return p.FeeGrowthGlobal0.Sub(below0).Sub(above0), p.FeeGrowthGlobal1.Sub(below1).Sub(above1)
+}
+
+// findTick returns the index in p.Ticks of the tick with the given index, or where it should be inserted.
+func (p Pool) findTick(tickIndex int64) (int, bool) {
+	i := sort.Search(len(p.Ticks), func(i int) bool { return p.Ticks[i].Index >= tickIndex })
+	return i, i < len(p.Ticks) && p.Ticks[i].Index == tickIndex
+}
+
+func (p Pool) mustFindTick(tickIndex int64) int {
+	i, found := p.findTick(tickIndex)
+	if !found {
+		panic(sdkerrors.Wrapf(types.ErrInvalidPositionTicks, "tick %d is not initialized in pool %d", tickIndex, p.Id))
+	}
+	return i
+}
+
+// updateTick adds liquidityDelta to the liquidity of the position bounded by the given tick, initializing it if needed.
+// The liquidity net is the liquidity entering the active range when crossing the tick upwards, so it is decreased
+// for upper ticks.
+func (p *Pool) updateTick(tickIndex int64, liquidityDelta sdk.Dec, upper bool) {
+	i, found := p.findTick(tickIndex)
+	if !found {
+		tick := TickInfo{
+			Index:             tickIndex,
+			LiquidityGross:    sdk.ZeroDec(),
+			LiquidityNet:      sdk.ZeroDec(),
+			FeeGrowthOutside0: sdk.ZeroDec(),
+			FeeGrowthOutside1: sdk.ZeroDec(),
+		}
+		// by convention, all the fees collected so far were collected below the tick if it is below the current price.
+		if tickIndex <= p.CurrentTick {
+			tick.FeeGrowthOutside0 = p.FeeGrowthGlobal0
+			tick.FeeGrowthOutside1 = p.FeeGrowthGlobal1
+		}
+		p.Ticks = append(p.Ticks, TickInfo{})
+		copy(p.Ticks[i+1:], p.Ticks[i:])
+		p.Ticks[i] = tick
+	}
+
+	tick := &p.Ticks[i]
+	tick.LiquidityGross = tick.LiquidityGross.Add(liquidityDelta)
+	if upper {
+		tick.LiquidityNet = tick.LiquidityNet.Sub(liquidityDelta)
+	} else {
+		tick.LiquidityNet = tick.LiquidityNet.Add(liquidityDelta)
+	}
+}
+
+func (p *Pool) removeTickIfEmpty(tickIndex int64) {
+	i := p.mustFindTick(tickIndex)
+	if p.Ticks[i].LiquidityGross.IsZero() {
+		p.Ticks = append(p.Ticks[:i], p.Ticks[i+1:]...)
+	}
+}
@@ -0,0 +1,262 @@
+package concentrated
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/types"
+)
+
+// swapStep is the result of swapping within the range between the current price and the next initialized tick.
+type swapStep struct {
+	sqrtPriceNext sdk.Dec
+	amountIn      sdk.Dec
+	amountOut     sdk.Dec
+	feeAmount     sdk.Dec
+}
+
+// zeroForOne returns whether a swap of tokenInDenom for tokenOutDenom swaps token0 for token1,
+// which moves the price down.
+func (p Pool) zeroForOne(tokenInDenom string, tokenOutDenom string) (bool, error) {
+	switch {
+	case tokenInDenom == p.Token0 && tokenOutDenom == p.Token1:
+		return true, nil
+	case tokenInDenom == p.Token1 && tokenOutDenom == p.Token0:
+		return false, nil
+	default:
+		return false, sdkerrors.Wrapf(types.ErrDenomNotFoundInPool,
+			"pool %d can't swap %s for %s", p.Id, tokenInDenom, tokenOutDenom)
+	}
+}
+
+// nextInitializedTick returns the index in p.Ticks of the next tick crossed by a swap in the given direction,
+// and false if there is none.
+func (p Pool) nextInitializedTick(zeroForOne bool) (int, bool) {
+	// index of the first tick above the current tick.
+	i, _ := p.findTick(p.CurrentTick + 1)
+	if zeroForOne {
+		return i - 1, i > 0
+	}
+	return i, i < len(p.Ticks)
+}
+
+// swapTarget returns the square root price of the next tick crossed by a swap in the given direction.
+// If there is none, it returns the price bound of the pool.
+func (p Pool) swapTarget(zeroForOne bool) (sdk.Dec, int, bool) {
+	i, ok := p.nextInitializedTick(zeroForOne)
+	switch {
+	case ok:
+		return TickToSqrtPrice(p.Ticks[i].Index), i, true
+	case zeroForOne:
+		return TickToSqrtPrice(MinTick), 0, false
+	default:
+		return TickToSqrtPrice(MaxTick), 0, false
+	}
+}
+
+// computeSwapStepExactIn swaps at most amountRemaining, including the swap fee, with the given liquidity,
+// between sqrtPrice and sqrtPriceTarget.
+func computeSwapStepExactIn(sqrtPrice, sqrtPriceTarget, liquidity, amountRemaining, swapFee sdk.Dec, zeroForOne bool) swapStep {
+	step := swapStep{sqrtPriceNext: sqrtPriceTarget, amountIn: sdk.ZeroDec(), amountOut: sdk.ZeroDec(), feeAmount: sdk.ZeroDec()}
+	if !liquidity.IsPositive() {
+		// no liquidity to swap against, the price moves to the target for free.
+		return step
+	}
+
+	amountRemainingLessFee := amountRemaining.MulTruncate(sdk.OneDec().Sub(swapFee))
+	if zeroForOne {
+		maxAmountIn := getAmount0(liquidity, sqrtPriceTarget, sqrtPrice)
+		if amountRemainingLessFee.GTE(maxAmountIn) {
+			step.amountIn = maxAmountIn
+		} else {
+			// sqrtPriceNext = liquidity * sqrtPrice / (liquidity + amountIn * sqrtPrice), rounding up in favor of the pool.
+			step.amountIn = amountRemainingLessFee
+			step.sqrtPriceNext = liquidity.Mul(sqrtPrice).QuoRoundUp(liquidity.Add(step.amountIn.Mul(sqrtPrice)))
+		}
+		step.amountOut = getAmount1(liquidity, step.sqrtPriceNext, sqrtPrice)
+	} else {
+		maxAmountIn := getAmount1(liquidity, sqrtPrice, sqrtPriceTarget)
+		if amountRemainingLessFee.GTE(maxAmountIn) {
+			step.amountIn = maxAmountIn
+		} else {
+			// sqrtPriceNext = sqrtPrice + amountIn / liquidity, rounding down in favor of the pool.
+			step.amountIn = amountRemainingLessFee
+			step.sqrtPriceNext = sqrtPrice.Add(step.amountIn.QuoTruncate(liquidity))
+		}
+		step.amountOut = getAmount0(liquidity, sqrtPrice, step.sqrtPriceNext)
+	}
+
+	if step.sqrtPriceNext.Equal(sqrtPriceTarget) {
+		step.feeAmount = sdk.MinDec(step.amountIn.Mul(swapFee).QuoRoundUp(sdk.OneDec().Sub(swapFee)), amountRemaining.Sub(step.amountIn))
+	} else {
+		// the whole remaining amount is consumed by this step.
+		step.feeAmount = amountRemaining.Sub(step.amountIn)
+	}
+	return step
+}
+
+// computeSwapStepExactOut swaps for at most amountRemaining with the given liquidity,
+// between sqrtPrice and sqrtPriceTarget.
+func computeSwapStepExactOut(sqrtPrice, sqrtPriceTarget, liquidity, amountRemaining, swapFee sdk.Dec, zeroForOne bool) swapStep {
+	step := swapStep{sqrtPriceNext: sqrtPriceTarget, amountIn: sdk.ZeroDec(), amountOut: sdk.ZeroDec(), feeAmount: sdk.ZeroDec()}
+	if !liquidity.IsPositive() {
+		// no liquidity to swap against, the price moves to the target for free.
+		return step
+	}
+
+	if zeroForOne {
+		maxAmountOut := getAmount1(liquidity, sqrtPriceTarget, sqrtPrice)
+		if amountRemaining.GTE(maxAmountOut) {
+			step.amountOut = maxAmountOut
+		} else {
+			// sqrtPriceNext = sqrtPrice - amountOut / liquidity, rounding down in favor of the pool.
+			step.amountOut = amountRemaining
+			step.sqrtPriceNext = sdk.MaxDec(sqrtPrice.Sub(step.amountOut.QuoRoundUp(liquidity)), sqrtPriceTarget)
+		}
+		step.amountIn = getAmount0(liquidity, step.sqrtPriceNext, sqrtPrice)
+	} else {
+		maxAmountOut := getAmount0(liquidity, sqrtPrice, sqrtPriceTarget)
+		if amountRemaining.GTE(maxAmountOut) {
+			step.amountOut = maxAmountOut
+		} else {
+			// sqrtPriceNext = liquidity * sqrtPrice / (liquidity - amountOut * sqrtPrice), rounding up in favor of the pool.
+			step.amountOut = amountRemaining
+			step.sqrtPriceNext = sdk.MinDec(liquidity.Mul(sqrtPrice).QuoRoundUp(liquidity.Sub(step.amountOut.Mul(sqrtPrice))), sqrtPriceTarget)
+		}
+		step.amountIn = getAmount1(liquidity, sqrtPrice, step.sqrtPriceNext)
+	}
+
+	step.feeAmount = step.amountIn.Mul(swapFee).QuoRoundUp(sdk.OneDec().Sub(swapFee))
+	return step
+}
+
+// applySwapStep moves the pool to the price reached by the step, crossing the next initialized tick if it was
+// reached, and distributes the swap fee of the step to the active liquidity.
+func (p *Pool) applySwapStep(step swapStep, sqrtPriceTarget sdk.Dec, tickIndex int, crossesTick bool, zeroForOne bool) {
+	if step.feeAmount.IsPositive() {
+		feeGrowth := step.feeAmount.QuoTruncate(p.CurrentLiquidity)
+		if zeroForOne {
+			p.FeeGrowthGlobal0 = p.FeeGrowthGlobal0.Add(feeGrowth)
+		} else {
+			p.FeeGrowthGlobal1 = p.FeeGrowthGlobal1.Add(feeGrowth)
+		}
+	}
+
+	p.CurrentSqrtPrice = step.sqrtPriceNext
+	if !crossesTick || !step.sqrtPriceNext.Equal(sqrtPriceTarget) {
+		p.CurrentTick = SqrtPriceToTick(step.sqrtPriceNext)
+		return
+	}
+
+	// the fee growth outside of the crossed tick is now on the other side of the current tick.
+	tick := &p.Ticks[tickIndex]
+	tick.FeeGrowthOutside0 = p.FeeGrowthGlobal0.Sub(tick.FeeGrowthOutside0)
+	tick.FeeGrowthOutside1 = p.FeeGrowthGlobal1.Sub(tick.FeeGrowthOutside1)
+	if zeroForOne {
+		p.CurrentLiquidity = p.CurrentLiquidity.Sub(tick.LiquidityNet)
+		p.CurrentTick = tick.Index - 1
+	} else {
+		p.CurrentLiquidity = p.CurrentLiquidity.Add(tick.LiquidityNet)
+		p.CurrentTick = tick.Index
+	}
+}
+
+func (p *Pool) swapExactAmountIn(tokenIn sdk.Coin, tokenOutDenom string, swapFee sdk.Dec) (sdk.Coin, error) {
+	zeroForOne, err := p.zeroForOne(tokenIn.Denom, tokenOutDenom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	amountRemaining := tokenIn.Amount.ToDec()
+	amountOut := sdk.ZeroDec()
+	for amountRemaining.IsPositive() {
+		sqrtPriceTarget, tickIndex, crossesTick := p.swapTarget(zeroForOne)
+		if !crossesTick && p.CurrentSqrtPrice.Equal(sqrtPriceTarget) {
+			return sdk.Coin{}, sdkerrors.Wrapf(types.ErrNotEnoughLiquidity,
+				"pool %d can't swap %s for %s", p.Id, tokenIn, tokenOutDenom)
+		}
+
+		step := computeSwapStepExactIn(p.CurrentSqrtPrice, sqrtPriceTarget, p.CurrentLiquidity, amountRemaining, swapFee, zeroForOne)
+		amountRemaining = amountRemaining.Sub(step.amountIn).Sub(step.feeAmount)
+		amountOut = amountOut.Add(step.amountOut)
+		p.applySwapStep(step, sqrtPriceTarget, tickIndex, crossesTick, zeroForOne)
+	}
+
+	return sdk.NewCoin(tokenOutDenom, amountOut.TruncateInt()), nil
+}
+
+func (p *Pool) swapExactAmountOut(tokenOut sdk.Coin, tokenInDenom string, swapFee sdk.Dec) (sdk.Coin, error) {
+	zeroForOne, err := p.zeroForOne(tokenInDenom, tokenOut.Denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	amountRemaining := tokenOut.Amount.ToDec()
+	amountIn := sdk.ZeroDec()
+	for amountRemaining.IsPositive() {
+		sqrtPriceTarget, tickIndex, crossesTick := p.swapTarget(zeroForOne)
+		if !crossesTick && p.CurrentSqrtPrice.Equal(sqrtPriceTarget) {
+			return sdk.Coin{}, sdkerrors.Wrapf(types.ErrNotEnoughLiquidity,
+				"pool %d can't swap %s for %s", p.Id, tokenInDenom, tokenOut)
+		}
+
+		step := computeSwapStepExactOut(p.CurrentSqrtPrice, sqrtPriceTarget, p.CurrentLiquidity, amountRemaining, swapFee, zeroForOne)
+		amountRemaining = amountRemaining.Sub(step.amountOut)
+		amountIn = amountIn.Add(step.amountIn).Add(step.feeAmount)
+		p.applySwapStep(step, sqrtPriceTarget, tickIndex, crossesTick, zeroForOne)
+	}
+
+	return sdk.NewCoin(tokenInDenom, amountIn.Ceil().TruncateInt()), nil
+}
+
+// copy returns a copy of the pool that can be mutated without affecting p.
+func (p Pool) copy() Pool {
+	p.Ticks = append([]TickInfo{}, p.Ticks...)
+	return p
+}
+
+func validateSwapTokens(tokens sdk.Coins) (sdk.Coin, error) {
+	if tokens.Len() != 1 {
+		return sdk.Coin{}, errors.New("concentrated liquidity pool only supports swapping a single token")
+	}
+	return tokens[0], nil
+}
+
+func (p Pool) CalcOutAmtGivenIn(ctx sdk.Context, tokenIn sdk.Coins, tokenOutDenom string, swapFee sdk.Dec) (tokenOut sdk.Coin, err error) {
+	poolCopy := p.copy()
+	return poolCopy.SwapOutAmtGivenIn(ctx, tokenIn, tokenOutDenom, swapFee)
+}
+
+func (p *Pool) SwapOutAmtGivenIn(ctx sdk.Context, tokenIn sdk.Coins, tokenOutDenom string, swapFee sdk.Dec) (tokenOut sdk.Coin, err error) {
+	coinIn, err := validateSwapTokens(tokenIn)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	tokenOut, err = p.swapExactAmountIn(coinIn, tokenOutDenom, swapFee)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if !tokenOut.Amount.IsPositive() {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrInvalidMathApprox, "token amount must be positive")
+	}
+	return tokenOut, nil
+}
+
+func (p Pool) CalcInAmtGivenOut(ctx sdk.Context, tokenOut sdk.Coins, tokenInDenom string, swapFee sdk.Dec) (tokenIn sdk.Coin, err error) {
+	poolCopy := p.copy()
+	return poolCopy.SwapInAmtGivenOut(ctx, tokenOut, tokenInDenom, swapFee)
+}
+
+func (p *Pool) SwapInAmtGivenOut(ctx sdk.Context, tokenOut sdk.Coins, tokenInDenom string, swapFee sdk.Dec) (tokenIn sdk.Coin, err error) {
+	coinOut, err := validateSwapTokens(tokenOut)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if !coinOut.Amount.IsPositive() {
+		return sdk.Coin{}, fmt.Errorf("token out amount must be positive, got %s", coinOut)
+	}
+	return p.swapExactAmountOut(coinOut, tokenInDenom, swapFee)
+}
@@ -0,0 +1,60 @@
+package orderbook
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+
+	types "github.com/osmosis-labs/osmosis/v12/x/gamm/types"
+)
+
+// RegisterLegacyAminoCodec registers the necessary x/gamm interfaces and concrete types
+// on the provided LegacyAmino codec. These types are used for Amino JSON serialization.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&Pool{}, "osmosis/gamm/OrderbookPool", nil)
+	cdc.RegisterConcrete(&MsgCreateOrderbookPool{}, "osmosis/gamm/create-orderbook-pool", nil)
+	cdc.RegisterConcrete(&MsgPlaceLimitOrder{}, "osmosis/gamm/place-limit-order", nil)
+	cdc.RegisterConcrete(&MsgCancelLimitOrder{}, "osmosis/gamm/cancel-limit-order", nil)
+	cdc.RegisterConcrete(&MsgClaimLimitOrder{}, "osmosis/gamm/claim-limit-order", nil)
+	cdc.RegisterConcrete(&PoolParams{}, "osmosis/gamm/OrderbookPoolParams", nil)
+}
+
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterInterface(
+		"osmosis.gamm.v1beta1.PoolI",
+		(*types.PoolI)(nil),
+		&Pool{},
+	)
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgCreateOrderbookPool{},
+		&MsgPlaceLimitOrder{},
+		&MsgCancelLimitOrder{},
+		&MsgClaimLimitOrder{},
+	)
+	registry.RegisterImplementations(
+		(*proto.Message)(nil),
+		&PoolParams{},
+	)
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+var (
+	amino = codec.NewLegacyAmino()
+
+	// ModuleCdc references the global x/bank module codec. Note, the codec should
+	// ONLY be used in certain instances of tests and for JSON encoding as Amino is
+	// still used for that purpose.
+	//
+	// The actual codec used for serialization should be provided to x/staking and
+	// defined at the application level.
+	ModuleCdc = codec.NewAminoCodec(amino)
+)
+
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	amino.Seal()
+}
@@ -0,0 +1,185 @@
+package orderbook
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/types"
+)
+
+const (
+	TypeMsgCreateOrderbookPool = "create_orderbook_pool"
+	TypeMsgPlaceLimitOrder     = "place_limit_order"
+	TypeMsgCancelLimitOrder    = "cancel_limit_order"
+	TypeMsgClaimLimitOrder     = "claim_limit_order"
+)
+
+var (
+	_ sdk.Msg             = &MsgCreateOrderbookPool{}
+	_ types.CreatePoolMsg = &MsgCreateOrderbookPool{}
+)
+
+func NewMsgCreateOrderbookPool(
+	sender sdk.AccAddress,
+	poolParams PoolParams,
+	initialLiquidity sdk.Coins,
+	baseDenom string,
+	futurePoolGovernor string,
+) MsgCreateOrderbookPool {
+	return MsgCreateOrderbookPool{
+		Sender:               sender.String(),
+		PoolParams:           poolParams,
+		InitialPoolLiquidity: initialLiquidity,
+		BaseDenom:            baseDenom,
+		FuturePoolGovernor:   futurePoolGovernor,
+	}
+}
+
+func (msg MsgCreateOrderbookPool) Route() string { return types.RouterKey }
+func (msg MsgCreateOrderbookPool) Type() string  { return TypeMsgCreateOrderbookPool }
+func (msg MsgCreateOrderbookPool) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	err = msg.PoolParams.Validate()
+	if err != nil {
+		return err
+	}
+
+	// validation for pool initial liquidity and base denom
+	if _, err = validateInitialLiquidity(msg.InitialPoolLiquidity, msg.BaseDenom); err != nil {
+		return err
+	}
+
+	// validation for future governor
+	if err = types.ValidateFutureGovernor(msg.FuturePoolGovernor); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (msg MsgCreateOrderbookPool) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCreateOrderbookPool) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+/// Implement the CreatePoolMsg interface
+
+func (msg MsgCreateOrderbookPool) PoolCreator() sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return sender
+}
+
+func (msg MsgCreateOrderbookPool) Validate(ctx sdk.Context) error {
+	return msg.ValidateBasic()
+}
+
+func (msg MsgCreateOrderbookPool) InitialLiquidity() sdk.Coins {
+	return msg.InitialPoolLiquidity
+}
+
+func (msg MsgCreateOrderbookPool) CreatePool(ctx sdk.Context, poolId uint64) (types.PoolI, error) {
+	orderbookPool, err := NewOrderbookPool(poolId, msg.PoolParams, msg.InitialPoolLiquidity,
+		msg.BaseDenom, msg.FuturePoolGovernor)
+	if err != nil {
+		return nil, err
+	}
+
+	return &orderbookPool, nil
+}
+
+var _ sdk.Msg = &MsgPlaceLimitOrder{}
+
+func (msg MsgPlaceLimitOrder) Route() string { return types.RouterKey }
+func (msg MsgPlaceLimitOrder) Type() string  { return TypeMsgPlaceLimitOrder }
+func (msg MsgPlaceLimitOrder) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if !msg.TokenIn.IsValid() || !msg.TokenIn.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.TokenIn.String())
+	}
+
+	if msg.Tick <= 0 {
+		return sdkerrors.Wrapf(types.ErrInvalidTick, "tick must be positive, got %d", msg.Tick)
+	}
+
+	return nil
+}
+
+func (msg MsgPlaceLimitOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgPlaceLimitOrder) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgCancelLimitOrder{}
+
+func (msg MsgCancelLimitOrder) Route() string { return types.RouterKey }
+func (msg MsgCancelLimitOrder) Type() string  { return TypeMsgCancelLimitOrder }
+func (msg MsgCancelLimitOrder) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	return nil
+}
+
+func (msg MsgCancelLimitOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCancelLimitOrder) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgClaimLimitOrder{}
+
+func (msg MsgClaimLimitOrder) Route() string { return types.RouterKey }
+func (msg MsgClaimLimitOrder) Type() string  { return TypeMsgClaimLimitOrder }
+func (msg MsgClaimLimitOrder) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	return nil
+}
+
+func (msg MsgClaimLimitOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgClaimLimitOrder) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
@@ -0,0 +1,198 @@
+package orderbook_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	appParams "github.com/osmosis-labs/osmosis/v12/app/params"
+	orderbook "github.com/osmosis-labs/osmosis/v12/x/gamm/pool-models/orderbook"
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/types"
+)
+
+func TestMsgCreateOrderbookPool(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	createMsg := func(after func(msg orderbook.MsgCreateOrderbookPool) orderbook.MsgCreateOrderbookPool) orderbook.MsgCreateOrderbookPool {
+		testPoolAsset := sdk.Coins{
+			sdk.NewCoin("atom", sdk.NewInt(100)),
+			sdk.NewCoin("osmo", sdk.NewInt(100)),
+		}
+
+		poolParams := orderbook.PoolParams{
+			SwapFee:      sdk.NewDecWithPrec(1, 2),
+			ExitFee:      sdk.NewDecWithPrec(1, 2),
+			TickSize:     sdk.NewDecWithPrec(1, 2),
+			MinOrderSize: sdk.NewInt(10),
+		}
+
+		msg := &orderbook.MsgCreateOrderbookPool{
+			Sender:               addr1,
+			PoolParams:           poolParams,
+			InitialPoolLiquidity: testPoolAsset,
+			BaseDenom:            "atom",
+			FuturePoolGovernor:   "",
+		}
+
+		return after(*msg)
+	}
+
+	default_msg := createMsg(func(msg orderbook.MsgCreateOrderbookPool) orderbook.MsgCreateOrderbookPool {
+		// Do nothing
+		return msg
+	})
+
+	require.Equal(t, default_msg.Route(), types.RouterKey)
+	require.Equal(t, default_msg.Type(), "create_orderbook_pool")
+	signers := default_msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	tests := []struct {
+		name       string
+		msg        orderbook.MsgCreateOrderbookPool
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg orderbook.MsgCreateOrderbookPool) orderbook.MsgCreateOrderbookPool {
+				// Do nothing
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: createMsg(func(msg orderbook.MsgCreateOrderbookPool) orderbook.MsgCreateOrderbookPool {
+				msg.Sender = invalidAddr.String()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "has one coin in InitialPoolLiquidity",
+			msg: createMsg(func(msg orderbook.MsgCreateOrderbookPool) orderbook.MsgCreateOrderbookPool {
+				msg.InitialPoolLiquidity = sdk.Coins{
+					sdk.NewCoin("atom", sdk.NewInt(100)),
+				}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "has three coins in InitialPoolLiquidity",
+			msg: createMsg(func(msg orderbook.MsgCreateOrderbookPool) orderbook.MsgCreateOrderbookPool {
+				msg.InitialPoolLiquidity = sdk.Coins{
+					sdk.NewCoin("atom", sdk.NewInt(100)),
+					sdk.NewCoin("osmo", sdk.NewInt(100)),
+					sdk.NewCoin("usdc", sdk.NewInt(100)),
+				}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "base denom not in InitialPoolLiquidity",
+			msg: createMsg(func(msg orderbook.MsgCreateOrderbookPool) orderbook.MsgCreateOrderbookPool {
+				msg.BaseDenom = "usdc"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "negative swap fee",
+			msg: createMsg(func(msg orderbook.MsgCreateOrderbookPool) orderbook.MsgCreateOrderbookPool {
+				msg.PoolParams.SwapFee = sdk.NewDecWithPrec(-1, 2)
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero tick size",
+			msg: createMsg(func(msg orderbook.MsgCreateOrderbookPool) orderbook.MsgCreateOrderbookPool {
+				msg.PoolParams.TickSize = sdk.ZeroDec()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero min order size",
+			msg: createMsg(func(msg orderbook.MsgCreateOrderbookPool) orderbook.MsgCreateOrderbookPool {
+				msg.PoolParams.MinOrderSize = sdk.ZeroInt()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "invalid governor",
+			msg: createMsg(func(msg orderbook.MsgCreateOrderbookPool) orderbook.MsgCreateOrderbookPool {
+				msg.FuturePoolGovernor = "invalid_cosmos_address"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "valid governor: just lock duration for pool token",
+			msg: createMsg(func(msg orderbook.MsgCreateOrderbookPool) orderbook.MsgCreateOrderbookPool {
+				msg.FuturePoolGovernor = "1000h"
+				return msg
+			}),
+			expectPass: true,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgPlaceLimitOrder(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+
+	tests := []struct {
+		name       string
+		msg        orderbook.MsgPlaceLimitOrder
+		expectPass bool
+	}{
+		{
+			name:       "proper msg",
+			msg:        orderbook.MsgPlaceLimitOrder{Sender: addr1, PoolId: 1, Tick: 10, TokenIn: sdk.NewInt64Coin("atom", 10)},
+			expectPass: true,
+		},
+		{
+			name:       "invalid sender",
+			msg:        orderbook.MsgPlaceLimitOrder{Sender: "", PoolId: 1, Tick: 10, TokenIn: sdk.NewInt64Coin("atom", 10)},
+			expectPass: false,
+		},
+		{
+			name:       "zero amount",
+			msg:        orderbook.MsgPlaceLimitOrder{Sender: addr1, PoolId: 1, Tick: 10, TokenIn: sdk.NewInt64Coin("atom", 0)},
+			expectPass: false,
+		},
+		{
+			name:       "negative tick",
+			msg:        orderbook.MsgPlaceLimitOrder{Sender: addr1, PoolId: 1, Tick: -10, TokenIn: sdk.NewInt64Coin("atom", 10)},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
@@ -0,0 +1,1885 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/gamm/pool-models/orderbook/orderbook_pool.proto
+
+package orderbook
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// OrderDirection is the side of the book a limit order rests on.
+type OrderDirection int32
+
+const (
+	// ASK orders sell the base denom for the quote denom.
+	Ask OrderDirection = 0
+	// BID orders buy the base denom with the quote denom.
+	Bid OrderDirection = 1
+)
+
+var OrderDirection_name = map[int32]string{
+	0: "ASK",
+	1: "BID",
+}
+
+var OrderDirection_value = map[string]int32{
+	"ASK": 0,
+	"BID": 1,
+}
+
+func (x OrderDirection) String() string {
+	return proto.EnumName(OrderDirection_name, int32(x))
+}
+
+func (OrderDirection) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_29558bc42d652eaf, []int{0}
+}
+
+// PoolParams defined the parameters that will be managed by the pool
+// governance in the future. This params are not managed by the chain
+// governance. Instead they will be managed by the token holders of the pool.
+// The pool's token holders are specified in future_pool_governor.
+type PoolParams struct {
+	SwapFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=swap_fee,json=swapFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_fee" yaml:"swap_fee"`
+	ExitFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=exit_fee,json=exitFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exit_fee" yaml:"exit_fee"`
+	// tick_size is the price increment between two adjacent ticks, denominated
+	// in quote denom per base denom. The price of tick i is i * tick_size.
+	TickSize github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=tick_size,json=tickSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"tick_size" yaml:"tick_size"`
+	// min_order_size is the minimum value of a limit order, denominated in the
+	// quote denom. The value of an ask is its quantity times its tick price.
+	MinOrderSize github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=min_order_size,json=minOrderSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_order_size" yaml:"min_order_size"`
+}
+
+func (m *PoolParams) Reset()         { *m = PoolParams{} }
+func (m *PoolParams) String() string { return proto.CompactTextString(m) }
+func (*PoolParams) ProtoMessage()    {}
+func (*PoolParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29558bc42d652eaf, []int{0}
+}
+func (m *PoolParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolParams.Merge(m, src)
+}
+func (m *PoolParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolParams proto.InternalMessageInfo
+
+// LimitOrder is a resting order placed at a single price tick.
+type LimitOrder struct {
+	OrderId   uint64         `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty" yaml:"order_id"`
+	Owner     string         `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	Tick      int64          `protobuf:"varint,3,opt,name=tick,proto3" json:"tick,omitempty" yaml:"tick"`
+	Direction OrderDirection `protobuf:"varint,4,opt,name=direction,proto3,enum=osmosis.gamm.poolmodels.orderbook.v1beta1.OrderDirection" json:"direction,omitempty" yaml:"direction"`
+	// quantity is the amount deposited by the owner, denominated in the base
+	// denom for asks and in the quote denom for bids.
+	Quantity github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=quantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"quantity" yaml:"quantity"`
+	// filled is the part of quantity that has been swapped against.
+	Filled github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=filled,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"filled" yaml:"filled"`
+	// proceeds is the total amount received for the filled quantity,
+	// denominated in the quote denom for asks and in the base denom for bids.
+	Proceeds github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=proceeds,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"proceeds" yaml:"proceeds"`
+	// claimed is the part of proceeds already withdrawn by the owner.
+	Claimed github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=claimed,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"claimed" yaml:"claimed"`
+}
+
+func (m *LimitOrder) Reset()         { *m = LimitOrder{} }
+func (m *LimitOrder) String() string { return proto.CompactTextString(m) }
+func (*LimitOrder) ProtoMessage()    {}
+func (*LimitOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29558bc42d652eaf, []int{1}
+}
+func (m *LimitOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LimitOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LimitOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LimitOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LimitOrder.Merge(m, src)
+}
+func (m *LimitOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *LimitOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_LimitOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LimitOrder proto.InternalMessageInfo
+
+func (m *LimitOrder) GetOrderId() uint64 {
+	if m != nil {
+		return m.OrderId
+	}
+	return 0
+}
+
+func (m *LimitOrder) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *LimitOrder) GetTick() int64 {
+	if m != nil {
+		return m.Tick
+	}
+	return 0
+}
+
+func (m *LimitOrder) GetDirection() OrderDirection {
+	if m != nil {
+		return m.Direction
+	}
+	return Ask
+}
+
+// Tick is the queue of the unfilled limit orders of one direction at a single
+// price, in the order they were placed. Every tick of a pool is stored in its
+// own state entry, and orders are stored by id apart from their tick.
+type Tick struct {
+	Index     int64          `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty" yaml:"index"`
+	Direction OrderDirection `protobuf:"varint,2,opt,name=direction,proto3,enum=osmosis.gamm.poolmodels.orderbook.v1beta1.OrderDirection" json:"direction,omitempty" yaml:"direction"`
+	OrderIds  []uint64       `protobuf:"varint,3,rep,packed,name=order_ids,json=orderIds,proto3" json:"order_ids,omitempty" yaml:"order_ids"`
+}
+
+func (m *Tick) Reset()         { *m = Tick{} }
+func (m *Tick) String() string { return proto.CompactTextString(m) }
+func (*Tick) ProtoMessage()    {}
+func (*Tick) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29558bc42d652eaf, []int{2}
+}
+func (m *Tick) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Tick) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Tick.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Tick) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Tick.Merge(m, src)
+}
+func (m *Tick) XXX_Size() int {
+	return m.Size()
+}
+func (m *Tick) XXX_DiscardUnknown() {
+	xxx_messageInfo_Tick.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Tick proto.InternalMessageInfo
+
+func (m *Tick) GetIndex() int64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *Tick) GetDirection() OrderDirection {
+	if m != nil {
+		return m.Direction
+	}
+	return Ask
+}
+
+func (m *Tick) GetOrderIds() []uint64 {
+	if m != nil {
+		return m.OrderIds
+	}
+	return nil
+}
+
+// Pool is the orderbook Pool struct. It combines a constant product reserve
+// owned by the LPs with an order book of limit orders at discrete price ticks.
+type Pool struct {
+	Address    string     `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	Id         uint64     `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	PoolParams PoolParams `protobuf:"bytes,3,opt,name=pool_params,json=poolParams,proto3" json:"pool_params" yaml:"orderbook_pool_params"`
+	// This string specifies who will govern the pool in the future.
+	// Valid forms of this are:
+	// {token name},{duration}
+	// {duration}
+	// where {token name} if specified is the token which determines the
+	// governor, and if not specified is the LP token for this pool.duration is
+	// a time specified as 0w,1w,2w, etc. which specifies how long the token
+	// would need to be locked up to count in governance. 0w means no lockup.
+	FuturePoolGovernor string `protobuf:"bytes,4,opt,name=future_pool_governor,json=futurePoolGovernor,proto3" json:"future_pool_governor,omitempty" yaml:"future_pool_governor"`
+	// sum of all LP shares
+	TotalShares types.Coin `protobuf:"bytes,5,opt,name=total_shares,json=totalShares,proto3" json:"total_shares" yaml:"total_shares"`
+	// reserves owned by the LPs. Funds escrowed by limit orders are not part of
+	// the pool liquidity.
+	PoolLiquidity github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=pool_liquidity,json=poolLiquidity,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"pool_liquidity"`
+	BaseDenom     string                                   `protobuf:"bytes,7,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty" yaml:"base_denom"`
+	QuoteDenom    string                                   `protobuf:"bytes,8,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty" yaml:"quote_denom"`
+	// limit_orders is only set in genesis. Limit orders are stored apart from
+	// the pool while it is in state, so that loading the pool does not load its
+	// order book.
+	LimitOrders []LimitOrder `protobuf:"bytes,9,rep,name=limit_orders,json=limitOrders,proto3" json:"limit_orders" yaml:"limit_orders"`
+	NextOrderId uint64       `protobuf:"varint,10,opt,name=next_order_id,json=nextOrderId,proto3" json:"next_order_id,omitempty" yaml:"next_order_id"`
+}
+
+func (m *Pool) Reset()      { *m = Pool{} }
+func (*Pool) ProtoMessage() {}
+func (*Pool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29558bc42d652eaf, []int{3}
+}
+func (m *Pool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Pool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Pool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Pool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Pool.Merge(m, src)
+}
+func (m *Pool) XXX_Size() int {
+	return m.Size()
+}
+func (m *Pool) XXX_DiscardUnknown() {
+	xxx_messageInfo_Pool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Pool proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("osmosis.gamm.poolmodels.orderbook.v1beta1.OrderDirection", OrderDirection_name, OrderDirection_value)
+	proto.RegisterType((*PoolParams)(nil), "osmosis.gamm.poolmodels.orderbook.v1beta1.PoolParams")
+	proto.RegisterType((*LimitOrder)(nil), "osmosis.gamm.poolmodels.orderbook.v1beta1.LimitOrder")
+	proto.RegisterType((*Tick)(nil), "osmosis.gamm.poolmodels.orderbook.v1beta1.Tick")
+	proto.RegisterType((*Pool)(nil), "osmosis.gamm.poolmodels.orderbook.v1beta1.Pool")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/gamm/pool-models/orderbook/orderbook_pool.proto", fileDescriptor_29558bc42d652eaf)
+}
+
+var fileDescriptor_29558bc42d652eaf = []byte{
+	// 987 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x96, 0xcf, 0x6b, 0x1b, 0xc7,
+	0x17, 0xc0, 0x25, 0xaf, 0xac, 0x1f, 0x23, 0x5b, 0x51, 0x26, 0xce, 0x17, 0xc5, 0xf9, 0xa2, 0x15,
+	0xd3, 0x62, 0xdc, 0x52, 0xef, 0xd6, 0x6e, 0x4b, 0xa8, 0x29, 0x34, 0xde, 0x9a, 0x06, 0xd3, 0x40,
+	0xd2, 0x71, 0xa1, 0xd4, 0xb4, 0x2c, 0x2b, 0xed, 0x58, 0x19, 0xbc, 0xbb, 0x23, 0xef, 0xac, 0x1c,
+	0x3b, 0xf4, 0x0f, 0x08, 0x3d, 0xf5, 0x18, 0x28, 0x85, 0x40, 0x6f, 0x3d, 0xf7, 0x8f, 0x08, 0x3d,
+	0xf9, 0x58, 0x7a, 0xd8, 0x16, 0xfb, 0xd8, 0xdb, 0xfe, 0x05, 0x65, 0x7e, 0xec, 0xae, 0x5c, 0x4a,
+	0x88, 0x08, 0x3d, 0x69, 0xe6, 0xfd, 0xf8, 0xbc, 0x99, 0xb7, 0xef, 0xbd, 0x11, 0xb8, 0xc3, 0x78,
+	0xc8, 0x38, 0xe5, 0xf6, 0xd8, 0x0b, 0x43, 0x7b, 0xc2, 0x58, 0xb0, 0x11, 0x32, 0x9f, 0x04, 0xdc,
+	0x66, 0xb1, 0x4f, 0xe2, 0x21, 0x63, 0x47, 0xe5, 0xca, 0x15, 0x7a, 0x6b, 0x12, 0xb3, 0x84, 0xc1,
+	0xb7, 0xb4, 0xa3, 0x25, 0x1c, 0x2d, 0xa1, 0x50, 0x7e, 0x56, 0x61, 0x6d, 0x9d, 0x6c, 0x0e, 0x49,
+	0xe2, 0x6d, 0xae, 0xde, 0x1a, 0x49, 0x5b, 0x57, 0x3a, 0xda, 0x6a, 0xa3, 0x28, 0xab, 0x2b, 0x63,
+	0x36, 0x66, 0x4a, 0x2e, 0x56, 0x5a, 0xda, 0x57, 0x36, 0xf6, 0xd0, 0xe3, 0xc4, 0xd6, 0x14, 0x7b,
+	0xc4, 0x68, 0xa4, 0xf4, 0xe8, 0x99, 0x01, 0xc0, 0x43, 0xc6, 0x82, 0x87, 0x5e, 0xec, 0x85, 0x1c,
+	0x7e, 0x0d, 0x9a, 0xfc, 0xb1, 0x37, 0x71, 0x0f, 0x09, 0xe9, 0x55, 0x07, 0xd5, 0xf5, 0x96, 0xb3,
+	0xf3, 0x22, 0x35, 0x2b, 0xbf, 0xa7, 0xe6, 0xda, 0x98, 0x26, 0x8f, 0xa6, 0x43, 0x6b, 0xc4, 0x42,
+	0x1d, 0x57, 0xff, 0x6c, 0x70, 0xff, 0xc8, 0x4e, 0xce, 0x26, 0x84, 0x5b, 0xbb, 0x64, 0x94, 0xa5,
+	0xe6, 0xb5, 0x33, 0x2f, 0x0c, 0xb6, 0x51, 0xce, 0x41, 0xb8, 0x21, 0x96, 0x9f, 0x12, 0x22, 0xe8,
+	0xe4, 0x94, 0x26, 0x92, 0xbe, 0xf0, 0x7a, 0xf4, 0x9c, 0x83, 0x70, 0x43, 0x2c, 0x05, 0xdd, 0x05,
+	0xad, 0x84, 0x8e, 0x8e, 0x5c, 0x4e, 0x9f, 0x90, 0x9e, 0x21, 0xf1, 0xce, 0xdc, 0xf8, 0xae, 0xc2,
+	0x17, 0x20, 0x84, 0x9b, 0x62, 0xbd, 0x4f, 0x9f, 0x10, 0x18, 0x82, 0x4e, 0x48, 0x23, 0x57, 0x7e,
+	0x15, 0x15, 0xa5, 0x26, 0xa3, 0xdc, 0x9b, 0x23, 0xca, 0x5e, 0x94, 0x64, 0xa9, 0x79, 0x53, 0x45,
+	0xb9, 0x4a, 0x43, 0x78, 0x29, 0xa4, 0xd1, 0x03, 0xb1, 0x17, 0xe1, 0xd0, 0x5f, 0x35, 0x00, 0xee,
+	0xd3, 0x90, 0x26, 0x52, 0x04, 0x2d, 0xd0, 0x54, 0xb6, 0xd4, 0x97, 0x9f, 0xa6, 0xe6, 0xdc, 0x28,
+	0xd3, 0x91, 0x6b, 0x10, 0x6e, 0xc8, 0xe5, 0x9e, 0x0f, 0xd7, 0xc0, 0x22, 0x7b, 0x1c, 0x91, 0x58,
+	0x67, 0xba, 0x9b, 0xa5, 0xe6, 0x92, 0x36, 0x16, 0x62, 0x84, 0x95, 0x1a, 0xbe, 0x01, 0x6a, 0xe2,
+	0x86, 0x32, 0x63, 0x86, 0x73, 0x2d, 0x4b, 0xcd, 0x76, 0x99, 0x03, 0x84, 0xa5, 0x12, 0x86, 0xa0,
+	0xe5, 0xd3, 0x98, 0x8c, 0x12, 0xca, 0x22, 0x79, 0xeb, 0xce, 0xd6, 0x87, 0xd6, 0x2b, 0x97, 0xad,
+	0x25, 0x6f, 0xb0, 0x9b, 0x03, 0x9c, 0x95, 0x32, 0xd1, 0x05, 0x15, 0xe1, 0x32, 0x02, 0xfc, 0x06,
+	0x34, 0x8f, 0xa7, 0x5e, 0x94, 0xd0, 0xe4, 0xac, 0xb7, 0x38, 0x77, 0xa1, 0xa8, 0x1c, 0xeb, 0xcc,
+	0xe4, 0x1c, 0x84, 0x0b, 0x24, 0xfc, 0x12, 0xd4, 0x0f, 0x69, 0x10, 0x10, 0xbf, 0x57, 0x97, 0xf0,
+	0x8f, 0xe7, 0x86, 0x2f, 0x2b, 0xb8, 0xa2, 0x20, 0xac, 0x71, 0xe2, 0xdc, 0x93, 0x98, 0x8d, 0x08,
+	0xf1, 0x79, 0xaf, 0xf1, 0x7a, 0xe7, 0xce, 0x39, 0x08, 0x17, 0x48, 0x78, 0x00, 0x1a, 0xa3, 0xc0,
+	0xa3, 0x21, 0xf1, 0x7b, 0x4d, 0x49, 0xbf, 0x3b, 0x37, 0xbd, 0xa3, 0xe8, 0x1a, 0x83, 0x70, 0x0e,
+	0x44, 0xe7, 0x55, 0x50, 0xfb, 0x42, 0x7c, 0xea, 0x35, 0xb0, 0x48, 0x23, 0x9f, 0x9c, 0xca, 0x22,
+	0x33, 0x66, 0xeb, 0x46, 0x8a, 0x11, 0x56, 0xea, 0xab, 0x25, 0xb1, 0xf0, 0x9f, 0x97, 0xc4, 0x26,
+	0x68, 0xe5, 0x45, 0xce, 0x7b, 0xc6, 0xc0, 0x58, 0xaf, 0xcd, 0xfa, 0x14, 0x2a, 0x84, 0x9b, 0xba,
+	0x01, 0x38, 0xfa, 0xa1, 0x0e, 0x6a, 0x62, 0xb6, 0xc1, 0x77, 0x40, 0xc3, 0xf3, 0xfd, 0x98, 0x70,
+	0xae, 0x87, 0x1a, 0x2c, 0x33, 0xa1, 0x15, 0x08, 0xe7, 0x26, 0xb0, 0x03, 0x16, 0xa8, 0x2f, 0x6f,
+	0x54, 0xc3, 0x0b, 0xd4, 0x87, 0xdf, 0x82, 0xb6, 0xb8, 0x89, 0x3b, 0x91, 0x23, 0x52, 0xf6, 0x49,
+	0x7b, 0xeb, 0x83, 0x39, 0xae, 0x5a, 0xce, 0x57, 0xe7, 0x4d, 0xf1, 0xc1, 0xb2, 0xd4, 0xfc, 0xff,
+	0xcc, 0xb1, 0x8b, 0xe7, 0x40, 0x47, 0x40, 0x18, 0x4c, 0xca, 0x89, 0xfc, 0x39, 0x58, 0x39, 0x9c,
+	0x26, 0xd3, 0x98, 0x28, 0x93, 0x31, 0x3b, 0x21, 0x71, 0xc4, 0x62, 0x3d, 0x7a, 0xcc, 0x2c, 0x35,
+	0x6f, 0xeb, 0x5a, 0xfc, 0x17, 0x2b, 0x84, 0xa1, 0x12, 0x8b, 0x23, 0xdc, 0xd3, 0x42, 0xf8, 0x15,
+	0x58, 0x4a, 0x58, 0xe2, 0x05, 0x2e, 0x7f, 0xe4, 0xc5, 0x84, 0xcb, 0x0e, 0x6b, 0x6f, 0xdd, 0xb2,
+	0xf4, 0x73, 0x22, 0x9e, 0x8a, 0xe2, 0xec, 0x9f, 0x30, 0x1a, 0x39, 0xb7, 0xf5, 0xa9, 0x6f, 0xe8,
+	0xc1, 0x30, 0xe3, 0x8c, 0x70, 0x5b, 0x6e, 0xf7, 0xe5, 0x0e, 0xc6, 0xa0, 0x23, 0x0f, 0x10, 0xd0,
+	0xe3, 0x29, 0xf5, 0x45, 0xfb, 0xd6, 0x07, 0xc6, 0xcb, 0xe1, 0xef, 0x0a, 0xf8, 0xcf, 0x7f, 0x98,
+	0xeb, 0xaf, 0x50, 0xc3, 0xc2, 0x81, 0xe3, 0x65, 0x11, 0xe2, 0x7e, 0x1e, 0x01, 0xbe, 0x0f, 0x80,
+	0xa0, 0xba, 0x3e, 0x89, 0x58, 0xa8, 0xdb, 0xee, 0x66, 0x96, 0x9a, 0xd7, 0xd5, 0x69, 0x4b, 0x1d,
+	0xc2, 0x2d, 0xb1, 0xd9, 0x15, 0x6b, 0x78, 0x07, 0xb4, 0x8f, 0xa7, 0x2c, 0xc9, 0xdd, 0x54, 0x3f,
+	0xfd, 0x2f, 0x4b, 0x4d, 0x98, 0xcf, 0x8d, 0x42, 0x89, 0x30, 0x90, 0x3b, 0xe5, 0x38, 0x05, 0x4b,
+	0x81, 0x98, 0xca, 0x6a, 0x72, 0xf3, 0x5e, 0x6b, 0x60, 0xcc, 0x59, 0x0f, 0xe5, 0x50, 0xff, 0x67,
+	0x66, 0x67, 0xc1, 0x08, 0xb7, 0x83, 0xc2, 0x90, 0xc3, 0x8f, 0xc0, 0x72, 0x44, 0x4e, 0x13, 0xb7,
+	0x78, 0x03, 0x80, 0x7c, 0x03, 0x7a, 0x59, 0x6a, 0xae, 0x28, 0xe7, 0x2b, 0x6a, 0x84, 0xdb, 0x62,
+	0xff, 0x40, 0xf5, 0xc2, 0xf6, 0xf5, 0xa7, 0xcf, 0xcd, 0xca, 0xb3, 0xe7, 0x66, 0xe5, 0xd7, 0x5f,
+	0x36, 0x16, 0x45, 0x31, 0xec, 0xbd, 0xbd, 0x0d, 0x3a, 0x57, 0x7b, 0x10, 0x76, 0x81, 0xb1, 0xb3,
+	0xff, 0x59, 0xb7, 0xb2, 0xda, 0xf8, 0xee, 0xc7, 0x81, 0xb1, 0xc3, 0x8f, 0x84, 0xc4, 0xd9, 0xdb,
+	0xed, 0x56, 0x95, 0xc4, 0xa1, 0xfe, 0x6a, 0xed, 0xe9, 0x4f, 0xfd, 0x8a, 0x73, 0xf0, 0xe2, 0xa2,
+	0x5f, 0x3d, 0xbf, 0xe8, 0x57, 0xff, 0xbc, 0xe8, 0x57, 0xbf, 0xbf, 0xec, 0x57, 0xce, 0x2f, 0xfb,
+	0x95, 0xdf, 0x2e, 0xfb, 0x95, 0x83, 0xbb, 0x33, 0x5f, 0x51, 0x67, 0x64, 0x23, 0xf0, 0x86, 0x3c,
+	0xdf, 0xd8, 0x27, 0x9b, 0x5b, 0xf6, 0xe9, 0x4b, 0xfe, 0x22, 0x0d, 0xeb, 0xf2, 0x8f, 0xc9, 0x7b,
+	0x7f, 0x0f, 0x00, 0xab, 0x90, 0xde, 0x87, 0x4f, 0x09, 0x00, 0x00,
+}
+
+func (m *PoolParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MinOrderSize.Size()
+		i -= size
+		if _, err := m.MinOrderSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrderbookPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.TickSize.Size()
+		i -= size
+		if _, err := m.TickSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrderbookPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.ExitFee.Size()
+		i -= size
+		if _, err := m.ExitFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrderbookPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.SwapFee.Size()
+		i -= size
+		if _, err := m.SwapFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrderbookPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *LimitOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LimitOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Claimed.Size()
+		i -= size
+		if _, err := m.Claimed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrderbookPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.Proceeds.Size()
+		i -= size
+		if _, err := m.Proceeds.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrderbookPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Filled.Size()
+		i -= size
+		if _, err := m.Filled.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrderbookPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Quantity.Size()
+		i -= size
+		if _, err := m.Quantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrderbookPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Direction != 0 {
+		i = encodeVarintOrderbookPool(dAtA, i, uint64(m.Direction))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Tick != 0 {
+		i = encodeVarintOrderbookPool(dAtA, i, uint64(m.Tick))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintOrderbookPool(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.OrderId != 0 {
+		i = encodeVarintOrderbookPool(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Tick) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Tick) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Tick) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OrderIds) > 0 {
+		dAtA2 := make([]byte, len(m.OrderIds)*10)
+		var j1 int
+		for _, num := range m.OrderIds {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintOrderbookPool(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Direction != 0 {
+		i = encodeVarintOrderbookPool(dAtA, i, uint64(m.Direction))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Index != 0 {
+		i = encodeVarintOrderbookPool(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Pool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Pool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextOrderId != 0 {
+		i = encodeVarintOrderbookPool(dAtA, i, uint64(m.NextOrderId))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.LimitOrders) > 0 {
+		for iNdEx := len(m.LimitOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LimitOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOrderbookPool(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintOrderbookPool(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintOrderbookPool(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.PoolLiquidity) > 0 {
+		for iNdEx := len(m.PoolLiquidity) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolLiquidity[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOrderbookPool(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size, err := m.TotalShares.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOrderbookPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.FuturePoolGovernor) > 0 {
+		i -= len(m.FuturePoolGovernor)
+		copy(dAtA[i:], m.FuturePoolGovernor)
+		i = encodeVarintOrderbookPool(dAtA, i, uint64(len(m.FuturePoolGovernor)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.PoolParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOrderbookPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Id != 0 {
+		i = encodeVarintOrderbookPool(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintOrderbookPool(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintOrderbookPool(dAtA []byte, offset int, v uint64) int {
+	offset -= sovOrderbookPool(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PoolParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SwapFee.Size()
+	n += 1 + l + sovOrderbookPool(uint64(l))
+	l = m.ExitFee.Size()
+	n += 1 + l + sovOrderbookPool(uint64(l))
+	l = m.TickSize.Size()
+	n += 1 + l + sovOrderbookPool(uint64(l))
+	l = m.MinOrderSize.Size()
+	n += 1 + l + sovOrderbookPool(uint64(l))
+	return n
+}
+
+func (m *LimitOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovOrderbookPool(uint64(m.OrderId))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovOrderbookPool(uint64(l))
+	}
+	if m.Tick != 0 {
+		n += 1 + sovOrderbookPool(uint64(m.Tick))
+	}
+	if m.Direction != 0 {
+		n += 1 + sovOrderbookPool(uint64(m.Direction))
+	}
+	l = m.Quantity.Size()
+	n += 1 + l + sovOrderbookPool(uint64(l))
+	l = m.Filled.Size()
+	n += 1 + l + sovOrderbookPool(uint64(l))
+	l = m.Proceeds.Size()
+	n += 1 + l + sovOrderbookPool(uint64(l))
+	l = m.Claimed.Size()
+	n += 1 + l + sovOrderbookPool(uint64(l))
+	return n
+}
+
+func (m *Tick) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovOrderbookPool(uint64(m.Index))
+	}
+	if m.Direction != 0 {
+		n += 1 + sovOrderbookPool(uint64(m.Direction))
+	}
+	if len(m.OrderIds) > 0 {
+		l = 0
+		for _, e := range m.OrderIds {
+			l += sovOrderbookPool(uint64(e))
+		}
+		n += 1 + sovOrderbookPool(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *Pool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovOrderbookPool(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovOrderbookPool(uint64(m.Id))
+	}
+	l = m.PoolParams.Size()
+	n += 1 + l + sovOrderbookPool(uint64(l))
+	l = len(m.FuturePoolGovernor)
+	if l > 0 {
+		n += 1 + l + sovOrderbookPool(uint64(l))
+	}
+	l = m.TotalShares.Size()
+	n += 1 + l + sovOrderbookPool(uint64(l))
+	if len(m.PoolLiquidity) > 0 {
+		for _, e := range m.PoolLiquidity {
+			l = e.Size()
+			n += 1 + l + sovOrderbookPool(uint64(l))
+		}
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovOrderbookPool(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovOrderbookPool(uint64(l))
+	}
+	if len(m.LimitOrders) > 0 {
+		for _, e := range m.LimitOrders {
+			l = e.Size()
+			n += 1 + l + sovOrderbookPool(uint64(l))
+		}
+	}
+	if m.NextOrderId != 0 {
+		n += 1 + sovOrderbookPool(uint64(m.NextOrderId))
+	}
+	return n
+}
+
+func sovOrderbookPool(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozOrderbookPool(x uint64) (n int) {
+	return sovOrderbookPool(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PoolParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrderbookPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbookPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrderbookPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbookPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbookPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrderbookPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbookPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExitFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbookPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrderbookPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbookPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TickSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinOrderSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbookPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrderbookPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbookPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinOrderSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrderbookPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrderbookPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LimitOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrderbookPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LimitOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbookPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbookPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrderbookPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbookPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tick", wireType)
+			}
+			m.Tick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbookPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Tick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			}
+			m.Direction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbookPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Direction |= OrderDirection(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbookPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrderbookPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbookPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filled", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbookPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrderbookPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbookPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Filled.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proceeds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbookPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrderbookPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbookPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proceeds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbookPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrderbookPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbookPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Claimed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrderbookPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrderbookPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Tick) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrderbookPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Tick: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Tick: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbookPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			}
+			m.Direction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbookPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Direction |= OrderDirection(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowOrderbookPool
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.OrderIds = append(m.OrderIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowOrderbookPool
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthOrderbookPool
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthOrderbookPool
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.OrderIds) == 0 {
+					m.OrderIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowOrderbookPool
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.OrderIds = append(m.OrderIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrderbookPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrderbookPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Pool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrderbookPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbookPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrderbookPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbookPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbookPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbookPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrderbookPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbookPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PoolParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FuturePoolGovernor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbookPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrderbookPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbookPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FuturePoolGovernor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalShares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbookPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrderbookPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbookPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolLiquidity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbookPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrderbookPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbookPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolLiquidity = append(m.PoolLiquidity, types.Coin{})
+			if err := m.PoolLiquidity[len(m.PoolLiquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbookPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrderbookPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbookPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbookPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrderbookPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbookPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbookPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrderbookPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbookPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LimitOrders = append(m.LimitOrders, LimitOrder{})
+			if err := m.LimitOrders[len(m.LimitOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextOrderId", wireType)
+			}
+			m.NextOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbookPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrderbookPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrderbookPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipOrderbookPool(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowOrderbookPool
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowOrderbookPool
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowOrderbookPool
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthOrderbookPool
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupOrderbookPool
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthOrderbookPool
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthOrderbookPool        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowOrderbookPool          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupOrderbookPool = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,237 @@
+package orderbook
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/types"
+)
+
+// Book gives access to the limit orders of an orderbook pool, which are kept in state apart from the pool.
+// Every order is stored by id, and the unfilled orders of each side of the book are queued in one entry per tick,
+// so that operations only load the ticks and orders they touch.
+type Book interface {
+	// GetLimitOrder returns the limit order with the given id, and false if there is none.
+	GetLimitOrder(orderId uint64) (LimitOrder, bool)
+	// SetLimitOrder stores the limit order.
+	SetLimitOrder(order LimitOrder)
+	// DeleteLimitOrder removes the limit order with the given id.
+	DeleteLimitOrder(orderId uint64)
+	// IterateLimitOrders calls cb on the limit orders by ascending id, until cb returns true.
+	IterateLimitOrders(cb func(order LimitOrder) (stop bool))
+	// GetTick returns the tick of the given direction and index, with an empty queue if it has no orders.
+	GetTick(direction OrderDirection, index int64) Tick
+	// SetTick stores the tick, or removes it if its queue is empty.
+	SetTick(tick Tick)
+	// IterateTicks calls cb on the ticks with orders of the given direction from the best price outwards,
+	// by ascending index for asks and by descending index for bids, until cb returns true.
+	IterateTicks(direction OrderDirection, cb func(tick Tick) (stop bool))
+}
+
+// bookUpdates are the writes of a swap to the book of the pool. They are buffered while the book is iterated,
+// and applied once the swap is done.
+type bookUpdates struct {
+	orders []LimitOrder
+	ticks  []Tick
+}
+
+func (u bookUpdates) apply(book Book) {
+	for _, order := range u.orders {
+		book.SetLimitOrder(order)
+	}
+	for _, tick := range u.ticks {
+		book.SetTick(tick)
+	}
+}
+
+// Unfilled returns the part of the order quantity that can still be swapped against.
+func (order LimitOrder) Unfilled() sdk.Int {
+	return order.Quantity.Sub(order.Filled)
+}
+
+// Unclaimed returns the part of the order proceeds that has not been withdrawn yet.
+func (order LimitOrder) Unclaimed() sdk.Int {
+	return order.Proceeds.Sub(order.Claimed)
+}
+
+// IsFilled returns true if the whole order quantity has been swapped against.
+func (order LimitOrder) IsFilled() bool {
+	return order.Filled.Equal(order.Quantity)
+}
+
+func (order *LimitOrder) fill(filled sdk.Int, proceeds sdk.Int) {
+	order.Filled = order.Filled.Add(filled)
+	order.Proceeds = order.Proceeds.Add(proceeds)
+}
+
+// removeOrder removes the given order id from the queue of the tick. It is a no-op if the order is not queued.
+func (tick *Tick) removeOrder(orderId uint64) {
+	for i, id := range tick.OrderIds {
+		if id == orderId {
+			tick.OrderIds = append(tick.OrderIds[:i], tick.OrderIds[i+1:]...)
+			return
+		}
+	}
+}
+
+// depositDenom returns the denom deposited by limit orders of the given direction.
+func (p Pool) depositDenom(direction OrderDirection) string {
+	if direction == Ask {
+		return p.BaseDenom
+	}
+	return p.QuoteDenom
+}
+
+// proceedsDenom returns the denom received by limit orders of the given direction.
+func (p Pool) proceedsDenom(direction OrderDirection) string {
+	if direction == Ask {
+		return p.QuoteDenom
+	}
+	return p.BaseDenom
+}
+
+// LimitOrderEscrow returns the tokens held by the pool for the limit order:
+// the unfilled part of its deposit and its unclaimed proceeds.
+func (p Pool) LimitOrderEscrow(order LimitOrder) sdk.Coins {
+	return sdk.NewCoins(
+		sdk.NewCoin(p.depositDenom(order.Direction), order.Unfilled()),
+		sdk.NewCoin(p.proceedsDenom(order.Direction), order.Unclaimed()),
+	)
+}
+
+// GetLimitOrder returns the limit order with the given id.
+func (p Pool) GetLimitOrder(book Book, orderId uint64) (LimitOrder, error) {
+	order, found := book.GetLimitOrder(orderId)
+	if !found {
+		return LimitOrder{}, sdkerrors.Wrapf(types.ErrLimitOrderNotFound, "order %d in pool %d", orderId, p.Id)
+	}
+	return order, nil
+}
+
+// GetLimitOrders returns all limit orders of the pool, by ascending id.
+// If owner is not empty, only the orders of owner are returned.
+func (p Pool) GetLimitOrders(book Book, owner string) []LimitOrder {
+	orders := []LimitOrder{}
+	book.IterateLimitOrders(func(order LimitOrder) bool {
+		if owner == "" || order.Owner == owner {
+			orders = append(orders, order)
+		}
+		return false
+	})
+	return orders
+}
+
+// PlaceLimitOrder adds a limit order depositing tokenIn at the given tick to the book, and returns it.
+// Depositing the base denom places an ask, which must be priced above the spot price.
+// Depositing the quote denom places a bid, which must be priced below the spot price.
+// The value of the order in the quote denom must be at least the minimum order size of the pool.
+// Transferring tokenIn to the pool is done in the keeper.
+func (p *Pool) PlaceLimitOrder(ctx sdk.Context, book Book, owner sdk.AccAddress, tick int64, tokenIn sdk.Coin) (LimitOrder, error) {
+	if !tokenIn.Amount.IsPositive() {
+		return LimitOrder{}, sdkerrors.Wrapf(types.ErrNotPositiveRequireAmount, "limit order amount must be positive, got %s", tokenIn)
+	}
+	if tick <= 0 {
+		return LimitOrder{}, sdkerrors.Wrapf(types.ErrInvalidTick, "tick must be positive, got %d", tick)
+	}
+
+	spotPrice, err := p.SpotPrice(ctx, p.BaseDenom, p.QuoteDenom)
+	if err != nil {
+		return LimitOrder{}, err
+	}
+	tickPrice := p.TickPrice(tick)
+
+	var direction OrderDirection
+	var value sdk.Int
+	switch tokenIn.Denom {
+	case p.BaseDenom:
+		direction = Ask
+		if tickPrice.LTE(spotPrice) {
+			return LimitOrder{}, sdkerrors.Wrapf(types.ErrInvalidTick, "ask price %s must be greater than spot price %s", tickPrice, spotPrice)
+		}
+		value = tickPrice.MulInt(tokenIn.Amount).TruncateInt()
+	case p.QuoteDenom:
+		direction = Bid
+		if tickPrice.GTE(spotPrice) {
+			return LimitOrder{}, sdkerrors.Wrapf(types.ErrInvalidTick, "bid price %s must be lesser than spot price %s", tickPrice, spotPrice)
+		}
+		value = tokenIn.Amount
+	default:
+		return LimitOrder{}, sdkerrors.Wrapf(types.ErrDenomNotFoundInPool, "denom (%s) is not in pool %d", tokenIn.Denom, p.Id)
+	}
+	if value.LT(p.PoolParams.MinOrderSize) {
+		return LimitOrder{}, sdkerrors.Wrapf(types.ErrLimitOrderTooSmall,
+			"order is worth %s%s, the minimum is %s%s", value, p.QuoteDenom, p.PoolParams.MinOrderSize, p.QuoteDenom)
+	}
+
+	order := LimitOrder{
+		OrderId:   p.NextOrderId,
+		Owner:     owner.String(),
+		Tick:      tick,
+		Direction: direction,
+		Quantity:  tokenIn.Amount,
+		Filled:    sdk.ZeroInt(),
+		Proceeds:  sdk.ZeroInt(),
+		Claimed:   sdk.ZeroInt(),
+	}
+	p.NextOrderId++
+
+	orderTick := book.GetTick(direction, tick)
+	orderTick.OrderIds = append(orderTick.OrderIds, order.OrderId)
+	book.SetLimitOrder(order)
+	book.SetTick(orderTick)
+
+	return order, nil
+}
+
+// CancelLimitOrder removes the limit order with the given id from the book, and returns its escrow to send to its owner.
+// Transferring the returned tokens out of the pool is done in the keeper.
+func (p *Pool) CancelLimitOrder(book Book, owner sdk.AccAddress, orderId uint64) (sdk.Coins, error) {
+	order, err := p.getOwnedLimitOrder(book, owner, orderId)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+
+	tokensOut := p.LimitOrderEscrow(order)
+	// filled orders have already been dequeued by the swap that filled them.
+	if !order.IsFilled() {
+		tick := book.GetTick(order.Direction, order.Tick)
+		tick.removeOrder(orderId)
+		book.SetTick(tick)
+	}
+	book.DeleteLimitOrder(orderId)
+	return tokensOut, nil
+}
+
+// ClaimLimitOrder withdraws the unclaimed proceeds of the limit order with the given id, and returns them.
+// Fully filled orders are removed from the book once claimed.
+// Transferring the returned tokens out of the pool is done in the keeper.
+func (p *Pool) ClaimLimitOrder(book Book, owner sdk.AccAddress, orderId uint64) (sdk.Coin, error) {
+	order, err := p.getOwnedLimitOrder(book, owner, orderId)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	tokenOut := sdk.NewCoin(p.proceedsDenom(order.Direction), order.Unclaimed())
+	if !tokenOut.IsPositive() {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrNothingToClaim, "order %d of pool %d", orderId, p.Id)
+	}
+
+	if order.IsFilled() {
+		book.DeleteLimitOrder(orderId)
+	} else {
+		order.Claimed = order.Proceeds
+		book.SetLimitOrder(order)
+	}
+	return tokenOut, nil
+}
+
+func (p Pool) getOwnedLimitOrder(book Book, owner sdk.AccAddress, orderId uint64) (LimitOrder, error) {
+	order, err := p.GetLimitOrder(book, orderId)
+	if err != nil {
+		return LimitOrder{}, err
+	}
+	if order.Owner != owner.String() {
+		return LimitOrder{}, sdkerrors.Wrapf(types.ErrNotLimitOrderOwner, "order %d is owned by %s", orderId, order.Owner)
+	}
+	return order, nil
+}
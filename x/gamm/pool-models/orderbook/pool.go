@@ -0,0 +1,230 @@
+package orderbook
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/types"
+)
+
+var _ types.PoolI = &Pool{}
+
+// NewOrderbookPool returns an orderbook pool with an empty order book.
+// Invariants that are assumed to be satisfied and not checked:
+// * FutureGovernor is valid
+// * poolID doesn't already exist
+func NewOrderbookPool(poolId uint64,
+	orderbookPoolParams PoolParams, initialLiquidity sdk.Coins,
+	baseDenom string, futureGovernor string,
+) (Pool, error) {
+	if err := orderbookPoolParams.Validate(); err != nil {
+		return Pool{}, err
+	}
+
+	quoteDenom, err := validateInitialLiquidity(initialLiquidity, baseDenom)
+	if err != nil {
+		return Pool{}, err
+	}
+
+	pool := Pool{
+		Address:            types.NewPoolAddress(poolId).String(),
+		Id:                 poolId,
+		PoolParams:         orderbookPoolParams,
+		FuturePoolGovernor: futureGovernor,
+		TotalShares:        sdk.NewCoin(types.GetPoolShareDenom(poolId), types.InitPoolSharesSupply),
+		PoolLiquidity:      initialLiquidity,
+		BaseDenom:          baseDenom,
+		QuoteDenom:         quoteDenom,
+		NextOrderId:        1,
+	}
+
+	return pool, nil
+}
+
+// validateInitialLiquidity checks that the initial liquidity consists of exactly two positive coins,
+// one of which is the base denom. It returns the other denom, which becomes the quote denom.
+func validateInitialLiquidity(initialLiquidity sdk.Coins, baseDenom string) (string, error) {
+	if len(initialLiquidity) < 2 {
+		return "", types.ErrTooFewPoolAssets
+	} else if len(initialLiquidity) > 2 {
+		return "", types.ErrTooManyPoolAssets
+	}
+
+	if err := initialLiquidity.Validate(); err != nil {
+		return "", err
+	}
+
+	for i, coin := range initialLiquidity {
+		if coin.Denom == baseDenom {
+			return initialLiquidity[1-i].Denom, nil
+		}
+	}
+	return "", sdkerrors.Wrapf(types.ErrDenomNotFoundInPool, "base denom (%s) is not in the initial liquidity", baseDenom)
+}
+
+func (p Pool) GetAddress() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(p.Address)
+	if err != nil {
+		panic(fmt.Sprintf("could not bech32 decode address of pool with id: %d", p.GetId()))
+	}
+	return addr
+}
+
+func (p Pool) String() string {
+	out, err := json.Marshal(p)
+	if err != nil {
+		panic(err)
+	}
+	return string(out)
+}
+
+func (p Pool) GetId() uint64 {
+	return p.Id
+}
+
+func (p Pool) GetSwapFee(ctx sdk.Context) sdk.Dec {
+	return p.PoolParams.SwapFee
+}
+
+func (p Pool) GetExitFee(ctx sdk.Context) sdk.Dec {
+	return p.PoolParams.ExitFee
+}
+
+func (p Pool) GetTickSize() sdk.Dec {
+	return p.PoolParams.TickSize
+}
+
+func (p Pool) IsActive(ctx sdk.Context) bool {
+	return true
+}
+
+// Returns the coins in the pool owned by all LP shareholders.
+// Funds escrowed by limit orders are not included.
+func (p Pool) GetTotalPoolLiquidity(ctx sdk.Context) sdk.Coins {
+	return p.PoolLiquidity
+}
+
+func (p Pool) GetTotalShares() sdk.Int {
+	return p.TotalShares.Amount
+}
+
+func (p Pool) NumAssets() int {
+	return len(p.PoolLiquidity)
+}
+
+// TickPrice returns the price of the given tick, in quote denom per base denom.
+func (p Pool) TickPrice(tick int64) sdk.Dec {
+	return p.PoolParams.TickSize.MulInt64(tick)
+}
+
+// PokePool is a no-op, orderbook pools have no time dependent parameters.
+func (p *Pool) PokePool(blockTime time.Time) {}
+
+// SpotPrice returns the marginal price of the pool reserves.
+// Limit orders are placed away from this price, and swaps move it to the price of
+// the orders they fill.
+func (p Pool) SpotPrice(ctx sdk.Context, baseAsset, quoteAsset string) (sdk.Dec, error) {
+	baseReserve := p.PoolLiquidity.AmountOf(baseAsset)
+	quoteReserve := p.PoolLiquidity.AmountOf(quoteAsset)
+	if baseReserve.IsZero() || quoteReserve.IsZero() {
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrDenomNotFoundInPool, "one of denom (%s, %s) not found in pool liquidity", baseAsset, quoteAsset)
+	}
+	if baseAsset == quoteAsset {
+		return sdk.Dec{}, errors.New("base and quote asset must be different")
+	}
+	return quoteReserve.ToDec().QuoInt(baseReserve), nil
+}
+
+// reservesPool returns the pool reserves as an equally weighted balancer pool,
+// which is used to compute joins and exits. Only joins and exits with both assets are
+// supported, as the swap of a single asset join or exit would trade against the reserves
+// without filling the orders of the book.
+func (p Pool) reservesPool() *balancer.Pool {
+	poolAssets := make([]balancer.PoolAsset, 0, len(p.PoolLiquidity))
+	for _, coin := range p.PoolLiquidity {
+		poolAssets = append(poolAssets, balancer.PoolAsset{Token: coin, Weight: sdk.OneInt()})
+	}
+	return &balancer.Pool{
+		Address: p.Address,
+		Id:      p.Id,
+		PoolParams: balancer.PoolParams{
+			SwapFee: p.PoolParams.SwapFee,
+			ExitFee: p.PoolParams.ExitFee,
+		},
+		FuturePoolGovernor: p.FuturePoolGovernor,
+		TotalShares:        p.TotalShares,
+		PoolAssets:         poolAssets,
+		TotalWeight:        sdk.NewInt(int64(len(poolAssets))),
+	}
+}
+
+// updateReserves sets the pool reserves and total shares to the ones of the given reserves pool.
+func (p *Pool) updateReserves(reservesPool *balancer.Pool) {
+	liquidity := sdk.Coins{}
+	for _, poolAsset := range reservesPool.PoolAssets {
+		liquidity = liquidity.Add(poolAsset.Token)
+	}
+	p.PoolLiquidity = liquidity
+	p.TotalShares = reservesPool.TotalShares
+}
+
+// JoinPool joins the pool with both assets. Single asset joins are not supported. Tokens in excess
+// of the ratio of the reserves are added to them without issuing more shares.
+func (p *Pool) JoinPool(ctx sdk.Context, tokensIn sdk.Coins, swapFee sdk.Dec) (numShares sdk.Int, err error) {
+	numShares, _, err = p.CalcJoinPoolShares(ctx, tokensIn, swapFee)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	p.IncreaseLiquidity(numShares, tokensIn)
+	return numShares, nil
+}
+
+func (p *Pool) JoinPoolNoSwap(ctx sdk.Context, tokensIn sdk.Coins, swapFee sdk.Dec) (numShares sdk.Int, err error) {
+	reservesPool := p.reservesPool()
+	numShares, err = reservesPool.JoinPoolNoSwap(ctx, tokensIn, swapFee)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	p.updateReserves(reservesPool)
+	return numShares, nil
+}
+
+func (p *Pool) CalcJoinPoolShares(ctx sdk.Context, tokensIn sdk.Coins, swapFee sdk.Dec) (numShares sdk.Int, newLiquidity sdk.Coins, err error) {
+	if tokensIn.Len() != 2 {
+		return sdk.Int{}, sdk.Coins{}, sdkerrors.Wrap(types.ErrNotImplemented, "orderbook pools only support joining with both assets")
+	}
+	numShares, _, err = p.reservesPool().CalcJoinPoolNoSwapShares(ctx, tokensIn, swapFee)
+	if err != nil {
+		return sdk.Int{}, sdk.Coins{}, err
+	}
+	return numShares, tokensIn, nil
+}
+
+func (p *Pool) CalcJoinPoolNoSwapShares(ctx sdk.Context, tokensIn sdk.Coins, swapFee sdk.Dec) (numShares sdk.Int, newLiquidity sdk.Coins, err error) {
+	return p.reservesPool().CalcJoinPoolNoSwapShares(ctx, tokensIn, swapFee)
+}
+
+func (p *Pool) ExitPool(ctx sdk.Context, exitingShares sdk.Int, exitFee sdk.Dec) (exitingCoins sdk.Coins, err error) {
+	reservesPool := p.reservesPool()
+	exitingCoins, err = reservesPool.ExitPool(ctx, exitingShares, exitFee)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+	p.updateReserves(reservesPool)
+	return exitingCoins, nil
+}
+
+func (p *Pool) CalcExitPoolCoinsFromShares(ctx sdk.Context, exitingShares sdk.Int, exitFee sdk.Dec) (exitedCoins sdk.Coins, err error) {
+	return p.reservesPool().CalcExitPoolCoinsFromShares(ctx, exitingShares, exitFee)
+}
+
+func (p *Pool) IncreaseLiquidity(sharesOut sdk.Int, coinsIn sdk.Coins) {
+	p.PoolLiquidity = p.PoolLiquidity.Add(coinsIn...)
+	p.TotalShares = sdk.NewCoin(p.TotalShares.Denom, p.TotalShares.Amount.Add(sharesOut))
+}
@@ -0,0 +1,34 @@
+package orderbook
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/types"
+)
+
+func (params PoolParams) Validate() error {
+	if params.ExitFee.IsNegative() {
+		return types.ErrNegativeExitFee
+	}
+
+	if params.ExitFee.GTE(sdk.OneDec()) {
+		return types.ErrTooMuchExitFee
+	}
+
+	if params.SwapFee.IsNegative() {
+		return types.ErrNegativeSwapFee
+	}
+
+	if params.SwapFee.GTE(sdk.OneDec()) {
+		return types.ErrTooMuchSwapFee
+	}
+
+	if params.TickSize.IsNil() || !params.TickSize.IsPositive() {
+		return types.ErrInvalidTickSize
+	}
+
+	if params.MinOrderSize.IsNil() || !params.MinOrderSize.IsPositive() {
+		return types.ErrInvalidMinOrderSize
+	}
+	return nil
+}
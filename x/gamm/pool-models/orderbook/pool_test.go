@@ -0,0 +1,595 @@
+package orderbook
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/types"
+)
+
+var (
+	defaultPoolId              = uint64(1)
+	defaultOrderbookPoolParams = PoolParams{
+		SwapFee:      sdk.ZeroDec(),
+		ExitFee:      sdk.ZeroDec(),
+		TickSize:     sdk.MustNewDecFromStr("0.1"),
+		MinOrderSize: sdk.NewInt(100),
+	}
+	// spot price of 10 uosmo per uatom
+	defaultPoolLiquidity = sdk.NewCoins(
+		sdk.NewInt64Coin("uatom", 1_000_000),
+		sdk.NewInt64Coin("uosmo", 10_000_000),
+	)
+	owner      = sdk.AccAddress([]byte("addr1---------------"))
+	otherOwner = sdk.AccAddress([]byte("addr2---------------"))
+)
+
+// memBook is an in memory Book.
+type memBook struct {
+	orders map[uint64]LimitOrder
+	ticks  map[OrderDirection]map[int64]Tick
+}
+
+func newMemBook() *memBook {
+	return &memBook{
+		orders: map[uint64]LimitOrder{},
+		ticks:  map[OrderDirection]map[int64]Tick{Ask: {}, Bid: {}},
+	}
+}
+
+func (b *memBook) GetLimitOrder(orderId uint64) (LimitOrder, bool) {
+	order, found := b.orders[orderId]
+	return order, found
+}
+
+func (b *memBook) SetLimitOrder(order LimitOrder) {
+	b.orders[order.OrderId] = order
+}
+
+func (b *memBook) DeleteLimitOrder(orderId uint64) {
+	delete(b.orders, orderId)
+}
+
+func (b *memBook) IterateLimitOrders(cb func(order LimitOrder) bool) {
+	orderIds := []uint64{}
+	for orderId := range b.orders {
+		orderIds = append(orderIds, orderId)
+	}
+	sort.Slice(orderIds, func(i, j int) bool { return orderIds[i] < orderIds[j] })
+	for _, orderId := range orderIds {
+		if cb(b.orders[orderId]) {
+			return
+		}
+	}
+}
+
+func (b *memBook) GetTick(direction OrderDirection, index int64) Tick {
+	tick, found := b.ticks[direction][index]
+	if !found {
+		return Tick{Index: index, Direction: direction}
+	}
+	tick.OrderIds = append([]uint64{}, tick.OrderIds...)
+	return tick
+}
+
+func (b *memBook) SetTick(tick Tick) {
+	if len(tick.OrderIds) == 0 {
+		delete(b.ticks[tick.Direction], tick.Index)
+		return
+	}
+	tick.OrderIds = append([]uint64{}, tick.OrderIds...)
+	b.ticks[tick.Direction][tick.Index] = tick
+}
+
+func (b *memBook) IterateTicks(direction OrderDirection, cb func(tick Tick) bool) {
+	for _, index := range b.tickIndexes(direction) {
+		if cb(b.GetTick(direction, index)) {
+			return
+		}
+	}
+}
+
+// tickIndexes returns the indexes of the ticks with orders of the given direction, from the best price outwards.
+func (b *memBook) tickIndexes(direction OrderDirection) []int64 {
+	indexes := []int64{}
+	for index := range b.ticks[direction] {
+		indexes = append(indexes, index)
+	}
+	sort.Slice(indexes, func(i, j int) bool { return (indexes[i] < indexes[j]) == (direction == Ask) })
+	return indexes
+}
+
+func newTestPool(t *testing.T, swapFee sdk.Dec) *BookPool {
+	params := defaultOrderbookPoolParams
+	params.SwapFee = swapFee
+	pool, err := NewOrderbookPool(defaultPoolId, params, defaultPoolLiquidity, "uatom", "")
+	require.NoError(t, err)
+	return pool.WithBook(newMemBook())
+}
+
+func placeOrder(t *testing.T, pool *BookPool, tick int64, tokenIn sdk.Coin) LimitOrder {
+	order, err := pool.PlaceLimitOrder(sdk.Context{}, pool.book, owner, tick, tokenIn)
+	require.NoError(t, err)
+	return order
+}
+
+func TestNewOrderbookPool(t *testing.T) {
+	tests := map[string]struct {
+		liquidity     sdk.Coins
+		baseDenom     string
+		params        PoolParams
+		expQuoteDenom string
+		expErr        error
+	}{
+		"valid pool": {
+			liquidity:     defaultPoolLiquidity,
+			baseDenom:     "uatom",
+			params:        defaultOrderbookPoolParams,
+			expQuoteDenom: "uosmo",
+		},
+		"base denom sorted last": {
+			liquidity:     defaultPoolLiquidity,
+			baseDenom:     "uosmo",
+			params:        defaultOrderbookPoolParams,
+			expQuoteDenom: "uatom",
+		},
+		"base denom not in liquidity": {
+			liquidity: defaultPoolLiquidity,
+			baseDenom: "uion",
+			params:    defaultOrderbookPoolParams,
+			expErr:    types.ErrDenomNotFoundInPool,
+		},
+		"single asset": {
+			liquidity: sdk.NewCoins(sdk.NewInt64Coin("uatom", 100)),
+			baseDenom: "uatom",
+			params:    defaultOrderbookPoolParams,
+			expErr:    types.ErrTooFewPoolAssets,
+		},
+		"three assets": {
+			liquidity: defaultPoolLiquidity.Add(sdk.NewInt64Coin("uion", 100)),
+			baseDenom: "uatom",
+			params:    defaultOrderbookPoolParams,
+			expErr:    types.ErrTooManyPoolAssets,
+		},
+		"zero tick size": {
+			liquidity: defaultPoolLiquidity,
+			baseDenom: "uatom",
+			params:    PoolParams{SwapFee: sdk.ZeroDec(), ExitFee: sdk.ZeroDec(), TickSize: sdk.ZeroDec()},
+			expErr:    types.ErrInvalidTickSize,
+		},
+		"zero min order size": {
+			liquidity: defaultPoolLiquidity,
+			baseDenom: "uatom",
+			params:    PoolParams{SwapFee: sdk.ZeroDec(), ExitFee: sdk.ZeroDec(), TickSize: sdk.OneDec(), MinOrderSize: sdk.ZeroInt()},
+			expErr:    types.ErrInvalidMinOrderSize,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			pool, err := NewOrderbookPool(defaultPoolId, tc.params, tc.liquidity, tc.baseDenom, "")
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.baseDenom, pool.BaseDenom)
+			require.Equal(t, tc.expQuoteDenom, pool.QuoteDenom)
+			require.Equal(t, types.InitPoolSharesSupply, pool.GetTotalShares())
+			require.Equal(t, uint64(1), pool.NextOrderId)
+		})
+	}
+}
+
+func TestPlaceLimitOrder(t *testing.T) {
+	tests := map[string]struct {
+		tick         int64
+		tokenIn      sdk.Coin
+		expDirection OrderDirection
+		expErr       error
+	}{
+		"ask above spot price": {
+			tick:         110,
+			tokenIn:      sdk.NewInt64Coin("uatom", 1000),
+			expDirection: Ask,
+		},
+		"bid below spot price": {
+			tick:         90,
+			tokenIn:      sdk.NewInt64Coin("uosmo", 9000),
+			expDirection: Bid,
+		},
+		"ask at spot price": {
+			tick:    100,
+			tokenIn: sdk.NewInt64Coin("uatom", 1000),
+			expErr:  types.ErrInvalidTick,
+		},
+		"bid at spot price": {
+			tick:    100,
+			tokenIn: sdk.NewInt64Coin("uosmo", 1000),
+			expErr:  types.ErrInvalidTick,
+		},
+		"zero tick": {
+			tick:    0,
+			tokenIn: sdk.NewInt64Coin("uosmo", 1000),
+			expErr:  types.ErrInvalidTick,
+		},
+		"denom not in pool": {
+			tick:    110,
+			tokenIn: sdk.NewInt64Coin("uion", 1000),
+			expErr:  types.ErrDenomNotFoundInPool,
+		},
+		"zero amount": {
+			tick:    110,
+			tokenIn: sdk.NewInt64Coin("uatom", 0),
+			expErr:  types.ErrNotPositiveRequireAmount,
+		},
+		"ask worth less than the min order size": {
+			tick:    110,
+			tokenIn: sdk.NewInt64Coin("uatom", 9),
+			expErr:  types.ErrLimitOrderTooSmall,
+		},
+		"ask worth the min order size": {
+			tick:         110,
+			tokenIn:      sdk.NewInt64Coin("uatom", 10),
+			expDirection: Ask,
+		},
+		"bid smaller than the min order size": {
+			tick:    90,
+			tokenIn: sdk.NewInt64Coin("uosmo", 99),
+			expErr:  types.ErrLimitOrderTooSmall,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			pool := newTestPool(t, sdk.ZeroDec())
+			order, err := pool.PlaceLimitOrder(sdk.Context{}, pool.book, owner, tc.tick, tc.tokenIn)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				require.Empty(t, pool.GetLimitOrders(pool.book, ""))
+				require.Equal(t, uint64(1), pool.NextOrderId)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, uint64(1), order.OrderId)
+			require.Equal(t, tc.expDirection, order.Direction)
+			require.Equal(t, tc.tokenIn.Amount, order.Quantity)
+			require.Equal(t, uint64(2), pool.NextOrderId)
+
+			stored, err := pool.GetLimitOrder(pool.book, order.OrderId)
+			require.NoError(t, err)
+			require.Equal(t, order, stored)
+			tick := pool.book.GetTick(tc.expDirection, tc.tick)
+			require.Equal(t, []uint64{order.OrderId}, tick.OrderIds)
+		})
+	}
+}
+
+func TestPlaceLimitOrderQueuesOrdersByTick(t *testing.T) {
+	pool := newTestPool(t, sdk.ZeroDec())
+	placeOrder(t, pool, 120, sdk.NewInt64Coin("uatom", 10))
+	placeOrder(t, pool, 80, sdk.NewInt64Coin("uosmo", 100))
+	placeOrder(t, pool, 110, sdk.NewInt64Coin("uatom", 10))
+	placeOrder(t, pool, 120, sdk.NewInt64Coin("uatom", 20))
+
+	book := pool.book.(*memBook)
+	require.Equal(t, []int64{110, 120}, book.tickIndexes(Ask))
+	require.Equal(t, []int64{80}, book.tickIndexes(Bid))
+	require.Equal(t, []uint64{1, 4}, book.GetTick(Ask, 120).OrderIds)
+	require.Len(t, pool.GetLimitOrders(book, ""), 4)
+	// the orders are not part of the pool state.
+	require.Empty(t, pool.LimitOrders)
+}
+
+func TestSwapWithoutBook(t *testing.T) {
+	pool := newTestPool(t, sdk.ZeroDec()).Pool
+	tokenIn := sdk.Coins{sdk.NewInt64Coin("uosmo", 1000)}
+	tokenOut := sdk.Coins{sdk.NewInt64Coin("uatom", 10)}
+
+	_, err := pool.CalcOutAmtGivenIn(sdk.Context{}, tokenIn, "uatom", sdk.ZeroDec())
+	require.ErrorIs(t, err, types.ErrSwapWithoutOrderBook)
+	_, err = pool.SwapOutAmtGivenIn(sdk.Context{}, tokenIn, "uatom", sdk.ZeroDec())
+	require.ErrorIs(t, err, types.ErrSwapWithoutOrderBook)
+	_, err = pool.CalcInAmtGivenOut(sdk.Context{}, tokenOut, "uosmo", sdk.ZeroDec())
+	require.ErrorIs(t, err, types.ErrSwapWithoutOrderBook)
+	_, err = pool.SwapInAmtGivenOut(sdk.Context{}, tokenOut, "uosmo", sdk.ZeroDec())
+	require.ErrorIs(t, err, types.ErrSwapWithoutOrderBook)
+	require.Equal(t, defaultPoolLiquidity, pool.PoolLiquidity)
+}
+
+func TestSwapOutAmtGivenIn(t *testing.T) {
+	tests := map[string]struct {
+		orders       []sdk.Coin
+		orderTicks   []int64
+		tokenIn      sdk.Coin
+		swapFee      sdk.Dec
+		expTokenOut  sdk.Coin
+		expLiquidity sdk.Coins
+		expFilled    []int64
+		expProceeds  []int64
+	}{
+		"no orders, constant product": {
+			tokenIn:     sdk.NewInt64Coin("uosmo", 1000),
+			swapFee:     sdk.ZeroDec(),
+			expTokenOut: sdk.NewInt64Coin("uatom", 99),
+			expLiquidity: sdk.NewCoins(
+				sdk.NewInt64Coin("uatom", 999_901),
+				sdk.NewInt64Coin("uosmo", 10_001_000),
+			),
+		},
+		"swap fee accrues to the reserves": {
+			tokenIn:     sdk.NewInt64Coin("uosmo", 1000),
+			swapFee:     sdk.MustNewDecFromStr("0.1"),
+			expTokenOut: sdk.NewInt64Coin("uatom", 89),
+			expLiquidity: sdk.NewCoins(
+				sdk.NewInt64Coin("uatom", 999_911),
+				sdk.NewInt64Coin("uosmo", 10_001_000),
+			),
+		},
+		"swap below the ask price does not fill it": {
+			orders:      []sdk.Coin{sdk.NewInt64Coin("uatom", 1000)},
+			orderTicks:  []int64{110},
+			tokenIn:     sdk.NewInt64Coin("uosmo", 1000),
+			swapFee:     sdk.ZeroDec(),
+			expTokenOut: sdk.NewInt64Coin("uatom", 99),
+			expLiquidity: sdk.NewCoins(
+				sdk.NewInt64Coin("uatom", 999_901),
+				sdk.NewInt64Coin("uosmo", 10_001_000),
+			),
+			expFilled:   []int64{0},
+			expProceeds: []int64{0},
+		},
+		"swap through an ask": {
+			orders:      []sdk.Coin{sdk.NewInt64Coin("uatom", 1000)},
+			orderTicks:  []int64{110},
+			tokenIn:     sdk.NewInt64Coin("uosmo", 600_000),
+			swapFee:     sdk.ZeroDec(),
+			expTokenOut: sdk.NewInt64Coin("uatom", 56_623),
+			// 11_000 uosmo went to the ask, 1000 uatom came from it.
+			expLiquidity: sdk.NewCoins(
+				sdk.NewInt64Coin("uatom", 944_377),
+				sdk.NewInt64Coin("uosmo", 10_589_000),
+			),
+			expFilled:   []int64{1000},
+			expProceeds: []int64{11_000},
+		},
+		"swap through a bid": {
+			orders:      []sdk.Coin{sdk.NewInt64Coin("uosmo", 9000)},
+			orderTicks:  []int64{90},
+			tokenIn:     sdk.NewInt64Coin("uatom", 100_000),
+			swapFee:     sdk.ZeroDec(),
+			expTokenOut: sdk.NewInt64Coin("uosmo", 909_818),
+			expLiquidity: sdk.NewCoins(
+				sdk.NewInt64Coin("uatom", 1_099_000),
+				sdk.NewInt64Coin("uosmo", 9_099_182),
+			),
+			expFilled:   []int64{9000},
+			expProceeds: []int64{1000},
+		},
+		"asks are filled in price then time priority": {
+			orders: []sdk.Coin{
+				sdk.NewInt64Coin("uatom", 1000),
+				sdk.NewInt64Coin("uatom", 1000),
+				sdk.NewInt64Coin("uatom", 1000),
+			},
+			orderTicks:  []int64{120, 110, 110},
+			tokenIn:     sdk.NewInt64Coin("uosmo", 488_088+15_000),
+			swapFee:     sdk.ZeroDec(),
+			expTokenOut: sdk.NewInt64Coin("uatom", 46_537+1000+363),
+			// the 7 uosmo left after the asks at tick 110 buy nothing from the reserves.
+			expLiquidity: sdk.NewCoins(
+				sdk.NewInt64Coin("uatom", 953_463),
+				sdk.NewInt64Coin("uosmo", 10_488_095),
+			),
+			expFilled:   []int64{0, 1000, 363},
+			expProceeds: []int64{0, 11_000, 3993},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			pool := newTestPool(t, tc.swapFee)
+			orderIds := []uint64{}
+			for i, order := range tc.orders {
+				orderIds = append(orderIds, placeOrder(t, pool, tc.orderTicks[i], order).OrderId)
+			}
+
+			calcOut, err := pool.CalcOutAmtGivenIn(sdk.Context{}, sdk.Coins{tc.tokenIn}, tc.expTokenOut.Denom, tc.swapFee)
+			require.NoError(t, err)
+			require.Equal(t, tc.expTokenOut, calcOut)
+			// calc must not mutate the pool
+			require.Equal(t, defaultPoolLiquidity, pool.PoolLiquidity)
+			for _, orderId := range orderIds {
+				order, err := pool.GetLimitOrder(pool.book, orderId)
+				require.NoError(t, err)
+				require.True(t, order.Filled.IsZero())
+			}
+
+			tokenOut, err := pool.SwapOutAmtGivenIn(sdk.Context{}, sdk.Coins{tc.tokenIn}, tc.expTokenOut.Denom, tc.swapFee)
+			require.NoError(t, err)
+			require.Equal(t, tc.expTokenOut, tokenOut)
+			require.Equal(t, tc.expLiquidity, pool.PoolLiquidity)
+			for i, orderId := range orderIds {
+				order, err := pool.GetLimitOrder(pool.book, orderId)
+				require.NoError(t, err)
+				require.Equal(t, sdk.NewInt(tc.expFilled[i]), order.Filled)
+				require.Equal(t, sdk.NewInt(tc.expProceeds[i]), order.Proceeds)
+			}
+		})
+	}
+}
+
+func TestSwapInAmtGivenOut(t *testing.T) {
+	tests := map[string]struct {
+		orders       []sdk.Coin
+		orderTicks   []int64
+		tokenOut     sdk.Coin
+		swapFee      sdk.Dec
+		expTokenIn   sdk.Coin
+		expLiquidity sdk.Coins
+		expFilled    []int64
+		expErr       error
+	}{
+		"no orders, constant product": {
+			tokenOut:   sdk.NewInt64Coin("uatom", 99),
+			swapFee:    sdk.ZeroDec(),
+			expTokenIn: sdk.NewInt64Coin("uosmo", 991),
+			expLiquidity: sdk.NewCoins(
+				sdk.NewInt64Coin("uatom", 999_901),
+				sdk.NewInt64Coin("uosmo", 10_000_991),
+			),
+		},
+		"swap fee accrues to the reserves": {
+			tokenOut:   sdk.NewInt64Coin("uatom", 99),
+			swapFee:    sdk.MustNewDecFromStr("0.1"),
+			expTokenIn: sdk.NewInt64Coin("uosmo", 1102),
+			expLiquidity: sdk.NewCoins(
+				sdk.NewInt64Coin("uatom", 999_901),
+				sdk.NewInt64Coin("uosmo", 10_001_102),
+			),
+		},
+		"swap through an ask": {
+			orders:     []sdk.Coin{sdk.NewInt64Coin("uatom", 1000)},
+			orderTicks: []int64{110},
+			tokenOut:   sdk.NewInt64Coin("uatom", 56_623),
+			swapFee:    sdk.ZeroDec(),
+			expTokenIn: sdk.NewInt64Coin("uosmo", 599_996),
+			expLiquidity: sdk.NewCoins(
+				sdk.NewInt64Coin("uatom", 944_377),
+				sdk.NewInt64Coin("uosmo", 10_588_996),
+			),
+			expFilled: []int64{1000},
+		},
+		"tokens out fully taken from an ask": {
+			orders:     []sdk.Coin{sdk.NewInt64Coin("uatom", 1000)},
+			orderTicks: []int64{110},
+			tokenOut:   sdk.NewInt64Coin("uatom", 46_537+500),
+			swapFee:    sdk.ZeroDec(),
+			expTokenIn: sdk.NewInt64Coin("uosmo", 488_088+5500),
+			expLiquidity: sdk.NewCoins(
+				sdk.NewInt64Coin("uatom", 953_463),
+				sdk.NewInt64Coin("uosmo", 10_488_088),
+			),
+			expFilled: []int64{500},
+		},
+		"more tokens out than the reserves": {
+			tokenOut: sdk.NewInt64Coin("uatom", 1_000_000),
+			swapFee:  sdk.ZeroDec(),
+			expErr:   types.ErrTooManyTokensOut,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			pool := newTestPool(t, tc.swapFee)
+			orderIds := []uint64{}
+			for i, order := range tc.orders {
+				orderIds = append(orderIds, placeOrder(t, pool, tc.orderTicks[i], order).OrderId)
+			}
+
+			calcIn, err := pool.CalcInAmtGivenOut(sdk.Context{}, sdk.Coins{tc.tokenOut}, "uosmo", tc.swapFee)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expTokenIn, calcIn)
+			require.Equal(t, defaultPoolLiquidity, pool.PoolLiquidity)
+
+			tokenIn, err := pool.SwapInAmtGivenOut(sdk.Context{}, sdk.Coins{tc.tokenOut}, "uosmo", tc.swapFee)
+			require.NoError(t, err)
+			require.Equal(t, tc.expTokenIn, tokenIn)
+			require.Equal(t, tc.expLiquidity, pool.PoolLiquidity)
+			for i, orderId := range orderIds {
+				order, err := pool.GetLimitOrder(pool.book, orderId)
+				require.NoError(t, err)
+				require.Equal(t, sdk.NewInt(tc.expFilled[i]), order.Filled)
+			}
+		})
+	}
+}
+
+func TestCancelAndClaimLimitOrder(t *testing.T) {
+	pool := newTestPool(t, sdk.ZeroDec())
+	order := placeOrder(t, pool, 110, sdk.NewInt64Coin("uatom", 1000))
+
+	// nothing filled yet
+	_, err := pool.ClaimLimitOrder(pool.book, owner, order.OrderId)
+	require.ErrorIs(t, err, types.ErrNothingToClaim)
+
+	// fill 363 uatom of the order
+	_, err = pool.SwapOutAmtGivenIn(sdk.Context{}, sdk.Coins{sdk.NewInt64Coin("uosmo", 488_088+3993)}, "uatom", sdk.ZeroDec())
+	require.NoError(t, err)
+
+	// only the owner can claim or cancel
+	_, err = pool.ClaimLimitOrder(pool.book, otherOwner, order.OrderId)
+	require.ErrorIs(t, err, types.ErrNotLimitOrderOwner)
+	_, err = pool.CancelLimitOrder(pool.book, otherOwner, order.OrderId)
+	require.ErrorIs(t, err, types.ErrNotLimitOrderOwner)
+
+	claimed, err := pool.ClaimLimitOrder(pool.book, owner, order.OrderId)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("uosmo", 3993), claimed)
+
+	_, err = pool.ClaimLimitOrder(pool.book, owner, order.OrderId)
+	require.ErrorIs(t, err, types.ErrNothingToClaim)
+
+	// partially filled orders stay in the book after a claim
+	order, err = pool.GetLimitOrder(pool.book, order.OrderId)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(3993), order.Claimed)
+
+	cancelled, err := pool.CancelLimitOrder(pool.book, owner, order.OrderId)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatom", 637)), cancelled)
+	require.Empty(t, pool.book.(*memBook).tickIndexes(Ask))
+
+	_, err = pool.CancelLimitOrder(pool.book, owner, order.OrderId)
+	require.ErrorIs(t, err, types.ErrLimitOrderNotFound)
+}
+
+func TestClaimRemovesFilledLimitOrder(t *testing.T) {
+	pool := newTestPool(t, sdk.ZeroDec())
+	order := placeOrder(t, pool, 90, sdk.NewInt64Coin("uosmo", 9000))
+	placeOrder(t, pool, 90, sdk.NewInt64Coin("uosmo", 9000))
+
+	_, err := pool.SwapOutAmtGivenIn(sdk.Context{}, sdk.Coins{sdk.NewInt64Coin("uatom", 100_000)}, "uosmo", sdk.ZeroDec())
+	require.NoError(t, err)
+
+	claimed, err := pool.ClaimLimitOrder(pool.book, owner, order.OrderId)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("uatom", 1000), claimed)
+
+	_, err = pool.GetLimitOrder(pool.book, order.OrderId)
+	require.ErrorIs(t, err, types.ErrLimitOrderNotFound)
+	require.Len(t, pool.GetLimitOrders(pool.book, ""), 1)
+	require.Len(t, pool.GetLimitOrders(pool.book, owner.String()), 1)
+	require.Empty(t, pool.GetLimitOrders(pool.book, otherOwner.String()))
+}
+
+func TestJoinExitKeepOrderBook(t *testing.T) {
+	pool := newTestPool(t, sdk.ZeroDec())
+	placeOrder(t, pool, 110, sdk.NewInt64Coin("uatom", 1000))
+
+	numShares, err := pool.JoinPoolNoSwap(sdk.Context{}, sdk.NewCoins(sdk.NewInt64Coin("uatom", 100_000), sdk.NewInt64Coin("uosmo", 1_000_000)), sdk.ZeroDec())
+	require.NoError(t, err)
+	require.Equal(t, types.InitPoolSharesSupply.QuoRaw(10), numShares)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1_100_000), sdk.NewInt64Coin("uosmo", 11_000_000)), pool.PoolLiquidity)
+	require.Equal(t, types.InitPoolSharesSupply.Add(numShares), pool.GetTotalShares())
+
+	exited, err := pool.ExitPool(sdk.Context{}, numShares, sdk.ZeroDec())
+	require.NoError(t, err)
+	// exits round down in favor of the pool
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatom", 99_999), sdk.NewInt64Coin("uosmo", 999_999)), exited)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1_000_001), sdk.NewInt64Coin("uosmo", 10_000_001)), pool.PoolLiquidity)
+	require.Equal(t, types.InitPoolSharesSupply, pool.GetTotalShares())
+	require.Len(t, pool.GetLimitOrders(pool.book, ""), 1)
+
+	spotPrice, err := pool.SpotPrice(sdk.Context{}, "uatom", "uosmo")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("9.999991000008999991"), spotPrice)
+}
@@ -0,0 +1,1046 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/gamm/pool-models/orderbook/query.proto
+
+package orderbook
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// =============================== LimitOrder
+type QueryLimitOrderRequest struct {
+	PoolId  uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	OrderId uint64 `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty" yaml:"order_id"`
+}
+
+func (m *QueryLimitOrderRequest) Reset()         { *m = QueryLimitOrderRequest{} }
+func (m *QueryLimitOrderRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLimitOrderRequest) ProtoMessage()    {}
+func (*QueryLimitOrderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6476a219e274f24, []int{0}
+}
+func (m *QueryLimitOrderRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLimitOrderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLimitOrderRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLimitOrderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLimitOrderRequest.Merge(m, src)
+}
+func (m *QueryLimitOrderRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLimitOrderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLimitOrderRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLimitOrderRequest proto.InternalMessageInfo
+
+func (m *QueryLimitOrderRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *QueryLimitOrderRequest) GetOrderId() uint64 {
+	if m != nil {
+		return m.OrderId
+	}
+	return 0
+}
+
+type QueryLimitOrderResponse struct {
+	Order LimitOrder `protobuf:"bytes,1,opt,name=order,proto3" json:"order" yaml:"order"`
+}
+
+func (m *QueryLimitOrderResponse) Reset()         { *m = QueryLimitOrderResponse{} }
+func (m *QueryLimitOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLimitOrderResponse) ProtoMessage()    {}
+func (*QueryLimitOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6476a219e274f24, []int{1}
+}
+func (m *QueryLimitOrderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLimitOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLimitOrderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLimitOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLimitOrderResponse.Merge(m, src)
+}
+func (m *QueryLimitOrderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLimitOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLimitOrderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLimitOrderResponse proto.InternalMessageInfo
+
+func (m *QueryLimitOrderResponse) GetOrder() LimitOrder {
+	if m != nil {
+		return m.Order
+	}
+	return LimitOrder{}
+}
+
+// =============================== LimitOrders
+type QueryLimitOrdersRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	// owner, if set, restricts the result to the orders of this address.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+}
+
+func (m *QueryLimitOrdersRequest) Reset()         { *m = QueryLimitOrdersRequest{} }
+func (m *QueryLimitOrdersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLimitOrdersRequest) ProtoMessage()    {}
+func (*QueryLimitOrdersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6476a219e274f24, []int{2}
+}
+func (m *QueryLimitOrdersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLimitOrdersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLimitOrdersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLimitOrdersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLimitOrdersRequest.Merge(m, src)
+}
+func (m *QueryLimitOrdersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLimitOrdersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLimitOrdersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLimitOrdersRequest proto.InternalMessageInfo
+
+func (m *QueryLimitOrdersRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *QueryLimitOrdersRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+type QueryLimitOrdersResponse struct {
+	Orders []LimitOrder `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders" yaml:"orders"`
+}
+
+func (m *QueryLimitOrdersResponse) Reset()         { *m = QueryLimitOrdersResponse{} }
+func (m *QueryLimitOrdersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLimitOrdersResponse) ProtoMessage()    {}
+func (*QueryLimitOrdersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6476a219e274f24, []int{3}
+}
+func (m *QueryLimitOrdersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLimitOrdersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLimitOrdersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLimitOrdersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLimitOrdersResponse.Merge(m, src)
+}
+func (m *QueryLimitOrdersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLimitOrdersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLimitOrdersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLimitOrdersResponse proto.InternalMessageInfo
+
+func (m *QueryLimitOrdersResponse) GetOrders() []LimitOrder {
+	if m != nil {
+		return m.Orders
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryLimitOrderRequest)(nil), "osmosis.gamm.poolmodels.orderbook.v1beta1.QueryLimitOrderRequest")
+	proto.RegisterType((*QueryLimitOrderResponse)(nil), "osmosis.gamm.poolmodels.orderbook.v1beta1.QueryLimitOrderResponse")
+	proto.RegisterType((*QueryLimitOrdersRequest)(nil), "osmosis.gamm.poolmodels.orderbook.v1beta1.QueryLimitOrdersRequest")
+	proto.RegisterType((*QueryLimitOrdersResponse)(nil), "osmosis.gamm.poolmodels.orderbook.v1beta1.QueryLimitOrdersResponse")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/gamm/pool-models/orderbook/query.proto", fileDescriptor_b6476a219e274f24)
+}
+
+var fileDescriptor_b6476a219e274f24 = []byte{
+	// 481 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xdd, 0x6a, 0x13, 0x41,
+	0x14, 0xce, 0xb4, 0x26, 0xd5, 0xa9, 0x7f, 0x8c, 0x55, 0x43, 0x90, 0x4d, 0x99, 0x0b, 0x51, 0xa4,
+	0x33, 0x26, 0x22, 0x8a, 0x22, 0xe8, 0xf6, 0xaa, 0x20, 0x88, 0x7b, 0xd9, 0x9b, 0xb2, 0xeb, 0x0e,
+	0xeb, 0xe2, 0xee, 0x9e, 0x74, 0x67, 0xd3, 0x5a, 0x6a, 0x41, 0x7c, 0x02, 0xc1, 0x67, 0xf1, 0x1d,
+	0x7a, 0x19, 0xf0, 0xa6, 0x57, 0x41, 0x12, 0x9f, 0x20, 0x4f, 0x20, 0xf3, 0xb3, 0xe9, 0x4a, 0xa4,
+	0xb4, 0xc9, 0x55, 0x0e, 0x39, 0xdf, 0xf9, 0x7e, 0xce, 0x1e, 0x06, 0x33, 0x90, 0x29, 0xc8, 0x58,
+	0xf2, 0xc8, 0x4f, 0x53, 0xde, 0x03, 0x48, 0x36, 0x52, 0x08, 0x45, 0x22, 0x39, 0xe4, 0xa1, 0xc8,
+	0x03, 0x80, 0x4f, 0x7c, 0xb7, 0x2f, 0xf2, 0x03, 0xd6, 0xcb, 0xa1, 0x00, 0xf2, 0xd0, 0xe2, 0x99,
+	0xc2, 0x33, 0x85, 0x37, 0x70, 0x36, 0x85, 0xb3, 0xbd, 0x4e, 0x20, 0x0a, 0xbf, 0xd3, 0x5a, 0x8b,
+	0x20, 0x02, 0x3d, 0xc5, 0x55, 0x65, 0x08, 0x5a, 0xf7, 0x22, 0x80, 0x28, 0x11, 0xdc, 0xef, 0xc5,
+	0xdc, 0xcf, 0x32, 0x28, 0xfc, 0x22, 0x86, 0x4c, 0xda, 0xee, 0xb3, 0x73, 0xd8, 0x99, 0x56, 0x3b,
+	0xaa, 0x6f, 0x06, 0x69, 0x1f, 0xdf, 0x79, 0xaf, 0x6c, 0xbe, 0x8d, 0xd3, 0xb8, 0x78, 0xa7, 0x10,
+	0x9e, 0xd8, 0xed, 0x0b, 0x59, 0x90, 0x47, 0x78, 0x45, 0xe1, 0x76, 0xe2, 0xb0, 0x89, 0xd6, 0xd1,
+	0x83, 0x4b, 0x2e, 0x99, 0x0c, 0xdb, 0xd7, 0x0f, 0xfc, 0x34, 0x79, 0x41, 0x6d, 0x83, 0x7a, 0x0d,
+	0x55, 0x6d, 0x85, 0x84, 0xe1, 0xcb, 0x9a, 0x5e, 0xa1, 0x97, 0x34, 0xfa, 0xd6, 0x64, 0xd8, 0xbe,
+	0x61, 0xd0, 0x65, 0x87, 0x7a, 0x2b, 0xba, 0xdc, 0x0a, 0xe9, 0x17, 0x7c, 0x77, 0x46, 0x56, 0xf6,
+	0x20, 0x93, 0x82, 0xf8, 0xb8, 0xae, 0x51, 0x5a, 0x75, 0xb5, 0xfb, 0x94, 0x9d, 0x7b, 0x73, 0xec,
+	0x94, 0xcd, 0x5d, 0x3b, 0x1e, 0xb6, 0x6b, 0x93, 0x61, 0xfb, 0x6a, 0xc5, 0x02, 0xf5, 0x0c, 0x33,
+	0xcd, 0x66, 0xd4, 0xe5, 0x5c, 0xa9, 0xef, 0xe3, 0x3a, 0xec, 0x67, 0x22, 0xd7, 0x91, 0xaf, 0xb8,
+	0x37, 0x2b, 0x7a, 0xfb, 0x99, 0xd1, 0xd3, 0xbf, 0x5f, 0x11, 0x6e, 0xce, 0x0a, 0xda, 0xbc, 0x21,
+	0x6e, 0x68, 0x57, 0xb2, 0x89, 0xd6, 0x97, 0xe7, 0x0f, 0x7c, 0xdb, 0x06, 0xbe, 0x56, 0x09, 0x2c,
+	0xa9, 0x67, 0xb9, 0xbb, 0x3f, 0x97, 0x71, 0x5d, 0x5b, 0x20, 0x27, 0x08, 0xe3, 0xd3, 0x39, 0xf2,
+	0xe6, 0x02, 0x72, 0xff, 0xbf, 0x94, 0x96, 0xbb, 0x08, 0x85, 0xd9, 0x02, 0xdd, 0xfc, 0xf6, 0xeb,
+	0xcf, 0x8f, 0xa5, 0x57, 0xe4, 0x25, 0xff, 0xe7, 0x92, 0xed, 0x58, 0xe5, 0x8a, 0x0f, 0xed, 0x57,
+	0x38, 0x32, 0xff, 0x49, 0x7e, 0x58, 0x9e, 0xd7, 0x11, 0x19, 0x20, 0xbc, 0x5a, 0x59, 0x31, 0x59,
+	0xc0, 0x58, 0x79, 0x10, 0xad, 0xcd, 0x85, 0x38, 0x6c, 0xba, 0xe7, 0x3a, 0x5d, 0x97, 0x3c, 0xbe,
+	0x68, 0x3a, 0x77, 0xfb, 0x78, 0xe4, 0xa0, 0xc1, 0xc8, 0x41, 0xbf, 0x47, 0x0e, 0xfa, 0x3e, 0x76,
+	0x6a, 0x83, 0xb1, 0x53, 0x3b, 0x19, 0x3b, 0xb5, 0xed, 0xd7, 0x51, 0x5c, 0x7c, 0xec, 0x07, 0xec,
+	0x03, 0xa4, 0x25, 0xeb, 0x46, 0xe2, 0x07, 0x72, 0x2a, 0xb1, 0xd7, 0xe9, 0xf2, 0xcf, 0x67, 0x3c,
+	0x08, 0x41, 0x43, 0x3f, 0x01, 0x4f, 0xfe, 0x0e, 0x00, 0x48, 0x4d, 0xb5, 0x7c, 0xcc, 0x04, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// LimitOrder returns a single limit order of an orderbook pool.
+	LimitOrder(ctx context.Context, in *QueryLimitOrderRequest, opts ...grpc.CallOption) (*QueryLimitOrderResponse, error)
+	// LimitOrders returns the limit orders of an orderbook pool, optionally
+	// filtered by owner.
+	LimitOrders(ctx context.Context, in *QueryLimitOrdersRequest, opts ...grpc.CallOption) (*QueryLimitOrdersResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) LimitOrder(ctx context.Context, in *QueryLimitOrderRequest, opts ...grpc.CallOption) (*QueryLimitOrderResponse, error) {
+	out := new(QueryLimitOrderResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.poolmodels.orderbook.v1beta1.Query/LimitOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LimitOrders(ctx context.Context, in *QueryLimitOrdersRequest, opts ...grpc.CallOption) (*QueryLimitOrdersResponse, error) {
+	out := new(QueryLimitOrdersResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.poolmodels.orderbook.v1beta1.Query/LimitOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// LimitOrder returns a single limit order of an orderbook pool.
+	LimitOrder(context.Context, *QueryLimitOrderRequest) (*QueryLimitOrderResponse, error)
+	// LimitOrders returns the limit orders of an orderbook pool, optionally
+	// filtered by owner.
+	LimitOrders(context.Context, *QueryLimitOrdersRequest) (*QueryLimitOrdersResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) LimitOrder(ctx context.Context, req *QueryLimitOrderRequest) (*QueryLimitOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LimitOrder not implemented")
+}
+func (*UnimplementedQueryServer) LimitOrders(ctx context.Context, req *QueryLimitOrdersRequest) (*QueryLimitOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LimitOrders not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_LimitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLimitOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LimitOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.poolmodels.orderbook.v1beta1.Query/LimitOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LimitOrder(ctx, req.(*QueryLimitOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LimitOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLimitOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LimitOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.poolmodels.orderbook.v1beta1.Query/LimitOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LimitOrders(ctx, req.(*QueryLimitOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "osmosis.gamm.poolmodels.orderbook.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "LimitOrder",
+			Handler:    _Query_LimitOrder_Handler,
+		},
+		{
+			MethodName: "LimitOrders",
+			Handler:    _Query_LimitOrders_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "osmosis/gamm/pool-models/orderbook/query.proto",
+}
+
+func (m *QueryLimitOrderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLimitOrderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLimitOrderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLimitOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLimitOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLimitOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLimitOrdersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLimitOrdersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLimitOrdersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLimitOrdersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLimitOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLimitOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Orders) > 0 {
+		for iNdEx := len(m.Orders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Orders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryLimitOrderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.OrderId != 0 {
+		n += 1 + sovQuery(uint64(m.OrderId))
+	}
+	return n
+}
+
+func (m *QueryLimitOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Order.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryLimitOrdersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLimitOrdersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Orders) > 0 {
+		for _, e := range m.Orders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryLimitOrderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLimitOrderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLimitOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLimitOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLimitOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLimitOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLimitOrdersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLimitOrdersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLimitOrdersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLimitOrdersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLimitOrdersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLimitOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orders = append(m.Orders, LimitOrder{})
+			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,372 @@
+package orderbook
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/types"
+)
+
+// A swap walks the order book from the best price outwards. Before filling the orders at a tick,
+// the reserves are swapped against until their marginal price reaches the tick price.
+// Once all ticks are exhausted, the remainder of the swap is done against the reserves.
+//
+// Swaps of the quote denom for the base denom fill asks in ascending tick order,
+// swaps of the base denom for the quote denom fill bids in descending tick order.
+// Swap fees are charged on the token in and accrue to the reserves.
+
+// levelPrice is the price of a tick expressed as the rational num / den,
+// in tokens in per token out of the swap.
+type levelPrice struct {
+	num *big.Int
+	den *big.Int
+}
+
+// outForIn returns how many tokens out are bought at this price for the given tokens in, rounding down.
+func (price levelPrice) outForIn(in sdk.Int) sdk.Int {
+	return mulDiv(in, price.den, price.num, false)
+}
+
+// inForOut returns how many tokens in are owed at this price for the given tokens out, rounding up.
+func (price levelPrice) inForOut(out sdk.Int) sdk.Int {
+	return mulDiv(out, price.num, price.den, true)
+}
+
+// mulDiv returns a * b / c, rounded up or down.
+func mulDiv(a sdk.Int, b, c *big.Int, roundUp bool) sdk.Int {
+	res := new(big.Int).Mul(a.BigInt(), b)
+	if roundUp {
+		res.Add(res, new(big.Int).Sub(c, big.NewInt(1)))
+	}
+	return sdk.NewIntFromBigInt(res.Quo(res, c))
+}
+
+// reserves are the reserves of the pool for the token in and the token out of a swap.
+// They follow the constant product formula.
+type reserves struct {
+	in  sdk.Int
+	out sdk.Int
+}
+
+// stepTo returns how many tokens in must be swapped against the reserves for their marginal price
+// to reach the given price. Returns zero if the reserves are already at or above it.
+func (r reserves) stepTo(price levelPrice) sdk.Int {
+	// r.in / r.out >= num / den
+	if new(big.Int).Mul(r.in.BigInt(), price.den).Cmp(new(big.Int).Mul(r.out.BigInt(), price.num)) >= 0 {
+		return sdk.ZeroInt()
+	}
+	// the reserve in after the swap is sqrt(k * price), rounded down to never cross the price.
+	k := new(big.Int).Mul(r.in.BigInt(), r.out.BigInt())
+	target := k.Mul(k, price.num)
+	target = target.Quo(target, price.den)
+	target = target.Sqrt(target)
+	step := sdk.NewIntFromBigInt(target).Sub(r.in)
+	if step.IsNegative() {
+		return sdk.ZeroInt()
+	}
+	return step
+}
+
+// swapIn swaps the given tokens in against the reserves, and returns the tokens out.
+func (r *reserves) swapIn(in sdk.Int) sdk.Int {
+	out := r.out.Mul(in).Quo(r.in.Add(in))
+	r.in = r.in.Add(in)
+	r.out = r.out.Sub(out)
+	return out
+}
+
+// swapOut swaps the tokens in required to get the given tokens out of the reserves, and returns them.
+func (r *reserves) swapOut(out sdk.Int) (sdk.Int, error) {
+	if out.GTE(r.out) {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrTooManyTokensOut,
+			"can't get more tokens out than there are tokens in the pool reserves")
+	}
+	remainingOut := r.out.Sub(out)
+	in := r.in.Mul(out).Add(remainingOut).Sub(sdk.OneInt()).Quo(remainingOut)
+	r.in = r.in.Add(in)
+	r.out = remainingOut
+	return in, nil
+}
+
+// swapDirection returns the direction of the orders that are filled by a swap of tokenInDenom for tokenOutDenom.
+func (p Pool) swapDirection(tokenInDenom string, tokenOutDenom string) (OrderDirection, error) {
+	switch {
+	case tokenInDenom == p.QuoteDenom && tokenOutDenom == p.BaseDenom:
+		return Ask, nil
+	case tokenInDenom == p.BaseDenom && tokenOutDenom == p.QuoteDenom:
+		return Bid, nil
+	default:
+		return 0, sdkerrors.Wrapf(types.ErrDenomNotFoundInPool,
+			"pool %d can't swap %s for %s", p.Id, tokenInDenom, tokenOutDenom)
+	}
+}
+
+// levelPrice returns the price of the given tick for swaps filling orders of the given direction.
+func (p Pool) levelPrice(tick int64, direction OrderDirection) levelPrice {
+	tickPrice := p.TickPrice(tick).BigInt()
+	precision := sdk.OneDec().BigInt()
+	if direction == Ask {
+		return levelPrice{num: tickPrice, den: precision}
+	}
+	return levelPrice{num: precision, den: tickPrice}
+}
+
+func (p Pool) reservesFor(tokenInDenom string, tokenOutDenom string) reserves {
+	return reserves{
+		in:  p.PoolLiquidity.AmountOf(tokenInDenom),
+		out: p.PoolLiquidity.AmountOf(tokenOutDenom),
+	}
+}
+
+func (p *Pool) setReserves(tokenInDenom string, tokenOutDenom string, r reserves) {
+	p.PoolLiquidity = sdk.NewCoins(sdk.NewCoin(tokenInDenom, r.in), sdk.NewCoin(tokenOutDenom, r.out))
+}
+
+// swapExactAmountIn swaps tokenIn against the pool, filling limit orders of the book, and returns the tokens out
+// along with the updates to apply to the book. It mutates the pool, but not the book.
+func (p *Pool) swapExactAmountIn(book Book, tokenIn sdk.Coin, tokenOutDenom string, swapFee sdk.Dec) (sdk.Coin, bookUpdates, error) {
+	direction, err := p.swapDirection(tokenIn.Denom, tokenOutDenom)
+	if err != nil {
+		return sdk.Coin{}, bookUpdates{}, err
+	}
+	r := p.reservesFor(tokenIn.Denom, tokenOutDenom)
+
+	remainingIn := tokenIn.Amount.ToDec().Mul(sdk.OneDec().Sub(swapFee)).TruncateInt()
+	fee := tokenIn.Amount.Sub(remainingIn)
+	tokenOutAmount := sdk.ZeroInt()
+	updates := bookUpdates{}
+
+	book.IterateTicks(direction, func(tick Tick) bool {
+		price := p.levelPrice(tick.Index, direction)
+
+		if step := sdk.MinInt(r.stepTo(price), remainingIn); step.IsPositive() {
+			tokenOutAmount = tokenOutAmount.Add(r.swapIn(step))
+			remainingIn = remainingIn.Sub(step)
+		}
+
+		stop := false
+		for len(tick.OrderIds) > 0 {
+			if remainingIn.IsZero() {
+				stop = true
+				break
+			}
+			var order LimitOrder
+			order, err = p.GetLimitOrder(book, tick.OrderIds[0])
+			if err != nil {
+				return true
+			}
+			filled := sdk.MinInt(order.Unfilled(), price.outForIn(remainingIn))
+			if filled.IsZero() {
+				// the remaining tokens in can't buy anything at this price, nor at any worse price.
+				stop = true
+				break
+			}
+			paid := price.inForOut(filled)
+			order.fill(filled, paid)
+			updates.orders = append(updates.orders, order)
+			tokenOutAmount = tokenOutAmount.Add(filled)
+			remainingIn = remainingIn.Sub(paid)
+			if !order.IsFilled() {
+				stop = true
+				break
+			}
+			tick.OrderIds = tick.OrderIds[1:]
+		}
+		updates.ticks = append(updates.ticks, tick)
+		return stop
+	})
+	if err != nil {
+		return sdk.Coin{}, bookUpdates{}, err
+	}
+
+	if remainingIn.IsPositive() {
+		tokenOutAmount = tokenOutAmount.Add(r.swapIn(remainingIn))
+	}
+	r.in = r.in.Add(fee)
+	p.setReserves(tokenIn.Denom, tokenOutDenom, r)
+
+	return sdk.NewCoin(tokenOutDenom, tokenOutAmount), updates, nil
+}
+
+// swapExactAmountOut swaps the tokens in required to get tokenOut out of the pool, filling limit orders of the book,
+// and returns them along with the updates to apply to the book. It mutates the pool, but not the book.
+func (p *Pool) swapExactAmountOut(book Book, tokenOut sdk.Coin, tokenInDenom string, swapFee sdk.Dec) (sdk.Coin, bookUpdates, error) {
+	direction, err := p.swapDirection(tokenInDenom, tokenOut.Denom)
+	if err != nil {
+		return sdk.Coin{}, bookUpdates{}, err
+	}
+	r := p.reservesFor(tokenInDenom, tokenOut.Denom)
+
+	remainingOut := tokenOut.Amount
+	tokenInAmount := sdk.ZeroInt()
+	updates := bookUpdates{}
+
+	book.IterateTicks(direction, func(tick Tick) bool {
+		price := p.levelPrice(tick.Index, direction)
+
+		if step := r.stepTo(price); step.IsPositive() {
+			stepReserves := r
+			if stepOut := stepReserves.swapIn(step); stepOut.LT(remainingOut) {
+				r = stepReserves
+				tokenInAmount = tokenInAmount.Add(step)
+				remainingOut = remainingOut.Sub(stepOut)
+			} else {
+				var in sdk.Int
+				in, err = r.swapOut(remainingOut)
+				if err != nil {
+					return true
+				}
+				tokenInAmount = tokenInAmount.Add(in)
+				remainingOut = sdk.ZeroInt()
+			}
+		}
+
+		stop := false
+		for len(tick.OrderIds) > 0 {
+			if remainingOut.IsZero() {
+				stop = true
+				break
+			}
+			var order LimitOrder
+			order, err = p.GetLimitOrder(book, tick.OrderIds[0])
+			if err != nil {
+				return true
+			}
+			filled := sdk.MinInt(order.Unfilled(), remainingOut)
+			paid := price.inForOut(filled)
+			order.fill(filled, paid)
+			updates.orders = append(updates.orders, order)
+			tokenInAmount = tokenInAmount.Add(paid)
+			remainingOut = remainingOut.Sub(filled)
+			if !order.IsFilled() {
+				stop = true
+				break
+			}
+			tick.OrderIds = tick.OrderIds[1:]
+		}
+		updates.ticks = append(updates.ticks, tick)
+		return stop || remainingOut.IsZero()
+	})
+	if err != nil {
+		return sdk.Coin{}, bookUpdates{}, err
+	}
+
+	if remainingOut.IsPositive() {
+		in, err := r.swapOut(remainingOut)
+		if err != nil {
+			return sdk.Coin{}, bookUpdates{}, err
+		}
+		tokenInAmount = tokenInAmount.Add(in)
+	}
+
+	// charge the swap fee on top of the tokens in, rounding up.
+	totalIn := tokenInAmount.ToDec().Quo(sdk.OneDec().Sub(swapFee)).Ceil().TruncateInt()
+	r.in = r.in.Add(totalIn.Sub(tokenInAmount))
+	p.setReserves(tokenInDenom, tokenOut.Denom, r)
+
+	return sdk.NewCoin(tokenInDenom, totalIn), updates, nil
+}
+
+func validateSwapTokens(tokens sdk.Coins) (sdk.Coin, error) {
+	if tokens.Len() != 1 {
+		return sdk.Coin{}, errors.New("orderbook pool only supports swapping a single token")
+	}
+	return tokens[0], nil
+}
+
+// BookPool is an orderbook pool bound to its book. Unlike Pool, it implements the swaps of types.PoolI,
+// which fill the limit orders of the book.
+type BookPool struct {
+	*Pool
+	book Book
+}
+
+var _ types.PoolI = &BookPool{}
+
+// WithBook binds the pool to its book.
+func (p *Pool) WithBook(book Book) *BookPool {
+	return &BookPool{Pool: p, book: book}
+}
+
+// Swaps against an orderbook pool fill the limit orders of its book, so they can only be done through a BookPool.
+func (p Pool) CalcOutAmtGivenIn(ctx sdk.Context, tokenIn sdk.Coins, tokenOutDenom string, swapFee sdk.Dec) (tokenOut sdk.Coin, err error) {
+	return sdk.Coin{}, sdkerrors.Wrapf(types.ErrSwapWithoutOrderBook, "pool %d", p.Id)
+}
+
+func (p *Pool) SwapOutAmtGivenIn(ctx sdk.Context, tokenIn sdk.Coins, tokenOutDenom string, swapFee sdk.Dec) (tokenOut sdk.Coin, err error) {
+	return sdk.Coin{}, sdkerrors.Wrapf(types.ErrSwapWithoutOrderBook, "pool %d", p.Id)
+}
+
+func (p Pool) CalcInAmtGivenOut(ctx sdk.Context, tokenOut sdk.Coins, tokenInDenom string, swapFee sdk.Dec) (tokenIn sdk.Coin, err error) {
+	return sdk.Coin{}, sdkerrors.Wrapf(types.ErrSwapWithoutOrderBook, "pool %d", p.Id)
+}
+
+func (p *Pool) SwapInAmtGivenOut(ctx sdk.Context, tokenOut sdk.Coins, tokenInDenom string, swapFee sdk.Dec) (tokenIn sdk.Coin, err error) {
+	return sdk.Coin{}, sdkerrors.Wrapf(types.ErrSwapWithoutOrderBook, "pool %d", p.Id)
+}
+
+func (p BookPool) CalcOutAmtGivenIn(ctx sdk.Context, tokenIn sdk.Coins, tokenOutDenom string, swapFee sdk.Dec) (tokenOut sdk.Coin, err error) {
+	poolCopy := p.Pool.copy()
+	tokenOut, _, err = poolCopy.swapOutAmtGivenIn(p.book, tokenIn, tokenOutDenom, swapFee)
+	return tokenOut, err
+}
+
+func (p *BookPool) SwapOutAmtGivenIn(ctx sdk.Context, tokenIn sdk.Coins, tokenOutDenom string, swapFee sdk.Dec) (tokenOut sdk.Coin, err error) {
+	tokenOut, updates, err := p.Pool.swapOutAmtGivenIn(p.book, tokenIn, tokenOutDenom, swapFee)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	updates.apply(p.book)
+	return tokenOut, nil
+}
+
+func (p *Pool) swapOutAmtGivenIn(book Book, tokenIn sdk.Coins, tokenOutDenom string, swapFee sdk.Dec) (sdk.Coin, bookUpdates, error) {
+	coinIn, err := validateSwapTokens(tokenIn)
+	if err != nil {
+		return sdk.Coin{}, bookUpdates{}, err
+	}
+	tokenOut, updates, err := p.swapExactAmountIn(book, coinIn, tokenOutDenom, swapFee)
+	if err != nil {
+		return sdk.Coin{}, bookUpdates{}, err
+	}
+	if !tokenOut.Amount.IsPositive() {
+		return sdk.Coin{}, bookUpdates{}, sdkerrors.Wrapf(types.ErrInvalidMathApprox, "token amount must be positive")
+	}
+	return tokenOut, updates, nil
+}
+
+func (p BookPool) CalcInAmtGivenOut(ctx sdk.Context, tokenOut sdk.Coins, tokenInDenom string, swapFee sdk.Dec) (tokenIn sdk.Coin, err error) {
+	poolCopy := p.Pool.copy()
+	tokenIn, _, err = poolCopy.swapInAmtGivenOut(p.book, tokenOut, tokenInDenom, swapFee)
+	return tokenIn, err
+}
+
+func (p *BookPool) SwapInAmtGivenOut(ctx sdk.Context, tokenOut sdk.Coins, tokenInDenom string, swapFee sdk.Dec) (tokenIn sdk.Coin, err error) {
+	tokenIn, updates, err := p.Pool.swapInAmtGivenOut(p.book, tokenOut, tokenInDenom, swapFee)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	updates.apply(p.book)
+	return tokenIn, nil
+}
+
+func (p *Pool) swapInAmtGivenOut(book Book, tokenOut sdk.Coins, tokenInDenom string, swapFee sdk.Dec) (sdk.Coin, bookUpdates, error) {
+	coinOut, err := validateSwapTokens(tokenOut)
+	if err != nil {
+		return sdk.Coin{}, bookUpdates{}, err
+	}
+	if !coinOut.Amount.IsPositive() {
+		return sdk.Coin{}, bookUpdates{}, fmt.Errorf("token out amount must be positive, got %s", coinOut)
+	}
+	return p.swapExactAmountOut(book, coinOut, tokenInDenom, swapFee)
+}
+
+// copy returns a copy of the pool that can be mutated without affecting p.
+func (p Pool) copy() Pool {
+	p.PoolLiquidity = sdk.NewCoins(p.PoolLiquidity...)
+	return p
+}
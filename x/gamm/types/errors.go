@@ -42,6 +42,7 @@ var (
 	ErrTooManyTokensOut         = sdkerrors.Register(ModuleName, 31, "tx is trying to get more tokens out of the pool than exist")
 	ErrSpotPriceOverflow        = sdkerrors.Register(ModuleName, 32, "invalid spot price (overflowed)")
 	ErrSpotPriceInternal        = sdkerrors.Register(ModuleName, 33, "internal spot price error")
+	ErrInvalidSplitRoutes       = sdkerrors.Register(ModuleName, 34, "invalid split routes")
 
 	ErrPoolParamsInvalidDenom     = sdkerrors.Register(ModuleName, 50, "pool params' LBP params has an invalid denomination")
 	ErrPoolParamsInvalidNumDenoms = sdkerrors.Register(ModuleName, 51, "pool params' LBP doesn't have same number of params as underlying pool")
@@ -52,4 +53,22 @@ var (
 	ErrInvalidStableswapScalingFactors = sdkerrors.Register(ModuleName, 62, "length between liquidity and scaling factors mismatch")
 	ErrNotScalingFactorGovernor        = sdkerrors.Register(ModuleName, 63, "not scaling factor governor")
 	ErrInvalidScalingFactors           = sdkerrors.Register(ModuleName, 64, "invalid scaling factor")
+
+	ErrNotOrderbookPool     = sdkerrors.Register(ModuleName, 70, "not orderbook pool")
+	ErrInvalidTick          = sdkerrors.Register(ModuleName, 71, "invalid limit order tick")
+	ErrLimitOrderNotFound   = sdkerrors.Register(ModuleName, 72, "limit order not found")
+	ErrNotLimitOrderOwner   = sdkerrors.Register(ModuleName, 73, "not limit order owner")
+	ErrNothingToClaim       = sdkerrors.Register(ModuleName, 74, "limit order has no proceeds to claim")
+	ErrLimitOrderTooSmall   = sdkerrors.Register(ModuleName, 75, "limit order is smaller than the minimum order size of the pool")
+	ErrInvalidTickSize      = sdkerrors.Register(ModuleName, 76, "tick size should be positive")
+	ErrInvalidMinOrderSize  = sdkerrors.Register(ModuleName, 77, "min order size should be positive")
+	ErrSwapWithoutOrderBook = sdkerrors.Register(ModuleName, 78, "orderbook pool swaps require the order book of the pool")
+
+	ErrNotConcentratedPool  = sdkerrors.Register(ModuleName, 80, "not concentrated liquidity pool")
+	ErrInvalidTickSpacing   = sdkerrors.Register(ModuleName, 81, "invalid tick spacing")
+	ErrInvalidPositionTicks = sdkerrors.Register(ModuleName, 82, "invalid position ticks")
+	ErrPositionNotFound     = sdkerrors.Register(ModuleName, 83, "position not found")
+	ErrNotPositionOwner     = sdkerrors.Register(ModuleName, 84, "not position owner")
+	ErrInvalidLiquidity     = sdkerrors.Register(ModuleName, 85, "invalid position liquidity")
+	ErrNotEnoughLiquidity   = sdkerrors.Register(ModuleName, 86, "not enough liquidity in pool to complete swap")
 )
@@ -6,9 +6,27 @@ const (
 	TypeEvtPoolCreated  = "pool_created"
 	TypeEvtTokenSwapped = "token_swapped"
 
+	TypeEvtSplitRouteSwapped = "split_route_swapped"
+
+	TypeEvtLimitOrderPlaced    = "limit_order_placed"
+	TypeEvtLimitOrderCancelled = "limit_order_cancelled"
+	TypeEvtLimitOrderClaimed   = "limit_order_claimed"
+
+	TypeEvtPositionCreated   = "position_created"
+	TypeEvtPositionWithdrawn = "position_withdrawn"
+	TypeEvtFeesCollected     = "fees_collected"
+
 	AttributeValueCategory = ModuleName
 	AttributeKeyPoolId     = "pool_id"
 	AttributeKeySwapFee    = "swap_fee"
 	AttributeKeyTokensIn   = "tokens_in"
 	AttributeKeyTokensOut  = "tokens_out"
+	AttributeKeyOrderId    = "order_id"
+	AttributeKeyTick       = "tick"
+	AttributeKeyPositionId = "position_id"
+	AttributeKeyLowerTick  = "lower_tick"
+	AttributeKeyUpperTick  = "upper_tick"
+	AttributeKeyLiquidity  = "liquidity"
+	AttributeKeyRoute      = "route"
+	AttributeKeyPoolIds    = "pool_ids"
 )
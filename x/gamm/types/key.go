@@ -25,6 +25,12 @@ var (
 	KeyPrefixPools = []byte{0x02}
 	// KeyTotalLiquidity defines key to store total liquidity.
 	KeyTotalLiquidity = []byte{0x03}
+	// KeyPrefixLimitOrders defines prefix to store the limit orders of orderbook pools.
+	KeyPrefixLimitOrders = []byte{0x04}
+	// KeyPrefixOrderbookTicks defines prefix to store the order queues of the ticks of orderbook pools.
+	KeyPrefixOrderbookTicks = []byte{0x05}
+	// KeyPrefixPositions defines prefix to store the positions of concentrated liquidity pools.
+	KeyPrefixPositions = []byte{0x06}
 )
 
 func MustGetPoolIdFromShareDenom(denom string) uint64 {
@@ -56,3 +62,34 @@ func GetPoolShareDenom(poolId uint64) string {
 func GetKeyPrefixPools(poolId uint64) []byte {
 	return append(KeyPrefixPools, sdk.Uint64ToBigEndian(poolId)...)
 }
+
+// GetKeyPrefixLimitOrders returns the prefix of the limit orders of an orderbook pool.
+func GetKeyPrefixLimitOrders(poolId uint64) []byte {
+	return append(KeyPrefixLimitOrders, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// GetKeyLimitOrder returns the key of a limit order of an orderbook pool.
+func GetKeyLimitOrder(poolId uint64, orderId uint64) []byte {
+	return append(GetKeyPrefixLimitOrders(poolId), sdk.Uint64ToBigEndian(orderId)...)
+}
+
+// GetKeyPrefixOrderbookTicks returns the prefix of the ticks of one side of the book of an orderbook pool.
+// Ticks under this prefix are sorted by ascending index.
+func GetKeyPrefixOrderbookTicks(poolId uint64, direction int32) []byte {
+	return append(append(KeyPrefixOrderbookTicks, sdk.Uint64ToBigEndian(poolId)...), byte(direction))
+}
+
+// GetKeyOrderbookTick returns the key of a tick of an orderbook pool. Tick indexes are positive.
+func GetKeyOrderbookTick(poolId uint64, direction int32, index int64) []byte {
+	return append(GetKeyPrefixOrderbookTicks(poolId, direction), sdk.Uint64ToBigEndian(uint64(index))...)
+}
+
+// GetKeyPrefixPositions returns the prefix of the positions of a concentrated liquidity pool.
+func GetKeyPrefixPositions(poolId uint64) []byte {
+	return append(KeyPrefixPositions, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// GetKeyPosition returns the key of a position of a concentrated liquidity pool.
+func GetKeyPosition(poolId uint64, positionId uint64) []byte {
+	return append(GetKeyPrefixPositions(poolId), sdk.Uint64ToBigEndian(positionId)...)
+}
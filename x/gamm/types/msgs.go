@@ -11,14 +11,15 @@ import (
 
 // constants.
 const (
-	TypeMsgSwapExactAmountIn       = "swap_exact_amount_in"
-	TypeMsgSwapExactAmountOut      = "swap_exact_amount_out"
-	TypeMsgJoinPool                = "join_pool"
-	TypeMsgExitPool                = "exit_pool"
-	TypeMsgJoinSwapExternAmountIn  = "join_swap_extern_amount_in"
-	TypeMsgJoinSwapShareAmountOut  = "join_swap_share_amount_out"
-	TypeMsgExitSwapExternAmountOut = "exit_swap_extern_amount_out"
-	TypeMsgExitSwapShareAmountIn   = "exit_swap_share_amount_in"
+	TypeMsgSwapExactAmountIn           = "swap_exact_amount_in"
+	TypeMsgSwapExactAmountOut          = "swap_exact_amount_out"
+	TypeMsgSplitRouteSwapExactAmountIn = "split_route_swap_exact_amount_in"
+	TypeMsgJoinPool                    = "join_pool"
+	TypeMsgExitPool                    = "exit_pool"
+	TypeMsgJoinSwapExternAmountIn      = "join_swap_extern_amount_in"
+	TypeMsgJoinSwapShareAmountOut      = "join_swap_share_amount_out"
+	TypeMsgExitSwapExternAmountOut     = "exit_swap_extern_amount_out"
+	TypeMsgExitSwapShareAmountIn       = "exit_swap_share_amount_in"
 )
 
 func ValidateFutureGovernor(governor string) error {
@@ -138,6 +139,45 @@ func (msg MsgSwapExactAmountOut) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sender}
 }
 
+var _ sdk.Msg = &MsgSplitRouteSwapExactAmountIn{}
+
+func (msg MsgSplitRouteSwapExactAmountIn) Route() string { return RouterKey }
+func (msg MsgSplitRouteSwapExactAmountIn) Type() string  { return TypeMsgSplitRouteSwapExactAmountIn }
+func (msg MsgSplitRouteSwapExactAmountIn) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	err = SwapAmountInSplitRoutes(msg.Routes).Validate()
+	if err != nil {
+		return err
+	}
+
+	err = sdk.ValidateDenom(msg.TokenInDenom)
+	if err != nil {
+		return err
+	}
+
+	if msg.TokenOutMinAmount.IsNil() || !msg.TokenOutMinAmount.IsPositive() {
+		return ErrNotPositiveCriteria
+	}
+
+	return nil
+}
+
+func (msg MsgSplitRouteSwapExactAmountIn) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSplitRouteSwapExactAmountIn) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
 var _ sdk.Msg = &MsgJoinPool{}
 
 func (msg MsgJoinPool) Route() string { return RouterKey }
@@ -273,6 +273,137 @@ func TestMsgSwapExactAmountOut(t *testing.T) {
 	}
 }
 
+func TestMsgSplitRouteSwapExactAmountIn(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	createMsg := func(after func(msg gammtypes.MsgSplitRouteSwapExactAmountIn) gammtypes.MsgSplitRouteSwapExactAmountIn) gammtypes.MsgSplitRouteSwapExactAmountIn {
+		properMsg := gammtypes.MsgSplitRouteSwapExactAmountIn{
+			Sender: addr1,
+			Routes: []gammtypes.SwapAmountInSplitRoute{{
+				Pools: []gammtypes.SwapAmountInRoute{{
+					PoolId:        0,
+					TokenOutDenom: "test2",
+				}, {
+					PoolId:        1,
+					TokenOutDenom: "test3",
+				}},
+				TokenInAmount: sdk.NewInt(100),
+			}, {
+				Pools: []gammtypes.SwapAmountInRoute{{
+					PoolId:        2,
+					TokenOutDenom: "test3",
+				}},
+				TokenInAmount: sdk.NewInt(50),
+			}},
+			TokenInDenom:      "test",
+			TokenOutMinAmount: sdk.NewInt(200),
+		}
+
+		return after(properMsg)
+	}
+
+	msg := createMsg(func(msg gammtypes.MsgSplitRouteSwapExactAmountIn) gammtypes.MsgSplitRouteSwapExactAmountIn {
+		// Do nothing
+		return msg
+	})
+
+	require.Equal(t, msg.Route(), gammtypes.RouterKey)
+	require.Equal(t, msg.Type(), "split_route_swap_exact_amount_in")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	tests := []struct {
+		name       string
+		msg        gammtypes.MsgSplitRouteSwapExactAmountIn
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg gammtypes.MsgSplitRouteSwapExactAmountIn) gammtypes.MsgSplitRouteSwapExactAmountIn {
+				// Do nothing
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: createMsg(func(msg gammtypes.MsgSplitRouteSwapExactAmountIn) gammtypes.MsgSplitRouteSwapExactAmountIn {
+				msg.Sender = invalidAddr.String()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "empty routes",
+			msg: createMsg(func(msg gammtypes.MsgSplitRouteSwapExactAmountIn) gammtypes.MsgSplitRouteSwapExactAmountIn {
+				msg.Routes = nil
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "route with no pools",
+			msg: createMsg(func(msg gammtypes.MsgSplitRouteSwapExactAmountIn) gammtypes.MsgSplitRouteSwapExactAmountIn {
+				msg.Routes[1].Pools = nil
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "routes ending in different denoms",
+			msg: createMsg(func(msg gammtypes.MsgSplitRouteSwapExactAmountIn) gammtypes.MsgSplitRouteSwapExactAmountIn {
+				msg.Routes[1].Pools[0].TokenOutDenom = "test2"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: createMsg(func(msg gammtypes.MsgSplitRouteSwapExactAmountIn) gammtypes.MsgSplitRouteSwapExactAmountIn {
+				msg.TokenInDenom = "1"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero route amount",
+			msg: createMsg(func(msg gammtypes.MsgSplitRouteSwapExactAmountIn) gammtypes.MsgSplitRouteSwapExactAmountIn {
+				msg.Routes[1].TokenInAmount = sdk.NewInt(0)
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "negative route amount",
+			msg: createMsg(func(msg gammtypes.MsgSplitRouteSwapExactAmountIn) gammtypes.MsgSplitRouteSwapExactAmountIn {
+				msg.Routes[0].TokenInAmount = sdk.NewInt(-10)
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero amount criteria",
+			msg: createMsg(func(msg gammtypes.MsgSplitRouteSwapExactAmountIn) gammtypes.MsgSplitRouteSwapExactAmountIn {
+				msg.TokenOutMinAmount = sdk.NewInt(0)
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
 func TestMsgJoinPool(t *testing.T) {
 	appParams.SetAddressPrefixes()
 	pk1 := ed25519.GenPrivKey().PubKey()
@@ -956,6 +1087,21 @@ func TestAuthzMsg(t *testing.T) {
 				TokenInMaxAmount: sdk.NewInt(1),
 			},
 		},
+		{
+			name: "MsgSplitRouteSwapExactAmountIn",
+			gammMsg: &gammtypes.MsgSplitRouteSwapExactAmountIn{
+				Sender: addr1,
+				Routes: []gammtypes.SwapAmountInSplitRoute{{
+					Pools: []gammtypes.SwapAmountInRoute{{
+						PoolId:        0,
+						TokenOutDenom: "test",
+					}},
+					TokenInAmount: sdk.NewInt(1),
+				}},
+				TokenInDenom:      sdk.DefaultBondDenom,
+				TokenOutMinAmount: sdk.NewInt(1),
+			},
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -2,6 +2,8 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
 	appparams "github.com/osmosis-labs/osmosis/v12/app/params"
 )
 
@@ -46,3 +48,50 @@ func (routes SwapAmountOutRoutes) Validate() error {
 
 	return nil
 }
+
+type SwapAmountInSplitRoutes []SwapAmountInSplitRoute
+
+// Validate checks that there is at least one route, that every route swaps a positive amount
+// through a valid sequence of pools, and that all of the routes end in the same token out.
+func (routes SwapAmountInSplitRoutes) Validate() error {
+	if len(routes) == 0 {
+		return ErrEmptyRoutes
+	}
+
+	tokenOutDenom := routes.TokenOutDenom()
+	for i, route := range routes {
+		err := SwapAmountInRoutes(route.Pools).Validate()
+		if err != nil {
+			return err
+		}
+
+		if route.TokenInAmount.IsNil() || !route.TokenInAmount.IsPositive() {
+			return sdkerrors.Wrapf(ErrInvalidSplitRoutes, "route %d swaps a non positive amount", i)
+		}
+
+		if routeOutDenom := route.Pools[len(route.Pools)-1].TokenOutDenom; routeOutDenom != tokenOutDenom {
+			return sdkerrors.Wrapf(ErrInvalidSplitRoutes,
+				"route %d ends in %s, while route 0 ends in %s", i, routeOutDenom, tokenOutDenom)
+		}
+	}
+
+	return nil
+}
+
+// TokenOutDenom returns the denom that the first route ends in.
+func (routes SwapAmountInSplitRoutes) TokenOutDenom() string {
+	if len(routes) == 0 || len(routes[0].Pools) == 0 {
+		return ""
+	}
+	firstRoute := routes[0].Pools
+	return firstRoute[len(firstRoute)-1].TokenOutDenom
+}
+
+// TokenInAmount returns the total amount swapped over all of the routes.
+func (routes SwapAmountInSplitRoutes) TokenInAmount() sdk.Int {
+	total := sdk.ZeroInt()
+	for _, route := range routes {
+		total = total.Add(route.TokenInAmount)
+	}
+	return total
+}
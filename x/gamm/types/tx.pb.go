@@ -546,6 +546,157 @@ func (m *MsgSwapExactAmountOutResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSwapExactAmountOutResponse proto.InternalMessageInfo
 
+// ===================== MsgSplitRouteSwapExactAmountIn
+// SwapAmountInSplitRoute is one of the routes of a split route swap, along with
+// the amount of the token in swapped through it.
+type SwapAmountInSplitRoute struct {
+	Pools         []SwapAmountInRoute                    `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools" yaml:"pools"`
+	TokenInAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=token_in_amount,json=tokenInAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"token_in_amount" yaml:"token_in_amount"`
+}
+
+func (m *SwapAmountInSplitRoute) Reset()         { *m = SwapAmountInSplitRoute{} }
+func (m *SwapAmountInSplitRoute) String() string { return proto.CompactTextString(m) }
+func (*SwapAmountInSplitRoute) ProtoMessage()    {}
+func (*SwapAmountInSplitRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfc8fd3ac7df3247, []int{10}
+}
+func (m *SwapAmountInSplitRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwapAmountInSplitRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapAmountInSplitRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SwapAmountInSplitRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapAmountInSplitRoute.Merge(m, src)
+}
+func (m *SwapAmountInSplitRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwapAmountInSplitRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapAmountInSplitRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwapAmountInSplitRoute proto.InternalMessageInfo
+
+func (m *SwapAmountInSplitRoute) GetPools() []SwapAmountInRoute {
+	if m != nil {
+		return m.Pools
+	}
+	return nil
+}
+
+// MsgSplitRouteSwapExactAmountIn swaps the token in through several routes at
+// once, all ending in the same token out. The swap fails unless the total
+// amount out over all of the routes is at least token_out_min_amount.
+type MsgSplitRouteSwapExactAmountIn struct {
+	Sender            string                                 `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	Routes            []SwapAmountInSplitRoute               `protobuf:"bytes,2,rep,name=routes,proto3" json:"routes"`
+	TokenInDenom      string                                 `protobuf:"bytes,3,opt,name=token_in_denom,json=tokenInDenom,proto3" json:"token_in_denom,omitempty" yaml:"token_in_denom"`
+	TokenOutMinAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=token_out_min_amount,json=tokenOutMinAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"token_out_min_amount" yaml:"token_out_min_amount"`
+}
+
+func (m *MsgSplitRouteSwapExactAmountIn) Reset()         { *m = MsgSplitRouteSwapExactAmountIn{} }
+func (m *MsgSplitRouteSwapExactAmountIn) String() string { return proto.CompactTextString(m) }
+func (*MsgSplitRouteSwapExactAmountIn) ProtoMessage()    {}
+func (*MsgSplitRouteSwapExactAmountIn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfc8fd3ac7df3247, []int{11}
+}
+func (m *MsgSplitRouteSwapExactAmountIn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSplitRouteSwapExactAmountIn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSplitRouteSwapExactAmountIn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSplitRouteSwapExactAmountIn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSplitRouteSwapExactAmountIn.Merge(m, src)
+}
+func (m *MsgSplitRouteSwapExactAmountIn) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSplitRouteSwapExactAmountIn) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSplitRouteSwapExactAmountIn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSplitRouteSwapExactAmountIn proto.InternalMessageInfo
+
+func (m *MsgSplitRouteSwapExactAmountIn) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSplitRouteSwapExactAmountIn) GetRoutes() []SwapAmountInSplitRoute {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
+func (m *MsgSplitRouteSwapExactAmountIn) GetTokenInDenom() string {
+	if m != nil {
+		return m.TokenInDenom
+	}
+	return ""
+}
+
+type MsgSplitRouteSwapExactAmountInResponse struct {
+	TokenOutAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=token_out_amount,json=tokenOutAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"token_out_amount" yaml:"token_out_amount"`
+}
+
+func (m *MsgSplitRouteSwapExactAmountInResponse) Reset() {
+	*m = MsgSplitRouteSwapExactAmountInResponse{}
+}
+func (m *MsgSplitRouteSwapExactAmountInResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSplitRouteSwapExactAmountInResponse) ProtoMessage()    {}
+func (*MsgSplitRouteSwapExactAmountInResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfc8fd3ac7df3247, []int{12}
+}
+func (m *MsgSplitRouteSwapExactAmountInResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSplitRouteSwapExactAmountInResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSplitRouteSwapExactAmountInResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSplitRouteSwapExactAmountInResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSplitRouteSwapExactAmountInResponse.Merge(m, src)
+}
+func (m *MsgSplitRouteSwapExactAmountInResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSplitRouteSwapExactAmountInResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSplitRouteSwapExactAmountInResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSplitRouteSwapExactAmountInResponse proto.InternalMessageInfo
+
 // ===================== MsgJoinSwapExternAmountIn
 // TODO: Rename to MsgJoinSwapExactAmountIn
 type MsgJoinSwapExternAmountIn struct {
@@ -559,7 +710,7 @@ func (m *MsgJoinSwapExternAmountIn) Reset()         { *m = MsgJoinSwapExternAmou
 func (m *MsgJoinSwapExternAmountIn) String() string { return proto.CompactTextString(m) }
 func (*MsgJoinSwapExternAmountIn) ProtoMessage()    {}
 func (*MsgJoinSwapExternAmountIn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cfc8fd3ac7df3247, []int{10}
+	return fileDescriptor_cfc8fd3ac7df3247, []int{13}
 }
 func (m *MsgJoinSwapExternAmountIn) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -617,7 +768,7 @@ func (m *MsgJoinSwapExternAmountInResponse) Reset()         { *m = MsgJoinSwapEx
 func (m *MsgJoinSwapExternAmountInResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgJoinSwapExternAmountInResponse) ProtoMessage()    {}
 func (*MsgJoinSwapExternAmountInResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cfc8fd3ac7df3247, []int{11}
+	return fileDescriptor_cfc8fd3ac7df3247, []int{14}
 }
 func (m *MsgJoinSwapExternAmountInResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -659,7 +810,7 @@ func (m *MsgJoinSwapShareAmountOut) Reset()         { *m = MsgJoinSwapShareAmoun
 func (m *MsgJoinSwapShareAmountOut) String() string { return proto.CompactTextString(m) }
 func (*MsgJoinSwapShareAmountOut) ProtoMessage()    {}
 func (*MsgJoinSwapShareAmountOut) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cfc8fd3ac7df3247, []int{12}
+	return fileDescriptor_cfc8fd3ac7df3247, []int{15}
 }
 func (m *MsgJoinSwapShareAmountOut) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -717,7 +868,7 @@ func (m *MsgJoinSwapShareAmountOutResponse) Reset()         { *m = MsgJoinSwapSh
 func (m *MsgJoinSwapShareAmountOutResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgJoinSwapShareAmountOutResponse) ProtoMessage()    {}
 func (*MsgJoinSwapShareAmountOutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cfc8fd3ac7df3247, []int{13}
+	return fileDescriptor_cfc8fd3ac7df3247, []int{16}
 }
 func (m *MsgJoinSwapShareAmountOutResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -759,7 +910,7 @@ func (m *MsgExitSwapShareAmountIn) Reset()         { *m = MsgExitSwapShareAmount
 func (m *MsgExitSwapShareAmountIn) String() string { return proto.CompactTextString(m) }
 func (*MsgExitSwapShareAmountIn) ProtoMessage()    {}
 func (*MsgExitSwapShareAmountIn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cfc8fd3ac7df3247, []int{14}
+	return fileDescriptor_cfc8fd3ac7df3247, []int{17}
 }
 func (m *MsgExitSwapShareAmountIn) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -817,7 +968,7 @@ func (m *MsgExitSwapShareAmountInResponse) Reset()         { *m = MsgExitSwapSha
 func (m *MsgExitSwapShareAmountInResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgExitSwapShareAmountInResponse) ProtoMessage()    {}
 func (*MsgExitSwapShareAmountInResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cfc8fd3ac7df3247, []int{15}
+	return fileDescriptor_cfc8fd3ac7df3247, []int{18}
 }
 func (m *MsgExitSwapShareAmountInResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -858,7 +1009,7 @@ func (m *MsgExitSwapExternAmountOut) Reset()         { *m = MsgExitSwapExternAmo
 func (m *MsgExitSwapExternAmountOut) String() string { return proto.CompactTextString(m) }
 func (*MsgExitSwapExternAmountOut) ProtoMessage()    {}
 func (*MsgExitSwapExternAmountOut) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cfc8fd3ac7df3247, []int{16}
+	return fileDescriptor_cfc8fd3ac7df3247, []int{19}
 }
 func (m *MsgExitSwapExternAmountOut) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -916,7 +1067,7 @@ func (m *MsgExitSwapExternAmountOutResponse) Reset()         { *m = MsgExitSwapE
 func (m *MsgExitSwapExternAmountOutResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgExitSwapExternAmountOutResponse) ProtoMessage()    {}
 func (*MsgExitSwapExternAmountOutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cfc8fd3ac7df3247, []int{17}
+	return fileDescriptor_cfc8fd3ac7df3247, []int{20}
 }
 func (m *MsgExitSwapExternAmountOutResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -956,6 +1107,9 @@ func init() {
 	proto.RegisterType((*SwapAmountOutRoute)(nil), "osmosis.gamm.v1beta1.SwapAmountOutRoute")
 	proto.RegisterType((*MsgSwapExactAmountOut)(nil), "osmosis.gamm.v1beta1.MsgSwapExactAmountOut")
 	proto.RegisterType((*MsgSwapExactAmountOutResponse)(nil), "osmosis.gamm.v1beta1.MsgSwapExactAmountOutResponse")
+	proto.RegisterType((*SwapAmountInSplitRoute)(nil), "osmosis.gamm.v1beta1.SwapAmountInSplitRoute")
+	proto.RegisterType((*MsgSplitRouteSwapExactAmountIn)(nil), "osmosis.gamm.v1beta1.MsgSplitRouteSwapExactAmountIn")
+	proto.RegisterType((*MsgSplitRouteSwapExactAmountInResponse)(nil), "osmosis.gamm.v1beta1.MsgSplitRouteSwapExactAmountInResponse")
 	proto.RegisterType((*MsgJoinSwapExternAmountIn)(nil), "osmosis.gamm.v1beta1.MsgJoinSwapExternAmountIn")
 	proto.RegisterType((*MsgJoinSwapExternAmountInResponse)(nil), "osmosis.gamm.v1beta1.MsgJoinSwapExternAmountInResponse")
 	proto.RegisterType((*MsgJoinSwapShareAmountOut)(nil), "osmosis.gamm.v1beta1.MsgJoinSwapShareAmountOut")
@@ -969,78 +1123,83 @@ func init() {
 func init() { proto.RegisterFile("osmosis/gamm/v1beta1/tx.proto", fileDescriptor_cfc8fd3ac7df3247) }
 
 var fileDescriptor_cfc8fd3ac7df3247 = []byte{
-	// 1122 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x4d, 0x6f, 0x1b, 0xc5,
-	0x1b, 0xcf, 0xd8, 0x6e, 0x5e, 0x26, 0xcd, 0xdb, 0x36, 0x69, 0x9c, 0x6d, 0x6b, 0xa7, 0xf3, 0xff,
-	0x0b, 0x52, 0xaa, 0xee, 0x36, 0xa9, 0x04, 0x88, 0x0b, 0x60, 0x08, 0xc2, 0x15, 0x96, 0xab, 0xed,
-	0xa5, 0xe2, 0x62, 0xad, 0xe3, 0x95, 0xbb, 0x6a, 0x76, 0xc6, 0xf2, 0xcc, 0x06, 0x57, 0x48, 0x20,
-	0xf1, 0x72, 0x07, 0x21, 0x5e, 0x3e, 0x01, 0xe2, 0x2b, 0x70, 0x80, 0x03, 0x5c, 0x7a, 0xec, 0x0d,
-	0xca, 0xc1, 0x42, 0xc9, 0x37, 0xf0, 0x27, 0x40, 0xbb, 0x33, 0xfb, 0xea, 0xdd, 0xd8, 0x9b, 0xd8,
-	0xcd, 0x29, 0xf1, 0xce, 0xf3, 0xfe, 0xfc, 0xe6, 0xf7, 0x3c, 0xbb, 0xf0, 0x06, 0xa1, 0x16, 0xa1,
-	0x26, 0x55, 0xdb, 0xba, 0x65, 0xa9, 0x47, 0xbb, 0x4d, 0x83, 0xe9, 0xbb, 0x2a, 0xeb, 0x29, 0x9d,
-	0x2e, 0x61, 0x44, 0x5a, 0x17, 0xc7, 0x8a, 0x73, 0xac, 0x88, 0x63, 0x79, 0xbd, 0x4d, 0xda, 0xc4,
-	0x15, 0x50, 0x9d, 0xff, 0xb8, 0xac, 0x5c, 0x3a, 0x70, 0x85, 0xd5, 0xa6, 0x4e, 0x0d, 0xdf, 0xd2,
-	0x01, 0x31, 0x31, 0x3f, 0x47, 0xbf, 0xe5, 0xe0, 0x62, 0x8d, 0xb6, 0xef, 0x13, 0x13, 0x3f, 0x20,
-	0xe4, 0x50, 0xba, 0x05, 0x67, 0xa9, 0x81, 0x5b, 0x46, 0xb7, 0x08, 0xb6, 0xc1, 0xce, 0x42, 0x65,
-	0x6d, 0xd0, 0x2f, 0x2f, 0x3d, 0xd5, 0xad, 0xc3, 0xb7, 0x10, 0x7f, 0x8e, 0x34, 0x21, 0x20, 0xdd,
-	0x86, 0x73, 0x1d, 0x42, 0x0e, 0x1b, 0x66, 0xab, 0x98, 0xdb, 0x06, 0x3b, 0x85, 0x8a, 0x34, 0xe8,
-	0x97, 0x97, 0xb9, 0xac, 0x38, 0x40, 0xda, 0xac, 0xf3, 0x5f, 0xb5, 0x25, 0x75, 0xe1, 0x2a, 0x7d,
-	0xac, 0x77, 0x8d, 0x06, 0xb1, 0x59, 0x43, 0xb7, 0x88, 0x8d, 0x59, 0x31, 0xef, 0x7a, 0xf8, 0xf0,
-	0x59, 0xbf, 0x3c, 0xf3, 0x4f, 0xbf, 0xfc, 0x4a, 0xdb, 0x64, 0x8f, 0xed, 0xa6, 0x72, 0x40, 0x2c,
-	0x55, 0x04, 0xcd, 0xff, 0xdc, 0xa1, 0xad, 0x27, 0x2a, 0x7b, 0xda, 0x31, 0xa8, 0x52, 0xc5, 0x6c,
-	0xd0, 0x2f, 0x5f, 0x0d, 0xf9, 0xe0, 0xa6, 0x1c, 0xab, 0x48, 0x5b, 0x76, 0x3d, 0xd4, 0x6d, 0xf6,
-	0xae, 0xfb, 0x50, 0x6a, 0xc2, 0x25, 0x46, 0x9e, 0x18, 0xb8, 0x61, 0xe2, 0x86, 0xa5, 0xf7, 0x68,
-	0xb1, 0xb0, 0x9d, 0xdf, 0x59, 0xdc, 0xdb, 0x52, 0xb8, 0x5d, 0xc5, 0xa9, 0x89, 0x57, 0x3e, 0xe5,
-	0x3d, 0x62, 0xe2, 0xca, 0xff, 0x9c, 0x58, 0x06, 0xfd, 0xf2, 0x35, 0xee, 0x21, 0xac, 0x2d, 0x3c,
-	0x51, 0xa4, 0x2d, 0xba, 0x8f, 0xab, 0xb8, 0xa6, 0xf7, 0x28, 0x7a, 0x01, 0xe0, 0x95, 0x50, 0xfd,
-	0x34, 0x83, 0x76, 0x08, 0xa6, 0x86, 0x44, 0x13, 0xf2, 0xe5, 0x15, 0xad, 0x66, 0xce, 0x77, 0x53,
-	0xd4, 0x3f, 0x66, 0x6f, 0x38, 0xe1, 0x1a, 0x9c, 0xf7, 0x42, 0x2e, 0xe6, 0x46, 0xe5, 0xba, 0x29,
-	0x72, 0x5d, 0x89, 0xe6, 0x8a, 0xb4, 0x39, 0x91, 0x1f, 0xfa, 0x9d, 0x63, 0x63, 0xbf, 0x67, 0xb2,
-	0xa9, 0x62, 0xa3, 0x03, 0x57, 0x78, 0x6e, 0x26, 0x9e, 0x10, 0x34, 0x62, 0xe6, 0x90, 0xb6, 0xe4,
-	0x3e, 0xa9, 0x62, 0x51, 0x28, 0x03, 0x2e, 0xf3, 0x7c, 0x9d, 0x6a, 0x5a, 0x26, 0x1e, 0x03, 0x1a,
-	0xff, 0x17, 0xe5, 0xba, 0x1e, 0x2e, 0x97, 0x50, 0x0f, 0xb0, 0x71, 0xd9, 0x7d, 0x5e, 0xb7, 0x59,
-	0xcd, 0xc4, 0x14, 0xb5, 0x5d, 0x6c, 0x78, 0xf5, 0xf3, 0xb1, 0xf1, 0x00, 0x2e, 0xf8, 0xea, 0x45,
-	0x30, 0xca, 0x71, 0x51, 0x38, 0x5e, 0x8d, 0x39, 0x46, 0xda, 0xbc, 0xe7, 0x0c, 0x7d, 0x05, 0xe0,
-	0xda, 0xc3, 0x4f, 0xf4, 0x0e, 0x4f, 0xaf, 0x8a, 0x35, 0x62, 0x33, 0x23, 0xdc, 0x04, 0x30, 0xb2,
-	0x09, 0x15, 0xb8, 0x12, 0xe4, 0xd4, 0x32, 0x30, 0xb1, 0xdc, 0xce, 0x2d, 0x54, 0xe4, 0xa0, 0xac,
-	0x31, 0x01, 0xa4, 0x2d, 0x79, 0x11, 0xbc, 0xef, 0xfe, 0xfe, 0x2b, 0x07, 0xd7, 0x6b, 0xb4, 0xed,
-	0x44, 0xb2, 0xdf, 0xd3, 0x0f, 0x98, 0x17, 0x4e, 0x16, 0xe4, 0xec, 0xc3, 0xd9, 0xae, 0x13, 0x3d,
-	0x15, 0x08, 0x7e, 0x55, 0x49, 0x62, 0x3b, 0x65, 0x28, 0xdb, 0x4a, 0xc1, 0xa9, 0x93, 0x26, 0x94,
-	0x23, 0x57, 0xc1, 0x01, 0xd3, 0xf9, 0xae, 0x82, 0xf4, 0x19, 0x5c, 0x4f, 0xea, 0x78, 0xb1, 0xe0,
-	0xa6, 0x53, 0xcb, 0x8c, 0xd3, 0x6b, 0xe9, 0x28, 0x42, 0xda, 0x5a, 0x08, 0x44, 0x3c, 0x47, 0xf4,
-	0x1d, 0x80, 0xd7, 0x93, 0x2a, 0x1b, 0xe6, 0x9b, 0xc0, 0xd8, 0x64, 0xf8, 0x26, 0x6e, 0x0f, 0x69,
-	0xcb, 0x5e, 0x60, 0x22, 0xaa, 0x2f, 0x01, 0x94, 0x82, 0x46, 0xd4, 0x6d, 0x76, 0x06, 0xdc, 0xbd,
-	0xe3, 0x5d, 0x45, 0x13, 0x8f, 0x0d, 0xbb, 0xcb, 0xa2, 0x2d, 0x1c, 0x75, 0x2f, 0x72, 0x70, 0x63,
-	0xb8, 0x36, 0x75, 0x9b, 0x65, 0x81, 0xdd, 0x07, 0x31, 0xd8, 0xed, 0x8c, 0x82, 0x9d, 0x97, 0x6d,
-	0x0c, 0x77, 0x9f, 0xc2, 0x2b, 0x09, 0x53, 0x43, 0xf0, 0xd9, 0x47, 0x99, 0x5b, 0x21, 0xa7, 0x0e,
-	0x22, 0xa4, 0xad, 0x06, 0x73, 0x48, 0xd0, 0x5a, 0x84, 0x58, 0x0a, 0xa3, 0x50, 0x3f, 0x0e, 0xb1,
-	0x7c, 0x0b, 0xe0, 0x8d, 0xc4, 0xda, 0xfa, 0xc0, 0xeb, 0x78, 0xbc, 0x11, 0x5c, 0x0a, 0x70, 0x3e,
-	0xf2, 0x8e, 0x99, 0xf3, 0x58, 0xc6, 0x23, 0x6f, 0xf4, 0x47, 0x0e, 0x6e, 0x89, 0x91, 0xcb, 0xe3,
-	0x62, 0x46, 0x17, 0x9f, 0x85, 0x6a, 0x32, 0x0d, 0xa9, 0xc9, 0x13, 0x4a, 0x30, 0xcf, 0x27, 0x47,
-	0x28, 0x49, 0x36, 0x91, 0xb6, 0xe6, 0xed, 0x09, 0x01, 0xa1, 0xfc, 0x04, 0xe0, 0xcd, 0xd4, 0x22,
-	0x5e, 0xe8, 0x16, 0x83, 0x7e, 0xce, 0x47, 0xfa, 0xfb, 0xd0, 0x39, 0x3d, 0xd3, 0x9d, 0xce, 0xd4,
-	0xdf, 0xb7, 0x87, 0x78, 0x88, 0xdf, 0xd9, 0xad, 0x41, 0xbf, 0xbc, 0x11, 0x03, 0x66, 0x12, 0x0d,
-	0x25, 0xd6, 0xaa, 0x30, 0xed, 0x8d, 0x2f, 0x85, 0x6e, 0x2e, 0xbd, 0x0c, 0xba, 0x41, 0xdf, 0x47,
-	0x31, 0x14, 0x6d, 0xd4, 0x05, 0x12, 0xc4, 0x2f, 0x79, 0x58, 0x14, 0x7b, 0x57, 0x2c, 0xae, 0x29,
-	0xf2, 0x43, 0xc2, 0xfe, 0x94, 0xcf, 0xb8, 0x3f, 0x25, 0x2d, 0xc2, 0x85, 0xe9, 0x2e, 0xc2, 0x69,
-	0x7b, 0xcd, 0xa5, 0x97, 0xb4, 0xd7, 0xfc, 0x08, 0xe0, 0x76, 0x5a, 0xab, 0x2e, 0x76, 0xb7, 0xf9,
-	0x33, 0x07, 0xe5, 0x50, 0x64, 0x61, 0x82, 0x9c, 0x26, 0x0d, 0x45, 0x46, 0x78, 0x7e, 0x02, 0x23,
-	0xdc, 0xa1, 0x08, 0x1f, 0x05, 0x21, 0x8a, 0x28, 0x9c, 0x8f, 0x22, 0x12, 0x4c, 0x22, 0x6d, 0x55,
-	0x80, 0x2b, 0xa0, 0x88, 0x1f, 0x00, 0x44, 0xe9, 0x55, 0x0c, 0x73, 0x44, 0x1c, 0xf8, 0x60, 0xaa,
-	0xc0, 0xdf, 0xfb, 0x75, 0x0e, 0xe6, 0x6b, 0xb4, 0x2d, 0x3d, 0x82, 0xf3, 0xfe, 0xb7, 0x8f, 0x9b,
-	0xc9, 0x3b, 0x5f, 0xe8, 0xf5, 0x5e, 0xbe, 0x35, 0x52, 0xc4, 0xcf, 0xe9, 0x11, 0x9c, 0xf7, 0xdf,
-	0x9c, 0xd3, 0x2d, 0x7b, 0x22, 0xa7, 0x58, 0x1e, 0x7a, 0x7f, 0xa4, 0xfc, 0x65, 0x2f, 0xfa, 0x8a,
-	0xf5, 0x5a, 0xaa, 0xfe, 0x90, 0xac, 0xbc, 0x37, 0xbe, 0xac, 0xef, 0xf4, 0x88, 0xaf, 0xfa, 0xb1,
-	0x0d, 0xfb, 0xf6, 0xb8, 0x96, 0xea, 0x36, 0x93, 0xef, 0x65, 0x10, 0xf6, 0xfd, 0x7e, 0x01, 0xe0,
-	0xd5, 0x94, 0x55, 0x4f, 0x3d, 0xb5, 0x19, 0xc3, 0x0a, 0xf2, 0x1b, 0x19, 0x15, 0x12, 0x83, 0x88,
-	0xed, 0x23, 0xa3, 0x83, 0x88, 0x2a, 0x8c, 0x11, 0x44, 0xca, 0x20, 0xfd, 0x1a, 0xc0, 0xcd, 0x34,
-	0x3a, 0xba, 0x7b, 0x2a, 0x7a, 0x12, 0x34, 0xe4, 0x37, 0xb3, 0x6a, 0xf8, 0x71, 0x7c, 0x0e, 0x37,
-	0x92, 0x47, 0xab, 0x32, 0xd2, 0x64, 0x44, 0x5e, 0x7e, 0x3d, 0x9b, 0xbc, 0x17, 0x40, 0xe5, 0xfe,
-	0xb3, 0xe3, 0x12, 0x78, 0x7e, 0x5c, 0x02, 0xff, 0x1e, 0x97, 0xc0, 0x37, 0x27, 0xa5, 0x99, 0xe7,
-	0x27, 0xa5, 0x99, 0xbf, 0x4f, 0x4a, 0x33, 0x1f, 0xdf, 0x0d, 0xd1, 0x84, 0xb0, 0x7d, 0xe7, 0x50,
-	0x6f, 0x52, 0xef, 0x87, 0x7a, 0xb4, 0xbb, 0xa7, 0xf6, 0xf8, 0x67, 0x55, 0x97, 0x34, 0x9a, 0xb3,
-	0xee, 0x67, 0xd0, 0x7b, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0xa6, 0xf5, 0x0b, 0xf1, 0x73, 0x15,
-	0x00, 0x00,
+	// 1211 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0xdb, 0x6f, 0xdb, 0x54,
+	0x18, 0xef, 0x49, 0xd2, 0xae, 0xfd, 0x7a, 0x77, 0x6f, 0xae, 0xbb, 0x25, 0xdd, 0x01, 0x8d, 0x96,
+	0x31, 0x7b, 0xed, 0x10, 0x43, 0x08, 0x09, 0x08, 0x14, 0x91, 0x8a, 0x28, 0x93, 0xfb, 0x32, 0xf1,
+	0x12, 0x39, 0x8d, 0x95, 0x59, 0x4b, 0x7c, 0xa2, 0x1c, 0xa7, 0x64, 0x42, 0x02, 0x89, 0xcb, 0x3b,
+	0x13, 0xe2, 0xf2, 0xc2, 0x2b, 0xe2, 0x9f, 0x80, 0x07, 0x78, 0xd9, 0x0b, 0xd2, 0xde, 0xd8, 0x78,
+	0x08, 0xa8, 0xfd, 0x0f, 0xf2, 0x17, 0x20, 0xdb, 0xc7, 0x97, 0x38, 0x76, 0x53, 0x37, 0xc9, 0xf2,
+	0xd4, 0xc6, 0xe7, 0xbb, 0x7f, 0xbf, 0xf3, 0xfb, 0x3e, 0x27, 0x70, 0x8d, 0xd0, 0x1a, 0xa1, 0x1a,
+	0x95, 0x2a, 0x4a, 0xad, 0x26, 0x9d, 0xec, 0x95, 0x54, 0x43, 0xd9, 0x93, 0x8c, 0x96, 0x58, 0x6f,
+	0x10, 0x83, 0x70, 0xab, 0xec, 0x58, 0x34, 0x8f, 0x45, 0x76, 0x2c, 0xac, 0x56, 0x48, 0x85, 0x58,
+	0x02, 0x92, 0xf9, 0x9f, 0x2d, 0x2b, 0xa4, 0x8f, 0x2d, 0x61, 0xa9, 0xa4, 0x50, 0xd5, 0xb5, 0x74,
+	0x4c, 0x34, 0xdd, 0x3e, 0xc7, 0xbf, 0x25, 0x60, 0x36, 0x4f, 0x2b, 0x87, 0x44, 0xd3, 0xef, 0x11,
+	0x52, 0xe5, 0x76, 0x61, 0x8a, 0xaa, 0x7a, 0x59, 0x6d, 0xf0, 0x68, 0x1b, 0xed, 0xcc, 0x64, 0x97,
+	0x3b, 0xed, 0xcc, 0xfc, 0x23, 0xa5, 0x56, 0x7d, 0x0b, 0xdb, 0xcf, 0xb1, 0xcc, 0x04, 0xb8, 0x9b,
+	0x70, 0xa5, 0x4e, 0x48, 0xb5, 0xa8, 0x95, 0xf9, 0xc4, 0x36, 0xda, 0x49, 0x65, 0xb9, 0x4e, 0x3b,
+	0xb3, 0x60, 0xcb, 0xb2, 0x03, 0x2c, 0x4f, 0x99, 0xff, 0xe5, 0xca, 0x5c, 0x03, 0x96, 0xe8, 0x03,
+	0xa5, 0xa1, 0x16, 0x49, 0xd3, 0x28, 0x2a, 0x35, 0xd2, 0xd4, 0x0d, 0x3e, 0x69, 0x79, 0xf8, 0xe8,
+	0x49, 0x3b, 0x33, 0xf1, 0x4f, 0x3b, 0x73, 0xa3, 0xa2, 0x19, 0x0f, 0x9a, 0x25, 0xf1, 0x98, 0xd4,
+	0x24, 0x16, 0xb4, 0xfd, 0xe7, 0x16, 0x2d, 0x3f, 0x94, 0x8c, 0x47, 0x75, 0x95, 0x8a, 0x39, 0xdd,
+	0xe8, 0xb4, 0x33, 0xeb, 0x3e, 0x1f, 0xb6, 0x29, 0xd3, 0x2a, 0x96, 0x17, 0x2c, 0x0f, 0x85, 0xa6,
+	0xf1, 0x9e, 0xf5, 0x90, 0x2b, 0xc1, 0xbc, 0x41, 0x1e, 0xaa, 0x7a, 0x51, 0xd3, 0x8b, 0x35, 0xa5,
+	0x45, 0xf9, 0xd4, 0x76, 0x72, 0x67, 0x76, 0x7f, 0x53, 0xb4, 0xed, 0x8a, 0x66, 0x4d, 0x9c, 0xf2,
+	0x89, 0xef, 0x13, 0x4d, 0xcf, 0xbe, 0x64, 0xc6, 0xd2, 0x69, 0x67, 0xb6, 0x6c, 0x0f, 0x7e, 0x6d,
+	0xe6, 0x89, 0x62, 0x79, 0xd6, 0x7a, 0x9c, 0xd3, 0xf3, 0x4a, 0x8b, 0xe2, 0xe7, 0x08, 0x56, 0x7c,
+	0xf5, 0x93, 0x55, 0x5a, 0x27, 0x3a, 0x55, 0x39, 0x1a, 0x92, 0xaf, 0x5d, 0xd1, 0x5c, 0xec, 0x7c,
+	0x37, 0x58, 0xfd, 0x03, 0xf6, 0x7a, 0x13, 0xce, 0xc3, 0xb4, 0x13, 0x32, 0x9f, 0xe8, 0x97, 0xeb,
+	0x06, 0xcb, 0x75, 0xb1, 0x3b, 0x57, 0x2c, 0x5f, 0x61, 0xf9, 0xe1, 0xdf, 0x6d, 0x6c, 0x1c, 0xb4,
+	0x34, 0x63, 0xa4, 0xd8, 0xa8, 0xc3, 0xa2, 0x9d, 0x9b, 0xa6, 0x0f, 0x09, 0x1a, 0x01, 0x73, 0x58,
+	0x9e, 0xb7, 0x9e, 0xe4, 0x74, 0x56, 0x28, 0x15, 0x16, 0xec, 0x7c, 0xcd, 0x6a, 0xd6, 0x34, 0xfd,
+	0x02, 0xd0, 0x78, 0x99, 0x95, 0xeb, 0xaa, 0xbf, 0x5c, 0x4c, 0xdd, 0xc3, 0xc6, 0x9c, 0xf5, 0xbc,
+	0xd0, 0x34, 0xf2, 0x9a, 0x4e, 0x71, 0x05, 0x56, 0x7c, 0xf5, 0x73, 0xb1, 0x71, 0x0f, 0x66, 0x5c,
+	0x75, 0x1e, 0xf5, 0x73, 0xcc, 0x33, 0xc7, 0x4b, 0x01, 0xc7, 0x58, 0x9e, 0x76, 0x9c, 0xe1, 0xaf,
+	0x11, 0x2c, 0x1f, 0x7d, 0xaa, 0xd4, 0xed, 0xf4, 0x72, 0xba, 0x4c, 0x9a, 0x86, 0xea, 0x6f, 0x02,
+	0xea, 0xdb, 0x84, 0x2c, 0x2c, 0x7a, 0x39, 0x95, 0x55, 0x9d, 0xd4, 0xac, 0xce, 0xcd, 0x64, 0x05,
+	0xaf, 0xac, 0x01, 0x01, 0x2c, 0xcf, 0x3b, 0x11, 0x7c, 0x60, 0x7d, 0xfe, 0x3b, 0x01, 0xab, 0x79,
+	0x5a, 0x31, 0x23, 0x39, 0x68, 0x29, 0xc7, 0x86, 0x13, 0x4e, 0x1c, 0xe4, 0x1c, 0xc0, 0x54, 0xc3,
+	0x8c, 0x9e, 0x32, 0x04, 0xbf, 0x22, 0x86, 0xb1, 0x9d, 0xd8, 0x93, 0x6d, 0x36, 0x65, 0xd6, 0x49,
+	0x66, 0xca, 0x5d, 0x57, 0xc1, 0x04, 0xd3, 0x60, 0x57, 0x81, 0xfb, 0x1c, 0x56, 0xc3, 0x3a, 0xce,
+	0xa7, 0xac, 0x74, 0xf2, 0xb1, 0x71, 0xba, 0x15, 0x8d, 0x22, 0x2c, 0x2f, 0xfb, 0x40, 0x64, 0xe7,
+	0x88, 0xbf, 0x43, 0x70, 0x35, 0xac, 0xb2, 0x7e, 0xbe, 0xf1, 0x8c, 0x0d, 0x87, 0x6f, 0x82, 0xf6,
+	0xb0, 0xbc, 0xe0, 0x04, 0xc6, 0xa2, 0xfa, 0x0a, 0x01, 0xe7, 0x35, 0xa2, 0xd0, 0x34, 0x2e, 0x81,
+	0xbb, 0x77, 0x9d, 0xab, 0xa8, 0xe9, 0x17, 0x86, 0xdd, 0x1c, 0x6b, 0x8b, 0x8d, 0xba, 0xe7, 0x09,
+	0x58, 0xeb, 0xad, 0x4d, 0xa1, 0x69, 0xc4, 0x81, 0xdd, 0x87, 0x01, 0xd8, 0xed, 0xf4, 0x83, 0x9d,
+	0x93, 0x6d, 0x00, 0x77, 0x9f, 0xc1, 0x4a, 0xc8, 0xd4, 0x60, 0x7c, 0xf6, 0x71, 0xec, 0x56, 0x08,
+	0x91, 0x83, 0x08, 0xcb, 0x4b, 0xde, 0x1c, 0x62, 0xb4, 0xd6, 0x45, 0x2c, 0xa9, 0x6d, 0x34, 0x38,
+	0xb1, 0x3c, 0x46, 0x70, 0x2d, 0xb4, 0xb6, 0x2e, 0xf0, 0xea, 0x0e, 0x6f, 0x78, 0x97, 0x02, 0x0d,
+	0x46, 0xde, 0x01, 0x73, 0x0e, 0xcb, 0x38, 0xe4, 0x8d, 0xff, 0x45, 0xb0, 0xee, 0xbf, 0xfe, 0x47,
+	0xf5, 0xaa, 0xc6, 0x90, 0x77, 0x04, 0x93, 0x26, 0xac, 0x28, 0x8f, 0xe2, 0x71, 0xc7, 0x2a, 0x2b,
+	0xc5, 0x9c, 0x07, 0x52, 0x8a, 0x65, 0xdb, 0x56, 0x58, 0x86, 0x89, 0xd1, 0x66, 0xf8, 0x2c, 0x01,
+	0x69, 0xb3, 0xea, 0x6e, 0x62, 0x03, 0x31, 0xea, 0x61, 0x00, 0xda, 0xaf, 0xf5, 0xaf, 0x8a, 0xe7,
+	0x39, 0x00, 0xef, 0x77, 0x7a, 0x6e, 0xab, 0x8d, 0xec, 0xcd, 0x4e, 0x3b, 0xb3, 0x16, 0x48, 0x2e,
+	0xec, 0xb2, 0x8e, 0x9d, 0x48, 0x7f, 0x46, 0x70, 0xe3, 0xfc, 0xd2, 0x8e, 0x97, 0x52, 0xff, 0x48,
+	0xc0, 0x26, 0xdb, 0x27, 0xed, 0xc8, 0x0c, 0xb5, 0xa1, 0x5f, 0xa6, 0xeb, 0xb1, 0x36, 0xb0, 0xe1,
+	0x4f, 0x4b, 0x6f, 0x59, 0x1d, 0x5e, 0x93, 0xc3, 0x6c, 0x62, 0x79, 0xd9, 0x59, 0x82, 0xbd, 0x26,
+	0xff, 0x84, 0xe0, 0x7a, 0x64, 0x11, 0xc7, 0xba, 0xa2, 0xe3, 0x5f, 0x92, 0x5d, 0xfd, 0x3d, 0x32,
+	0x4f, 0x2f, 0x35, 0xb0, 0x62, 0xf5, 0x77, 0xe0, 0x6b, 0x1b, 0x56, 0xab, 0xd4, 0xa8, 0x5f, 0x67,
+	0x22, 0x66, 0xe9, 0xe4, 0x8b, 0x98, 0xa5, 0xf8, 0xfb, 0x6e, 0x0c, 0x75, 0x37, 0x6a, 0x8c, 0xd3,
+	0xef, 0xd7, 0x24, 0xf0, 0xec, 0xa5, 0x22, 0x10, 0xd7, 0x08, 0xf9, 0x21, 0xe4, 0xe5, 0x20, 0x19,
+	0xf3, 0xe5, 0x20, 0xec, 0x2d, 0x2f, 0x35, 0xda, 0xb7, 0xbc, 0xa8, 0x59, 0x33, 0xf9, 0x82, 0x66,
+	0xcd, 0x8f, 0x08, 0xb6, 0xa3, 0x5a, 0x35, 0xde, 0x29, 0xf3, 0x67, 0x02, 0x04, 0x5f, 0x64, 0x7e,
+	0x82, 0x1c, 0x25, 0x0d, 0x75, 0xed, 0xa7, 0xc9, 0x21, 0xec, 0xa7, 0x26, 0x45, 0xb8, 0x28, 0xf0,
+	0x51, 0x44, 0x6a, 0x30, 0x8a, 0x08, 0x31, 0x89, 0xe5, 0x25, 0x06, 0x2e, 0x8f, 0x22, 0x7e, 0x40,
+	0x80, 0xa3, 0xab, 0xe8, 0xe7, 0x88, 0x20, 0xf0, 0xd1, 0x48, 0x81, 0xbf, 0xff, 0xd7, 0x34, 0x24,
+	0xf3, 0xb4, 0xc2, 0xdd, 0x87, 0x69, 0xf7, 0x8b, 0xbd, 0xeb, 0xe1, 0x5b, 0x9f, 0xef, 0xbb, 0x2b,
+	0x61, 0xb7, 0xaf, 0x88, 0x9b, 0xd3, 0x7d, 0x98, 0x76, 0xbf, 0x16, 0x8a, 0xb6, 0xec, 0x88, 0x08,
+	0xbb, 0x7d, 0x45, 0x7c, 0xf7, 0x61, 0xb9, 0x77, 0xdb, 0x7d, 0x35, 0x52, 0xbf, 0x47, 0x56, 0xd8,
+	0xbf, 0xb8, 0xac, 0xeb, 0xf4, 0x04, 0xb8, 0xc0, 0xa1, 0x09, 0xae, 0x9b, 0x17, 0xb5, 0x54, 0x68,
+	0x1a, 0xc2, 0x9d, 0x18, 0xc2, 0xae, 0xdf, 0xc7, 0x08, 0xb6, 0xce, 0xdb, 0xf2, 0x5f, 0x8f, 0x36,
+	0x1a, 0xad, 0x25, 0xbc, 0x7d, 0x19, 0x2d, 0x37, 0xa6, 0x2f, 0x11, 0xac, 0x47, 0xac, 0x9f, 0xd2,
+	0xb9, 0x00, 0xe9, 0x55, 0x10, 0xee, 0xc6, 0x54, 0x08, 0x0d, 0x22, 0xb0, 0x23, 0xf5, 0x0f, 0xa2,
+	0x5b, 0x41, 0xb8, 0x1b, 0x53, 0xc1, 0x0d, 0xe2, 0x1b, 0x04, 0x1b, 0x51, 0x14, 0x79, 0xfb, 0x5c,
+	0x44, 0x87, 0x68, 0x08, 0x6f, 0xc6, 0xd5, 0x70, 0xe3, 0xf8, 0x02, 0xd6, 0xc2, 0xc7, 0xbd, 0xd8,
+	0xd7, 0x64, 0x97, 0xbc, 0xf0, 0x46, 0x3c, 0x79, 0x27, 0x80, 0xec, 0xe1, 0x93, 0xd3, 0x34, 0x7a,
+	0x7a, 0x9a, 0x46, 0xff, 0x9d, 0xa6, 0xd1, 0xb7, 0x67, 0xe9, 0x89, 0xa7, 0x67, 0xe9, 0x89, 0x67,
+	0x67, 0xe9, 0x89, 0x4f, 0x6e, 0xfb, 0xa8, 0x8b, 0xd9, 0xbe, 0x55, 0x55, 0x4a, 0xd4, 0xf9, 0x20,
+	0x9d, 0xec, 0xed, 0x4b, 0x2d, 0xfb, 0x77, 0x0c, 0x8b, 0xc8, 0x4a, 0x53, 0xd6, 0xef, 0x0e, 0x77,
+	0xfe, 0x1f, 0x00, 0xcd, 0xe9, 0x14, 0x9a, 0xe4, 0x18, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1059,6 +1218,7 @@ type MsgClient interface {
 	ExitPool(ctx context.Context, in *MsgExitPool, opts ...grpc.CallOption) (*MsgExitPoolResponse, error)
 	SwapExactAmountIn(ctx context.Context, in *MsgSwapExactAmountIn, opts ...grpc.CallOption) (*MsgSwapExactAmountInResponse, error)
 	SwapExactAmountOut(ctx context.Context, in *MsgSwapExactAmountOut, opts ...grpc.CallOption) (*MsgSwapExactAmountOutResponse, error)
+	SplitRouteSwapExactAmountIn(ctx context.Context, in *MsgSplitRouteSwapExactAmountIn, opts ...grpc.CallOption) (*MsgSplitRouteSwapExactAmountInResponse, error)
 	JoinSwapExternAmountIn(ctx context.Context, in *MsgJoinSwapExternAmountIn, opts ...grpc.CallOption) (*MsgJoinSwapExternAmountInResponse, error)
 	JoinSwapShareAmountOut(ctx context.Context, in *MsgJoinSwapShareAmountOut, opts ...grpc.CallOption) (*MsgJoinSwapShareAmountOutResponse, error)
 	ExitSwapExternAmountOut(ctx context.Context, in *MsgExitSwapExternAmountOut, opts ...grpc.CallOption) (*MsgExitSwapExternAmountOutResponse, error)
@@ -1109,6 +1269,15 @@ func (c *msgClient) SwapExactAmountOut(ctx context.Context, in *MsgSwapExactAmou
 	return out, nil
 }
 
+func (c *msgClient) SplitRouteSwapExactAmountIn(ctx context.Context, in *MsgSplitRouteSwapExactAmountIn, opts ...grpc.CallOption) (*MsgSplitRouteSwapExactAmountInResponse, error) {
+	out := new(MsgSplitRouteSwapExactAmountInResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Msg/SplitRouteSwapExactAmountIn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) JoinSwapExternAmountIn(ctx context.Context, in *MsgJoinSwapExternAmountIn, opts ...grpc.CallOption) (*MsgJoinSwapExternAmountInResponse, error) {
 	out := new(MsgJoinSwapExternAmountInResponse)
 	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Msg/JoinSwapExternAmountIn", in, out, opts...)
@@ -1151,6 +1320,7 @@ type MsgServer interface {
 	ExitPool(context.Context, *MsgExitPool) (*MsgExitPoolResponse, error)
 	SwapExactAmountIn(context.Context, *MsgSwapExactAmountIn) (*MsgSwapExactAmountInResponse, error)
 	SwapExactAmountOut(context.Context, *MsgSwapExactAmountOut) (*MsgSwapExactAmountOutResponse, error)
+	SplitRouteSwapExactAmountIn(context.Context, *MsgSplitRouteSwapExactAmountIn) (*MsgSplitRouteSwapExactAmountInResponse, error)
 	JoinSwapExternAmountIn(context.Context, *MsgJoinSwapExternAmountIn) (*MsgJoinSwapExternAmountInResponse, error)
 	JoinSwapShareAmountOut(context.Context, *MsgJoinSwapShareAmountOut) (*MsgJoinSwapShareAmountOutResponse, error)
 	ExitSwapExternAmountOut(context.Context, *MsgExitSwapExternAmountOut) (*MsgExitSwapExternAmountOutResponse, error)
@@ -1173,6 +1343,9 @@ func (*UnimplementedMsgServer) SwapExactAmountIn(ctx context.Context, req *MsgSw
 func (*UnimplementedMsgServer) SwapExactAmountOut(ctx context.Context, req *MsgSwapExactAmountOut) (*MsgSwapExactAmountOutResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SwapExactAmountOut not implemented")
 }
+func (*UnimplementedMsgServer) SplitRouteSwapExactAmountIn(ctx context.Context, req *MsgSplitRouteSwapExactAmountIn) (*MsgSplitRouteSwapExactAmountInResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SplitRouteSwapExactAmountIn not implemented")
+}
 func (*UnimplementedMsgServer) JoinSwapExternAmountIn(ctx context.Context, req *MsgJoinSwapExternAmountIn) (*MsgJoinSwapExternAmountInResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method JoinSwapExternAmountIn not implemented")
 }
@@ -1262,6 +1435,24 @@ func _Msg_SwapExactAmountOut_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SplitRouteSwapExactAmountIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSplitRouteSwapExactAmountIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SplitRouteSwapExactAmountIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Msg/SplitRouteSwapExactAmountIn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SplitRouteSwapExactAmountIn(ctx, req.(*MsgSplitRouteSwapExactAmountIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_JoinSwapExternAmountIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgJoinSwapExternAmountIn)
 	if err := dec(in); err != nil {
@@ -1354,6 +1545,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SwapExactAmountOut",
 			Handler:    _Msg_SwapExactAmountOut_Handler,
 		},
+		{
+			MethodName: "SplitRouteSwapExactAmountIn",
+			Handler:    _Msg_SplitRouteSwapExactAmountIn_Handler,
+		},
 		{
 			MethodName: "JoinSwapExternAmountIn",
 			Handler:    _Msg_JoinSwapExternAmountIn_Handler,
@@ -1841,6 +2036,147 @@ func (m *MsgSwapExactAmountOutResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *SwapAmountInSplitRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwapAmountInSplitRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapAmountInSplitRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TokenInAmount.Size()
+		i -= size
+		if _, err := m.TokenInAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSplitRouteSwapExactAmountIn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSplitRouteSwapExactAmountIn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSplitRouteSwapExactAmountIn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TokenOutMinAmount.Size()
+		i -= size
+		if _, err := m.TokenOutMinAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.TokenInDenom) > 0 {
+		i -= len(m.TokenInDenom)
+		copy(dAtA[i:], m.TokenInDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenInDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSplitRouteSwapExactAmountInResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSplitRouteSwapExactAmountInResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSplitRouteSwapExactAmountInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TokenOutAmount.Size()
+		i -= size
+		if _, err := m.TokenOutAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgJoinSwapExternAmountIn) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2398,13 +2734,66 @@ func (m *MsgSwapExactAmountOutResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgJoinSwapExternAmountIn) Size() (n int) {
+func (m *SwapAmountInSplitRoute) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Sender)
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.TokenInAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSplitRouteSwapExactAmountIn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.TokenInDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TokenOutMinAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSplitRouteSwapExactAmountInResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenOutAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgJoinSwapExternAmountIn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
@@ -3812,6 +4201,390 @@ func (m *MsgSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SwapAmountInSplitRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapAmountInSplitRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapAmountInSplitRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, SwapAmountInRoute{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSplitRouteSwapExactAmountIn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountIn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountIn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, SwapAmountInSplitRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenInDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSplitRouteSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountInResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgJoinSwapExternAmountIn) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -12,6 +12,7 @@ const (
 	FlagStartTime = "start-time"
 	FlagEpochs    = "epochs"
 	FlagPerpetual = "perpetual"
+	FlagNoLock    = "no-lock"
 	FlagTimestamp = "timestamp"
 	FlagOwner     = "owner"
 	FlagLockIds   = "lock-ids"
@@ -27,5 +28,6 @@ func FlagSetCreateGauge() *flag.FlagSet {
 	fs.String(FlagStartTime, "", "Timestamp to begin distribution")
 	fs.Uint64(FlagEpochs, 0, "Total epochs to distribute tokens")
 	fs.Bool(FlagPerpetual, false, "Perpetual distribution")
+	fs.Bool(FlagNoLock, false, "Distribute to unlocked pool share balances rather than to locks, ignoring the duration")
 	return fs
 }
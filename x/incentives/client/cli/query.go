@@ -36,6 +36,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdUpcomingGauges(),
 		GetCmdUpcomingGaugesPerDenom(),
 		GetCmdRewardsEst(),
+		GetCmdClaimableRewards(),
 	)
 
 	return cmd
@@ -423,6 +424,42 @@ $ %s query incentives rewards-estimation
 	return cmd
 }
 
+// GetCmdClaimableRewards returns the rewards an owner can currently claim.
+func GetCmdClaimableRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claimable-rewards [owner]",
+		Short: "Query the rewards accrued by the locks of an owner.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the rewards accrued by the locks of an owner that can be claimed.
+
+Example:
+$ %s query incentives claimable-rewards osmo1...
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ClaimableRewards(cmd.Context(), &types.QueryClaimableRewardsRequest{Owner: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func contains(s []uint64, value uint64) bool {
 	for _, v := range s {
 		if v == value {
@@ -30,6 +30,9 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(
 		NewCreateGaugeCmd(),
 		NewAddToGaugeCmd(),
+		NewCancelGaugeCmd(),
+		NewWithdrawUndistributedCmd(),
+		NewClaimRewardsCmd(),
 	)
 
 	return cmd
@@ -89,12 +92,21 @@ func NewCreateGaugeCmd() *cobra.Command {
 				return err
 			}
 
+			noLock, err := cmd.Flags().GetBool(FlagNoLock)
+			if err != nil {
+				return err
+			}
+
 			distributeTo := lockuptypes.QueryCondition{
 				LockQueryType: lockuptypes.ByDuration,
 				Denom:         denom,
 				Duration:      duration,
 				Timestamp:     time.Unix(0, 0), // XXX check
 			}
+			if noLock {
+				distributeTo.LockQueryType = lockuptypes.NoLock
+				distributeTo.Duration = 0
+			}
 
 			msg := types.NewMsgCreateGauge(
 				epochs == 1,
@@ -152,3 +164,91 @@ func NewAddToGaugeCmd() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// NewCancelGaugeCmd broadcasts a MsgCancelGauge message.
+func NewCancelGaugeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-gauge [gauge_id] [flags]",
+		Short: "cancel a gauge and refund its undistributed coins to its owner",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			gaugeId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelGauge(
+				clientCtx.GetFromAddress(),
+				gaugeId,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewWithdrawUndistributedCmd broadcasts a MsgWithdrawUndistributed message.
+func NewWithdrawUndistributedCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-undistributed [gauge_id] [flags]",
+		Short: "withdraw the undistributed coins of a finished gauge to its owner",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			gaugeId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWithdrawUndistributed(
+				clientCtx.GetFromAddress(),
+				gaugeId,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewClaimRewardsCmd broadcasts a MsgClaimRewards message.
+func NewClaimRewardsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-rewards [flags]",
+		Short: "claim the rewards accrued by the locks of the sender",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			msg := types.NewMsgClaimRewards(clientCtx.GetFromAddress())
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
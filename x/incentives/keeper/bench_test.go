@@ -68,13 +68,13 @@ func genQueryCondition(
 	}
 }
 
-// benchmarkDistributionLogic creates gauges with lockups that get distributed to. Benchmarks the performance of the distribution process.
-func benchmarkDistributionLogic(numAccts, numDenoms, numGauges, numLockups, numDistrs int, b *testing.B) {
-	b.StopTimer()
-
+// setupDistributionBenchmark creates accounts, gauges and lockups that get distributed to, then begins the distribution of the gauges.
+// It returns the app along with the created accounts and gauge IDs, and the function to clean the app up.
+func setupDistributionBenchmark(numAccts, numDenoms, numGauges, numLockups int, b *testing.B) (
+	*app.OsmosisApp, sdk.Context, []sdk.AccAddress, []uint64, func(),
+) {
 	blockStartTime := time.Now().UTC()
 	app, cleanupFn := app.SetupTestingAppWithLevelDb(false)
-	defer cleanupFn()
 	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1, ChainID: "osmosis-1", Time: blockStartTime})
 
 	r := rand.New(rand.NewSource(10))
@@ -154,8 +154,11 @@ func benchmarkDistributionLogic(numAccts, numDenoms, numGauges, numLockups, numD
 		}
 	}
 
-	b.StartTimer()
-	// distribute coins from gauges to lockup owners
+	return app, ctx, addrs, gaugeIds, cleanupFn
+}
+
+// distributeGauges distributes coins from all the provided gauges numDistrs times.
+func distributeGauges(app *app.OsmosisApp, ctx sdk.Context, gaugeIds []uint64, numDistrs int, b *testing.B) {
 	for i := 0; i < numDistrs; i++ {
 		gauges := []types.Gauge{}
 		for _, gaugeId := range gaugeIds {
@@ -169,6 +172,31 @@ func benchmarkDistributionLogic(numAccts, numDenoms, numGauges, numLockups, numD
 	}
 }
 
+// benchmarkDistributionLogic creates gauges with lockups that get distributed to. Benchmarks the performance of the distribution process.
+func benchmarkDistributionLogic(numAccts, numDenoms, numGauges, numLockups, numDistrs int, b *testing.B) {
+	b.StopTimer()
+	app, ctx, _, gaugeIds, cleanupFn := setupDistributionBenchmark(numAccts, numDenoms, numGauges, numLockups, b)
+	defer cleanupFn()
+
+	b.StartTimer()
+	// distribute coins from gauges to the reward accumulators
+	distributeGauges(app, ctx, gaugeIds, numDistrs, b)
+}
+
+// benchmarkClaimRewardsLogic distributes gauges to lockups, then benchmarks the performance of every lockup owner claiming its rewards.
+func benchmarkClaimRewardsLogic(numAccts, numDenoms, numGauges, numLockups, numDistrs int, b *testing.B) {
+	b.StopTimer()
+	app, ctx, addrs, gaugeIds, cleanupFn := setupDistributionBenchmark(numAccts, numDenoms, numGauges, numLockups, b)
+	defer cleanupFn()
+	distributeGauges(app, ctx, gaugeIds, numDistrs, b)
+
+	b.StartTimer()
+	// claim the rewards accrued by the lockups of every account, some of which may not have accrued any
+	for _, addr := range addrs {
+		_, _ = app.IncentivesKeeper.ClaimRewards(ctx, addr)
+	}
+}
+
 func BenchmarkDistributionLogicTiny(b *testing.B) {
 	numAccts := 1
 	numDenoms := 1
@@ -215,3 +243,21 @@ func BenchmarkDistributionLogicHuge(b *testing.B) {
 	numDistrs := 30000
 	benchmarkDistributionLogic(numAccts, numDenoms, numGauges, numLockups, numDistrs, b)
 }
+
+func BenchmarkClaimRewardsLogicSmall(b *testing.B) {
+	numAccts := 10
+	numDenoms := 1
+	numGauges := 10
+	numLockups := 1000
+	numDistrs := 100
+	benchmarkClaimRewardsLogic(numAccts, numDenoms, numGauges, numLockups, numDistrs, b)
+}
+
+func BenchmarkClaimRewardsLogicMedium(b *testing.B) {
+	numAccts := 1000
+	numDenoms := 8
+	numGauges := 30
+	numLockups := 20000
+	numDistrs := 1
+	benchmarkClaimRewardsLogic(numAccts, numDenoms, numGauges, numLockups, numDistrs, b)
+}
@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/osmosis-labs/osmosis/v12/x/incentives/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// gaugeContributionsPrefix returns the store prefix of the contributions to the provided gauge ID.
+func gaugeContributionsPrefix(gaugeID uint64) []byte {
+	return combineKeys(types.KeyPrefixGaugeContributions, sdk.Uint64ToBigEndian(gaugeID), []byte{})
+}
+
+// setGaugeContribution stores the provided contribution to a gauge.
+func (k Keeper) setGaugeContribution(ctx sdk.Context, contribution types.GaugeContribution) error {
+	addr, err := sdk.AccAddressFromBech32(contribution.Address)
+	if err != nil {
+		return err
+	}
+	bz, err := proto.Marshal(&contribution)
+	if err != nil {
+		return err
+	}
+	prefix.NewStore(ctx.KVStore(k.storeKey), gaugeContributionsPrefix(contribution.GaugeId)).Set(addr, bz)
+	return nil
+}
+
+// addGaugeContribution records the coins added to the gauge by the address. The coins of the owner are not recorded,
+// as the owner is refunded whatever the other contributors are not. Contributions are stored apart from the gauge,
+// so that their number does not weigh on every read of the gauge.
+func (k Keeper) addGaugeContribution(ctx sdk.Context, gauge types.Gauge, addr sdk.AccAddress, coins sdk.Coins) error {
+	if coins.Empty() || addr.String() == gauge.Owner {
+		return nil
+	}
+	contribution := types.GaugeContribution{GaugeId: gauge.Id, Address: addr.String(), Coins: sdk.Coins{}}
+	if bz := prefix.NewStore(ctx.KVStore(k.storeKey), gaugeContributionsPrefix(gauge.Id)).Get(addr); bz != nil {
+		if err := proto.Unmarshal(bz, &contribution); err != nil {
+			return err
+		}
+	}
+	contribution.Coins = contribution.Coins.Add(coins...)
+	return k.setGaugeContribution(ctx, contribution)
+}
+
+// GetGaugeContributions returns the contributions to the provided gauge ID, by addresses other than its owner.
+func (k Keeper) GetGaugeContributions(ctx sdk.Context, gaugeID uint64) []types.GaugeContribution {
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), gaugeContributionsPrefix(gaugeID)).Iterator(nil, nil)
+	defer iterator.Close()
+
+	contributions := []types.GaugeContribution{}
+	for ; iterator.Valid(); iterator.Next() {
+		contribution := types.GaugeContribution{}
+		if err := proto.Unmarshal(iterator.Value(), &contribution); err != nil {
+			panic(err)
+		}
+		contributions = append(contributions, contribution)
+	}
+	return contributions
+}
@@ -6,6 +6,7 @@ import (
 
 	db "github.com/tendermint/tm-db"
 
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/utils"
 	"github.com/osmosis-labs/osmosis/v12/x/incentives/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v12/x/lockup/types"
 
@@ -21,14 +22,14 @@ func (k Keeper) getDistributedCoinsFromGauges(gauges []types.Gauge) sdk.Coins {
 	return coins
 }
 
-// getToDistributeCoinsFromGauges returns coins that have not been distributed yet from the provided gauges
+// getToDistributeCoinsFromGauges returns coins that have been neither distributed nor refunded yet from the provided gauges
 func (k Keeper) getToDistributeCoinsFromGauges(gauges []types.Gauge) sdk.Coins {
 	coins := sdk.Coins{}
 	distributed := sdk.Coins{}
 
 	for _, gauge := range gauges {
 		coins = coins.Add(gauge.Coins...)
-		distributed = distributed.Add(gauge.DistributedCoins...)
+		distributed = distributed.Add(gauge.DistributedCoins...).Add(gauge.RefundedCoins...)
 	}
 	return coins.Sub(distributed)
 }
@@ -107,7 +108,7 @@ func (k Keeper) FilteredLocksDistributionEst(ctx sdk.Context, gauge types.Gauge,
 		return types.Gauge{}, nil, true, nil
 	}
 
-	remainCoins := gauge.Coins.Sub(gauge.DistributedCoins)
+	remainCoins := gauge.UndistributedCoins()
 	// remainEpochs is the number of remaining epochs that the gauge will pay out its rewards.
 	// for a perpetual gauge, it will pay out everything in the next epoch, and we don't make
 	// an assumption of the rate at which it will get refilled at.
@@ -258,6 +259,15 @@ func (k Keeper) distributeSyntheticInternal(
 	return k.distributeInternal(ctx, gauge, sortedAndTrimmedQualifiedLocks, distrInfo)
 }
 
+// distributeLPSharesInternal runs the distribution logic for an LP share rewards distribution gauge, and adds the sends to
+// the distrInfo struct. It also updates the gauge for the distribution.
+// The gauge distributes pro-rata to the unlocked share balances of the tracked holders of its share denom.
+func (k Keeper) distributeLPSharesInternal(
+	ctx sdk.Context, gauge types.Gauge, distrInfo *distributionInfo,
+) (sdk.Coins, error) {
+	return k.distributeInternal(ctx, gauge, k.getLPShareBalances(ctx, gauge), distrInfo)
+}
+
 // distributeInternal runs the distribution logic for a gauge, and adds the sends to
 // the distrInfo struct. It also updates the gauge for the distribution.
 // Locks is expected to be the correct set of lock recipients for this gauge.
@@ -269,10 +279,15 @@ func (k Keeper) distributeInternal(
 	lockSum := lockuptypes.SumLocksByDenom(locks, denom)
 
 	if lockSum.IsZero() {
+		// the coins of a non-perpetual gauge for an epoch without qualifying locks could never be
+		// distributed, so they are refunded to the owner instead.
+		if !gauge.IsPerpetual && gauge.Owner != "" {
+			return nil, k.refundGaugeEpoch(ctx, gauge)
+		}
 		return nil, nil
 	}
 
-	remainCoins := gauge.Coins.Sub(gauge.DistributedCoins)
+	remainCoins := gauge.UndistributedCoins()
 	// if its a perpetual gauge, we set remaining epochs to 1.
 	// otherwise is is a non perpetual gauge and we determine how many epoch payouts are left
 	remainEpochs := uint64(1)
@@ -304,15 +319,66 @@ func (k Keeper) distributeInternal(
 		totalDistrCoins = totalDistrCoins.Add(distrCoins...)
 	}
 
-	err := k.updateGaugePostDistribute(ctx, gauge, totalDistrCoins)
+	err := k.updateGaugePostDistribute(ctx, gauge, totalDistrCoins, sdk.Coins{})
 	return totalDistrCoins, err
 }
 
+// refundGaugeEpoch refunds the coins a non-perpetual gauge pays out in an epoch to the gauge owner,
+// and updates the gauge for the epoch.
+func (k Keeper) refundGaugeEpoch(ctx sdk.Context, gauge types.Gauge) error {
+	if gauge.FilledEpochs >= gauge.NumEpochsPaidOver {
+		return nil
+	}
+	remainEpochs := gauge.NumEpochsPaidOver - gauge.FilledEpochs
+
+	refundCoins := sdk.Coins{}
+	for _, coin := range gauge.UndistributedCoins() {
+		// refund amount = remaining_gauge_size / remain_epochs
+		amt := coin.Amount.QuoRaw(int64(remainEpochs))
+		if amt.IsPositive() {
+			refundCoins = refundCoins.Add(sdk.NewCoin(coin.Denom, amt))
+		}
+	}
+
+	if err := k.refundGaugeCoins(ctx, gauge, refundCoins); err != nil {
+		return err
+	}
+	return k.updateGaugePostDistribute(ctx, gauge, sdk.Coins{}, refundCoins)
+}
+
+// refundGaugeCoins sends the provided coins of the gauge from the module account to the contributors of the gauge,
+// pro rata of their contributions.
+func (k Keeper) refundGaugeCoins(ctx sdk.Context, gauge types.Gauge, coins sdk.Coins) error {
+	if coins.Empty() {
+		return nil
+	}
+	for _, refund := range gauge.SplitRefund(k.GetGaugeContributions(ctx, gauge.Id), coins) {
+		receiver, err := sdk.AccAddressFromBech32(refund.Address)
+		if err != nil {
+			return err
+		}
+		if err := k.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, refund.Coins); err != nil {
+			return err
+		}
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.TypeEvtRefund,
+				sdk.NewAttribute(types.AttributeGaugeID, utils.Uint64ToString(gauge.Id)),
+				sdk.NewAttribute(types.AttributeReceiver, refund.Address),
+				sdk.NewAttribute(types.AttributeAmount, refund.Coins.String()),
+			),
+		})
+	}
+	return nil
+}
+
 // updateGaugePostDistribute increments the gauge's filled epochs field.
-// Also adds the coins that were just distributed to the gauge's distributed coins field.
-func (k Keeper) updateGaugePostDistribute(ctx sdk.Context, gauge types.Gauge, newlyDistributedCoins sdk.Coins) error {
+// Also adds the coins that were just distributed to the gauge's distributed coins field,
+// and the coins that were just refunded to the gauge's refunded coins field.
+func (k Keeper) updateGaugePostDistribute(ctx sdk.Context, gauge types.Gauge, newlyDistributedCoins, newlyRefundedCoins sdk.Coins) error {
 	gauge.FilledEpochs += 1
 	gauge.DistributedCoins = gauge.DistributedCoins.Add(newlyDistributedCoins...)
+	gauge.RefundedCoins = gauge.RefundedCoins.Add(newlyRefundedCoins...)
 	if err := k.setGauge(ctx, &gauge); err != nil {
 		return err
 	}
@@ -339,20 +405,33 @@ func (k Keeper) getDistributeToBaseLocks(ctx sdk.Context, gauge types.Gauge, cac
 }
 
 // Distribute distributes coins from an array of gauges to all eligible locks.
+// The rewards of gauges distributing to native locks are accumulated for the lock owners to claim,
+// while the rewards of gauges distributing to synthetic locks or LP shares are sent right away.
 func (k Keeper) Distribute(ctx sdk.Context, gauges []types.Gauge) (sdk.Coins, error) {
 	distrInfo := newDistributionInfo()
 
 	locksByDenomCache := make(map[string][]lockuptypes.PeriodLock)
 	totalDistributedCoins := sdk.Coins{}
 	for _, gauge := range gauges {
-		filteredLocks := k.getDistributeToBaseLocks(ctx, gauge, locksByDenomCache)
-		// send based on synthetic lockup coins if it's distributing to synthetic lockups
 		var gaugeDistributedCoins sdk.Coins
 		var err error
+		// send based on unlocked share balances if it's distributing to LP shares
+		if gauge.DistributeTo.LockQueryType == lockuptypes.NoLock {
+			gaugeDistributedCoins, err = k.distributeLPSharesInternal(ctx, gauge, &distrInfo)
+			if err != nil {
+				return nil, err
+			}
+			totalDistributedCoins = totalDistributedCoins.Add(gaugeDistributedCoins...)
+			continue
+		}
+
+		// send based on synthetic lockup coins if it's distributing to synthetic lockups,
+		// otherwise accumulate the rewards for the lock owners to claim
 		if lockuptypes.IsSyntheticDenom(gauge.DistributeTo.Denom) {
+			filteredLocks := k.getDistributeToBaseLocks(ctx, gauge, locksByDenomCache)
 			gaugeDistributedCoins, err = k.distributeSyntheticInternal(ctx, gauge, filteredLocks, &distrInfo)
 		} else {
-			gaugeDistributedCoins, err = k.distributeInternal(ctx, gauge, filteredLocks, &distrInfo)
+			gaugeDistributedCoins, err = k.distributeToAccumulator(ctx, gauge)
 		}
 		if err != nil {
 			return nil, err
@@ -6,6 +6,8 @@ import (
 
 	"github.com/stretchr/testify/suite"
 
+	"github.com/osmosis-labs/osmosis/v12/app/apptesting"
+	gammtypes "github.com/osmosis-labs/osmosis/v12/x/gamm/types"
 	"github.com/osmosis-labs/osmosis/v12/x/incentives/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v12/x/lockup/types"
 
@@ -84,8 +86,14 @@ func (suite *KeeperTestSuite) TestDistribute() {
 		addrs := suite.SetupUserLocks(tc.users)
 		_, err := suite.App.IncentivesKeeper.Distribute(suite.Ctx, gauges)
 		suite.Require().NoError(err)
-		// check expected rewards against actual rewards received
+		// check expected rewards against the rewards claimed
 		for i, addr := range addrs {
+			claimable := suite.App.IncentivesKeeper.GetClaimableRewards(suite.Ctx, addr)
+			suite.Require().Equal(tc.expectedRewards[i].String(), claimable.String(), "test %v, person %d", tc.name, i)
+			if !claimable.Empty() {
+				_, err = suite.App.IncentivesKeeper.ClaimRewards(suite.Ctx, addr)
+				suite.Require().NoError(err)
+			}
 			bal := suite.App.BankKeeper.GetAllBalances(suite.Ctx, addr)
 			suite.Require().Equal(tc.expectedRewards[i].String(), bal.String(), "test %v, person %d", tc.name, i)
 		}
@@ -278,6 +286,7 @@ func (suite *KeeperTestSuite) TestNoLockPerpetualGaugeDistribution() {
 		FilledEpochs:      0,
 		DistributedCoins:  sdk.Coins{},
 		StartTime:         startTime,
+		Owner:             defaultGaugeOwner.String(),
 	}
 	suite.Require().Equal(gauges[0].String(), expectedGauge.String())
 
@@ -299,7 +308,8 @@ func (suite *KeeperTestSuite) TestNoLockPerpetualGaugeDistribution() {
 	suite.Require().Equal(gauges[0].String(), expectedGauge.String())
 }
 
-// TestNoLockNonPerpetualGaugeDistribution tests that the creation of a non perp gauge that has no locks associated does not distribute any tokens.
+// TestNoLockNonPerpetualGaugeDistribution tests that the creation of a non perp gauge that has no locks associated does not distribute any tokens,
+// and refunds the coins of the epoch to the gauge owner instead.
 func (suite *KeeperTestSuite) TestNoLockNonPerpetualGaugeDistribution() {
 	suite.SetupTest()
 
@@ -325,6 +335,7 @@ func (suite *KeeperTestSuite) TestNoLockNonPerpetualGaugeDistribution() {
 		FilledEpochs:      0,
 		DistributedCoins:  sdk.Coins{},
 		StartTime:         startTime,
+		Owner:             defaultGaugeOwner.String(),
 	}
 	suite.Require().Equal(gauges[0].String(), expectedGauge.String())
 
@@ -336,12 +347,133 @@ func (suite *KeeperTestSuite) TestNoLockNonPerpetualGaugeDistribution() {
 	suite.Require().NoError(err)
 
 	// distribute coins to stakers
+	ownerBalance := suite.App.BankKeeper.GetBalance(suite.Ctx, defaultGaugeOwner, "stake")
 	distrCoins, err := suite.App.IncentivesKeeper.Distribute(suite.Ctx, []types.Gauge{*gauge})
 	suite.Require().NoError(err)
 	suite.Require().Equal(distrCoins, sdk.Coins(nil))
 
-	// check state is same after distribution
+	// check the coins of the epoch have been refunded to the gauge owner
+	refundedCoins := sdk.Coins{sdk.NewInt64Coin("stake", 5)}
+	suite.Require().Equal(ownerBalance.Add(refundedCoins[0]), suite.App.BankKeeper.GetBalance(suite.Ctx, defaultGaugeOwner, "stake"))
 	gauges = suite.App.IncentivesKeeper.GetNotFinishedGauges(suite.Ctx)
 	suite.Require().Len(gauges, 1)
+	expectedGauge.FilledEpochs = 1
+	expectedGauge.RefundedCoins = refundedCoins
 	suite.Require().Equal(gauges[0].String(), expectedGauge.String())
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 5)}, suite.App.IncentivesKeeper.GetModuleToDistributeCoins(suite.Ctx))
+}
+
+// advanceDistrEpoch starts the next distribution epoch.
+func (suite *KeeperTestSuite) advanceDistrEpoch() {
+	epochInfo := suite.App.EpochsKeeper.GetEpochInfo(suite.Ctx, suite.App.IncentivesKeeper.GetParams(suite.Ctx).DistrEpochIdentifier)
+	suite.App.EpochsKeeper.DeleteEpochInfo(suite.Ctx, epochInfo.Identifier)
+	epochInfo.CurrentEpoch++
+	suite.Require().NoError(suite.App.EpochsKeeper.AddEpochInfo(suite.Ctx, epochInfo))
+}
+
+// createLPShareGauge creates an active perpetual gauge distributing the provided coins to unlocked shares of the provided pool.
+func (suite *KeeperTestSuite) createLPShareGauge(poolId uint64, coins sdk.Coins) types.Gauge {
+	distrTo := lockuptypes.QueryCondition{
+		LockQueryType: lockuptypes.NoLock,
+		Denom:         gammtypes.GetPoolShareDenom(poolId),
+	}
+	_, gauge := suite.CreateGauge(false, defaultGaugeOwner, coins, distrTo, suite.Ctx.BlockTime(), 1)
+	err := suite.App.IncentivesKeeper.MoveUpcomingGaugeToActiveGauge(suite.Ctx, *gauge)
+	suite.Require().NoError(err)
+	return *gauge
+}
+
+// TestLPShareGaugeDistribution tests that an LP share gauge distributes pro-rata to the unlocked share balances
+// of the accounts holding pool shares, as tracked by the bank hooks.
+func (suite *KeeperTestSuite) TestLPShareGaugeDistribution() {
+	suite.SetupTest()
+
+	// the pool creator holds the initial shares
+	poolId := suite.PrepareBalancerPool()
+	shareDenom := gammtypes.GetPoolShareDenom(poolId)
+	creator, joiner, exiter := suite.TestAccs[0], suite.TestAccs[1], suite.TestAccs[2]
+	suite.Require().Equal([]sdk.AccAddress{creator}, suite.App.IncentivesKeeper.GetLPShareHolders(suite.Ctx, shareDenom))
+
+	// one account joins with half of the initial shares, and another joins and exits with all of its shares
+	halfShares := gammtypes.InitPoolSharesSupply.QuoRaw(2)
+	for _, acc := range []sdk.AccAddress{joiner, exiter} {
+		suite.FundAcc(acc, apptesting.DefaultAcctFunds)
+		_, _, err := suite.App.GAMMKeeper.JoinPoolNoSwap(suite.Ctx, acc, poolId, halfShares, sdk.Coins{})
+		suite.Require().NoError(err)
+	}
+	exiterShares := suite.App.BankKeeper.GetBalance(suite.Ctx, exiter, shareDenom)
+	_, err := suite.App.GAMMKeeper.ExitPool(suite.Ctx, exiter, poolId, exiterShares.Amount, sdk.Coins{})
+	suite.Require().NoError(err)
+	suite.Require().ElementsMatch([]sdk.AccAddress{creator, joiner}, suite.App.IncentivesKeeper.GetLPShareHolders(suite.Ctx, shareDenom))
+
+	// locked shares are not rewarded, leaving the creator with as many unlocked shares as the joiner
+	creatorShares := suite.App.BankKeeper.GetBalance(suite.Ctx, creator, shareDenom)
+	joinerShares := suite.App.BankKeeper.GetBalance(suite.Ctx, joiner, shareDenom)
+	_, err = suite.App.LockupKeeper.CreateLock(suite.Ctx, creator, sdk.NewCoins(creatorShares.Sub(joinerShares)), defaultLockDuration)
+	suite.Require().NoError(err)
+	suite.advanceDistrEpoch()
+
+	// gauges without locks can only distribute to pool shares
+	noLockDistrTo := lockuptypes.QueryCondition{
+		LockQueryType: lockuptypes.NoLock,
+		Denom:         defaultLPDenom,
+	}
+	suite.FundAcc(defaultGaugeOwner, sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 100)})
+	_, err = suite.App.IncentivesKeeper.CreateGauge(suite.Ctx, false, defaultGaugeOwner, sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 100)}, noLockDistrTo, suite.Ctx.BlockTime(), 1)
+	suite.Require().Error(err)
+
+	noLockDistrTo.Denom = shareDenom
+	_, gauge := suite.CreateGauge(false, defaultGaugeOwner, sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 100)}, noLockDistrTo, suite.Ctx.BlockTime(), 1)
+	err = suite.App.IncentivesKeeper.MoveUpcomingGaugeToActiveGauge(suite.Ctx, *gauge)
+	suite.Require().NoError(err)
+
+	creatorBalance := suite.App.BankKeeper.GetBalance(suite.Ctx, creator, defaultRewardDenom)
+	distrCoins, err := suite.App.IncentivesKeeper.Distribute(suite.Ctx, []types.Gauge{*gauge})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 100)}, distrCoins)
+
+	// the unlocked shares are rewarded evenly, and the exited account is not rewarded
+	suite.Require().Equal(creatorBalance.AddAmount(sdk.NewInt(50)), suite.App.BankKeeper.GetBalance(suite.Ctx, creator, defaultRewardDenom))
+	suite.Require().Equal(sdk.NewInt64Coin(defaultRewardDenom, 50), suite.App.BankKeeper.GetBalance(suite.Ctx, joiner, defaultRewardDenom))
+	suite.Require().True(suite.App.BankKeeper.GetBalance(suite.Ctx, exiter, defaultRewardDenom).IsZero())
+}
+
+// TestLPShareGaugeQualifyingShares tests that an LP share gauge only rewards the lowest share balance of each holder
+// during the distribution epoch, so that shares joined or received just before a distribution are not rewarded.
+func (suite *KeeperTestSuite) TestLPShareGaugeQualifyingShares() {
+	suite.SetupTest()
+
+	poolId := suite.PrepareBalancerPool()
+	shareDenom := gammtypes.GetPoolShareDenom(poolId)
+	creator, joiner, receiver := suite.TestAccs[0], suite.TestAccs[1], suite.TestAccs[2]
+	suite.advanceDistrEpoch()
+
+	// the joiner joins right before the distribution, and the creator sends half of its shares to the receiver
+	suite.FundAcc(joiner, apptesting.DefaultAcctFunds)
+	_, _, err := suite.App.GAMMKeeper.JoinPoolNoSwap(suite.Ctx, joiner, poolId, gammtypes.InitPoolSharesSupply, sdk.Coins{})
+	suite.Require().NoError(err)
+	halfShares := sdk.NewCoin(shareDenom, gammtypes.InitPoolSharesSupply.QuoRaw(2))
+	err = suite.App.BankKeeper.SendCoins(suite.Ctx, creator, receiver, sdk.NewCoins(halfShares))
+	suite.Require().NoError(err)
+	suite.Require().ElementsMatch([]sdk.AccAddress{creator, joiner, receiver}, suite.App.IncentivesKeeper.GetLPShareHolders(suite.Ctx, shareDenom))
+
+	// only the shares the creator held throughout the epoch are rewarded
+	gauge := suite.createLPShareGauge(poolId, sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 100)})
+	creatorBalance := suite.App.BankKeeper.GetBalance(suite.Ctx, creator, defaultRewardDenom)
+	distrCoins, err := suite.App.IncentivesKeeper.Distribute(suite.Ctx, []types.Gauge{gauge})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 100)}, distrCoins)
+	suite.Require().Equal(creatorBalance.AddAmount(sdk.NewInt(100)), suite.App.BankKeeper.GetBalance(suite.Ctx, creator, defaultRewardDenom))
+	suite.Require().True(suite.App.BankKeeper.GetBalance(suite.Ctx, joiner, defaultRewardDenom).IsZero())
+	suite.Require().True(suite.App.BankKeeper.GetBalance(suite.Ctx, receiver, defaultRewardDenom).IsZero())
+
+	// on the next epoch, the unchanged share balances are rewarded in full
+	suite.advanceDistrEpoch()
+	gauge = suite.createLPShareGauge(poolId, sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 200)})
+	creatorBalance = suite.App.BankKeeper.GetBalance(suite.Ctx, creator, defaultRewardDenom)
+	_, err = suite.App.IncentivesKeeper.Distribute(suite.Ctx, []types.Gauge{gauge})
+	suite.Require().NoError(err)
+	suite.Require().Equal(creatorBalance.AddAmount(sdk.NewInt(50)), suite.App.BankKeeper.GetBalance(suite.Ctx, creator, defaultRewardDenom))
+	suite.Require().Equal(sdk.NewInt64Coin(defaultRewardDenom, 100), suite.App.BankKeeper.GetBalance(suite.Ctx, joiner, defaultRewardDenom))
+	suite.Require().Equal(sdk.NewInt64Coin(defaultRewardDenom, 50), suite.App.BankKeeper.GetBalance(suite.Ctx, receiver, defaultRewardDenom))
 }
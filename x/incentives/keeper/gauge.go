@@ -12,6 +12,7 @@ import (
 	db "github.com/tendermint/tm-db"
 
 	epochtypes "github.com/osmosis-labs/osmosis/v12/x/epochs/types"
+	gammtypes "github.com/osmosis-labs/osmosis/v12/x/gamm/types"
 	"github.com/osmosis-labs/osmosis/v12/x/incentives/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v12/x/lockup/types"
 
@@ -107,6 +108,13 @@ func (k Keeper) CreateGauge(ctx sdk.Context, isPerpetual bool, owner sdk.AccAddr
 		}
 	}
 
+	// Ensure that a gauge distributing to unlocked balances pays out to pool shares
+	if distrTo.LockQueryType == lockuptypes.NoLock {
+		if !strings.HasPrefix(distrTo.Denom, "gamm/pool/") || gammtypes.ValidatePoolShareDenom(distrTo.Denom) != nil {
+			return 0, fmt.Errorf("gauges without locks can only distribute to pool shares, got denom: %s", distrTo.Denom)
+		}
+	}
+
 	// Ensure that the denom this gauge pays out to exists on-chain
 	if !k.bk.HasSupply(ctx, distrTo.Denom) && !strings.Contains(distrTo.Denom, "osmovaloper") {
 		return 0, fmt.Errorf("denom does not exist: %s", distrTo.Denom)
@@ -119,6 +127,7 @@ func (k Keeper) CreateGauge(ctx sdk.Context, isPerpetual bool, owner sdk.AccAddr
 		Coins:             coins,
 		StartTime:         startTime,
 		NumEpochsPaidOver: numEpochsPaidOver,
+		Owner:             owner.String(),
 	}
 
 	if err := k.bk.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, gauge.Coins); err != nil {
@@ -153,6 +162,9 @@ func (k Keeper) AddToGaugeRewards(ctx sdk.Context, owner sdk.AccAddress, coins s
 	}
 
 	gauge.Coins = gauge.Coins.Add(coins...)
+	if err := k.addGaugeContribution(ctx, *gauge, owner, coins); err != nil {
+		return err
+	}
 	err = k.setGauge(ctx, gauge)
 	if err != nil {
 		return err
@@ -161,6 +173,91 @@ func (k Keeper) AddToGaugeRewards(ctx sdk.Context, owner sdk.AccAddress, coins s
 	return nil
 }
 
+// CancelGauge cancels a gauge that has not finished its distribution yet, and refunds the coins it has not
+// distributed to its contributors. The gauge is moved to the finished gauges, and returns the refunded coins.
+func (k Keeper) CancelGauge(ctx sdk.Context, owner sdk.AccAddress, gaugeID uint64) (sdk.Coins, error) {
+	gauge, err := k.getOwnedGauge(ctx, owner, gaugeID)
+	if err != nil {
+		return nil, err
+	}
+
+	timeKey := getTimeKey(gauge.StartTime)
+	upcomingKey := combineKeys(types.KeyPrefixUpcomingGauges, timeKey)
+	activeKey := combineKeys(types.KeyPrefixActiveGauges, timeKey)
+	if findIndex(k.getGaugeRefs(ctx, upcomingKey), gauge.Id) > -1 {
+		err = k.deleteGaugeRefByKey(ctx, upcomingKey, gauge.Id)
+	} else if findIndex(k.getGaugeRefs(ctx, activeKey), gauge.Id) > -1 {
+		err = k.deleteGaugeRefByKey(ctx, activeKey, gauge.Id)
+	} else {
+		return nil, fmt.Errorf("gauge with ID %d has already finished its distribution", gaugeID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := k.addGaugeRefByKey(ctx, combineKeys(types.KeyPrefixFinishedGauges, timeKey), gauge.Id); err != nil {
+		return nil, err
+	}
+	if err := k.deleteGaugeIDForDenom(ctx, gauge.Id, gauge.DistributeTo.Denom); err != nil {
+		return nil, err
+	}
+
+	refundCoins, err := k.refundUndistributedCoins(ctx, *gauge)
+	if err != nil {
+		return nil, err
+	}
+	k.hooks.AfterFinishDistribution(ctx, gauge.Id)
+	return refundCoins, nil
+}
+
+// WithdrawUndistributed withdraws the coins a finished gauge has not distributed to its contributors,
+// such as the remainders of the distribution rounding, and returns the withdrawn coins.
+func (k Keeper) WithdrawUndistributed(ctx sdk.Context, owner sdk.AccAddress, gaugeID uint64) (sdk.Coins, error) {
+	gauge, err := k.getOwnedGauge(ctx, owner, gaugeID)
+	if err != nil {
+		return nil, err
+	}
+
+	finishedKey := combineKeys(types.KeyPrefixFinishedGauges, getTimeKey(gauge.StartTime))
+	if findIndex(k.getGaugeRefs(ctx, finishedKey), gauge.Id) < 0 {
+		return nil, fmt.Errorf("gauge with ID %d has not finished its distribution yet", gaugeID)
+	}
+
+	withdrawCoins, err := k.refundUndistributedCoins(ctx, *gauge)
+	if err != nil {
+		return nil, err
+	}
+	if withdrawCoins.Empty() {
+		return nil, fmt.Errorf("gauge with ID %d has no undistributed coins", gaugeID)
+	}
+	return withdrawCoins, nil
+}
+
+// getOwnedGauge returns the gauge with the provided ID, if it is owned by the provided owner.
+func (k Keeper) getOwnedGauge(ctx sdk.Context, owner sdk.AccAddress, gaugeID uint64) (*types.Gauge, error) {
+	gauge, err := k.GetGaugeByID(ctx, gaugeID)
+	if err != nil {
+		return nil, err
+	}
+	if gauge.Owner == "" || gauge.Owner != owner.String() {
+		return nil, fmt.Errorf("gauge with ID %d is not owned by %s", gaugeID, owner)
+	}
+	return gauge, nil
+}
+
+// refundUndistributedCoins refunds all the coins the gauge has neither distributed nor refunded yet to its contributors,
+// and returns the refunded coins.
+func (k Keeper) refundUndistributedCoins(ctx sdk.Context, gauge types.Gauge) (sdk.Coins, error) {
+	refundCoins := gauge.UndistributedCoins()
+	if err := k.refundGaugeCoins(ctx, gauge, refundCoins); err != nil {
+		return nil, err
+	}
+	gauge.RefundedCoins = gauge.RefundedCoins.Add(refundCoins...)
+	if err := k.setGauge(ctx, &gauge); err != nil {
+		return nil, err
+	}
+	return refundCoins, nil
+}
+
 // GetGaugeByID returns gauge from gauge ID.
 func (k Keeper) GetGaugeByID(ctx sdk.Context, gaugeID uint64) (*types.Gauge, error) {
 	gauge := types.Gauge{}
@@ -241,6 +338,10 @@ func (k Keeper) GetRewardsEst(ctx sdk.Context, addr sdk.AccAddress, locks []lock
 			if err != nil {
 				return sdk.Coins{}
 			}
+			// LP share gauges do not reward locks
+			if gauge.DistributeTo.LockQueryType == lockuptypes.NoLock {
+				continue
+			}
 			gauges = append(gauges, *gauge)
 		}
 	}
@@ -111,6 +111,7 @@ func (suite *KeeperTestSuite) TestGaugeOperations() {
 			FilledEpochs:      0,
 			DistributedCoins:  sdk.Coins{},
 			StartTime:         startTime,
+			Owner:             defaultGaugeOwner.String(),
 		}
 		suite.Require().Equal(expectedGauge.String(), gauges[0].String())
 
@@ -332,3 +333,179 @@ func (suite *KeeperTestSuite) TestChargeFeeIfSufficientFeeDenomBalance() {
 		})
 	}
 }
+
+// TestCancelGauge tests that cancelling a gauge refunds its undistributed coins to the owner and finishes the gauge.
+func (suite *KeeperTestSuite) TestCancelGauge() {
+	testCases := []struct {
+		name            string
+		isActive        bool
+		isFinished      bool
+		nonOwner        bool
+		expectedRefund  sdk.Coins
+		expectedDistrTo sdk.Coins
+		expectErr       bool
+	}{
+		{
+			name:           "cancel upcoming gauge",
+			expectedRefund: sdk.Coins{sdk.NewInt64Coin("stake", 10)},
+		},
+		{
+			name:            "cancel active gauge after one distribution",
+			isActive:        true,
+			expectedRefund:  sdk.Coins{sdk.NewInt64Coin("stake", 5)},
+			expectedDistrTo: sdk.Coins{sdk.NewInt64Coin("stake", 5)},
+		},
+		{
+			name:      "cancel gauge not owned by sender",
+			nonOwner:  true,
+			expectErr: true,
+		},
+		{
+			name:       "cancel finished gauge",
+			isActive:   true,
+			isFinished: true,
+			expectErr:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			lockOwner, gaugeID, _, startTime := suite.SetupLockAndGauge(false)
+
+			if tc.isActive {
+				suite.Ctx = suite.Ctx.WithBlockTime(startTime)
+				gauge, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gaugeID)
+				suite.Require().NoError(err)
+				err = suite.App.IncentivesKeeper.MoveUpcomingGaugeToActiveGauge(suite.Ctx, *gauge)
+				suite.Require().NoError(err)
+				_, err = suite.App.IncentivesKeeper.Distribute(suite.Ctx, []types.Gauge{*gauge})
+				suite.Require().NoError(err)
+			}
+			if tc.isFinished {
+				gauge, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gaugeID)
+				suite.Require().NoError(err)
+				err = suite.App.IncentivesKeeper.MoveActiveGaugeToFinishedGauge(suite.Ctx, *gauge)
+				suite.Require().NoError(err)
+			}
+
+			sender := defaultGaugeOwner
+			if tc.nonOwner {
+				sender = lockOwner
+			}
+			balanceBefore := suite.App.BankKeeper.GetAllBalances(suite.Ctx, defaultGaugeOwner)
+
+			refundCoins, err := suite.App.IncentivesKeeper.CancelGauge(suite.Ctx, sender, gaugeID)
+			if tc.expectErr {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expectedRefund, refundCoins)
+
+			// the refund is sent back to the gauge owner
+			balanceAfter := suite.App.BankKeeper.GetAllBalances(suite.Ctx, defaultGaugeOwner)
+			suite.Require().Equal(balanceBefore.Add(tc.expectedRefund...), balanceAfter)
+
+			// the gauge is finished, with nothing left to distribute
+			gauge, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gaugeID)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expectedRefund, gauge.RefundedCoins)
+			suite.Require().True(gauge.UndistributedCoins().Empty())
+			suite.Require().Equal(tc.expectedDistrTo.String(), gauge.DistributedCoins.String())
+			suite.Require().Len(suite.App.IncentivesKeeper.GetFinishedGauges(suite.Ctx), 1)
+			suite.Require().Len(suite.App.IncentivesKeeper.GetNotFinishedGauges(suite.Ctx), 0)
+			suite.Require().Len(suite.App.IncentivesKeeper.GetAllGaugeIDsByDenom(suite.Ctx, "lptoken"), 0)
+
+			// a cancelled gauge cannot be cancelled twice
+			_, err = suite.App.IncentivesKeeper.CancelGauge(suite.Ctx, sender, gaugeID)
+			suite.Require().Error(err)
+		})
+	}
+}
+
+// TestCancelGaugeRefundsContributors tests that cancelling a gauge refunds every address that added coins to it
+// pro rata of its contribution, rather than refunding everything to the owner.
+func (suite *KeeperTestSuite) TestCancelGaugeRefundsContributors() {
+	suite.SetupTest()
+	_, gaugeID, _, startTime := suite.SetupLockAndGauge(false)
+
+	// a third party tops up the 10 stake of the gauge with 30 stake
+	contributor := sdk.AccAddress([]byte("addr1---------------"))
+	suite.FundAcc(contributor, sdk.Coins{sdk.NewInt64Coin("stake", 30)})
+	err := suite.App.IncentivesKeeper.AddToGaugeRewards(suite.Ctx, contributor, sdk.Coins{sdk.NewInt64Coin("stake", 30)}, gaugeID)
+	suite.Require().NoError(err)
+
+	// the coins the owner adds are not recorded as a contribution
+	suite.FundAcc(defaultGaugeOwner, sdk.Coins{sdk.NewInt64Coin("stake", 10)})
+	err = suite.App.IncentivesKeeper.AddToGaugeRewards(suite.Ctx, defaultGaugeOwner, sdk.Coins{sdk.NewInt64Coin("stake", 10)}, gaugeID)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.GaugeContribution{{
+		GaugeId: gaugeID,
+		Address: contributor.String(),
+		Coins:   sdk.Coins{sdk.NewInt64Coin("stake", 30)},
+	}}, suite.App.IncentivesKeeper.GetGaugeContributions(suite.Ctx, gaugeID))
+
+	// half of the gauge is distributed in the first of its two epochs
+	suite.Ctx = suite.Ctx.WithBlockTime(startTime)
+	gauge, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gaugeID)
+	suite.Require().NoError(err)
+	err = suite.App.IncentivesKeeper.MoveUpcomingGaugeToActiveGauge(suite.Ctx, *gauge)
+	suite.Require().NoError(err)
+	_, err = suite.App.IncentivesKeeper.Distribute(suite.Ctx, []types.Gauge{*gauge})
+	suite.Require().NoError(err)
+
+	ownerBalance := suite.App.BankKeeper.GetAllBalances(suite.Ctx, defaultGaugeOwner)
+	contributorBalance := suite.App.BankKeeper.GetAllBalances(suite.Ctx, contributor)
+	refundCoins, err := suite.App.IncentivesKeeper.CancelGauge(suite.Ctx, defaultGaugeOwner, gaugeID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 25)}, refundCoins)
+
+	// the 25 stake left are refunded 2:3, as contributed
+	suite.Require().Equal(ownerBalance.Add(sdk.NewInt64Coin("stake", 10)), suite.App.BankKeeper.GetAllBalances(suite.Ctx, defaultGaugeOwner))
+	suite.Require().Equal(contributorBalance.Add(sdk.NewInt64Coin("stake", 15)), suite.App.BankKeeper.GetAllBalances(suite.Ctx, contributor))
+}
+
+// TestWithdrawUndistributed tests that the owner of a finished gauge can withdraw the coins left over from distribution.
+func (suite *KeeperTestSuite) TestWithdrawUndistributed() {
+	suite.SetupTest()
+
+	// three equal synthetic locks, which are rewarded directly rather than through the reward accumulators,
+	// share 10 stake, leaving 1 stake undistributed
+	suite.SetupUserSyntheticLocks([]userLocks{oneSyntheticLockupUser, oneSyntheticLockupUser, oneSyntheticLockupUser})
+	startTime := suite.Ctx.BlockTime()
+	distrTo := lockuptypes.QueryCondition{
+		LockQueryType: lockuptypes.ByDuration,
+		Denom:         defaultLPSyntheticDenom,
+		Duration:      defaultLockDuration,
+	}
+	gaugeID, gauge := suite.CreateGauge(false, defaultGaugeOwner, sdk.Coins{sdk.NewInt64Coin("stake", 10)}, distrTo, startTime, 1)
+
+	err := suite.App.IncentivesKeeper.MoveUpcomingGaugeToActiveGauge(suite.Ctx, *gauge)
+	suite.Require().NoError(err)
+
+	// the gauge has not finished yet
+	_, err = suite.App.IncentivesKeeper.WithdrawUndistributed(suite.Ctx, defaultGaugeOwner, gaugeID)
+	suite.Require().Error(err)
+
+	// distributing the only epoch finishes the gauge
+	distrCoins, err := suite.App.IncentivesKeeper.Distribute(suite.Ctx, []types.Gauge{*gauge})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 9)}, distrCoins)
+	suite.Require().Len(suite.App.IncentivesKeeper.GetFinishedGauges(suite.Ctx), 1)
+
+	// only the owner can withdraw
+	_, err = suite.App.IncentivesKeeper.WithdrawUndistributed(suite.Ctx, sdk.AccAddress([]byte("addr1---------------")), gaugeID)
+	suite.Require().Error(err)
+
+	balanceBefore := suite.App.BankKeeper.GetAllBalances(suite.Ctx, defaultGaugeOwner)
+	withdrawCoins, err := suite.App.IncentivesKeeper.WithdrawUndistributed(suite.Ctx, defaultGaugeOwner, gaugeID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 1)}, withdrawCoins)
+	balanceAfter := suite.App.BankKeeper.GetAllBalances(suite.Ctx, defaultGaugeOwner)
+	suite.Require().Equal(balanceBefore.Add(withdrawCoins...), balanceAfter)
+
+	// nothing is left to withdraw
+	_, err = suite.App.IncentivesKeeper.WithdrawUndistributed(suite.Ctx, defaultGaugeOwner, gaugeID)
+	suite.Require().Error(err)
+}
@@ -17,14 +17,50 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 			panic(err)
 		}
 	}
+	for _, holder := range genState.LpShareHolders {
+		if err := k.setLPShareHolder(ctx, holder); err != nil {
+			panic(err)
+		}
+	}
+	for _, acc := range genState.RewardAccumulators {
+		if err := k.setRewardAccumulator(ctx, acc); err != nil {
+			panic(err)
+		}
+	}
+	for _, checkpoint := range genState.LockRewardCheckpoints {
+		if err := k.setLockRewardCheckpoint(ctx, checkpoint); err != nil {
+			panic(err)
+		}
+	}
+	for _, unclaimed := range genState.UnclaimedRewards {
+		if err := k.setUnclaimedRewards(ctx, sdk.MustAccAddressFromBech32(unclaimed.Owner), unclaimed.Rewards); err != nil {
+			panic(err)
+		}
+	}
+	for _, contribution := range genState.GaugeContributions {
+		if err := k.setGaugeContribution(ctx, contribution); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis returns the x/incentives module's exported genesis.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	gauges := k.GetNotFinishedGauges(ctx)
+	// only the contributions to the exported gauges are exported
+	contributions := []types.GaugeContribution{}
+	for _, gauge := range gauges {
+		contributions = append(contributions, k.GetGaugeContributions(ctx, gauge.Id)...)
+	}
 	return &types.GenesisState{
-		Params:            k.GetParams(ctx),
-		LockableDurations: k.GetLockableDurations(ctx),
-		Gauges:            k.GetNotFinishedGauges(ctx),
-		LastGaugeId:       k.GetLastGaugeID(ctx),
+		Params:                k.GetParams(ctx),
+		LockableDurations:     k.GetLockableDurations(ctx),
+		Gauges:                gauges,
+		LastGaugeId:           k.GetLastGaugeID(ctx),
+		LpShareHolders:        k.GetAllLPShareHolders(ctx),
+		RewardAccumulators:    k.GetAllRewardAccumulators(ctx),
+		LockRewardCheckpoints: k.GetAllLockRewardCheckpoints(ctx),
+		UnclaimedRewards:      k.GetAllUnclaimedRewards(ctx),
+		GaugeContributions:    contributions,
 	}
 }
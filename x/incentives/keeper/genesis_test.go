@@ -63,6 +63,7 @@ func TestIncentivesExportGenesis(t *testing.T) {
 		FilledEpochs:      0,
 		DistributedCoins:  sdk.Coins(nil),
 		StartTime:         startTime.UTC(),
+		Owner:             addr.String(),
 	})
 }
 
@@ -94,7 +95,32 @@ func TestIncentivesInitGenesis(t *testing.T) {
 		StartTime:         startTime.UTC(),
 	}
 
-	// initialize genesis with specified parameter, the gauge created earlier, and lockable durations
+	// track a holder of unlocked pool shares
+	lpShareHolderAddr := sdk.AccAddress([]byte("addr1---------------"))
+	lpShareHolder := types.LPShareHolder{Denom: "gamm/pool/1", Address: lpShareHolderAddr.String(), QualifyingShares: sdk.NewInt(10), EpochNumber: 1}
+
+	// a reward accumulator, the checkpoint of a lock sharing in it and the rewards settled to its owner
+	rewardAccumulator := types.RewardAccumulator{
+		Denom:          "lptoken",
+		Duration:       time.Second,
+		RewardPerShare: sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(15, 1))),
+	}
+	lockRewardCheckpoint := types.LockRewardCheckpoint{
+		LockId:       1,
+		Owner:        lpShareHolderAddr.String(),
+		Coins:        sdk.Coins{sdk.NewInt64Coin("lptoken", 10)},
+		Duration:     time.Hour,
+		Accumulators: []types.RewardAccumulator{rewardAccumulator},
+	}
+	unclaimedRewards := types.UnclaimedRewards{
+		Owner:   lpShareHolderAddr.String(),
+		Rewards: sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(25, 1))),
+	}
+
+	// a contribution to the gauge by an address other than its owner
+	gaugeContribution := types.GaugeContribution{GaugeId: 1, Address: lpShareHolderAddr.String(), Coins: sdk.Coins{sdk.NewInt64Coin("stake", 100)}}
+
+	// initialize genesis with specified parameter, the gauge created earlier, lockable durations, LP share holders, rewards and contributions
 	app.IncentivesKeeper.InitGenesis(ctx, types.GenesisState{
 		Params: types.Params{
 			DistrEpochIdentifier: "week",
@@ -106,10 +132,30 @@ func TestIncentivesInitGenesis(t *testing.T) {
 			time.Hour * 3,
 			time.Hour * 7,
 		},
+		LpShareHolders:        []types.LPShareHolder{lpShareHolder},
+		RewardAccumulators:    []types.RewardAccumulator{rewardAccumulator},
+		LockRewardCheckpoints: []types.LockRewardCheckpoint{lockRewardCheckpoint},
+		UnclaimedRewards:      []types.UnclaimedRewards{unclaimedRewards},
+		GaugeContributions:    []types.GaugeContribution{gaugeContribution},
 	})
 
 	// check that the gauge created earlier was initialized through initGenesis and still exists on chain
 	gauges := app.IncentivesKeeper.GetGauges(ctx)
 	require.Len(t, gauges, 1)
 	require.Equal(t, gauges[0], gauge)
+
+	// check that the LP share holder was initialized through initGenesis and is exported back
+	require.Equal(t, []sdk.AccAddress{lpShareHolderAddr}, app.IncentivesKeeper.GetLPShareHolders(ctx, lpShareHolder.Denom))
+	require.Equal(t, []types.LPShareHolder{lpShareHolder}, app.IncentivesKeeper.ExportGenesis(ctx).LpShareHolders)
+
+	// check that the rewards were initialized through initGenesis and are exported back
+	genesis := app.IncentivesKeeper.ExportGenesis(ctx)
+	require.Equal(t, []types.RewardAccumulator{rewardAccumulator}, genesis.RewardAccumulators)
+	require.Equal(t, []types.LockRewardCheckpoint{lockRewardCheckpoint}, genesis.LockRewardCheckpoints)
+	require.Equal(t, []types.UnclaimedRewards{unclaimedRewards}, genesis.UnclaimedRewards)
+	require.Equal(t, unclaimedRewards.Rewards, app.IncentivesKeeper.GetUnclaimedRewards(ctx, lpShareHolderAddr))
+
+	// check that the gauge contribution was initialized through initGenesis and is exported back
+	require.Equal(t, []types.GaugeContribution{gaugeContribution}, app.IncentivesKeeper.GetGaugeContributions(ctx, gauge.Id))
+	require.Equal(t, []types.GaugeContribution{gaugeContribution}, genesis.GaugeContributions)
 }
@@ -167,6 +167,20 @@ func (q Querier) RewardsEst(goCtx context.Context, req *types.RewardsEstRequest)
 	return &types.RewardsEstResponse{Coins: q.Keeper.GetRewardsEst(ctx, ownerAddress, locks, req.EndEpoch)}, nil
 }
 
+// ClaimableRewards returns the rewards accrued by the locks of an account that it can claim.
+func (q Querier) ClaimableRewards(goCtx context.Context, req *types.QueryClaimableRewardsRequest) (*types.QueryClaimableRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.QueryClaimableRewardsResponse{Coins: q.Keeper.GetClaimableRewards(ctx, owner)}, nil
+}
+
 // LockableDurations returns all of the allowed lockable durations on chain.
 func (q Querier) LockableDurations(ctx context.Context, _ *types.QueryLockableDurationsRequest) (*types.QueryLockableDurationsResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
@@ -44,6 +44,7 @@ func (suite *KeeperTestSuite) TestGRPCGaugeByID() {
 		FilledEpochs:      0,
 		DistributedCoins:  sdk.Coins{},
 		StartTime:         startTime,
+		Owner:             defaultGaugeOwner.String(),
 	}
 	suite.Require().Equal(res.Gauge.String(), expectedGauge.String())
 }
@@ -77,6 +78,7 @@ func (suite *KeeperTestSuite) TestGRPCGauges() {
 		FilledEpochs:      0,
 		DistributedCoins:  sdk.Coins{},
 		StartTime:         startTime,
+		Owner:             defaultGaugeOwner.String(),
 	}
 	suite.Require().Equal(res.Data[0].String(), expectedGauge.String())
 
@@ -124,6 +126,7 @@ func (suite *KeeperTestSuite) TestGRPCActiveGauges() {
 		FilledEpochs:      0,
 		DistributedCoins:  sdk.Coins{},
 		StartTime:         startTime,
+		Owner:             defaultGaugeOwner.String(),
 	}
 	suite.Require().Equal(res.Data[0].String(), expectedGauge.String())
 
@@ -178,6 +181,7 @@ func (suite *KeeperTestSuite) TestGRPCActiveGaugesPerDenom() {
 		FilledEpochs:      0,
 		DistributedCoins:  sdk.Coins{},
 		StartTime:         startTime,
+		Owner:             defaultGaugeOwner.String(),
 	}
 	suite.Require().Equal(res.Data[0].String(), expectedGauge.String())
 
@@ -234,6 +238,7 @@ func (suite *KeeperTestSuite) TestGRPCUpcomingGauges() {
 		FilledEpochs:      0,
 		DistributedCoins:  sdk.Coins{},
 		StartTime:         startTime,
+		Owner:             defaultGaugeOwner.String(),
 	}
 	suite.Require().Equal(res.Data[0].String(), expectedGauge.String())
 
@@ -288,6 +293,7 @@ func (suite *KeeperTestSuite) TestGRPCUpcomingGaugesPerDenom() {
 		FilledEpochs:      0,
 		DistributedCoins:  sdk.Coins{},
 		StartTime:         startTime,
+		Owner:             defaultGaugeOwner.String(),
 	}
 	suite.Require().Equal(res.UpcomingGauges[0].String(), expectedGauge.String())
 
@@ -433,16 +439,16 @@ func (suite *KeeperTestSuite) TestGRPCToDistributeCoins() {
 	// distribute coins to stakers
 	distrCoins, err := suite.querier.Distribute(suite.Ctx, gauges)
 	suite.Require().NoError(err)
-	suite.Require().Equal(distrCoins, sdk.Coins{sdk.NewInt64Coin("stake", 4)})
+	suite.Require().Equal(distrCoins, sdk.Coins{sdk.NewInt64Coin("stake", 5)})
 
 	// check gauge changes after distribution
 	// ensure the gauge's filled epochs have been increased by 1
-	// ensure we have distributed 4 out of the 10 stake tokens
+	// ensure we have distributed 5 out of the 10 stake tokens
 	gauge, err = suite.querier.GetGaugeByID(suite.Ctx, gaugeID)
 	suite.Require().NoError(err)
 	suite.Require().NotNil(gauge)
 	suite.Require().Equal(gauge.FilledEpochs, uint64(1))
-	suite.Require().Equal(gauge.DistributedCoins, sdk.Coins{sdk.NewInt64Coin("stake", 4)})
+	suite.Require().Equal(gauge.DistributedCoins, sdk.Coins{sdk.NewInt64Coin("stake", 5)})
 	gauges = []types.Gauge{*gauge}
 
 	// move gauge from an upcoming to an active status
@@ -450,7 +456,7 @@ func (suite *KeeperTestSuite) TestGRPCToDistributeCoins() {
 	err = suite.querier.MoveUpcomingGaugeToActiveGauge(suite.Ctx, *gauge)
 	suite.Require().NoError(err)
 
-	// check that the to distribute coins is equal to the initial gauge coin balance minus what has been distributed already (10-4=6)
+	// check that the to distribute coins is equal to the initial gauge coin balance minus what has been distributed already (10-5=5)
 	res, err = suite.querier.ModuleToDistributeCoins(sdk.WrapSDKContext(suite.Ctx), &types.ModuleToDistributeCoinsRequest{})
 	suite.Require().NoError(err)
 	suite.Require().Equal(res.Coins, coins.Sub(distrCoins))
@@ -458,9 +464,9 @@ func (suite *KeeperTestSuite) TestGRPCToDistributeCoins() {
 	// distribute second round to stakers
 	distrCoins, err = suite.querier.Distribute(suite.Ctx, gauges)
 	suite.Require().NoError(err)
-	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 6)}, distrCoins)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 5)}, distrCoins)
 
-	// now that all coins have been distributed (4 in first found 6 in the second round)
+	// now that all coins have been distributed (5 in first found 5 in the second round)
 	// to distribute coins should be null
 	res, err = suite.querier.ModuleToDistributeCoins(sdk.WrapSDKContext(suite.Ctx), &types.ModuleToDistributeCoinsRequest{})
 	suite.Require().NoError(err)
@@ -492,20 +498,20 @@ func (suite *KeeperTestSuite) TestGRPCDistributedCoins() {
 	// distribute coins to stakers
 	distrCoins, err := suite.querier.Distribute(suite.Ctx, gauges)
 	suite.Require().NoError(err)
-	suite.Require().Equal(distrCoins, sdk.Coins{sdk.NewInt64Coin("stake", 4)})
+	suite.Require().Equal(distrCoins, sdk.Coins{sdk.NewInt64Coin("stake", 5)})
 
 	// check gauge changes after distribution
 	// ensure the gauge's filled epochs have been increased by 1
-	// ensure we have distributed 4 out of the 10 stake tokens
+	// ensure we have distributed 5 out of the 10 stake tokens
 	gauge, err = suite.querier.GetGaugeByID(suite.Ctx, gaugeID)
 	suite.Require().NoError(err)
 	suite.Require().NotNil(gauge)
 	suite.Require().Equal(gauge.FilledEpochs, uint64(1))
-	suite.Require().Equal(gauge.DistributedCoins, sdk.Coins{sdk.NewInt64Coin("stake", 4)})
+	suite.Require().Equal(gauge.DistributedCoins, sdk.Coins{sdk.NewInt64Coin("stake", 5)})
 	gauges = []types.Gauge{*gauge}
 
 	// distribute second round to stakers
 	distrCoins, err = suite.querier.Distribute(suite.Ctx, gauges)
 	suite.Require().NoError(err)
-	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 6)}, distrCoins)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 5)}, distrCoins)
 }
@@ -1,11 +1,14 @@
 package keeper
 
 import (
+	"time"
+
 	epochstypes "github.com/osmosis-labs/osmosis/v12/x/epochs/types"
 	"github.com/osmosis-labs/osmosis/v12/x/incentives/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v12/x/lockup/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 // BeforeEpochStart is the epoch start hook.
@@ -71,3 +74,86 @@ func (h Hooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNu
 func (h Hooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
 	return h.k.AfterEpochEnd(ctx, epochIdentifier, epochNumber)
 }
+
+// ___________________________________________________________________________________________________
+
+// bankhook is the wrapper struct for the incentives keeper, listening to sends of pool shares.
+type bankhook struct {
+	k Keeper
+}
+
+var _ banktypes.BankHooks = bankhook{}
+
+// BankHooks returns the bank hook wrapper struct, tracking the holders of unlocked pool shares.
+func (k Keeper) BankHooks() banktypes.BankHooks {
+	return bankhook{k}
+}
+
+// BeforeSend tracks the holders of the pool shares about to be sent. It covers the pool shares minted to
+// joining accounts and burned from exiting accounts, which are sent through the gamm module account.
+func (h bankhook) BeforeSend(ctx sdk.Context, from, to sdk.AccAddress, amount sdk.Coins) error {
+	return h.k.trackLPShareSend(ctx, from, to, amount)
+}
+
+// ___________________________________________________________________________________________________
+
+// lockuphook is the wrapper struct for the incentives keeper, listening to lock changes.
+type lockuphook struct {
+	k Keeper
+}
+
+var _ lockuptypes.LockupHooks = lockuphook{}
+
+// LockupHooks returns the lockup hook wrapper struct, settling the rewards of locks whenever they change.
+func (k Keeper) LockupHooks() lockuptypes.LockupHooks {
+	return lockuphook{k}
+}
+
+// settleLockRewards settles the rewards of the lock, logging the errors as lockup hooks cannot fail.
+func (h lockuphook) settleLockRewards(ctx sdk.Context, lockID uint64) {
+	if err := h.k.settleLockRewards(ctx, lockID); err != nil {
+		h.k.Logger(ctx).Error(err.Error())
+	}
+}
+
+func (h lockuphook) AfterAddTokensToLock(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins) {
+	h.settleLockRewards(ctx, lockID)
+}
+
+func (h lockuphook) OnTokenLocked(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time) {
+	h.settleLockRewards(ctx, lockID)
+}
+
+func (h lockuphook) OnStartUnlock(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time) {
+	h.settleLockRewards(ctx, lockID)
+}
+
+func (h lockuphook) OnTokenUnlocked(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time) {
+	h.settleLockRewards(ctx, lockID)
+}
+
+func (h lockuphook) OnTokenSlashed(ctx sdk.Context, lockID uint64, amount sdk.Coins) {
+	h.settleLockRewards(ctx, lockID)
+}
+
+func (h lockuphook) OnLockupExtend(ctx sdk.Context, lockID uint64, prevDuration time.Duration, newDuration time.Duration) {
+	h.settleLockRewards(ctx, lockID)
+}
+
+func (h lockuphook) OnLockSplit(ctx sdk.Context, lockID uint64, splitLockID uint64, amount sdk.Coins) {
+	h.settleLockRewards(ctx, lockID)
+	h.settleLockRewards(ctx, splitLockID)
+}
+
+func (h lockuphook) OnLockTransfer(ctx sdk.Context, lockID uint64, prevOwner sdk.AccAddress, newOwner sdk.AccAddress) {
+	h.settleLockRewards(ctx, lockID)
+}
+
+func (h lockuphook) OnLocksMerge(ctx sdk.Context, fromLockID uint64, toLockID uint64, amount sdk.Coins) {
+	h.settleLockRewards(ctx, fromLockID)
+	h.settleLockRewards(ctx, toLockID)
+}
+
+// AfterLockMatured is a noop, as the rewards of matured locks are settled when their tokens are unlocked.
+func (h lockuphook) AfterLockMatured(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time) {
+}
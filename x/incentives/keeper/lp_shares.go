@@ -0,0 +1,217 @@
+package keeper
+
+import (
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+
+	gammtypes "github.com/osmosis-labs/osmosis/v12/x/gamm/types"
+	"github.com/osmosis-labs/osmosis/v12/x/incentives/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/v12/x/lockup/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// lpShareHoldersPrefix returns the store prefix of the holders of the provided share denom.
+func lpShareHoldersPrefix(denom string) []byte {
+	return combineKeys(types.KeyPrefixLPShareHolders, []byte(denom), []byte{})
+}
+
+// setLPShareHolder tracks the holder as a holder of unlocked shares of its share denom, along with its qualifying shares.
+func (k Keeper) setLPShareHolder(ctx sdk.Context, holder types.LPShareHolder) error {
+	addr, err := sdk.AccAddressFromBech32(holder.Address)
+	if err != nil {
+		return err
+	}
+	// the denom and address are already part of the key
+	bz, err := proto.Marshal(&types.LPShareHolder{QualifyingShares: holder.QualifyingShares, EpochNumber: holder.EpochNumber})
+	if err != nil {
+		return err
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), lpShareHoldersPrefix(holder.Denom))
+	store.Set(addr, bz)
+	return nil
+}
+
+// getLPShareHolder returns the provided address as a holder of unlocked shares of the provided share denom, if tracked.
+func (k Keeper) getLPShareHolder(ctx sdk.Context, denom string, addr sdk.AccAddress) (types.LPShareHolder, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), lpShareHoldersPrefix(denom))
+	bz := store.Get(addr)
+	if bz == nil {
+		return types.LPShareHolder{}, false
+	}
+	return unmarshalLPShareHolder(denom, addr, bz), true
+}
+
+// unmarshalLPShareHolder unmarshals a tracked holder of unlocked shares. Holders tracked before their qualifying
+// shares were are stored without any value, so that their whole balance qualifies.
+func unmarshalLPShareHolder(denom string, addr sdk.AccAddress, bz []byte) types.LPShareHolder {
+	holder := types.LPShareHolder{}
+	if err := proto.Unmarshal(bz, &holder); err != nil {
+		panic(err)
+	}
+	holder.Denom = denom
+	holder.Address = addr.String()
+	if holder.QualifyingShares.IsNil() {
+		holder.QualifyingShares = sdk.ZeroInt()
+	}
+	return holder
+}
+
+// deleteLPShareHolder stops tracking the provided address as a holder of shares of the provided share denom.
+func (k Keeper) deleteLPShareHolder(ctx sdk.Context, denom string, addr sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), lpShareHoldersPrefix(denom))
+	store.Delete(addr)
+}
+
+// GetLPShareHolders returns all the addresses tracked as holders of unlocked shares of the provided share denom.
+func (k Keeper) GetLPShareHolders(ctx sdk.Context, denom string) []sdk.AccAddress {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), lpShareHoldersPrefix(denom))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	holders := []sdk.AccAddress{}
+	for ; iterator.Valid(); iterator.Next() {
+		holders = append(holders, sdk.AccAddress(iterator.Key()))
+	}
+	return holders
+}
+
+// GetAllLPShareHolders returns all the tracked holders of unlocked pool shares, across all share denoms.
+func (k Keeper) GetAllLPShareHolders(ctx sdk.Context) []types.LPShareHolder {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), combineKeys(types.KeyPrefixLPShareHolders, []byte{}))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	holders := []types.LPShareHolder{}
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		// keys are of the form {denom}{separator}{address}; denoms never contain the separator.
+		for i, b := range key {
+			if b == types.KeyIndexSeparator[0] {
+				holders = append(holders, unmarshalLPShareHolder(string(key[:i]), sdk.AccAddress(key[i+1:]), iterator.Value()))
+				break
+			}
+		}
+	}
+	return holders
+}
+
+// currentDistrEpoch returns the number of the current distribution epoch.
+func (k Keeper) currentDistrEpoch(ctx sdk.Context) int64 {
+	// only the distribution epoch identifier is read, as this is called on every send of pool shares
+	var distrEpochIdentifier string
+	k.paramSpace.Get(ctx, types.KeyDistrEpochIdentifier, &distrEpochIdentifier)
+	return k.ek.GetEpochInfo(ctx, distrEpochIdentifier).CurrentEpoch
+}
+
+// qualifyingLPShares returns the shares of the holder rewarded for the provided distribution epoch, given its
+// current share balance. Holders whose balance did not change during the epoch qualify with their whole balance.
+func qualifyingLPShares(holder types.LPShareHolder, epochNumber int64, balance sdk.Int) sdk.Int {
+	if holder.EpochNumber == epochNumber {
+		return holder.QualifyingShares
+	}
+	return balance
+}
+
+// updateLPShareHolder updates the tracking of the provided address as a holder of unlocked shares of the provided
+// share denom, for its share balance changing from prevBalance to newBalance. Only the lowest balance of the holder
+// during the current distribution epoch qualifies for the epoch, so that shares joined or received just before
+// a distribution are not rewarded for the whole epoch.
+func (k Keeper) updateLPShareHolder(ctx sdk.Context, addr sdk.AccAddress, denom string, prevBalance, newBalance sdk.Int) error {
+	if !newBalance.IsPositive() {
+		k.deleteLPShareHolder(ctx, denom, addr)
+		return nil
+	}
+
+	epochNumber := k.currentDistrEpoch(ctx)
+	qualifyingShares := prevBalance
+	if holder, found := k.getLPShareHolder(ctx, denom, addr); found {
+		qualifyingShares = qualifyingLPShares(holder, epochNumber, prevBalance)
+	}
+	return k.setLPShareHolder(ctx, types.LPShareHolder{
+		Denom:            denom,
+		Address:          addr.String(),
+		QualifyingShares: sdk.MinInt(qualifyingShares, newBalance),
+		EpochNumber:      epochNumber,
+	})
+}
+
+// isLPShareDenom returns whether the provided denom is a pool share denom.
+func isLPShareDenom(denom string) bool {
+	return strings.HasPrefix(denom, "gamm/pool/") && gammtypes.ValidatePoolShareDenom(denom) == nil
+}
+
+// trackLPShareSend updates the holders of the pool shares about to be sent from an account to another.
+// Module accounts, such as the lockup module account holding locked shares, are not tracked.
+func (k Keeper) trackLPShareSend(ctx sdk.Context, from, to sdk.AccAddress, amount sdk.Coins) error {
+	for _, coin := range amount {
+		if !isLPShareDenom(coin.Denom) {
+			continue
+		}
+		if !k.bk.BlockedAddr(from) {
+			balance := k.bk.GetBalance(ctx, from, coin.Denom).Amount
+			// the send fails later on if the balance is insufficient
+			if balance.GTE(coin.Amount) {
+				if err := k.updateLPShareHolder(ctx, from, coin.Denom, balance, balance.Sub(coin.Amount)); err != nil {
+					return err
+				}
+			}
+		}
+		if !k.bk.BlockedAddr(to) {
+			balance := k.bk.GetBalance(ctx, to, coin.Denom).Amount
+			if err := k.updateLPShareHolder(ctx, to, coin.Denom, balance, balance.Add(coin.Amount)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// getLPShareBalances returns the unlocked share balances of all the tracked holders of the gauge's share denom that
+// qualify for the current distribution epoch. Each balance is returned as a lock owned by the holder, so that the
+// balances can be distributed to like locks.
+func (k Keeper) getLPShareBalances(ctx sdk.Context, gauge types.Gauge) []lockuptypes.PeriodLock {
+	denom := gauge.DistributeTo.Denom
+	epochNumber := k.currentDistrEpoch(ctx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), lpShareHoldersPrefix(denom))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	balances := []lockuptypes.PeriodLock{}
+	for ; iterator.Valid(); iterator.Next() {
+		addr := sdk.AccAddress(iterator.Key())
+		holder := unmarshalLPShareHolder(denom, addr, iterator.Value())
+		shares := qualifyingLPShares(holder, epochNumber, k.bk.GetBalance(ctx, addr, denom).Amount)
+		if !shares.IsPositive() {
+			continue
+		}
+		balances = append(balances, lockuptypes.PeriodLock{
+			Owner: holder.Address,
+			Coins: sdk.NewCoins(sdk.NewCoin(denom, shares)),
+		})
+	}
+	return balances
+}
+
+// MigrateLPShareHolders tracks all the accounts holding unlocked pool shares as holders, with their whole balance
+// qualifying for the current distribution epoch. It is run once, when the holders start being tracked by the bank
+// hooks, as the shares held from before are never sent through the hooks.
+func (k Keeper) MigrateLPShareHolders(ctx sdk.Context) error {
+	epochNumber := k.currentDistrEpoch(ctx)
+	var err error
+	k.bk.IterateAllBalances(ctx, func(addr sdk.AccAddress, coin sdk.Coin) bool {
+		if !isLPShareDenom(coin.Denom) || !coin.Amount.IsPositive() || k.bk.BlockedAddr(addr) {
+			return false
+		}
+		err = k.setLPShareHolder(ctx, types.LPShareHolder{
+			Denom:            coin.Denom,
+			Address:          addr.String(),
+			QualifyingShares: coin.Amount,
+			EpochNumber:      epochNumber,
+		})
+		return err != nil
+	})
+	return err
+}
@@ -49,7 +49,7 @@ func (server msgServer) CreateGauge(goCtx context.Context, msg *types.MsgCreateG
 		),
 	})
 
-	return &types.MsgCreateGaugeResponse{}, nil
+	return &types.MsgCreateGaugeResponse{GaugeId: gaugeID}, nil
 }
 
 // AddToGauge adds coins to gauge.
@@ -78,3 +78,69 @@ func (server msgServer) AddToGauge(goCtx context.Context, msg *types.MsgAddToGau
 
 	return &types.MsgAddToGaugeResponse{}, nil
 }
+
+// CancelGauge cancels a gauge that has not finished its distribution yet, and refunds its undistributed coins to its owner.
+// Emits cancel gauge event and returns the cancel gauge response.
+func (server msgServer) CancelGauge(goCtx context.Context, msg *types.MsgCancelGauge) (*types.MsgCancelGaugeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	refundedCoins, err := server.keeper.CancelGauge(ctx, owner, msg.GaugeId)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtCancelGauge,
+			sdk.NewAttribute(types.AttributeGaugeID, utils.Uint64ToString(msg.GaugeId)),
+		),
+	})
+
+	return &types.MsgCancelGaugeResponse{RefundedCoins: refundedCoins}, nil
+}
+
+// WithdrawUndistributed withdraws the coins a finished gauge has not distributed to its owner.
+// Returns the withdraw undistributed response.
+func (server msgServer) WithdrawUndistributed(goCtx context.Context, msg *types.MsgWithdrawUndistributed) (*types.MsgWithdrawUndistributedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	withdrawnCoins, err := server.keeper.WithdrawUndistributed(ctx, owner, msg.GaugeId)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return &types.MsgWithdrawUndistributedResponse{WithdrawnCoins: withdrawnCoins}, nil
+}
+
+// ClaimRewards claims the rewards accrued by the locks of the owner.
+// Emits claim rewards event and returns the claim rewards response.
+func (server msgServer) ClaimRewards(goCtx context.Context, msg *types.MsgClaimRewards) (*types.MsgClaimRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	claimedCoins, err := server.keeper.ClaimRewards(ctx, owner)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtClaimRewards,
+			sdk.NewAttribute(types.AttributeReceiver, msg.Owner),
+			sdk.NewAttribute(types.AttributeAmount, claimedCoins.String()),
+		),
+	})
+
+	return &types.MsgClaimRewardsResponse{ClaimedCoins: claimedCoins}, nil
+}
@@ -0,0 +1,346 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/osmosis-labs/osmosis/v12/x/incentives/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/v12/x/lockup/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// rewardAccumulatorsPrefix returns the store prefix of the reward accumulators of the provided denom.
+func rewardAccumulatorsPrefix(denom string) []byte {
+	return combineKeys(types.KeyPrefixRewardAccumulator, []byte(denom), []byte{})
+}
+
+// rewardAccumulatorStoreKey returns the store key of the reward accumulator of the provided denom and duration.
+// Durations are big endian encoded, so that the accumulators of a denom are iterated by increasing duration.
+func rewardAccumulatorStoreKey(denom string, duration time.Duration) []byte {
+	return append(rewardAccumulatorsPrefix(denom), sdk.Uint64ToBigEndian(uint64(duration))...)
+}
+
+// lockRewardCheckpointStoreKey returns the store key of the reward checkpoint of the provided lock ID.
+func lockRewardCheckpointStoreKey(lockID uint64) []byte {
+	return combineKeys(types.KeyPrefixLockRewardCheckpoint, sdk.Uint64ToBigEndian(lockID))
+}
+
+// unclaimedRewardsStoreKey returns the store key of the unclaimed rewards of the provided address.
+func unclaimedRewardsStoreKey(addr sdk.AccAddress) []byte {
+	return combineKeys(types.KeyPrefixUnclaimedRewards, addr)
+}
+
+// GetRewardAccumulator returns the reward accumulator of the locks of the provided denom locked for at least
+// the provided duration. An accumulator that has never been distributed to is empty.
+func (k Keeper) GetRewardAccumulator(ctx sdk.Context, denom string, duration time.Duration) types.RewardAccumulator {
+	acc := types.RewardAccumulator{Denom: denom, Duration: duration, RewardPerShare: sdk.DecCoins{}}
+	bz := ctx.KVStore(k.storeKey).Get(rewardAccumulatorStoreKey(denom, duration))
+	if bz == nil {
+		return acc
+	}
+	if err := proto.Unmarshal(bz, &acc); err != nil {
+		panic(err)
+	}
+	return acc
+}
+
+// setRewardAccumulator stores the provided reward accumulator.
+func (k Keeper) setRewardAccumulator(ctx sdk.Context, acc types.RewardAccumulator) error {
+	bz, err := proto.Marshal(&acc)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(rewardAccumulatorStoreKey(acc.Denom, acc.Duration), bz)
+	return nil
+}
+
+// getRewardAccumulatorsFromPrefix returns all the reward accumulators stored under the provided prefix.
+func (k Keeper) getRewardAccumulatorsFromPrefix(ctx sdk.Context, keyPrefix []byte) []types.RewardAccumulator {
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	accs := []types.RewardAccumulator{}
+	for ; iterator.Valid(); iterator.Next() {
+		acc := types.RewardAccumulator{}
+		if err := proto.Unmarshal(iterator.Value(), &acc); err != nil {
+			panic(err)
+		}
+		accs = append(accs, acc)
+	}
+	return accs
+}
+
+// getRewardAccumulatorsByDenom returns the reward accumulators of the provided denom, by increasing duration.
+func (k Keeper) getRewardAccumulatorsByDenom(ctx sdk.Context, denom string) []types.RewardAccumulator {
+	return k.getRewardAccumulatorsFromPrefix(ctx, rewardAccumulatorsPrefix(denom))
+}
+
+// GetAllRewardAccumulators returns all the reward accumulators.
+func (k Keeper) GetAllRewardAccumulators(ctx sdk.Context) []types.RewardAccumulator {
+	return k.getRewardAccumulatorsFromPrefix(ctx, combineKeys(types.KeyPrefixRewardAccumulator, []byte{}))
+}
+
+// getLockRewardCheckpoint returns the reward checkpoint of the provided lock ID, if any.
+func (k Keeper) getLockRewardCheckpoint(ctx sdk.Context, lockID uint64) (types.LockRewardCheckpoint, bool) {
+	checkpoint := types.LockRewardCheckpoint{}
+	bz := ctx.KVStore(k.storeKey).Get(lockRewardCheckpointStoreKey(lockID))
+	if bz == nil {
+		return checkpoint, false
+	}
+	if err := proto.Unmarshal(bz, &checkpoint); err != nil {
+		panic(err)
+	}
+	return checkpoint, true
+}
+
+// setLockRewardCheckpoint stores the provided lock reward checkpoint.
+func (k Keeper) setLockRewardCheckpoint(ctx sdk.Context, checkpoint types.LockRewardCheckpoint) error {
+	bz, err := proto.Marshal(&checkpoint)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(lockRewardCheckpointStoreKey(checkpoint.LockId), bz)
+	return nil
+}
+
+// GetAllLockRewardCheckpoints returns the reward checkpoints of all locks.
+func (k Keeper) GetAllLockRewardCheckpoints(ctx sdk.Context) []types.LockRewardCheckpoint {
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), combineKeys(types.KeyPrefixLockRewardCheckpoint, []byte{})).Iterator(nil, nil)
+	defer iterator.Close()
+
+	checkpoints := []types.LockRewardCheckpoint{}
+	for ; iterator.Valid(); iterator.Next() {
+		checkpoint := types.LockRewardCheckpoint{}
+		if err := proto.Unmarshal(iterator.Value(), &checkpoint); err != nil {
+			panic(err)
+		}
+		checkpoints = append(checkpoints, checkpoint)
+	}
+	return checkpoints
+}
+
+// GetUnclaimedRewards returns the rewards settled to the provided address that it has not claimed yet.
+func (k Keeper) GetUnclaimedRewards(ctx sdk.Context, addr sdk.AccAddress) sdk.DecCoins {
+	bz := ctx.KVStore(k.storeKey).Get(unclaimedRewardsStoreKey(addr))
+	if bz == nil {
+		return sdk.DecCoins{}
+	}
+	unclaimed := types.UnclaimedRewards{}
+	if err := proto.Unmarshal(bz, &unclaimed); err != nil {
+		panic(err)
+	}
+	return unclaimed.Rewards
+}
+
+// setUnclaimedRewards stores the unclaimed rewards of the provided address, deleting them once empty.
+func (k Keeper) setUnclaimedRewards(ctx sdk.Context, addr sdk.AccAddress, rewards sdk.DecCoins) error {
+	store := ctx.KVStore(k.storeKey)
+	if rewards.IsZero() {
+		store.Delete(unclaimedRewardsStoreKey(addr))
+		return nil
+	}
+	bz, err := proto.Marshal(&types.UnclaimedRewards{Owner: addr.String(), Rewards: rewards})
+	if err != nil {
+		return err
+	}
+	store.Set(unclaimedRewardsStoreKey(addr), bz)
+	return nil
+}
+
+// GetAllUnclaimedRewards returns the unclaimed rewards of all accounts.
+func (k Keeper) GetAllUnclaimedRewards(ctx sdk.Context) []types.UnclaimedRewards {
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), combineKeys(types.KeyPrefixUnclaimedRewards, []byte{})).Iterator(nil, nil)
+	defer iterator.Close()
+
+	allUnclaimed := []types.UnclaimedRewards{}
+	for ; iterator.Valid(); iterator.Next() {
+		unclaimed := types.UnclaimedRewards{}
+		if err := proto.Unmarshal(iterator.Value(), &unclaimed); err != nil {
+			panic(err)
+		}
+		allUnclaimed = append(allUnclaimed, unclaimed)
+	}
+	return allUnclaimed
+}
+
+// distributeToAccumulator runs the distribution logic for a gauge distributing to native locks.
+// Rather than sending the rewards of the epoch to every qualifying lock, it adds them per share to the reward
+// accumulator of the gauge's denom and duration, for the lock owners to claim. It also updates the gauge for the distribution.
+func (k Keeper) distributeToAccumulator(ctx sdk.Context, gauge types.Gauge) (sdk.Coins, error) {
+	totalShares := k.lk.GetPeriodLocksAccumulation(ctx, gauge.DistributeTo)
+	if !totalShares.IsPositive() {
+		// the coins of a non-perpetual gauge for an epoch without qualifying locks could never be
+		// distributed, so they are refunded to the owner instead.
+		if !gauge.IsPerpetual && gauge.Owner != "" {
+			return nil, k.refundGaugeEpoch(ctx, gauge)
+		}
+		return nil, nil
+	}
+
+	// if its a perpetual gauge, we set remaining epochs to 1.
+	// otherwise is is a non perpetual gauge and we determine how many epoch payouts are left
+	remainEpochs := uint64(1)
+	if !gauge.IsPerpetual {
+		remainEpochs = gauge.NumEpochsPaidOver - gauge.FilledEpochs
+	}
+
+	distrCoins := sdk.Coins{}
+	for _, coin := range gauge.UndistributedCoins() {
+		// distribution amount = gauge_size / remain_epochs
+		amt := coin.Amount.QuoRaw(int64(remainEpochs))
+		if amt.IsPositive() {
+			distrCoins = distrCoins.Add(sdk.NewCoin(coin.Denom, amt))
+		}
+	}
+
+	// reward per share = distribution amount / total shares
+	rewardPerShare := sdk.NewDecCoinsFromCoins(distrCoins...).QuoDecTruncate(totalShares.ToDec())
+	// the reward per share is truncated, so the locks can only ever claim reward per share * total shares.
+	// Only that much is counted as distributed, the remainder is left in the gauge for the next epochs.
+	distrCoins = sdk.Coins{}
+	for _, coin := range rewardPerShare.MulDec(totalShares.ToDec()) {
+		amt := coin.Amount.Ceil().TruncateInt()
+		if amt.IsPositive() {
+			distrCoins = distrCoins.Add(sdk.NewCoin(coin.Denom, amt))
+		}
+	}
+
+	if !rewardPerShare.IsZero() {
+		acc := k.GetRewardAccumulator(ctx, gauge.DistributeTo.Denom, gauge.DistributeTo.Duration)
+		acc.RewardPerShare = acc.RewardPerShare.Add(rewardPerShare...)
+		if err := k.setRewardAccumulator(ctx, acc); err != nil {
+			return nil, err
+		}
+	}
+
+	err := k.updateGaugePostDistribute(ctx, gauge, distrCoins, sdk.Coins{})
+	return distrCoins, err
+}
+
+// accruedLockRewards returns the rewards a lock has accrued since the provided checkpoint,
+// from every reward accumulator of its denom for a duration the lock qualified for.
+func (k Keeper) accruedLockRewards(ctx sdk.Context, checkpoint types.LockRewardCheckpoint) sdk.DecCoins {
+	// the accumulators of the lock as of the checkpoint. Accumulators missing from the checkpoint
+	// were created after it, so the lock has accrued all of their rewards.
+	checkpointAccs := make(map[string]sdk.DecCoins, len(checkpoint.Accumulators))
+	for _, acc := range checkpoint.Accumulators {
+		checkpointAccs[string(rewardAccumulatorStoreKey(acc.Denom, acc.Duration))] = acc.RewardPerShare
+	}
+
+	rewards := sdk.DecCoins{}
+	for _, coin := range checkpoint.Coins {
+		for _, acc := range k.getRewardAccumulatorsByDenom(ctx, coin.Denom) {
+			if acc.Duration > checkpoint.Duration {
+				break
+			}
+			accrued := acc.RewardPerShare.Sub(checkpointAccs[string(rewardAccumulatorStoreKey(acc.Denom, acc.Duration))])
+			rewards = rewards.Add(accrued.MulDecTruncate(coin.Amount.ToDec())...)
+		}
+	}
+	return rewards
+}
+
+// checkpointLock stores the current state of the lock, along with the current values of the reward accumulators
+// it shares in, for the rewards it accrues from now on to be computed.
+func (k Keeper) checkpointLock(ctx sdk.Context, lock lockuptypes.PeriodLock) error {
+	checkpoint := types.LockRewardCheckpoint{
+		LockId:       lock.ID,
+		Owner:        lock.Owner,
+		Coins:        lock.Coins,
+		Duration:     lock.Duration,
+		Accumulators: []types.RewardAccumulator{},
+	}
+	for _, coin := range lock.Coins {
+		for _, acc := range k.getRewardAccumulatorsByDenom(ctx, coin.Denom) {
+			if acc.Duration > lock.Duration {
+				break
+			}
+			checkpoint.Accumulators = append(checkpoint.Accumulators, acc)
+		}
+	}
+	return k.setLockRewardCheckpoint(ctx, checkpoint)
+}
+
+// settleLockRewards settles the rewards the lock has accrued since its last checkpoint to the owner of the lock
+// as of that checkpoint, then checkpoints the current state of the lock, or deletes its checkpoint if the lock
+// no longer exists. A lock without a checkpoint is a new lock, that has not accrued any rewards yet.
+// It must be called whenever the owner, coins or duration of a lock change.
+func (k Keeper) settleLockRewards(ctx sdk.Context, lockID uint64) error {
+	checkpoint, found := k.getLockRewardCheckpoint(ctx, lockID)
+	if found {
+		rewards := k.accruedLockRewards(ctx, checkpoint)
+		if !rewards.IsZero() {
+			owner, err := sdk.AccAddressFromBech32(checkpoint.Owner)
+			if err != nil {
+				return err
+			}
+			if err := k.setUnclaimedRewards(ctx, owner, k.GetUnclaimedRewards(ctx, owner).Add(rewards...)); err != nil {
+				return err
+			}
+		}
+	}
+
+	lock, err := k.lk.GetLockByID(ctx, lockID)
+	if errors.Is(err, lockuptypes.ErrLockupNotFound) {
+		ctx.KVStore(k.storeKey).Delete(lockRewardCheckpointStoreKey(lockID))
+		return nil
+	} else if err != nil {
+		return err
+	}
+	return k.checkpointLock(ctx, *lock)
+}
+
+// ClaimRewards settles the rewards accrued by all the locks of the owner, then sends all the rewards settled to
+// the owner that it has not claimed yet, and returns them. Decimal remainders are kept for later claims.
+func (k Keeper) ClaimRewards(ctx sdk.Context, owner sdk.AccAddress) (sdk.Coins, error) {
+	for _, lock := range k.lk.GetAccountPeriodLocks(ctx, owner) {
+		if err := k.settleLockRewards(ctx, lock.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	claimCoins, remainder := k.GetUnclaimedRewards(ctx, owner).TruncateDecimal()
+	if claimCoins.Empty() {
+		return nil, fmt.Errorf("no rewards to claim for %s", owner)
+	}
+	if err := k.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, claimCoins); err != nil {
+		return nil, err
+	}
+	if err := k.setUnclaimedRewards(ctx, owner, remainder); err != nil {
+		return nil, err
+	}
+	return claimCoins, nil
+}
+
+// GetClaimableRewards returns the rewards the owner would receive by claiming its rewards now.
+func (k Keeper) GetClaimableRewards(ctx sdk.Context, owner sdk.AccAddress) sdk.Coins {
+	rewards := k.GetUnclaimedRewards(ctx, owner)
+	for _, lock := range k.lk.GetAccountPeriodLocks(ctx, owner) {
+		if checkpoint, found := k.getLockRewardCheckpoint(ctx, lock.ID); found {
+			rewards = rewards.Add(k.accruedLockRewards(ctx, checkpoint)...)
+		}
+	}
+	claimCoins, _ := rewards.TruncateDecimal()
+	return claimCoins
+}
+
+// MigrateLocksToRewardCheckpoints checkpoints all the existing locks, for them to start accruing rewards from the
+// reward accumulators. It is run once, when the distribution of native lock rewards moves from sending the rewards
+// to every lock each epoch to the reward accumulators.
+func (k Keeper) MigrateLocksToRewardCheckpoints(ctx sdk.Context) error {
+	locks, err := k.lk.GetPeriodLocks(ctx)
+	if err != nil {
+		return err
+	}
+	for _, lock := range locks {
+		if err := k.checkpointLock(ctx, lock); err != nil {
+			return err
+		}
+	}
+	return nil
+}
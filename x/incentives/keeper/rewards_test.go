@@ -0,0 +1,275 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v12/x/incentives/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/v12/x/lockup/types"
+)
+
+// createLock funds the address with the provided coins and locks them for the provided duration.
+func (suite *KeeperTestSuite) createLock(addr sdk.AccAddress, coins sdk.Coins, duration time.Duration) lockuptypes.PeriodLock {
+	suite.FundAcc(addr, coins)
+	lock, err := suite.App.LockupKeeper.CreateLock(suite.Ctx, addr, coins, duration)
+	suite.Require().NoError(err)
+	return lock
+}
+
+// distributeGauge distributes the current state of the gauge with the provided ID.
+func (suite *KeeperTestSuite) distributeGauge(gaugeID uint64) {
+	gauge, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gaugeID)
+	suite.Require().NoError(err)
+	_, err = suite.App.IncentivesKeeper.Distribute(suite.Ctx, []types.Gauge{*gauge})
+	suite.Require().NoError(err)
+}
+
+// requireClaimable checks the rewards each address can claim.
+func (suite *KeeperTestSuite) requireClaimable(addrs []sdk.AccAddress, expected []sdk.Coins) {
+	for i, addr := range addrs {
+		claimable := suite.App.IncentivesKeeper.GetClaimableRewards(suite.Ctx, addr)
+		suite.Require().Equal(expected[i].String(), claimable.String(), "address %d", i)
+	}
+}
+
+// TestClaimRewards tests that the rewards of native locks are accumulated for their owners to claim.
+func (suite *KeeperTestSuite) TestClaimRewards() {
+	suite.SetupTest()
+
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	addr2 := sdk.AccAddress([]byte("addr2---------------"))
+	suite.createLock(addr1, sdk.Coins{sdk.NewInt64Coin(defaultLPDenom, 10)}, defaultLockDuration)
+	suite.createLock(addr2, sdk.Coins{sdk.NewInt64Coin(defaultLPDenom, 30)}, 2*defaultLockDuration)
+
+	// the first gauge is shared by both locks, the second one only by the lock of addr2
+	gaugeID1, _, _, _ := suite.setupNewGaugeWithDuration(true, sdk.Coins{sdk.NewInt64Coin("stake", 400)}, defaultLockDuration, defaultLPDenom)
+	gaugeID2, _, _, _ := suite.setupNewGaugeWithDuration(true, sdk.Coins{sdk.NewInt64Coin("stake", 300)}, 2*defaultLockDuration, defaultLPDenom)
+	suite.distributeGauge(gaugeID1)
+	suite.distributeGauge(gaugeID2)
+
+	// distributing does not send any reward
+	suite.Require().True(suite.App.BankKeeper.GetAllBalances(suite.Ctx, addr1).Empty())
+	suite.Require().True(suite.App.BankKeeper.GetAllBalances(suite.Ctx, addr2).Empty())
+	suite.requireClaimable([]sdk.AccAddress{addr1, addr2}, []sdk.Coins{
+		{sdk.NewInt64Coin("stake", 100)},
+		{sdk.NewInt64Coin("stake", 600)},
+	})
+
+	// a lock created after the distribution has not accrued any reward
+	addr3 := sdk.AccAddress([]byte("addr3---------------"))
+	suite.createLock(addr3, sdk.Coins{sdk.NewInt64Coin(defaultLPDenom, 10)}, defaultLockDuration)
+	suite.requireClaimable([]sdk.AccAddress{addr3}, []sdk.Coins{{}})
+	_, err := suite.App.IncentivesKeeper.ClaimRewards(suite.Ctx, addr3)
+	suite.Require().Error(err)
+
+	// claiming sends the rewards to the owner, only once
+	claimed, err := suite.App.IncentivesKeeper.ClaimRewards(suite.Ctx, addr1)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 100)}, claimed)
+	suite.Require().Equal(claimed, suite.App.BankKeeper.GetAllBalances(suite.Ctx, addr1))
+	suite.requireClaimable([]sdk.AccAddress{addr1}, []sdk.Coins{{}})
+	_, err = suite.App.IncentivesKeeper.ClaimRewards(suite.Ctx, addr1)
+	suite.Require().Error(err)
+
+	// the rewards of the next epochs keep accruing from the claim on
+	suite.AddToGauge(sdk.Coins{sdk.NewInt64Coin("stake", 500)}, gaugeID1)
+	suite.distributeGauge(gaugeID1)
+	suite.requireClaimable([]sdk.AccAddress{addr1, addr2, addr3}, []sdk.Coins{
+		{sdk.NewInt64Coin("stake", 100)},
+		{sdk.NewInt64Coin("stake", 900)},
+		{sdk.NewInt64Coin("stake", 100)},
+	})
+}
+
+// TestClaimRewardsRemainder tests that the decimal remainders of the rewards are kept for later claims.
+func (suite *KeeperTestSuite) TestClaimRewardsRemainder() {
+	suite.SetupTest()
+
+	addrs := suite.SetupManyLocks(2, sdk.Coins{}, defaultLPTokens, defaultLockDuration)
+	gaugeID, _, _, _ := suite.SetupNewGauge(true, sdk.Coins{sdk.NewInt64Coin("stake", 5)})
+
+	// two equal locks share 5 stake, 2.5 stake each
+	suite.distributeGauge(gaugeID)
+	claimed, err := suite.App.IncentivesKeeper.ClaimRewards(suite.Ctx, addrs[0])
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 2)}, claimed)
+
+	// the remainder adds up with the rewards of the next epochs
+	suite.AddToGauge(sdk.Coins{sdk.NewInt64Coin("stake", 5)}, gaugeID)
+	suite.distributeGauge(gaugeID)
+	claimed, err = suite.App.IncentivesKeeper.ClaimRewards(suite.Ctx, addrs[0])
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 3)}, claimed)
+}
+
+// TestDistributeRewardPerShareRemainder tests that only the rewards the locks can claim given the truncated reward
+// per share are counted as distributed, the remainder staying in the gauge.
+func (suite *KeeperTestSuite) TestDistributeRewardPerShareRemainder() {
+	suite.SetupTest()
+
+	// 7 stake over 3e18 shares is a reward per share of 2.333...e-18, truncated to 2e-18,
+	// so that the lock can only claim 6 stake.
+	addr := sdk.AccAddress([]byte("addr1---------------"))
+	suite.createLock(addr, sdk.Coins{sdk.NewCoin(defaultLPDenom, sdk.NewIntWithDecimal(3, 18))}, defaultLockDuration)
+	gaugeID, _, _, _ := suite.SetupNewGauge(true, sdk.Coins{sdk.NewInt64Coin("stake", 7)})
+	gauge, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gaugeID)
+	suite.Require().NoError(err)
+
+	distrCoins, err := suite.App.IncentivesKeeper.Distribute(suite.Ctx, []types.Gauge{*gauge})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 6)}, distrCoins)
+	suite.requireClaimable([]sdk.AccAddress{addr}, []sdk.Coins{{sdk.NewInt64Coin("stake", 6)}})
+
+	gauge, err = suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gaugeID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 6)}, gauge.DistributedCoins)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 1)}, gauge.UndistributedCoins())
+
+	// the claimed rewards never exceed what the gauge counted as distributed
+	claimed, err := suite.App.IncentivesKeeper.ClaimRewards(suite.Ctx, addr)
+	suite.Require().NoError(err)
+	suite.Require().Equal(gauge.DistributedCoins, claimed)
+}
+
+// TestLockChangesSettleRewards tests that the rewards accrued by a lock are settled whenever the lock changes,
+// so that the rewards of each epoch are accrued according to the state of the lock during that epoch.
+func (suite *KeeperTestSuite) TestLockChangesSettleRewards() {
+	lockCoins := sdk.Coins{sdk.NewInt64Coin(defaultLPDenom, 20)}
+
+	tests := []struct {
+		name string
+		// changeLock changes the lock of the first address after the first distribution
+		changeLock      func(lock lockuptypes.PeriodLock)
+		expectedRewards []sdk.Coins
+	}{
+		{
+			// the lock has 60 of 80 shares in the second epoch
+			name: "add tokens to lock",
+			changeLock: func(lock lockuptypes.PeriodLock) {
+				suite.FundAcc(lock.OwnerAddress(), sdk.Coins{sdk.NewInt64Coin(defaultLPDenom, 40)})
+				_, err := suite.App.LockupKeeper.AddTokensToLockByID(suite.Ctx, lock.ID, lock.OwnerAddress(), sdk.NewInt64Coin(defaultLPDenom, 40))
+				suite.Require().NoError(err)
+			},
+			expectedRewards: []sdk.Coins{{sdk.NewInt64Coin("stake", 500)}, {sdk.NewInt64Coin("stake", 500)}},
+		},
+		{
+			// the lock shares in the gauge of the longer duration in the second epoch
+			name: "extend lock",
+			changeLock: func(lock lockuptypes.PeriodLock) {
+				err := suite.App.LockupKeeper.ExtendLockup(suite.Ctx, lock.ID, lock.OwnerAddress(), 2*defaultLockDuration)
+				suite.Require().NoError(err)
+			},
+			expectedRewards: []sdk.Coins{{sdk.NewInt64Coin("stake", 500)}, {sdk.NewInt64Coin("stake", 500)}},
+		},
+		{
+			// the unlocking part of the lock is split into a new lock, which keeps sharing in the rewards
+			name: "partially unlock lock",
+			changeLock: func(lock lockuptypes.PeriodLock) {
+				err := suite.App.LockupKeeper.BeginUnlock(suite.Ctx, lock.ID, sdk.Coins{sdk.NewInt64Coin(defaultLPDenom, 5)})
+				suite.Require().NoError(err)
+				suite.Require().Len(suite.App.LockupKeeper.GetAccountPeriodLocks(suite.Ctx, lock.OwnerAddress()), 2)
+			},
+			expectedRewards: []sdk.Coins{{sdk.NewInt64Coin("stake", 400)}, {sdk.NewInt64Coin("stake", 600)}},
+		},
+		{
+			// the rewards accrued by an unlocked lock stay claimable, and it no longer accrues any
+			name: "unlock lock",
+			changeLock: func(lock lockuptypes.PeriodLock) {
+				err := suite.App.LockupKeeper.BeginUnlock(suite.Ctx, lock.ID, nil)
+				suite.Require().NoError(err)
+				suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(defaultLockDuration))
+				err = suite.App.LockupKeeper.UnlockMaturedLock(suite.Ctx, lock.ID)
+				suite.Require().NoError(err)
+				suite.Require().Len(suite.App.LockupKeeper.GetAccountPeriodLocks(suite.Ctx, lock.OwnerAddress()), 0)
+			},
+			expectedRewards: []sdk.Coins{{sdk.NewInt64Coin("stake", 200)}, {sdk.NewInt64Coin("stake", 800)}},
+		},
+		{
+			// the rewards accrued before the transfer go to the previous owner
+			name: "transfer lock",
+			changeLock: func(lock lockuptypes.PeriodLock) {
+				err := suite.App.LockupKeeper.TransferLock(suite.Ctx, lock.ID, lock.OwnerAddress(), sdk.AccAddress([]byte("addr2---------------")), false)
+				suite.Require().NoError(err)
+			},
+			expectedRewards: []sdk.Coins{{sdk.NewInt64Coin("stake", 200)}, {sdk.NewInt64Coin("stake", 800)}},
+		},
+		{
+			// the merged lock has 60 of 80 shares in the second epoch
+			name: "merge locks",
+			changeLock: func(lock lockuptypes.PeriodLock) {
+				newLock := suite.createLock(lock.OwnerAddress(), sdk.Coins{sdk.NewInt64Coin(defaultLPDenom, 40)}, defaultLockDuration)
+				_, err := suite.App.LockupKeeper.MergeLocks(suite.Ctx, lock.OwnerAddress(), newLock.ID, lock.ID)
+				suite.Require().NoError(err)
+			},
+			expectedRewards: []sdk.Coins{{sdk.NewInt64Coin("stake", 500)}, {sdk.NewInt64Coin("stake", 500)}},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			addr1 := sdk.AccAddress([]byte("addr1---------------"))
+			addr2 := sdk.AccAddress([]byte("addr2---------------"))
+			lock := suite.createLock(addr1, lockCoins, defaultLockDuration)
+			suite.createLock(addr2, lockCoins, 2*defaultLockDuration)
+
+			// both locks share 400 stake in the first epoch
+			gaugeID, _, _, _ := suite.SetupNewGauge(true, sdk.Coins{sdk.NewInt64Coin("stake", 400)})
+			suite.distributeGauge(gaugeID)
+			suite.requireClaimable([]sdk.AccAddress{addr1, addr2}, []sdk.Coins{
+				{sdk.NewInt64Coin("stake", 200)},
+				{sdk.NewInt64Coin("stake", 200)},
+			})
+
+			tc.changeLock(lock)
+
+			// the rewards of the second epoch are 400 stake to the default duration and 200 stake to the longer one
+			suite.AddToGauge(sdk.Coins{sdk.NewInt64Coin("stake", 400)}, gaugeID)
+			suite.distributeGauge(gaugeID)
+			longGaugeID, _, _, _ := suite.setupNewGaugeWithDuration(true, sdk.Coins{sdk.NewInt64Coin("stake", 200)}, 2*defaultLockDuration, defaultLPDenom)
+			suite.distributeGauge(longGaugeID)
+
+			suite.requireClaimable([]sdk.AccAddress{addr1, addr2}, tc.expectedRewards)
+			for i, addr := range []sdk.AccAddress{addr1, addr2} {
+				claimed, err := suite.App.IncentivesKeeper.ClaimRewards(suite.Ctx, addr)
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expectedRewards[i], claimed)
+			}
+		})
+	}
+}
+
+// TestMigrateLocksToRewardCheckpoints tests that the locks existing before the reward accumulators start accruing
+// rewards once migrated.
+func (suite *KeeperTestSuite) TestMigrateLocksToRewardCheckpoints() {
+	suite.SetupTest()
+
+	addrs := suite.SetupManyLocks(2, sdk.Coins{}, defaultLPTokens, defaultLockDuration)
+
+	// drop the checkpoints of the locks, as for locks created before the reward accumulators
+	store := prefix.NewStore(suite.Ctx.KVStore(suite.App.GetKey(types.StoreKey)), types.KeyPrefixLockRewardCheckpoint)
+	iterator := store.Iterator(nil, nil)
+	keys := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	suite.Require().Len(suite.App.IncentivesKeeper.GetAllLockRewardCheckpoints(suite.Ctx), 0)
+
+	err := suite.App.IncentivesKeeper.MigrateLocksToRewardCheckpoints(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().Len(suite.App.IncentivesKeeper.GetAllLockRewardCheckpoints(suite.Ctx), 2)
+
+	gaugeID, _, _, _ := suite.SetupNewGauge(true, sdk.Coins{sdk.NewInt64Coin("stake", 100)})
+	suite.distributeGauge(gaugeID)
+	suite.requireClaimable(addrs, []sdk.Coins{
+		{sdk.NewInt64Coin("stake", 50)},
+		{sdk.NewInt64Coin("stake", 50)},
+	})
+}
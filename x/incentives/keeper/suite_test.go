@@ -34,7 +34,8 @@ var (
 		lockDurations: []time.Duration{defaultLockDuration, 2 * defaultLockDuration},
 		lockAmounts:   []sdk.Coins{defaultLPSyntheticTokens, defaultLPSyntheticTokens},
 	}
-	defaultRewardDenom string = "rewardDenom"
+	defaultRewardDenom string         = "rewardDenom"
+	defaultGaugeOwner  sdk.AccAddress = sdk.AccAddress([]byte("Gauge_Creation_Addr_"))
 )
 
 // TODO: Switch more code to use userLocks and perpGaugeDesc
@@ -146,7 +147,7 @@ func (suite *KeeperTestSuite) LockTokens(addr sdk.AccAddress, coins sdk.Coins, d
 func (suite *KeeperTestSuite) setupNewGaugeWithDuration(isPerpetual bool, coins sdk.Coins, duration time.Duration, denom string) (
 	uint64, *types.Gauge, sdk.Coins, time.Time,
 ) {
-	addr := sdk.AccAddress([]byte("Gauge_Creation_Addr_"))
+	addr := defaultGaugeOwner
 	startTime2 := time.Now()
 	distrTo := lockuptypes.QueryCondition{
 		LockQueryType: lockuptypes.ByDuration,
@@ -175,7 +176,7 @@ func (suite *KeeperTestSuite) SetupNewGauge(isPerpetual bool, coins sdk.Coins) (
 func (suite *KeeperTestSuite) setupNewGaugeWithDenom(isPerpetual bool, coins sdk.Coins, duration time.Duration, denom string) (
 	uint64, *types.Gauge, sdk.Coins, time.Time,
 ) {
-	addr := sdk.AccAddress([]byte("Gauge_Creation_Addr_"))
+	addr := defaultGaugeOwner
 	startTime2 := time.Now()
 	distrTo := lockuptypes.QueryCondition{
 		LockQueryType: lockuptypes.ByDuration,
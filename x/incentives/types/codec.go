@@ -18,6 +18,9 @@ var (
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCreateGauge{}, "osmosis/incentives/create-gauge", nil)
 	cdc.RegisterConcrete(&MsgAddToGauge{}, "osmosis/incentives/add-to-gauge", nil)
+	cdc.RegisterConcrete(&MsgCancelGauge{}, "osmosis/incentives/cancel-gauge", nil)
+	cdc.RegisterConcrete(&MsgWithdrawUndistributed{}, "osmosis/incentives/withdraw-undistributed", nil)
+	cdc.RegisterConcrete(&MsgClaimRewards{}, "osmosis/incentives/claim-rewards", nil)
 }
 
 // RegisterInterfaces registers interfaces and implementations of the incentives module.
@@ -26,6 +29,9 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgCreateGauge{},
 		&MsgAddToGauge{},
+		&MsgCancelGauge{},
+		&MsgWithdrawUndistributed{},
+		&MsgClaimRewards{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
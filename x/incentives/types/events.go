@@ -5,6 +5,9 @@ const (
 	TypeEvtCreateGauge  = "create_gauge"
 	TypeEvtAddToGauge   = "add_to_gauge"
 	TypeEvtDistribution = "distribution"
+	TypeEvtCancelGauge  = "cancel_gauge"
+	TypeEvtRefund       = "refund"
+	TypeEvtClaimRewards = "claim_rewards"
 
 	AttributeGaugeID     = "gauge_id"
 	AttributeLockedDenom = "denom"
@@ -29,6 +29,41 @@ func NewGauge(id uint64, isPerpetual bool, distrTo lockuptypes.QueryCondition, c
 	}
 }
 
+// UndistributedCoins returns the coins of the gauge that have been neither distributed nor refunded yet.
+func (gauge Gauge) UndistributedCoins() sdk.Coins {
+	return gauge.Coins.Sub(gauge.DistributedCoins).Sub(gauge.RefundedCoins)
+}
+
+// SplitRefund splits coins refunded by the gauge between its contributors, pro rata of their contribution to
+// the total amount of every denom added to the gauge. The owner gets the rest, which includes its own contribution,
+// the rounding remainders and the coins added before contributions were tracked.
+func (gauge Gauge) SplitRefund(contributions []GaugeContribution, coins sdk.Coins) []GaugeContribution {
+	refunds := []GaugeContribution{}
+	ownerRefund := coins
+	for _, contribution := range contributions {
+		refund := sdk.Coins{}
+		for _, coin := range coins {
+			total := gauge.Coins.AmountOf(coin.Denom)
+			if !total.IsPositive() {
+				continue
+			}
+			amt := coin.Amount.Mul(contribution.Coins.AmountOf(coin.Denom)).Quo(total)
+			if amt.IsPositive() {
+				refund = refund.Add(sdk.NewCoin(coin.Denom, amt))
+			}
+		}
+		if refund.Empty() {
+			continue
+		}
+		refunds = append(refunds, GaugeContribution{GaugeId: gauge.Id, Address: contribution.Address, Coins: refund})
+		ownerRefund = ownerRefund.Sub(refund)
+	}
+	if !ownerRefund.Empty() {
+		refunds = append(refunds, GaugeContribution{GaugeId: gauge.Id, Address: gauge.Owner, Coins: ownerRefund})
+	}
+	return refunds
+}
+
 // IsUpcomingGauge returns true if the gauge's distribution start time is after the provided time.
 func (gauge Gauge) IsUpcomingGauge(curTime time.Time) bool {
 	return curTime.Before(gauge.StartTime)
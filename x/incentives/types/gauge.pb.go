@@ -58,6 +58,14 @@ type Gauge struct {
 	FilledEpochs uint64 `protobuf:"varint,7,opt,name=filled_epochs,json=filledEpochs,proto3" json:"filled_epochs,omitempty"`
 	// distributed_coins are coins that have been distributed already
 	DistributedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=distributed_coins,json=distributedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"distributed_coins"`
+	// owner is the address of the gauge creator, who can cancel the gauge and
+	// withdraw its undistributed coins. It is empty for gauges created before
+	// gauges had an owner, which can be neither cancelled nor withdrawn from,
+	// and are never refunded.
+	Owner string `protobuf:"bytes,9,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	// refunded_coins are coins that have been refunded to the contributors already,
+	// for epochs without any qualifying lock, or on cancellation or withdrawal
+	RefundedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,10,rep,name=refunded_coins,json=refundedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"refunded_coins"`
 }
 
 func (m *Gauge) Reset()         { *m = Gauge{} }
@@ -149,6 +157,83 @@ func (m *Gauge) GetDistributedCoins() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+func (m *Gauge) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *Gauge) GetRefundedCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RefundedCoins
+	}
+	return nil
+}
+
+// GaugeContribution is the coins an address other than its owner added to a
+// gauge. Refunds of the gauge are split between the contributors pro rata of
+// their contributions, and the owner is refunded the rest.
+type GaugeContribution struct {
+	Address string                                   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Coins   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+	GaugeId uint64                                   `protobuf:"varint,3,opt,name=gauge_id,json=gaugeId,proto3" json:"gauge_id,omitempty" yaml:"gauge_id"`
+}
+
+func (m *GaugeContribution) Reset()         { *m = GaugeContribution{} }
+func (m *GaugeContribution) String() string { return proto.CompactTextString(m) }
+func (*GaugeContribution) ProtoMessage()    {}
+func (*GaugeContribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0304e2bb0159901, []int{1}
+}
+func (m *GaugeContribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GaugeContribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GaugeContribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GaugeContribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GaugeContribution.Merge(m, src)
+}
+func (m *GaugeContribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *GaugeContribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_GaugeContribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GaugeContribution proto.InternalMessageInfo
+
+func (m *GaugeContribution) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *GaugeContribution) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Coins
+	}
+	return nil
+}
+
+func (m *GaugeContribution) GetGaugeId() uint64 {
+	if m != nil {
+		return m.GaugeId
+	}
+	return 0
+}
+
 type LockableDurationsInfo struct {
 	// List of incentivised durations that gauges will pay out to
 	LockableDurations []time.Duration `protobuf:"bytes,1,rep,name=lockable_durations,json=lockableDurations,proto3,stdduration" json:"lockable_durations" yaml:"lockable_durations"`
@@ -158,7 +243,7 @@ func (m *LockableDurationsInfo) Reset()         { *m = LockableDurationsInfo{} }
 func (m *LockableDurationsInfo) String() string { return proto.CompactTextString(m) }
 func (*LockableDurationsInfo) ProtoMessage()    {}
 func (*LockableDurationsInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c0304e2bb0159901, []int{1}
+	return fileDescriptor_c0304e2bb0159901, []int{2}
 }
 func (m *LockableDurationsInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -196,48 +281,55 @@ func (m *LockableDurationsInfo) GetLockableDurations() []time.Duration {
 
 func init() {
 	proto.RegisterType((*Gauge)(nil), "osmosis.incentives.Gauge")
+	proto.RegisterType((*GaugeContribution)(nil), "osmosis.incentives.GaugeContribution")
 	proto.RegisterType((*LockableDurationsInfo)(nil), "osmosis.incentives.LockableDurationsInfo")
 }
 
 func init() { proto.RegisterFile("osmosis/incentives/gauge.proto", fileDescriptor_c0304e2bb0159901) }
 
 var fileDescriptor_c0304e2bb0159901 = []byte{
-	// 547 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x4f, 0x6f, 0xd3, 0x3e,
-	0x18, 0xc7, 0x9b, 0xad, 0xdd, 0x6f, 0x3f, 0xb7, 0x43, 0xd4, 0x1a, 0x52, 0x5a, 0x89, 0xb4, 0x14,
-	0x21, 0xf5, 0x32, 0x9b, 0x16, 0x89, 0x03, 0xc7, 0x0e, 0x84, 0x26, 0x21, 0x51, 0xaa, 0x1d, 0x10,
-	0x97, 0xc8, 0x49, 0xdc, 0xcc, 0x6a, 0x92, 0x27, 0x8a, 0x9d, 0x6a, 0x7d, 0x07, 0x1c, 0x27, 0x4e,
-	0xbc, 0x06, 0x5e, 0xc9, 0x8e, 0x3b, 0x72, 0xda, 0x50, 0xfb, 0x0e, 0x78, 0x05, 0x28, 0x76, 0xa2,
-	0x56, 0xe5, 0xca, 0xc9, 0xf1, 0xf3, 0x7d, 0xfe, 0x7d, 0x3f, 0x72, 0x90, 0x03, 0x32, 0x06, 0x29,
-	0x24, 0x15, 0x89, 0xcf, 0x13, 0x25, 0x96, 0x5c, 0xd2, 0x90, 0xe5, 0x21, 0x27, 0x69, 0x06, 0x0a,
-	0x30, 0x2e, 0x75, 0xb2, 0xd5, 0xbb, 0xa7, 0x21, 0x84, 0xa0, 0x65, 0x5a, 0x7c, 0x99, 0xcc, 0xae,
-	0x13, 0x02, 0x84, 0x11, 0xa7, 0xfa, 0xe6, 0xe5, 0x73, 0x1a, 0xe4, 0x19, 0x53, 0x02, 0x92, 0x52,
-	0xef, 0xed, 0xeb, 0x4a, 0xc4, 0x5c, 0x2a, 0x16, 0xa7, 0x55, 0x03, 0x5f, 0xcf, 0xa2, 0x1e, 0x93,
-	0x9c, 0x2e, 0x47, 0x1e, 0x57, 0x6c, 0x44, 0x7d, 0x10, 0x55, 0x83, 0x4e, 0xb5, 0x6a, 0x04, 0xfe,
-	0x22, 0x4f, 0xf5, 0x61, 0xa4, 0xc1, 0xb7, 0x3a, 0x6a, 0xbc, 0x2f, 0xb6, 0xc6, 0x8f, 0xd0, 0x81,
-	0x08, 0x6c, 0xab, 0x6f, 0x0d, 0xeb, 0xb3, 0x03, 0x11, 0xe0, 0x67, 0xa8, 0x25, 0xa4, 0x9b, 0xf2,
-	0x2c, 0xe5, 0x2a, 0x67, 0x91, 0x7d, 0xd0, 0xb7, 0x86, 0xc7, 0xb3, 0xa6, 0x90, 0xd3, 0x2a, 0x84,
-	0x2f, 0xd0, 0x49, 0x20, 0xa4, 0xca, 0x84, 0x97, 0x2b, 0xee, 0x2a, 0xb0, 0x0f, 0xfb, 0xd6, 0xb0,
-	0x39, 0x76, 0x48, 0x65, 0xdd, 0xcc, 0x23, 0x9f, 0x72, 0x9e, 0xad, 0xce, 0x21, 0x09, 0x44, 0xe1,
-	0x6a, 0x52, 0xbf, 0xbd, 0xef, 0xd5, 0x66, 0xad, 0x6d, 0xe9, 0x25, 0x60, 0x86, 0x1a, 0xc5, 0xc2,
-	0xd2, 0xae, 0xf7, 0x0f, 0x87, 0xcd, 0x71, 0x87, 0x18, 0x4b, 0xa4, 0xb0, 0x44, 0x4a, 0x4b, 0xe4,
-	0x1c, 0x44, 0x32, 0x79, 0x59, 0x54, 0xff, 0x78, 0xe8, 0x0d, 0x43, 0xa1, 0xae, 0x72, 0x8f, 0xf8,
-	0x10, 0xd3, 0xd2, 0xbf, 0x39, 0xce, 0x64, 0xb0, 0xa0, 0x6a, 0x95, 0x72, 0xa9, 0x0b, 0xe4, 0xcc,
-	0x74, 0xc6, 0x9f, 0x11, 0x92, 0x8a, 0x65, 0xca, 0x2d, 0xf0, 0xd9, 0x0d, 0xbd, 0x6a, 0x97, 0x18,
-	0xb6, 0xa4, 0x62, 0x4b, 0x2e, 0x2b, 0xb6, 0x93, 0xa7, 0xc5, 0xa0, 0xdf, 0xf7, 0xbd, 0xf6, 0x8a,
-	0xc5, 0xd1, 0x9b, 0xc1, 0xb6, 0x76, 0x70, 0xf3, 0xd0, 0xb3, 0x66, 0xff, 0xeb, 0x40, 0x91, 0x8e,
-	0x29, 0x3a, 0x4d, 0xf2, 0xd8, 0xe5, 0x29, 0xf8, 0x57, 0xd2, 0x4d, 0x99, 0x08, 0x5c, 0x58, 0xf2,
-	0xcc, 0x3e, 0xd2, 0x30, 0xdb, 0x49, 0x1e, 0xbf, 0xd3, 0xd2, 0x94, 0x89, 0xe0, 0xe3, 0x92, 0x67,
-	0xf8, 0x39, 0x3a, 0x99, 0x8b, 0x28, 0xe2, 0x41, 0x59, 0x63, 0xff, 0xa7, 0x33, 0x5b, 0x26, 0x68,
-	0x92, 0xf1, 0x35, 0x6a, 0x6f, 0x11, 0x05, 0xae, 0xc1, 0x73, 0xfc, 0xef, 0xf1, 0x3c, 0xde, 0x99,
-	0xa2, 0x23, 0x83, 0xaf, 0x16, 0x7a, 0xf2, 0x01, 0xfc, 0x05, 0xf3, 0x22, 0xfe, 0xb6, 0x7c, 0x8b,
-	0xf2, 0x22, 0x99, 0x03, 0x06, 0x84, 0xa3, 0x52, 0x70, 0xab, 0x57, 0x2a, 0x6d, 0xab, 0x5c, 0x6a,
-	0x9f, 0x65, 0x55, 0x3b, 0x79, 0x51, 0xa2, 0xec, 0x18, 0x94, 0x7f, 0xb7, 0x18, 0x7c, 0x2f, 0x90,
-	0xb6, 0xa3, 0xfd, 0xa1, 0x93, 0xe9, 0xed, 0xda, 0xb1, 0xee, 0xd6, 0x8e, 0xf5, 0x6b, 0xed, 0x58,
-	0x37, 0x1b, 0xa7, 0x76, 0xb7, 0x71, 0x6a, 0x3f, 0x37, 0x4e, 0xed, 0xcb, 0xeb, 0x1d, 0x83, 0xe5,
-	0x7b, 0x3b, 0x8b, 0x98, 0x27, 0xab, 0x0b, 0x5d, 0x8e, 0xc6, 0xf4, 0x7a, 0xf7, 0xef, 0xd4, 0xa6,
-	0xbd, 0x23, 0xbd, 0xde, 0xab, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x45, 0xcb, 0x05, 0xbe, 0xc0,
-	0x03, 0x00, 0x00,
+	// 653 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcf, 0x6e, 0xd3, 0x4e,
+	0x10, 0x8e, 0xd3, 0xa4, 0x4d, 0x36, 0x69, 0x7f, 0xcd, 0xfe, 0x8a, 0xe4, 0x56, 0xc2, 0x0e, 0x46,
+	0xa0, 0x5c, 0x6a, 0xd3, 0x22, 0x71, 0xe0, 0xe8, 0x82, 0x50, 0x11, 0x12, 0xc5, 0xea, 0x01, 0x71,
+	0xb1, 0xd6, 0xde, 0x8d, 0xbb, 0xaa, 0xed, 0xb5, 0xbc, 0xeb, 0xd0, 0xbe, 0x01, 0xc7, 0x1e, 0x79,
+	0x06, 0xce, 0x3c, 0x00, 0xc7, 0x1e, 0x7b, 0xe4, 0x94, 0xa2, 0xf6, 0x0d, 0xfa, 0x04, 0xc8, 0xbb,
+	0x5e, 0x52, 0x95, 0x6b, 0x39, 0xd9, 0x33, 0xdf, 0xfc, 0xf9, 0xe6, 0xd3, 0xcc, 0x02, 0x8b, 0xf1,
+	0x8c, 0x71, 0xca, 0x3d, 0x9a, 0xc7, 0x24, 0x17, 0x74, 0x46, 0xb8, 0x97, 0xa0, 0x2a, 0x21, 0x6e,
+	0x51, 0x32, 0xc1, 0x20, 0x6c, 0x70, 0x77, 0x81, 0x6f, 0x6d, 0x24, 0x2c, 0x61, 0x12, 0xf6, 0xea,
+	0x3f, 0x15, 0xb9, 0x65, 0x25, 0x8c, 0x25, 0x29, 0xf1, 0xa4, 0x15, 0x55, 0x53, 0x0f, 0x57, 0x25,
+	0x12, 0x94, 0xe5, 0x0d, 0x6e, 0xdf, 0xc5, 0x05, 0xcd, 0x08, 0x17, 0x28, 0x2b, 0x74, 0x81, 0x58,
+	0xf6, 0xf2, 0x22, 0xc4, 0x89, 0x37, 0xdb, 0x89, 0x88, 0x40, 0x3b, 0x5e, 0xcc, 0xa8, 0x2e, 0xb0,
+	0xa9, 0xa9, 0xa6, 0x2c, 0x3e, 0xae, 0x0a, 0xf9, 0x51, 0x90, 0xf3, 0xbd, 0x0b, 0xba, 0x6f, 0x6a,
+	0xd6, 0x70, 0x0d, 0xb4, 0x29, 0x36, 0x8d, 0xb1, 0x31, 0xe9, 0x04, 0x6d, 0x8a, 0xe1, 0x23, 0x30,
+	0xa4, 0x3c, 0x2c, 0x48, 0x59, 0x10, 0x51, 0xa1, 0xd4, 0x6c, 0x8f, 0x8d, 0x49, 0x2f, 0x18, 0x50,
+	0x7e, 0xa0, 0x5d, 0x70, 0x1f, 0xac, 0x62, 0xca, 0x45, 0x49, 0xa3, 0x4a, 0x90, 0x50, 0x30, 0x73,
+	0x69, 0x6c, 0x4c, 0x06, 0xbb, 0x96, 0xab, 0x47, 0x57, 0xfd, 0xdc, 0x0f, 0x15, 0x29, 0x4f, 0xf7,
+	0x58, 0x8e, 0x69, 0x3d, 0x95, 0xdf, 0x39, 0x9f, 0xdb, 0xad, 0x60, 0xb8, 0x48, 0x3d, 0x64, 0x10,
+	0x81, 0x6e, 0x4d, 0x98, 0x9b, 0x9d, 0xf1, 0xd2, 0x64, 0xb0, 0xbb, 0xe9, 0xaa, 0x91, 0xdc, 0x7a,
+	0x24, 0xb7, 0x19, 0xc9, 0xdd, 0x63, 0x34, 0xf7, 0x9f, 0xd5, 0xd9, 0xdf, 0x2e, 0xed, 0x49, 0x42,
+	0xc5, 0x51, 0x15, 0xb9, 0x31, 0xcb, 0xbc, 0x66, 0x7e, 0xf5, 0xd9, 0xe6, 0xf8, 0xd8, 0x13, 0xa7,
+	0x05, 0xe1, 0x32, 0x81, 0x07, 0xaa, 0x32, 0xfc, 0x08, 0x00, 0x17, 0xa8, 0x14, 0x61, 0x2d, 0x9f,
+	0xd9, 0x95, 0x54, 0xb7, 0x5c, 0xa5, 0xad, 0xab, 0xb5, 0x75, 0x0f, 0xb5, 0xb6, 0xfe, 0xc3, 0xba,
+	0xd1, 0xcd, 0xdc, 0x1e, 0x9d, 0xa2, 0x2c, 0x7d, 0xe9, 0x2c, 0x72, 0x9d, 0xb3, 0x4b, 0xdb, 0x08,
+	0xfa, 0xd2, 0x51, 0x87, 0x43, 0x0f, 0x6c, 0xe4, 0x55, 0x16, 0x92, 0x82, 0xc5, 0x47, 0x3c, 0x2c,
+	0x10, 0xc5, 0x21, 0x9b, 0x91, 0xd2, 0x5c, 0x96, 0x62, 0x8e, 0xf2, 0x2a, 0x7b, 0x2d, 0xa1, 0x03,
+	0x44, 0xf1, 0xfb, 0x19, 0x29, 0xe1, 0x63, 0xb0, 0x3a, 0xa5, 0x69, 0x4a, 0x70, 0x93, 0x63, 0xae,
+	0xc8, 0xc8, 0xa1, 0x72, 0xaa, 0x60, 0x78, 0x02, 0x46, 0x0b, 0x89, 0x70, 0xa8, 0xe4, 0xe9, 0xdd,
+	0xbf, 0x3c, 0xeb, 0xb7, 0xba, 0x48, 0x0f, 0x7c, 0x0a, 0xba, 0xec, 0x73, 0x4e, 0x4a, 0xb3, 0x3f,
+	0x36, 0x26, 0x7d, 0x7f, 0xfd, 0x66, 0x6e, 0x0f, 0x95, 0x08, 0xd2, 0xed, 0x04, 0x0a, 0x86, 0x25,
+	0x58, 0x2b, 0xc9, 0xb4, 0xca, 0xf1, 0x1f, 0x7a, 0xe0, 0xfe, 0xe9, 0xad, 0xea, 0x16, 0xd2, 0x7c,
+	0xdb, 0xe9, 0x0d, 0xd6, 0x87, 0xce, 0x0f, 0x03, 0x8c, 0xe4, 0xda, 0xee, 0xb1, 0x5c, 0x71, 0xa7,
+	0x2c, 0x87, 0x26, 0x58, 0x41, 0x18, 0x97, 0x84, 0x73, 0xb9, 0xc7, 0xfd, 0x40, 0x9b, 0x8b, 0xf5,
+	0x6a, 0xff, 0xb3, 0xf5, 0x72, 0x41, 0x4f, 0x9e, 0x7f, 0x48, 0xb1, 0xbc, 0x83, 0x8e, 0xff, 0xff,
+	0xcd, 0xdc, 0xfe, 0x4f, 0xe9, 0xa6, 0x11, 0x27, 0x58, 0x91, 0xbf, 0xfb, 0xd8, 0xf9, 0x62, 0x80,
+	0x07, 0xef, 0x58, 0x7c, 0x8c, 0xa2, 0x94, 0xbc, 0x6a, 0x0e, 0x9e, 0xef, 0xe7, 0x53, 0x06, 0x19,
+	0x80, 0x69, 0x03, 0x84, 0xfa, 0x29, 0xa8, 0x27, 0x52, 0xcc, 0xef, 0x2e, 0xac, 0xce, 0xf5, 0x9f,
+	0x34, 0xfb, 0xba, 0xa9, 0x5a, 0xfe, 0x5d, 0xc2, 0xf9, 0x5a, 0xef, 0xed, 0x28, 0xbd, 0xdb, 0xd4,
+	0x3f, 0x38, 0xbf, 0xb2, 0x8c, 0x8b, 0x2b, 0xcb, 0xf8, 0x75, 0x65, 0x19, 0x67, 0xd7, 0x56, 0xeb,
+	0xe2, 0xda, 0x6a, 0xfd, 0xbc, 0xb6, 0x5a, 0x9f, 0x5e, 0xdc, 0x52, 0xa1, 0x39, 0xea, 0xed, 0x14,
+	0x45, 0x5c, 0x1b, 0xde, 0x6c, 0x67, 0xd7, 0x3b, 0xb9, 0xfd, 0x04, 0x4a, 0x65, 0xa2, 0x65, 0x49,
+	0xef, 0xf9, 0xef, 0x01, 0x00, 0xff, 0x8c, 0xd5, 0x64, 0x25, 0x05, 0x00, 0x00,
 }
 
 func (m *Gauge) Marshal() (dAtA []byte, err error) {
@@ -260,6 +352,27 @@ func (m *Gauge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RefundedCoins) > 0 {
+		for iNdEx := len(m.RefundedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RefundedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGauge(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintGauge(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.DistributedCoins) > 0 {
 		for iNdEx := len(m.DistributedCoins) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -334,6 +447,55 @@ func (m *Gauge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GaugeContribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GaugeContribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GaugeContribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GaugeId != 0 {
+		i = encodeVarintGauge(dAtA, i, uint64(m.GaugeId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGauge(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGauge(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *LockableDurationsInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -414,6 +576,38 @@ func (m *Gauge) Size() (n int) {
 			n += 1 + l + sovGauge(uint64(l))
 		}
 	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovGauge(uint64(l))
+	}
+	if len(m.RefundedCoins) > 0 {
+		for _, e := range m.RefundedCoins {
+			l = e.Size()
+			n += 1 + l + sovGauge(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GaugeContribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGauge(uint64(l))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovGauge(uint64(l))
+		}
+	}
+	if m.GaugeId != 0 {
+		n += 1 + sovGauge(uint64(m.GaugeId))
+	}
 	return n
 }
 
@@ -678,6 +872,207 @@ func (m *Gauge) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundedCoins = append(m.RefundedCoins, types1.Coin{})
+			if err := m.RefundedCoins[len(m.RefundedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGauge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GaugeContribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGauge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GaugeContribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GaugeContribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types1.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GaugeId", wireType)
+			}
+			m.GaugeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GaugeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGauge(dAtA[iNdEx:])
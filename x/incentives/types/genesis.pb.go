@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	_ "github.com/gogo/protobuf/types"
@@ -40,6 +41,21 @@ type GenesisState struct {
 	// last_gauge_id is what the gauge number will increment from when creating
 	// the next gauge after genesis
 	LastGaugeId uint64 `protobuf:"varint,4,opt,name=last_gauge_id,json=lastGaugeId,proto3" json:"last_gauge_id,omitempty"`
+	// lp_share_holders are all accounts tracked as holders of unlocked pool
+	// shares, that LP share gauges distribute to
+	LpShareHolders []LPShareHolder `protobuf:"bytes,5,rep,name=lp_share_holders,json=lpShareHolders,proto3" json:"lp_share_holders" yaml:"lp_share_holders"`
+	// reward_accumulators are the rewards distributed per share of the locks of
+	// each denom and duration
+	RewardAccumulators []RewardAccumulator `protobuf:"bytes,6,rep,name=reward_accumulators,json=rewardAccumulators,proto3" json:"reward_accumulators" yaml:"reward_accumulators"`
+	// lock_reward_checkpoints are the states of the locks as of the last time
+	// their rewards were settled
+	LockRewardCheckpoints []LockRewardCheckpoint `protobuf:"bytes,7,rep,name=lock_reward_checkpoints,json=lockRewardCheckpoints,proto3" json:"lock_reward_checkpoints" yaml:"lock_reward_checkpoints"`
+	// unclaimed_rewards are the rewards settled to accounts that they have not
+	// claimed yet
+	UnclaimedRewards []UnclaimedRewards `protobuf:"bytes,8,rep,name=unclaimed_rewards,json=unclaimedRewards,proto3" json:"unclaimed_rewards" yaml:"unclaimed_rewards"`
+	// gauge_contributions are the coins added to the gauges by addresses other
+	// than their owner
+	GaugeContributions []GaugeContribution `protobuf:"bytes,9,rep,name=gauge_contributions,json=gaugeContributions,proto3" json:"gauge_contributions" yaml:"gauge_contributions"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -103,36 +119,160 @@ func (m *GenesisState) GetLastGaugeId() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetLpShareHolders() []LPShareHolder {
+	if m != nil {
+		return m.LpShareHolders
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRewardAccumulators() []RewardAccumulator {
+	if m != nil {
+		return m.RewardAccumulators
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLockRewardCheckpoints() []LockRewardCheckpoint {
+	if m != nil {
+		return m.LockRewardCheckpoints
+	}
+	return nil
+}
+
+func (m *GenesisState) GetUnclaimedRewards() []UnclaimedRewards {
+	if m != nil {
+		return m.UnclaimedRewards
+	}
+	return nil
+}
+
+func (m *GenesisState) GetGaugeContributions() []GaugeContribution {
+	if m != nil {
+		return m.GaugeContributions
+	}
+	return nil
+}
+
+// LPShareHolder is an account tracked as a holder of unlocked shares of a
+// pool, whose share balance LP share gauges of the share denom distribute to
+type LPShareHolder struct {
+	Denom   string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// qualifying_shares is the lowest share balance of the holder during the
+	// distribution epoch epoch_number, which is the only part of its shares
+	// rewarded for that epoch. The holder's balance is rewarded in full for
+	// epochs during which it did not change.
+	QualifyingShares github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=qualifying_shares,json=qualifyingShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"qualifying_shares" yaml:"qualifying_shares"`
+	EpochNumber      int64                                  `protobuf:"varint,4,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty" yaml:"epoch_number"`
+}
+
+func (m *LPShareHolder) Reset()         { *m = LPShareHolder{} }
+func (m *LPShareHolder) String() string { return proto.CompactTextString(m) }
+func (*LPShareHolder) ProtoMessage()    {}
+func (*LPShareHolder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a288ccc95d977d2d, []int{1}
+}
+func (m *LPShareHolder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LPShareHolder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LPShareHolder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LPShareHolder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LPShareHolder.Merge(m, src)
+}
+func (m *LPShareHolder) XXX_Size() int {
+	return m.Size()
+}
+func (m *LPShareHolder) XXX_DiscardUnknown() {
+	xxx_messageInfo_LPShareHolder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LPShareHolder proto.InternalMessageInfo
+
+func (m *LPShareHolder) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *LPShareHolder) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *LPShareHolder) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "osmosis.incentives.GenesisState")
+	proto.RegisterType((*LPShareHolder)(nil), "osmosis.incentives.LPShareHolder")
 }
 
 func init() { proto.RegisterFile("osmosis/incentives/genesis.proto", fileDescriptor_a288ccc95d977d2d) }
 
 var fileDescriptor_a288ccc95d977d2d = []byte{
-	// 340 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xb1, 0x4e, 0xc3, 0x30,
-	0x10, 0x86, 0x93, 0xb6, 0xea, 0x90, 0xc2, 0x80, 0xc5, 0x90, 0x76, 0x70, 0xa2, 0x48, 0x48, 0x5d,
-	0xb0, 0x45, 0x91, 0x00, 0x31, 0x56, 0x48, 0x15, 0x5b, 0x55, 0x36, 0x96, 0xca, 0x69, 0x8d, 0xb1,
-	0x48, 0xe2, 0xaa, 0xe7, 0x54, 0xf4, 0x2d, 0x98, 0x10, 0x8f, 0xd4, 0xb1, 0x23, 0x53, 0x41, 0xed,
-	0x1b, 0xf0, 0x04, 0x28, 0x4e, 0x2c, 0x90, 0xda, 0xcd, 0xe7, 0xff, 0xbb, 0xff, 0xfe, 0x3b, 0x2f,
-	0x54, 0x90, 0x2a, 0x90, 0x40, 0x65, 0x36, 0xe1, 0x99, 0x96, 0x0b, 0x0e, 0x54, 0xf0, 0x8c, 0x83,
-	0x04, 0x32, 0x9b, 0x2b, 0xad, 0x10, 0xaa, 0x08, 0xf2, 0x47, 0x74, 0x4e, 0x85, 0x12, 0xca, 0xc8,
-	0xb4, 0x78, 0x95, 0x64, 0x07, 0x0b, 0xa5, 0x44, 0xc2, 0xa9, 0xa9, 0xe2, 0xfc, 0x89, 0x4e, 0xf3,
-	0x39, 0xd3, 0x52, 0x65, 0x95, 0x1e, 0x1c, 0x98, 0x35, 0x63, 0x73, 0x96, 0x82, 0x35, 0x38, 0x14,
-	0x86, 0xe5, 0x82, 0x97, 0x7a, 0xf4, 0x5e, 0xf3, 0x8e, 0x06, 0x65, 0xb8, 0x07, 0xcd, 0x34, 0x47,
-	0x37, 0x5e, 0xb3, 0x34, 0xf0, 0xdd, 0xd0, 0xed, 0xb6, 0x7a, 0x1d, 0xb2, 0x1f, 0x96, 0x0c, 0x0d,
-	0xd1, 0x6f, 0xac, 0x36, 0x81, 0x33, 0xaa, 0x78, 0x74, 0xed, 0x35, 0x8d, 0x33, 0xf8, 0xb5, 0xb0,
-	0xde, 0x6d, 0xf5, 0xda, 0x87, 0x3a, 0x07, 0x05, 0x61, 0x1b, 0x4b, 0x1c, 0x29, 0x0f, 0x25, 0x6a,
-	0xf2, 0xc2, 0xe2, 0x84, 0x8f, 0xed, 0x7e, 0xe0, 0xd7, 0x2b, 0x93, 0xf2, 0x02, 0xc4, 0x5e, 0x80,
-	0xdc, 0x55, 0x44, 0xff, 0xac, 0x30, 0xf9, 0xd9, 0x04, 0xed, 0x25, 0x4b, 0x93, 0xdb, 0x68, 0xdf,
-	0x22, 0xfa, 0xf8, 0x0a, 0xdc, 0xd1, 0x89, 0x15, 0x6c, 0x23, 0xa0, 0xc8, 0x3b, 0x4e, 0x18, 0xe8,
-	0xb1, 0x99, 0x3f, 0x96, 0x53, 0xbf, 0x11, 0xba, 0xdd, 0xc6, 0xa8, 0x55, 0x7c, 0x9a, 0x80, 0xf7,
-	0xd3, 0xfe, 0x70, 0xb5, 0xc5, 0xee, 0x7a, 0x8b, 0xdd, 0xef, 0x2d, 0x76, 0xdf, 0x76, 0xd8, 0x59,
-	0xef, 0xb0, 0xf3, 0xb9, 0xc3, 0xce, 0xe3, 0x95, 0x90, 0xfa, 0x39, 0x8f, 0xc9, 0x44, 0xa5, 0xb4,
-	0xda, 0xf0, 0x3c, 0x61, 0x31, 0xd8, 0x82, 0x2e, 0x2e, 0x7a, 0xf4, 0xf5, 0xff, 0xc1, 0xf5, 0x72,
-	0xc6, 0x21, 0x6e, 0x9a, 0x15, 0x2e, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x6a, 0x4e, 0xbb, 0x49,
-	0x20, 0x02, 0x00, 0x00,
+	// 674 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0x4f, 0x4f, 0xdb, 0x48,
+	0x14, 0x8f, 0x17, 0x08, 0xcb, 0x04, 0x56, 0x30, 0xb0, 0xc2, 0xe4, 0xe0, 0x64, 0xad, 0x05, 0xe5,
+	0x82, 0xad, 0x52, 0xa9, 0xad, 0xb8, 0xd5, 0x54, 0xa2, 0x54, 0x55, 0x85, 0x8c, 0x7a, 0xe9, 0xc5,
+	0x1a, 0xdb, 0x83, 0x63, 0x65, 0xec, 0x71, 0xfd, 0x6c, 0x28, 0xfd, 0x04, 0x3d, 0xf6, 0xd8, 0x8f,
+	0xc4, 0x91, 0x63, 0xd5, 0x43, 0x5a, 0xc1, 0x37, 0x40, 0x3d, 0xf6, 0x50, 0x79, 0x66, 0xdc, 0xa4,
+	0x89, 0x7b, 0x4a, 0x9e, 0xdf, 0xef, 0xdf, 0x3c, 0xbf, 0x31, 0xea, 0x73, 0x48, 0x38, 0xc4, 0x60,
+	0xc7, 0x69, 0x40, 0xd3, 0x22, 0xbe, 0xa0, 0x60, 0x47, 0x34, 0xa5, 0x10, 0x83, 0x95, 0xe5, 0xbc,
+	0xe0, 0x18, 0x2b, 0x84, 0x35, 0x41, 0x74, 0xb7, 0x22, 0x1e, 0x71, 0xd1, 0xb6, 0xab, 0x7f, 0x12,
+	0xd9, 0x35, 0x22, 0xce, 0x23, 0x46, 0x6d, 0x51, 0xf9, 0xe5, 0xb9, 0x1d, 0x96, 0x39, 0x29, 0x62,
+	0x9e, 0xaa, 0x7e, 0xaf, 0xc1, 0x2b, 0x23, 0x39, 0x49, 0xa0, 0x16, 0x68, 0x0a, 0x43, 0xca, 0x88,
+	0xaa, 0x7e, 0x53, 0xd8, 0x9c, 0x5e, 0x92, 0x3c, 0x54, 0x0a, 0xe6, 0x8f, 0x36, 0x5a, 0x3d, 0x96,
+	0xf1, 0xcf, 0x0a, 0x52, 0x50, 0xfc, 0x04, 0xb5, 0xa5, 0x85, 0xae, 0xf5, 0xb5, 0x41, 0xe7, 0xa0,
+	0x6b, 0xcd, 0x1f, 0xc7, 0x3a, 0x15, 0x08, 0x67, 0xf1, 0x7a, 0xdc, 0x6b, 0xb9, 0x0a, 0x8f, 0x1f,
+	0xa3, 0xb6, 0xf0, 0x06, 0xfd, 0xaf, 0xfe, 0xc2, 0xa0, 0x73, 0xb0, 0xd3, 0xc4, 0x3c, 0xae, 0x10,
+	0x35, 0x51, 0xc2, 0x31, 0x47, 0x98, 0xf1, 0x60, 0x44, 0x7c, 0x46, 0xbd, 0x7a, 0x02, 0xa0, 0x2f,
+	0x28, 0x11, 0x39, 0x23, 0xab, 0x9e, 0x91, 0xf5, 0x4c, 0x21, 0x9c, 0xdd, 0x4a, 0xe4, 0x7e, 0xdc,
+	0xdb, 0xb9, 0x22, 0x09, 0x3b, 0x34, 0xe7, 0x25, 0xcc, 0x4f, 0x5f, 0x7b, 0x9a, 0xbb, 0x51, 0x37,
+	0x6a, 0x22, 0x60, 0x13, 0xad, 0x31, 0x02, 0x85, 0x27, 0xfc, 0xbd, 0x38, 0xd4, 0x17, 0xfb, 0xda,
+	0x60, 0xd1, 0xed, 0x54, 0x0f, 0x45, 0xc0, 0x93, 0x10, 0x33, 0xb4, 0xce, 0x32, 0x0f, 0x86, 0x24,
+	0xa7, 0xde, 0x90, 0xb3, 0x90, 0xe6, 0xa0, 0x2f, 0x89, 0x48, 0xff, 0x35, 0x9d, 0xeb, 0xe5, 0xe9,
+	0x59, 0x05, 0x7d, 0x2e, 0x90, 0x4e, 0x4f, 0x45, 0xdb, 0x56, 0xd1, 0x66, 0x84, 0x4c, 0xf7, 0x1f,
+	0x96, 0x4d, 0xe1, 0x01, 0xbf, 0x47, 0x9b, 0xf2, 0xbd, 0x78, 0x24, 0x08, 0xca, 0xa4, 0x64, 0xa4,
+	0xe0, 0x39, 0xe8, 0x6d, 0x61, 0xb8, 0xdb, 0x64, 0xe8, 0x0a, 0xf8, 0xd3, 0x09, 0xda, 0x31, 0x95,
+	0x69, 0x57, 0x9a, 0x36, 0xe8, 0x99, 0x2e, 0xce, 0x67, 0x69, 0x80, 0x3f, 0x68, 0x68, 0xbb, 0x9a,
+	0x91, 0xa7, 0x18, 0xc1, 0x90, 0x06, 0xa3, 0x8c, 0xc7, 0x69, 0x01, 0xfa, 0xb2, 0x08, 0x30, 0x68,
+	0x3c, 0x31, 0x0f, 0x46, 0x32, 0xc4, 0xd1, 0x2f, 0x82, 0xb3, 0xa7, 0x32, 0x18, 0x93, 0x77, 0xd2,
+	0x20, 0x6b, 0xba, 0xff, 0xb2, 0x06, 0x36, 0x60, 0x40, 0x1b, 0x65, 0x1a, 0x30, 0x12, 0x27, 0x34,
+	0x54, 0x3c, 0xd0, 0xff, 0x16, 0x19, 0xfe, 0x6f, 0xca, 0xf0, 0xba, 0x06, 0x4b, 0x29, 0x70, 0xfa,
+	0xca, 0x5f, 0x97, 0xfe, 0x73, 0x62, 0xa6, 0xbb, 0x5e, 0xce, 0x70, 0xaa, 0xd9, 0xcb, 0x45, 0x08,
+	0x78, 0x5a, 0xe4, 0xb1, 0x5f, 0xca, 0xfd, 0x5b, 0xf9, 0xf3, 0xec, 0xc5, 0x8e, 0x1c, 0x4d, 0xa1,
+	0x67, 0x67, 0xdf, 0xa0, 0x67, 0xba, 0x38, 0x9a, 0xa5, 0x81, 0xf9, 0x5d, 0x43, 0x6b, 0xbf, 0xad,
+	0x0e, 0xde, 0x42, 0x4b, 0x21, 0x4d, 0x79, 0x22, 0xae, 0xdf, 0x8a, 0x2b, 0x0b, 0xac, 0xa3, 0x65,
+	0x12, 0x86, 0x39, 0x85, 0xea, 0x72, 0x55, 0xcf, 0xeb, 0x12, 0x5f, 0xa2, 0x8d, 0xb7, 0x25, 0x61,
+	0xf1, 0xf9, 0x55, 0x9c, 0x46, 0x72, 0xcd, 0xaa, 0xbb, 0xa3, 0x0d, 0x56, 0x9c, 0x17, 0x55, 0xa8,
+	0x2f, 0xe3, 0xde, 0x5e, 0x14, 0x17, 0xc3, 0xd2, 0xb7, 0x02, 0x9e, 0xd8, 0x81, 0x38, 0x8e, 0xfa,
+	0xd9, 0x87, 0x70, 0x64, 0x17, 0x57, 0x19, 0x05, 0xeb, 0x24, 0x2d, 0x26, 0x63, 0x9b, 0x13, 0x34,
+	0xdd, 0xf5, 0xc9, 0x33, 0x91, 0x16, 0xf0, 0x21, 0x5a, 0xa5, 0x19, 0x0f, 0x86, 0x5e, 0x5a, 0x26,
+	0x3e, 0xcd, 0xc5, 0x1d, 0x5a, 0x70, 0xb6, 0xef, 0xc7, 0xbd, 0x4d, 0xa9, 0x32, 0xdd, 0x35, 0xdd,
+	0x8e, 0x28, 0x5f, 0x89, 0xca, 0x39, 0xbd, 0xbe, 0x35, 0xb4, 0x9b, 0x5b, 0x43, 0xfb, 0x76, 0x6b,
+	0x68, 0x1f, 0xef, 0x8c, 0xd6, 0xcd, 0x9d, 0xd1, 0xfa, 0x7c, 0x67, 0xb4, 0xde, 0x3c, 0x9a, 0xca,
+	0xaa, 0x26, 0xbf, 0xcf, 0x88, 0x0f, 0x75, 0x61, 0x5f, 0x3c, 0x38, 0xb0, 0xdf, 0x4d, 0x7f, 0xcf,
+	0x44, 0x7e, 0xbf, 0x2d, 0xbe, 0x0f, 0x0f, 0x7f, 0x0e, 0x00, 0xc8, 0xda, 0x9e, 0x66, 0x9f, 0x05,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -155,6 +295,76 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.GaugeContributions) > 0 {
+		for iNdEx := len(m.GaugeContributions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GaugeContributions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.UnclaimedRewards) > 0 {
+		for iNdEx := len(m.UnclaimedRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnclaimedRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.LockRewardCheckpoints) > 0 {
+		for iNdEx := len(m.LockRewardCheckpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockRewardCheckpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.RewardAccumulators) > 0 {
+		for iNdEx := len(m.RewardAccumulators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardAccumulators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.LpShareHolders) > 0 {
+		for iNdEx := len(m.LpShareHolders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LpShareHolders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if m.LastGaugeId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.LastGaugeId))
 		i--
@@ -199,6 +409,58 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LPShareHolder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LPShareHolder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LPShareHolder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.QualifyingShares.Size()
+		i -= size
+		if _, err := m.QualifyingShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -233,6 +495,58 @@ func (m *GenesisState) Size() (n int) {
 	if m.LastGaugeId != 0 {
 		n += 1 + sovGenesis(uint64(m.LastGaugeId))
 	}
+	if len(m.LpShareHolders) > 0 {
+		for _, e := range m.LpShareHolders {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RewardAccumulators) > 0 {
+		for _, e := range m.RewardAccumulators {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LockRewardCheckpoints) > 0 {
+		for _, e := range m.LockRewardCheckpoints {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UnclaimedRewards) > 0 {
+		for _, e := range m.UnclaimedRewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.GaugeContributions) > 0 {
+		for _, e := range m.GaugeContributions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LPShareHolder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.QualifyingShares.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.EpochNumber != 0 {
+		n += 1 + sovGenesis(uint64(m.EpochNumber))
+	}
 	return n
 }
 
@@ -391,6 +705,343 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LpShareHolders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LpShareHolders = append(m.LpShareHolders, LPShareHolder{})
+			if err := m.LpShareHolders[len(m.LpShareHolders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardAccumulators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardAccumulators = append(m.RewardAccumulators, RewardAccumulator{})
+			if err := m.RewardAccumulators[len(m.RewardAccumulators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockRewardCheckpoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockRewardCheckpoints = append(m.LockRewardCheckpoints, LockRewardCheckpoint{})
+			if err := m.LockRewardCheckpoints[len(m.LockRewardCheckpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnclaimedRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnclaimedRewards = append(m.UnclaimedRewards, UnclaimedRewards{})
+			if err := m.UnclaimedRewards[len(m.UnclaimedRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GaugeContributions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GaugeContributions = append(m.GaugeContributions, GaugeContribution{})
+			if err := m.GaugeContributions[len(m.GaugeContributions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LPShareHolder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LPShareHolder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LPShareHolder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QualifyingShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QualifyingShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -40,6 +40,21 @@ var (
 	// KeyPrefixGaugesByDenom defines prefix key for storing indexes of gauge IDs by denomination.
 	KeyPrefixGaugesByDenom = []byte{0x05}
 
+	// KeyPrefixLPShareHolders defines prefix key for storing the accounts holding unlocked pool shares by share denomination.
+	KeyPrefixLPShareHolders = []byte{0x08}
+
+	// KeyPrefixRewardAccumulator defines prefix key for storing the reward accumulators by denomination and duration.
+	KeyPrefixRewardAccumulator = []byte{0x09}
+
+	// KeyPrefixLockRewardCheckpoint defines prefix key for storing the reward checkpoints of locks by lock ID.
+	KeyPrefixLockRewardCheckpoint = []byte{0x0A}
+
+	// KeyPrefixUnclaimedRewards defines prefix key for storing the unclaimed rewards of accounts by address.
+	KeyPrefixUnclaimedRewards = []byte{0x0B}
+
+	// KeyPrefixGaugeContributions defines prefix key for storing the contributions to gauges by gauge ID and address.
+	KeyPrefixGaugeContributions = []byte{0x0C}
+
 	// KeyIndexSeparator defines key for merging bytes.
 	KeyIndexSeparator = []byte{0x07}
 
@@ -12,6 +12,10 @@ import (
 const (
 	TypeMsgCreateGauge = "create_gauge"
 	TypeMsgAddToGauge  = "add_to_gauge"
+
+	TypeMsgCancelGauge           = "cancel_gauge"
+	TypeMsgWithdrawUndistributed = "withdraw_undistributed"
+	TypeMsgClaimRewards          = "claim_rewards"
 )
 
 var _ sdk.Msg = &MsgCreateGauge{}
@@ -55,8 +59,12 @@ func (m MsgCreateGauge) ValidateBasic() error {
 		return errors.New("distribution period should be 1 epoch for perpetual gauge")
 	}
 
-	if lockuptypes.LockQueryType_name[int32(m.DistributeTo.LockQueryType)] != "ByDuration" {
-		return errors.New("only duration query condition is allowed. Start time distr conditions is an obsolete codepath slated for deletion")
+	if m.DistributeTo.LockQueryType == lockuptypes.ByTime {
+		return errors.New("only duration or no lock query conditions are allowed. Start time distr conditions is an obsolete codepath slated for deletion")
+	}
+
+	if m.DistributeTo.LockQueryType == lockuptypes.NoLock && m.DistributeTo.Duration != 0 {
+		return errors.New("lock duration should not be set for no lock query condition")
 	}
 
 	return nil
@@ -112,3 +120,116 @@ func (m MsgAddToGauge) GetSigners() []sdk.AccAddress {
 	owner, _ := sdk.AccAddressFromBech32(m.Owner)
 	return []sdk.AccAddress{owner}
 }
+
+var _ sdk.Msg = &MsgCancelGauge{}
+
+// NewMsgCancelGauge creates a message to cancel a gauge and refund its undistributed coins to its owner.
+func NewMsgCancelGauge(owner sdk.AccAddress, gaugeId uint64) *MsgCancelGauge {
+	return &MsgCancelGauge{
+		Owner:   owner.String(),
+		GaugeId: gaugeId,
+	}
+}
+
+// Route takes a cancel gauge message, then returns the RouterKey used for slashing.
+func (m MsgCancelGauge) Route() string { return RouterKey }
+
+// Type takes a cancel gauge message, then returns a cancel gauge message type.
+func (m MsgCancelGauge) Type() string { return TypeMsgCancelGauge }
+
+// ValidateBasic checks that a cancel gauge message is valid.
+func (m MsgCancelGauge) ValidateBasic() error {
+	if m.Owner == "" {
+		return errors.New("owner should be set")
+	}
+	if m.GaugeId == 0 {
+		return errors.New("gauge id should be set")
+	}
+
+	return nil
+}
+
+// GetSignBytes takes a cancel gauge message and turns it into a byte array.
+func (m MsgCancelGauge) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners takes a cancel gauge message and returns the owner in a byte array.
+func (m MsgCancelGauge) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{owner}
+}
+
+var _ sdk.Msg = &MsgWithdrawUndistributed{}
+
+// NewMsgWithdrawUndistributed creates a message to withdraw the undistributed coins of a finished gauge to its owner.
+func NewMsgWithdrawUndistributed(owner sdk.AccAddress, gaugeId uint64) *MsgWithdrawUndistributed {
+	return &MsgWithdrawUndistributed{
+		Owner:   owner.String(),
+		GaugeId: gaugeId,
+	}
+}
+
+// Route takes a withdraw undistributed message, then returns the RouterKey used for slashing.
+func (m MsgWithdrawUndistributed) Route() string { return RouterKey }
+
+// Type takes a withdraw undistributed message, then returns a withdraw undistributed message type.
+func (m MsgWithdrawUndistributed) Type() string { return TypeMsgWithdrawUndistributed }
+
+// ValidateBasic checks that a withdraw undistributed message is valid.
+func (m MsgWithdrawUndistributed) ValidateBasic() error {
+	if m.Owner == "" {
+		return errors.New("owner should be set")
+	}
+	if m.GaugeId == 0 {
+		return errors.New("gauge id should be set")
+	}
+
+	return nil
+}
+
+// GetSignBytes takes a withdraw undistributed message and turns it into a byte array.
+func (m MsgWithdrawUndistributed) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners takes a withdraw undistributed message and returns the owner in a byte array.
+func (m MsgWithdrawUndistributed) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{owner}
+}
+
+var _ sdk.Msg = &MsgClaimRewards{}
+
+// NewMsgClaimRewards creates a message to claim the rewards accrued by the locks of the owner.
+func NewMsgClaimRewards(owner sdk.AccAddress) *MsgClaimRewards {
+	return &MsgClaimRewards{
+		Owner: owner.String(),
+	}
+}
+
+// Route takes a claim rewards message, then returns the RouterKey used for slashing.
+func (m MsgClaimRewards) Route() string { return RouterKey }
+
+// Type takes a claim rewards message, then returns a claim rewards message type.
+func (m MsgClaimRewards) Type() string { return TypeMsgClaimRewards }
+
+// ValidateBasic checks that a claim rewards message is valid.
+func (m MsgClaimRewards) ValidateBasic() error {
+	if m.Owner == "" {
+		return errors.New("owner should be set")
+	}
+
+	return nil
+}
+
+// GetSignBytes takes a claim rewards message and turns it into a byte array.
+func (m MsgClaimRewards) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners takes a claim rewards message and returns the owner in a byte array.
+func (m MsgClaimRewards) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{owner}
+}
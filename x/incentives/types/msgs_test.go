@@ -105,6 +105,23 @@ func TestMsgCreatePool(t *testing.T) {
 			}),
 			expectPass: false,
 		},
+		{
+			name: "valid no lock query type",
+			msg: createMsg(func(msg incentivestypes.MsgCreateGauge) incentivestypes.MsgCreateGauge {
+				msg.DistributeTo.LockQueryType = lockuptypes.NoLock
+				msg.DistributeTo.Duration = 0
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid lock duration for no lock query type",
+			msg: createMsg(func(msg incentivestypes.MsgCreateGauge) incentivestypes.MsgCreateGauge {
+				msg.DistributeTo.LockQueryType = lockuptypes.NoLock
+				return msg
+			}),
+			expectPass: false,
+		},
 		{
 			name: "invalid distribution start time",
 			msg: createMsg(func(msg incentivestypes.MsgCreateGauge) incentivestypes.MsgCreateGauge {
@@ -256,6 +273,26 @@ func TestAuthzMsg(t *testing.T) {
 				NumEpochsPaidOver: 1,
 			},
 		},
+		{
+			name: "MsgCancelGauge",
+			incentivesMsg: &incentivestypes.MsgCancelGauge{
+				Owner:   addr1,
+				GaugeId: 1,
+			},
+		},
+		{
+			name: "MsgWithdrawUndistributed",
+			incentivesMsg: &incentivestypes.MsgWithdrawUndistributed{
+				Owner:   addr1,
+				GaugeId: 1,
+			},
+		},
+		{
+			name: "MsgClaimRewards",
+			incentivesMsg: &incentivestypes.MsgClaimRewards{
+				Owner: addr1,
+			},
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
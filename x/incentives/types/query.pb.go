@@ -912,6 +912,96 @@ func (m *QueryLockableDurationsResponse) GetLockableDurations() []time.Duration
 	return nil
 }
 
+type QueryClaimableRewardsRequest struct {
+	// Address of the owner of the locks
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+}
+
+func (m *QueryClaimableRewardsRequest) Reset()         { *m = QueryClaimableRewardsRequest{} }
+func (m *QueryClaimableRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClaimableRewardsRequest) ProtoMessage()    {}
+func (*QueryClaimableRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8124258a89427f98, []int{18}
+}
+func (m *QueryClaimableRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClaimableRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClaimableRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClaimableRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClaimableRewardsRequest.Merge(m, src)
+}
+func (m *QueryClaimableRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClaimableRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClaimableRewardsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClaimableRewardsRequest proto.InternalMessageInfo
+
+func (m *QueryClaimableRewardsRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+type QueryClaimableRewardsResponse struct {
+	// Rewards that the owner can claim
+	Coins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+}
+
+func (m *QueryClaimableRewardsResponse) Reset()         { *m = QueryClaimableRewardsResponse{} }
+func (m *QueryClaimableRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClaimableRewardsResponse) ProtoMessage()    {}
+func (*QueryClaimableRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8124258a89427f98, []int{19}
+}
+func (m *QueryClaimableRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClaimableRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClaimableRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClaimableRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClaimableRewardsResponse.Merge(m, src)
+}
+func (m *QueryClaimableRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClaimableRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClaimableRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClaimableRewardsResponse proto.InternalMessageInfo
+
+func (m *QueryClaimableRewardsResponse) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Coins
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ModuleToDistributeCoinsRequest)(nil), "osmosis.incentives.ModuleToDistributeCoinsRequest")
 	proto.RegisterType((*ModuleToDistributeCoinsResponse)(nil), "osmosis.incentives.ModuleToDistributeCoinsResponse")
@@ -931,79 +1021,83 @@ func init() {
 	proto.RegisterType((*RewardsEstResponse)(nil), "osmosis.incentives.RewardsEstResponse")
 	proto.RegisterType((*QueryLockableDurationsRequest)(nil), "osmosis.incentives.QueryLockableDurationsRequest")
 	proto.RegisterType((*QueryLockableDurationsResponse)(nil), "osmosis.incentives.QueryLockableDurationsResponse")
+	proto.RegisterType((*QueryClaimableRewardsRequest)(nil), "osmosis.incentives.QueryClaimableRewardsRequest")
+	proto.RegisterType((*QueryClaimableRewardsResponse)(nil), "osmosis.incentives.QueryClaimableRewardsResponse")
 }
 
 func init() { proto.RegisterFile("osmosis/incentives/query.proto", fileDescriptor_8124258a89427f98) }
 
 var fileDescriptor_8124258a89427f98 = []byte{
-	// 1057 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0x33, 0x4e, 0x5c, 0x9a, 0xd7, 0x12, 0x9a, 0x21, 0x40, 0xe2, 0xb6, 0x6b, 0xb3, 0x6a,
-	0x53, 0x37, 0x25, 0x3b, 0xb1, 0x4d, 0x53, 0x04, 0x02, 0x09, 0xe3, 0x52, 0x2a, 0x81, 0x14, 0x56,
-	0x20, 0x24, 0x24, 0xb4, 0x5a, 0x7b, 0x87, 0xed, 0x2a, 0xf6, 0x8e, 0xeb, 0xd9, 0x4d, 0xb0, 0xa2,
-	0x5c, 0x10, 0xe7, 0x0a, 0x44, 0x84, 0x38, 0xf4, 0x2f, 0xe0, 0x08, 0x12, 0x47, 0x0e, 0x9c, 0x7a,
-	0xac, 0x84, 0x84, 0x38, 0xa5, 0x28, 0xe1, 0x2f, 0xe8, 0x5f, 0x80, 0x76, 0x76, 0xd6, 0x5e, 0xdb,
-	0xeb, 0x75, 0x82, 0x68, 0x94, 0x93, 0x33, 0x79, 0xbf, 0x3e, 0xef, 0xeb, 0xf1, 0x7b, 0x03, 0x0a,
-	0xe3, 0x2d, 0xc6, 0x1d, 0x4e, 0x1c, 0xb7, 0x41, 0x5d, 0xcf, 0xd9, 0xa2, 0x9c, 0xdc, 0xf7, 0x69,
-	0xa7, 0xab, 0xb5, 0x3b, 0xcc, 0x63, 0x18, 0x4b, 0xbb, 0xd6, 0xb7, 0xe7, 0x16, 0x6c, 0x66, 0x33,
-	0x61, 0x26, 0xc1, 0x5f, 0xa1, 0x67, 0xee, 0x92, 0xcd, 0x98, 0xdd, 0xa4, 0xc4, 0x6c, 0x3b, 0xc4,
-	0x74, 0x5d, 0xe6, 0x99, 0x9e, 0xc3, 0x5c, 0x2e, 0xad, 0x8a, 0xb4, 0x8a, 0x53, 0xdd, 0xff, 0x92,
-	0x58, 0x7e, 0x47, 0x38, 0x44, 0xf6, 0x86, 0x28, 0x44, 0xea, 0x26, 0xa7, 0x64, 0xab, 0x54, 0xa7,
-	0x9e, 0x59, 0x22, 0x0d, 0xe6, 0x44, 0xf6, 0x95, 0xb8, 0x5d, 0x00, 0xf6, 0xbc, 0xda, 0xa6, 0xed,
-	0xb8, 0x03, 0xb9, 0x12, 0x7a, 0xb2, 0x4d, 0xdf, 0xa6, 0xd2, 0xbe, 0x14, 0xd9, 0x9b, 0xac, 0xb1,
-	0xe9, 0xb7, 0xc5, 0x47, 0x68, 0x52, 0x0b, 0xa0, 0x7c, 0xc4, 0x2c, 0xbf, 0x49, 0x3f, 0x61, 0x35,
-	0x87, 0x7b, 0x1d, 0xa7, 0xee, 0x7b, 0xf4, 0x3d, 0xe6, 0xb8, 0x5c, 0xa7, 0xf7, 0x7d, 0xca, 0x3d,
-	0xf5, 0x1b, 0x04, 0xf9, 0xb1, 0x2e, 0xbc, 0xcd, 0x5c, 0x4e, 0xb1, 0x09, 0xd9, 0x00, 0x9d, 0x2f,
-	0xa2, 0xc2, 0x74, 0xf1, 0x5c, 0x79, 0x49, 0x0b, 0xe1, 0xb5, 0x00, 0x5e, 0x93, 0xd8, 0x5a, 0x10,
-	0x52, 0x5d, 0x7b, 0xb4, 0x9f, 0x9f, 0xfa, 0xe9, 0x49, 0xbe, 0x68, 0x3b, 0xde, 0x3d, 0xbf, 0xae,
-	0x35, 0x58, 0x8b, 0xc8, 0x4e, 0xc3, 0x8f, 0x55, 0x6e, 0x6d, 0x12, 0xaf, 0xdb, 0xa6, 0x5c, 0x0b,
-	0x6b, 0x84, 0x99, 0x55, 0x15, 0x2e, 0xdc, 0x09, 0x5a, 0xaa, 0x76, 0xef, 0xd6, 0x24, 0x1a, 0x9e,
-	0x83, 0x8c, 0x63, 0x2d, 0xa2, 0x02, 0x2a, 0xce, 0xe8, 0x19, 0xc7, 0x52, 0x6b, 0x30, 0x1f, 0xf3,
-	0x91, 0x6c, 0x04, 0xb2, 0x42, 0x0b, 0xe1, 0x17, 0xb0, 0x8d, 0x7e, 0xc1, 0x9a, 0x88, 0xd2, 0x43,
-	0x3f, 0xf5, 0x33, 0x78, 0x5e, 0x9c, 0x23, 0x05, 0xf0, 0xfb, 0x00, 0x7d, 0xc9, 0x65, 0x9a, 0xe5,
-	0x81, 0x16, 0xc3, 0x0b, 0x14, 0x35, 0xba, 0x61, 0xda, 0x54, 0xc6, 0xea, 0xb1, 0x48, 0xf5, 0x01,
-	0x82, 0xb9, 0x28, 0xb3, 0x84, 0xab, 0xc0, 0x8c, 0x65, 0x7a, 0x66, 0x4f, 0xb7, 0x71, 0x6c, 0xd5,
-	0x99, 0x40, 0x37, 0x5d, 0x38, 0xe3, 0x3b, 0x03, 0x3c, 0x19, 0xc1, 0x73, 0x6d, 0x22, 0x4f, 0x58,
-	0x71, 0x00, 0xe8, 0x0b, 0x78, 0xf1, 0xdd, 0x46, 0x50, 0xe5, 0xd9, 0xf4, 0xbb, 0x87, 0x60, 0x61,
-	0x30, 0xff, 0xa9, 0xe8, 0x7a, 0x07, 0x2e, 0xc6, 0xa9, 0x36, 0x68, 0xa7, 0x46, 0x5d, 0xd6, 0x8a,
-	0xba, 0x5f, 0x80, 0xac, 0x15, 0x9c, 0x45, 0xe3, 0xb3, 0x7a, 0x78, 0x18, 0xd2, 0x24, 0xf3, 0x9f,
-	0x35, 0x79, 0x88, 0xe0, 0x52, 0x72, 0xf5, 0x53, 0xa1, 0x8d, 0x01, 0x2f, 0x7d, 0xda, 0x6e, 0xb0,
-	0x96, 0xe3, 0xda, 0xcf, 0xe6, 0x4e, 0xfc, 0x80, 0xe0, 0xe5, 0xe1, 0x0a, 0xa7, 0xa2, 0xf3, 0x5d,
-	0xb8, 0x3c, 0xc8, 0x75, 0xb2, 0xf7, 0xe2, 0x17, 0x04, 0xca, 0xb8, 0xfa, 0x52, 0x9f, 0x0f, 0xe0,
-	0x05, 0x5f, 0x7a, 0x18, 0x62, 0x52, 0xf1, 0xa3, 0x4a, 0x35, 0xe7, 0x0f, 0x64, 0xfe, 0xff, 0x44,
-	0xe3, 0x30, 0xaf, 0xd3, 0x6d, 0xb3, 0x63, 0xf1, 0xdb, 0xdc, 0x8b, 0x84, 0x5a, 0x86, 0x2c, 0xdb,
-	0x76, 0x69, 0x27, 0x14, 0xaa, 0x7a, 0xe1, 0xe9, 0x7e, 0xfe, 0x7c, 0xd7, 0x6c, 0x35, 0xdf, 0x54,
-	0xc5, 0xbf, 0x55, 0x3d, 0x34, 0xe3, 0x25, 0x38, 0x1b, 0x2c, 0x22, 0xc3, 0xb1, 0xf8, 0x62, 0xa6,
-	0x30, 0x5d, 0x9c, 0xd1, 0x9f, 0x0b, 0xce, 0x77, 0x2d, 0x8e, 0x2f, 0xc2, 0x2c, 0x75, 0x2d, 0x83,
-	0xb6, 0x59, 0xe3, 0xde, 0xe2, 0x74, 0x01, 0x15, 0xa7, 0xf5, 0xb3, 0xd4, 0xb5, 0x6e, 0x07, 0x67,
-	0x75, 0x1b, 0x70, 0xbc, 0xe8, 0xc9, 0xad, 0xa0, 0x3c, 0x5c, 0xfe, 0x38, 0xd0, 0xe5, 0x43, 0xd6,
-	0xd8, 0x34, 0xeb, 0x4d, 0x5a, 0x93, 0x1b, 0xbd, 0xb7, 0x2a, 0xbf, 0x43, 0xa0, 0x8c, 0xf3, 0x90,
-	0x98, 0x0c, 0x70, 0x53, 0x1a, 0x8d, 0xe8, 0x45, 0xd0, 0x67, 0x0e, 0xdf, 0x0c, 0x5a, 0xf4, 0x66,
-	0xd0, 0xa2, 0xf8, 0xea, 0xd5, 0x80, 0xf9, 0xe9, 0x7e, 0x7e, 0x29, 0x14, 0x72, 0x34, 0x85, 0xfa,
-	0xe3, 0x93, 0x3c, 0xd2, 0xe7, 0x9b, 0xc3, 0x85, 0xcb, 0x7f, 0x9e, 0x83, 0xac, 0x60, 0xc2, 0xbf,
-	0x23, 0x78, 0x65, 0xcc, 0x22, 0xc7, 0xe5, 0xa4, 0x2b, 0x94, 0xfe, 0x30, 0xc8, 0x55, 0x8e, 0x15,
-	0x13, 0xf6, 0xaf, 0xbe, 0xf3, 0xf5, 0x1f, 0xff, 0x7c, 0x9f, 0x79, 0x03, 0xaf, 0x93, 0x84, 0x37,
-	0x4b, 0xf4, 0xc0, 0x69, 0x89, 0x24, 0x86, 0xc7, 0x0c, 0xab, 0x97, 0xc6, 0x10, 0xdf, 0x01, 0x7e,
-	0x80, 0x60, 0xb6, 0xb7, 0xe3, 0xf1, 0x95, 0xf1, 0x37, 0xbf, 0xff, 0x4c, 0xc8, 0x5d, 0x9d, 0xe0,
-	0x25, 0xd1, 0x5e, 0x17, 0x68, 0x1a, 0x7e, 0x2d, 0x0d, 0x4d, 0xfc, 0xf0, 0x8c, 0x7a, 0xd7, 0x70,
-	0x2c, 0xb2, 0xe3, 0x58, 0xbb, 0x78, 0x07, 0xce, 0xc8, 0x5f, 0xd5, 0xab, 0x63, 0xcb, 0xf4, 0x24,
-	0x53, 0xd3, 0x5c, 0x24, 0xc6, 0x8a, 0xc0, 0xb8, 0x82, 0xd5, 0x89, 0x18, 0x1c, 0xef, 0x21, 0x38,
-	0x1f, 0xdf, 0x26, 0xf8, 0x5a, 0x52, 0x81, 0x84, 0x1d, 0x9f, 0x2b, 0x4e, 0x76, 0x94, 0x3c, 0x25,
-	0xc1, 0x73, 0x03, 0x5f, 0x4f, 0xe3, 0x31, 0x45, 0xa4, 0x1c, 0x4b, 0xf8, 0xd7, 0xa1, 0xc5, 0x1f,
-	0x8d, 0x32, 0x4c, 0x26, 0x55, 0x1d, 0x1a, 0xba, 0xb9, 0xb5, 0xa3, 0x07, 0x48, 0xdc, 0xb7, 0x04,
-	0xee, 0x4d, 0x5c, 0x39, 0x32, 0xae, 0xd1, 0xa6, 0x1d, 0x23, 0x9c, 0xe6, 0x0f, 0x11, 0xcc, 0x0d,
-	0x4e, 0x61, 0x7c, 0x3d, 0x89, 0x20, 0x71, 0x47, 0xe6, 0x56, 0x8e, 0xe2, 0x2a, 0x31, 0x2b, 0x02,
-	0x73, 0x15, 0xdf, 0x48, 0xc3, 0x1c, 0x1a, 0xf7, 0xf8, 0xb7, 0x91, 0xe5, 0xd9, 0x53, 0xb6, 0x34,
-	0xb9, 0xf6, 0xb0, 0xb6, 0xe5, 0xe3, 0x84, 0x48, 0xec, 0xb7, 0x05, 0xf6, 0x2d, 0x7c, 0xf3, 0x18,
-	0xd8, 0x31, 0x7d, 0xf7, 0x10, 0x40, 0x7f, 0x76, 0xe3, 0xc4, 0x1f, 0xe6, 0xc8, 0x42, 0xc9, 0x2d,
-	0x4f, 0x72, 0x93, 0x70, 0xb7, 0x04, 0x5c, 0x09, 0x93, 0x34, 0xb8, 0x4e, 0x18, 0x67, 0x50, 0xee,
-	0x91, 0x1d, 0xb1, 0x88, 0x76, 0xf1, 0xcf, 0x08, 0xe6, 0x47, 0x46, 0x76, 0xb2, 0xa4, 0xa9, 0x0b,
-	0x20, 0x59, 0xd2, 0xf4, 0x8d, 0xa0, 0xae, 0x0b, 0xea, 0x35, 0xac, 0xa5, 0x51, 0x8f, 0x0e, 0xfc,
-	0xea, 0xc6, 0xa3, 0x03, 0x05, 0x3d, 0x3e, 0x50, 0xd0, 0xdf, 0x07, 0x0a, 0xfa, 0xf6, 0x50, 0x99,
-	0x7a, 0x7c, 0xa8, 0x4c, 0xfd, 0x75, 0xa8, 0x4c, 0x7d, 0xbe, 0x1e, 0x5b, 0x6c, 0x32, 0xe7, 0x6a,
-	0xd3, 0xac, 0xf3, 0x5e, 0x81, 0xad, 0x52, 0x99, 0x7c, 0x15, 0x2f, 0x23, 0x96, 0x5d, 0xfd, 0x8c,
-	0xd8, 0x3b, 0x95, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xab, 0x12, 0x45, 0x20, 0x23, 0x0f, 0x00,
-	0x00,
+	// 1104 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0x4d, 0x6f, 0xdc, 0x44,
+	0x18, 0xc7, 0x33, 0x79, 0x29, 0xcd, 0x43, 0x1b, 0x92, 0x21, 0x40, 0xe2, 0x26, 0xde, 0xc5, 0x6a,
+	0xd3, 0x6d, 0x4a, 0xec, 0xec, 0x2e, 0x4d, 0x11, 0x08, 0x24, 0xb6, 0xdb, 0x94, 0x4a, 0x20, 0x05,
+	0x0b, 0x84, 0x84, 0x84, 0x2c, 0xaf, 0x3d, 0xb8, 0x56, 0x76, 0x3d, 0xdb, 0x1d, 0x3b, 0x61, 0x15,
+	0xe5, 0x52, 0x71, 0x2e, 0x20, 0x22, 0xc4, 0xa1, 0x9f, 0x80, 0x23, 0x48, 0x1c, 0x39, 0x70, 0xea,
+	0xb1, 0x12, 0x17, 0x4e, 0x29, 0x4a, 0xf8, 0x04, 0xfd, 0x04, 0xc8, 0xe3, 0xf1, 0xbe, 0xda, 0xbb,
+	0x59, 0xd4, 0x46, 0x39, 0x6d, 0x26, 0xcf, 0xdb, 0xef, 0xf9, 0xdb, 0x9e, 0xe7, 0x01, 0x99, 0xb2,
+	0x1a, 0x65, 0x2e, 0xd3, 0x5c, 0xcf, 0x22, 0x9e, 0xef, 0xee, 0x10, 0xa6, 0xdd, 0x0f, 0x48, 0xa3,
+	0xa9, 0xd6, 0x1b, 0xd4, 0xa7, 0x18, 0x0b, 0xbb, 0xda, 0xb6, 0x4b, 0xf3, 0x0e, 0x75, 0x28, 0x37,
+	0x6b, 0xe1, 0x5f, 0x91, 0xa7, 0xb4, 0xe4, 0x50, 0xea, 0x54, 0x89, 0x66, 0xd6, 0x5d, 0xcd, 0xf4,
+	0x3c, 0xea, 0x9b, 0xbe, 0x4b, 0x3d, 0x26, 0xac, 0xb2, 0xb0, 0xf2, 0x53, 0x25, 0xf8, 0x5a, 0xb3,
+	0x83, 0x06, 0x77, 0x88, 0xed, 0x16, 0x2f, 0xa4, 0x55, 0x4c, 0x46, 0xb4, 0x9d, 0x7c, 0x85, 0xf8,
+	0x66, 0x5e, 0xb3, 0xa8, 0x1b, 0xdb, 0x57, 0x3b, 0xed, 0x1c, 0xb0, 0xe5, 0x55, 0x37, 0x1d, 0xd7,
+	0xeb, 0xca, 0x95, 0xd0, 0x93, 0x63, 0x06, 0x0e, 0x11, 0xf6, 0xc5, 0xd8, 0x5e, 0xa5, 0xd6, 0x76,
+	0x50, 0xe7, 0x3f, 0x91, 0x49, 0xc9, 0x82, 0xfc, 0x09, 0xb5, 0x83, 0x2a, 0xf9, 0x8c, 0x96, 0x5d,
+	0xe6, 0x37, 0xdc, 0x4a, 0xe0, 0x93, 0x5b, 0xd4, 0xf5, 0x98, 0x4e, 0xee, 0x07, 0x84, 0xf9, 0xca,
+	0xb7, 0x08, 0x32, 0xa9, 0x2e, 0xac, 0x4e, 0x3d, 0x46, 0xb0, 0x09, 0x53, 0x21, 0x3a, 0x5b, 0x40,
+	0xd9, 0x89, 0xdc, 0xcb, 0x85, 0x45, 0x35, 0x82, 0x57, 0x43, 0x78, 0x55, 0x60, 0xab, 0x61, 0x48,
+	0x69, 0xfd, 0xf1, 0x61, 0x66, 0xec, 0x97, 0xa7, 0x99, 0x9c, 0xe3, 0xfa, 0xf7, 0x82, 0x8a, 0x6a,
+	0xd1, 0x9a, 0x26, 0x3a, 0x8d, 0x7e, 0xd6, 0x98, 0xbd, 0xad, 0xf9, 0xcd, 0x3a, 0x61, 0x6a, 0x54,
+	0x23, 0xca, 0xac, 0x28, 0x30, 0x7b, 0x27, 0x6c, 0xa9, 0xd4, 0xbc, 0x5b, 0x16, 0x68, 0x78, 0x06,
+	0xc6, 0x5d, 0x7b, 0x01, 0x65, 0x51, 0x6e, 0x52, 0x1f, 0x77, 0x6d, 0xa5, 0x0c, 0x73, 0x1d, 0x3e,
+	0x82, 0x4d, 0x83, 0x29, 0xae, 0x05, 0xf7, 0x0b, 0xd9, 0xfa, 0x1f, 0xb0, 0xca, 0xa3, 0xf4, 0xc8,
+	0x4f, 0xf9, 0x02, 0x2e, 0xf2, 0x73, 0xac, 0x00, 0xde, 0x04, 0x68, 0x4b, 0x2e, 0xd2, 0xac, 0x74,
+	0xb5, 0x18, 0xbd, 0x40, 0x71, 0xa3, 0x5b, 0xa6, 0x43, 0x44, 0xac, 0xde, 0x11, 0xa9, 0x3c, 0x44,
+	0x30, 0x13, 0x67, 0x16, 0x70, 0x45, 0x98, 0xb4, 0x4d, 0xdf, 0x6c, 0xe9, 0x96, 0xc6, 0x56, 0x9a,
+	0x0c, 0x75, 0xd3, 0xb9, 0x33, 0xbe, 0xd3, 0xc5, 0x33, 0xce, 0x79, 0xae, 0x0e, 0xe5, 0x89, 0x2a,
+	0x76, 0x01, 0x7d, 0x05, 0xaf, 0x7e, 0x68, 0x85, 0x55, 0x5e, 0x4c, 0xbf, 0x07, 0x08, 0xe6, 0xbb,
+	0xf3, 0x9f, 0x89, 0xae, 0xf7, 0xe0, 0x52, 0x27, 0xd5, 0x16, 0x69, 0x94, 0x89, 0x47, 0x6b, 0x71,
+	0xf7, 0xf3, 0x30, 0x65, 0x87, 0x67, 0xde, 0xf8, 0xb4, 0x1e, 0x1d, 0xf0, 0x66, 0x42, 0xf5, 0xff,
+	0xa3, 0xc9, 0x23, 0x04, 0x4b, 0xc9, 0xd5, 0xcf, 0x84, 0x36, 0x06, 0xbc, 0xf6, 0x79, 0xdd, 0xa2,
+	0x35, 0xd7, 0x73, 0x5e, 0xcc, 0x3b, 0xf1, 0x13, 0x82, 0xd7, 0x7b, 0x2b, 0x9c, 0x89, 0xce, 0xf7,
+	0x61, 0xb9, 0x9b, 0xeb, 0x74, 0xdf, 0x8b, 0xdf, 0x10, 0xc8, 0x69, 0xf5, 0x85, 0x3e, 0x1f, 0xc1,
+	0x2b, 0x81, 0xf0, 0x30, 0xf8, 0x4d, 0xc5, 0x4e, 0x2a, 0xd5, 0x4c, 0xd0, 0x95, 0xf9, 0xf9, 0x89,
+	0xc6, 0x60, 0x4e, 0x27, 0xbb, 0x66, 0xc3, 0x66, 0xb7, 0x99, 0x1f, 0x0b, 0xb5, 0x02, 0x53, 0x74,
+	0xd7, 0x23, 0x8d, 0x48, 0xa8, 0xd2, 0xec, 0xb3, 0xc3, 0xcc, 0x85, 0xa6, 0x59, 0xab, 0xbe, 0xab,
+	0xf0, 0x7f, 0x2b, 0x7a, 0x64, 0xc6, 0x8b, 0x70, 0x3e, 0x1c, 0x44, 0x86, 0x6b, 0xb3, 0x85, 0xf1,
+	0xec, 0x44, 0x6e, 0x52, 0x7f, 0x29, 0x3c, 0xdf, 0xb5, 0x19, 0xbe, 0x04, 0xd3, 0xc4, 0xb3, 0x0d,
+	0x52, 0xa7, 0xd6, 0xbd, 0x85, 0x89, 0x2c, 0xca, 0x4d, 0xe8, 0xe7, 0x89, 0x67, 0xdf, 0x0e, 0xcf,
+	0xca, 0x2e, 0xe0, 0xce, 0xa2, 0xa7, 0x37, 0x82, 0x32, 0xb0, 0xfc, 0x69, 0xa8, 0xcb, 0xc7, 0xd4,
+	0xda, 0x36, 0x2b, 0x55, 0x52, 0x16, 0x13, 0xbd, 0x35, 0x2a, 0x7f, 0x40, 0x20, 0xa7, 0x79, 0x08,
+	0x4c, 0x0a, 0xb8, 0x2a, 0x8c, 0x46, 0xbc, 0x11, 0xb4, 0x99, 0xa3, 0x9d, 0x41, 0x8d, 0x77, 0x06,
+	0x35, 0x8e, 0x2f, 0x5d, 0x09, 0x99, 0x9f, 0x1d, 0x66, 0x16, 0x23, 0x21, 0xfb, 0x53, 0x28, 0x3f,
+	0x3f, 0xcd, 0x20, 0x7d, 0xae, 0xda, 0x5b, 0x58, 0xd9, 0x84, 0x25, 0x8e, 0x74, 0xab, 0x6a, 0xba,
+	0xb5, 0xd0, 0x24, 0xb4, 0x1b, 0xf1, 0x69, 0x29, 0x0f, 0x10, 0x2c, 0xa7, 0x24, 0x3a, 0xb5, 0x27,
+	0x50, 0xf8, 0xee, 0x22, 0x4c, 0x71, 0x08, 0xfc, 0x27, 0x82, 0x37, 0x52, 0xb6, 0x12, 0x5c, 0x48,
+	0xfa, 0x1e, 0x06, 0x6f, 0x39, 0x52, 0x71, 0xa4, 0x98, 0xa8, 0x63, 0xe5, 0x83, 0x07, 0x7f, 0xfd,
+	0xfb, 0xe3, 0xf8, 0x3b, 0x78, 0x43, 0x4b, 0x58, 0xc0, 0xe2, 0x6d, 0xad, 0xc6, 0x93, 0x18, 0x3e,
+	0x35, 0xec, 0x56, 0x1a, 0x83, 0xb7, 0x83, 0x1f, 0x22, 0x98, 0x6e, 0x2d, 0x2c, 0xf8, 0x72, 0xfa,
+	0x67, 0xdc, 0xde, 0x79, 0xa4, 0x2b, 0x43, 0xbc, 0x04, 0xda, 0xdb, 0x1c, 0x4d, 0xc5, 0x6f, 0x0d,
+	0x42, 0xe3, 0xb7, 0x88, 0x51, 0x69, 0x1a, 0xae, 0xad, 0xed, 0xb9, 0xf6, 0x3e, 0xde, 0x83, 0x73,
+	0xe2, 0x8a, 0x78, 0x33, 0xb5, 0x4c, 0x4b, 0x32, 0x65, 0x90, 0x8b, 0xc0, 0x58, 0xe5, 0x18, 0x97,
+	0xb1, 0x32, 0x14, 0x83, 0xe1, 0x03, 0x04, 0x17, 0x3a, 0x47, 0x23, 0xbe, 0x9a, 0x54, 0x20, 0x61,
+	0x61, 0x91, 0x72, 0xc3, 0x1d, 0x05, 0x4f, 0x9e, 0xf3, 0x5c, 0xc7, 0xd7, 0x06, 0xf1, 0x98, 0x3c,
+	0x52, 0xdc, 0xb1, 0xf8, 0xf7, 0x9e, 0x2d, 0x26, 0xbe, 0x97, 0xb1, 0x36, 0xac, 0x6a, 0xcf, 0x04,
+	0x91, 0xd6, 0x4f, 0x1e, 0x20, 0x70, 0xdf, 0xe3, 0xb8, 0x37, 0x70, 0xf1, 0xc4, 0xb8, 0x46, 0x9d,
+	0x34, 0x8c, 0x68, 0x34, 0x3d, 0x42, 0x30, 0xd3, 0x3d, 0x52, 0xf0, 0xb5, 0x24, 0x82, 0xc4, 0x81,
+	0x2f, 0xad, 0x9e, 0xc4, 0x55, 0x60, 0x16, 0x39, 0xe6, 0x1a, 0xbe, 0x3e, 0x08, 0xb3, 0x67, 0x76,
+	0xe1, 0x3f, 0xfa, 0x36, 0x81, 0x96, 0xb2, 0xf9, 0xe1, 0xb5, 0x7b, 0xb5, 0x2d, 0x8c, 0x12, 0x22,
+	0xb0, 0xdf, 0xe7, 0xd8, 0x37, 0xf1, 0x8d, 0x11, 0xb0, 0x3b, 0xf4, 0x3d, 0x40, 0x00, 0xed, 0x41,
+	0x84, 0x13, 0x3f, 0xcc, 0xbe, 0xe9, 0x28, 0xad, 0x0c, 0x73, 0x13, 0x70, 0x37, 0x39, 0x5c, 0x1e,
+	0x6b, 0x83, 0xe0, 0x1a, 0x51, 0x9c, 0x41, 0x98, 0xaf, 0xed, 0xf1, 0x7b, 0x7a, 0x3f, 0x7c, 0x5f,
+	0x67, 0x7b, 0xef, 0x68, 0x9c, 0xf8, 0xea, 0x0d, 0x9a, 0x0b, 0x52, 0x7e, 0x84, 0x88, 0x51, 0xf4,
+	0xb4, 0xe2, 0x68, 0x43, 0xc0, 0xb7, 0xc0, 0x7f, 0x45, 0x30, 0xd7, 0x37, 0x38, 0x71, 0x3a, 0x47,
+	0xda, 0x18, 0x96, 0x0a, 0xa3, 0x84, 0x08, 0xf6, 0x0d, 0xce, 0xbe, 0x8e, 0xd5, 0x41, 0xec, 0xfd,
+	0x63, 0xb7, 0xb4, 0xf5, 0xf8, 0x48, 0x46, 0x4f, 0x8e, 0x64, 0xf4, 0xcf, 0x91, 0x8c, 0xbe, 0x3f,
+	0x96, 0xc7, 0x9e, 0x1c, 0xcb, 0x63, 0x7f, 0x1f, 0xcb, 0x63, 0x5f, 0x6e, 0x74, 0x0c, 0x37, 0x91,
+	0x73, 0xad, 0x6a, 0x56, 0x58, 0xab, 0xc0, 0x4e, 0xbe, 0xa0, 0x7d, 0xd3, 0x59, 0x86, 0x0f, 0xbc,
+	0xca, 0x39, 0x3e, 0xfd, 0x8b, 0xff, 0x0d, 0x00, 0x69, 0xba, 0x74, 0x71, 0xa9, 0x10, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1037,6 +1131,9 @@ type QueryClient interface {
 	// time in the future The querier either provides an address or a set of locks
 	// for which they want to find the associated rewards
 	RewardsEst(ctx context.Context, in *RewardsEstRequest, opts ...grpc.CallOption) (*RewardsEstResponse, error)
+	// ClaimableRewards returns the rewards accrued by the locks of an account
+	// that it can claim
+	ClaimableRewards(ctx context.Context, in *QueryClaimableRewardsRequest, opts ...grpc.CallOption) (*QueryClaimableRewardsResponse, error)
 	// LockableDurations returns lockable durations that are valid to distribute
 	// incentives for
 	LockableDurations(ctx context.Context, in *QueryLockableDurationsRequest, opts ...grpc.CallOption) (*QueryLockableDurationsResponse, error)
@@ -1122,6 +1219,15 @@ func (c *queryClient) RewardsEst(ctx context.Context, in *RewardsEstRequest, opt
 	return out, nil
 }
 
+func (c *queryClient) ClaimableRewards(ctx context.Context, in *QueryClaimableRewardsRequest, opts ...grpc.CallOption) (*QueryClaimableRewardsResponse, error) {
+	out := new(QueryClaimableRewardsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.incentives.Query/ClaimableRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) LockableDurations(ctx context.Context, in *QueryLockableDurationsRequest, opts ...grpc.CallOption) (*QueryLockableDurationsResponse, error) {
 	out := new(QueryLockableDurationsResponse)
 	err := c.cc.Invoke(ctx, "/osmosis.incentives.Query/LockableDurations", in, out, opts...)
@@ -1152,6 +1258,9 @@ type QueryServer interface {
 	// time in the future The querier either provides an address or a set of locks
 	// for which they want to find the associated rewards
 	RewardsEst(context.Context, *RewardsEstRequest) (*RewardsEstResponse, error)
+	// ClaimableRewards returns the rewards accrued by the locks of an account
+	// that it can claim
+	ClaimableRewards(context.Context, *QueryClaimableRewardsRequest) (*QueryClaimableRewardsResponse, error)
 	// LockableDurations returns lockable durations that are valid to distribute
 	// incentives for
 	LockableDurations(context.Context, *QueryLockableDurationsRequest) (*QueryLockableDurationsResponse, error)
@@ -1185,6 +1294,9 @@ func (*UnimplementedQueryServer) UpcomingGaugesPerDenom(ctx context.Context, req
 func (*UnimplementedQueryServer) RewardsEst(ctx context.Context, req *RewardsEstRequest) (*RewardsEstResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RewardsEst not implemented")
 }
+func (*UnimplementedQueryServer) ClaimableRewards(ctx context.Context, req *QueryClaimableRewardsRequest) (*QueryClaimableRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimableRewards not implemented")
+}
 func (*UnimplementedQueryServer) LockableDurations(ctx context.Context, req *QueryLockableDurationsRequest) (*QueryLockableDurationsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LockableDurations not implemented")
 }
@@ -1337,6 +1449,24 @@ func _Query_RewardsEst_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ClaimableRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClaimableRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClaimableRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.incentives.Query/ClaimableRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClaimableRewards(ctx, req.(*QueryClaimableRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_LockableDurations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryLockableDurationsRequest)
 	if err := dec(in); err != nil {
@@ -1391,6 +1521,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RewardsEst",
 			Handler:    _Query_RewardsEst_Handler,
 		},
+		{
+			MethodName: "ClaimableRewards",
+			Handler:    _Query_ClaimableRewards_Handler,
+		},
 		{
 			MethodName: "LockableDurations",
 			Handler:    _Query_LockableDurations_Handler,
@@ -2105,6 +2239,73 @@ func (m *QueryLockableDurationsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryClaimableRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimableRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimableRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimableRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimableRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimableRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -2395,6 +2596,34 @@ func (m *QueryLockableDurationsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryClaimableRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClaimableRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4179,6 +4408,172 @@ func (m *QueryLockableDurationsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryClaimableRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClaimableRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClaimableRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClaimableRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClaimableRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClaimableRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
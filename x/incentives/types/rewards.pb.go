@@ -0,0 +1,1086 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/incentives/rewards.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RewardAccumulator accumulates the rewards distributed per share of the locks
+// of a denom locked for at least a duration
+type RewardAccumulator struct {
+	// denom is the denom of the locks the rewards are distributed to
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// duration is the minimum duration of the locks the rewards are distributed
+	// to
+	Duration time.Duration `protobuf:"bytes,2,opt,name=duration,proto3,stdduration" json:"duration" yaml:"duration"`
+	// reward_per_share is the sum of the rewards distributed per locked share
+	RewardPerShare github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=reward_per_share,json=rewardPerShare,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"reward_per_share" yaml:"reward_per_share"`
+}
+
+func (m *RewardAccumulator) Reset()         { *m = RewardAccumulator{} }
+func (m *RewardAccumulator) String() string { return proto.CompactTextString(m) }
+func (*RewardAccumulator) ProtoMessage()    {}
+func (*RewardAccumulator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_63ce0966c8bc5bc3, []int{0}
+}
+func (m *RewardAccumulator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardAccumulator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardAccumulator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardAccumulator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardAccumulator.Merge(m, src)
+}
+func (m *RewardAccumulator) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardAccumulator) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardAccumulator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardAccumulator proto.InternalMessageInfo
+
+func (m *RewardAccumulator) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *RewardAccumulator) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *RewardAccumulator) GetRewardPerShare() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.RewardPerShare
+	}
+	return nil
+}
+
+// LockRewardCheckpoint is the state of a lock as of the last time its rewards
+// were settled, from which the rewards it has accrued since are computed
+type LockRewardCheckpoint struct {
+	// lock_id is the ID of the lock
+	LockId uint64 `protobuf:"varint,1,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty" yaml:"lock_id"`
+	// owner is the owner of the lock, that the accrued rewards are settled to
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	// coins are the coins locked by the lock
+	Coins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+	// duration is the duration of the lock
+	Duration time.Duration `protobuf:"bytes,4,opt,name=duration,proto3,stdduration" json:"duration" yaml:"duration"`
+	// accumulators are the values of the reward accumulators the lock shares in
+	Accumulators []RewardAccumulator `protobuf:"bytes,5,rep,name=accumulators,proto3" json:"accumulators"`
+}
+
+func (m *LockRewardCheckpoint) Reset()         { *m = LockRewardCheckpoint{} }
+func (m *LockRewardCheckpoint) String() string { return proto.CompactTextString(m) }
+func (*LockRewardCheckpoint) ProtoMessage()    {}
+func (*LockRewardCheckpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_63ce0966c8bc5bc3, []int{1}
+}
+func (m *LockRewardCheckpoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockRewardCheckpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockRewardCheckpoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LockRewardCheckpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockRewardCheckpoint.Merge(m, src)
+}
+func (m *LockRewardCheckpoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockRewardCheckpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockRewardCheckpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockRewardCheckpoint proto.InternalMessageInfo
+
+func (m *LockRewardCheckpoint) GetLockId() uint64 {
+	if m != nil {
+		return m.LockId
+	}
+	return 0
+}
+
+func (m *LockRewardCheckpoint) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *LockRewardCheckpoint) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Coins
+	}
+	return nil
+}
+
+func (m *LockRewardCheckpoint) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *LockRewardCheckpoint) GetAccumulators() []RewardAccumulator {
+	if m != nil {
+		return m.Accumulators
+	}
+	return nil
+}
+
+// UnclaimedRewards are the rewards settled to an account that it has not
+// claimed yet
+type UnclaimedRewards struct {
+	// owner is the account the rewards are settled to
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	// rewards are the settled rewards, including their decimal remainders
+	Rewards github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"rewards"`
+}
+
+func (m *UnclaimedRewards) Reset()         { *m = UnclaimedRewards{} }
+func (m *UnclaimedRewards) String() string { return proto.CompactTextString(m) }
+func (*UnclaimedRewards) ProtoMessage()    {}
+func (*UnclaimedRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_63ce0966c8bc5bc3, []int{2}
+}
+func (m *UnclaimedRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnclaimedRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnclaimedRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnclaimedRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnclaimedRewards.Merge(m, src)
+}
+func (m *UnclaimedRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnclaimedRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnclaimedRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnclaimedRewards proto.InternalMessageInfo
+
+func (m *UnclaimedRewards) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *UnclaimedRewards) GetRewards() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*RewardAccumulator)(nil), "osmosis.incentives.RewardAccumulator")
+	proto.RegisterType((*LockRewardCheckpoint)(nil), "osmosis.incentives.LockRewardCheckpoint")
+	proto.RegisterType((*UnclaimedRewards)(nil), "osmosis.incentives.UnclaimedRewards")
+}
+
+func init() { proto.RegisterFile("osmosis/incentives/rewards.proto", fileDescriptor_63ce0966c8bc5bc3) }
+
+var fileDescriptor_63ce0966c8bc5bc3 = []byte{
+	// 527 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0xae, 0xbb, 0x76, 0x63, 0xde, 0x34, 0x4a, 0x54, 0x89, 0x6e, 0xa0, 0xa4, 0x8a, 0x04, 0xaa,
+	0x34, 0xcd, 0xa6, 0x9d, 0xc4, 0x81, 0x1b, 0xd9, 0x2e, 0x48, 0x08, 0xa6, 0x20, 0x2e, 0x5c, 0x2a,
+	0xc7, 0x31, 0xad, 0xd5, 0x24, 0xae, 0xe2, 0xa4, 0x63, 0xff, 0x82, 0xd3, 0xc4, 0x3f, 0x40, 0xda,
+	0x2f, 0xd9, 0x71, 0x47, 0x4e, 0x2d, 0x6a, 0xff, 0x41, 0xf9, 0x03, 0x28, 0xb6, 0x43, 0x37, 0x86,
+	0xd0, 0x90, 0x38, 0xc5, 0xce, 0x7b, 0xef, 0xfb, 0xde, 0xf7, 0xbd, 0x67, 0xd8, 0x16, 0x32, 0x16,
+	0x92, 0x4b, 0xcc, 0x13, 0xca, 0x92, 0x8c, 0x4f, 0x98, 0xc4, 0x29, 0x3b, 0x25, 0x69, 0x28, 0xd1,
+	0x38, 0x15, 0x99, 0xb0, 0x2c, 0x93, 0x81, 0x56, 0x19, 0x7b, 0xcd, 0x81, 0x18, 0x08, 0x15, 0xc6,
+	0xc5, 0x49, 0x67, 0xee, 0xd9, 0x03, 0x21, 0x06, 0x11, 0xc3, 0xea, 0x16, 0xe4, 0x1f, 0x71, 0x98,
+	0xa7, 0x24, 0xe3, 0x22, 0x29, 0xe3, 0x54, 0x41, 0xe1, 0x80, 0x48, 0x86, 0x27, 0xdd, 0x80, 0x65,
+	0xa4, 0x8b, 0xa9, 0xe0, 0x26, 0xee, 0x9e, 0x57, 0xe1, 0x03, 0x5f, 0x71, 0xbf, 0xa4, 0x34, 0x8f,
+	0xf3, 0x88, 0x64, 0x22, 0xb5, 0x9a, 0xb0, 0x1e, 0xb2, 0x44, 0xc4, 0x2d, 0xd0, 0x06, 0x9d, 0x4d,
+	0x5f, 0x5f, 0x2c, 0x1f, 0xde, 0x2b, 0xd1, 0x5b, 0xd5, 0x36, 0xe8, 0x6c, 0xf5, 0x76, 0x91, 0xa6,
+	0x47, 0x25, 0x3d, 0x3a, 0x36, 0x09, 0xde, 0xa3, 0xcb, 0xa9, 0x53, 0x59, 0x4e, 0x9d, 0xfb, 0x67,
+	0x24, 0x8e, 0x5e, 0xb8, 0x65, 0xa1, 0xfb, 0x65, 0xe6, 0x00, 0xff, 0x17, 0x8e, 0x75, 0x0e, 0x60,
+	0x43, 0x6b, 0xef, 0x8f, 0x59, 0xda, 0x97, 0x43, 0x92, 0xb2, 0xd6, 0x5a, 0x7b, 0xad, 0xb3, 0xd5,
+	0x7b, 0x8c, 0x74, 0xef, 0xa8, 0xe8, 0x1d, 0x99, 0xde, 0xd1, 0x31, 0xa3, 0x47, 0x82, 0x27, 0xde,
+	0x1b, 0x83, 0xff, 0x50, 0xe3, 0xff, 0x8e, 0xe1, 0x5e, 0xcc, 0x9c, 0xfd, 0x01, 0xcf, 0x86, 0x79,
+	0x80, 0xa8, 0x88, 0xb1, 0xb1, 0x41, 0x7f, 0x0e, 0x64, 0x38, 0xc2, 0xd9, 0xd9, 0x98, 0xc9, 0x12,
+	0x4e, 0xfa, 0x3b, 0x1a, 0xe1, 0x84, 0xa5, 0xef, 0x54, 0xfd, 0x8f, 0x2a, 0x6c, 0xbe, 0x16, 0x74,
+	0xa4, 0xcd, 0x39, 0x1a, 0x32, 0x3a, 0x1a, 0x0b, 0x9e, 0x64, 0xd6, 0x3e, 0xdc, 0x88, 0x04, 0x1d,
+	0xf5, 0x79, 0xa8, 0xdc, 0xa9, 0x79, 0xd6, 0x72, 0xea, 0xec, 0xe8, 0x2e, 0x4c, 0xc0, 0xf5, 0xd7,
+	0x8b, 0xd3, 0xab, 0xd0, 0x7a, 0x0a, 0xeb, 0xe2, 0x34, 0x61, 0xa9, 0xf2, 0x6b, 0xd3, 0x6b, 0x2c,
+	0xa7, 0xce, 0xb6, 0x4e, 0x55, 0xbf, 0x5d, 0x5f, 0x87, 0x2d, 0x02, 0xeb, 0xc5, 0x50, 0xa4, 0x91,
+	0xbe, 0xfb, 0x47, 0xe9, 0x4a, 0xf7, 0xb3, 0x42, 0xf7, 0xc5, 0xcc, 0xe9, 0xdc, 0x41, 0x9c, 0x56,
+	0xa6, 0x91, 0x6f, 0x4c, 0xaf, 0xf6, 0x9f, 0xa6, 0xf7, 0x16, 0x6e, 0x93, 0xd5, 0xda, 0xc8, 0x56,
+	0x5d, 0x75, 0xff, 0x04, 0xdd, 0x5e, 0x5f, 0x74, 0x6b, 0xc9, 0xbc, 0x5a, 0xc1, 0xe1, 0xdf, 0x00,
+	0x70, 0xbf, 0x02, 0xd8, 0x78, 0x9f, 0xd0, 0x88, 0xf0, 0x98, 0x85, 0xba, 0x44, 0xae, 0x4c, 0x04,
+	0x7f, 0x37, 0x71, 0x04, 0x37, 0xcc, 0x33, 0x6a, 0x55, 0xef, 0xb0, 0x41, 0x87, 0xc6, 0xc9, 0x7f,
+	0x5a, 0x93, 0x92, 0xc1, 0x3b, 0xb9, 0x9c, 0xdb, 0xe0, 0x6a, 0x6e, 0x83, 0xef, 0x73, 0x1b, 0x7c,
+	0x5e, 0xd8, 0x95, 0xab, 0x85, 0x5d, 0xf9, 0xb6, 0xb0, 0x2b, 0x1f, 0x9e, 0x5f, 0xc3, 0x33, 0x46,
+	0x1c, 0x44, 0x24, 0x90, 0xe5, 0x05, 0x4f, 0xba, 0x3d, 0xfc, 0xe9, 0xfa, 0xe3, 0x57, 0x1c, 0xc1,
+	0xba, 0x1a, 0xc3, 0xe1, 0xcf, 0x01, 0x00, 0x61, 0x45, 0x53, 0xb4, 0x1f, 0x04, 0x00, 0x00,
+}
+
+func (m *RewardAccumulator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardAccumulator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardAccumulator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RewardPerShare) > 0 {
+		for iNdEx := len(m.RewardPerShare) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardPerShare[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewards(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintRewards(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintRewards(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LockRewardCheckpoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockRewardCheckpoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockRewardCheckpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Accumulators) > 0 {
+		for iNdEx := len(m.Accumulators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accumulators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewards(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintRewards(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewards(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintRewards(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.LockId != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.LockId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnclaimedRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnclaimedRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnclaimedRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewards(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintRewards(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRewards(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRewards(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RewardAccumulator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovRewards(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovRewards(uint64(l))
+	if len(m.RewardPerShare) > 0 {
+		for _, e := range m.RewardPerShare {
+			l = e.Size()
+			n += 1 + l + sovRewards(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LockRewardCheckpoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LockId != 0 {
+		n += 1 + sovRewards(uint64(m.LockId))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovRewards(uint64(l))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovRewards(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovRewards(uint64(l))
+	if len(m.Accumulators) > 0 {
+		for _, e := range m.Accumulators {
+			l = e.Size()
+			n += 1 + l + sovRewards(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UnclaimedRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovRewards(uint64(l))
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovRewards(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovRewards(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRewards(x uint64) (n int) {
+	return sovRewards(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RewardAccumulator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardAccumulator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardAccumulator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPerShare", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardPerShare = append(m.RewardPerShare, types1.DecCoin{})
+			if err := m.RewardPerShare[len(m.RewardPerShare)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LockRewardCheckpoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockRewardCheckpoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockRewardCheckpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
+			}
+			m.LockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types1.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accumulators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accumulators = append(m.Accumulators, RewardAccumulator{})
+			if err := m.Accumulators[len(m.Accumulators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnclaimedRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnclaimedRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnclaimedRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types1.DecCoin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRewards(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRewards
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRewards
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRewards
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRewards
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRewards        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRewards          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRewards = fmt.Errorf("proto: unexpected end of group")
+)
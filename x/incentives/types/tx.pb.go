@@ -133,6 +133,8 @@ func (m *MsgCreateGauge) GetNumEpochsPaidOver() uint64 {
 }
 
 type MsgCreateGaugeResponse struct {
+	// gauge_id is the ID of the created gauge.
+	GaugeId uint64 `protobuf:"varint,1,opt,name=gauge_id,json=gaugeId,proto3" json:"gauge_id,omitempty"`
 }
 
 func (m *MsgCreateGaugeResponse) Reset()         { *m = MsgCreateGaugeResponse{} }
@@ -168,6 +170,13 @@ func (m *MsgCreateGaugeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCreateGaugeResponse proto.InternalMessageInfo
 
+func (m *MsgCreateGaugeResponse) GetGaugeId() uint64 {
+	if m != nil {
+		return m.GaugeId
+	}
+	return 0
+}
+
 // MsgAddToGauge adds coins to a previously created gauge
 type MsgAddToGauge struct {
 	// owner is the gauge owner's address
@@ -268,55 +277,365 @@ func (m *MsgAddToGaugeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgAddToGaugeResponse proto.InternalMessageInfo
 
+// MsgCancelGauge cancels a gauge that has not finished its distribution yet,
+// refunding the coins it has not distributed to its owner
+type MsgCancelGauge struct {
+	// owner is the gauge owner's address
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	// gauge_id is the ID of the gauge to cancel
+	GaugeId uint64 `protobuf:"varint,2,opt,name=gauge_id,json=gaugeId,proto3" json:"gauge_id,omitempty"`
+}
+
+func (m *MsgCancelGauge) Reset()         { *m = MsgCancelGauge{} }
+func (m *MsgCancelGauge) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelGauge) ProtoMessage()    {}
+func (*MsgCancelGauge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ea120e22291556e, []int{4}
+}
+func (m *MsgCancelGauge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelGauge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelGauge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelGauge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelGauge.Merge(m, src)
+}
+func (m *MsgCancelGauge) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelGauge) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelGauge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelGauge proto.InternalMessageInfo
+
+func (m *MsgCancelGauge) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgCancelGauge) GetGaugeId() uint64 {
+	if m != nil {
+		return m.GaugeId
+	}
+	return 0
+}
+
+type MsgCancelGaugeResponse struct {
+	// refunded_coins are the coins refunded to the owner
+	RefundedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=refunded_coins,json=refundedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"refunded_coins"`
+}
+
+func (m *MsgCancelGaugeResponse) Reset()         { *m = MsgCancelGaugeResponse{} }
+func (m *MsgCancelGaugeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelGaugeResponse) ProtoMessage()    {}
+func (*MsgCancelGaugeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ea120e22291556e, []int{5}
+}
+func (m *MsgCancelGaugeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelGaugeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelGaugeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelGaugeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelGaugeResponse.Merge(m, src)
+}
+func (m *MsgCancelGaugeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelGaugeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelGaugeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelGaugeResponse proto.InternalMessageInfo
+
+func (m *MsgCancelGaugeResponse) GetRefundedCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RefundedCoins
+	}
+	return nil
+}
+
+// MsgWithdrawUndistributed withdraws the coins a finished gauge has not
+// distributed to its owner
+type MsgWithdrawUndistributed struct {
+	// owner is the gauge owner's address
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	// gauge_id is the ID of the finished gauge to withdraw from
+	GaugeId uint64 `protobuf:"varint,2,opt,name=gauge_id,json=gaugeId,proto3" json:"gauge_id,omitempty"`
+}
+
+func (m *MsgWithdrawUndistributed) Reset()         { *m = MsgWithdrawUndistributed{} }
+func (m *MsgWithdrawUndistributed) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawUndistributed) ProtoMessage()    {}
+func (*MsgWithdrawUndistributed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ea120e22291556e, []int{6}
+}
+func (m *MsgWithdrawUndistributed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawUndistributed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawUndistributed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawUndistributed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawUndistributed.Merge(m, src)
+}
+func (m *MsgWithdrawUndistributed) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawUndistributed) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawUndistributed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawUndistributed proto.InternalMessageInfo
+
+func (m *MsgWithdrawUndistributed) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgWithdrawUndistributed) GetGaugeId() uint64 {
+	if m != nil {
+		return m.GaugeId
+	}
+	return 0
+}
+
+type MsgWithdrawUndistributedResponse struct {
+	// withdrawn_coins are the coins withdrawn to the owner
+	WithdrawnCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=withdrawn_coins,json=withdrawnCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"withdrawn_coins"`
+}
+
+func (m *MsgWithdrawUndistributedResponse) Reset()         { *m = MsgWithdrawUndistributedResponse{} }
+func (m *MsgWithdrawUndistributedResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawUndistributedResponse) ProtoMessage()    {}
+func (*MsgWithdrawUndistributedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ea120e22291556e, []int{7}
+}
+func (m *MsgWithdrawUndistributedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawUndistributedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawUndistributedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawUndistributedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawUndistributedResponse.Merge(m, src)
+}
+func (m *MsgWithdrawUndistributedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawUndistributedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawUndistributedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawUndistributedResponse proto.InternalMessageInfo
+
+func (m *MsgWithdrawUndistributedResponse) GetWithdrawnCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.WithdrawnCoins
+	}
+	return nil
+}
+
+// MsgClaimRewards claims the rewards accrued by the locks of the owner
+type MsgClaimRewards struct {
+	// owner is the address of the owner of the locks
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+}
+
+func (m *MsgClaimRewards) Reset()         { *m = MsgClaimRewards{} }
+func (m *MsgClaimRewards) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimRewards) ProtoMessage()    {}
+func (*MsgClaimRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ea120e22291556e, []int{8}
+}
+func (m *MsgClaimRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimRewards.Merge(m, src)
+}
+func (m *MsgClaimRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimRewards proto.InternalMessageInfo
+
+func (m *MsgClaimRewards) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+type MsgClaimRewardsResponse struct {
+	// claimed_coins are the rewards sent to the owner
+	ClaimedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=claimed_coins,json=claimedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"claimed_coins"`
+}
+
+func (m *MsgClaimRewardsResponse) Reset()         { *m = MsgClaimRewardsResponse{} }
+func (m *MsgClaimRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimRewardsResponse) ProtoMessage()    {}
+func (*MsgClaimRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ea120e22291556e, []int{9}
+}
+func (m *MsgClaimRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimRewardsResponse.Merge(m, src)
+}
+func (m *MsgClaimRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimRewardsResponse proto.InternalMessageInfo
+
+func (m *MsgClaimRewardsResponse) GetClaimedCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ClaimedCoins
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MsgCreateGauge)(nil), "osmosis.incentives.MsgCreateGauge")
 	proto.RegisterType((*MsgCreateGaugeResponse)(nil), "osmosis.incentives.MsgCreateGaugeResponse")
 	proto.RegisterType((*MsgAddToGauge)(nil), "osmosis.incentives.MsgAddToGauge")
 	proto.RegisterType((*MsgAddToGaugeResponse)(nil), "osmosis.incentives.MsgAddToGaugeResponse")
+	proto.RegisterType((*MsgCancelGauge)(nil), "osmosis.incentives.MsgCancelGauge")
+	proto.RegisterType((*MsgCancelGaugeResponse)(nil), "osmosis.incentives.MsgCancelGaugeResponse")
+	proto.RegisterType((*MsgWithdrawUndistributed)(nil), "osmosis.incentives.MsgWithdrawUndistributed")
+	proto.RegisterType((*MsgWithdrawUndistributedResponse)(nil), "osmosis.incentives.MsgWithdrawUndistributedResponse")
+	proto.RegisterType((*MsgClaimRewards)(nil), "osmosis.incentives.MsgClaimRewards")
+	proto.RegisterType((*MsgClaimRewardsResponse)(nil), "osmosis.incentives.MsgClaimRewardsResponse")
 }
 
 func init() { proto.RegisterFile("osmosis/incentives/tx.proto", fileDescriptor_8ea120e22291556e) }
 
 var fileDescriptor_8ea120e22291556e = []byte{
-	// 596 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcd, 0x6e, 0xd3, 0x4c,
-	0x14, 0xcd, 0x7c, 0x49, 0xff, 0x26, 0xed, 0xa7, 0x62, 0x15, 0x70, 0x03, 0xb2, 0x53, 0x2f, 0x90,
-	0x41, 0xea, 0x0c, 0x0d, 0x12, 0x0b, 0x76, 0xa4, 0x42, 0xa8, 0x8b, 0x88, 0x60, 0x45, 0x42, 0xaa,
-	0x84, 0xac, 0xb1, 0x3d, 0xb8, 0xa3, 0xc6, 0x1e, 0xcb, 0x33, 0x4e, 0x9b, 0xb7, 0xa8, 0xc4, 0x5b,
-	0xf0, 0x06, 0xec, 0x58, 0x76, 0xd9, 0x25, 0xab, 0x14, 0x25, 0x6f, 0xd0, 0x27, 0x40, 0x1e, 0xdb,
-	0x4d, 0x22, 0x7e, 0xba, 0x61, 0x35, 0x99, 0x39, 0xe7, 0x9e, 0xb9, 0x73, 0xce, 0x8d, 0xe1, 0x23,
-	0x2e, 0x22, 0x2e, 0x98, 0xc0, 0x2c, 0xf6, 0x69, 0x2c, 0xd9, 0x88, 0x0a, 0x2c, 0xcf, 0x51, 0x92,
-	0x72, 0xc9, 0x35, 0xad, 0x04, 0xd1, 0x1c, 0x6c, 0xed, 0x84, 0x3c, 0xe4, 0x0a, 0xc6, 0xf9, 0xaf,
-	0x82, 0xd9, 0x32, 0x43, 0xce, 0xc3, 0x21, 0xc5, 0x6a, 0xe7, 0x65, 0x9f, 0xb0, 0x64, 0x11, 0x15,
-	0x92, 0x44, 0x49, 0x49, 0x30, 0x7c, 0xa5, 0x85, 0x3d, 0x22, 0x28, 0x1e, 0x1d, 0x78, 0x54, 0x92,
-	0x03, 0xec, 0x73, 0x16, 0x57, 0xf8, 0x6f, 0xfa, 0x08, 0x49, 0x16, 0xd2, 0x12, 0xdf, 0xad, 0xf0,
-	0x21, 0xf7, 0x4f, 0xb3, 0x44, 0x2d, 0x05, 0x64, 0x7d, 0xae, 0xc3, 0xff, 0x7b, 0x22, 0x3c, 0x4c,
-	0x29, 0x91, 0xf4, 0x6d, 0x5e, 0xa3, 0xed, 0xc1, 0x4d, 0x26, 0xdc, 0x84, 0xa6, 0x09, 0x95, 0x19,
-	0x19, 0xea, 0xa0, 0x0d, 0xec, 0x75, 0xa7, 0xc9, 0x44, 0xbf, 0x3a, 0xd2, 0x9e, 0xc0, 0x15, 0x7e,
-	0x16, 0xd3, 0x54, 0xff, 0xaf, 0x0d, 0xec, 0x8d, 0xee, 0xf6, 0xcd, 0xc4, 0xdc, 0x1c, 0x93, 0x68,
-	0xf8, 0xca, 0x52, 0xc7, 0x96, 0x53, 0xc0, 0xda, 0x11, 0xdc, 0x0a, 0x98, 0x90, 0x29, 0xf3, 0x32,
-	0x49, 0x5d, 0xc9, 0xf5, 0x7a, 0x1b, 0xd8, 0xcd, 0x8e, 0x81, 0x2a, 0x6f, 0x8a, 0x86, 0xd0, 0xfb,
-	0x8c, 0xa6, 0xe3, 0x43, 0x1e, 0x07, 0x4c, 0x32, 0x1e, 0x77, 0x1b, 0x97, 0x13, 0xb3, 0xe6, 0x6c,
-	0xce, 0x4b, 0x07, 0x5c, 0x23, 0x70, 0x25, 0x7f, 0xb1, 0xd0, 0x1b, 0xed, 0xba, 0xdd, 0xec, 0xec,
-	0xa2, 0xc2, 0x13, 0x94, 0x7b, 0x82, 0x4a, 0x4f, 0xd0, 0x21, 0x67, 0x71, 0xf7, 0x79, 0x5e, 0xfd,
-	0xe5, 0xda, 0xb4, 0x43, 0x26, 0x4f, 0x32, 0x0f, 0xf9, 0x3c, 0xc2, 0xa5, 0x81, 0xc5, 0xb2, 0x2f,
-	0x82, 0x53, 0x2c, 0xc7, 0x09, 0x15, 0xaa, 0x40, 0x38, 0x85, 0xb2, 0xf6, 0x01, 0x42, 0x21, 0x49,
-	0x2a, 0xdd, 0xdc, 0x7f, 0x7d, 0x45, 0xb5, 0xda, 0x42, 0x45, 0x38, 0xa8, 0x0a, 0x07, 0x0d, 0xaa,
-	0x70, 0xba, 0x8f, 0xf3, 0x8b, 0x6e, 0x26, 0xe6, 0x76, 0xf1, 0xf4, 0xdb, 0xd4, 0xac, 0x8b, 0x6b,
-	0x13, 0x38, 0x1b, 0x4a, 0x2b, 0x67, 0x6b, 0x18, 0xee, 0xc4, 0x59, 0xe4, 0xd2, 0x84, 0xfb, 0x27,
-	0xc2, 0x4d, 0x08, 0x0b, 0x5c, 0x3e, 0xa2, 0xa9, 0xbe, 0xda, 0x06, 0x76, 0xc3, 0xb9, 0x17, 0x67,
-	0xd1, 0x1b, 0x05, 0xf5, 0x09, 0x0b, 0xde, 0x8d, 0x68, 0x6a, 0xe9, 0xf0, 0xc1, 0x72, 0x28, 0x0e,
-	0x15, 0x09, 0x8f, 0x05, 0xb5, 0xbe, 0x02, 0xb8, 0xd5, 0x13, 0xe1, 0xeb, 0x20, 0x18, 0xf0, 0x22,
-	0xae, 0xdb, 0x2c, 0xc0, 0xdf, 0xb3, 0xd8, 0x85, 0xeb, 0x6a, 0x26, 0x5c, 0x16, 0xa8, 0xd8, 0x1a,
-	0xce, 0x9a, 0xda, 0x1f, 0x05, 0x1a, 0x85, 0x6b, 0x29, 0x3d, 0x23, 0x69, 0x20, 0xf4, 0xfa, 0xbf,
-	0x77, 0xb7, 0xd2, 0xb6, 0x1e, 0xc2, 0xfb, 0x4b, 0xad, 0x57, 0x8f, 0xea, 0x7c, 0x03, 0xb0, 0xde,
-	0x13, 0xa1, 0xf6, 0x11, 0x36, 0x17, 0x07, 0xd1, 0x42, 0xbf, 0xfe, 0x85, 0xd0, 0xb2, 0x2f, 0xad,
-	0x67, 0x77, 0x73, 0xaa, 0x6b, 0xb4, 0x63, 0x08, 0x17, 0x7c, 0xdb, 0xfb, 0x43, 0xe5, 0x9c, 0xd2,
-	0x7a, 0x7a, 0x27, 0xa5, 0xd2, 0xee, 0xf6, 0x2f, 0xa7, 0x06, 0xb8, 0x9a, 0x1a, 0xe0, 0xc7, 0xd4,
-	0x00, 0x17, 0x33, 0xa3, 0x76, 0x35, 0x33, 0x6a, 0xdf, 0x67, 0x46, 0xed, 0xf8, 0xe5, 0x82, 0x51,
-	0xa5, 0xdc, 0xfe, 0x90, 0x78, 0xa2, 0xda, 0xe0, 0xd1, 0x41, 0x07, 0x9f, 0x2f, 0x7d, 0x42, 0x72,
-	0xf3, 0xbc, 0x55, 0x35, 0x71, 0x2f, 0x7e, 0x06, 0x00, 0x00, 0xff, 0xff, 0x41, 0xff, 0xee, 0x97,
-	0x65, 0x04, 0x00, 0x00,
+	// 774 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xdd, 0x4e, 0xdb, 0x48,
+	0x14, 0x8e, 0x49, 0xf8, 0x9b, 0x24, 0xc0, 0x5a, 0xfc, 0x18, 0xef, 0xca, 0x09, 0x5e, 0x69, 0x95,
+	0x65, 0x17, 0x7b, 0x09, 0xab, 0x95, 0xb6, 0x77, 0x0d, 0xaa, 0x2a, 0x2e, 0xa2, 0x52, 0x97, 0x0a,
+	0x09, 0x09, 0xb9, 0x13, 0x7b, 0x30, 0x23, 0x62, 0x8f, 0xe5, 0x19, 0x27, 0xa0, 0x3e, 0x42, 0x7b,
+	0x81, 0xd4, 0x9b, 0x3e, 0x43, 0xdf, 0xa0, 0x6f, 0xc0, 0x25, 0x97, 0xbd, 0x82, 0x0a, 0xde, 0x80,
+	0x07, 0xa8, 0x2a, 0x8f, 0x7f, 0x92, 0xb4, 0xa4, 0x50, 0x29, 0x5c, 0x99, 0xf1, 0xf9, 0xce, 0x77,
+	0xce, 0x77, 0xbe, 0x33, 0x38, 0xe0, 0x57, 0x42, 0x5d, 0x42, 0x31, 0xd5, 0xb1, 0x67, 0x21, 0x8f,
+	0xe1, 0x0e, 0xa2, 0x3a, 0x3b, 0xd6, 0xfc, 0x80, 0x30, 0x22, 0x8a, 0x49, 0x50, 0xeb, 0x05, 0xe5,
+	0x79, 0x87, 0x38, 0x84, 0x87, 0xf5, 0xe8, 0xaf, 0x18, 0x29, 0x57, 0x1c, 0x42, 0x9c, 0x36, 0xd2,
+	0xf9, 0xa9, 0x15, 0x1e, 0xe8, 0x0c, 0xbb, 0x88, 0x32, 0xe8, 0xfa, 0x09, 0x40, 0xb1, 0x38, 0x97,
+	0xde, 0x82, 0x14, 0xe9, 0x9d, 0xf5, 0x16, 0x62, 0x70, 0x5d, 0xb7, 0x08, 0xf6, 0xd2, 0xf8, 0x2d,
+	0x7d, 0x38, 0x30, 0x74, 0x50, 0x12, 0x5f, 0x4e, 0xe3, 0x6d, 0x62, 0x1d, 0x85, 0x3e, 0x7f, 0xc4,
+	0x21, 0xf5, 0x5d, 0x1e, 0xcc, 0x34, 0xa9, 0xb3, 0x19, 0x20, 0xc8, 0xd0, 0xd3, 0x28, 0x47, 0x5c,
+	0x01, 0x25, 0x4c, 0x4d, 0x1f, 0x05, 0x3e, 0x62, 0x21, 0x6c, 0x4b, 0x42, 0x55, 0xa8, 0x4d, 0x19,
+	0x45, 0x4c, 0xb7, 0xd3, 0x57, 0xe2, 0x1f, 0x60, 0x9c, 0x74, 0x3d, 0x14, 0x48, 0x63, 0x55, 0xa1,
+	0x36, 0xdd, 0x98, 0xbb, 0xb9, 0xa8, 0x94, 0x4e, 0xa0, 0xdb, 0x7e, 0xa4, 0xf2, 0xd7, 0xaa, 0x11,
+	0x87, 0xc5, 0x2d, 0x50, 0xb6, 0x31, 0x65, 0x01, 0x6e, 0x85, 0x0c, 0x99, 0x8c, 0x48, 0xf9, 0xaa,
+	0x50, 0x2b, 0xd6, 0x15, 0x2d, 0x9d, 0x4d, 0xdc, 0x90, 0xf6, 0x3c, 0x44, 0xc1, 0xc9, 0x26, 0xf1,
+	0x6c, 0xcc, 0x30, 0xf1, 0x1a, 0x85, 0xb3, 0x8b, 0x4a, 0xce, 0x28, 0xf5, 0x52, 0x77, 0x88, 0x08,
+	0xc1, 0x78, 0xa4, 0x98, 0x4a, 0x85, 0x6a, 0xbe, 0x56, 0xac, 0x2f, 0x6b, 0xf1, 0x4c, 0xb4, 0x68,
+	0x26, 0x5a, 0x32, 0x13, 0x6d, 0x93, 0x60, 0xaf, 0xf1, 0x4f, 0x94, 0xfd, 0xe1, 0xb2, 0x52, 0x73,
+	0x30, 0x3b, 0x0c, 0x5b, 0x9a, 0x45, 0x5c, 0x3d, 0x19, 0x60, 0xfc, 0x58, 0xa3, 0xf6, 0x91, 0xce,
+	0x4e, 0x7c, 0x44, 0x79, 0x02, 0x35, 0x62, 0x66, 0x71, 0x17, 0x00, 0xca, 0x60, 0xc0, 0xcc, 0x68,
+	0xfe, 0xd2, 0x38, 0x6f, 0x55, 0xd6, 0x62, 0x73, 0xb4, 0xd4, 0x1c, 0x6d, 0x27, 0x35, 0xa7, 0xf1,
+	0x5b, 0x54, 0xe8, 0xe6, 0xa2, 0x32, 0x17, 0x4b, 0xcf, 0x5c, 0x53, 0x4f, 0x2f, 0x2b, 0x82, 0x31,
+	0xcd, 0xb9, 0x22, 0xb4, 0xa8, 0x83, 0x79, 0x2f, 0x74, 0x4d, 0xe4, 0x13, 0xeb, 0x90, 0x9a, 0x3e,
+	0xc4, 0xb6, 0x49, 0x3a, 0x28, 0x90, 0x26, 0xaa, 0x42, 0xad, 0x60, 0xfc, 0xe2, 0x85, 0xee, 0x13,
+	0x1e, 0xda, 0x86, 0xd8, 0x7e, 0xd6, 0x41, 0x81, 0xba, 0x01, 0x16, 0x07, 0x4d, 0x31, 0x10, 0xf5,
+	0x89, 0x47, 0x91, 0xb8, 0x0c, 0xa6, 0xb8, 0xb3, 0x26, 0xb6, 0xb9, 0x31, 0x05, 0x63, 0x92, 0x9f,
+	0xb7, 0x6c, 0xf5, 0xa3, 0x00, 0xca, 0x4d, 0xea, 0x3c, 0xb6, 0xed, 0x1d, 0x12, 0x3b, 0x99, 0xd9,
+	0x24, 0xfc, 0xd8, 0xa6, 0x7e, 0xd2, 0xb1, 0x01, 0x52, 0x11, 0x81, 0xc9, 0x00, 0x75, 0x61, 0x60,
+	0x53, 0x29, 0x3f, 0xfa, 0xc1, 0xa7, 0xdc, 0xea, 0x12, 0x58, 0x18, 0x68, 0x3d, 0xd5, 0xab, 0xbe,
+	0x88, 0xd7, 0x13, 0x7a, 0x16, 0x6a, 0x8f, 0x4a, 0x94, 0xfa, 0x56, 0x00, 0x8b, 0x83, 0xac, 0xd9,
+	0x7c, 0x03, 0x30, 0x13, 0xa0, 0x83, 0xd0, 0xb3, 0x91, 0x6d, 0xc6, 0xfb, 0x26, 0x8c, 0x5e, 0x76,
+	0x39, 0x2d, 0xc1, 0x8f, 0xea, 0x3e, 0x90, 0x9a, 0xd4, 0xd9, 0xc5, 0xec, 0xd0, 0x0e, 0x60, 0xf7,
+	0xa5, 0xd7, 0xdb, 0x7b, 0x7b, 0x14, 0x6a, 0xdf, 0x0b, 0xa0, 0x3a, 0x8c, 0x3f, 0xd3, 0xcd, 0xc0,
+	0x6c, 0x37, 0x01, 0x78, 0x0f, 0x27, 0x7c, 0x26, 0xab, 0x11, 0x2b, 0xff, 0x1f, 0xcc, 0x46, 0x3e,
+	0xb4, 0x21, 0x76, 0x8d, 0x78, 0x13, 0xee, 0x2b, 0x58, 0x7d, 0x23, 0x80, 0xa5, 0x6f, 0x72, 0x33,
+	0x31, 0x3e, 0x28, 0x5b, 0xd1, 0xfb, 0x87, 0xf4, 0xb0, 0x94, 0x54, 0xe0, 0xa7, 0xfa, 0x97, 0x3c,
+	0xc8, 0x37, 0xa9, 0x23, 0xee, 0x83, 0x62, 0xff, 0xbf, 0x52, 0x55, 0xfb, 0xfe, 0x23, 0xa0, 0x0d,
+	0xde, 0x6c, 0x79, 0xf5, 0x6e, 0x4c, 0x26, 0x6c, 0x0f, 0x80, 0xbe, 0xeb, 0xbd, 0x32, 0x24, 0xb3,
+	0x07, 0x91, 0xff, 0xbc, 0x13, 0x92, 0x71, 0x47, 0xad, 0xf7, 0x5d, 0xb3, 0xa1, 0xad, 0xf7, 0x30,
+	0xf2, 0xea, 0xdd, 0x98, 0x8c, 0xfe, 0x35, 0x58, 0xb8, 0x7d, 0xc3, 0xff, 0x1e, 0x42, 0x72, 0x2b,
+	0x5a, 0xfe, 0xf7, 0x67, 0xd0, 0x59, 0xf1, 0x57, 0xa0, 0x34, 0xb0, 0x64, 0xbf, 0x0f, 0x6b, 0xbc,
+	0x0f, 0x24, 0xff, 0x75, 0x0f, 0x50, 0x5a, 0xa1, 0xb1, 0x7d, 0x76, 0xa5, 0x08, 0xe7, 0x57, 0x8a,
+	0xf0, 0xf9, 0x4a, 0x11, 0x4e, 0xaf, 0x95, 0xdc, 0xf9, 0xb5, 0x92, 0xfb, 0x74, 0xad, 0xe4, 0xf6,
+	0xfe, 0xeb, 0x5b, 0xa9, 0x84, 0x70, 0xad, 0x0d, 0x5b, 0x34, 0x3d, 0xe8, 0x9d, 0xf5, 0xba, 0x7e,
+	0x3c, 0xf0, 0x13, 0x22, 0x5a, 0xb3, 0xd6, 0x04, 0xff, 0xe2, 0x6c, 0x7c, 0x1d, 0x00, 0x3e, 0xf4,
+	0xee, 0x29, 0x65, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -333,6 +652,9 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	CreateGauge(ctx context.Context, in *MsgCreateGauge, opts ...grpc.CallOption) (*MsgCreateGaugeResponse, error)
 	AddToGauge(ctx context.Context, in *MsgAddToGauge, opts ...grpc.CallOption) (*MsgAddToGaugeResponse, error)
+	CancelGauge(ctx context.Context, in *MsgCancelGauge, opts ...grpc.CallOption) (*MsgCancelGaugeResponse, error)
+	WithdrawUndistributed(ctx context.Context, in *MsgWithdrawUndistributed, opts ...grpc.CallOption) (*MsgWithdrawUndistributedResponse, error)
+	ClaimRewards(ctx context.Context, in *MsgClaimRewards, opts ...grpc.CallOption) (*MsgClaimRewardsResponse, error)
 }
 
 type msgClient struct {
@@ -361,10 +683,40 @@ func (c *msgClient) AddToGauge(ctx context.Context, in *MsgAddToGauge, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) CancelGauge(ctx context.Context, in *MsgCancelGauge, opts ...grpc.CallOption) (*MsgCancelGaugeResponse, error) {
+	out := new(MsgCancelGaugeResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.incentives.Msg/CancelGauge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawUndistributed(ctx context.Context, in *MsgWithdrawUndistributed, opts ...grpc.CallOption) (*MsgWithdrawUndistributedResponse, error) {
+	out := new(MsgWithdrawUndistributedResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.incentives.Msg/WithdrawUndistributed", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimRewards(ctx context.Context, in *MsgClaimRewards, opts ...grpc.CallOption) (*MsgClaimRewardsResponse, error) {
+	out := new(MsgClaimRewardsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.incentives.Msg/ClaimRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	CreateGauge(context.Context, *MsgCreateGauge) (*MsgCreateGaugeResponse, error)
 	AddToGauge(context.Context, *MsgAddToGauge) (*MsgAddToGaugeResponse, error)
+	CancelGauge(context.Context, *MsgCancelGauge) (*MsgCancelGaugeResponse, error)
+	WithdrawUndistributed(context.Context, *MsgWithdrawUndistributed) (*MsgWithdrawUndistributedResponse, error)
+	ClaimRewards(context.Context, *MsgClaimRewards) (*MsgClaimRewardsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -377,6 +729,15 @@ func (*UnimplementedMsgServer) CreateGauge(ctx context.Context, req *MsgCreateGa
 func (*UnimplementedMsgServer) AddToGauge(ctx context.Context, req *MsgAddToGauge) (*MsgAddToGaugeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddToGauge not implemented")
 }
+func (*UnimplementedMsgServer) CancelGauge(ctx context.Context, req *MsgCancelGauge) (*MsgCancelGaugeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelGauge not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawUndistributed(ctx context.Context, req *MsgWithdrawUndistributed) (*MsgWithdrawUndistributedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawUndistributed not implemented")
+}
+func (*UnimplementedMsgServer) ClaimRewards(ctx context.Context, req *MsgClaimRewards) (*MsgClaimRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimRewards not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -418,6 +779,60 @@ func _Msg_AddToGauge_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CancelGauge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelGauge)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelGauge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.incentives.Msg/CancelGauge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelGauge(ctx, req.(*MsgCancelGauge))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawUndistributed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawUndistributed)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawUndistributed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.incentives.Msg/WithdrawUndistributed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawUndistributed(ctx, req.(*MsgWithdrawUndistributed))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.incentives.Msg/ClaimRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimRewards(ctx, req.(*MsgClaimRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.incentives.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -430,6 +845,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AddToGauge",
 			Handler:    _Msg_AddToGauge_Handler,
 		},
+		{
+			MethodName: "CancelGauge",
+			Handler:    _Msg_CancelGauge_Handler,
+		},
+		{
+			MethodName: "WithdrawUndistributed",
+			Handler:    _Msg_WithdrawUndistributed_Handler,
+		},
+		{
+			MethodName: "ClaimRewards",
+			Handler:    _Msg_ClaimRewards_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/incentives/tx.proto",
@@ -532,6 +959,11 @@ func (m *MsgCreateGaugeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.GaugeId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GaugeId))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -607,7 +1039,218 @@ func (m *MsgAddToGaugeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+func (m *MsgCancelGauge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelGauge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelGauge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GaugeId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GaugeId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelGaugeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelGaugeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelGaugeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RefundedCoins) > 0 {
+		for iNdEx := len(m.RefundedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RefundedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawUndistributed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawUndistributed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawUndistributed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GaugeId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GaugeId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawUndistributedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawUndistributedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawUndistributedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawnCoins) > 0 {
+		for iNdEx := len(m.WithdrawnCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WithdrawnCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClaimedCoins) > 0 {
+		for iNdEx := len(m.ClaimedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClaimedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
 	for v >= 1<<7 {
@@ -653,6 +1296,9 @@ func (m *MsgCreateGaugeResponse) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.GaugeId != 0 {
+		n += 1 + sovTx(uint64(m.GaugeId))
+	}
 	return n
 }
 
@@ -675,25 +1321,336 @@ func (m *MsgAddToGauge) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
-	return n
-}
+	return n
+}
+
+func (m *MsgAddToGaugeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelGauge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GaugeId != 0 {
+		n += 1 + sovTx(uint64(m.GaugeId))
+	}
+	return n
+}
+
+func (m *MsgCancelGaugeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RefundedCoins) > 0 {
+		for _, e := range m.RefundedCoins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgWithdrawUndistributed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GaugeId != 0 {
+		n += 1 + sovTx(uint64(m.GaugeId))
+	}
+	return n
+}
+
+func (m *MsgWithdrawUndistributedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.WithdrawnCoins) > 0 {
+		for _, e := range m.WithdrawnCoins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgClaimRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ClaimedCoins) > 0 {
+		for _, e := range m.ClaimedCoins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateGauge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateGauge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateGauge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsPerpetual", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsPerpetual = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributeTo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DistributeTo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types1.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumEpochsPaidOver", wireType)
+			}
+			m.NumEpochsPaidOver = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumEpochsPaidOver |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgAddToGaugeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgCreateGauge) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateGaugeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -716,17 +1673,17 @@ func (m *MsgCreateGauge) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateGauge: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateGaugeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateGauge: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateGaugeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsPerpetual", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GaugeId", wireType)
 			}
-			var v int
+			m.GaugeId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -736,13 +1693,62 @@ func (m *MsgCreateGauge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.GaugeId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsPerpetual = bool(v != 0)
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddToGauge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddToGauge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddToGauge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
@@ -774,11 +1780,11 @@ func (m *MsgCreateGauge) Unmarshal(dAtA []byte) error {
 			}
 			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DistributeTo", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GaugeId", wireType)
 			}
-			var msglen int
+			m.GaugeId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -788,28 +1794,14 @@ func (m *MsgCreateGauge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.GaugeId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.DistributeTo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -833,19 +1825,119 @@ func (m *MsgCreateGauge) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types1.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddToGaugeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddToGaugeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddToGaugeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelGauge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Coins = append(m.Coins, types1.Coin{})
-			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 5:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelGauge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelGauge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -855,30 +1947,29 @@ func (m *MsgCreateGauge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumEpochsPaidOver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GaugeId", wireType)
 			}
-			m.NumEpochsPaidOver = 0
+			m.GaugeId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -888,7 +1979,7 @@ func (m *MsgCreateGauge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NumEpochsPaidOver |= uint64(b&0x7F) << shift
+				m.GaugeId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -914,7 +2005,7 @@ func (m *MsgCreateGauge) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateGaugeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelGaugeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -937,12 +2028,46 @@ func (m *MsgCreateGaugeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateGaugeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelGaugeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateGaugeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelGaugeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundedCoins = append(m.RefundedCoins, types1.Coin{})
+			if err := m.RefundedCoins[len(m.RefundedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -964,7 +2089,7 @@ func (m *MsgCreateGaugeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddToGauge) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawUndistributed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -987,10 +2112,10 @@ func (m *MsgAddToGauge) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddToGauge: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawUndistributed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddToGauge: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawUndistributed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1044,9 +2169,59 @@ func (m *MsgAddToGauge) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawUndistributedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawUndistributedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawUndistributedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawnCoins", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1073,8 +2248,8 @@ func (m *MsgAddToGauge) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Rewards = append(m.Rewards, types1.Coin{})
-			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.WithdrawnCoins = append(m.WithdrawnCoins, types1.Coin{})
+			if err := m.WithdrawnCoins[len(m.WithdrawnCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1099,7 +2274,7 @@ func (m *MsgAddToGauge) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddToGaugeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimRewards) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1122,12 +2297,128 @@ func (m *MsgAddToGaugeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddToGaugeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimRewards: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddToGaugeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimedCoins = append(m.ClaimedCoins, types1.Coin{})
+			if err := m.ClaimedCoins[len(m.ClaimedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
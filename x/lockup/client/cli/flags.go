@@ -9,6 +9,8 @@ const (
 	FlagDuration    = "duration"
 	FlagMinDuration = "min-duration"
 	FlagAmount      = "amount"
+
+	FlagAllowSyntheticLockups = "allow-synthetic-lockups"
 )
 
 // FlagSetLockTokens returns flags for LockTokens msg builder.
@@ -32,3 +34,11 @@ func FlagSetMinDuration() *flag.FlagSet {
 	fs.String(FlagMinDuration, "336h", "The minimum duration of token bonded. e.g. 24h, 168h, 336h")
 	return fs
 }
+
+// FlagSetTransferLock returns flags for TransferLock msg builder.
+func FlagSetTransferLock() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.Bool(FlagAllowSyntheticLockups, false, "Allow the transfer of a lock with synthetic lockups, such as a superfluid delegated lock")
+	return fs
+}
@@ -35,6 +35,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdModuleLockedAmount(),
 		GetCmdAccountUnlockableCoins(),
 		GetCmdAccountUnlockingCoins(),
+		GetCmdAccountUnlockSchedule(),
 		GetCmdAccountLockedCoins(),
 		GetCmdAccountLockedPastTime(),
 		GetCmdAccountLockedPastTimeNotUnlockingOnly(),
@@ -48,6 +49,8 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdOutputLocksJson(),
 		GetCmdSyntheticLockupsByLockupID(),
 		GetCmdAccountLockedDuration(),
+		GetCmdParams(),
+		GetCmdEstimateEarlyUnlock(),
 	)
 
 	return cmd
@@ -202,6 +205,43 @@ $ %s query lockup account-unlocking-coins <address>
 	return cmd
 }
 
+// GetCmdAccountUnlockSchedule returns unlocking coins of a specific account grouped by unlock time.
+func GetCmdAccountUnlockSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "account-unlock-schedule <address>",
+		Short: "Query account's unlocking coins grouped by unlock time",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query account's unlocking coins grouped by unlock time.
+
+Example:
+$ %s query lockup account-unlock-schedule <address>
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AccountUnlockSchedule(cmd.Context(), &types.AccountUnlockScheduleRequest{Owner: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdAccountLockedCoins returns locked coins that can't be withdrawn of a specific account.
 func GetCmdAccountLockedCoins() *cobra.Command {
 	cmd := &cobra.Command{
@@ -457,6 +497,85 @@ $ %s query lockup lock-by-id <id>
 	return cmd
 }
 
+// GetCmdParams returns the params of the lockup module.
+func GetCmdParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the current lockup parameters",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the current lockup parameters.
+
+Example:
+$ %s query lockup params
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdEstimateEarlyUnlock returns the coins released and the penalty taken when early unlocking a lock.
+func GetCmdEstimateEarlyUnlock() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimate-early-unlock <id>",
+		Short: "Query the coins released and the penalty taken when early unlocking a lock",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the coins released and the penalty taken when early unlocking a lock by id.
+
+Example:
+$ %s query lockup estimate-early-unlock <id>
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EstimateEarlyUnlock(cmd.Context(), &types.EstimateEarlyUnlockRequest{LockId: id})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdSyntheticLockupsByLockupID returns synthetic lockups by lockup id.
 func GetCmdSyntheticLockupsByLockupID() *cobra.Command {
 	cmd := &cobra.Command{
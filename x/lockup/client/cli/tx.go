@@ -29,6 +29,9 @@ func GetTxCmd() *cobra.Command {
 		NewLockTokensCmd(),
 		NewBeginUnlockingCmd(),
 		NewBeginUnlockByIDCmd(),
+		NewTransferLockCmd(),
+		NewMergeLocksCmd(),
+		NewEarlyUnlockCmd(),
 	)
 
 	return cmd
@@ -154,3 +157,135 @@ func NewBeginUnlockByIDCmd() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// NewTransferLockCmd transfers the ownership of a lock to another account.
+func NewTransferLockCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-lock [id] [new_owner]",
+		Short: "transfer the ownership of a period lock by ID to another account",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			newOwner, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			allowSyntheticLockups, err := cmd.Flags().GetBool(FlagAllowSyntheticLockups)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTransferLock(
+				clientCtx.GetFromAddress(),
+				id,
+				newOwner,
+				allowSyntheticLockups,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetTransferLock())
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewMergeLocksCmd merges a period lock into another one with the same denom and duration.
+func NewMergeLocksCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "merge-locks [from_id] [to_id]",
+		Short: "merge the period lock with ID from_id into the period lock with ID to_id",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			fromID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			toID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgMergeLocks(
+				clientCtx.GetFromAddress(),
+				fromID,
+				toID,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewEarlyUnlockCmd unlocks a period lock by ID before it matures, for a penalty.
+func NewEarlyUnlockCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "early-unlock [id]",
+		Short: "unlock individual period lock by ID before it matures, for a penalty scaled by its remaining duration",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			coins := sdk.Coins(nil)
+			amountStr, err := cmd.Flags().GetString(FlagAmount)
+			if err != nil {
+				return err
+			}
+
+			if amountStr != "" {
+				coins, err = sdk.ParseCoinsNormalized(amountStr)
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgEarlyUnlock(
+				clientCtx.GetFromAddress(),
+				id,
+				coins,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetUnlockTokens())
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
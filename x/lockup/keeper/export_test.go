@@ -25,3 +25,8 @@ func (k Keeper) GetCoinsFromLocks(locks []types.PeriodLock) sdk.Coins {
 func (k Keeper) Lock(ctx sdk.Context, lock types.PeriodLock, tokensToLock sdk.Coins) error {
 	return k.lock(ctx, lock, tokensToLock)
 }
+
+// AddHooksForTest adds lockup hooks after the ones already set, which SetHooks does not allow.
+func (k *Keeper) AddHooksForTest(lh types.LockupHooks) {
+	k.hooks = types.NewMultiLockupHooks(k.hooks, lh)
+}
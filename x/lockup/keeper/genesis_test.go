@@ -45,6 +45,7 @@ var (
 				Coins:    sdk.Coins{sdk.NewInt64Coin("foo", 5000000)},
 			},
 		},
+		Params: types.NewParams(true, sdk.NewDecWithPrec(5, 1), true),
 	}
 )
 
@@ -64,6 +65,8 @@ func TestInitGenesis(t *testing.T) {
 	lastLockId := app.LockupKeeper.GetLastLockID(ctx)
 	require.Equal(t, lastLockId, uint64(10))
 
+	require.Equal(t, testGenesis.Params, app.LockupKeeper.GetParams(ctx))
+
 	acc := app.LockupKeeper.GetPeriodLocksAccumulation(ctx, types.QueryCondition{
 		Denom:    "foo",
 		Duration: time.Second,
@@ -88,6 +91,7 @@ func TestExportGenesis(t *testing.T) {
 
 	genesisExported := app.LockupKeeper.ExportGenesis(ctx)
 	require.Equal(t, genesisExported.LastLockId, uint64(11))
+	require.Equal(t, testGenesis.Params, genesisExported.Params)
 	require.Equal(t, genesisExported.Locks, []types.PeriodLock{
 		{
 			ID:       1,
@@ -285,3 +285,48 @@ func (q Querier) LockedDenom(goCtx context.Context, req *types.LockedDenomReques
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	return &types.LockedDenomResponse{Amount: q.Keeper.GetLockedDenom(ctx, req.Denom, req.Duration)}, nil
 }
+
+// AccountUnlockSchedule returns the unlocking coins of an account grouped by unlock time.
+func (q Querier) AccountUnlockSchedule(goCtx context.Context, req *types.AccountUnlockScheduleRequest) (*types.AccountUnlockScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if len(req.Owner) == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty owner")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.AccountUnlockScheduleResponse{Schedule: q.Keeper.GetAccountUnlockSchedule(ctx, owner)}, nil
+}
+
+// Params returns lockup params.
+func (q Querier) Params(goCtx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.QueryParamsResponse{Params: q.Keeper.GetParams(ctx)}, nil
+}
+
+// EstimateEarlyUnlock returns the coins released and the penalty taken when early unlocking the lock.
+func (q Querier) EstimateEarlyUnlock(goCtx context.Context, req *types.EstimateEarlyUnlockRequest) (*types.EstimateEarlyUnlockResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	lock, err := q.Keeper.GetLockByID(ctx, req.LockId)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, penalty := q.Keeper.EstimateEarlyUnlock(ctx, *lock, lock.Coins)
+	return &types.EstimateEarlyUnlockResponse{
+		Amount:      amount,
+		Penalty:     penalty,
+		PenaltyRate: q.Keeper.EarlyUnlockPenaltyRate(ctx, *lock),
+	}, nil
+}
@@ -156,6 +156,59 @@ func (suite *KeeperTestSuite) TestAccountUnlockingCoins() {
 	suite.Require().Equal(res.Coins, sdk.Coins{})
 }
 
+func (suite *KeeperTestSuite) TestAccountUnlockSchedule() {
+	suite.SetupTest()
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+
+	// empty address unlock schedule check
+	_, err := suite.querier.AccountUnlockSchedule(sdk.WrapSDKContext(suite.Ctx), &types.AccountUnlockScheduleRequest{Owner: ""})
+	suite.Require().Error(err)
+
+	// initial check
+	res, err := suite.querier.AccountUnlockSchedule(sdk.WrapSDKContext(suite.Ctx), &types.AccountUnlockScheduleRequest{Owner: addr1.String()})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Schedule, 0)
+
+	// lock coins for 2 seconds twice and for 1 second, then begin unlocking them
+	coins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+	suite.LockTokens(addr1, coins, 2*time.Second)
+	suite.LockTokens(addr1, sdk.Coins{sdk.NewInt64Coin("foo", 5)}, 2*time.Second)
+	suite.LockTokens(addr1, coins, time.Second)
+	suite.BeginUnlocking(addr1)
+
+	// locks not unlocking yet are not scheduled
+	suite.LockTokens(addr1, coins, time.Second)
+
+	// check the schedule is grouped by unlock time, in ascending order
+	now := suite.Ctx.BlockTime()
+	res, err = suite.querier.AccountUnlockSchedule(sdk.WrapSDKContext(suite.Ctx), &types.AccountUnlockScheduleRequest{Owner: addr1.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.UnlockScheduleEntry{
+		{
+			EndTime: now.Add(time.Second),
+			Coins:   coins,
+			LockIds: []uint64{3},
+		},
+		{
+			EndTime: now.Add(2 * time.Second),
+			Coins:   sdk.Coins{sdk.NewInt64Coin("foo", 5), sdk.NewInt64Coin("stake", 10)},
+			LockIds: []uint64{1, 2},
+		},
+	}, res.Schedule)
+
+	// check matured locks are scheduled until withdrawn
+	suite.Ctx = suite.Ctx.WithBlockTime(now.Add(time.Second))
+	res, err = suite.querier.AccountUnlockSchedule(sdk.WrapSDKContext(suite.Ctx), &types.AccountUnlockScheduleRequest{Owner: addr1.String()})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Schedule, 2)
+
+	suite.WithdrawAllMaturedLocks()
+	res, err = suite.querier.AccountUnlockSchedule(sdk.WrapSDKContext(suite.Ctx), &types.AccountUnlockScheduleRequest{Owner: addr1.String()})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Schedule, 1)
+	suite.Require().Equal([]uint64{1, 2}, res.Schedule[0].LockIds)
+}
+
 func (suite *KeeperTestSuite) TestAccountLockedCoins() {
 	suite.SetupTest()
 	addr1 := sdk.AccAddress([]byte("addr1---------------"))
@@ -361,6 +414,26 @@ func (suite *KeeperTestSuite) TestLockedByID() {
 	suite.Require().Equal(res.Lock.IsUnlocking(), false)
 }
 
+func (suite *KeeperTestSuite) TestEstimateEarlyUnlockQuery() {
+	suite.SetupTest()
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+
+	// estimate of not available id check
+	_, err := suite.querier.EstimateEarlyUnlock(sdk.WrapSDKContext(suite.Ctx), &types.EstimateEarlyUnlockRequest{LockId: 1})
+	suite.Require().Error(err)
+
+	// lock coins
+	coins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+	suite.LockTokens(addr1, coins, time.Second)
+
+	// estimate of available id check
+	res, err := suite.querier.EstimateEarlyUnlock(sdk.WrapSDKContext(suite.Ctx), &types.EstimateEarlyUnlockRequest{LockId: 1})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 7)}, res.Amount)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 3)}, res.Penalty)
+	suite.Require().Equal(sdk.NewDecWithPrec(25, 2), res.PenaltyRate)
+}
+
 func (suite *KeeperTestSuite) TestAccountLockedLongerDuration() {
 	suite.SetupTest()
 	addr1 := sdk.AccAddress([]byte("addr1---------------"))
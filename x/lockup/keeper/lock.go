@@ -11,13 +11,35 @@ import (
 	"github.com/gogo/protobuf/proto"
 
 	"github.com/osmosis-labs/osmosis/v12/store"
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/utils"
 	"github.com/osmosis-labs/osmosis/v12/x/lockup/types"
 )
 
 // WithdrawAllMaturedLocks withdraws every lock thats in the process of unlocking, and has finished unlocking by
-// the current block time.
+// the current block time. Emits the matured and withdrawn events of each lock, and calls the AfterLockMatured hook.
 func (k Keeper) WithdrawAllMaturedLocks(ctx sdk.Context) {
-	k.unlockFromIterator(ctx, k.LockIteratorBeforeTime(ctx, ctx.BlockTime()))
+	locks, _ := k.unlockFromIterator(ctx, k.LockIteratorBeforeTime(ctx, ctx.BlockTime()))
+	for _, lock := range locks {
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.TypeEvtLockMatured,
+				sdk.NewAttribute(types.AttributePeriodLockID, utils.Uint64ToString(lock.ID)),
+				sdk.NewAttribute(types.AttributePeriodLockOwner, lock.Owner),
+				sdk.NewAttribute(types.AttributePeriodLockDuration, lock.Duration.String()),
+				sdk.NewAttribute(types.AttributePeriodLockUnlockTime, lock.EndTime.String()),
+			),
+			sdk.NewEvent(
+				types.TypeEvtLockWithdrawn,
+				sdk.NewAttribute(types.AttributePeriodLockID, utils.Uint64ToString(lock.ID)),
+				sdk.NewAttribute(types.AttributePeriodLockOwner, lock.Owner),
+				sdk.NewAttribute(types.AttributeUnlockedCoins, lock.Coins.String()),
+			),
+		})
+
+		if k.hooks != nil {
+			k.hooks.AfterLockMatured(ctx, lock.OwnerAddress(), lock.ID, lock.Coins, lock.Duration, lock.EndTime)
+		}
+	}
 }
 
 // GetModuleBalance returns full balance of the module.
@@ -94,6 +116,7 @@ func (k Keeper) AddTokensToLockByID(ctx sdk.Context, lockID uint64, owner sdk.Ac
 	if err != nil {
 		return nil, err
 	}
+	k.hooks.OnTokenLocked(ctx, owner, lock.ID, lock.Coins, lock.Duration, lock.EndTime)
 
 	for _, synthlock := range k.GetAllSyntheticLockupsByLockup(ctx, lock.ID) {
 		k.accumulationStore(ctx, synthlock.SynthDenom).Increase(accumulationKey(synthlock.Duration), tokensToAdd.Amount)
@@ -128,10 +151,12 @@ func (k Keeper) CreateLock(ctx sdk.Context, owner sdk.AccAddress, coins sdk.Coin
 	}
 
 	k.SetLastLockID(ctx, lock.ID)
+	k.hooks.OnTokenLocked(ctx, owner, lock.ID, lock.Coins, lock.Duration, lock.EndTime)
 	return lock, nil
 }
 
 // lock is an internal utility to lock coins and set corresponding states.
+// The callers call the OnTokenLocked hook once the lock is fully stored, along with its lock refs.
 // This is only called by either of the two possible entry points to lock tokens.
 // 1. CreateLock
 // 2. AddTokensToLockByID
@@ -154,8 +179,6 @@ func (k Keeper) lock(ctx sdk.Context, lock types.PeriodLock, tokensToLock sdk.Co
 	for _, coin := range tokensToLock {
 		k.accumulationStore(ctx, coin.Denom).Increase(accumulationKey(lock.Duration), coin.Amount)
 	}
-
-	k.hooks.OnTokenLocked(ctx, owner, lock.ID, lock.Coins, lock.Duration, lock.EndTime)
 	return nil
 }
 
@@ -430,6 +453,250 @@ func (k Keeper) ExtendLockup(ctx sdk.Context, lockID uint64, owner sdk.AccAddres
 	return nil
 }
 
+// TransferLock transfers the ownership of a lock to a new owner.
+// Transferring a lock would fail on either of the following conditions.
+// 1. Only lock owner is able to transfer the lock, to a different account.
+// 2. Locks cannot be transferred to accounts that are not allowed to receive funds, such as module accounts,
+// as the coins of the lock could not be sent to them once unlocked.
+// 3. Locks that are unlocking are not allowed to be transferred.
+// 4. Locks that have synthetic lockup are only transferred if allowSyntheticLockups is set.
+func (k Keeper) TransferLock(ctx sdk.Context, lockID uint64, owner, newOwner sdk.AccAddress, allowSyntheticLockups bool) error {
+	lock, err := k.GetLockByID(ctx, lockID)
+	if err != nil {
+		return err
+	}
+
+	if lock.GetOwner() != owner.String() {
+		return types.ErrNotLockOwner
+	}
+
+	if owner.Equals(newOwner) {
+		return fmt.Errorf("cannot transfer lock %d to its owner", lock.ID)
+	}
+
+	if k.bk.BlockedAddr(newOwner) {
+		return fmt.Errorf("cannot transfer lock %d to %s, which is not allowed to receive funds", lock.ID, newOwner)
+	}
+
+	if lock.IsUnlocking() {
+		return fmt.Errorf("cannot transfer lock %d, which is unlocking", lock.ID)
+	}
+
+	synthLocks := k.GetAllSyntheticLockupsByLockup(ctx, lock.ID)
+	if len(synthLocks) > 0 && !allowSyntheticLockups {
+		return fmt.Errorf("cannot transfer lock %d with synthetic lockup unless explicitly allowed", lock.ID)
+	}
+
+	// lock refs are keyed by owner, so they are replaced by the ones of the new owner.
+	// The accumulation store does not depend on the owner and is left unchanged.
+	err = k.deleteLockRefs(ctx, unlockingPrefix(lock.IsUnlocking()), *lock)
+	if err != nil {
+		return err
+	}
+	for _, synthLock := range synthLocks {
+		err = k.deleteSyntheticLockRefs(ctx, *lock, synthLock)
+		if err != nil {
+			return err
+		}
+	}
+
+	lock.Owner = newOwner.String()
+	err = k.setLockAndAddLockRefs(ctx, *lock)
+	if err != nil {
+		return err
+	}
+	for _, synthLock := range synthLocks {
+		err = k.addSyntheticLockRefs(ctx, *lock, synthLock)
+		if err != nil {
+			return err
+		}
+	}
+
+	if k.hooks != nil {
+		k.hooks.OnLockTransfer(ctx, lock.ID, owner, newOwner)
+	}
+	return nil
+}
+
+// MergeLocks merges the lock with ID fromLockID into the lock with ID toLockID, and returns the merged lock.
+// Merging locks would fail on either of the following conditions.
+// 1. Only the owner of both locks is able to merge them.
+// 2. Both locks should lock the same denoms for the same duration.
+// 3. Locks that are unlocking are not allowed to be merged.
+// 4. Locks that have synthetic lockup are not allowed to be merged.
+func (k Keeper) MergeLocks(ctx sdk.Context, owner sdk.AccAddress, fromLockID, toLockID uint64) (*types.PeriodLock, error) {
+	if fromLockID == toLockID {
+		return nil, fmt.Errorf("cannot merge lock %d into itself", fromLockID)
+	}
+
+	fromLock, err := k.GetLockByID(ctx, fromLockID)
+	if err != nil {
+		return nil, err
+	}
+	toLock, err := k.GetLockByID(ctx, toLockID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, lock := range []*types.PeriodLock{fromLock, toLock} {
+		if lock.GetOwner() != owner.String() {
+			return nil, types.ErrNotLockOwner
+		}
+		if lock.IsUnlocking() {
+			return nil, fmt.Errorf("cannot merge unlocking lock %d", lock.ID)
+		}
+		if k.HasAnySyntheticLockups(ctx, lock.ID) {
+			return nil, fmt.Errorf("cannot merge lock %d with synthetic lockup", lock.ID)
+		}
+	}
+
+	if fromLock.Duration != toLock.Duration {
+		return nil, fmt.Errorf("cannot merge locks with different durations %s and %s", fromLock.Duration, toLock.Duration)
+	}
+	if !fromLock.Coins.DenomsSubsetOf(toLock.Coins) || !toLock.Coins.DenomsSubsetOf(fromLock.Coins) {
+		return nil, fmt.Errorf("cannot merge locks of different denoms %s and %s", fromLock.Coins, toLock.Coins)
+	}
+
+	// both locks have the same owner, denoms and duration, so the lock refs of the merged lock and
+	// the accumulation store are unchanged, and only the lock refs of the removed lock are deleted.
+	err = k.deleteLockRefs(ctx, unlockingPrefix(fromLock.IsUnlocking()), *fromLock)
+	if err != nil {
+		return nil, err
+	}
+	k.deleteLock(ctx, fromLock.ID)
+
+	toLock.Coins = toLock.Coins.Add(fromLock.Coins...)
+	err = k.setLock(ctx, *toLock)
+	if err != nil {
+		return nil, err
+	}
+
+	if k.hooks != nil {
+		k.hooks.OnLocksMerge(ctx, fromLock.ID, toLock.ID, fromLock.Coins)
+	}
+	return toLock, nil
+}
+
+// EarlyUnlockPenaltyRate returns the fraction of the coins of the lock taken as a penalty when it is
+// unlocked early. The early unlock penalty of the params is scaled by the remaining duration of the lock:
+// its full duration if it is not unlocking, or the time left until its end time if it is.
+func (k Keeper) EarlyUnlockPenaltyRate(ctx sdk.Context, lock types.PeriodLock) sdk.Dec {
+	remaining := lock.Duration
+	if lock.IsUnlocking() {
+		remaining = lock.EndTime.Sub(ctx.BlockTime())
+	}
+	if remaining <= 0 || lock.Duration <= 0 {
+		return sdk.ZeroDec()
+	}
+	if remaining > lock.Duration {
+		remaining = lock.Duration
+	}
+
+	penalty := k.GetParams(ctx).EarlyUnlockPenalty
+	return penalty.MulInt64(int64(remaining)).QuoInt64(int64(lock.Duration))
+}
+
+// EstimateEarlyUnlock returns the coins released to the owner and the coins taken as a penalty
+// when the given coins of the lock are unlocked early. The penalty is rounded up.
+func (k Keeper) EstimateEarlyUnlock(ctx sdk.Context, lock types.PeriodLock, coins sdk.Coins) (amount, penalty sdk.Coins) {
+	rate := k.EarlyUnlockPenaltyRate(ctx, lock)
+	penalty = sdk.Coins{}
+	for _, coin := range coins {
+		penaltyAmt := coin.Amount.ToDec().Mul(rate).Ceil().TruncateInt()
+		if penaltyAmt.IsPositive() {
+			penalty = penalty.Add(sdk.NewCoin(coin.Denom, penaltyAmt))
+		}
+	}
+	return coins.Sub(penalty), penalty
+}
+
+// EarlyUnlock immediately unlocks the given coins of a lock before it matures, and returns the coins
+// released to the owner and the coins taken as a penalty. The penalty is burned or sent to the
+// community pool depending on the params. Unlocks the entire lock if coins is empty.
+// Early unlocking would fail on either of the following conditions.
+// 1. Early unlocks are disabled by the params.
+// 2. Only lock owner is able to early unlock the lock.
+// 3. Locks that have synthetic lockup are not allowed to be unlocked early.
+// 4. Locks that are unlocking are only allowed to be unlocked early entirely.
+func (k Keeper) EarlyUnlock(ctx sdk.Context, lockID uint64, owner sdk.AccAddress, coins sdk.Coins) (amount, penalty sdk.Coins, err error) {
+	params := k.GetParams(ctx)
+	if !params.EarlyUnlockEnabled {
+		return nil, nil, types.ErrEarlyUnlockDisabled
+	}
+
+	lock, err := k.GetLockByID(ctx, lockID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if lock.GetOwner() != owner.String() {
+		return nil, nil, types.ErrNotLockOwner
+	}
+
+	if k.HasAnySyntheticLockups(ctx, lock.ID) {
+		return nil, nil, fmt.Errorf("cannot early unlock lock %d with synthetic lockup", lock.ID)
+	}
+
+	if len(coins) == 0 {
+		coins = lock.Coins
+	}
+	if !coins.IsAllLTE(lock.Coins) {
+		return nil, nil, fmt.Errorf("requested amount to unlock exceeds locked tokens")
+	}
+
+	// the penalty depends on the end time of the lock, so it is computed before unlocking begins.
+	amount, penalty = k.EstimateEarlyUnlock(ctx, *lock, coins)
+
+	// split the coins to unlock off into a new lock, which is then unlocked entirely.
+	if !coins.IsEqual(lock.Coins) {
+		if lock.IsUnlocking() {
+			return nil, nil, fmt.Errorf("cannot partially early unlock unlocking lock %d", lock.ID)
+		}
+		splitLock, err := k.splitLock(ctx, *lock, coins)
+		if err != nil {
+			return nil, nil, err
+		}
+		err = k.addLockRefs(ctx, splitLock)
+		if err != nil {
+			return nil, nil, err
+		}
+		lock = &splitLock
+	}
+
+	if !lock.IsUnlocking() {
+		err = k.beginUnlock(ctx, *lock, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		lock, err = k.GetLockByID(ctx, lock.ID)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	// the penalty is taken out of the lock, so only the rest of the coins are released to the owner.
+	if !penalty.Empty() {
+		if params.BurnEarlyUnlockPenalty {
+			err = k.bk.BurnCoins(ctx, types.ModuleName, penalty)
+		} else {
+			err = k.ck.FundCommunityPool(ctx, penalty, k.ak.GetModuleAddress(types.ModuleName))
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		err = k.removeTokensFromLock(ctx, lock, penalty)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	err = k.unlockMaturedLockInternalLogic(ctx, *lock)
+	if err != nil {
+		return nil, nil, err
+	}
+	return amount, penalty, nil
+}
+
 // InitializeAllLocks takes a set of locks, and initializes state to be storing
 // them all correctly. This utilizes batch optimizations to improve efficiency,
 // as this becomes a bottleneck at chain initialization & upgrades.
@@ -657,6 +924,50 @@ func (k Keeper) deleteLock(ctx sdk.Context, id uint64) {
 	store.Delete(lockStoreKey(id))
 }
 
+// SplitLock splits the given coins off a lock that is not unlocking, into a new lock with the same owner
+// and duration, and returns the new lock. The synthetic lockups of the lock are split along with it:
+// the new lock gets a copy of every synthetic lockup of the lock, accumulating the split coins.
+// This method should be called by the superfluid module ONLY, which keeps track of the synthetic
+// lockups it creates.
+func (k Keeper) SplitLock(ctx sdk.Context, lockID uint64, coins sdk.Coins) (types.PeriodLock, error) {
+	lock, err := k.GetLockByID(ctx, lockID)
+	if err != nil {
+		return types.PeriodLock{}, err
+	}
+	if coins.Empty() || !coins.IsAllLTE(lock.Coins) || coins.IsEqual(lock.Coins) {
+		return types.PeriodLock{}, fmt.Errorf("split amount %s must be a part of the locked tokens %s", coins, lock.Coins)
+	}
+
+	splitLock, err := k.splitLock(ctx, *lock, coins)
+	if err != nil {
+		return types.PeriodLock{}, err
+	}
+	err = k.addLockRefs(ctx, splitLock)
+	if err != nil {
+		return types.PeriodLock{}, err
+	}
+
+	// the split coins move from the synthetic lockups of the lock to the ones of the split lock.
+	for _, synthLock := range k.GetAllSyntheticLockupsByLockup(ctx, lock.ID) {
+		splitSynthLock := types.SyntheticLock{
+			UnderlyingLockId: splitLock.ID,
+			SynthDenom:       synthLock.SynthDenom,
+			EndTime:          synthLock.EndTime,
+			Duration:         synthLock.Duration,
+		}
+		err = k.setSyntheticLockupObject(ctx, &splitSynthLock)
+		if err != nil {
+			return types.PeriodLock{}, err
+		}
+		err = k.addSyntheticLockRefs(ctx, splitLock, splitSynthLock)
+		if err != nil {
+			return types.PeriodLock{}, err
+		}
+	}
+
+	return splitLock, nil
+}
+
 // splitLock splits a lock with the given amount, and stores split new lock to the state.
 func (k Keeper) splitLock(ctx sdk.Context, lock types.PeriodLock, coins sdk.Coins) (types.PeriodLock, error) {
 	if lock.IsUnlocking() {
@@ -673,7 +984,14 @@ func (k Keeper) splitLock(ctx sdk.Context, lock types.PeriodLock, coins sdk.Coin
 
 	splitLock := types.NewPeriodLock(splitLockID, lock.OwnerAddress(), lock.Duration, lock.EndTime, coins)
 	err = k.setLock(ctx, splitLock)
-	return splitLock, err
+	if err != nil {
+		return types.PeriodLock{}, err
+	}
+
+	if k.hooks != nil {
+		k.hooks.OnLockSplit(ctx, lock.ID, splitLock.ID, coins)
+	}
+	return splitLock, nil
 }
 
 func (k Keeper) getCoinsFromLocks(locks []types.PeriodLock) sdk.Coins {
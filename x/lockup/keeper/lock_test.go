@@ -7,6 +7,8 @@ import (
 	"github.com/osmosis-labs/osmosis/v12/x/lockup/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
 
 func (suite *KeeperTestSuite) TestBeginUnlocking() { // test for all unlockable coins
@@ -716,6 +718,45 @@ func (suite *KeeperTestSuite) TestEndblockerWithdrawAllMaturedLockups() {
 	suite.Require().Len(locks, 0)
 }
 
+// maturedLocksRecorder records the locks passed to the AfterLockMatured hook.
+type maturedLocksRecorder struct {
+	types.MultiLockupHooks
+	lockIDs []uint64
+}
+
+func (h *maturedLocksRecorder) AfterLockMatured(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time) {
+	h.lockIDs = append(h.lockIDs, lockID)
+}
+
+func (suite *KeeperTestSuite) TestWithdrawAllMaturedLocksEventsAndHooks() {
+	suite.SetupTest()
+	recorder := &maturedLocksRecorder{}
+	suite.App.LockupKeeper.AddHooksForTest(recorder)
+
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	coins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+	for _, duration := range []time.Duration{time.Second, time.Second * 2, time.Second * 3} {
+		suite.LockTokens(addr1, coins, duration)
+	}
+	suite.BeginUnlocking(addr1)
+
+	// only the locks that matured by the block time are withdrawn
+	ctx := suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(time.Second * 2)).WithEventManager(sdk.NewEventManager())
+	suite.App.LockupKeeper.WithdrawAllMaturedLocks(ctx)
+	suite.Require().Equal([]uint64{1, 2}, recorder.lockIDs)
+	suite.AssertEventEmitted(ctx, types.TypeEvtLockMatured, 2)
+	suite.AssertEventEmitted(ctx, types.TypeEvtLockWithdrawn, 2)
+
+	// locks that are withdrawn early do not mature
+	suite.App.LockupKeeper.SetParams(ctx, types.NewParams(true, sdk.NewDecWithPrec(25, 2), false))
+	_, _, err := suite.App.LockupKeeper.EarlyUnlock(ctx, 3, addr1, nil)
+	suite.Require().NoError(err)
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Second)).WithEventManager(sdk.NewEventManager())
+	suite.App.LockupKeeper.WithdrawAllMaturedLocks(ctx)
+	suite.Require().Equal([]uint64{1, 2}, recorder.lockIDs)
+	suite.AssertEventEmitted(ctx, types.TypeEvtLockMatured, 0)
+}
+
 func (suite *KeeperTestSuite) TestLockAccumulationStore() {
 	suite.SetupTest()
 
@@ -871,3 +912,419 @@ func (suite *KeeperTestSuite) TestEditLockup() {
 	})
 	suite.Require().Equal(int64(0), acc.Int64())
 }
+
+func (suite *KeeperTestSuite) TestTransferLock() {
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	addr2 := sdk.AccAddress([]byte("addr2---------------"))
+	coins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+
+	testCases := []struct {
+		name                  string
+		owner                 sdk.AccAddress
+		newOwner              sdk.AccAddress
+		isUnlocking           bool
+		withSyntheticLockup   bool
+		allowSyntheticLockups bool
+		expectPass            bool
+	}{
+		{
+			name:       "transfer lock",
+			owner:      addr1,
+			newOwner:   addr2,
+			expectPass: true,
+		},
+		{
+			name:        "transfer unlocking lock",
+			owner:       addr1,
+			newOwner:    addr2,
+			isUnlocking: true,
+			expectPass:  false,
+		},
+		{
+			name:       "transfer lock to a module account",
+			owner:      addr1,
+			newOwner:   authtypes.NewModuleAddress(distrtypes.ModuleName),
+			expectPass: false,
+		},
+		{
+			name:                "transfer lock with synthetic lockup without allowing it",
+			owner:               addr1,
+			newOwner:            addr2,
+			withSyntheticLockup: true,
+			expectPass:          false,
+		},
+		{
+			name:                  "transfer lock with synthetic lockup allowing it",
+			owner:                 addr1,
+			newOwner:              addr2,
+			withSyntheticLockup:   true,
+			allowSyntheticLockups: true,
+			expectPass:            true,
+		},
+		{
+			name:       "transfer lock not owned by the sender",
+			owner:      addr2,
+			newOwner:   addr1,
+			expectPass: false,
+		},
+		{
+			name:       "transfer lock to its owner",
+			owner:      addr1,
+			newOwner:   addr1,
+			expectPass: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.LockTokens(addr1, coins, time.Second)
+			if tc.withSyntheticLockup {
+				err := suite.App.LockupKeeper.CreateSyntheticLockup(suite.Ctx, 1, "synthstakestakedtovalidator", time.Second, false)
+				suite.Require().NoError(err)
+			}
+			if tc.isUnlocking {
+				err := suite.App.LockupKeeper.BeginUnlock(suite.Ctx, 1, nil)
+				suite.Require().NoError(err)
+			}
+
+			err := suite.App.LockupKeeper.TransferLock(suite.Ctx, 1, tc.owner, tc.newOwner, tc.allowSyntheticLockups)
+			if !tc.expectPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			lock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, 1)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.newOwner.String(), lock.Owner)
+
+			// the lock refs move to the new owner
+			suite.Require().Len(suite.App.LockupKeeper.GetAccountPeriodLocks(suite.Ctx, tc.owner), 0)
+			suite.Require().Len(suite.App.LockupKeeper.GetAccountPeriodLocks(suite.Ctx, tc.newOwner), 1)
+			suite.Require().Len(suite.App.LockupKeeper.GetAccountLockedLongerDurationDenom(suite.Ctx, tc.owner, "stake", 0), 0)
+			suite.Require().Len(suite.App.LockupKeeper.GetAccountLockedLongerDurationDenom(suite.Ctx, tc.newOwner, "stake", 0), 1)
+			if tc.withSyntheticLockup {
+				suite.Require().Len(suite.App.LockupKeeper.GetAccountLockedLongerDurationDenom(suite.Ctx, tc.owner, "synthstakestakedtovalidator", 0), 0)
+				suite.Require().Len(suite.App.LockupKeeper.GetAccountLockedLongerDurationDenom(suite.Ctx, tc.newOwner, "synthstakestakedtovalidator", 0), 1)
+			}
+
+			// the accumulation store is unchanged
+			acc := suite.App.LockupKeeper.GetPeriodLocksAccumulation(suite.Ctx, types.QueryCondition{
+				Denom:    "stake",
+				Duration: time.Second,
+			})
+			suite.Require().Equal(int64(10), acc.Int64())
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMergeLocks() {
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	addr2 := sdk.AccAddress([]byte("addr2---------------"))
+
+	testCases := []struct {
+		name       string
+		owner      sdk.AccAddress
+		toLock     func() uint64
+		expectPass bool
+	}{
+		{
+			name:  "merge locks of the same denom and duration",
+			owner: addr1,
+			toLock: func() uint64 {
+				lock, err := suite.App.LockupKeeper.CreateLock(suite.Ctx, addr1, sdk.Coins{sdk.NewInt64Coin("stake", 20)}, time.Second)
+				suite.Require().NoError(err)
+				return lock.ID
+			},
+			expectPass: true,
+		},
+		{
+			name:  "merge locks of different durations",
+			owner: addr1,
+			toLock: func() uint64 {
+				lock, err := suite.App.LockupKeeper.CreateLock(suite.Ctx, addr1, sdk.Coins{sdk.NewInt64Coin("stake", 20)}, time.Second*2)
+				suite.Require().NoError(err)
+				return lock.ID
+			},
+			expectPass: false,
+		},
+		{
+			name:  "merge locks of different denoms",
+			owner: addr1,
+			toLock: func() uint64 {
+				lock, err := suite.App.LockupKeeper.CreateLock(suite.Ctx, addr1, sdk.Coins{sdk.NewInt64Coin("foo", 20)}, time.Second)
+				suite.Require().NoError(err)
+				return lock.ID
+			},
+			expectPass: false,
+		},
+		{
+			name:  "merge locks of different owners",
+			owner: addr1,
+			toLock: func() uint64 {
+				lock, err := suite.App.LockupKeeper.CreateLock(suite.Ctx, addr2, sdk.Coins{sdk.NewInt64Coin("stake", 20)}, time.Second)
+				suite.Require().NoError(err)
+				return lock.ID
+			},
+			expectPass: false,
+		},
+		{
+			name:  "merge into an unlocking lock",
+			owner: addr1,
+			toLock: func() uint64 {
+				lock, err := suite.App.LockupKeeper.CreateLock(suite.Ctx, addr1, sdk.Coins{sdk.NewInt64Coin("stake", 20)}, time.Second)
+				suite.Require().NoError(err)
+				err = suite.App.LockupKeeper.BeginUnlock(suite.Ctx, lock.ID, nil)
+				suite.Require().NoError(err)
+				return lock.ID
+			},
+			expectPass: false,
+		},
+		{
+			name:  "merge into a lock with synthetic lockup",
+			owner: addr1,
+			toLock: func() uint64 {
+				lock, err := suite.App.LockupKeeper.CreateLock(suite.Ctx, addr1, sdk.Coins{sdk.NewInt64Coin("stake", 20)}, time.Second)
+				suite.Require().NoError(err)
+				err = suite.App.LockupKeeper.CreateSyntheticLockup(suite.Ctx, lock.ID, "synthstakestakedtovalidator", time.Second, false)
+				suite.Require().NoError(err)
+				return lock.ID
+			},
+			expectPass: false,
+		},
+		{
+			name:       "merge lock into itself",
+			owner:      addr1,
+			toLock:     func() uint64 { return 1 },
+			expectPass: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.FundAcc(addr1, sdk.Coins{sdk.NewInt64Coin("foo", 20), sdk.NewInt64Coin("stake", 30)})
+			suite.FundAcc(addr2, sdk.Coins{sdk.NewInt64Coin("stake", 20)})
+			fromLock, err := suite.App.LockupKeeper.CreateLock(suite.Ctx, addr1, sdk.Coins{sdk.NewInt64Coin("stake", 10)}, time.Second)
+			suite.Require().NoError(err)
+			toLockID := tc.toLock()
+
+			lock, err := suite.App.LockupKeeper.MergeLocks(suite.Ctx, tc.owner, fromLock.ID, toLockID)
+			if !tc.expectPass {
+				suite.Require().Error(err)
+				_, err = suite.App.LockupKeeper.GetLockByID(suite.Ctx, fromLock.ID)
+				suite.Require().NoError(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(toLockID, lock.ID)
+			suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 30)}, lock.Coins)
+
+			// the merged lock is removed along with its lock refs
+			_, err = suite.App.LockupKeeper.GetLockByID(suite.Ctx, fromLock.ID)
+			suite.Require().Error(err)
+			locks := suite.App.LockupKeeper.GetAccountLockedLongerDurationDenom(suite.Ctx, addr1, "stake", 0)
+			suite.Require().Len(locks, 1)
+			suite.Require().Equal(*lock, locks[0])
+			suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 30)}, suite.App.LockupKeeper.GetAccountLockedCoins(suite.Ctx, addr1))
+
+			// the accumulation store is unchanged
+			acc := suite.App.LockupKeeper.GetPeriodLocksAccumulation(suite.Ctx, types.QueryCondition{
+				Denom:    "stake",
+				Duration: time.Second,
+			})
+			suite.Require().Equal(int64(30), acc.Int64())
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestEarlyUnlock() {
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	addr2 := sdk.AccAddress([]byte("addr2---------------"))
+	lockCoins := sdk.Coins{sdk.NewInt64Coin("stake", 1000)}
+	enabledParams := types.NewParams(true, sdk.NewDecWithPrec(25, 2), false)
+
+	testCases := []struct {
+		name            string
+		owner           sdk.AccAddress
+		coins           sdk.Coins
+		params          types.Params
+		beginUnlock     bool
+		elapsed         time.Duration
+		syntheticLockup bool
+		expectPass      bool
+		expectAmount    sdk.Coins
+		expectPenalty   sdk.Coins
+		expectRemaining sdk.Coins
+	}{
+		{
+			name:          "early unlock the entire lock",
+			owner:         addr1,
+			params:        enabledParams,
+			expectPass:    true,
+			expectAmount:  sdk.Coins{sdk.NewInt64Coin("stake", 750)},
+			expectPenalty: sdk.Coins{sdk.NewInt64Coin("stake", 250)},
+		},
+		{
+			name:            "early unlock part of the lock",
+			owner:           addr1,
+			coins:           sdk.Coins{sdk.NewInt64Coin("stake", 400)},
+			params:          enabledParams,
+			expectPass:      true,
+			expectAmount:    sdk.Coins{sdk.NewInt64Coin("stake", 300)},
+			expectPenalty:   sdk.Coins{sdk.NewInt64Coin("stake", 100)},
+			expectRemaining: sdk.Coins{sdk.NewInt64Coin("stake", 600)},
+		},
+		{
+			name:          "penalty scales with the remaining duration of an unlocking lock",
+			owner:         addr1,
+			params:        enabledParams,
+			beginUnlock:   true,
+			elapsed:       time.Second * 6,
+			expectPass:    true,
+			expectAmount:  sdk.Coins{sdk.NewInt64Coin("stake", 900)},
+			expectPenalty: sdk.Coins{sdk.NewInt64Coin("stake", 100)},
+		},
+		{
+			name:            "penalty is rounded up",
+			owner:           addr1,
+			coins:           sdk.Coins{sdk.NewInt64Coin("stake", 3)},
+			params:          types.NewParams(true, sdk.NewDecWithPrec(1, 1), false),
+			expectPass:      true,
+			expectAmount:    sdk.Coins{sdk.NewInt64Coin("stake", 2)},
+			expectPenalty:   sdk.Coins{sdk.NewInt64Coin("stake", 1)},
+			expectRemaining: sdk.Coins{sdk.NewInt64Coin("stake", 997)},
+		},
+		{
+			name:          "penalty is burned",
+			owner:         addr1,
+			params:        types.NewParams(true, sdk.NewDecWithPrec(25, 2), true),
+			expectPass:    true,
+			expectAmount:  sdk.Coins{sdk.NewInt64Coin("stake", 750)},
+			expectPenalty: sdk.Coins{sdk.NewInt64Coin("stake", 250)},
+		},
+		{
+			name:       "early unlock disabled",
+			owner:      addr1,
+			params:     types.NewParams(false, sdk.NewDecWithPrec(25, 2), false),
+			expectPass: false,
+		},
+		{
+			name:       "early unlock disabled by default",
+			owner:      addr1,
+			params:     types.DefaultParams(),
+			expectPass: false,
+		},
+		{
+			name:       "early unlock by another account",
+			owner:      addr2,
+			params:     enabledParams,
+			expectPass: false,
+		},
+		{
+			name:            "early unlock a lock with synthetic lockup",
+			owner:           addr1,
+			params:          enabledParams,
+			syntheticLockup: true,
+			expectPass:      false,
+		},
+		{
+			name:        "early unlock part of an unlocking lock",
+			owner:       addr1,
+			coins:       sdk.Coins{sdk.NewInt64Coin("stake", 400)},
+			params:      enabledParams,
+			beginUnlock: true,
+			expectPass:  false,
+		},
+		{
+			name:       "early unlock more than the locked tokens",
+			owner:      addr1,
+			coins:      sdk.Coins{sdk.NewInt64Coin("stake", 1001)},
+			params:     enabledParams,
+			expectPass: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.App.LockupKeeper.SetParams(suite.Ctx, tc.params)
+			suite.FundAcc(addr1, lockCoins)
+			lock, err := suite.App.LockupKeeper.CreateLock(suite.Ctx, addr1, lockCoins, time.Second*10)
+			suite.Require().NoError(err)
+			if tc.syntheticLockup {
+				err = suite.App.LockupKeeper.CreateSyntheticLockup(suite.Ctx, lock.ID, "synthstakestakedtovalidator", time.Second*10, false)
+				suite.Require().NoError(err)
+			}
+			if tc.beginUnlock {
+				err = suite.App.LockupKeeper.BeginUnlock(suite.Ctx, lock.ID, nil)
+				suite.Require().NoError(err)
+			}
+			suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(tc.elapsed))
+			communityPool := suite.App.DistrKeeper.GetFeePoolCommunityCoins(suite.Ctx)
+			supply := suite.App.BankKeeper.GetSupply(suite.Ctx, "stake")
+
+			amount, penalty, err := suite.App.LockupKeeper.EarlyUnlock(suite.Ctx, lock.ID, tc.owner, tc.coins)
+			if !tc.expectPass {
+				suite.Require().Error(err)
+				_, err = suite.App.LockupKeeper.GetLockByID(suite.Ctx, lock.ID)
+				suite.Require().NoError(err)
+				suite.Require().Equal(lockCoins, suite.App.LockupKeeper.GetModuleBalance(suite.Ctx))
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expectAmount, amount)
+			suite.Require().Equal(tc.expectPenalty, penalty)
+			suite.Require().Equal(tc.expectAmount, suite.App.BankKeeper.GetAllBalances(suite.Ctx, addr1))
+
+			// the penalty is either burned or sent to the community pool
+			if tc.params.BurnEarlyUnlockPenalty {
+				suite.Require().Equal(supply.Sub(tc.expectPenalty[0]), suite.App.BankKeeper.GetSupply(suite.Ctx, "stake"))
+				suite.Require().Equal(communityPool, suite.App.DistrKeeper.GetFeePoolCommunityCoins(suite.Ctx))
+			} else {
+				suite.Require().Equal(supply, suite.App.BankKeeper.GetSupply(suite.Ctx, "stake"))
+				suite.Require().Equal(communityPool.Add(sdk.NewDecCoinsFromCoins(tc.expectPenalty...)...), suite.App.DistrKeeper.GetFeePoolCommunityCoins(suite.Ctx))
+			}
+
+			// only the coins that are not unlocked early remain locked
+			suite.Require().True(tc.expectRemaining.IsEqual(suite.App.LockupKeeper.GetModuleBalance(suite.Ctx)))
+			suite.Require().True(tc.expectRemaining.IsEqual(suite.App.LockupKeeper.GetAccountLockedCoins(suite.Ctx, addr1)))
+			acc := suite.App.LockupKeeper.GetPeriodLocksAccumulation(suite.Ctx, types.QueryCondition{
+				Denom:    "stake",
+				Duration: time.Second * 10,
+			})
+			suite.Require().Equal(tc.expectRemaining.AmountOf("stake"), acc)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestEstimateEarlyUnlock() {
+	suite.SetupTest()
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	coins := sdk.Coins{sdk.NewInt64Coin("stake", 1000)}
+	suite.FundAcc(addr1, coins)
+	lock, err := suite.App.LockupKeeper.CreateLock(suite.Ctx, addr1, coins, time.Second*10)
+	suite.Require().NoError(err)
+
+	// a lock that is not unlocking has its full duration remaining
+	suite.Require().Equal(sdk.NewDecWithPrec(25, 2), suite.App.LockupKeeper.EarlyUnlockPenaltyRate(suite.Ctx, lock))
+	amount, penalty := suite.App.LockupKeeper.EstimateEarlyUnlock(suite.Ctx, lock, coins)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 750)}, amount)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 250)}, penalty)
+
+	err = suite.App.LockupKeeper.BeginUnlock(suite.Ctx, lock.ID, nil)
+	suite.Require().NoError(err)
+	unlockingLock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, lock.ID)
+	suite.Require().NoError(err)
+
+	// the penalty rate decreases linearly as the unlocking lock approaches its end time
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(time.Second * 8))
+	suite.Require().Equal(sdk.NewDecWithPrec(5, 2), suite.App.LockupKeeper.EarlyUnlockPenaltyRate(suite.Ctx, *unlockingLock))
+
+	// matured locks have no penalty
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(time.Second * 2))
+	amount, penalty = suite.App.LockupKeeper.EstimateEarlyUnlock(suite.Ctx, *unlockingLock, coins)
+	suite.Require().Equal(coins, amount)
+	suite.Require().True(penalty.Empty())
+}
@@ -182,3 +182,90 @@ func (server msgServer) ExtendLockup(goCtx context.Context, msg *types.MsgExtend
 
 	return &types.MsgExtendLockupResponse{}, nil
 }
+
+// TransferLock transfers the ownership of the lock to the new owner.
+// TransferLock would fail if the lock has synthetic lockups, unless they are explicitly allowed.
+func (server msgServer) TransferLock(goCtx context.Context, msg *types.MsgTransferLock) (*types.MsgTransferLockResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+	newOwner, err := sdk.AccAddressFromBech32(msg.NewOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.keeper.TransferLock(ctx, msg.ID, owner, newOwner, msg.AllowSyntheticLockups)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtTransferLock,
+			sdk.NewAttribute(types.AttributePeriodLockID, utils.Uint64ToString(msg.ID)),
+			sdk.NewAttribute(types.AttributePeriodLockOwner, msg.Owner),
+			sdk.NewAttribute(types.AttributePeriodLockNewOwner, msg.NewOwner),
+		),
+	})
+
+	return &types.MsgTransferLockResponse{}, nil
+}
+
+// MergeLocks merges a lock into another lock with the same owner, denoms and duration.
+// MergeLocks would fail if either lock is unlocking or has synthetic lockups.
+func (server msgServer) MergeLocks(goCtx context.Context, msg *types.MsgMergeLocks) (*types.MsgMergeLocksResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	lock, err := server.keeper.MergeLocks(ctx, owner, msg.FromLockId, msg.ToLockId)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtMergeLocks,
+			sdk.NewAttribute(types.AttributePeriodLockID, utils.Uint64ToString(lock.ID)),
+			sdk.NewAttribute(types.AttributeMergedLockID, utils.Uint64ToString(msg.FromLockId)),
+			sdk.NewAttribute(types.AttributePeriodLockOwner, lock.Owner),
+			sdk.NewAttribute(types.AttributePeriodLockAmount, lock.Coins.String()),
+		),
+	})
+
+	return &types.MsgMergeLocksResponse{Lock: lock}, nil
+}
+
+// EarlyUnlock unlocks coins of a lock before it matures, taking a penalty scaled by its remaining duration.
+// EarlyUnlock would fail if early unlocks are disabled or if the lock has synthetic lockups.
+func (server msgServer) EarlyUnlock(goCtx context.Context, msg *types.MsgEarlyUnlock) (*types.MsgEarlyUnlockResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, penalty, err := server.keeper.EarlyUnlock(ctx, msg.ID, owner, msg.Coins)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtEarlyUnlock,
+			sdk.NewAttribute(types.AttributePeriodLockID, utils.Uint64ToString(msg.ID)),
+			sdk.NewAttribute(types.AttributePeriodLockOwner, msg.Owner),
+			sdk.NewAttribute(types.AttributeUnlockedCoins, amount.String()),
+			sdk.NewAttribute(types.AttributeEarlyUnlockPenalty, penalty.String()),
+		),
+	})
+
+	return &types.MsgEarlyUnlockResponse{Amount: amount, Penalty: penalty}, nil
+}
@@ -327,3 +327,158 @@ func (suite *KeeperTestSuite) TestMsgEditLockup() {
 		}
 	}
 }
+
+func (suite *KeeperTestSuite) TestMsgTransferLock() {
+	owner := sdk.AccAddress([]byte("addr1---------------"))
+	newOwner := sdk.AccAddress([]byte("addr2---------------"))
+	coins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+
+	tests := []struct {
+		name                  string
+		isSyntheticLockup     bool
+		allowSyntheticLockups bool
+		expectPass            bool
+	}{
+		{
+			name:       "transfer lock",
+			expectPass: true,
+		},
+		{
+			name:              "disallow transfer when synthetic lockup exists",
+			isSyntheticLockup: true,
+			expectPass:        false,
+		},
+		{
+			name:                  "allow transfer when synthetic lockup exists if explicitly allowed",
+			isSyntheticLockup:     true,
+			allowSyntheticLockups: true,
+			expectPass:            true,
+		},
+	}
+
+	for _, test := range tests {
+		suite.SetupTest()
+
+		err := simapp.FundAccount(suite.App.BankKeeper, suite.Ctx, owner, coins)
+		suite.Require().NoError(err)
+
+		msgServer := keeper.NewMsgServerImpl(suite.App.LockupKeeper)
+		c := sdk.WrapSDKContext(suite.Ctx)
+		resp, err := msgServer.LockTokens(c, types.NewMsgLockTokens(owner, time.Second, coins))
+		suite.Require().NoError(err)
+
+		if test.isSyntheticLockup {
+			err = suite.App.LockupKeeper.CreateSyntheticLockup(suite.Ctx, resp.ID, "synthetic", time.Second, false)
+			suite.Require().NoError(err)
+		}
+
+		_, err = msgServer.TransferLock(c, types.NewMsgTransferLock(owner, resp.ID, newOwner, test.allowSyntheticLockups))
+
+		if test.expectPass {
+			suite.Require().NoError(err, test.name)
+			suite.AssertEventEmitted(suite.Ctx, types.TypeEvtTransferLock, 1)
+			suite.Require().Equal(coins, suite.App.LockupKeeper.GetAccountLockedCoins(suite.Ctx, newOwner))
+		} else {
+			suite.Require().Error(err, test.name)
+			suite.AssertEventEmitted(suite.Ctx, types.TypeEvtTransferLock, 0)
+		}
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgMergeLocks() {
+	owner := sdk.AccAddress([]byte("addr1---------------"))
+	coins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+
+	tests := []struct {
+		name        string
+		newDuration time.Duration
+		expectPass  bool
+	}{
+		{
+			name:        "merge locks of the same duration",
+			newDuration: time.Second,
+			expectPass:  true,
+		},
+		{
+			name:        "disallow merge of locks of different durations",
+			newDuration: time.Second * 2,
+			expectPass:  false,
+		},
+	}
+
+	for _, test := range tests {
+		suite.SetupTest()
+
+		err := simapp.FundAccount(suite.App.BankKeeper, suite.Ctx, owner, coins.Add(coins...))
+		suite.Require().NoError(err)
+
+		msgServer := keeper.NewMsgServerImpl(suite.App.LockupKeeper)
+		c := sdk.WrapSDKContext(suite.Ctx)
+		fromLock, err := suite.App.LockupKeeper.CreateLock(suite.Ctx, owner, coins, time.Second)
+		suite.Require().NoError(err)
+		toLock, err := suite.App.LockupKeeper.CreateLock(suite.Ctx, owner, coins, test.newDuration)
+		suite.Require().NoError(err)
+
+		resp, err := msgServer.MergeLocks(c, types.NewMsgMergeLocks(owner, fromLock.ID, toLock.ID))
+
+		if test.expectPass {
+			suite.Require().NoError(err, test.name)
+			suite.AssertEventEmitted(suite.Ctx, types.TypeEvtMergeLocks, 1)
+			suite.Require().Equal(toLock.ID, resp.Lock.ID)
+			suite.Require().Equal(coins.Add(coins...), resp.Lock.Coins)
+		} else {
+			suite.Require().Error(err, test.name)
+			suite.AssertEventEmitted(suite.Ctx, types.TypeEvtMergeLocks, 0)
+		}
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgEarlyUnlock() {
+	owner := sdk.AccAddress([]byte("addr1---------------"))
+	coins := sdk.Coins{sdk.NewInt64Coin("stake", 100)}
+
+	tests := []struct {
+		name               string
+		earlyUnlockEnabled bool
+		expectPass         bool
+	}{
+		{
+			name:               "early unlock when enabled",
+			earlyUnlockEnabled: true,
+			expectPass:         true,
+		},
+		{
+			name:               "disallow early unlock when disabled",
+			earlyUnlockEnabled: false,
+			expectPass:         false,
+		},
+	}
+
+	for _, test := range tests {
+		suite.SetupTest()
+
+		params := types.DefaultParams()
+		params.EarlyUnlockEnabled = test.earlyUnlockEnabled
+		suite.App.LockupKeeper.SetParams(suite.Ctx, params)
+
+		err := simapp.FundAccount(suite.App.BankKeeper, suite.Ctx, owner, coins)
+		suite.Require().NoError(err)
+
+		msgServer := keeper.NewMsgServerImpl(suite.App.LockupKeeper)
+		c := sdk.WrapSDKContext(suite.Ctx)
+		lock, err := suite.App.LockupKeeper.CreateLock(suite.Ctx, owner, coins, time.Second)
+		suite.Require().NoError(err)
+
+		resp, err := msgServer.EarlyUnlock(c, types.NewMsgEarlyUnlock(owner, lock.ID, nil))
+
+		if test.expectPass {
+			suite.Require().NoError(err, test.name)
+			suite.AssertEventEmitted(suite.Ctx, types.TypeEvtEarlyUnlock, 1)
+			suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 75)}, resp.Amount)
+			suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 25)}, resp.Penalty)
+		} else {
+			suite.Require().Error(err, test.name)
+			suite.AssertEventEmitted(suite.Ctx, types.TypeEvtEarlyUnlock, 0)
+		}
+	}
+}
@@ -150,6 +150,28 @@ func (k Keeper) GetAccountUnlockedBeforeTime(ctx sdk.Context, addr sdk.AccAddres
 	return combineLocks(notUnlockings, unlockings)
 }
 
+// GetAccountUnlockSchedule Returns the unlocking coins of an account grouped by unlock time, in ascending order of unlock time.
+// Locks that finished unlocking but are not withdrawn yet are included.
+func (k Keeper) GetAccountUnlockSchedule(ctx sdk.Context, addr sdk.AccAddress) []types.UnlockScheduleEntry {
+	schedule := []types.UnlockScheduleEntry{}
+	// lock refs of the account are ordered by unlock time
+	unlockings := k.getLocksFromIterator(ctx, k.AccountLockIteratorAfterTime(ctx, addr, time.Time{}))
+	for _, lock := range unlockings {
+		last := len(schedule) - 1
+		if last >= 0 && schedule[last].EndTime.Equal(lock.EndTime) {
+			schedule[last].Coins = schedule[last].Coins.Add(lock.Coins...)
+			schedule[last].LockIds = append(schedule[last].LockIds, lock.ID)
+			continue
+		}
+		schedule = append(schedule, types.UnlockScheduleEntry{
+			EndTime: lock.EndTime,
+			Coins:   lock.Coins,
+			LockIds: []uint64{lock.ID},
+		})
+	}
+	return schedule
+}
+
 // GetAccountLockedPastTimeDenom is equal to GetAccountLockedPastTime but denom specific.
 func (k Keeper) GetAccountLockedPastTimeDenom(ctx sdk.Context, addr sdk.AccAddress, denom string, timestamp time.Time) []types.PeriodLock {
 	// unlockings finish after specific time + not started locks that will finish after the time even though it start now
@@ -327,3 +327,79 @@ func (suite *KeeperTestSuite) TestResetAllSyntheticLocks() {
 	})
 	suite.Require().Equal(accum.String(), "10")
 }
+
+func (suite *KeeperTestSuite) TestSplitLockWithSyntheticLockups() {
+	suite.SetupTest()
+
+	// lock coins
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	coins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+	suite.LockTokens(addr1, coins, time.Second)
+
+	// create synthetic lockup
+	err := suite.App.LockupKeeper.CreateSyntheticLockup(suite.Ctx, 1, "synthstakestakedtovalidator1", time.Second, false)
+	suite.Require().NoError(err)
+
+	// split amount should be a part of the locked tokens
+	_, err = suite.App.LockupKeeper.SplitLock(suite.Ctx, 1, sdk.Coins{})
+	suite.Require().Error(err)
+	_, err = suite.App.LockupKeeper.SplitLock(suite.Ctx, 1, coins)
+	suite.Require().Error(err)
+	_, err = suite.App.LockupKeeper.SplitLock(suite.Ctx, 1, sdk.Coins{sdk.NewInt64Coin("stake", 11)})
+	suite.Require().Error(err)
+	_, err = suite.App.LockupKeeper.SplitLock(suite.Ctx, 1, sdk.Coins{sdk.NewInt64Coin("stake1", 1)})
+	suite.Require().Error(err)
+	_, err = suite.App.LockupKeeper.SplitLock(suite.Ctx, 2, sdk.Coins{sdk.NewInt64Coin("stake", 1)})
+	suite.Require().Error(err)
+
+	// split lock
+	splitLock, err := suite.App.LockupKeeper.SplitLock(suite.Ctx, 1, sdk.Coins{sdk.NewInt64Coin("stake", 4)})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), splitLock.ID)
+	suite.Require().Equal(addr1.String(), splitLock.Owner)
+	suite.Require().Equal(time.Second, splitLock.Duration)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 4)}, splitLock.Coins)
+
+	lock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, 1)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 6)}, lock.Coins)
+
+	locks := suite.App.LockupKeeper.GetAccountLockedLongerDurationDenom(suite.Ctx, addr1, "stake", time.Second)
+	suite.Require().Len(locks, 2)
+
+	// check synthetic lockups
+	synthLock, err := suite.App.LockupKeeper.GetSyntheticLockup(suite.Ctx, splitLock.ID, "synthstakestakedtovalidator1")
+	suite.Require().NoError(err)
+	suite.Require().Equal(time.Second, synthLock.Duration)
+	suite.Require().Len(suite.App.LockupKeeper.GetAllSyntheticLockupsByLockup(suite.Ctx, 1), 1)
+	suite.Require().Len(suite.App.LockupKeeper.GetAllSyntheticLockupsByLockup(suite.Ctx, splitLock.ID), 1)
+
+	// check accumulations are unchanged
+	acc := suite.App.LockupKeeper.GetPeriodLocksAccumulation(suite.Ctx, types.QueryCondition{
+		Denom:    "stake",
+		Duration: time.Second,
+	})
+	suite.Require().Equal(int64(10), acc.Int64())
+	acc = suite.App.LockupKeeper.GetPeriodLocksAccumulation(suite.Ctx, types.QueryCondition{
+		Denom:    "synthstakestakedtovalidator1",
+		Duration: time.Second,
+	})
+	suite.Require().Equal(int64(10), acc.Int64())
+
+	// deleting the synthetic lockups releases the accumulation of both locks
+	err = suite.App.LockupKeeper.DeleteSyntheticLockup(suite.Ctx, 1, "synthstakestakedtovalidator1")
+	suite.Require().NoError(err)
+	err = suite.App.LockupKeeper.DeleteSyntheticLockup(suite.Ctx, splitLock.ID, "synthstakestakedtovalidator1")
+	suite.Require().NoError(err)
+	acc = suite.App.LockupKeeper.GetPeriodLocksAccumulation(suite.Ctx, types.QueryCondition{
+		Denom:    "synthstakestakedtovalidator1",
+		Duration: time.Second,
+	})
+	suite.Require().Equal(int64(0), acc.Int64())
+
+	// unlocking lock can not be split
+	err = suite.App.LockupKeeper.BeginUnlock(suite.Ctx, 1, nil)
+	suite.Require().NoError(err)
+	_, err = suite.App.LockupKeeper.SplitLock(suite.Ctx, 1, sdk.Coins{sdk.NewInt64Coin("stake", 1)})
+	suite.Require().Error(err)
+}
@@ -6,6 +6,11 @@ const (
 	TypeEvtAddTokensToLock = "add_tokens_to_lock"
 	TypeEvtBeginUnlockAll  = "begin_unlock_all"
 	TypeEvtBeginUnlock     = "begin_unlock"
+	TypeEvtTransferLock    = "transfer_lock"
+	TypeEvtMergeLocks      = "merge_locks"
+	TypeEvtEarlyUnlock     = "early_unlock"
+	TypeEvtLockMatured     = "lock_matured"
+	TypeEvtLockWithdrawn   = "lock_withdrawn"
 
 	AttributePeriodLockID         = "period_lock_id"
 	AttributePeriodLockOwner      = "owner"
@@ -13,4 +18,7 @@ const (
 	AttributePeriodLockDuration   = "duration"
 	AttributePeriodLockUnlockTime = "unlock_time"
 	AttributeUnlockedCoins        = "unlocked_coins"
+	AttributePeriodLockNewOwner   = "new_owner"
+	AttributeMergedLockID         = "merged_lock_id"
+	AttributeEarlyUnlockPenalty   = "penalty"
 )
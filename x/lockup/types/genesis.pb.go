@@ -28,6 +28,7 @@ type GenesisState struct {
 	LastLockId     uint64          `protobuf:"varint,1,opt,name=last_lock_id,json=lastLockId,proto3" json:"last_lock_id,omitempty"`
 	Locks          []PeriodLock    `protobuf:"bytes,2,rep,name=locks,proto3" json:"locks"`
 	SyntheticLocks []SyntheticLock `protobuf:"bytes,3,rep,name=synthetic_locks,json=syntheticLocks,proto3" json:"synthetic_locks"`
+	Params         Params          `protobuf:"bytes,4,opt,name=params,proto3" json:"params"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -84,6 +85,13 @@ func (m *GenesisState) GetSyntheticLocks() []SyntheticLock {
 	return nil
 }
 
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "osmosis.lockup.GenesisState")
 }
@@ -91,24 +99,26 @@ func init() {
 func init() { proto.RegisterFile("osmosis/lockup/genesis.proto", fileDescriptor_648db7c6ebb608b0) }
 
 var fileDescriptor_648db7c6ebb608b0 = []byte{
-	// 262 bytes of a gzipped FileDescriptorProto
+	// 291 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xc9, 0x2f, 0xce, 0xcd,
 	0x2f, 0xce, 0x2c, 0xd6, 0xcf, 0xc9, 0x4f, 0xce, 0x2e, 0x2d, 0xd0, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d,
 	0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x83, 0xca, 0xea, 0x41, 0x64, 0xa5,
 	0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x52, 0xfa, 0x20, 0x16, 0x44, 0x95, 0x94, 0x24, 0x9a, 0x19,
-	0x20, 0x0a, 0x22, 0xa5, 0xb4, 0x8d, 0x91, 0x8b, 0xc7, 0x1d, 0x62, 0x64, 0x70, 0x49, 0x62, 0x49,
-	0xaa, 0x90, 0x02, 0x17, 0x4f, 0x4e, 0x62, 0x71, 0x49, 0x3c, 0x48, 0x4d, 0x7c, 0x66, 0x8a, 0x04,
-	0xa3, 0x02, 0xa3, 0x06, 0x4b, 0x10, 0x17, 0x48, 0xcc, 0x27, 0x3f, 0x39, 0xdb, 0x33, 0x45, 0xc8,
-	0x8c, 0x8b, 0x15, 0x24, 0x59, 0x2c, 0xc1, 0xa4, 0xc0, 0xac, 0xc1, 0x6d, 0x24, 0xa5, 0x87, 0xea,
-	0x06, 0xbd, 0x80, 0xd4, 0xa2, 0xcc, 0xfc, 0x14, 0x90, 0x62, 0x27, 0x96, 0x13, 0xf7, 0xe4, 0x19,
-	0x82, 0x20, 0xca, 0x85, 0x7c, 0xb8, 0xf8, 0x8b, 0x2b, 0xf3, 0x4a, 0x32, 0x52, 0x4b, 0x32, 0x93,
-	0xe3, 0x21, 0x26, 0x30, 0x83, 0x4d, 0x90, 0x45, 0x37, 0x21, 0x18, 0xa6, 0x0c, 0xc9, 0x10, 0xbe,
-	0x62, 0x64, 0xc1, 0x62, 0x27, 0x9f, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0,
-	0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88,
-	0x32, 0x4a, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x87, 0x1a, 0xac, 0x9b,
-	0x93, 0x98, 0x54, 0x0c, 0xe3, 0xe8, 0x97, 0x19, 0x1a, 0xe9, 0x57, 0xc0, 0xc2, 0xa2, 0xa4, 0xb2,
-	0x20, 0xb5, 0x38, 0x89, 0x0d, 0x1c, 0x1a, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe2, 0x0d,
-	0x10, 0x26, 0x6e, 0x01, 0x00, 0x00,
+	0x20, 0x0a, 0x2a, 0x25, 0x8d, 0x26, 0x55, 0x90, 0x58, 0x94, 0x98, 0x0b, 0x35, 0x5d, 0xe9, 0x0d,
+	0x23, 0x17, 0x8f, 0x3b, 0xc4, 0xbe, 0xe0, 0x92, 0xc4, 0x92, 0x54, 0x21, 0x05, 0x2e, 0x9e, 0x9c,
+	0xc4, 0xe2, 0x92, 0x78, 0x90, 0xe2, 0xf8, 0xcc, 0x14, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x96, 0x20,
+	0x2e, 0x90, 0x98, 0x4f, 0x7e, 0x72, 0xb6, 0x67, 0x8a, 0x90, 0x19, 0x17, 0x2b, 0x48, 0xb2, 0x58,
+	0x82, 0x49, 0x81, 0x59, 0x83, 0xdb, 0x48, 0x4a, 0x0f, 0xd5, 0x81, 0x7a, 0x01, 0xa9, 0x45, 0x99,
+	0xf9, 0x29, 0x20, 0xc5, 0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33, 0x04, 0x41, 0x94, 0x0b, 0xf9, 0x70,
+	0xf1, 0x17, 0x57, 0xe6, 0x95, 0x64, 0xa4, 0x96, 0x64, 0x26, 0xc7, 0x43, 0x4c, 0x60, 0x06, 0x9b,
+	0x20, 0x8b, 0x6e, 0x42, 0x30, 0x4c, 0x19, 0x92, 0x21, 0x7c, 0xc5, 0xc8, 0x82, 0xc5, 0x42, 0x26,
+	0x5c, 0x6c, 0x10, 0x8f, 0x48, 0xb0, 0x28, 0x30, 0x6a, 0x70, 0x1b, 0x89, 0x61, 0x38, 0x03, 0x2c,
+	0x0b, 0xd5, 0x0d, 0x55, 0xeb, 0xe4, 0x73, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f,
+	0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c,
+	0x51, 0x46, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x50, 0x93, 0x74,
+	0x73, 0x12, 0x93, 0x8a, 0x61, 0x1c, 0xfd, 0x32, 0x43, 0x23, 0xfd, 0x0a, 0x58, 0x18, 0x96, 0x54,
+	0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0xc3, 0xd0, 0x18, 0x30, 0x00, 0x79, 0x80, 0x4b, 0x3c, 0xc1,
+	0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -131,6 +141,16 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	if len(m.SyntheticLocks) > 0 {
 		for iNdEx := len(m.SyntheticLocks) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -199,6 +219,8 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -324,6 +346,39 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
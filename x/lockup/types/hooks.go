@@ -13,6 +13,10 @@ type LockupHooks interface {
 	OnTokenUnlocked(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time)
 	OnTokenSlashed(ctx sdk.Context, lockID uint64, amount sdk.Coins)
 	OnLockupExtend(ctx sdk.Context, lockID uint64, prevDuration time.Duration, newDuration time.Duration)
+	OnLockSplit(ctx sdk.Context, lockID uint64, splitLockID uint64, amount sdk.Coins)
+	OnLockTransfer(ctx sdk.Context, lockID uint64, prevOwner sdk.AccAddress, newOwner sdk.AccAddress)
+	OnLocksMerge(ctx sdk.Context, fromLockID uint64, toLockID uint64, amount sdk.Coins)
+	AfterLockMatured(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time)
 }
 
 var _ LockupHooks = MultiLockupHooks{}
@@ -59,3 +63,27 @@ func (h MultiLockupHooks) OnLockupExtend(ctx sdk.Context, lockID uint64, prevDur
 		h[i].OnLockupExtend(ctx, lockID, prevDuration, newDuration)
 	}
 }
+
+func (h MultiLockupHooks) OnLockSplit(ctx sdk.Context, lockID uint64, splitLockID uint64, amount sdk.Coins) {
+	for i := range h {
+		h[i].OnLockSplit(ctx, lockID, splitLockID, amount)
+	}
+}
+
+func (h MultiLockupHooks) OnLockTransfer(ctx sdk.Context, lockID uint64, prevOwner sdk.AccAddress, newOwner sdk.AccAddress) {
+	for i := range h {
+		h[i].OnLockTransfer(ctx, lockID, prevOwner, newOwner)
+	}
+}
+
+func (h MultiLockupHooks) OnLocksMerge(ctx sdk.Context, fromLockID uint64, toLockID uint64, amount sdk.Coins) {
+	for i := range h {
+		h[i].OnLocksMerge(ctx, fromLockID, toLockID, amount)
+	}
+}
+
+func (h MultiLockupHooks) AfterLockMatured(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time) {
+	for i := range h {
+		h[i].AfterLockMatured(ctx, address, lockID, amount, lockDuration, unlockTime)
+	}
+}
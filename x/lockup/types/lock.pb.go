@@ -30,22 +30,26 @@ var _ = time.Kitchen
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // LockQueryType defines the type of the lock query that can
-// either be by duration or start time of the lock.
+// either be by duration or start time of the lock, or that matches
+// the unlocked balances of the denom rather than any lock.
 type LockQueryType int32
 
 const (
 	ByDuration LockQueryType = 0
 	ByTime     LockQueryType = 1
+	NoLock     LockQueryType = 2
 )
 
 var LockQueryType_name = map[int32]string{
 	0: "ByDuration",
 	1: "ByTime",
+	2: "NoLock",
 }
 
 var LockQueryType_value = map[string]int32{
 	"ByDuration": 0,
 	"ByTime":     1,
+	"NoLock":     2,
 }
 
 func (x LockQueryType) String() string {
@@ -152,7 +156,8 @@ func (m *PeriodLock) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
 // Duration field and timestamp fields could be optional, depending on the
 // LockQueryType.
 type QueryCondition struct {
-	// LockQueryType is a type of lock query, ByLockDuration | ByLockTime
+	// LockQueryType is a type of lock query, ByLockDuration | ByLockTime |
+	// NoLock
 	LockQueryType LockQueryType `protobuf:"varint,1,opt,name=lock_query_type,json=lockQueryType,proto3,enum=osmosis.lockup.LockQueryType" json:"lock_query_type,omitempty"`
 	// Denom represents the token denomination we are looking to lock up
 	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
@@ -317,45 +322,45 @@ func init() {
 func init() { proto.RegisterFile("osmosis/lockup/lock.proto", fileDescriptor_7e9d7527a237b489) }
 
 var fileDescriptor_7e9d7527a237b489 = []byte{
-	// 596 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x3f, 0x6f, 0xd3, 0x4e,
-	0x18, 0xb6, 0x9d, 0xa4, 0xbf, 0xf6, 0xfa, 0x4b, 0x1a, 0x9d, 0x3a, 0xa4, 0x01, 0xec, 0xc8, 0x03,
-	0x8a, 0x50, 0x6b, 0x93, 0xb0, 0x31, 0xba, 0x61, 0x88, 0xd4, 0x01, 0x4c, 0xc5, 0xc0, 0x12, 0xf9,
-	0xcf, 0xe1, 0x9c, 0x62, 0xfb, 0x8c, 0xff, 0x14, 0xfc, 0x0d, 0x18, 0x3b, 0x82, 0xc4, 0xc6, 0xc6,
-	0x27, 0xe9, 0xd8, 0x91, 0x29, 0x45, 0x89, 0x58, 0x18, 0xfb, 0x09, 0xd0, 0xdd, 0xf9, 0x92, 0xb4,
-	0x08, 0xa9, 0x03, 0x4c, 0xe7, 0xf7, 0x9e, 0xf7, 0x7d, 0xde, 0xf7, 0x9e, 0xf7, 0x91, 0xc1, 0x01,
-	0xc9, 0x22, 0x92, 0xe1, 0xcc, 0x0c, 0x89, 0x37, 0x2b, 0x12, 0x76, 0x18, 0x49, 0x4a, 0x72, 0x02,
-	0x5b, 0x15, 0x64, 0x70, 0xa8, 0xbb, 0x1f, 0x90, 0x80, 0x30, 0xc8, 0xa4, 0x5f, 0x3c, 0xab, 0xab,
-	0x06, 0x84, 0x04, 0x21, 0x32, 0x59, 0xe4, 0x16, 0x6f, 0x4c, 0xbf, 0x48, 0x9d, 0x1c, 0x93, 0xb8,
-	0xc2, 0xb5, 0xdb, 0x78, 0x8e, 0x23, 0x94, 0xe5, 0x4e, 0x94, 0x08, 0x02, 0x8f, 0xf5, 0x31, 0x5d,
-	0x27, 0x43, 0xe6, 0xd9, 0xc0, 0x45, 0xb9, 0x33, 0x30, 0x3d, 0x82, 0x2b, 0x02, 0xfd, 0x87, 0x02,
-	0xc0, 0x73, 0x94, 0x62, 0xe2, 0x9f, 0x10, 0x6f, 0x06, 0x5b, 0x40, 0x19, 0x8f, 0x3a, 0x72, 0x4f,
-	0xee, 0xd7, 0x6d, 0x65, 0x3c, 0x82, 0x0f, 0x41, 0x83, 0xbc, 0x8b, 0x51, 0xda, 0x51, 0x7a, 0x72,
-	0x7f, 0xc7, 0x6a, 0x5f, 0xcf, 0xb5, 0xff, 0x4b, 0x27, 0x0a, 0x9f, 0xea, 0xec, 0x5a, 0xb7, 0x39,
-	0x0c, 0xa7, 0x60, 0x5b, 0x4c, 0xd6, 0xa9, 0xf5, 0xe4, 0xfe, 0xee, 0xf0, 0xc0, 0xe0, 0xa3, 0x19,
-	0x62, 0x34, 0x63, 0x54, 0x25, 0x58, 0x83, 0x8b, 0xb9, 0x26, 0xfd, 0x9c, 0x6b, 0x50, 0x94, 0x1c,
-	0x92, 0x08, 0xe7, 0x28, 0x4a, 0xf2, 0xf2, 0x7a, 0xae, 0xed, 0x71, 0x7e, 0x81, 0xe9, 0x1f, 0xaf,
-	0x34, 0xd9, 0x5e, 0xb1, 0x43, 0x1b, 0x6c, 0xa3, 0xd8, 0x9f, 0xd0, 0x77, 0x76, 0xea, 0xac, 0x53,
-	0xf7, 0xb7, 0x4e, 0xa7, 0x42, 0x04, 0xeb, 0x1e, 0x6d, 0xb5, 0x26, 0x15, 0x95, 0xfa, 0x39, 0x25,
-	0xfd, 0x0f, 0xc5, 0x3e, 0x4d, 0x85, 0x0e, 0x68, 0x50, 0x49, 0xb2, 0x4e, 0xa3, 0x57, 0x63, 0xa3,
-	0x73, 0xd1, 0x0c, 0x2a, 0x9a, 0x51, 0x89, 0x66, 0x1c, 0x13, 0x1c, 0x5b, 0x8f, 0x29, 0xdf, 0xd7,
-	0x2b, 0xad, 0x1f, 0xe0, 0x7c, 0x5a, 0xb8, 0x86, 0x47, 0x22, 0xb3, 0x52, 0x98, 0x1f, 0x47, 0x99,
-	0x3f, 0x33, 0xf3, 0x32, 0x41, 0x19, 0x2b, 0xc8, 0x6c, 0xce, 0xac, 0x7f, 0x52, 0x40, 0xeb, 0x45,
-	0x81, 0xd2, 0xf2, 0x98, 0xc4, 0x3e, 0x66, 0x2f, 0x79, 0x06, 0xf6, 0xe8, 0xee, 0x27, 0x6f, 0xe9,
-	0xf5, 0x84, 0xd6, 0x30, 0xe1, 0x5b, 0xc3, 0x07, 0xc6, 0x4d, 0x6f, 0x18, 0x74, 0x35, 0xac, 0xf8,
-	0xb4, 0x4c, 0x90, 0xdd, 0x0c, 0x37, 0x43, 0xb8, 0x0f, 0x1a, 0x3e, 0x8a, 0x49, 0xc4, 0x57, 0x64,
-	0xf3, 0x80, 0xca, 0x74, 0xf7, 0x85, 0xdc, 0x52, 0xe9, 0x4f, 0xd2, 0xbf, 0x02, 0x3b, 0x2b, 0x7b,
-	0xdd, 0x41, 0xfb, 0xfb, 0x15, 0x6b, 0x9b, 0xb3, 0xae, 0x4a, 0xb9, 0xf8, 0x6b, 0x2a, 0xfd, 0xb3,
-	0x02, 0x9a, 0x2f, 0xcb, 0x38, 0x9f, 0xa2, 0x1c, 0x7b, 0xcc, 0x86, 0x87, 0x00, 0x16, 0xb1, 0x8f,
-	0xd2, 0xb0, 0xc4, 0x71, 0x30, 0x61, 0x2a, 0x61, 0xbf, 0xb2, 0x65, 0x7b, 0x8d, 0xd0, 0xdc, 0xb1,
-	0x0f, 0x35, 0xb0, 0x9b, 0xd1, 0xf2, 0xc9, 0xa6, 0x0e, 0x80, 0x5d, 0x8d, 0x84, 0x18, 0x2b, 0xcf,
-	0xd4, 0xfe, 0x92, 0x67, 0x36, 0x1d, 0x5f, 0xff, 0x97, 0x8e, 0x7f, 0x34, 0x00, 0xcd, 0x1b, 0x06,
-	0x80, 0x2d, 0x00, 0xac, 0x52, 0x70, 0xb7, 0x25, 0x08, 0xc0, 0x96, 0x55, 0xd2, 0xa1, 0xda, 0x72,
-	0xb7, 0xfe, 0xe1, 0x8b, 0x2a, 0x59, 0x27, 0x17, 0x0b, 0x55, 0xbe, 0x5c, 0xa8, 0xf2, 0xf7, 0x85,
-	0x2a, 0x9f, 0x2f, 0x55, 0xe9, 0x72, 0xa9, 0x4a, 0xdf, 0x96, 0xaa, 0xf4, 0x7a, 0xb8, 0x61, 0xdc,
-	0xca, 0x65, 0x47, 0xa1, 0xe3, 0x66, 0x22, 0x30, 0xcf, 0x06, 0x43, 0xf3, 0xbd, 0xf8, 0x5f, 0x31,
-	0x23, 0xbb, 0x5b, 0xec, 0x41, 0x4f, 0x7e, 0x05, 0x00, 0x00, 0xff, 0xff, 0x72, 0x1a, 0xe9, 0x52,
-	0xce, 0x04, 0x00, 0x00,
+	// 600 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0xb6, 0x9d, 0xa4, 0xb4, 0x57, 0x92, 0x5a, 0xa7, 0x0e, 0x69, 0x00, 0x3b, 0xf2, 0x80, 0x22,
+	0xd4, 0xda, 0x24, 0x6c, 0x48, 0x2c, 0x6e, 0x18, 0x22, 0x55, 0x08, 0x4c, 0xc5, 0xc0, 0x12, 0xf9,
+	0xc7, 0xe1, 0x9c, 0x62, 0xfb, 0x8c, 0x7f, 0x14, 0xfc, 0x1f, 0x30, 0x76, 0x04, 0x89, 0x8d, 0x8d,
+	0xbf, 0xa4, 0x63, 0x47, 0xa6, 0x14, 0x25, 0x62, 0x61, 0xec, 0x5f, 0x80, 0xee, 0xce, 0x4e, 0xd2,
+	0x22, 0xa4, 0x0e, 0x74, 0xf2, 0xbd, 0xfb, 0xde, 0xfb, 0xde, 0xbb, 0xef, 0x7d, 0x32, 0xd8, 0x23,
+	0x69, 0x48, 0x52, 0x9c, 0x1a, 0x01, 0x71, 0xa7, 0x79, 0xcc, 0x3e, 0x7a, 0x9c, 0x90, 0x8c, 0xc0,
+	0x56, 0x09, 0xe9, 0x1c, 0xea, 0xec, 0xfa, 0xc4, 0x27, 0x0c, 0x32, 0xe8, 0x89, 0x67, 0x75, 0x14,
+	0x9f, 0x10, 0x3f, 0x40, 0x06, 0x8b, 0x9c, 0xfc, 0x9d, 0xe1, 0xe5, 0x89, 0x9d, 0x61, 0x12, 0x95,
+	0xb8, 0x7a, 0x1d, 0xcf, 0x70, 0x88, 0xd2, 0xcc, 0x0e, 0xe3, 0x8a, 0xc0, 0x65, 0x7d, 0x0c, 0xc7,
+	0x4e, 0x91, 0x71, 0xd2, 0x77, 0x50, 0x66, 0xf7, 0x0d, 0x97, 0xe0, 0x92, 0x40, 0xfb, 0x25, 0x01,
+	0xf0, 0x12, 0x25, 0x98, 0x78, 0x47, 0xc4, 0x9d, 0xc2, 0x16, 0x90, 0x46, 0xc3, 0xb6, 0xd8, 0x15,
+	0x7b, 0x75, 0x4b, 0x1a, 0x0d, 0xe1, 0x43, 0xd0, 0x20, 0x1f, 0x22, 0x94, 0xb4, 0xa5, 0xae, 0xd8,
+	0xdb, 0x32, 0xe5, 0xcb, 0x99, 0x7a, 0xb7, 0xb0, 0xc3, 0xe0, 0xa9, 0xc6, 0xae, 0x35, 0x8b, 0xc3,
+	0x70, 0x02, 0x36, 0xab, 0xc9, 0xda, 0xb5, 0xae, 0xd8, 0xdb, 0x1e, 0xec, 0xe9, 0x7c, 0x34, 0xbd,
+	0x1a, 0x4d, 0x1f, 0x96, 0x09, 0x66, 0xff, 0x6c, 0xa6, 0x0a, 0xbf, 0x67, 0x2a, 0xac, 0x4a, 0xf6,
+	0x49, 0x88, 0x33, 0x14, 0xc6, 0x59, 0x71, 0x39, 0x53, 0x77, 0x38, 0x7f, 0x85, 0x69, 0x9f, 0x2f,
+	0x54, 0xd1, 0x5a, 0xb2, 0x43, 0x0b, 0x6c, 0xa2, 0xc8, 0x1b, 0xd3, 0x77, 0xb6, 0xeb, 0xac, 0x53,
+	0xe7, 0xaf, 0x4e, 0xc7, 0x95, 0x08, 0xe6, 0x3d, 0xda, 0x6a, 0x45, 0x5a, 0x55, 0x6a, 0xa7, 0x94,
+	0xf4, 0x0e, 0x8a, 0x3c, 0x9a, 0x0a, 0x6d, 0xd0, 0xa0, 0x92, 0xa4, 0xed, 0x46, 0xb7, 0xc6, 0x46,
+	0xe7, 0xa2, 0xe9, 0x54, 0x34, 0xbd, 0x14, 0x4d, 0x3f, 0x24, 0x38, 0x32, 0x1f, 0x53, 0xbe, 0xef,
+	0x17, 0x6a, 0xcf, 0xc7, 0xd9, 0x24, 0x77, 0x74, 0x97, 0x84, 0x46, 0xa9, 0x30, 0xff, 0x1c, 0xa4,
+	0xde, 0xd4, 0xc8, 0x8a, 0x18, 0xa5, 0xac, 0x20, 0xb5, 0x38, 0xb3, 0xf6, 0x45, 0x02, 0xad, 0x57,
+	0x39, 0x4a, 0x8a, 0x43, 0x12, 0x79, 0x98, 0xbd, 0xe4, 0x39, 0xd8, 0xa1, 0xbb, 0x1f, 0xbf, 0xa7,
+	0xd7, 0x63, 0x5a, 0xc3, 0x84, 0x6f, 0x0d, 0x1e, 0xe8, 0x57, 0xbd, 0xa1, 0xd3, 0xd5, 0xb0, 0xe2,
+	0xe3, 0x22, 0x46, 0x56, 0x33, 0x58, 0x0f, 0xe1, 0x2e, 0x68, 0x78, 0x28, 0x22, 0x21, 0x5f, 0x91,
+	0xc5, 0x03, 0x2a, 0xd3, 0xcd, 0x17, 0x72, 0x4d, 0xa5, 0x7f, 0x49, 0xff, 0x06, 0x6c, 0x2d, 0xed,
+	0x75, 0x03, 0xed, 0xef, 0x97, 0xac, 0x32, 0x67, 0x5d, 0x96, 0x72, 0xf1, 0x57, 0x54, 0xda, 0x57,
+	0x09, 0x34, 0x5f, 0x17, 0x51, 0x36, 0x41, 0x19, 0x76, 0x99, 0x0d, 0xf7, 0x01, 0xcc, 0x23, 0x0f,
+	0x25, 0x41, 0x81, 0x23, 0x7f, 0xcc, 0x54, 0xc2, 0x5e, 0x69, 0x4b, 0x79, 0x85, 0xd0, 0xdc, 0x91,
+	0x07, 0x55, 0xb0, 0x9d, 0xd2, 0xf2, 0xf1, 0xba, 0x0e, 0x80, 0x5d, 0x0d, 0x2b, 0x31, 0x96, 0x9e,
+	0xa9, 0xfd, 0x27, 0xcf, 0xac, 0x3b, 0xbe, 0x7e, 0x9b, 0x8e, 0x7f, 0xf4, 0x0c, 0x34, 0xaf, 0x18,
+	0x00, 0xb6, 0x00, 0x30, 0x8b, 0x8a, 0x5b, 0x16, 0x20, 0x00, 0x1b, 0x66, 0x41, 0x87, 0x92, 0x45,
+	0x7a, 0x7e, 0x41, 0x68, 0xba, 0x2c, 0x75, 0xea, 0x9f, 0xbe, 0x29, 0x82, 0x79, 0x74, 0x36, 0x57,
+	0xc4, 0xf3, 0xb9, 0x22, 0xfe, 0x9c, 0x2b, 0xe2, 0xe9, 0x42, 0x11, 0xce, 0x17, 0x8a, 0xf0, 0x63,
+	0xa1, 0x08, 0x6f, 0x07, 0x6b, 0x26, 0x2e, 0x1d, 0x77, 0x10, 0xd8, 0x4e, 0x5a, 0x05, 0xc6, 0x49,
+	0x7f, 0x60, 0x7c, 0xac, 0xfe, 0x5d, 0xcc, 0xd4, 0xce, 0x06, 0x7b, 0xdc, 0x93, 0x3f, 0x03, 0x00,
+	0xe0, 0xd4, 0xca, 0x7b, 0xda, 0x04, 0x00, 0x00,
 }
 
 func (m *PeriodLock) Marshal() (dAtA []byte, err error) {
@@ -14,6 +14,9 @@ const (
 	TypeMsgBeginUnlockingAll = "begin_unlocking_all"
 	TypeMsgBeginUnlocking    = "begin_unlocking"
 	TypeMsgExtendLockup      = "edit_lockup"
+	TypeMsgTransferLock      = "transfer_lock"
+	TypeMsgMergeLocks        = "merge_locks"
+	TypeMsgEarlyUnlock       = "early_unlock"
 )
 
 var _ sdk.Msg = &MsgLockTokens{}
@@ -165,3 +168,124 @@ func (m MsgExtendLockup) GetSigners() []sdk.AccAddress {
 	owner, _ := sdk.AccAddressFromBech32(m.Owner)
 	return []sdk.AccAddress{owner}
 }
+
+var _ sdk.Msg = &MsgTransferLock{}
+
+// NewMsgTransferLock creates a message to transfer the ownership of a lock.
+func NewMsgTransferLock(owner sdk.AccAddress, id uint64, newOwner sdk.AccAddress, allowSyntheticLockups bool) *MsgTransferLock {
+	return &MsgTransferLock{
+		Owner:                 owner.String(),
+		ID:                    id,
+		NewOwner:              newOwner.String(),
+		AllowSyntheticLockups: allowSyntheticLockups,
+	}
+}
+
+func (m MsgTransferLock) Route() string { return RouterKey }
+func (m MsgTransferLock) Type() string  { return TypeMsgTransferLock }
+func (m MsgTransferLock) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Owner)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid owner address (%s)", err)
+	}
+	_, err = sdk.AccAddressFromBech32(m.NewOwner)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid new owner address (%s)", err)
+	}
+	if m.Owner == m.NewOwner {
+		return fmt.Errorf("new owner should be different from the owner")
+	}
+	if m.ID == 0 {
+		return fmt.Errorf("invalid lockup ID, got %v", m.ID)
+	}
+	return nil
+}
+
+func (m MsgTransferLock) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgTransferLock) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{owner}
+}
+
+var _ sdk.Msg = &MsgMergeLocks{}
+
+// NewMsgMergeLocks creates a message to merge a lock into another lock.
+func NewMsgMergeLocks(owner sdk.AccAddress, fromLockID, toLockID uint64) *MsgMergeLocks {
+	return &MsgMergeLocks{
+		Owner:      owner.String(),
+		FromLockId: fromLockID,
+		ToLockId:   toLockID,
+	}
+}
+
+func (m MsgMergeLocks) Route() string { return RouterKey }
+func (m MsgMergeLocks) Type() string  { return TypeMsgMergeLocks }
+func (m MsgMergeLocks) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Owner)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid owner address (%s)", err)
+	}
+	if m.FromLockId == 0 || m.ToLockId == 0 {
+		return fmt.Errorf("invalid lockup IDs, got %v and %v", m.FromLockId, m.ToLockId)
+	}
+	if m.FromLockId == m.ToLockId {
+		return fmt.Errorf("cannot merge lock %v into itself", m.FromLockId)
+	}
+	return nil
+}
+
+func (m MsgMergeLocks) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgMergeLocks) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{owner}
+}
+
+var _ sdk.Msg = &MsgEarlyUnlock{}
+
+// NewMsgEarlyUnlock creates a message to unlock coins of a lock before it matures.
+func NewMsgEarlyUnlock(owner sdk.AccAddress, id uint64, coins sdk.Coins) *MsgEarlyUnlock {
+	return &MsgEarlyUnlock{
+		Owner: owner.String(),
+		ID:    id,
+		Coins: coins,
+	}
+}
+
+func (m MsgEarlyUnlock) Route() string { return RouterKey }
+func (m MsgEarlyUnlock) Type() string  { return TypeMsgEarlyUnlock }
+func (m MsgEarlyUnlock) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Owner)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid owner address (%s)", err)
+	}
+
+	if m.ID == 0 {
+		return fmt.Errorf("invalid lockup ID, got %v", m.ID)
+	}
+
+	// only allow unlocks with a single denom or empty
+	if m.Coins.Len() > 1 {
+		return fmt.Errorf("can only unlock one denom per lock ID, got %v", m.Coins)
+	}
+
+	if !m.Coins.Empty() && !m.Coins.IsAllPositive() {
+		return fmt.Errorf("cannot unlock a zero or negative amount")
+	}
+
+	return nil
+}
+
+func (m MsgEarlyUnlock) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgEarlyUnlock) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{owner}
+}
@@ -262,6 +262,209 @@ func TestMsgExtendLockup(t *testing.T) {
 	}
 }
 
+func TestMsgTransferLock(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	addr1, invalidAddr := apptesting.GenerateTestAddrs()
+	addr2 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+
+	tests := []struct {
+		name       string
+		msg        types.MsgTransferLock
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgTransferLock{
+				Owner:    addr1,
+				ID:       1,
+				NewOwner: addr2,
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid owner",
+			msg: types.MsgTransferLock{
+				Owner:    invalidAddr,
+				ID:       1,
+				NewOwner: addr2,
+			},
+		},
+		{
+			name: "invalid new owner",
+			msg: types.MsgTransferLock{
+				Owner:    addr1,
+				ID:       1,
+				NewOwner: invalidAddr,
+			},
+		},
+		{
+			name: "new owner is the owner",
+			msg: types.MsgTransferLock{
+				Owner:    addr1,
+				ID:       1,
+				NewOwner: addr1,
+			},
+		},
+		{
+			name: "invalid lockup ID",
+			msg: types.MsgTransferLock{
+				Owner:    addr1,
+				ID:       0,
+				NewOwner: addr2,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.expectPass {
+				require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+				require.Equal(t, test.msg.Route(), types.RouterKey)
+				require.Equal(t, test.msg.Type(), "transfer_lock")
+				signers := test.msg.GetSigners()
+				require.Equal(t, len(signers), 1)
+				require.Equal(t, signers[0].String(), addr1)
+			} else {
+				require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+			}
+		})
+	}
+}
+
+func TestMsgMergeLocks(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	addr1, invalidAddr := apptesting.GenerateTestAddrs()
+
+	tests := []struct {
+		name       string
+		msg        types.MsgMergeLocks
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgMergeLocks{
+				Owner:      addr1,
+				FromLockId: 1,
+				ToLockId:   2,
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid owner",
+			msg: types.MsgMergeLocks{
+				Owner:      invalidAddr,
+				FromLockId: 1,
+				ToLockId:   2,
+			},
+		},
+		{
+			name: "invalid lockup ID",
+			msg: types.MsgMergeLocks{
+				Owner:      addr1,
+				FromLockId: 0,
+				ToLockId:   2,
+			},
+		},
+		{
+			name: "same lockup IDs",
+			msg: types.MsgMergeLocks{
+				Owner:      addr1,
+				FromLockId: 1,
+				ToLockId:   1,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.expectPass {
+				require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+				require.Equal(t, test.msg.Route(), types.RouterKey)
+				require.Equal(t, test.msg.Type(), "merge_locks")
+				signers := test.msg.GetSigners()
+				require.Equal(t, len(signers), 1)
+				require.Equal(t, signers[0].String(), addr1)
+			} else {
+				require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+			}
+		})
+	}
+}
+
+func TestMsgEarlyUnlock(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	addr1, invalidAddr := apptesting.GenerateTestAddrs()
+
+	tests := []struct {
+		name       string
+		msg        types.MsgEarlyUnlock
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgEarlyUnlock{
+				Owner: addr1,
+				ID:    1,
+				Coins: sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+			},
+			expectPass: true,
+		},
+		{
+			name: "proper msg without coins",
+			msg: types.MsgEarlyUnlock{
+				Owner: addr1,
+				ID:    1,
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid owner",
+			msg: types.MsgEarlyUnlock{
+				Owner: invalidAddr,
+				ID:    1,
+			},
+		},
+		{
+			name: "invalid lockup ID",
+			msg: types.MsgEarlyUnlock{
+				Owner: addr1,
+				ID:    0,
+			},
+		},
+		{
+			name: "multiple denoms",
+			msg: types.MsgEarlyUnlock{
+				Owner: addr1,
+				ID:    1,
+				Coins: sdk.NewCoins(sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("foo", 100)),
+			},
+		},
+		{
+			name: "zero amount",
+			msg: types.MsgEarlyUnlock{
+				Owner: addr1,
+				ID:    1,
+				Coins: sdk.Coins{sdk.NewInt64Coin("stake", 0)},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.expectPass {
+				require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+				require.Equal(t, test.msg.Route(), types.RouterKey)
+				require.Equal(t, test.msg.Type(), "early_unlock")
+				signers := test.msg.GetSigners()
+				require.Equal(t, len(signers), 1)
+				require.Equal(t, signers[0].String(), addr1)
+			} else {
+				require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+			}
+		})
+	}
+}
+
 // // Test authz serialize and de-serializes for lockup msg.
 func TestAuthzMsg(t *testing.T) {
 	pk1 := ed25519.GenPrivKey().PubKey()
@@ -299,6 +502,30 @@ func TestAuthzMsg(t *testing.T) {
 				Owner: addr1,
 			},
 		},
+		{
+			name: "MsgTransferLock",
+			msg: &types.MsgTransferLock{
+				Owner:    addr1,
+				ID:       1,
+				NewOwner: sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String(),
+			},
+		},
+		{
+			name: "MsgMergeLocks",
+			msg: &types.MsgMergeLocks{
+				Owner:      addr1,
+				FromLockId: 1,
+				ToLockId:   2,
+			},
+		},
+		{
+			name: "MsgEarlyUnlock",
+			msg: &types.MsgEarlyUnlock{
+				Owner: addr1,
+				ID:    1,
+				Coins: sdk.NewCoins(coin),
+			},
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -0,0 +1,83 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Parameter store keys.
+var (
+	KeyEarlyUnlockEnabled     = []byte("EarlyUnlockEnabled")
+	KeyEarlyUnlockPenalty     = []byte("EarlyUnlockPenalty")
+	KeyBurnEarlyUnlockPenalty = []byte("BurnEarlyUnlockPenalty")
+)
+
+// ParamKeyTable for lockup module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func NewParams(earlyUnlockEnabled bool, earlyUnlockPenalty sdk.Dec, burnEarlyUnlockPenalty bool) Params {
+	return Params{
+		EarlyUnlockEnabled:     earlyUnlockEnabled,
+		EarlyUnlockPenalty:     earlyUnlockPenalty,
+		BurnEarlyUnlockPenalty: burnEarlyUnlockPenalty,
+	}
+}
+
+// default lockup module parameters.
+func DefaultParams() Params {
+	return Params{
+		EarlyUnlockEnabled:     false,
+		EarlyUnlockPenalty:     sdk.NewDecWithPrec(25, 2), // 25%
+		BurnEarlyUnlockPenalty: false,
+	}
+}
+
+// validate params.
+func (p Params) Validate() error {
+	if err := validateBool(p.EarlyUnlockEnabled); err != nil {
+		return err
+	}
+	if err := validateEarlyUnlockPenalty(p.EarlyUnlockPenalty); err != nil {
+		return err
+	}
+	if err := validateBool(p.BurnEarlyUnlockPenalty); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Implements params.ParamSet.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyEarlyUnlockEnabled, &p.EarlyUnlockEnabled, validateBool),
+		paramtypes.NewParamSetPair(KeyEarlyUnlockPenalty, &p.EarlyUnlockPenalty, validateEarlyUnlockPenalty),
+		paramtypes.NewParamSetPair(KeyBurnEarlyUnlockPenalty, &p.BurnEarlyUnlockPenalty, validateBool),
+	}
+}
+
+func validateBool(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateEarlyUnlockPenalty(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("early unlock penalty must be between 0 and 1: %s", v)
+	}
+
+	return nil
+}
@@ -1528,6 +1528,337 @@ func (m *AccountLockedLongerDurationDenomResponse) GetLocks() []PeriodLock {
 	return nil
 }
 
+type AccountUnlockScheduleRequest struct {
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+}
+
+func (m *AccountUnlockScheduleRequest) Reset()         { *m = AccountUnlockScheduleRequest{} }
+func (m *AccountUnlockScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*AccountUnlockScheduleRequest) ProtoMessage()    {}
+func (*AccountUnlockScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e906fda01cffd91a, []int{32}
+}
+func (m *AccountUnlockScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountUnlockScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountUnlockScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountUnlockScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountUnlockScheduleRequest.Merge(m, src)
+}
+func (m *AccountUnlockScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountUnlockScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountUnlockScheduleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountUnlockScheduleRequest proto.InternalMessageInfo
+
+func (m *AccountUnlockScheduleRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+type AccountUnlockScheduleResponse struct {
+	// unlocking coins in ascending order of unlock time
+	Schedule []UnlockScheduleEntry `protobuf:"bytes,1,rep,name=schedule,proto3" json:"schedule"`
+}
+
+func (m *AccountUnlockScheduleResponse) Reset()         { *m = AccountUnlockScheduleResponse{} }
+func (m *AccountUnlockScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*AccountUnlockScheduleResponse) ProtoMessage()    {}
+func (*AccountUnlockScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e906fda01cffd91a, []int{33}
+}
+func (m *AccountUnlockScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountUnlockScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountUnlockScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountUnlockScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountUnlockScheduleResponse.Merge(m, src)
+}
+func (m *AccountUnlockScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountUnlockScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountUnlockScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountUnlockScheduleResponse proto.InternalMessageInfo
+
+func (m *AccountUnlockScheduleResponse) GetSchedule() []UnlockScheduleEntry {
+	if m != nil {
+		return m.Schedule
+	}
+	return nil
+}
+
+// UnlockScheduleEntry is the coins of the locks that finish unlocking at
+// end_time.
+type UnlockScheduleEntry struct {
+	EndTime time.Time                                `protobuf:"bytes,1,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time" yaml:"end_time"`
+	Coins   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+	LockIds []uint64                                 `protobuf:"varint,3,rep,packed,name=lock_ids,json=lockIds,proto3" json:"lock_ids,omitempty" yaml:"lock_ids"`
+}
+
+func (m *UnlockScheduleEntry) Reset()         { *m = UnlockScheduleEntry{} }
+func (m *UnlockScheduleEntry) String() string { return proto.CompactTextString(m) }
+func (*UnlockScheduleEntry) ProtoMessage()    {}
+func (*UnlockScheduleEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e906fda01cffd91a, []int{34}
+}
+func (m *UnlockScheduleEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnlockScheduleEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnlockScheduleEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnlockScheduleEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnlockScheduleEntry.Merge(m, src)
+}
+func (m *UnlockScheduleEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnlockScheduleEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnlockScheduleEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnlockScheduleEntry proto.InternalMessageInfo
+
+func (m *UnlockScheduleEntry) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
+func (m *UnlockScheduleEntry) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Coins
+	}
+	return nil
+}
+
+func (m *UnlockScheduleEntry) GetLockIds() []uint64 {
+	if m != nil {
+		return m.LockIds
+	}
+	return nil
+}
+
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e906fda01cffd91a, []int{35}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e906fda01cffd91a, []int{36}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+type EstimateEarlyUnlockRequest struct {
+	LockId uint64 `protobuf:"varint,1,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
+}
+
+func (m *EstimateEarlyUnlockRequest) Reset()         { *m = EstimateEarlyUnlockRequest{} }
+func (m *EstimateEarlyUnlockRequest) String() string { return proto.CompactTextString(m) }
+func (*EstimateEarlyUnlockRequest) ProtoMessage()    {}
+func (*EstimateEarlyUnlockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e906fda01cffd91a, []int{37}
+}
+func (m *EstimateEarlyUnlockRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EstimateEarlyUnlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EstimateEarlyUnlockRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EstimateEarlyUnlockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateEarlyUnlockRequest.Merge(m, src)
+}
+func (m *EstimateEarlyUnlockRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EstimateEarlyUnlockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateEarlyUnlockRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EstimateEarlyUnlockRequest proto.InternalMessageInfo
+
+func (m *EstimateEarlyUnlockRequest) GetLockId() uint64 {
+	if m != nil {
+		return m.LockId
+	}
+	return 0
+}
+
+type EstimateEarlyUnlockResponse struct {
+	// coins released to the owner of the lock
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// coins taken as the early unlock penalty
+	Penalty github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=penalty,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"penalty"`
+	// fraction of the unlocked coins taken as the penalty
+	PenaltyRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=penalty_rate,json=penaltyRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"penalty_rate" yaml:"penalty_rate"`
+}
+
+func (m *EstimateEarlyUnlockResponse) Reset()         { *m = EstimateEarlyUnlockResponse{} }
+func (m *EstimateEarlyUnlockResponse) String() string { return proto.CompactTextString(m) }
+func (*EstimateEarlyUnlockResponse) ProtoMessage()    {}
+func (*EstimateEarlyUnlockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e906fda01cffd91a, []int{38}
+}
+func (m *EstimateEarlyUnlockResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EstimateEarlyUnlockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EstimateEarlyUnlockResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EstimateEarlyUnlockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateEarlyUnlockResponse.Merge(m, src)
+}
+func (m *EstimateEarlyUnlockResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EstimateEarlyUnlockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateEarlyUnlockResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EstimateEarlyUnlockResponse proto.InternalMessageInfo
+
+func (m *EstimateEarlyUnlockResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *EstimateEarlyUnlockResponse) GetPenalty() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Penalty
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ModuleBalanceRequest)(nil), "osmosis.lockup.ModuleBalanceRequest")
 	proto.RegisterType((*ModuleBalanceResponse)(nil), "osmosis.lockup.ModuleBalanceResponse")
@@ -1561,102 +1892,128 @@ func init() {
 	proto.RegisterType((*AccountLockedLongerDurationNotUnlockingOnlyResponse)(nil), "osmosis.lockup.AccountLockedLongerDurationNotUnlockingOnlyResponse")
 	proto.RegisterType((*AccountLockedLongerDurationDenomRequest)(nil), "osmosis.lockup.AccountLockedLongerDurationDenomRequest")
 	proto.RegisterType((*AccountLockedLongerDurationDenomResponse)(nil), "osmosis.lockup.AccountLockedLongerDurationDenomResponse")
+	proto.RegisterType((*AccountUnlockScheduleRequest)(nil), "osmosis.lockup.AccountUnlockScheduleRequest")
+	proto.RegisterType((*AccountUnlockScheduleResponse)(nil), "osmosis.lockup.AccountUnlockScheduleResponse")
+	proto.RegisterType((*UnlockScheduleEntry)(nil), "osmosis.lockup.UnlockScheduleEntry")
+	proto.RegisterType((*QueryParamsRequest)(nil), "osmosis.lockup.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "osmosis.lockup.QueryParamsResponse")
+	proto.RegisterType((*EstimateEarlyUnlockRequest)(nil), "osmosis.lockup.EstimateEarlyUnlockRequest")
+	proto.RegisterType((*EstimateEarlyUnlockResponse)(nil), "osmosis.lockup.EstimateEarlyUnlockResponse")
 }
 
 func init() { proto.RegisterFile("osmosis/lockup/query.proto", fileDescriptor_e906fda01cffd91a) }
 
 var fileDescriptor_e906fda01cffd91a = []byte{
-	// 1426 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xce, 0xb4, 0x4d, 0xa1, 0xaf, 0xf4, 0x87, 0x86, 0xb6, 0x24, 0xdb, 0xd6, 0x4e, 0xb7, 0x6d,
-	0x30, 0x10, 0xef, 0x36, 0x6e, 0x95, 0x96, 0x2a, 0x6d, 0x52, 0xc7, 0x04, 0x05, 0x19, 0x68, 0xdd,
-	0x42, 0xc5, 0x2f, 0x59, 0x6b, 0x7b, 0xeb, 0xae, 0x62, 0xef, 0xb8, 0xde, 0x75, 0xc1, 0x54, 0xa5,
-	0xa2, 0xe5, 0xc8, 0xa1, 0x88, 0x0b, 0xe2, 0x80, 0x80, 0x1b, 0x1c, 0x10, 0x17, 0x0e, 0x15, 0x77,
-	0x54, 0x81, 0x84, 0x2a, 0x71, 0x41, 0x1c, 0x52, 0x94, 0xf0, 0x17, 0xe4, 0xc4, 0x11, 0xed, 0xcc,
-	0xec, 0xc6, 0xbb, 0xde, 0x5d, 0xef, 0xda, 0x24, 0xca, 0xc9, 0x3f, 0xe6, 0xcd, 0x7b, 0xdf, 0xf7,
-	0xcd, 0xdb, 0x99, 0xf9, 0x16, 0x04, 0x62, 0xd4, 0x89, 0xa1, 0x19, 0x72, 0x8d, 0x94, 0x17, 0x5b,
-	0x0d, 0xf9, 0x46, 0x4b, 0x6d, 0xb6, 0xa5, 0x46, 0x93, 0x98, 0x04, 0xef, 0xe6, 0x63, 0x12, 0x1b,
-	0x13, 0xf6, 0x55, 0x49, 0x95, 0xd0, 0x21, 0xd9, 0xfa, 0xc6, 0xa2, 0x84, 0x44, 0x99, 0x86, 0xc9,
-	0x25, 0xc5, 0x50, 0xe5, 0x9b, 0x93, 0x25, 0xd5, 0x54, 0x26, 0xe5, 0x32, 0xd1, 0x74, 0x3e, 0x7e,
-	0xa8, 0x4a, 0x48, 0xb5, 0xa6, 0xca, 0x4a, 0x43, 0x93, 0x15, 0x5d, 0x27, 0xa6, 0x62, 0x6a, 0x44,
-	0x37, 0xf8, 0x68, 0x92, 0x8f, 0xd2, 0x5f, 0xa5, 0xd6, 0x35, 0xd9, 0xd4, 0xea, 0xaa, 0x61, 0x2a,
-	0xf5, 0x86, 0x9d, 0xde, 0x1b, 0x50, 0x69, 0x35, 0x69, 0x06, 0x3e, 0x3e, 0xea, 0x21, 0x60, 0x7d,
-	0xb0, 0x21, 0xf1, 0x00, 0xec, 0x7b, 0x95, 0x54, 0x5a, 0x35, 0x35, 0xab, 0xd4, 0x14, 0xbd, 0xac,
-	0x16, 0xd4, 0x1b, 0x2d, 0xd5, 0x30, 0xc5, 0x0f, 0x61, 0xbf, 0xe7, 0x7f, 0xa3, 0x41, 0x74, 0x43,
-	0xc5, 0x0a, 0x0c, 0x5b, 0xc0, 0x8d, 0x11, 0x34, 0xb6, 0x35, 0xb5, 0x33, 0x33, 0x2a, 0x31, 0x6a,
-	0x92, 0x45, 0x4d, 0xe2, 0xd4, 0xa4, 0x39, 0xa2, 0xe9, 0xd9, 0x13, 0x0f, 0x97, 0x92, 0x43, 0xdf,
-	0x3f, 0x4e, 0xa6, 0xaa, 0x9a, 0x79, 0xbd, 0x55, 0x92, 0xca, 0xa4, 0x2e, 0x73, 0x1d, 0xd8, 0x47,
-	0xda, 0xa8, 0x2c, 0xca, 0x66, 0xbb, 0xa1, 0x1a, 0x74, 0x82, 0x51, 0x60, 0x99, 0xc5, 0x83, 0x30,
-	0xca, 0x6a, 0xe7, 0x49, 0x79, 0x51, 0xad, 0x5c, 0xa8, 0x93, 0x96, 0x6e, 0xda, 0xc0, 0xee, 0x80,
-	0xe0, 0x37, 0xb8, 0x71, 0xe8, 0x5e, 0x86, 0xc3, 0x17, 0xca, 0x65, 0xab, 0xea, 0x1b, 0xba, 0x25,
-	0xa4, 0x52, 0xaa, 0xa9, 0x2c, 0x80, 0x21, 0xc4, 0xe3, 0x30, 0x4c, 0xde, 0xd7, 0xd5, 0xe6, 0x08,
-	0x1a, 0x43, 0xa9, 0x1d, 0xd9, 0xbd, 0xab, 0x4b, 0xc9, 0xa7, 0xda, 0x4a, 0xbd, 0x76, 0x56, 0xa4,
-	0x7f, 0x8b, 0x05, 0x36, 0x2c, 0xde, 0x43, 0x90, 0x08, 0xca, 0xb4, 0x71, 0x74, 0xe6, 0xe1, 0x90,
-	0x0b, 0x84, 0xa6, 0x57, 0xfb, 0x62, 0x73, 0x17, 0x79, 0x74, 0x59, 0x4b, 0xb4, 0x71, 0x64, 0xe6,
-	0x60, 0x94, 0x63, 0x60, 0xdd, 0xd1, 0x17, 0x93, 0x3b, 0x20, 0xf8, 0x25, 0xd9, 0x38, 0x16, 0x5f,
-	0x21, 0x67, 0x4d, 0x18, 0x82, 0x8b, 0x8a, 0x61, 0x5e, 0xd1, 0xea, 0x6a, 0x4c, 0x26, 0xf8, 0x4d,
-	0xd8, 0xe1, 0x6c, 0x15, 0x23, 0x5b, 0xc6, 0x50, 0x6a, 0x67, 0x46, 0x90, 0xd8, 0x5e, 0x21, 0xd9,
-	0x7b, 0x85, 0x74, 0xc5, 0x8e, 0xc8, 0x1e, 0xb2, 0x00, 0xaf, 0x2e, 0x25, 0xf7, 0xb2, 0x5c, 0xce,
-	0x54, 0xf1, 0xfe, 0xe3, 0x24, 0x2a, 0xac, 0xa5, 0x12, 0xaf, 0x3a, 0x4b, 0xed, 0xc5, 0xc7, 0x45,
-	0x9a, 0x82, 0x61, 0xab, 0x05, 0x6c, 0x91, 0x04, 0xc9, 0xbd, 0x4b, 0x4a, 0x17, 0xd5, 0xa6, 0x46,
-	0x2a, 0xd6, 0xe4, 0xec, 0x36, 0xab, 0x68, 0x81, 0x85, 0x8b, 0x3f, 0x20, 0x98, 0xf0, 0xcd, 0xfc,
-	0x1a, 0x59, 0xeb, 0xaa, 0xd7, 0xf5, 0x5a, 0x7b, 0xb3, 0x28, 0x51, 0x85, 0x74, 0x44, 0xbc, 0x03,
-	0x2a, 0xf3, 0x2d, 0x82, 0x31, 0xd7, 0xe3, 0xa5, 0x56, 0xb2, 0xea, 0x35, 0xd2, 0x54, 0x37, 0x53,
-	0x5f, 0xbc, 0x03, 0x47, 0x42, 0x30, 0x0e, 0xa8, 0xc0, 0x03, 0xe4, 0x64, 0x77, 0x6b, 0x9d, 0x53,
-	0x75, 0x52, 0xdf, 0x24, 0x12, 0xe0, 0x7d, 0x30, 0x5c, 0xb1, 0xf0, 0x8c, 0x6c, 0xb5, 0xea, 0x17,
-	0xd8, 0x0f, 0xf1, 0x5d, 0x10, 0xc3, 0xa0, 0x0f, 0xa8, 0xcc, 0x47, 0x80, 0x59, 0x5a, 0x97, 0x12,
-	0x0e, 0x12, 0xd4, 0x81, 0x04, 0x17, 0xe0, 0x49, 0xfb, 0x72, 0xc0, 0x69, 0x8f, 0x76, 0xd1, 0xce,
-	0xf1, 0x80, 0xec, 0x41, 0xce, 0x7a, 0x0f, 0x63, 0x6d, 0x4f, 0x14, 0xbf, 0xb0, 0x48, 0x3b, 0x79,
-	0x44, 0x1d, 0x9e, 0x76, 0xd5, 0xe7, 0x74, 0xae, 0xc2, 0x76, 0x85, 0x9e, 0xce, 0x7c, 0x2d, 0x66,
-	0xac, 0x6c, 0x7f, 0x2d, 0x25, 0xc7, 0x23, 0xec, 0x87, 0x0b, 0xba, 0xb9, 0xba, 0x94, 0xdc, 0xc5,
-	0xea, 0xb2, 0x2c, 0x62, 0x81, 0xa7, 0x13, 0x53, 0xb0, 0x8b, 0xd5, 0xb3, 0xa9, 0x3e, 0x03, 0x4f,
-	0x58, 0x4a, 0x14, 0xb5, 0x0a, 0x2d, 0xb5, 0xad, 0xb0, 0xdd, 0xfa, 0xb9, 0x50, 0x11, 0x67, 0x61,
-	0xb7, 0x1d, 0xc9, 0x41, 0x49, 0xb0, 0xcd, 0x1a, 0xa3, 0x71, 0xa1, 0x12, 0x17, 0x68, 0x9c, 0x38,
-	0x0d, 0x47, 0x2e, 0xb7, 0x75, 0xf3, 0xba, 0x6a, 0x6a, 0xe5, 0x3c, 0x8d, 0x31, 0xb2, 0x6d, 0xf6,
-	0x65, 0x21, 0xd7, 0xb3, 0x7e, 0x13, 0xc4, 0xb0, 0xd9, 0x1c, 0x53, 0x1e, 0xf6, 0x18, 0x76, 0x54,
-	0xb1, 0xb3, 0x03, 0x0e, 0x7b, 0xe1, 0xb9, 0x92, 0xf1, 0x26, 0xd8, 0x6d, 0x74, 0xfe, 0x69, 0x88,
-	0x5f, 0x23, 0x4f, 0xb3, 0xe5, 0x89, 0x5e, 0x55, 0x9b, 0xf6, 0xa2, 0xc6, 0x7d, 0x50, 0xd6, 0xa3,
-	0x61, 0xde, 0x83, 0xa3, 0xa1, 0x08, 0x07, 0x7c, 0x1e, 0xbe, 0xf4, 0x9e, 0x9f, 0x9b, 0x89, 0xbb,
-	0xf7, 0xec, 0xfc, 0xdf, 0x58, 0xff, 0x88, 0x20, 0x13, 0xa2, 0xea, 0xa0, 0x27, 0xe8, 0x7a, 0x68,
-	0x51, 0x87, 0x93, 0xb1, 0x10, 0x0f, 0xa8, 0xd0, 0xcf, 0x08, 0x9e, 0x0d, 0xa9, 0xd7, 0xd7, 0x39,
-	0xb2, 0x0e, 0xb2, 0x04, 0x9c, 0x21, 0x25, 0x48, 0xf5, 0x06, 0x3f, 0x98, 0x42, 0x99, 0x8f, 0x47,
-	0x60, 0xf8, 0x92, 0xe5, 0x6e, 0xf1, 0xa7, 0x08, 0x76, 0xb9, 0x0c, 0x20, 0x3e, 0xe6, 0x4d, 0xe2,
-	0xe7, 0x1b, 0x85, 0xe3, 0x3d, 0xa2, 0x18, 0x40, 0x51, 0xba, 0xfb, 0xc7, 0x3f, 0x9f, 0x6f, 0x49,
-	0xe1, 0x71, 0xd9, 0x63, 0x4d, 0x6d, 0x73, 0x5c, 0xa7, 0xd3, 0x8a, 0x25, 0x5e, 0xfc, 0x1b, 0x04,
-	0xb8, 0xdb, 0xf6, 0xe1, 0xe7, 0xfc, 0xab, 0xf9, 0xf8, 0x46, 0xe1, 0xf9, 0x28, 0xa1, 0x1c, 0xdd,
-	0x29, 0x8a, 0x4e, 0xc2, 0x13, 0x3d, 0xd0, 0xb1, 0x3b, 0x4e, 0x91, 0x1d, 0x4b, 0xf8, 0x01, 0x82,
-	0x03, 0xfe, 0x7e, 0x0e, 0xa7, 0xbd, 0xc5, 0x43, 0x1d, 0xa4, 0x20, 0x45, 0x0d, 0xe7, 0x78, 0x67,
-	0x29, 0xde, 0xb3, 0xf8, 0x4c, 0x10, 0x5e, 0x85, 0xcd, 0x2f, 0xb6, 0x9c, 0x04, 0x45, 0x6a, 0x35,
-	0xe4, 0x5b, 0xb4, 0x8b, 0x6f, 0xe3, 0x9f, 0x10, 0xec, 0xf7, 0x75, 0x6f, 0x78, 0x22, 0x14, 0x8b,
-	0xc7, 0x2d, 0x0a, 0xe9, 0x88, 0xd1, 0x1c, 0xf8, 0x0c, 0x05, 0xfe, 0x22, 0x3e, 0x1d, 0x0d, 0xb8,
-	0xa6, 0x57, 0x3d, 0xb8, 0xbf, 0x43, 0x80, 0xbb, 0xcd, 0x5a, 0x77, 0x5f, 0x04, 0xba, 0xc2, 0xee,
-	0xbe, 0x08, 0xf6, 0x7e, 0xe2, 0x34, 0x85, 0x3b, 0x85, 0x4f, 0xf5, 0x82, 0xcb, 0x1b, 0x23, 0x50,
-	0x63, 0xf7, 0x2d, 0x30, 0x50, 0x63, 0x5f, 0xf7, 0x17, 0xa8, 0xb1, 0xbf, 0x17, 0x8b, 0xae, 0x31,
-	0x07, 0xdd, 0x50, 0x0c, 0xd3, 0xba, 0xcf, 0x3a, 0xb8, 0xff, 0x45, 0x70, 0x3c, 0x92, 0xc9, 0xc1,
-	0xd3, 0x91, 0x90, 0x05, 0x9c, 0x44, 0xc2, 0xb9, 0x3e, 0x67, 0x73, 0x9e, 0x05, 0xca, 0x33, 0x8f,
-	0x5f, 0x89, 0xc9, 0xb3, 0xa8, 0x93, 0xce, 0xfe, 0x22, 0x7a, 0xad, 0xed, 0x50, 0xff, 0x05, 0x39,
-	0x2f, 0x14, 0xba, 0x1d, 0x0d, 0x3e, 0x11, 0xda, 0xec, 0x3e, 0x06, 0x4d, 0x98, 0x8c, 0x31, 0x83,
-	0xd3, 0xca, 0x51, 0x5a, 0xe7, 0xf1, 0x74, 0xb4, 0x47, 0x44, 0xad, 0x14, 0x4b, 0x34, 0x49, 0xd1,
-	0xb5, 0x86, 0xbf, 0x22, 0xcf, 0x4b, 0x0d, 0x97, 0x03, 0xc1, 0x93, 0x91, 0xa4, 0xef, 0x3c, 0x20,
-	0x85, 0x4c, 0x9c, 0x29, 0x9c, 0xcb, 0x4b, 0x94, 0xcb, 0x0c, 0x3e, 0x17, 0x77, 0x89, 0xe8, 0x09,
-	0xe8, 0x90, 0xf9, 0x04, 0xc1, 0xce, 0x0e, 0xc3, 0x81, 0x45, 0x2f, 0x94, 0x6e, 0x37, 0x24, 0x1c,
-	0x0d, 0x8d, 0xe1, 0xf8, 0x26, 0x28, 0xbe, 0x71, 0x7c, 0x2c, 0x08, 0x1f, 0xc7, 0xc5, 0xac, 0xd4,
-	0x3d, 0x04, 0xc0, 0xb2, 0x64, 0xdb, 0x0b, 0x39, 0x7c, 0xd8, 0xbf, 0x82, 0x0d, 0x20, 0x11, 0x34,
-	0xcc, 0x6b, 0x4f, 0xd1, 0xda, 0x27, 0xb0, 0xd4, 0xa3, 0x76, 0xa9, 0x5d, 0xd4, 0x2a, 0xf2, 0x2d,
-	0xee, 0x37, 0x6e, 0xe3, 0xdf, 0x10, 0x08, 0xc1, 0x1e, 0xa3, 0x7b, 0x65, 0x7b, 0xba, 0x99, 0xee,
-	0x95, 0xed, 0x6d, 0x61, 0xc4, 0x79, 0x8a, 0x7e, 0x16, 0x9f, 0x0f, 0x42, 0xef, 0x36, 0x38, 0xad,
-	0x86, 0x61, 0x11, 0xe1, 0x24, 0x3a, 0xd8, 0xfc, 0x8e, 0xe0, 0x60, 0xc8, 0x2d, 0x07, 0x87, 0x77,
-	0x9d, 0xaf, 0xd3, 0x11, 0x4e, 0xc6, 0x9a, 0x13, 0x95, 0x90, 0xa7, 0x55, 0x6b, 0x34, 0x4d, 0xd1,
-	0xbe, 0xc3, 0x05, 0x6f, 0xfa, 0x0e, 0x95, 0xf0, 0x4d, 0xdf, 0x4b, 0x22, 0x1d, 0x31, 0xba, 0xcf,
-	0x4d, 0xbf, 0x0b, 0xf7, 0x67, 0x5b, 0xe0, 0x85, 0x18, 0x77, 0x73, 0x9c, 0x8d, 0x21, 0x72, 0xd0,
-	0x01, 0x30, 0x37, 0x50, 0x0e, 0xce, 0xfc, 0x2d, 0xca, 0xfc, 0x32, 0xbe, 0xd4, 0xdf, 0xc2, 0x85,
-	0x9d, 0x06, 0x2b, 0x6b, 0xef, 0xe0, 0x02, 0xaf, 0xe0, 0xf8, 0x74, 0x0c, 0x12, 0xae, 0x1d, 0xea,
-	0x4c, 0xfc, 0x89, 0x9c, 0x72, 0x9e, 0x52, 0x9e, 0xc7, 0xb9, 0x3e, 0x29, 0xbb, 0x76, 0xd7, 0x6c,
-	0xfe, 0xe1, 0x72, 0x02, 0x3d, 0x5a, 0x4e, 0xa0, 0xbf, 0x97, 0x13, 0xe8, 0xfe, 0x4a, 0x62, 0xe8,
-	0xd1, 0x4a, 0x62, 0xe8, 0xcf, 0x95, 0xc4, 0xd0, 0xdb, 0x99, 0x8e, 0x17, 0x37, 0xbc, 0x52, 0xba,
-	0xa6, 0x94, 0x0c, 0xa7, 0xec, 0xcd, 0xc9, 0x8c, 0xfc, 0x81, 0x5d, 0x9c, 0xbe, 0xc8, 0x29, 0x6d,
-	0xa7, 0x2e, 0xe8, 0xe4, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xc4, 0xbc, 0x1f, 0x4f, 0x44, 0x1b,
-	0x00, 0x00,
+	// 1733 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcd, 0x6f, 0x13, 0xd7,
+	0x16, 0xcf, 0xcd, 0x27, 0x9c, 0xf0, 0xa5, 0x9b, 0xc0, 0x4b, 0x26, 0x89, 0x1d, 0x26, 0x90, 0xe7,
+	0x07, 0xf1, 0x98, 0x18, 0x1e, 0xf0, 0x50, 0xf8, 0x32, 0x09, 0x4f, 0x69, 0xd3, 0x16, 0x0c, 0x2d,
+	0xea, 0x97, 0xac, 0xb1, 0x7d, 0x71, 0x2c, 0xec, 0x19, 0xe3, 0x19, 0xd3, 0xba, 0x88, 0x22, 0x41,
+	0x97, 0x5d, 0x50, 0x75, 0x53, 0x75, 0x51, 0xb5, 0xdd, 0xb5, 0x8b, 0xb6, 0x9b, 0x2e, 0x50, 0xf7,
+	0x15, 0x6a, 0xa5, 0x0a, 0x89, 0x4d, 0xd5, 0x45, 0xa8, 0x48, 0xd5, 0x3f, 0x20, 0xab, 0x2e, 0xba,
+	0xa8, 0xe6, 0xde, 0x3b, 0x13, 0xcf, 0x78, 0x66, 0x3c, 0x63, 0x93, 0x28, 0x2b, 0x7b, 0xe6, 0x9e,
+	0x8f, 0xdf, 0xef, 0xdc, 0x33, 0xf7, 0xdc, 0x73, 0x40, 0x50, 0xb5, 0xb2, 0xaa, 0x15, 0xb5, 0x44,
+	0x49, 0xcd, 0xdd, 0xa8, 0x55, 0x12, 0x37, 0x6b, 0xa4, 0x5a, 0x97, 0x2a, 0x55, 0x55, 0x57, 0xf1,
+	0x2e, 0xbe, 0x26, 0xb1, 0x35, 0x61, 0xb8, 0xa0, 0x16, 0x54, 0xba, 0x94, 0x30, 0xfe, 0x31, 0x29,
+	0x21, 0x92, 0xa3, 0x62, 0x89, 0xac, 0xac, 0x91, 0xc4, 0xad, 0xd9, 0x2c, 0xd1, 0xe5, 0xd9, 0x44,
+	0x4e, 0x2d, 0x2a, 0x7c, 0x7d, 0xbc, 0xa0, 0xaa, 0x85, 0x12, 0x49, 0xc8, 0x95, 0x62, 0x42, 0x56,
+	0x14, 0x55, 0x97, 0xf5, 0xa2, 0xaa, 0x68, 0x7c, 0x35, 0xca, 0x57, 0xe9, 0x53, 0xb6, 0x76, 0x3d,
+	0xa1, 0x17, 0xcb, 0x44, 0xd3, 0xe5, 0x72, 0xc5, 0x34, 0xef, 0x14, 0xc8, 0xd7, 0xaa, 0xd4, 0x02,
+	0x5f, 0x1f, 0x75, 0x10, 0x30, 0x7e, 0xf8, 0xd2, 0x98, 0x63, 0xa9, 0x22, 0x57, 0xe5, 0x32, 0x77,
+	0x2c, 0xee, 0x83, 0xe1, 0x97, 0xd4, 0x7c, 0xad, 0x44, 0x52, 0x72, 0x49, 0x56, 0x72, 0x24, 0x4d,
+	0x6e, 0xd6, 0x88, 0xa6, 0x8b, 0xef, 0xc1, 0x5e, 0xc7, 0x7b, 0xad, 0xa2, 0x2a, 0x1a, 0xc1, 0x32,
+	0xf4, 0x19, 0xac, 0xb4, 0x11, 0x34, 0xd9, 0x13, 0x1b, 0x4c, 0x8e, 0x4a, 0x8c, 0xb7, 0x64, 0xf0,
+	0x96, 0x38, 0x6f, 0xe9, 0x82, 0x5a, 0x54, 0x52, 0x47, 0x1e, 0xad, 0x44, 0xbb, 0xbe, 0x7e, 0x1a,
+	0x8d, 0x15, 0x8a, 0xfa, 0x72, 0x2d, 0x2b, 0xe5, 0xd4, 0x72, 0x82, 0x07, 0x89, 0xfd, 0xc4, 0xb5,
+	0xfc, 0x8d, 0x84, 0x5e, 0xaf, 0x10, 0x8d, 0x2a, 0x68, 0x69, 0x66, 0x59, 0x1c, 0x83, 0x51, 0xe6,
+	0x7b, 0x49, 0xcd, 0xdd, 0x20, 0xf9, 0xf3, 0x65, 0xb5, 0xa6, 0xe8, 0x26, 0xb0, 0xbb, 0x20, 0xb8,
+	0x2d, 0x6e, 0x1e, 0xba, 0xff, 0xc3, 0xc4, 0xf9, 0x5c, 0xce, 0xf0, 0xfa, 0xaa, 0x62, 0x44, 0x54,
+	0xce, 0x96, 0x08, 0x13, 0x60, 0x08, 0xf1, 0x34, 0xf4, 0xa9, 0xef, 0x28, 0xa4, 0x3a, 0x82, 0x26,
+	0x51, 0x6c, 0x7b, 0x6a, 0xcf, 0xda, 0x4a, 0x74, 0x47, 0x5d, 0x2e, 0x97, 0x4e, 0x89, 0xf4, 0xb5,
+	0x98, 0x66, 0xcb, 0xe2, 0x7d, 0x04, 0x11, 0x2f, 0x4b, 0x9b, 0x47, 0xe7, 0x22, 0x8c, 0xdb, 0x40,
+	0x14, 0x95, 0x42, 0x5b, 0x6c, 0xee, 0x21, 0x98, 0xf0, 0x30, 0xb4, 0x79, 0x64, 0x2e, 0xc0, 0x28,
+	0xc7, 0xc0, 0xb2, 0xa3, 0x2d, 0x26, 0x77, 0x41, 0x70, 0x33, 0xb2, 0x79, 0x2c, 0x3e, 0x43, 0x30,
+	0x6e, 0x43, 0x70, 0x49, 0xd6, 0xf4, 0xab, 0xc5, 0x32, 0x09, 0xc9, 0x04, 0xbf, 0x06, 0xdb, 0xad,
+	0x73, 0x64, 0xa4, 0x7b, 0x12, 0xc5, 0x06, 0x93, 0x82, 0xc4, 0x0e, 0x12, 0xc9, 0x3c, 0x48, 0xa4,
+	0xab, 0xa6, 0x44, 0x6a, 0xdc, 0x00, 0xbc, 0xb6, 0x12, 0xdd, 0xc3, 0x6c, 0x59, 0xaa, 0xe2, 0x83,
+	0xa7, 0x51, 0x94, 0x5e, 0x37, 0x25, 0x5e, 0x83, 0x09, 0x0f, 0x7c, 0x3c, 0x48, 0xc7, 0xa1, 0xcf,
+	0x48, 0x01, 0x33, 0x48, 0x82, 0x64, 0x3f, 0x42, 0xa5, 0x4b, 0xa4, 0x5a, 0x54, 0xf3, 0x86, 0x72,
+	0xaa, 0xd7, 0x70, 0x9a, 0x66, 0xe2, 0xe2, 0x37, 0x08, 0x66, 0x5c, 0x2d, 0xbf, 0xac, 0xae, 0x67,
+	0xd5, 0x2b, 0x4a, 0xa9, 0xbe, 0x55, 0x22, 0x51, 0x80, 0x78, 0x40, 0xbc, 0x1d, 0x46, 0xe6, 0x4b,
+	0x04, 0x93, 0xb6, 0xcf, 0x8b, 0xe4, 0x53, 0xe4, 0xba, 0x5a, 0x25, 0x5b, 0x29, 0x2f, 0xde, 0x84,
+	0xfd, 0x3e, 0x18, 0x3b, 0x8c, 0xc0, 0x43, 0x64, 0x59, 0xb7, 0xc7, 0x7a, 0x9e, 0x28, 0x6a, 0x79,
+	0x8b, 0x84, 0x00, 0x0f, 0x43, 0x5f, 0xde, 0xc0, 0x33, 0xd2, 0x63, 0xf8, 0x4f, 0xb3, 0x07, 0xf1,
+	0x2d, 0x10, 0xfd, 0xa0, 0x77, 0x18, 0x99, 0xf7, 0x01, 0x33, 0xb3, 0xb6, 0x48, 0x58, 0x48, 0x50,
+	0x03, 0x12, 0x9c, 0x86, 0x6d, 0xe6, 0xcd, 0x81, 0xd3, 0x1e, 0x6d, 0xa2, 0x3d, 0xcf, 0x05, 0x52,
+	0x63, 0x9c, 0xf5, 0x6e, 0xc6, 0xda, 0x54, 0x14, 0x3f, 0x31, 0x48, 0x5b, 0x76, 0x44, 0x05, 0x86,
+	0x6c, 0xfe, 0x39, 0x9d, 0x6b, 0xd0, 0x2f, 0xd3, 0xea, 0xcc, 0xf7, 0xe2, 0xac, 0x61, 0xed, 0xb7,
+	0x95, 0xe8, 0x74, 0x80, 0xf3, 0x70, 0x51, 0xd1, 0xd7, 0x56, 0xa2, 0x3b, 0x99, 0x5f, 0x66, 0x45,
+	0x4c, 0x73, 0x73, 0x62, 0x0c, 0x76, 0x32, 0x7f, 0x26, 0xd5, 0x7f, 0xc1, 0x80, 0x11, 0x89, 0x4c,
+	0x31, 0x4f, 0x5d, 0xf5, 0xa6, 0xfb, 0x8d, 0xc7, 0xc5, 0xbc, 0x78, 0x0e, 0x76, 0x99, 0x92, 0x1c,
+	0x94, 0x04, 0xbd, 0xc6, 0x1a, 0x95, 0xf3, 0x0d, 0x71, 0x9a, 0xca, 0x89, 0x73, 0xb0, 0xff, 0x4a,
+	0x5d, 0xd1, 0x97, 0x89, 0x5e, 0xcc, 0x2d, 0x51, 0x19, 0x2d, 0x55, 0x67, 0x7f, 0x16, 0xe7, 0x5b,
+	0xfa, 0xaf, 0x82, 0xe8, 0xa7, 0xcd, 0x31, 0x2d, 0xc1, 0x6e, 0xcd, 0x94, 0xca, 0x34, 0x66, 0xc0,
+	0x84, 0x13, 0x9e, 0xcd, 0x18, 0x4f, 0x82, 0x5d, 0x5a, 0xe3, 0x4b, 0x4d, 0xfc, 0x1c, 0x39, 0x92,
+	0x6d, 0x49, 0x55, 0x0a, 0xa4, 0x6a, 0x6e, 0x6a, 0xd8, 0x0f, 0x65, 0x23, 0x12, 0xe6, 0x6d, 0x98,
+	0xf2, 0x45, 0xd8, 0xe1, 0xf7, 0xf0, 0xa9, 0xb3, 0x7e, 0x6e, 0x25, 0xee, 0xce, 0xda, 0xf9, 0xdc,
+	0x58, 0x7f, 0x87, 0x20, 0xe9, 0x13, 0xd5, 0x4e, 0x2b, 0xe8, 0x46, 0xc4, 0xa2, 0x0c, 0x47, 0x43,
+	0x21, 0xee, 0x30, 0x42, 0x3f, 0x20, 0xf8, 0xb7, 0x8f, 0xbf, 0xb6, 0xea, 0xc8, 0x06, 0x84, 0xc5,
+	0xa3, 0x86, 0x64, 0x21, 0xd6, 0x1a, 0x7c, 0x87, 0x11, 0x72, 0x36, 0x03, 0x57, 0x72, 0xcb, 0xc4,
+	0xe8, 0xb5, 0xc2, 0x5e, 0xa1, 0xaf, 0xc3, 0x84, 0x87, 0x1d, 0x0e, 0x70, 0x01, 0xb6, 0x69, 0xfc,
+	0x1d, 0xc7, 0x38, 0xe5, 0xc4, 0x68, 0xd7, 0x5c, 0x50, 0xf4, 0x6a, 0x9d, 0x83, 0xb5, 0x54, 0xc5,
+	0xbf, 0x11, 0x0c, 0xb9, 0xc8, 0x19, 0xbb, 0x42, 0x94, 0x7c, 0xc6, 0x28, 0xcb, 0xd6, 0x49, 0xef,
+	0x5d, 0xdc, 0x1d, 0xdb, 0x62, 0x6a, 0xb2, 0xda, 0x3e, 0x40, 0x94, 0xbc, 0x21, 0xba, 0x7e, 0xf1,
+	0xef, 0xde, 0xa8, 0x8b, 0x3f, 0x96, 0x60, 0x1b, 0xaf, 0x23, 0xda, 0x48, 0xcf, 0x64, 0x4f, 0xac,
+	0x37, 0x35, 0xb4, 0x0e, 0xcb, 0x5c, 0x11, 0xd3, 0x03, 0xac, 0xba, 0x68, 0xe2, 0x30, 0xe0, 0xcb,
+	0xc6, 0xa0, 0xe2, 0x12, 0xed, 0xe8, 0xcd, 0x0e, 0xf9, 0x45, 0x18, 0xb2, 0xbd, 0xe5, 0x21, 0x3f,
+	0x06, 0xfd, 0xac, 0xf3, 0xe7, 0x11, 0xd9, 0xd7, 0x94, 0x14, 0x74, 0x95, 0xc7, 0x98, 0xcb, 0x8a,
+	0xff, 0x05, 0x61, 0x41, 0xd3, 0x8b, 0x65, 0x59, 0x27, 0x0b, 0x72, 0xb5, 0x54, 0x67, 0xd1, 0x6e,
+	0x59, 0xf8, 0x9e, 0x74, 0xc3, 0x98, 0xab, 0x1e, 0x07, 0x93, 0x6b, 0xb8, 0x1b, 0x3c, 0xf7, 0x68,
+	0x72, 0xd3, 0x98, 0xc0, 0x40, 0x85, 0x28, 0x72, 0x49, 0xaf, 0x6f, 0xc4, 0x9e, 0x99, 0xb6, 0xf1,
+	0x32, 0xec, 0xe0, 0x7f, 0x33, 0x55, 0x59, 0x27, 0xec, 0xab, 0x4d, 0x2d, 0x84, 0xb8, 0xed, 0xcc,
+	0x93, 0xdc, 0xda, 0x4a, 0x74, 0x88, 0xed, 0x73, 0xa3, 0x2d, 0x31, 0x3d, 0xc8, 0x1f, 0xd3, 0xb2,
+	0x4e, 0x92, 0x7f, 0x8e, 0x41, 0x1f, 0xdd, 0x5a, 0xfc, 0x21, 0x82, 0x9d, 0xb6, 0xf9, 0x0c, 0x3e,
+	0xe0, 0xdc, 0x4e, 0xb7, 0xb1, 0x8e, 0x70, 0xb0, 0x85, 0x14, 0xdb, 0x1e, 0x51, 0xba, 0xf7, 0xe4,
+	0x8f, 0x8f, 0xbb, 0x63, 0x78, 0x3a, 0xe1, 0x98, 0x1d, 0x99, 0x83, 0xad, 0x32, 0x55, 0xcb, 0x64,
+	0xb9, 0xf3, 0x2f, 0x10, 0xe0, 0xe6, 0xa9, 0x0c, 0xfe, 0x8f, 0xbb, 0x37, 0x97, 0xb1, 0x8e, 0x70,
+	0x28, 0x88, 0x28, 0x47, 0x77, 0x8c, 0xa2, 0x93, 0xf0, 0x4c, 0x0b, 0x74, 0xac, 0x05, 0xc9, 0xf0,
+	0x6c, 0x78, 0x88, 0x60, 0x9f, 0xfb, 0xb8, 0x05, 0xc7, 0x9d, 0xce, 0x7d, 0x07, 0x3c, 0x82, 0x14,
+	0x54, 0x9c, 0xe3, 0x3d, 0x47, 0xf1, 0x9e, 0xc2, 0x27, 0xbd, 0xf0, 0xca, 0x4c, 0x3f, 0x53, 0xb3,
+	0x0c, 0x64, 0xe8, 0x81, 0x90, 0xb8, 0x4d, 0x8f, 0xd3, 0x3b, 0xf8, 0x7b, 0x04, 0x7b, 0x5d, 0x87,
+	0x2b, 0x78, 0xc6, 0x17, 0x8b, 0x63, 0x98, 0x23, 0xc4, 0x03, 0x4a, 0x73, 0xe0, 0x67, 0x29, 0xf0,
+	0xff, 0xe1, 0x13, 0xc1, 0x80, 0x17, 0x95, 0x82, 0x03, 0xf7, 0x57, 0x08, 0x70, 0xf3, 0x2c, 0xa5,
+	0x39, 0x2f, 0x3c, 0x87, 0x36, 0xc2, 0xa1, 0x20, 0xa2, 0x1c, 0xee, 0x1c, 0x85, 0x7b, 0x1c, 0x1f,
+	0x6b, 0x05, 0x97, 0x27, 0x86, 0x67, 0x8c, 0xed, 0x4d, 0x9a, 0x67, 0x8c, 0x5d, 0x87, 0x33, 0x42,
+	0x3c, 0xa0, 0x74, 0xd8, 0x18, 0x73, 0xd0, 0x15, 0x59, 0xd3, 0x8d, 0xea, 0x64, 0xe1, 0xfe, 0x0b,
+	0xc1, 0xc1, 0x40, 0x33, 0x08, 0x3c, 0x17, 0x08, 0x99, 0xc7, 0x45, 0x51, 0x38, 0xdd, 0xa6, 0x36,
+	0xe7, 0x99, 0xa6, 0x3c, 0x97, 0xf0, 0x0b, 0x21, 0x79, 0x66, 0x14, 0xb5, 0x31, 0xbf, 0x54, 0xa5,
+	0x54, 0xb7, 0xa8, 0xff, 0x88, 0xac, 0x79, 0x5f, 0xf3, 0xc0, 0x01, 0x1f, 0xf1, 0x4d, 0x76, 0x97,
+	0xf9, 0x89, 0x30, 0x1b, 0x42, 0x83, 0xd3, 0x9a, 0xa7, 0xb4, 0xce, 0xe0, 0xb9, 0x60, 0x9f, 0x08,
+	0xc9, 0x67, 0xb2, 0xd4, 0x48, 0xc6, 0xb6, 0x87, 0x3f, 0x21, 0x10, 0x5c, 0xc3, 0x49, 0xaf, 0x75,
+	0x78, 0x36, 0x50, 0xe8, 0x1b, 0xef, 0xaf, 0x42, 0x32, 0x8c, 0x0a, 0xe7, 0xb2, 0x40, 0xb9, 0x9c,
+	0xc5, 0xa7, 0xc3, 0x6e, 0x11, 0xbd, 0xa0, 0x5a, 0x64, 0x3e, 0x40, 0x30, 0xd8, 0x30, 0x0f, 0xc0,
+	0xa2, 0x13, 0x4a, 0xf3, 0xb0, 0x42, 0x98, 0xf2, 0x95, 0xe1, 0xf8, 0x66, 0x28, 0xbe, 0x69, 0x7c,
+	0xc0, 0x0b, 0x1f, 0xc7, 0xc5, 0x26, 0x1d, 0xf7, 0x11, 0x00, 0xb3, 0x92, 0xaa, 0x2f, 0xce, 0xe3,
+	0x09, 0x77, 0x0f, 0x26, 0x80, 0x88, 0xd7, 0x32, 0xf7, 0x7d, 0x9c, 0xfa, 0x3e, 0x82, 0xa5, 0x16,
+	0xbe, 0xb3, 0xf5, 0x4c, 0x31, 0x9f, 0xb8, 0xcd, 0x6f, 0x45, 0x77, 0xf0, 0xcf, 0x08, 0x04, 0xef,
+	0x11, 0x40, 0xf3, 0xce, 0xb6, 0x1c, 0x36, 0x08, 0xc9, 0x30, 0x2a, 0x1c, 0xfd, 0x45, 0x8a, 0xfe,
+	0x1c, 0x3e, 0xe3, 0x85, 0xde, 0x3e, 0x7f, 0xa8, 0x55, 0x34, 0x83, 0x08, 0x27, 0xd1, 0xc0, 0xe6,
+	0x17, 0x04, 0x63, 0x3e, 0x4d, 0x08, 0xf6, 0xcf, 0x3a, 0xd7, 0x41, 0x84, 0x70, 0x34, 0x94, 0x4e,
+	0x50, 0x42, 0x8e, 0x54, 0x2d, 0x51, 0x33, 0x19, 0xb3, 0xc5, 0xf2, 0x3e, 0xf4, 0x2d, 0x2a, 0xfe,
+	0x87, 0xbe, 0x93, 0x44, 0x3c, 0xa0, 0x74, 0x9b, 0x87, 0x7e, 0x13, 0xee, 0x8f, 0xba, 0xe1, 0x70,
+	0x88, 0xd6, 0x19, 0xa7, 0x42, 0x04, 0xd9, 0xab, 0x00, 0x5c, 0xe8, 0xc8, 0x06, 0x67, 0xfe, 0x3a,
+	0x65, 0x7e, 0x05, 0x5f, 0x6e, 0x6f, 0xe3, 0xfc, 0xaa, 0xc1, 0xea, 0xfa, 0x88, 0xdc, 0xb3, 0x43,
+	0xc6, 0x27, 0x42, 0x90, 0xb0, 0x9d, 0x50, 0x27, 0xc3, 0x2b, 0x72, 0xca, 0x4b, 0x94, 0xf2, 0x45,
+	0x3c, 0xdf, 0x26, 0x65, 0xfb, 0xe9, 0xda, 0x74, 0x15, 0x34, 0x3b, 0xdf, 0x16, 0x57, 0x41, 0x47,
+	0x2b, 0x2f, 0xc4, 0x03, 0x4a, 0xb7, 0x77, 0x15, 0xcc, 0x98, 0x2d, 0xba, 0x85, 0xbb, 0x0e, 0xfd,
+	0xac, 0xc1, 0x6c, 0xae, 0x07, 0xcd, 0x3d, 0xac, 0x30, 0xe5, 0x2b, 0xc3, 0x31, 0x4d, 0x53, 0x4c,
+	0x93, 0x38, 0xe2, 0x85, 0x89, 0xf5, 0xb0, 0xf8, 0x5b, 0x04, 0x43, 0x2e, 0xcd, 0x28, 0x6e, 0xba,
+	0x5b, 0x7a, 0x77, 0xba, 0xc2, 0xe1, 0x40, 0xb2, 0x41, 0x83, 0x45, 0xb8, 0x72, 0x86, 0x18, 0xda,
+	0x3c, 0x66, 0xeb, 0xe7, 0x6c, 0x6a, 0xe9, 0xd1, 0xb3, 0x08, 0x7a, 0xfc, 0x2c, 0x82, 0x7e, 0x7f,
+	0x16, 0x41, 0x0f, 0x56, 0x23, 0x5d, 0x8f, 0x57, 0x23, 0x5d, 0xbf, 0xae, 0x46, 0xba, 0xde, 0x48,
+	0x36, 0xb4, 0x93, 0xdc, 0x78, 0xbc, 0x24, 0x67, 0x35, 0xcb, 0xd3, 0xad, 0xd9, 0x64, 0xe2, 0x5d,
+	0xd3, 0x1f, 0x6d, 0x2f, 0xb3, 0xfd, 0x74, 0xe6, 0x71, 0xf4, 0x9f, 0x01, 0x00, 0x9a, 0x61, 0x3c,
+	0xf1, 0xe5, 0x20, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1705,6 +2062,13 @@ type QueryClient interface {
 	AccountLockedLongerDurationNotUnlockingOnly(ctx context.Context, in *AccountLockedLongerDurationNotUnlockingOnlyRequest, opts ...grpc.CallOption) (*AccountLockedLongerDurationNotUnlockingOnlyResponse, error)
 	// Returns account's locked records for a denom with longer duration
 	AccountLockedLongerDurationDenom(ctx context.Context, in *AccountLockedLongerDurationDenomRequest, opts ...grpc.CallOption) (*AccountLockedLongerDurationDenomResponse, error)
+	// Returns the unlocking coins of an account grouped by unlock time
+	AccountUnlockSchedule(ctx context.Context, in *AccountUnlockScheduleRequest, opts ...grpc.CallOption) (*AccountUnlockScheduleResponse, error)
+	// Params returns lockup params.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Returns the coins released and the penalty taken when early unlocking
+	// the lock with the given id
+	EstimateEarlyUnlock(ctx context.Context, in *EstimateEarlyUnlockRequest, opts ...grpc.CallOption) (*EstimateEarlyUnlockResponse, error)
 }
 
 type queryClient struct {
@@ -1859,6 +2223,33 @@ func (c *queryClient) AccountLockedLongerDurationDenom(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *queryClient) AccountUnlockSchedule(ctx context.Context, in *AccountUnlockScheduleRequest, opts ...grpc.CallOption) (*AccountUnlockScheduleResponse, error) {
+	out := new(AccountUnlockScheduleResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.lockup.Query/AccountUnlockSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.lockup.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EstimateEarlyUnlock(ctx context.Context, in *EstimateEarlyUnlockRequest, opts ...grpc.CallOption) (*EstimateEarlyUnlockResponse, error) {
+	out := new(EstimateEarlyUnlockResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.lockup.Query/EstimateEarlyUnlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Return full balance of the module
@@ -1895,6 +2286,13 @@ type QueryServer interface {
 	AccountLockedLongerDurationNotUnlockingOnly(context.Context, *AccountLockedLongerDurationNotUnlockingOnlyRequest) (*AccountLockedLongerDurationNotUnlockingOnlyResponse, error)
 	// Returns account's locked records for a denom with longer duration
 	AccountLockedLongerDurationDenom(context.Context, *AccountLockedLongerDurationDenomRequest) (*AccountLockedLongerDurationDenomResponse, error)
+	// Returns the unlocking coins of an account grouped by unlock time
+	AccountUnlockSchedule(context.Context, *AccountUnlockScheduleRequest) (*AccountUnlockScheduleResponse, error)
+	// Params returns lockup params.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Returns the coins released and the penalty taken when early unlocking
+	// the lock with the given id
+	EstimateEarlyUnlock(context.Context, *EstimateEarlyUnlockRequest) (*EstimateEarlyUnlockResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1949,6 +2347,15 @@ func (*UnimplementedQueryServer) AccountLockedLongerDurationNotUnlockingOnly(ctx
 func (*UnimplementedQueryServer) AccountLockedLongerDurationDenom(ctx context.Context, req *AccountLockedLongerDurationDenomRequest) (*AccountLockedLongerDurationDenomResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AccountLockedLongerDurationDenom not implemented")
 }
+func (*UnimplementedQueryServer) AccountUnlockSchedule(ctx context.Context, req *AccountUnlockScheduleRequest) (*AccountUnlockScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountUnlockSchedule not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) EstimateEarlyUnlock(ctx context.Context, req *EstimateEarlyUnlockRequest) (*EstimateEarlyUnlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateEarlyUnlock not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2242,6 +2649,60 @@ func _Query_AccountLockedLongerDurationDenom_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AccountUnlockSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountUnlockScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountUnlockSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.lockup.Query/AccountUnlockSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountUnlockSchedule(ctx, req.(*AccountUnlockScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.lockup.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EstimateEarlyUnlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateEarlyUnlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateEarlyUnlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.lockup.Query/EstimateEarlyUnlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateEarlyUnlock(ctx, req.(*EstimateEarlyUnlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.lockup.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2310,6 +2771,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AccountLockedLongerDurationDenom",
 			Handler:    _Query_AccountLockedLongerDurationDenom_Handler,
 		},
+		{
+			MethodName: "AccountUnlockSchedule",
+			Handler:    _Query_AccountUnlockSchedule_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "EstimateEarlyUnlock",
+			Handler:    _Query_EstimateEarlyUnlock_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/lockup/query.proto",
@@ -3449,6 +3922,281 @@ func (m *AccountLockedLongerDurationDenomResponse) MarshalToSizedBuffer(dAtA []b
 	return len(dAtA) - i, nil
 }
 
+func (m *AccountUnlockScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountUnlockScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountUnlockScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountUnlockScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountUnlockScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountUnlockScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Schedule) > 0 {
+		for iNdEx := len(m.Schedule) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schedule[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnlockScheduleEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnlockScheduleEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnlockScheduleEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LockIds) > 0 {
+		dAtA12 := make([]byte, len(m.LockIds)*10)
+		var j11 int
+		for _, num := range m.LockIds {
+			for num >= 1<<7 {
+				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j11++
+			}
+			dAtA12[j11] = uint8(num)
+			j11++
+		}
+		i -= j11
+		copy(dAtA[i:], dAtA12[:j11])
+		i = encodeVarintQuery(dAtA, i, uint64(j11))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintQuery(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EstimateEarlyUnlockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateEarlyUnlockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateEarlyUnlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LockId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LockId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EstimateEarlyUnlockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateEarlyUnlockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateEarlyUnlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.PenaltyRate.Size()
+		i -= size
+		if _, err := m.PenaltyRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Penalty) > 0 {
+		for iNdEx := len(m.Penalty) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Penalty[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -3918,18 +4666,125 @@ func (m *AccountLockedLongerDurationDenomResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *AccountUnlockScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *AccountUnlockScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Schedule) > 0 {
+		for _, e := range m.Schedule {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
 }
-func (m *ModuleBalanceRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
+
+func (m *UnlockScheduleEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.LockIds) > 0 {
+		l = 0
+		for _, e := range m.LockIds {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *EstimateEarlyUnlockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LockId != 0 {
+		n += 1 + sovQuery(uint64(m.LockId))
+	}
+	return n
+}
+
+func (m *EstimateEarlyUnlockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Penalty) > 0 {
+		for _, e := range m.Penalty {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.PenaltyRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ModuleBalanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
@@ -6853,6 +7708,719 @@ func (m *AccountLockedLongerDurationDenomResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
+func (m *AccountUnlockScheduleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountUnlockScheduleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountUnlockScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountUnlockScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountUnlockScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountUnlockScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedule = append(m.Schedule, UnlockScheduleEntry{})
+			if err := m.Schedule[len(m.Schedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnlockScheduleEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnlockScheduleEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnlockScheduleEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.LockIds = append(m.LockIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.LockIds) == 0 {
+					m.LockIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.LockIds = append(m.LockIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EstimateEarlyUnlockRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateEarlyUnlockRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimateEarlyUnlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
+			}
+			m.LockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EstimateEarlyUnlockResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateEarlyUnlockResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimateEarlyUnlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Penalty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Penalty = append(m.Penalty, types.Coin{})
+			if err := m.Penalty[len(m.Penalty)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PenaltyRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PenaltyRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -32,6 +32,8 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdLockableDurations(),
 		GetCmdIncentivizedPools(),
 		GetCmdExternalIncentiveGauges(),
+		GetCmdGaugeVoteTally(),
+		GetCmdGaugeVotes(),
 	)
 
 	return cmd
@@ -259,3 +261,77 @@ $ %s query pool-incentives external-incentivized-gauges
 
 	return cmd
 }
+
+// GetCmdGaugeVoteTally returns the gauge vote tally and the distribution records derived from it.
+func GetCmdGaugeVoteTally() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gauge-vote-tally",
+		Short: "Query the gauge vote tally",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the voting power allocated to each gauge and the distribution info derived from it.
+
+Example:
+$ %s query pool-incentives gauge-vote-tally
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.GaugeVoteTally(cmd.Context(), &types.QueryGaugeVoteTallyRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdGaugeVotes takes the voter address and returns its gauge votes and voting power.
+func GetCmdGaugeVotes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gauge-votes [voter]",
+		Short: "Query the gauge votes of a voter",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the gauge votes and voting power of a voter.
+
+Example:
+$ %s query pool-incentives gauge-votes osmo1...
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.GaugeVotes(cmd.Context(), &types.QueryGaugeVotesRequest{
+				Voter: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
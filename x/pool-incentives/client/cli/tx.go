@@ -2,13 +2,16 @@ package cli
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client/tx"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
 	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
@@ -16,6 +19,86 @@ import (
 	"github.com/osmosis-labs/osmosis/v12/x/pool-incentives/types"
 )
 
+// GetTxCmd returns the transaction commands for this module.
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		NewVoteGaugesCmd(),
+	)
+
+	return cmd
+}
+
+// NewVoteGaugesCmd broadcasts a VoteGauges message.
+func NewVoteGaugesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote-gauges [gaugeIds] [weights]",
+		Short: "Allocate your voting power to pool gauges",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Allocate fractions of your voting power to pool gauges, replacing your previous gauge votes.
+Your gauge votes are removed when no gauges are given.
+
+Example:
+$ %s tx poolincentives vote-gauges 1,4 0.7,0.3 --from mykey
+$ %s tx poolincentives vote-gauges --from mykey
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		Args: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 0 && len(args) != 2 {
+				return fmt.Errorf("accepts 0 or 2 arg(s), received %d", len(args))
+			}
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var votes []types.GaugeVote
+			if len(args) == 2 {
+				gaugeIds, err := osmoutils.ParseUint64SliceFromString(args[0], ",")
+				if err != nil {
+					return err
+				}
+
+				weights, err := osmoutils.ParseSdkDecFromString(args[1], ",")
+				if err != nil {
+					return err
+				}
+
+				if len(gaugeIds) != len(weights) {
+					return fmt.Errorf("the length of gauge ids and weights not matched")
+				}
+
+				for i, gaugeId := range gaugeIds {
+					votes = append(votes, types.GaugeVote{
+						GaugeId: gaugeId,
+						Weight:  weights[i],
+					})
+				}
+			}
+
+			msg := types.NewMsgVoteGauges(clientCtx.GetFromAddress(), votes)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func NewCmdSubmitUpdatePoolIncentivesProposal() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "update-pool-incentives [gaugeIds] [weights]",
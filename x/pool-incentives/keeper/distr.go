@@ -33,6 +33,16 @@ func (k Keeper) AllocateAsset(ctx sdk.Context) error {
 
 	distrInfo := k.GetDistrInfo(ctx)
 
+	// when gauge voting is enabled, the records are derived from the current gauge votes,
+	// falling back to the governance set records while no voting power has been allocated.
+	// The derived records are not stored, so that the governance set records are kept.
+	if params.GaugeVotingEnabled {
+		k.RefreshGaugeVoteTally(ctx)
+		if tally := k.GetGaugeVoteTally(ctx); len(tally) > 0 {
+			distrInfo = k.GetGaugeVoteDistrInfo(ctx, tally)
+		}
+	}
+
 	if distrInfo.TotalWeight.IsZero() {
 		// If there are no records, put the asset to the community pool
 		return k.FundCommunityPoolFromModule(ctx, asset)
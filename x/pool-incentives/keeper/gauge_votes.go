@@ -0,0 +1,305 @@
+package keeper
+
+import (
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v12/x/pool-incentives/types"
+)
+
+// GetVotingPower returns the gauge voting power of the voter, which is the amount of the minted denom
+// it has locked, and not begun unlocking, for at least the minimum vote lock duration.
+func (k Keeper) GetVotingPower(ctx sdk.Context, voter sdk.AccAddress) sdk.Int {
+	params := k.GetParams(ctx)
+	votingPower := sdk.ZeroInt()
+	for _, lock := range k.lockupKeeper.GetAccountLockedLongerDurationDenomNotUnlockingOnly(ctx, voter, params.MintedDenom, params.MinVoteLockDuration) {
+		votingPower = votingPower.Add(lock.Coins.AmountOf(params.MintedDenom))
+	}
+	return votingPower
+}
+
+// VoteGauges replaces the gauge votes of the voter. Empty votes remove the gauge votes of the voter.
+func (k Keeper) VoteGauges(ctx sdk.Context, voter sdk.AccAddress, votes []types.GaugeVote) error {
+	if !k.GetParams(ctx).GaugeVotingEnabled {
+		return types.ErrGaugeVotingDisabled
+	}
+
+	if len(votes) == 0 {
+		k.updateGaugeVotes(ctx, voter, nil)
+		return nil
+	}
+
+	if minVotingPower := k.GetParams(ctx).MinVotingPower; k.GetVotingPower(ctx, voter).LT(minVotingPower) {
+		return sdkerrors.Wrapf(types.ErrNoVotingPower, "voter %s has less than the min voting power %s", voter, minVotingPower)
+	}
+
+	if err := k.validateGaugeVotes(ctx, votes); err != nil {
+		return err
+	}
+
+	sortedVotes := make([]types.GaugeVote, len(votes))
+	copy(sortedVotes, votes)
+	sort.SliceStable(sortedVotes, func(i, j int) bool {
+		return sortedVotes[i].GaugeId < sortedVotes[j].GaugeId
+	})
+
+	k.updateGaugeVotes(ctx, voter, sortedVotes)
+	return nil
+}
+
+// updateGaugeVotes replaces the gauge votes of the voter along with its voting power counted in the gauge vote
+// tally, and updates the tally accordingly. Votes are deleted if they are empty or the voter has no voting power
+// left, and the voting power of voters below the min voting power is not counted.
+func (k Keeper) updateGaugeVotes(ctx sdk.Context, voter sdk.AccAddress, votes []types.GaugeVote) {
+	if prevGaugeVotes, found := k.getGaugeVotes(ctx, voter); found {
+		k.addGaugeVotesToTally(ctx, prevGaugeVotes, true)
+	}
+
+	votingPower := sdk.ZeroInt()
+	if len(votes) > 0 {
+		votingPower = k.GetVotingPower(ctx, voter)
+	}
+	if !votingPower.IsPositive() {
+		k.deleteGaugeVotes(ctx, voter)
+		return
+	}
+	if votingPower.LT(k.GetParams(ctx).MinVotingPower) {
+		votingPower = sdk.ZeroInt()
+	}
+
+	gaugeVotes := types.GaugeVotes{
+		Voter:       voter.String(),
+		Votes:       votes,
+		VotingPower: votingPower,
+	}
+	k.SetGaugeVotes(ctx, gaugeVotes)
+	k.addGaugeVotesToTally(ctx, gaugeVotes, false)
+}
+
+// updateVotingPower updates the voting power of the voter counted in the gauge vote tally, if it has voted.
+// It must be called whenever the locks of the voter change.
+func (k Keeper) updateVotingPower(ctx sdk.Context, voter sdk.AccAddress) {
+	if gaugeVotes, found := k.getGaugeVotes(ctx, voter); found {
+		k.updateGaugeVotes(ctx, voter, gaugeVotes.Votes)
+	}
+}
+
+// validateGaugeVotes validates a list of gauge votes to ensure that:
+// 1) the weights are positive and sum up to at most 1,
+// 2) there are no duplicates,
+// 3) the votes are only for perpetual gauges that were created for a pool.
+func (k Keeper) validateGaugeVotes(ctx sdk.Context, votes []types.GaugeVote) error {
+	totalWeight := sdk.ZeroDec()
+	gaugeIdFlags := make(map[uint64]bool)
+
+	for _, vote := range votes {
+		if err := vote.ValidateBasic(); err != nil {
+			return err
+		}
+
+		if gaugeIdFlags[vote.GaugeId] {
+			return sdkerrors.Wrapf(types.ErrInvalidGaugeVote, "gauge ID #%d has duplications", vote.GaugeId)
+		}
+		gaugeIdFlags[vote.GaugeId] = true
+
+		totalWeight = totalWeight.Add(vote.Weight)
+		if totalWeight.GT(sdk.OneDec()) {
+			return sdkerrors.Wrapf(types.ErrInvalidGaugeVote, "vote weights sum up to more than 1")
+		}
+
+		gauge, err := k.incentivesKeeper.GetGaugeByID(ctx, vote.GaugeId)
+		if err != nil {
+			return err
+		}
+		if !gauge.IsPerpetual {
+			return sdkerrors.Wrapf(types.ErrInvalidGaugeVote, "gauge ID #%d is not perpetual", vote.GaugeId)
+		}
+		if _, err := k.GetPoolIdFromGaugeId(ctx, vote.GaugeId, gauge.DistributeTo.Duration); err != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidGaugeVote, "gauge ID #%d is not a pool gauge", vote.GaugeId)
+		}
+	}
+
+	return nil
+}
+
+// SetGaugeVotes stores the gauge votes of a voter.
+func (k Keeper) SetGaugeVotes(ctx sdk.Context, gaugeVotes types.GaugeVotes) {
+	voter, err := sdk.AccAddressFromBech32(gaugeVotes.Voter)
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetGaugeVotesStoreKey(voter), k.cdc.MustMarshal(&gaugeVotes))
+}
+
+// GetGaugeVotes returns the gauge votes of a voter, which are empty if the voter has not voted.
+func (k Keeper) GetGaugeVotes(ctx sdk.Context, voter sdk.AccAddress) []types.GaugeVote {
+	gaugeVotes, found := k.getGaugeVotes(ctx, voter)
+	if !found {
+		return []types.GaugeVote{}
+	}
+	return gaugeVotes.Votes
+}
+
+func (k Keeper) getGaugeVotes(ctx sdk.Context, voter sdk.AccAddress) (types.GaugeVotes, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetGaugeVotesStoreKey(voter))
+	if len(bz) == 0 {
+		return types.GaugeVotes{}, false
+	}
+
+	gaugeVotes := types.GaugeVotes{}
+	k.cdc.MustUnmarshal(bz, &gaugeVotes)
+	return gaugeVotes, true
+}
+
+// GetAllGaugeVotes returns the gauge votes of all voters.
+func (k Keeper) GetAllGaugeVotes(ctx sdk.Context) []types.GaugeVotes {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GaugeVotesPrefix)
+	iterator := prefixStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	allGaugeVotes := []types.GaugeVotes{}
+	for ; iterator.Valid(); iterator.Next() {
+		gaugeVotes := types.GaugeVotes{}
+		k.cdc.MustUnmarshal(iterator.Value(), &gaugeVotes)
+		allGaugeVotes = append(allGaugeVotes, gaugeVotes)
+	}
+	return allGaugeVotes
+}
+
+func (k Keeper) deleteGaugeVotes(ctx sdk.Context, voter sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetGaugeVotesStoreKey(voter))
+}
+
+// GetGaugeVoteTally returns the voting power allocated to each gauge by the current gauge votes,
+// sorted by gauge ID. Gauges without any voting power allocated are left out.
+func (k Keeper) GetGaugeVoteTally(ctx sdk.Context) []types.DistrRecord {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GaugeVoteTallyPrefix)
+	iterator := prefixStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	tally := []types.DistrRecord{}
+	for ; iterator.Valid(); iterator.Next() {
+		record := types.DistrRecord{}
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		tally = append(tally, record)
+	}
+	return tally
+}
+
+// addGaugeVotesToTally adds the voting power the gauge votes allocate to each gauge to the gauge vote tally,
+// or subtracts it if remove is set. Gauges left without any voting power allocated are removed from the tally.
+func (k Keeper) addGaugeVotesToTally(ctx sdk.Context, gaugeVotes types.GaugeVotes, remove bool) {
+	if gaugeVotes.VotingPower.IsNil() || !gaugeVotes.VotingPower.IsPositive() {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	for _, vote := range gaugeVotes.Votes {
+		allocated := vote.Weight.MulInt(gaugeVotes.VotingPower).TruncateInt()
+		if !allocated.IsPositive() {
+			continue
+		}
+
+		key := types.GetGaugeVoteTallyStoreKey(vote.GaugeId)
+		record := types.DistrRecord{GaugeId: vote.GaugeId, Weight: sdk.ZeroInt()}
+		if bz := store.Get(key); bz != nil {
+			k.cdc.MustUnmarshal(bz, &record)
+		}
+		if remove {
+			record.Weight = record.Weight.Sub(allocated)
+		} else {
+			record.Weight = record.Weight.Add(allocated)
+		}
+
+		if record.Weight.IsPositive() {
+			store.Set(key, k.cdc.MustMarshal(&record))
+		} else {
+			store.Delete(key)
+		}
+	}
+}
+
+// RefreshGaugeVoteTally rebuilds the gauge vote tally from the current voting power of every voter, if the
+// params the voting power depends on changed since the tally was last built. Otherwise the tally is kept up
+// to date as votes and locks change.
+func (k Keeper) RefreshGaugeVoteTally(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	store := ctx.KVStore(k.storeKey)
+	if bz := store.Get(types.GaugeVoteTallyParamsKey); bz != nil {
+		tallyParams := types.Params{}
+		k.cdc.MustUnmarshal(bz, &tallyParams)
+		if tallyParams.MintedDenom == params.MintedDenom &&
+			tallyParams.MinVoteLockDuration == params.MinVoteLockDuration &&
+			tallyParams.MinVotingPower.Equal(params.MinVotingPower) {
+			return
+		}
+	}
+
+	k.clearGaugeVoteTally(ctx)
+	for _, gaugeVotes := range k.GetAllGaugeVotes(ctx) {
+		voter := sdk.MustAccAddressFromBech32(gaugeVotes.Voter)
+		// the tally is cleared, so the previous voting power of the voter is not subtracted from it
+		k.deleteGaugeVotes(ctx, voter)
+		k.updateGaugeVotes(ctx, voter, gaugeVotes.Votes)
+	}
+	k.setGaugeVoteTallyParams(ctx, params)
+}
+
+func (k Keeper) setGaugeVoteTallyParams(ctx sdk.Context, params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GaugeVoteTallyParamsKey, k.cdc.MustMarshal(&params))
+}
+
+func (k Keeper) clearGaugeVoteTally(ctx sdk.Context) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GaugeVoteTallyPrefix)
+	iterator := prefixStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	keys := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		prefixStore.Delete(key)
+	}
+}
+
+// GetGaugeVoteDistrInfo derives the distribution records from a gauge vote tally. The weight of
+// each gauge is capped at the max gauge weight of the total voting power, with the voting power
+// over the cap allocated to the community pool.
+func (k Keeper) GetGaugeVoteDistrInfo(ctx sdk.Context, tally []types.DistrRecord) types.DistrInfo {
+	totalWeight := sdk.ZeroInt()
+	for _, record := range tally {
+		totalWeight = totalWeight.Add(record.Weight)
+	}
+
+	maxWeight := k.GetParams(ctx).MaxGaugeWeight.MulInt(totalWeight).TruncateInt()
+	communityPoolWeight := sdk.ZeroInt()
+	records := make([]types.DistrRecord, 0, len(tally)+1)
+	for _, record := range tally {
+		if record.Weight.GT(maxWeight) {
+			communityPoolWeight = communityPoolWeight.Add(record.Weight.Sub(maxWeight))
+			record.Weight = maxWeight
+		}
+		if record.Weight.IsPositive() {
+			records = append(records, record)
+		}
+	}
+
+	if communityPoolWeight.IsPositive() {
+		// the community pool record with gauge ID 0 keeps the records sorted
+		records = append([]types.DistrRecord{{GaugeId: 0, Weight: communityPoolWeight}}, records...)
+	}
+
+	return types.DistrInfo{
+		TotalWeight: totalWeight,
+		Records:     records,
+	}
+}
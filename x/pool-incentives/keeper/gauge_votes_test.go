@@ -0,0 +1,360 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	lockuptypes "github.com/osmosis-labs/osmosis/v12/x/lockup/types"
+	"github.com/osmosis-labs/osmosis/v12/x/pool-incentives/keeper"
+	"github.com/osmosis-labs/osmosis/v12/x/pool-incentives/types"
+)
+
+func (suite *KeeperTestSuite) enableGaugeVoting(maxGaugeWeight sdk.Dec) {
+	params := suite.App.PoolIncentivesKeeper.GetParams(suite.Ctx)
+	params.GaugeVotingEnabled = true
+	params.MinVoteLockDuration = time.Hour
+	params.MaxGaugeWeight = maxGaugeWeight
+	params.MinVotingPower = sdk.NewInt(50)
+	suite.App.PoolIncentivesKeeper.SetParams(suite.Ctx, params)
+}
+
+func (suite *KeeperTestSuite) TestVoteGauges() {
+	tests := []struct {
+		name          string
+		votingEnabled bool
+		lockAmount    int64
+		lockDuration  time.Duration
+		votes         []types.GaugeVote
+		expectErr     bool
+	}{
+		{
+			name:          "voting disabled",
+			votingEnabled: false,
+			lockAmount:    100,
+			lockDuration:  time.Hour,
+			votes:         []types.GaugeVote{{GaugeId: 1, Weight: sdk.OneDec()}},
+			expectErr:     true,
+		},
+		{
+			name:          "no voting power",
+			votingEnabled: true,
+			votes:         []types.GaugeVote{{GaugeId: 1, Weight: sdk.OneDec()}},
+			expectErr:     true,
+		},
+		{
+			name:          "less than the min voting power",
+			votingEnabled: true,
+			lockAmount:    49,
+			lockDuration:  time.Hour,
+			votes:         []types.GaugeVote{{GaugeId: 1, Weight: sdk.OneDec()}},
+			expectErr:     true,
+		},
+		{
+			name:          "lock shorter than the min vote lock duration",
+			votingEnabled: true,
+			lockAmount:    100,
+			lockDuration:  time.Minute,
+			votes:         []types.GaugeVote{{GaugeId: 1, Weight: sdk.OneDec()}},
+			expectErr:     true,
+		},
+		{
+			name:          "weights sum up to more than 1",
+			votingEnabled: true,
+			lockAmount:    100,
+			lockDuration:  time.Hour,
+			votes: []types.GaugeVote{
+				{GaugeId: 1, Weight: sdk.NewDecWithPrec(6, 1)},
+				{GaugeId: 2, Weight: sdk.NewDecWithPrec(5, 1)},
+			},
+			expectErr: true,
+		},
+		{
+			name:          "duplicate gauge",
+			votingEnabled: true,
+			lockAmount:    100,
+			lockDuration:  time.Hour,
+			votes: []types.GaugeVote{
+				{GaugeId: 1, Weight: sdk.NewDecWithPrec(2, 1)},
+				{GaugeId: 1, Weight: sdk.NewDecWithPrec(2, 1)},
+			},
+			expectErr: true,
+		},
+		{
+			name:          "non-existent gauge",
+			votingEnabled: true,
+			lockAmount:    100,
+			lockDuration:  time.Hour,
+			votes:         []types.GaugeVote{{GaugeId: 100, Weight: sdk.OneDec()}},
+			expectErr:     true,
+		},
+		{
+			name:          "non-pool gauge",
+			votingEnabled: true,
+			lockAmount:    100,
+			lockDuration:  time.Hour,
+			votes:         []types.GaugeVote{{GaugeId: 4, Weight: sdk.OneDec()}},
+			expectErr:     true,
+		},
+		{
+			name:          "valid votes",
+			votingEnabled: true,
+			lockAmount:    100,
+			lockDuration:  time.Hour,
+			votes: []types.GaugeVote{
+				{GaugeId: 3, Weight: sdk.NewDecWithPrec(5, 1)},
+				{GaugeId: 1, Weight: sdk.NewDecWithPrec(3, 1)},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		suite.Run(test.name, func() {
+			suite.Setup()
+			keeper := suite.App.PoolIncentivesKeeper
+			voter := suite.TestAccs[0]
+
+			// gauges 1 to 3 are the pool gauges
+			suite.PrepareBalancerPool()
+			// gauge 4 is a perpetual gauge that is not a pool gauge
+			_, err := suite.App.IncentivesKeeper.CreateGauge(suite.Ctx, true, voter, sdk.Coins{}, lockuptypes.QueryCondition{
+				LockQueryType: lockuptypes.ByDuration,
+				Denom:         sdk.DefaultBondDenom,
+				Duration:      time.Hour,
+			}, suite.Ctx.BlockTime(), 1)
+			suite.Require().NoError(err)
+
+			if test.votingEnabled {
+				suite.enableGaugeVoting(sdk.OneDec())
+			}
+			if test.lockAmount > 0 {
+				coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, test.lockAmount))
+				suite.FundAcc(voter, coins)
+				suite.LockTokens(voter, coins, test.lockDuration)
+			}
+
+			err = keeper.VoteGauges(suite.Ctx, voter, test.votes)
+			if test.expectErr {
+				suite.Require().Error(err)
+				suite.Require().Empty(keeper.GetGaugeVotes(suite.Ctx, voter))
+				return
+			}
+			suite.Require().NoError(err)
+
+			// votes are stored sorted by gauge ID
+			suite.Require().Equal([]types.GaugeVote{
+				{GaugeId: 1, Weight: sdk.NewDecWithPrec(3, 1)},
+				{GaugeId: 3, Weight: sdk.NewDecWithPrec(5, 1)},
+			}, keeper.GetGaugeVotes(suite.Ctx, voter))
+
+			// empty votes remove the votes of the voter
+			err = keeper.VoteGauges(suite.Ctx, voter, nil)
+			suite.Require().NoError(err)
+			suite.Require().Empty(keeper.GetGaugeVotes(suite.Ctx, voter))
+			suite.Require().Empty(keeper.GetAllGaugeVotes(suite.Ctx))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGaugeVoteTally() {
+	suite.Setup()
+	keeper := suite.App.PoolIncentivesKeeper
+	suite.PrepareBalancerPool()
+	suite.enableGaugeVoting(sdk.NewDecWithPrec(5, 1))
+
+	voter1, voter2 := suite.TestAccs[0], suite.TestAccs[1]
+	suite.lockForVoting(voter1, 100, time.Hour)
+	suite.lockForVoting(voter2, 300, time.Hour*2)
+	// locks shorter than the min vote lock duration do not count towards the voting power
+	suite.lockForVoting(voter2, 1000, time.Minute)
+	suite.Require().Equal(sdk.NewInt(100), keeper.GetVotingPower(suite.Ctx, voter1))
+	suite.Require().Equal(sdk.NewInt(300), keeper.GetVotingPower(suite.Ctx, voter2))
+
+	suite.Require().NoError(keeper.VoteGauges(suite.Ctx, voter1, []types.GaugeVote{
+		{GaugeId: 1, Weight: sdk.OneDec()},
+	}))
+	suite.Require().NoError(keeper.VoteGauges(suite.Ctx, voter2, []types.GaugeVote{
+		{GaugeId: 1, Weight: sdk.NewDecWithPrec(5, 1)},
+		{GaugeId: 2, Weight: sdk.NewDecWithPrec(5, 1)},
+	}))
+
+	tally := keeper.GetGaugeVoteTally(suite.Ctx)
+	suite.Require().Equal([]types.DistrRecord{
+		{GaugeId: 1, Weight: sdk.NewInt(250)},
+		{GaugeId: 2, Weight: sdk.NewInt(150)},
+	}, tally)
+
+	// gauge 1 is capped at half of the total voting power, with the rest going to the community pool
+	suite.Require().Equal(types.DistrInfo{
+		TotalWeight: sdk.NewInt(400),
+		Records: []types.DistrRecord{
+			{GaugeId: 0, Weight: sdk.NewInt(50)},
+			{GaugeId: 1, Weight: sdk.NewInt(200)},
+			{GaugeId: 2, Weight: sdk.NewInt(150)},
+		},
+	}, keeper.GetGaugeVoteDistrInfo(suite.Ctx, tally))
+
+	// the votes of voters without voting power are not counted
+	err := suite.App.LockupKeeper.BeginUnlock(suite.Ctx, 2, nil)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.DistrRecord{
+		{GaugeId: 1, Weight: sdk.NewInt(100)},
+	}, keeper.GetGaugeVoteTally(suite.Ctx))
+}
+
+func (suite *KeeperTestSuite) TestGaugeVoteTallyLockChanges() {
+	suite.Setup()
+	keeper := suite.App.PoolIncentivesKeeper
+	suite.PrepareBalancerPool()
+	suite.enableGaugeVoting(sdk.OneDec())
+
+	voter := suite.TestAccs[0]
+	suite.lockForVoting(voter, 100, time.Hour)
+	suite.Require().NoError(keeper.VoteGauges(suite.Ctx, voter, []types.GaugeVote{
+		{GaugeId: 1, Weight: sdk.NewDecWithPrec(5, 1)},
+		{GaugeId: 2, Weight: sdk.NewDecWithPrec(5, 1)},
+	}))
+
+	// new locks of the voter count towards the tally right away
+	suite.lockForVoting(voter, 100, time.Hour*2)
+	suite.Require().Equal([]types.DistrRecord{
+		{GaugeId: 1, Weight: sdk.NewInt(100)},
+		{GaugeId: 2, Weight: sdk.NewInt(100)},
+	}, keeper.GetGaugeVoteTally(suite.Ctx))
+
+	// the voting power of a voter below the min voting power is not counted, but its votes are kept
+	suite.Require().NoError(suite.App.LockupKeeper.BeginUnlock(suite.Ctx, 1, nil))
+	suite.Require().NoError(suite.App.LockupKeeper.BeginUnlock(suite.Ctx, 2, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 60))))
+	suite.Require().Empty(keeper.GetGaugeVoteTally(suite.Ctx))
+	suite.Require().Len(keeper.GetGaugeVotes(suite.Ctx, voter), 2)
+
+	// and counted again once the voting power is back above the min voting power
+	suite.lockForVoting(voter, 60, time.Hour)
+	suite.Require().Equal([]types.DistrRecord{
+		{GaugeId: 1, Weight: sdk.NewInt(50)},
+		{GaugeId: 2, Weight: sdk.NewInt(50)},
+	}, keeper.GetGaugeVoteTally(suite.Ctx))
+
+	// votes are deleted once the voter has no voting power left
+	for _, lock := range suite.App.LockupKeeper.GetAccountLockedLongerDurationNotUnlockingOnly(suite.Ctx, voter, 0) {
+		suite.Require().NoError(suite.App.LockupKeeper.BeginUnlock(suite.Ctx, lock.ID, nil))
+	}
+	suite.Require().Empty(keeper.GetGaugeVoteTally(suite.Ctx))
+	suite.Require().Empty(keeper.GetAllGaugeVotes(suite.Ctx))
+}
+
+func (suite *KeeperTestSuite) TestRefreshGaugeVoteTally() {
+	suite.Setup()
+	keeper := suite.App.PoolIncentivesKeeper
+	suite.PrepareBalancerPool()
+	suite.enableGaugeVoting(sdk.OneDec())
+
+	voter1, voter2 := suite.TestAccs[0], suite.TestAccs[1]
+	suite.lockForVoting(voter1, 100, time.Hour)
+	suite.lockForVoting(voter2, 300, time.Hour*2)
+	for _, voter := range []sdk.AccAddress{voter1, voter2} {
+		suite.Require().NoError(keeper.VoteGauges(suite.Ctx, voter, []types.GaugeVote{{GaugeId: 1, Weight: sdk.OneDec()}}))
+	}
+	keeper.RefreshGaugeVoteTally(suite.Ctx)
+	suite.Require().Equal([]types.DistrRecord{{GaugeId: 1, Weight: sdk.NewInt(400)}}, keeper.GetGaugeVoteTally(suite.Ctx))
+
+	// the tally is rebuilt once the min vote lock duration changes, without any lock change
+	params := keeper.GetParams(suite.Ctx)
+	params.MinVoteLockDuration = time.Hour * 2
+	keeper.SetParams(suite.Ctx, params)
+	suite.Require().Equal([]types.DistrRecord{{GaugeId: 1, Weight: sdk.NewInt(400)}}, keeper.GetGaugeVoteTally(suite.Ctx))
+	keeper.RefreshGaugeVoteTally(suite.Ctx)
+	suite.Require().Equal([]types.DistrRecord{{GaugeId: 1, Weight: sdk.NewInt(300)}}, keeper.GetGaugeVoteTally(suite.Ctx))
+	suite.Require().Empty(keeper.GetGaugeVotes(suite.Ctx, voter1))
+}
+
+func (suite *KeeperTestSuite) TestAllocateAssetWithGaugeVotes() {
+	suite.Setup()
+	keeper := suite.App.PoolIncentivesKeeper
+	suite.PrepareBalancerPool()
+	suite.Require().NoError(keeper.ReplaceDistrRecords(suite.Ctx, types.DistrRecord{GaugeId: 3, Weight: sdk.NewInt(100)}))
+	suite.enableGaugeVoting(sdk.NewDecWithPrec(5, 1))
+
+	// governance set records are used while there are no votes
+	suite.FundModuleAcc(types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 4000)))
+	suite.Require().NoError(keeper.AllocateAsset(suite.Ctx))
+	gauge, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, 3)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 4000)), gauge.Coins)
+
+	voter1, voter2 := suite.TestAccs[0], suite.TestAccs[1]
+	suite.lockForVoting(voter1, 100, time.Hour)
+	suite.lockForVoting(voter2, 300, time.Hour)
+	suite.Require().NoError(keeper.VoteGauges(suite.Ctx, voter1, []types.GaugeVote{
+		{GaugeId: 1, Weight: sdk.OneDec()},
+	}))
+	suite.Require().NoError(keeper.VoteGauges(suite.Ctx, voter2, []types.GaugeVote{
+		{GaugeId: 1, Weight: sdk.NewDecWithPrec(5, 1)},
+		{GaugeId: 2, Weight: sdk.NewDecWithPrec(5, 1)},
+	}))
+
+	feePoolOrigin := suite.App.DistrKeeper.GetFeePool(suite.Ctx)
+	suite.FundModuleAcc(types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 4000)))
+	suite.Require().NoError(keeper.AllocateAsset(suite.Ctx))
+
+	// the records derived from the votes are used instead of the governance set records, which are kept
+	governanceDistrInfo := types.DistrInfo{
+		TotalWeight: sdk.NewInt(100),
+		Records:     []types.DistrRecord{{GaugeId: 3, Weight: sdk.NewInt(100)}},
+	}
+	suite.Require().Equal(governanceDistrInfo, keeper.GetDistrInfo(suite.Ctx))
+
+	expectedGaugeCoins := map[uint64]int64{1: 2000, 2: 1500, 3: 4000}
+	for gaugeId, amount := range expectedGaugeCoins {
+		gauge, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gaugeId)
+		suite.Require().NoError(err)
+		suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount)), gauge.Coins)
+	}
+
+	feePoolNew := suite.App.DistrKeeper.GetFeePool(suite.Ctx)
+	suite.Require().Equal(feePoolOrigin.CommunityPool.Add(sdk.NewInt64DecCoin(sdk.DefaultBondDenom, 500)), feePoolNew.CommunityPool)
+
+	// once all the votes are withdrawn, the governance set records are used again
+	for _, voter := range []sdk.AccAddress{voter1, voter2} {
+		suite.Require().NoError(keeper.VoteGauges(suite.Ctx, voter, nil))
+	}
+	suite.FundModuleAcc(types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 4000)))
+	suite.Require().NoError(keeper.AllocateAsset(suite.Ctx))
+	suite.Require().Equal(governanceDistrInfo, keeper.GetDistrInfo(suite.Ctx))
+
+	expectedGaugeCoins = map[uint64]int64{1: 2000, 2: 1500, 3: 8000}
+	for gaugeId, amount := range expectedGaugeCoins {
+		gauge, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gaugeId)
+		suite.Require().NoError(err)
+		suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount)), gauge.Coins)
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgVoteGauges() {
+	suite.Setup()
+	suite.PrepareBalancerPool()
+	suite.enableGaugeVoting(sdk.OneDec())
+
+	voter := suite.TestAccs[0]
+	suite.lockForVoting(voter, 100, time.Hour)
+
+	msgServer := keeper.NewMsgServerImpl(suite.App.PoolIncentivesKeeper)
+	ctx := suite.Ctx.WithEventManager(sdk.NewEventManager())
+	_, err := msgServer.VoteGauges(sdk.WrapSDKContext(ctx), types.NewMsgVoteGauges(voter, []types.GaugeVote{
+		{GaugeId: 2, Weight: sdk.OneDec()},
+	}))
+	suite.Require().NoError(err)
+	suite.AssertEventEmitted(ctx, types.TypeEvtVoteGauges, 1)
+
+	_, err = msgServer.VoteGauges(sdk.WrapSDKContext(ctx), types.NewMsgVoteGauges(voter, []types.GaugeVote{
+		{GaugeId: 100, Weight: sdk.OneDec()},
+	}))
+	suite.Require().Error(err)
+	suite.Require().Equal([]types.GaugeVote{{GaugeId: 2, Weight: sdk.OneDec()}}, suite.App.PoolIncentivesKeeper.GetGaugeVotes(suite.Ctx, voter))
+}
+
+func (suite *KeeperTestSuite) lockForVoting(addr sdk.AccAddress, amount int64, duration time.Duration) {
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount))
+	suite.FundAcc(addr, coins)
+	suite.LockTokens(addr, coins, duration)
+}
@@ -22,6 +22,16 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 			k.SetPoolGaugeId(ctx, record.PoolId, record.Duration, record.GaugeId)
 		}
 	}
+	// the locks the voting power derives from are initialized after this module,
+	// so the tally is rebuilt from the exported voting power of every voter.
+	for _, gaugeVotes := range genState.GaugeVotes {
+		if gaugeVotes.VotingPower.IsNil() {
+			gaugeVotes.VotingPower = sdk.ZeroInt()
+		}
+		k.SetGaugeVotes(ctx, gaugeVotes)
+		k.addGaugeVotesToTally(ctx, gaugeVotes, false)
+	}
+	k.setGaugeVoteTallyParams(ctx, genState.Params)
 }
 
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
@@ -48,5 +58,6 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		LockableDurations: k.GetLockableDurations(ctx),
 		DistrInfo:         &distrInfo,
 		PoolToGauges:      &poolToGauges,
+		GaugeVotes:        k.GetAllGaugeVotes(ctx),
 	}
 }
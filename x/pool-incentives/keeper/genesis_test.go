@@ -19,9 +19,7 @@ import (
 var (
 	now         = time.Now().UTC()
 	testGenesis = types.GenesisState{
-		Params: types.Params{
-			MintedDenom: "uosmo",
-		},
+		Params: types.NewParams("uosmo", true, time.Hour, sdk.NewDecWithPrec(5, 1), sdk.NewInt(100)),
 		LockableDurations: []time.Duration{
 			time.Second,
 			time.Minute,
@@ -36,6 +34,18 @@ var (
 				},
 			},
 		},
+		GaugeVotes: []types.GaugeVotes{
+			{
+				Voter: sdk.AccAddress([]byte("addr1---------------")).String(),
+				Votes: []types.GaugeVote{
+					{
+						GaugeId: 1,
+						Weight:  sdk.NewDecWithPrec(6, 1),
+					},
+				},
+				VotingPower: sdk.NewInt(1000),
+			},
+		},
 	}
 )
 
@@ -77,6 +87,10 @@ func TestInitGenesis(t *testing.T) {
 
 	distrInfo := app.PoolIncentivesKeeper.GetDistrInfo(ctx)
 	require.Equal(t, distrInfo, *genesis.DistrInfo)
+
+	gaugeVotes := app.PoolIncentivesKeeper.GetAllGaugeVotes(ctx)
+	require.Equal(t, gaugeVotes, genesis.GaugeVotes)
+	require.Equal(t, []types.DistrRecord{{GaugeId: 1, Weight: sdk.NewInt(600)}}, app.PoolIncentivesKeeper.GetGaugeVoteTally(ctx))
 }
 
 func (suite *KeeperTestSuite) TestExportGenesis() {
@@ -113,4 +127,5 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 	suite.Equal(genesisExported.LockableDurations, durations)
 	suite.Equal(genesisExported.DistrInfo, genesis.DistrInfo)
 	suite.Equal(genesisExported.PoolToGauges, &expectedPoolToGauges)
+	suite.Equal(genesisExported.GaugeVotes, genesis.GaugeVotes)
 }
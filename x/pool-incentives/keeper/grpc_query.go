@@ -142,3 +142,32 @@ func (q Querier) ExternalIncentiveGauges(ctx context.Context, req *types.QueryEx
 
 	return &types.QueryExternalIncentiveGaugesResponse{Data: gauges}, nil
 }
+
+// GaugeVoteTally returns the voting power allocated to each gauge by the current gauge votes,
+// and the distribution records derived from it.
+func (q Querier) GaugeVoteTally(ctx context.Context, _ *types.QueryGaugeVoteTallyRequest) (*types.QueryGaugeVoteTallyResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	tally := q.Keeper.GetGaugeVoteTally(sdkCtx)
+	return &types.QueryGaugeVoteTallyResponse{
+		Tally:     tally,
+		DistrInfo: q.Keeper.GetGaugeVoteDistrInfo(sdkCtx, tally),
+	}, nil
+}
+
+// GaugeVotes returns the gauge votes and voting power of a voter.
+func (q Querier) GaugeVotes(ctx context.Context, req *types.QueryGaugeVotesRequest) (*types.QueryGaugeVotesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	voter, err := sdk.AccAddressFromBech32(req.Voter)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return &types.QueryGaugeVotesResponse{
+		Votes:       q.Keeper.GetGaugeVotes(sdkCtx, voter),
+		VotingPower: q.Keeper.GetVotingPower(sdkCtx, voter),
+	}, nil
+}
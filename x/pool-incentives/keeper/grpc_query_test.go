@@ -290,3 +290,47 @@ func (suite *KeeperTestSuite) TestGaugeIncentivePercentage() {
 	suite.Require().Equal("33.333333333333333300", res.GaugeIdsWithDuration[1].GaugeIncentivePercentage)
 	suite.Require().Equal("50.000000000000000000", res.GaugeIdsWithDuration[2].GaugeIncentivePercentage)
 }
+
+func (suite *KeeperTestSuite) TestGaugeVoteQueries() {
+	suite.SetupTest()
+	suite.PrepareBalancerPool()
+	suite.enableGaugeVoting(sdk.NewDecWithPrec(5, 1))
+
+	voter1, voter2 := suite.TestAccs[0], suite.TestAccs[1]
+	suite.lockForVoting(voter1, 100, time.Hour)
+	suite.Require().NoError(suite.App.PoolIncentivesKeeper.VoteGauges(suite.Ctx, voter1, []types.GaugeVote{
+		{GaugeId: 1, Weight: sdk.NewDecWithPrec(5, 1)},
+		{GaugeId: 2, Weight: sdk.NewDecWithPrec(2, 1)},
+	}))
+
+	votesRes, err := suite.queryClient.GaugeVotes(context.Background(), &types.QueryGaugeVotesRequest{Voter: voter1.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(100), votesRes.VotingPower)
+	suite.Require().Equal([]types.GaugeVote{
+		{GaugeId: 1, Weight: sdk.NewDecWithPrec(5, 1)},
+		{GaugeId: 2, Weight: sdk.NewDecWithPrec(2, 1)},
+	}, votesRes.Votes)
+
+	votesRes, err = suite.queryClient.GaugeVotes(context.Background(), &types.QueryGaugeVotesRequest{Voter: voter2.String()})
+	suite.Require().NoError(err)
+	suite.Require().True(votesRes.VotingPower.IsZero())
+	suite.Require().Empty(votesRes.Votes)
+
+	_, err = suite.queryClient.GaugeVotes(context.Background(), &types.QueryGaugeVotesRequest{Voter: "invalid"})
+	suite.Require().Error(err)
+
+	tallyRes, err := suite.queryClient.GaugeVoteTally(context.Background(), &types.QueryGaugeVoteTallyRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.DistrRecord{
+		{GaugeId: 1, Weight: sdk.NewInt(50)},
+		{GaugeId: 2, Weight: sdk.NewInt(20)},
+	}, tallyRes.Tally)
+	suite.Require().Equal(types.DistrInfo{
+		TotalWeight: sdk.NewInt(70),
+		Records: []types.DistrRecord{
+			{GaugeId: 0, Weight: sdk.NewInt(15)},
+			{GaugeId: 1, Weight: sdk.NewInt(35)},
+			{GaugeId: 2, Weight: sdk.NewInt(20)},
+		},
+	}, tallyRes.DistrInfo)
+}
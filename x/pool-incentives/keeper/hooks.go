@@ -1,9 +1,12 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	gammtypes "github.com/osmosis-labs/osmosis/v12/x/gamm/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/v12/x/lockup/types"
 	minttypes "github.com/osmosis-labs/osmosis/v12/x/mint/types"
 )
 
@@ -60,3 +63,68 @@ func (h Hooks) AfterDistributeMintedCoin(ctx sdk.Context) {
 		panic(err)
 	}
 }
+
+// ___________________________________________________________________________________________________
+
+// lockuphook is the wrapper struct for the pool incentives keeper, listening to lock changes.
+type lockuphook struct {
+	k Keeper
+}
+
+var _ lockuptypes.LockupHooks = lockuphook{}
+
+// LockupHooks returns the lockup hook wrapper struct, updating the voting power of voters whenever their locks change.
+func (k Keeper) LockupHooks() lockuptypes.LockupHooks {
+	return lockuphook{k}
+}
+
+// updateLockVotingPower updates the voting power of the owner of the lock.
+func (h lockuphook) updateLockVotingPower(ctx sdk.Context, lockID uint64) {
+	lock, err := h.k.lockupKeeper.GetLockByID(ctx, lockID)
+	if err != nil {
+		h.k.Logger(ctx).Error(err.Error())
+		return
+	}
+	h.k.updateVotingPower(ctx, lock.OwnerAddress())
+}
+
+func (h lockuphook) AfterAddTokensToLock(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins) {
+	h.k.updateVotingPower(ctx, address)
+}
+
+func (h lockuphook) OnTokenLocked(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time) {
+	h.k.updateVotingPower(ctx, address)
+}
+
+func (h lockuphook) OnStartUnlock(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time) {
+	h.k.updateVotingPower(ctx, address)
+}
+
+// OnTokenUnlocked is a noop, as unlocking locks do not count towards the voting power.
+func (h lockuphook) OnTokenUnlocked(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time) {
+}
+
+func (h lockuphook) OnTokenSlashed(ctx sdk.Context, lockID uint64, amount sdk.Coins) {
+	h.updateLockVotingPower(ctx, lockID)
+}
+
+func (h lockuphook) OnLockupExtend(ctx sdk.Context, lockID uint64, prevDuration time.Duration, newDuration time.Duration) {
+	h.updateLockVotingPower(ctx, lockID)
+}
+
+func (h lockuphook) OnLockSplit(ctx sdk.Context, lockID uint64, splitLockID uint64, amount sdk.Coins) {
+	h.updateLockVotingPower(ctx, lockID)
+}
+
+func (h lockuphook) OnLockTransfer(ctx sdk.Context, lockID uint64, prevOwner sdk.AccAddress, newOwner sdk.AccAddress) {
+	h.k.updateVotingPower(ctx, prevOwner)
+	h.k.updateVotingPower(ctx, newOwner)
+}
+
+// OnLocksMerge is a noop, as merged locks have the same owner and duration.
+func (h lockuphook) OnLocksMerge(ctx sdk.Context, fromLockID uint64, toLockID uint64, amount sdk.Coins) {
+}
+
+// AfterLockMatured is a noop, as unlocking locks do not count towards the voting power.
+func (h lockuphook) AfterLockMatured(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time) {
+}
@@ -28,9 +28,10 @@ type Keeper struct {
 	incentivesKeeper types.IncentivesKeeper
 	distrKeeper      types.DistrKeeper
 	gammKeeper       types.GAMMKeeper
+	lockupKeeper     types.LockupKeeper
 }
 
-func NewKeeper(cdc codec.BinaryCodec, storeKey sdk.StoreKey, paramSpace paramtypes.Subspace, accountKeeper types.AccountKeeper, bankKeeper types.BankKeeper, incentivesKeeper types.IncentivesKeeper, distrKeeper types.DistrKeeper, gammKeeper types.GAMMKeeper) Keeper {
+func NewKeeper(cdc codec.BinaryCodec, storeKey sdk.StoreKey, paramSpace paramtypes.Subspace, accountKeeper types.AccountKeeper, bankKeeper types.BankKeeper, incentivesKeeper types.IncentivesKeeper, distrKeeper types.DistrKeeper, gammKeeper types.GAMMKeeper, lockupKeeper types.LockupKeeper) Keeper {
 	// ensure pool-incentives module account is set
 	if addr := accountKeeper.GetModuleAddress(types.ModuleName); addr == nil {
 		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
@@ -52,6 +53,7 @@ func NewKeeper(cdc codec.BinaryCodec, storeKey sdk.StoreKey, paramSpace paramtyp
 		incentivesKeeper: incentivesKeeper,
 		distrKeeper:      distrKeeper,
 		gammKeeper:       gammKeeper,
+		lockupKeeper:     lockupKeeper,
 	}
 }
 
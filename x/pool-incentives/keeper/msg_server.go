@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v12/x/pool-incentives/types"
+)
+
+// msgServer provides a way to reference keeper pointer in the message server interface.
+type msgServer struct {
+	keeper *Keeper
+}
+
+// NewMsgServerImpl returns an instance of MsgServer for the provided keeper.
+func NewMsgServerImpl(keeper *Keeper) types.MsgServer {
+	return &msgServer{
+		keeper: keeper,
+	}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// VoteGauges replaces the gauge votes of the voter with the given votes.
+func (server msgServer) VoteGauges(goCtx context.Context, msg *types.MsgVoteGauges) (*types.MsgVoteGaugesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	voter, err := sdk.AccAddressFromBech32(msg.Voter)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := server.keeper.VoteGauges(ctx, voter, msg.Votes); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtVoteGauges,
+			sdk.NewAttribute(types.AttributeVoter, msg.Voter),
+			sdk.NewAttribute(types.AttributeVotingPower, server.keeper.GetVotingPower(ctx, voter).String()),
+		),
+	})
+
+	return &types.MsgVoteGaugesResponse{}, nil
+}
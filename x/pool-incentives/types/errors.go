@@ -14,4 +14,8 @@ var (
 
 	ErrEmptyProposalRecords  = sdkerrors.Register(ModuleName, 10, "records are empty")
 	ErrEmptyProposalGaugeIds = sdkerrors.Register(ModuleName, 11, "gauge ids are empty")
+
+	ErrGaugeVotingDisabled = sdkerrors.Register(ModuleName, 20, "gauge voting is disabled")
+	ErrNoVotingPower       = sdkerrors.Register(ModuleName, 21, "voter has no voting power")
+	ErrInvalidGaugeVote    = sdkerrors.Register(ModuleName, 22, "invalid gauge vote")
 )
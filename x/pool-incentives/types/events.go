@@ -0,0 +1,9 @@
+package types
+
+// Pool-incentives module event types.
+const (
+	TypeEvtVoteGauges = "vote_gauges"
+
+	AttributeVoter       = "voter"
+	AttributeVotingPower = "voting_power"
+)
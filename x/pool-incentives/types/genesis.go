@@ -57,6 +57,10 @@ func ValidateGenesis(data *GenesisState) error {
 		return errors.New("distrinfo weight should not be negative")
 	}
 
+	if err := validateGaugeVotes(data.GaugeVotes); err != nil {
+		return err
+	}
+
 	return validateLockableDurations(data.LockableDurations)
 }
 
@@ -69,3 +73,25 @@ func validateLockableDurations(i interface{}) error {
 
 	return nil
 }
+
+// validateGaugeVotes ensures the provided gauge votes are of valid voters, each with valid votes.
+func validateGaugeVotes(allGaugeVotes []GaugeVotes) error {
+	voters := make(map[string]bool)
+	for _, gaugeVotes := range allGaugeVotes {
+		msg := MsgVoteGauges{Voter: gaugeVotes.Voter, Votes: gaugeVotes.Votes}
+		if err := msg.ValidateBasic(); err != nil {
+			return err
+		}
+
+		if !gaugeVotes.VotingPower.IsNil() && gaugeVotes.VotingPower.IsNegative() {
+			return fmt.Errorf("negative voting power of voter %s", gaugeVotes.Voter)
+		}
+
+		if voters[gaugeVotes.Voter] {
+			return fmt.Errorf("duplicate gauge votes of voter %s", gaugeVotes.Voter)
+		}
+		voters[gaugeVotes.Voter] = true
+	}
+
+	return nil
+}
@@ -34,6 +34,7 @@ type GenesisState struct {
 	LockableDurations []time.Duration `protobuf:"bytes,2,rep,name=lockable_durations,json=lockableDurations,proto3,stdduration" json:"lockable_durations" yaml:"lockable_durations"`
 	DistrInfo         *DistrInfo      `protobuf:"bytes,3,opt,name=distr_info,json=distrInfo,proto3" json:"distr_info,omitempty" yaml:"distr_info"`
 	PoolToGauges      *PoolToGauges   `protobuf:"bytes,4,opt,name=pool_to_gauges,json=poolToGauges,proto3" json:"pool_to_gauges,omitempty" yaml:"pool_to_gauges"`
+	GaugeVotes        []GaugeVotes    `protobuf:"bytes,5,rep,name=gauge_votes,json=gaugeVotes,proto3" json:"gauge_votes" yaml:"gauge_votes"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -97,6 +98,13 @@ func (m *GenesisState) GetPoolToGauges() *PoolToGauges {
 	return nil
 }
 
+func (m *GenesisState) GetGaugeVotes() []GaugeVotes {
+	if m != nil {
+		return m.GaugeVotes
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "osmosis.poolincentives.v1beta1.GenesisState")
 }
@@ -106,32 +114,34 @@ func init() {
 }
 
 var fileDescriptor_cc1f078212600632 = []byte{
-	// 392 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xcd, 0xaa, 0xd3, 0x40,
-	0x14, 0xc7, 0x13, 0x7b, 0xb9, 0x60, 0xee, 0x45, 0xb8, 0x41, 0x21, 0x2d, 0x38, 0x29, 0x01, 0xa5,
-	0x82, 0x9d, 0xb1, 0x75, 0xa7, 0xbb, 0x50, 0x28, 0xee, 0x24, 0xea, 0xc6, 0x4d, 0x98, 0xa4, 0xd3,
-	0x71, 0x30, 0xc9, 0x89, 0x99, 0x49, 0xb1, 0x6f, 0xe1, 0xd2, 0x8d, 0xef, 0xd3, 0x65, 0x97, 0xae,
-	0xa2, 0xb4, 0x6f, 0xd0, 0x27, 0x90, 0x4c, 0x12, 0x5a, 0x29, 0xdc, 0xee, 0x32, 0x9c, 0xdf, 0xff,
-	0xe3, 0x1c, 0x62, 0x8d, 0x41, 0xa6, 0x20, 0x85, 0x24, 0x39, 0x40, 0x32, 0x16, 0x59, 0xcc, 0x32,
-	0x25, 0x56, 0x4c, 0x92, 0xd5, 0x24, 0x62, 0x8a, 0x4e, 0x08, 0x67, 0x19, 0x93, 0x42, 0xe2, 0xbc,
-	0x00, 0x05, 0x36, 0x6a, 0x71, 0x5c, 0xe3, 0x47, 0x1a, 0xb7, 0xf4, 0xe0, 0x31, 0x07, 0x0e, 0x1a,
-	0x25, 0xf5, 0x57, 0xa3, 0x1a, 0x20, 0x0e, 0xc0, 0x13, 0x46, 0xf4, 0x2b, 0x2a, 0x97, 0x64, 0x51,
-	0x16, 0x54, 0x09, 0xc8, 0xda, 0xf9, 0xab, 0x4b, 0x25, 0x4e, 0x92, 0xb4, 0xc2, 0xfb, 0xd5, 0xb3,
-	0x6e, 0xe7, 0x4d, 0xb3, 0x0f, 0x8a, 0x2a, 0x66, 0xcf, 0xac, 0xeb, 0x9c, 0x16, 0x34, 0x95, 0x8e,
-	0x39, 0x34, 0x47, 0x37, 0xd3, 0xe7, 0xf8, 0xfe, 0xa6, 0xf8, 0xbd, 0xa6, 0xfd, 0xab, 0x4d, 0xe5,
-	0x1a, 0x41, 0xab, 0xb5, 0xc1, 0xb2, 0x13, 0x88, 0xbf, 0xd2, 0x28, 0x61, 0x61, 0xd7, 0x51, 0x3a,
-	0x0f, 0x86, 0xbd, 0xd1, 0xcd, 0xb4, 0x8f, 0x9b, 0x2d, 0x70, 0xb7, 0x05, 0x9e, 0xb5, 0x84, 0xff,
-	0xac, 0x36, 0x39, 0x54, 0x6e, 0x7f, 0x4d, 0xd3, 0xe4, 0x8d, 0x77, 0x6e, 0xe1, 0xfd, 0xfc, 0xe3,
-	0x9a, 0xc1, 0x5d, 0x37, 0xe8, 0x84, 0xd2, 0x8e, 0x2d, 0x6b, 0x21, 0xa4, 0x2a, 0x42, 0x91, 0x2d,
-	0xc1, 0xe9, 0xe9, 0xea, 0x2f, 0x2e, 0x55, 0x9f, 0xd5, 0x8a, 0x77, 0xd9, 0x12, 0xfc, 0xfe, 0xa6,
-	0x72, 0xcd, 0x43, 0xe5, 0xde, 0x35, 0xc1, 0x47, 0x2b, 0x2f, 0x78, 0xb8, 0xe8, 0x28, 0xfb, 0x9b,
-	0xf5, 0xa8, 0x76, 0x0a, 0x15, 0x84, 0x9c, 0x96, 0x9c, 0x49, 0xe7, 0x4a, 0x07, 0xbd, 0xbc, 0x78,
-	0x23, 0x80, 0xe4, 0x23, 0xcc, 0xb5, 0xc6, 0x7f, 0xda, 0x66, 0x3d, 0x69, 0xb2, 0xfe, 0x77, 0xf4,
-	0x82, 0xdb, 0xfc, 0x14, 0xfe, 0xb4, 0xd9, 0x21, 0x73, 0xbb, 0x43, 0xe6, 0xdf, 0x1d, 0x32, 0x7f,
-	0xec, 0x91, 0xb1, 0xdd, 0x23, 0xe3, 0xf7, 0x1e, 0x19, 0x9f, 0xdf, 0x72, 0xa1, 0xbe, 0x94, 0x11,
-	0x8e, 0x21, 0x25, 0x6d, 0xfc, 0x38, 0xa1, 0x91, 0xec, 0x1e, 0x64, 0x35, 0x99, 0x92, 0xef, 0x67,
-	0x7f, 0x82, 0x5a, 0xe7, 0x4c, 0x46, 0xd7, 0xfa, 0xf6, 0xaf, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff,
-	0x76, 0x99, 0xc9, 0xcb, 0xb6, 0x02, 0x00, 0x00,
+	// 424 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x4d, 0x8b, 0xd3, 0x40,
+	0x18, 0xc7, 0x1b, 0xb7, 0x2e, 0x38, 0x5d, 0x84, 0x1d, 0x14, 0xd2, 0x82, 0x93, 0x25, 0xa0, 0xac,
+	0x62, 0x67, 0x6c, 0xbd, 0xe9, 0x2d, 0x14, 0x16, 0x6f, 0x12, 0x5f, 0x0e, 0x5e, 0xc2, 0xa4, 0x9d,
+	0x8e, 0x83, 0x69, 0x9e, 0xd8, 0x99, 0x16, 0xf7, 0x0b, 0x78, 0xf6, 0xe8, 0x47, 0xea, 0x71, 0x8f,
+	0x9e, 0xa2, 0xb4, 0xdf, 0x60, 0x3f, 0x81, 0x64, 0x32, 0xa1, 0x95, 0x82, 0xb9, 0x65, 0x78, 0x7e,
+	0xff, 0x97, 0x79, 0x32, 0x68, 0x08, 0x7a, 0x01, 0x5a, 0x69, 0x56, 0x00, 0x64, 0x43, 0x95, 0x4f,
+	0x45, 0x6e, 0xd4, 0x5a, 0x68, 0xb6, 0x1e, 0xa5, 0xc2, 0xf0, 0x11, 0x93, 0x22, 0x17, 0x5a, 0x69,
+	0x5a, 0x2c, 0xc1, 0x00, 0x26, 0x0e, 0xa7, 0x15, 0xbe, 0xa7, 0xa9, 0xa3, 0x07, 0x0f, 0x24, 0x48,
+	0xb0, 0x28, 0xab, 0xbe, 0x6a, 0xd5, 0x80, 0x48, 0x00, 0x99, 0x09, 0x66, 0x4f, 0xe9, 0x6a, 0xce,
+	0x66, 0xab, 0x25, 0x37, 0x0a, 0x72, 0x37, 0x7f, 0xd1, 0x56, 0xe2, 0x20, 0xc9, 0x2a, 0xc2, 0xef,
+	0x5d, 0x74, 0x76, 0x55, 0x37, 0x7b, 0x67, 0xb8, 0x11, 0x78, 0x82, 0x4e, 0x0b, 0xbe, 0xe4, 0x0b,
+	0xed, 0x7b, 0x17, 0xde, 0x65, 0x6f, 0xfc, 0x84, 0xfe, 0xbf, 0x29, 0x7d, 0x6b, 0xe9, 0xa8, 0xbb,
+	0x29, 0x83, 0x4e, 0xec, 0xb4, 0x18, 0x10, 0xce, 0x60, 0xfa, 0x85, 0xa7, 0x99, 0x48, 0x9a, 0x8e,
+	0xda, 0xbf, 0x73, 0x71, 0x72, 0xd9, 0x1b, 0xf7, 0x69, 0x7d, 0x0b, 0xda, 0xdc, 0x82, 0x4e, 0x1c,
+	0x11, 0x3d, 0xae, 0x4c, 0x6e, 0xcb, 0xa0, 0x7f, 0xcd, 0x17, 0xd9, 0xab, 0xf0, 0xd8, 0x22, 0xfc,
+	0xf9, 0x3b, 0xf0, 0xe2, 0xf3, 0x66, 0xd0, 0x08, 0x35, 0x9e, 0x22, 0x34, 0x53, 0xda, 0x2c, 0x13,
+	0x95, 0xcf, 0xc1, 0x3f, 0xb1, 0xd5, 0x9f, 0xb6, 0x55, 0x9f, 0x54, 0x8a, 0x37, 0xf9, 0x1c, 0xa2,
+	0xfe, 0xa6, 0x0c, 0xbc, 0xdb, 0x32, 0x38, 0xaf, 0x83, 0xf7, 0x56, 0x61, 0x7c, 0x6f, 0xd6, 0x50,
+	0xf8, 0x2b, 0xba, 0x5f, 0x39, 0x25, 0x06, 0x12, 0xc9, 0x57, 0x52, 0x68, 0xbf, 0x6b, 0x83, 0x9e,
+	0xb7, 0xee, 0x08, 0x20, 0x7b, 0x0f, 0x57, 0x56, 0x13, 0x3d, 0x72, 0x59, 0x0f, 0xeb, 0xac, 0x7f,
+	0x1d, 0xc3, 0xf8, 0xac, 0x38, 0x80, 0xb1, 0x44, 0x3d, 0x3b, 0x48, 0xd6, 0x60, 0x84, 0xf6, 0xef,
+	0xda, 0x0d, 0x3e, 0x6b, 0xcb, 0xb3, 0xe2, 0x8f, 0x95, 0x22, 0x1a, 0xb8, 0x95, 0xe2, 0x3a, 0xed,
+	0xc0, 0x2c, 0x8c, 0x91, 0xdc, 0x73, 0x1f, 0x36, 0x5b, 0xe2, 0xdd, 0x6c, 0x89, 0xf7, 0x67, 0x4b,
+	0xbc, 0x1f, 0x3b, 0xd2, 0xb9, 0xd9, 0x91, 0xce, 0xaf, 0x1d, 0xe9, 0x7c, 0x7a, 0x2d, 0x95, 0xf9,
+	0xbc, 0x4a, 0xe9, 0x14, 0x16, 0xcc, 0xe5, 0x0e, 0x33, 0x9e, 0xea, 0xe6, 0xc0, 0xd6, 0xa3, 0x31,
+	0xfb, 0x76, 0xf4, 0xe4, 0xcc, 0x75, 0x21, 0x74, 0x7a, 0x6a, 0x7f, 0xf2, 0xcb, 0xbf, 0x03, 0x00,
+	0x7e, 0x70, 0x90, 0x62, 0x1f, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -154,6 +164,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.GaugeVotes) > 0 {
+		for iNdEx := len(m.GaugeVotes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GaugeVotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if m.PoolToGauges != nil {
 		{
 			size, err := m.PoolToGauges.MarshalToSizedBuffer(dAtA[:i])
@@ -236,6 +260,12 @@ func (m *GenesisState) Size() (n int) {
 		l = m.PoolToGauges.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.GaugeVotes) > 0 {
+		for _, e := range m.GaugeVotes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -413,6 +443,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GaugeVotes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GaugeVotes = append(m.GaugeVotes, GaugeVotes{})
+			if err := m.GaugeVotes[len(m.GaugeVotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -53,6 +53,27 @@ func TestGenesisStateMarshalUnmarshal(t *testing.T) {
 				},
 			},
 		},
+		{ // gauge votes
+			state: &types.GenesisState{
+				Params:            types.DefaultParams(),
+				LockableDurations: []time.Duration(nil),
+				DistrInfo: &types.DistrInfo{
+					TotalWeight: sdk.ZeroInt(),
+					Records:     nil,
+				},
+				GaugeVotes: []types.GaugeVotes{
+					{
+						Voter: sdk.AccAddress([]byte("addr1---------------")).String(),
+						Votes: []types.GaugeVote{
+							{
+								GaugeId: 1,
+								Weight:  sdk.NewDecWithPrec(5, 1),
+							},
+						},
+					},
+				},
+			},
+		},
 		{ // empty params
 			state: &types.GenesisState{
 				Params:            types.Params{},
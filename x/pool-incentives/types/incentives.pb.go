@@ -34,6 +34,21 @@ type Params struct {
 	// itself, but rather manages the distribution of coins that matches the
 	// defined minted_denom.
 	MintedDenom string `protobuf:"bytes,1,opt,name=minted_denom,json=mintedDenom,proto3" json:"minted_denom,omitempty" yaml:"minted_denom"`
+	// gauge_voting_enabled determines whether the distribution records are
+	// derived from the gauge votes of lockers each time minted coins are
+	// allocated. Governance set records are used while there are no votes.
+	GaugeVotingEnabled bool `protobuf:"varint,2,opt,name=gauge_voting_enabled,json=gaugeVotingEnabled,proto3" json:"gauge_voting_enabled,omitempty" yaml:"gauge_voting_enabled"`
+	// min_vote_lock_duration is the minimum duration of the locks of the minted
+	// denom that count towards the voting power of a voter.
+	MinVoteLockDuration time.Duration `protobuf:"bytes,3,opt,name=min_vote_lock_duration,json=minVoteLockDuration,proto3,stdduration" json:"min_vote_lock_duration" yaml:"min_vote_lock_duration"`
+	// max_gauge_weight is the maximum fraction of the total voting power a
+	// single gauge is weighted with. Votes over the cap are allocated to the
+	// community pool.
+	MaxGaugeWeight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=max_gauge_weight,json=maxGaugeWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_gauge_weight" yaml:"max_gauge_weight"`
+	// min_voting_power is the minimum voting power a voter needs to vote on
+	// gauges. The votes of voters whose voting power drops below it are not
+	// counted until it is reached again.
+	MinVotingPower github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=min_voting_power,json=minVotingPower,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_voting_power" yaml:"min_voting_power"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -75,6 +90,20 @@ func (m *Params) GetMintedDenom() string {
 	return ""
 }
 
+func (m *Params) GetGaugeVotingEnabled() bool {
+	if m != nil {
+		return m.GaugeVotingEnabled
+	}
+	return false
+}
+
+func (m *Params) GetMinVoteLockDuration() time.Duration {
+	if m != nil {
+		return m.MinVoteLockDuration
+	}
+	return 0
+}
+
 type LockableDurationsInfo struct {
 	LockableDurations []time.Duration `protobuf:"bytes,1,rep,name=lockable_durations,json=lockableDurations,proto3,stdduration" json:"lockable_durations" yaml:"lockable_durations"`
 }
@@ -313,6 +342,110 @@ func (m *PoolToGauges) GetPoolToGauge() []PoolToGauge {
 	return nil
 }
 
+// GaugeVote allocates a fraction of the voting power of a voter to a pool
+// gauge.
+type GaugeVote struct {
+	GaugeId uint64                                 `protobuf:"varint,1,opt,name=gauge_id,json=gaugeId,proto3" json:"gauge_id,omitempty" yaml:"gauge_id"`
+	Weight  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight"`
+}
+
+func (m *GaugeVote) Reset()         { *m = GaugeVote{} }
+func (m *GaugeVote) String() string { return proto.CompactTextString(m) }
+func (*GaugeVote) ProtoMessage()    {}
+func (*GaugeVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a8153bad03e553d1, []int{6}
+}
+func (m *GaugeVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GaugeVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GaugeVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GaugeVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GaugeVote.Merge(m, src)
+}
+func (m *GaugeVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *GaugeVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_GaugeVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GaugeVote proto.InternalMessageInfo
+
+func (m *GaugeVote) GetGaugeId() uint64 {
+	if m != nil {
+		return m.GaugeId
+	}
+	return 0
+}
+
+// GaugeVotes is the gauge votes of a voter, which remain in effect until the
+// voter changes them.
+type GaugeVotes struct {
+	Voter string      `protobuf:"bytes,1,opt,name=voter,proto3" json:"voter,omitempty" yaml:"voter"`
+	Votes []GaugeVote `protobuf:"bytes,2,rep,name=votes,proto3" json:"votes"`
+	// voting_power is the voting power of the voter counted in the gauge vote
+	// tally, as of the last time the votes or the locks of the voter changed.
+	VotingPower github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=voting_power,json=votingPower,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"voting_power" yaml:"voting_power"`
+}
+
+func (m *GaugeVotes) Reset()         { *m = GaugeVotes{} }
+func (m *GaugeVotes) String() string { return proto.CompactTextString(m) }
+func (*GaugeVotes) ProtoMessage()    {}
+func (*GaugeVotes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a8153bad03e553d1, []int{7}
+}
+func (m *GaugeVotes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GaugeVotes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GaugeVotes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GaugeVotes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GaugeVotes.Merge(m, src)
+}
+func (m *GaugeVotes) XXX_Size() int {
+	return m.Size()
+}
+func (m *GaugeVotes) XXX_DiscardUnknown() {
+	xxx_messageInfo_GaugeVotes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GaugeVotes proto.InternalMessageInfo
+
+func (m *GaugeVotes) GetVoter() string {
+	if m != nil {
+		return m.Voter
+	}
+	return ""
+}
+
+func (m *GaugeVotes) GetVotes() []GaugeVote {
+	if m != nil {
+		return m.Votes
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.poolincentives.v1beta1.Params")
 	proto.RegisterType((*LockableDurationsInfo)(nil), "osmosis.poolincentives.v1beta1.LockableDurationsInfo")
@@ -320,6 +453,8 @@ func init() {
 	proto.RegisterType((*DistrRecord)(nil), "osmosis.poolincentives.v1beta1.DistrRecord")
 	proto.RegisterType((*PoolToGauge)(nil), "osmosis.poolincentives.v1beta1.PoolToGauge")
 	proto.RegisterType((*PoolToGauges)(nil), "osmosis.poolincentives.v1beta1.PoolToGauges")
+	proto.RegisterType((*GaugeVote)(nil), "osmosis.poolincentives.v1beta1.GaugeVote")
+	proto.RegisterType((*GaugeVotes)(nil), "osmosis.poolincentives.v1beta1.GaugeVotes")
 }
 
 func init() {
@@ -327,43 +462,56 @@ func init() {
 }
 
 var fileDescriptor_a8153bad03e553d1 = []byte{
-	// 565 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x73, 0x6d, 0x94, 0xb4, 0xe7, 0xf0, 0xcb, 0x05, 0x91, 0x16, 0xc9, 0x8e, 0x4e, 0x02,
-	0x55, 0x8a, 0x62, 0x93, 0xb2, 0x85, 0x2d, 0x0a, 0xa0, 0x00, 0x43, 0x65, 0x51, 0x21, 0xb1, 0x44,
-	0x76, 0x7c, 0x75, 0xac, 0xda, 0x7e, 0x91, 0xef, 0x12, 0xe8, 0x7f, 0x80, 0xc4, 0xc2, 0xd8, 0xb1,
-	0xff, 0x07, 0x1b, 0x53, 0xc7, 0x8e, 0x88, 0xc1, 0xa0, 0x64, 0x61, 0xce, 0x5f, 0x80, 0x7c, 0x3e,
-	0x93, 0x13, 0x95, 0x2a, 0x98, 0x72, 0xcf, 0xef, 0xbe, 0xef, 0x7d, 0xbe, 0xef, 0x9d, 0x82, 0x1f,
-	0x03, 0x8b, 0x81, 0x85, 0xcc, 0x9e, 0x02, 0x44, 0x9d, 0x30, 0x19, 0xd3, 0x84, 0x87, 0x73, 0xca,
-	0xec, 0x79, 0xd7, 0xa3, 0xdc, 0xed, 0xda, 0xeb, 0x4f, 0xd6, 0x34, 0x05, 0x0e, 0xba, 0x21, 0x15,
-	0x56, 0xae, 0x50, 0xb2, 0x52, 0xb0, 0x77, 0x37, 0x80, 0x00, 0xc4, 0x55, 0x3b, 0x3f, 0x15, 0xaa,
-	0x3d, 0x23, 0x00, 0x08, 0x22, 0x6a, 0x8b, 0xc8, 0x9b, 0x1d, 0xdb, 0xfe, 0x2c, 0x75, 0x79, 0x08,
-	0x49, 0x91, 0x27, 0x2f, 0x71, 0xed, 0xd0, 0x4d, 0xdd, 0x98, 0xe9, 0x3d, 0xdc, 0x88, 0xc3, 0x84,
-	0x53, 0x7f, 0xe4, 0xd3, 0x04, 0xe2, 0x26, 0x6a, 0xa1, 0xfd, 0xed, 0xfe, 0xfd, 0x55, 0x66, 0xee,
-	0x9c, 0xba, 0x71, 0xd4, 0x23, 0x6a, 0x96, 0x38, 0x5a, 0x11, 0x0e, 0xf2, 0xa8, 0x57, 0x3d, 0x3b,
-	0x37, 0x2b, 0xe4, 0x23, 0xc2, 0xf7, 0x5e, 0xc3, 0xf8, 0xc4, 0xf5, 0x22, 0x3a, 0x90, 0x6d, 0xd8,
-	0x30, 0x39, 0x06, 0x1d, 0xb0, 0x1e, 0xc9, 0xc4, 0xa8, 0x04, 0x60, 0x4d, 0xd4, 0xda, 0xdc, 0xd7,
-	0x0e, 0x76, 0xad, 0x02, 0xd1, 0x2a, 0x11, 0xad, 0x52, 0xdb, 0x7f, 0x78, 0x91, 0x99, 0x95, 0x55,
-	0x66, 0xee, 0x16, 0x00, 0x57, 0x4b, 0x90, 0xb3, 0x1f, 0x26, 0x72, 0xee, 0x44, 0x7f, 0x37, 0x25,
-	0x5f, 0x11, 0xde, 0x1e, 0x84, 0x8c, 0xa7, 0xa2, 0xfd, 0x04, 0x37, 0x38, 0x70, 0x37, 0x1a, 0xbd,
-	0xa7, 0x61, 0x30, 0xe1, 0xd2, 0xda, 0xb3, 0xbc, 0xfa, 0xf7, 0xcc, 0x7c, 0x14, 0x84, 0x7c, 0x32,
-	0xf3, 0xac, 0x31, 0xc4, 0xf6, 0x58, 0x0c, 0x59, 0xfe, 0x74, 0x98, 0x7f, 0x62, 0xf3, 0xd3, 0x29,
-	0x65, 0xd6, 0x30, 0xe1, 0xeb, 0x41, 0xa8, 0xb5, 0x88, 0xa3, 0x89, 0xf0, 0xad, 0x88, 0xf4, 0x57,
-	0xb8, 0x9e, 0xd2, 0x31, 0xa4, 0x3e, 0x6b, 0x6e, 0x08, 0x77, 0x6d, 0xeb, 0xfa, 0xb5, 0x59, 0x82,
-	0xd2, 0x11, 0x9a, 0x7e, 0x35, 0x27, 0x72, 0xca, 0x0a, 0xe4, 0x13, 0xc2, 0x9a, 0x92, 0xd6, 0x2d,
-	0xbc, 0x15, 0xb8, 0xb3, 0x80, 0x8e, 0x42, 0x5f, 0x58, 0xa8, 0xf6, 0x77, 0x56, 0x99, 0x79, 0xab,
-	0x80, 0x2a, 0x33, 0xc4, 0xa9, 0x8b, 0xe3, 0xd0, 0xd7, 0x9f, 0xe3, 0x9a, 0x34, 0xbc, 0x21, 0x0c,
-	0x5b, 0xff, 0x67, 0xd8, 0x91, 0xea, 0x5e, 0xf5, 0xd7, 0xb9, 0x89, 0xc8, 0x17, 0x84, 0xb5, 0x43,
-	0x80, 0xe8, 0x0d, 0xbc, 0xc8, 0xeb, 0xeb, 0x6d, 0x5c, 0xcf, 0x2d, 0xad, 0x61, 0xf4, 0x55, 0x66,
-	0xde, 0x2c, 0x60, 0x64, 0x82, 0x38, 0xb5, 0xfc, 0x34, 0xf4, 0xf5, 0xb6, 0x82, 0xbe, 0x21, 0x6e,
-	0xdf, 0x5e, 0x65, 0x66, 0x43, 0x41, 0x57, 0xb8, 0x1d, 0xbc, 0x55, 0x6e, 0xb8, 0xb9, 0xd9, 0x42,
-	0xd7, 0xbf, 0x91, 0x07, 0xf2, 0x8d, 0xc8, 0x31, 0x94, 0xc2, 0xe2, 0x65, 0xfc, 0xa9, 0x43, 0x28,
-	0x6e, 0x28, 0xf0, 0x4c, 0x3f, 0xc2, 0x37, 0x04, 0x24, 0x87, 0x91, 0x68, 0xfb, 0xaf, 0xeb, 0x52,
-	0x8a, 0xc8, 0x75, 0x69, 0x53, 0xe5, 0xd3, 0xd1, 0xc5, 0xc2, 0x40, 0x97, 0x0b, 0x03, 0xfd, 0x5c,
-	0x18, 0xe8, 0xf3, 0xd2, 0xa8, 0x5c, 0x2e, 0x8d, 0xca, 0xb7, 0xa5, 0x51, 0x79, 0xf7, 0x54, 0x19,
-	0xba, 0xec, 0xd1, 0x89, 0x5c, 0x8f, 0x95, 0x81, 0x3d, 0xef, 0x1e, 0xd8, 0x1f, 0xae, 0xfc, 0x1d,
-	0x88, 0x6d, 0x78, 0x35, 0xe1, 0xfb, 0xc9, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x23, 0xa8, 0x43,
-	0xbb, 0x36, 0x04, 0x00, 0x00,
+	// 773 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0x3d, 0x4f, 0xdb, 0x5a,
+	0x18, 0xce, 0x09, 0x21, 0xc0, 0x49, 0x2e, 0x97, 0x7b, 0xe0, 0x5e, 0x02, 0xe8, 0xda, 0xd1, 0x91,
+	0x2e, 0x02, 0x21, 0xec, 0x0b, 0xdd, 0xd2, 0x2d, 0x0a, 0x45, 0x51, 0x3b, 0x50, 0xab, 0x50, 0xa9,
+	0x4b, 0xe4, 0xd8, 0x07, 0x63, 0x61, 0xfb, 0x44, 0xb6, 0x13, 0x60, 0xed, 0xd4, 0xaa, 0x4b, 0x47,
+	0x46, 0xfe, 0x47, 0xb7, 0x4e, 0x8c, 0x8c, 0x55, 0x07, 0xb7, 0x02, 0x55, 0xea, 0x9c, 0x5f, 0x50,
+	0x9d, 0x0f, 0x27, 0x26, 0x20, 0x0a, 0xaa, 0xd4, 0x29, 0x3e, 0xef, 0xe7, 0xf3, 0x3e, 0xef, 0x47,
+	0xe0, 0xff, 0x34, 0xf2, 0x69, 0xe4, 0x46, 0x7a, 0x87, 0x52, 0x6f, 0xdd, 0x0d, 0x2c, 0x12, 0xc4,
+	0x6e, 0x8f, 0x44, 0x7a, 0x6f, 0xa3, 0x4d, 0x62, 0x73, 0x43, 0x1f, 0x8a, 0xb4, 0x4e, 0x48, 0x63,
+	0x8a, 0x14, 0xe9, 0xa1, 0x31, 0x8f, 0x8c, 0x56, 0x3a, 0x2c, 0xce, 0x39, 0xd4, 0xa1, 0xdc, 0x54,
+	0x67, 0x5f, 0xc2, 0x6b, 0x51, 0x71, 0x28, 0x75, 0x3c, 0xa2, 0xf3, 0x57, 0xbb, 0xbb, 0xaf, 0xdb,
+	0xdd, 0xd0, 0x8c, 0x5d, 0x1a, 0x08, 0x3d, 0x7e, 0x5d, 0x80, 0xc5, 0x1d, 0x33, 0x34, 0xfd, 0x08,
+	0xd5, 0x60, 0xd9, 0x77, 0x83, 0x98, 0xd8, 0x2d, 0x9b, 0x04, 0xd4, 0xaf, 0x80, 0x2a, 0x58, 0x99,
+	0xaa, 0xcf, 0xf7, 0x13, 0x75, 0xf6, 0xc4, 0xf4, 0xbd, 0x1a, 0xce, 0x6a, 0xb1, 0x51, 0x12, 0xcf,
+	0x06, 0x7b, 0xa1, 0xe7, 0x70, 0xce, 0x31, 0xbb, 0x0e, 0x69, 0xf5, 0x68, 0xec, 0x06, 0x4e, 0x8b,
+	0x04, 0x66, 0xdb, 0x23, 0x76, 0x25, 0x5f, 0x05, 0x2b, 0x93, 0x75, 0xb5, 0x9f, 0xa8, 0x4b, 0x22,
+	0xc6, 0x6d, 0x56, 0xd8, 0x40, 0x5c, 0xbc, 0xc7, 0xa5, 0x5b, 0x42, 0x88, 0x4e, 0xe0, 0x3f, 0xbe,
+	0x1b, 0x30, 0x53, 0xd2, 0xf2, 0xa8, 0x75, 0xd8, 0x4a, 0x91, 0x57, 0xc6, 0xaa, 0x60, 0xa5, 0xb4,
+	0xb9, 0xa0, 0x89, 0xd2, 0xb4, 0xb4, 0x34, 0xad, 0x21, 0x0d, 0xea, 0xab, 0xe7, 0x89, 0x9a, 0xeb,
+	0x27, 0xea, 0xbf, 0x03, 0xdc, 0xb7, 0x84, 0xc1, 0xa7, 0x5f, 0x54, 0x60, 0xcc, 0xfa, 0x6e, 0xb0,
+	0x47, 0x63, 0xf2, 0x8c, 0x5a, 0x87, 0xa9, 0x3f, 0x8a, 0xe0, 0x8c, 0x6f, 0x1e, 0xb7, 0x04, 0xd6,
+	0x23, 0xe2, 0x3a, 0x07, 0x71, 0xa5, 0xc0, 0xd9, 0x68, 0xb2, 0xc8, 0x9f, 0x13, 0x75, 0xd9, 0x71,
+	0xe3, 0x83, 0x6e, 0x5b, 0xb3, 0xa8, 0xaf, 0x5b, 0xbc, 0x31, 0xf2, 0x67, 0x3d, 0xb2, 0x0f, 0xf5,
+	0xf8, 0xa4, 0x43, 0x22, 0xad, 0x41, 0xac, 0x7e, 0xa2, 0xce, 0x4b, 0x0c, 0x23, 0xf1, 0xb0, 0x31,
+	0xed, 0x9b, 0xc7, 0xdb, 0x4c, 0xf2, 0x92, 0x0b, 0x78, 0x52, 0x37, 0x48, 0xa9, 0xe9, 0xd0, 0x23,
+	0x12, 0x56, 0xc6, 0x1f, 0x9c, 0xb4, 0x19, 0xc4, 0x99, 0xa4, 0x23, 0xf1, 0x58, 0x52, 0x5e, 0xae,
+	0x1b, 0x38, 0x3b, 0x4c, 0x50, 0x2b, 0x9c, 0x9e, 0xa9, 0x39, 0xfc, 0x06, 0xc0, 0xbf, 0x19, 0x01,
+	0x8c, 0xf8, 0x94, 0x84, 0xa8, 0x19, 0xec, 0x53, 0x44, 0x21, 0xf2, 0xa4, 0x62, 0x40, 0x5c, 0x54,
+	0x01, 0xd5, 0xb1, 0xbb, 0x1b, 0xf0, 0x9f, 0x6c, 0xc0, 0x82, 0xc0, 0x71, 0x33, 0x84, 0x20, 0xff,
+	0x2f, 0x6f, 0x34, 0x29, 0xfe, 0x08, 0xe0, 0x54, 0xc3, 0x8d, 0xe2, 0x90, 0xa7, 0x3f, 0x80, 0xe5,
+	0x98, 0xc6, 0xa6, 0x97, 0x36, 0x41, 0x8c, 0xe4, 0xd6, 0x83, 0xf9, 0x90, 0x03, 0x9c, 0x8d, 0x85,
+	0x8d, 0x12, 0x7f, 0x4a, 0xf6, 0x9f, 0xc2, 0x89, 0x90, 0x58, 0x34, 0xb4, 0xa3, 0x4a, 0x9e, 0x57,
+	0xb7, 0xa6, 0xdd, 0xbd, 0x6f, 0x1a, 0x47, 0x69, 0x70, 0x9f, 0x7a, 0x81, 0x21, 0x32, 0xd2, 0x08,
+	0xf8, 0x1d, 0x80, 0xa5, 0x8c, 0x1a, 0x69, 0x70, 0x52, 0xf4, 0xde, 0xb5, 0x79, 0x09, 0x85, 0xfa,
+	0x6c, 0x3f, 0x51, 0xff, 0xcc, 0x6e, 0x84, 0x6b, 0x63, 0x63, 0x82, 0x7f, 0x36, 0x6d, 0xf4, 0x04,
+	0x16, 0x65, 0xc1, 0x79, 0x5e, 0xb0, 0xf6, 0xb0, 0x82, 0x0d, 0xe9, 0x5d, 0x2b, 0x7c, 0x3f, 0x53,
+	0x01, 0xfe, 0x00, 0x60, 0x69, 0x87, 0x52, 0xef, 0x05, 0xe5, 0xe3, 0x86, 0xd6, 0xe0, 0x04, 0x2b,
+	0x69, 0x08, 0x06, 0xf5, 0x13, 0x75, 0x5a, 0x80, 0x91, 0x0a, 0x6c, 0x14, 0xd9, 0x57, 0xd3, 0x46,
+	0x6b, 0x19, 0xe8, 0x79, 0x6e, 0x3d, 0xd3, 0x4f, 0xd4, 0x72, 0x06, 0x7a, 0x06, 0xb7, 0x01, 0x27,
+	0xef, 0xbf, 0xa4, 0x4b, 0x72, 0x46, 0x24, 0x0d, 0xd7, 0xd7, 0x72, 0x10, 0x07, 0x13, 0x58, 0xce,
+	0x80, 0x8f, 0xd0, 0x2e, 0xfc, 0x83, 0x83, 0x8c, 0xa9, 0xd8, 0xa7, 0xfb, 0xb6, 0x2b, 0x13, 0x44,
+	0xb6, 0xab, 0xd4, 0x19, 0x8a, 0xf0, 0x5b, 0x00, 0xa7, 0xb6, 0xe5, 0x11, 0x22, 0xbf, 0xb5, 0x61,
+	0x0d, 0x62, 0x8d, 0x34, 0xec, 0x1b, 0x80, 0x70, 0x80, 0x25, 0x42, 0xcb, 0x70, 0x9c, 0x5d, 0xaf,
+	0x50, 0x4e, 0x7f, 0x86, 0x7f, 0x2e, 0xc6, 0x86, 0x50, 0xa3, 0x2d, 0x61, 0x97, 0x0e, 0xf0, 0xea,
+	0xcf, 0x18, 0x19, 0xa4, 0x90, 0x7c, 0x08, 0x6f, 0xb6, 0x73, 0xd7, 0x6e, 0xd0, 0xd8, 0xaf, 0xed,
+	0xdc, 0xf5, 0xfb, 0x53, 0xea, 0x0d, 0x8f, 0x4f, 0x7d, 0xf7, 0xfc, 0x52, 0x01, 0x17, 0x97, 0x0a,
+	0xf8, 0x7a, 0xa9, 0x80, 0xf7, 0x57, 0x4a, 0xee, 0xe2, 0x4a, 0xc9, 0x7d, 0xba, 0x52, 0x72, 0xaf,
+	0x1e, 0x67, 0xb2, 0xc8, 0x2a, 0xd6, 0x3d, 0xb3, 0x1d, 0xa5, 0x0f, 0xbd, 0xb7, 0xb1, 0xa9, 0x1f,
+	0xdf, 0xf8, 0xef, 0xe4, 0xe9, 0xdb, 0x45, 0x3e, 0x6b, 0x8f, 0x7e, 0x0c, 0x00, 0x5d, 0x77, 0x5d,
+	0x3f, 0x63, 0x07, 0x00, 0x00,
 }
 
 func (this *DistrRecord) Equal(that interface{}) bool {
@@ -393,6 +541,33 @@ func (this *DistrRecord) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *GaugeVote) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GaugeVote)
+	if !ok {
+		that2, ok := that.(GaugeVote)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.GaugeId != that1.GaugeId {
+		return false
+	}
+	if !this.Weight.Equal(that1.Weight) {
+		return false
+	}
+	return true
+}
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -413,6 +588,44 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MinVotingPower.Size()
+		i -= size
+		if _, err := m.MinVotingPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIncentives(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MaxGaugeWeight.Size()
+		i -= size
+		if _, err := m.MaxGaugeWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIncentives(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MinVoteLockDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinVoteLockDuration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintIncentives(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	if m.GaugeVotingEnabled {
+		i--
+		if m.GaugeVotingEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.MintedDenom) > 0 {
 		i -= len(m.MintedDenom)
 		copy(dAtA[i:], m.MintedDenom)
@@ -563,12 +776,12 @@ func (m *PoolToGauge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
-	if err1 != nil {
-		return 0, err1
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err2 != nil {
+		return 0, err2
 	}
-	i -= n1
-	i = encodeVarintIncentives(dAtA, i, uint64(n1))
+	i -= n2
+	i = encodeVarintIncentives(dAtA, i, uint64(n2))
 	i--
 	dAtA[i] = 0x1a
 	if m.GaugeId != 0 {
@@ -621,6 +834,98 @@ func (m *PoolToGauges) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GaugeVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GaugeVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GaugeVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIncentives(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.GaugeId != 0 {
+		i = encodeVarintIncentives(dAtA, i, uint64(m.GaugeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GaugeVotes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GaugeVotes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GaugeVotes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.VotingPower.Size()
+		i -= size
+		if _, err := m.VotingPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIncentives(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Votes) > 0 {
+		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Votes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIncentives(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintIncentives(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintIncentives(dAtA []byte, offset int, v uint64) int {
 	offset -= sovIncentives(v)
 	base := offset
@@ -642,6 +947,15 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovIncentives(uint64(l))
 	}
+	if m.GaugeVotingEnabled {
+		n += 2
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinVoteLockDuration)
+	n += 1 + l + sovIncentives(uint64(l))
+	l = m.MaxGaugeWeight.Size()
+	n += 1 + l + sovIncentives(uint64(l))
+	l = m.MinVotingPower.Size()
+	n += 1 + l + sovIncentives(uint64(l))
 	return n
 }
 
@@ -723,6 +1037,41 @@ func (m *PoolToGauges) Size() (n int) {
 	return n
 }
 
+func (m *GaugeVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GaugeId != 0 {
+		n += 1 + sovIncentives(uint64(m.GaugeId))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovIncentives(uint64(l))
+	return n
+}
+
+func (m *GaugeVotes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovIncentives(uint64(l))
+	}
+	if len(m.Votes) > 0 {
+		for _, e := range m.Votes {
+			l = e.Size()
+			n += 1 + l + sovIncentives(uint64(l))
+		}
+	}
+	l = m.VotingPower.Size()
+	n += 1 + l + sovIncentives(uint64(l))
+	return n
+}
+
 func sovIncentives(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -790,17 +1139,138 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.MintedDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipIncentives(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GaugeVotingEnabled", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthIncentives
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			m.GaugeVotingEnabled = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinVoteLockDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MinVoteLockDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGaugeWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxGaugeWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinVotingPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinVotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIncentives(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
 		}
@@ -1321,6 +1791,259 @@ func (m *PoolToGauges) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GaugeVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIncentives
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GaugeVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GaugeVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GaugeId", wireType)
+			}
+			m.GaugeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GaugeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIncentives(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GaugeVotes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIncentives
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GaugeVotes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GaugeVotes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Votes = append(m.Votes, GaugeVote{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIncentives(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipIncentives(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3,6 +3,9 @@ package types
 import (
 	"fmt"
 	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -18,6 +21,10 @@ const (
 var (
 	LockableDurationsKey = []byte("lockable_durations")
 	DistrInfoKey         = []byte("distr_info")
+	GaugeVotesPrefix     = []byte("gauge-votes/")
+	GaugeVoteTallyPrefix = []byte("gauge-vote-tally/")
+	// GaugeVoteTallyParamsKey stores the params the gauge vote tally was last built with.
+	GaugeVoteTallyParamsKey = []byte("gauge_vote_tally_params")
 )
 
 // GetPoolGaugeIdStoreKey returns a StoreKey with pool ID and its duration as inputs
@@ -29,3 +36,14 @@ func GetPoolGaugeIdStoreKey(poolId uint64, duration time.Duration) []byte {
 func GetPoolIdFromGaugeIdStoreKey(gaugeId uint64, duration time.Duration) []byte {
 	return []byte(fmt.Sprintf("pool-incentives-pool-id/%d/%s", gaugeId, duration.String()))
 }
+
+// GetGaugeVoteTallyStoreKey returns a StoreKey for the voting power allocated to the given gauge.
+// Gauge IDs are big endian encoded, so that the tally is iterated by increasing gauge ID.
+func GetGaugeVoteTallyStoreKey(gaugeId uint64) []byte {
+	return append(GaugeVoteTallyPrefix, sdk.Uint64ToBigEndian(gaugeId)...)
+}
+
+// GetGaugeVotesStoreKey returns a StoreKey for the gauge votes of the given voter.
+func GetGaugeVotesStoreKey(voter sdk.AccAddress) []byte {
+	return append(GaugeVotesPrefix, address.MustLengthPrefix(voter)...)
+}
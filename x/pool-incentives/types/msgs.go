@@ -0,0 +1,57 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// constants.
+const (
+	TypeMsgVoteGauges = "vote_gauges"
+)
+
+var _ sdk.Msg = &MsgVoteGauges{}
+
+// NewMsgVoteGauges creates a message to vote for pool gauges.
+func NewMsgVoteGauges(voter sdk.AccAddress, votes []GaugeVote) *MsgVoteGauges {
+	return &MsgVoteGauges{
+		Voter: voter.String(),
+		Votes: votes,
+	}
+}
+
+func (m MsgVoteGauges) Route() string { return RouterKey }
+func (m MsgVoteGauges) Type() string  { return TypeMsgVoteGauges }
+func (m MsgVoteGauges) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Voter); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid voter address (%s)", err)
+	}
+
+	totalWeight := sdk.ZeroDec()
+	gaugeIdFlags := make(map[uint64]bool)
+	for _, vote := range m.Votes {
+		if err := vote.ValidateBasic(); err != nil {
+			return err
+		}
+		if gaugeIdFlags[vote.GaugeId] {
+			return sdkerrors.Wrapf(ErrInvalidGaugeVote, "gauge ID #%d has duplications", vote.GaugeId)
+		}
+		gaugeIdFlags[vote.GaugeId] = true
+		totalWeight = totalWeight.Add(vote.Weight)
+	}
+
+	if totalWeight.GT(sdk.OneDec()) {
+		return sdkerrors.Wrap(ErrInvalidGaugeVote, "vote weights sum up to more than 1")
+	}
+
+	return nil
+}
+
+func (m MsgVoteGauges) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgVoteGauges) GetSigners() []sdk.AccAddress {
+	voter, _ := sdk.AccAddressFromBech32(m.Voter)
+	return []sdk.AccAddress{voter}
+}
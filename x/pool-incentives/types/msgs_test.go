@@ -0,0 +1,131 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v12/app/apptesting"
+	appParams "github.com/osmosis-labs/osmosis/v12/app/params"
+	"github.com/osmosis-labs/osmosis/v12/x/pool-incentives/types"
+)
+
+func TestMsgVoteGauges(t *testing.T) {
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	invalidAddr := sdk.AccAddress("invalid")
+
+	createMsg := func(after func(msg types.MsgVoteGauges) types.MsgVoteGauges) types.MsgVoteGauges {
+		properMsg := *types.NewMsgVoteGauges(addr1, []types.GaugeVote{
+			{GaugeId: 1, Weight: sdk.NewDecWithPrec(4, 1)},
+			{GaugeId: 2, Weight: sdk.NewDecWithPrec(6, 1)},
+		})
+		return after(properMsg)
+	}
+
+	msg := createMsg(func(msg types.MsgVoteGauges) types.MsgVoteGauges {
+		return msg
+	})
+	require.Equal(t, msg.Route(), types.RouterKey)
+	require.Equal(t, msg.Type(), "vote_gauges")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        types.MsgVoteGauges
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg types.MsgVoteGauges) types.MsgVoteGauges {
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "empty votes",
+			msg: createMsg(func(msg types.MsgVoteGauges) types.MsgVoteGauges {
+				msg.Votes = nil
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid voter",
+			msg: createMsg(func(msg types.MsgVoteGauges) types.MsgVoteGauges {
+				msg.Voter = invalidAddr.String()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "community pool gauge",
+			msg: createMsg(func(msg types.MsgVoteGauges) types.MsgVoteGauges {
+				msg.Votes[0].GaugeId = 0
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero weight",
+			msg: createMsg(func(msg types.MsgVoteGauges) types.MsgVoteGauges {
+				msg.Votes[0].Weight = sdk.ZeroDec()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "duplicate gauge",
+			msg: createMsg(func(msg types.MsgVoteGauges) types.MsgVoteGauges {
+				msg.Votes[1].GaugeId = 1
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "weights sum up to more than 1",
+			msg: createMsg(func(msg types.MsgVoteGauges) types.MsgVoteGauges {
+				msg.Votes[1].Weight = sdk.NewDecWithPrec(7, 1)
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+// Test authz serialize and de-serializes for pool-incentives msg.
+func TestAuthzMsg(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+
+	testCases := []struct {
+		name string
+		msg  sdk.Msg
+	}{
+		{
+			name: "MsgVoteGauges",
+			msg: types.NewMsgVoteGauges(addr1, []types.GaugeVote{
+				{GaugeId: 1, Weight: sdk.OneDec()},
+			}),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			apptesting.TestMessageAuthzSerialization(t, tc.msg)
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"gopkg.in/yaml.v2"
 
@@ -12,27 +13,49 @@ import (
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
-var KeyMintedDenom = []byte("MintedDenom")
+var (
+	KeyMintedDenom         = []byte("MintedDenom")
+	KeyGaugeVotingEnabled  = []byte("GaugeVotingEnabled")
+	KeyMinVoteLockDuration = []byte("MinVoteLockDuration")
+	KeyMaxGaugeWeight      = []byte("MaxGaugeWeight")
+	KeyMinVotingPower      = []byte("MinVotingPower")
+)
 
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
-func NewParams(mintedDenom string) Params {
+func NewParams(mintedDenom string, gaugeVotingEnabled bool, minVoteLockDuration time.Duration, maxGaugeWeight sdk.Dec, minVotingPower sdk.Int) Params {
 	return Params{
-		MintedDenom: mintedDenom,
+		MintedDenom:         mintedDenom,
+		GaugeVotingEnabled:  gaugeVotingEnabled,
+		MinVoteLockDuration: minVoteLockDuration,
+		MaxGaugeWeight:      maxGaugeWeight,
+		MinVotingPower:      minVotingPower,
 	}
 }
 
 // DefaultParams is the default parameter configuration for the pool-incentives module.
 func DefaultParams() Params {
-	return NewParams(sdk.DefaultBondDenom)
+	return NewParams(sdk.DefaultBondDenom, false, time.Hour*24*14, sdk.OneDec(), sdk.NewInt(1_000_000))
 }
 
 func (p Params) Validate() error {
 	if err := validateMintedDenom(p.MintedDenom); err != nil {
 		return err
 	}
+	if err := validateGaugeVotingEnabled(p.GaugeVotingEnabled); err != nil {
+		return err
+	}
+	if err := validateMinVoteLockDuration(p.MinVoteLockDuration); err != nil {
+		return err
+	}
+	if err := validateMaxGaugeWeight(p.MaxGaugeWeight); err != nil {
+		return err
+	}
+	if err := validateMinVotingPower(p.MinVotingPower); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -58,8 +81,60 @@ func validateMintedDenom(i interface{}) error {
 	return nil
 }
 
+func validateGaugeVotingEnabled(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateMinVoteLockDuration(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("min vote lock duration cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateMaxGaugeWeight(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || !v.IsPositive() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("max gauge weight must be positive and at most 1: %s", v)
+	}
+
+	return nil
+}
+
+func validateMinVotingPower(i interface{}) error {
+	v, ok := i.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || !v.IsPositive() {
+		return fmt.Errorf("min voting power must be positive: %s", v)
+	}
+
+	return nil
+}
+
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyMintedDenom, &p.MintedDenom, validateMintedDenom),
+		paramtypes.NewParamSetPair(KeyGaugeVotingEnabled, &p.GaugeVotingEnabled, validateGaugeVotingEnabled),
+		paramtypes.NewParamSetPair(KeyMinVoteLockDuration, &p.MinVoteLockDuration, validateMinVoteLockDuration),
+		paramtypes.NewParamSetPair(KeyMaxGaugeWeight, &p.MaxGaugeWeight, validateMaxGaugeWeight),
+		paramtypes.NewParamSetPair(KeyMinVotingPower, &p.MinVotingPower, validateMinVotingPower),
 	}
 }
@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -646,6 +647,186 @@ func (m *QueryExternalIncentiveGaugesResponse) GetData() []types1.Gauge {
 	return nil
 }
 
+type QueryGaugeVoteTallyRequest struct {
+}
+
+func (m *QueryGaugeVoteTallyRequest) Reset()         { *m = QueryGaugeVoteTallyRequest{} }
+func (m *QueryGaugeVoteTallyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGaugeVoteTallyRequest) ProtoMessage()    {}
+func (*QueryGaugeVoteTallyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_302873ecccbc7636, []int{13}
+}
+func (m *QueryGaugeVoteTallyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGaugeVoteTallyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGaugeVoteTallyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGaugeVoteTallyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGaugeVoteTallyRequest.Merge(m, src)
+}
+func (m *QueryGaugeVoteTallyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGaugeVoteTallyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGaugeVoteTallyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGaugeVoteTallyRequest proto.InternalMessageInfo
+
+type QueryGaugeVoteTallyResponse struct {
+	// voting power allocated to each gauge
+	Tally []DistrRecord `protobuf:"bytes,1,rep,name=tally,proto3" json:"tally"`
+	// distribution records derived from the tally, with the gauge weight cap
+	// applied
+	DistrInfo DistrInfo `protobuf:"bytes,2,opt,name=distr_info,json=distrInfo,proto3" json:"distr_info" yaml:"distr_info"`
+}
+
+func (m *QueryGaugeVoteTallyResponse) Reset()         { *m = QueryGaugeVoteTallyResponse{} }
+func (m *QueryGaugeVoteTallyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGaugeVoteTallyResponse) ProtoMessage()    {}
+func (*QueryGaugeVoteTallyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_302873ecccbc7636, []int{14}
+}
+func (m *QueryGaugeVoteTallyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGaugeVoteTallyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGaugeVoteTallyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGaugeVoteTallyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGaugeVoteTallyResponse.Merge(m, src)
+}
+func (m *QueryGaugeVoteTallyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGaugeVoteTallyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGaugeVoteTallyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGaugeVoteTallyResponse proto.InternalMessageInfo
+
+func (m *QueryGaugeVoteTallyResponse) GetTally() []DistrRecord {
+	if m != nil {
+		return m.Tally
+	}
+	return nil
+}
+
+func (m *QueryGaugeVoteTallyResponse) GetDistrInfo() DistrInfo {
+	if m != nil {
+		return m.DistrInfo
+	}
+	return DistrInfo{}
+}
+
+type QueryGaugeVotesRequest struct {
+	Voter string `protobuf:"bytes,1,opt,name=voter,proto3" json:"voter,omitempty" yaml:"voter"`
+}
+
+func (m *QueryGaugeVotesRequest) Reset()         { *m = QueryGaugeVotesRequest{} }
+func (m *QueryGaugeVotesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGaugeVotesRequest) ProtoMessage()    {}
+func (*QueryGaugeVotesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_302873ecccbc7636, []int{15}
+}
+func (m *QueryGaugeVotesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGaugeVotesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGaugeVotesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGaugeVotesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGaugeVotesRequest.Merge(m, src)
+}
+func (m *QueryGaugeVotesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGaugeVotesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGaugeVotesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGaugeVotesRequest proto.InternalMessageInfo
+
+func (m *QueryGaugeVotesRequest) GetVoter() string {
+	if m != nil {
+		return m.Voter
+	}
+	return ""
+}
+
+type QueryGaugeVotesResponse struct {
+	Votes       []GaugeVote                            `protobuf:"bytes,1,rep,name=votes,proto3" json:"votes"`
+	VotingPower github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=voting_power,json=votingPower,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"voting_power" yaml:"voting_power"`
+}
+
+func (m *QueryGaugeVotesResponse) Reset()         { *m = QueryGaugeVotesResponse{} }
+func (m *QueryGaugeVotesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGaugeVotesResponse) ProtoMessage()    {}
+func (*QueryGaugeVotesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_302873ecccbc7636, []int{16}
+}
+func (m *QueryGaugeVotesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGaugeVotesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGaugeVotesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGaugeVotesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGaugeVotesResponse.Merge(m, src)
+}
+func (m *QueryGaugeVotesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGaugeVotesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGaugeVotesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGaugeVotesResponse proto.InternalMessageInfo
+
+func (m *QueryGaugeVotesResponse) GetVotes() []GaugeVote {
+	if m != nil {
+		return m.Votes
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryGaugeIdsRequest)(nil), "osmosis.poolincentives.v1beta1.QueryGaugeIdsRequest")
 	proto.RegisterType((*QueryGaugeIdsResponse)(nil), "osmosis.poolincentives.v1beta1.QueryGaugeIdsResponse")
@@ -661,6 +842,10 @@ func init() {
 	proto.RegisterType((*QueryIncentivizedPoolsResponse)(nil), "osmosis.poolincentives.v1beta1.QueryIncentivizedPoolsResponse")
 	proto.RegisterType((*QueryExternalIncentiveGaugesRequest)(nil), "osmosis.poolincentives.v1beta1.QueryExternalIncentiveGaugesRequest")
 	proto.RegisterType((*QueryExternalIncentiveGaugesResponse)(nil), "osmosis.poolincentives.v1beta1.QueryExternalIncentiveGaugesResponse")
+	proto.RegisterType((*QueryGaugeVoteTallyRequest)(nil), "osmosis.poolincentives.v1beta1.QueryGaugeVoteTallyRequest")
+	proto.RegisterType((*QueryGaugeVoteTallyResponse)(nil), "osmosis.poolincentives.v1beta1.QueryGaugeVoteTallyResponse")
+	proto.RegisterType((*QueryGaugeVotesRequest)(nil), "osmosis.poolincentives.v1beta1.QueryGaugeVotesRequest")
+	proto.RegisterType((*QueryGaugeVotesResponse)(nil), "osmosis.poolincentives.v1beta1.QueryGaugeVotesResponse")
 }
 
 func init() {
@@ -668,65 +853,79 @@ func init() {
 }
 
 var fileDescriptor_302873ecccbc7636 = []byte{
-	// 923 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x41, 0x6f, 0x1b, 0x45,
-	0x14, 0xce, 0x34, 0x21, 0x4d, 0x26, 0x12, 0xd4, 0x93, 0x40, 0x9c, 0x15, 0xac, 0xc3, 0xd0, 0x42,
-	0xaa, 0x28, 0xbb, 0x8d, 0xdd, 0xf6, 0xd0, 0x06, 0x90, 0xdc, 0x20, 0x14, 0x89, 0x43, 0x58, 0x09,
-	0x21, 0xc1, 0xc1, 0x5a, 0x7b, 0x27, 0x9b, 0x11, 0xeb, 0x1d, 0xd7, 0xb3, 0x0e, 0x0d, 0xa8, 0x97,
-	0x4a, 0xdc, 0x41, 0x5c, 0x38, 0x23, 0x7a, 0xe6, 0xc4, 0x3f, 0xe0, 0xd0, 0x1b, 0x95, 0xb8, 0x70,
-	0xc1, 0xa0, 0x84, 0x03, 0x67, 0xff, 0x02, 0xb4, 0xb3, 0x6f, 0x36, 0xf6, 0x6e, 0xec, 0xb5, 0xcb,
-	0x6d, 0x33, 0xef, 0xbd, 0xef, 0x7d, 0xdf, 0xbc, 0x37, 0x9f, 0x83, 0xb7, 0x85, 0x6c, 0x0b, 0xc9,
-	0xa5, 0xdd, 0x11, 0x22, 0xd8, 0xe1, 0x61, 0x8b, 0x85, 0x11, 0x3f, 0x61, 0xd2, 0x3e, 0xd9, 0x6d,
-	0xb2, 0xc8, 0xdd, 0xb5, 0x1f, 0xf6, 0x58, 0xf7, 0xd4, 0xea, 0x74, 0x45, 0x24, 0x88, 0x09, 0xc9,
-	0x56, 0x9c, 0x7c, 0x91, 0x6b, 0x41, 0xae, 0xb1, 0xe6, 0x0b, 0x5f, 0xa8, 0x54, 0x3b, 0xfe, 0x4a,
-	0xaa, 0x8c, 0xd7, 0x7d, 0x21, 0xfc, 0x80, 0xd9, 0x6e, 0x87, 0xdb, 0x6e, 0x18, 0x8a, 0xc8, 0x8d,
-	0xb8, 0x08, 0x25, 0x44, 0x4d, 0x88, 0xaa, 0xbf, 0x9a, 0xbd, 0x23, 0xdb, 0xeb, 0x75, 0x55, 0x82,
-	0x8e, 0x6b, 0x82, 0x43, 0xdc, 0x7c, 0xb7, 0xe7, 0x33, 0x88, 0xdf, 0x2a, 0x12, 0x30, 0xc4, 0x53,
-	0x55, 0xd0, 0x07, 0x78, 0xed, 0xe3, 0x58, 0xd4, 0x87, 0x31, 0xca, 0x81, 0x27, 0x1d, 0xf6, 0xb0,
-	0xc7, 0x64, 0x44, 0xb6, 0xf1, 0xd5, 0x18, 0xa3, 0xc1, 0xbd, 0x32, 0xda, 0x44, 0x5b, 0x0b, 0x75,
-	0x32, 0xe8, 0x57, 0x5e, 0x3e, 0x75, 0xdb, 0xc1, 0x3d, 0x0a, 0x01, 0xea, 0x2c, 0xc6, 0x5f, 0x07,
-	0x1e, 0xfd, 0x66, 0x1e, 0xbf, 0x9a, 0x41, 0x91, 0x1d, 0x11, 0x4a, 0x46, 0x7e, 0x42, 0x78, 0x5d,
-	0x11, 0x6c, 0x70, 0x4f, 0x36, 0xbe, 0xe4, 0xd1, 0x71, 0x43, 0x4b, 0x2a, 0xa3, 0xcd, 0xf9, 0xad,
-	0x95, 0xea, 0x81, 0x35, 0xf9, 0x1e, 0xad, 0x4b, 0x81, 0x2d, 0x38, 0xf8, 0x94, 0x47, 0xc7, 0xfb,
-	0x00, 0x58, 0xa7, 0x83, 0x7e, 0xc5, 0x4c, 0x28, 0x8e, 0xe9, 0x49, 0x9d, 0x35, 0x1f, 0x90, 0x86,
-	0x2b, 0x8d, 0x5f, 0x11, 0x5e, 0xbd, 0x04, 0x91, 0x58, 0x78, 0x49, 0x23, 0xc1, 0x35, 0xac, 0x0e,
-	0xfa, 0x95, 0x57, 0x46, 0x7b, 0x50, 0xe7, 0x2a, 0x80, 0x92, 0xf7, 0xf1, 0x52, 0x2a, 0xef, 0xca,
-	0x26, 0xda, 0x5a, 0xa9, 0x6e, 0x58, 0xc9, 0x48, 0x2d, 0x3d, 0x52, 0x2b, 0xa5, 0xbb, 0xf4, 0xac,
-	0x5f, 0x99, 0xfb, 0xe1, 0xaf, 0x0a, 0x72, 0xd2, 0x22, 0xb2, 0x87, 0x0d, 0x80, 0xd5, 0x17, 0xd1,
-	0xe8, 0xb0, 0x6e, 0xfc, 0xe9, 0xfa, 0xac, 0x3c, 0xbf, 0x89, 0xb6, 0x96, 0x9d, 0x72, 0xd2, 0x4d,
-	0x27, 0x1c, 0xa6, 0x71, 0xba, 0x0e, 0x63, 0xd8, 0xe7, 0x32, 0xea, 0x1e, 0x84, 0x47, 0x02, 0xa6,
-	0x49, 0x1f, 0xe3, 0xd7, 0xb2, 0x01, 0x18, 0x50, 0x0b, 0x63, 0x2f, 0x3e, 0x6c, 0xf0, 0xf0, 0x48,
-	0x28, 0x8d, 0x2b, 0xd5, 0x9b, 0x45, 0x23, 0x49, 0x61, 0xea, 0x1b, 0xb1, 0x86, 0x41, 0xbf, 0x52,
-	0x4a, 0xae, 0xe4, 0x02, 0x8a, 0x3a, 0xcb, 0x9e, 0xce, 0xa2, 0x6b, 0x98, 0xa8, 0xf6, 0x87, 0x6e,
-	0xd7, 0x6d, 0xeb, 0x15, 0xa3, 0x9f, 0xe3, 0xd5, 0x91, 0x53, 0x60, 0xb4, 0x8f, 0x17, 0x3b, 0xea,
-	0x04, 0xd8, 0xbc, 0x5d, 0xc4, 0x26, 0xa9, 0xaf, 0x2f, 0xc4, 0x54, 0x1c, 0xa8, 0xa5, 0x15, 0xfc,
-	0x86, 0x02, 0xff, 0x48, 0xb4, 0xbe, 0x70, 0x9b, 0x01, 0xd3, 0xb7, 0x9e, 0x76, 0xff, 0x0e, 0x61,
-	0x73, 0x5c, 0x06, 0x30, 0x11, 0x98, 0x04, 0x10, 0x4c, 0x37, 0x48, 0xc2, 0xda, 0x4e, 0x98, 0xeb,
-	0x0d, 0xb8, 0x93, 0x8d, 0xe4, 0x4e, 0xf2, 0x10, 0x54, 0x0d, 0xbd, 0x14, 0x64, 0x1b, 0xa7, 0xa4,
-	0xf5, 0x6c, 0xf9, 0x57, 0xcc, 0x3b, 0x14, 0x22, 0x48, 0x49, 0xff, 0x89, 0xf0, 0xb5, 0x6c, 0x70,
-	0xa6, 0xa7, 0x4a, 0x02, 0x5c, 0xca, 0x11, 0x2a, 0x5e, 0xd5, 0xeb, 0x20, 0xa9, 0x3c, 0x46, 0x52,
-	0xa2, 0xe8, 0x5a, 0x56, 0xd1, 0xc8, 0xfb, 0x99, 0x2f, 0x7e, 0x3f, 0xf4, 0xa9, 0x1e, 0xca, 0x25,
-	0x37, 0x00, 0x43, 0x79, 0x82, 0x30, 0xe1, 0x43, 0xd1, 0x46, 0x2c, 0x4c, 0x4f, 0xe5, 0x56, 0xd1,
-	0xae, 0x64, 0x71, 0xeb, 0x6f, 0x8e, 0x0e, 0x2b, 0x8f, 0x4c, 0x9d, 0x12, 0xcf, 0x92, 0xa1, 0x37,
-	0xf0, 0x5b, 0x8a, 0xe6, 0x07, 0x8f, 0x22, 0xd6, 0x0d, 0xdd, 0x20, 0x7d, 0x8c, 0xca, 0x44, 0x86,
-	0x36, 0xfc, 0xfa, 0xe4, 0x34, 0xd0, 0x54, 0xc3, 0x0b, 0x9e, 0x1b, 0xb9, 0xe9, 0x6a, 0x69, 0x11,
-	0x43, 0x02, 0x54, 0x05, 0xec, 0xb8, 0x4a, 0xae, 0x3e, 0x5d, 0xc6, 0x2f, 0x29, 0x74, 0xf2, 0x0b,
-	0xc2, 0x4b, 0xda, 0x20, 0xc9, 0xed, 0x19, 0xfd, 0x54, 0x31, 0x35, 0xee, 0xbc, 0x90, 0x0b, 0xd3,
-	0xbd, 0x27, 0xbf, 0xff, 0xf3, 0xfd, 0x95, 0xbb, 0xe4, 0xb6, 0x5d, 0xf4, 0xc3, 0xa3, 0x26, 0xbc,
-	0xc3, 0x3d, 0x69, 0x7f, 0x0d, 0x3b, 0xf9, 0x98, 0xfc, 0x8c, 0xf0, 0x72, 0x6a, 0x25, 0x64, 0x3a,
-	0x0a, 0x59, 0x6b, 0x33, 0xee, 0xce, 0x5a, 0x06, 0xd4, 0x6b, 0x8a, 0xfa, 0x0e, 0xd9, 0x2e, 0xa4,
-	0x7e, 0x61, 0x6a, 0xe4, 0x47, 0x84, 0x17, 0x13, 0xbb, 0x21, 0xd5, 0xa9, 0xfa, 0x8e, 0x38, 0x9e,
-	0x51, 0x9b, 0xa9, 0x06, 0x88, 0xda, 0x8a, 0xe8, 0x4d, 0xf2, 0x4e, 0x21, 0xd1, 0xc4, 0xfa, 0xc8,
-	0x6f, 0x08, 0x97, 0x72, 0xa6, 0x46, 0xde, 0x9d, 0xaa, 0xf7, 0x38, 0xbb, 0x34, 0xde, 0x7b, 0xd1,
-	0x72, 0x50, 0x71, 0x5f, 0xa9, 0xb8, 0x43, 0x6a, 0x85, 0x2a, 0xf2, 0x7e, 0xa9, 0x14, 0xe5, 0x1c,
-	0x61, 0x4a, 0x45, 0xe3, 0xbc, 0x74, 0x4a, 0x45, 0x63, 0x8d, 0x68, 0x06, 0x45, 0x79, 0x53, 0x21,
-	0xff, 0x22, 0xbc, 0x3e, 0xc6, 0x15, 0xc8, 0x83, 0xa9, 0x88, 0x4d, 0xb6, 0x1e, 0x63, 0xff, 0xff,
-	0x81, 0x80, 0xc6, 0xba, 0xd2, 0xb8, 0x47, 0xee, 0x15, 0x6a, 0x64, 0x80, 0x34, 0xf4, 0x8f, 0x8b,
-	0x7a, 0xf2, 0xb2, 0xfe, 0xc9, 0xb3, 0x33, 0x13, 0x3d, 0x3f, 0x33, 0xd1, 0xdf, 0x67, 0x26, 0xfa,
-	0xf6, 0xdc, 0x9c, 0x7b, 0x7e, 0x6e, 0xce, 0xfd, 0x71, 0x6e, 0xce, 0x7d, 0x76, 0xdf, 0xe7, 0xd1,
-	0x71, 0xaf, 0x69, 0xb5, 0x44, 0x5b, 0xe3, 0xef, 0x04, 0x6e, 0x53, 0xa6, 0xcd, 0x4e, 0x76, 0xab,
-	0xf6, 0xa3, 0x5c, 0xcb, 0xe8, 0xb4, 0xc3, 0x64, 0x73, 0x51, 0xfd, 0x4a, 0xd5, 0xfe, 0x0b, 0x00,
-	0x00, 0xff, 0xff, 0x36, 0xb5, 0xdb, 0x7d, 0xb4, 0x0b, 0x00, 0x00,
+	// 1142 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6f, 0xdc, 0x44,
+	0x14, 0xce, 0xe4, 0x57, 0x93, 0x49, 0x54, 0x92, 0x49, 0x68, 0x12, 0x53, 0xec, 0x30, 0xb4, 0x21,
+	0x55, 0x14, 0xbb, 0xd9, 0x6d, 0x83, 0x48, 0x42, 0x41, 0xdb, 0x44, 0x55, 0x24, 0x0e, 0xc1, 0xe2,
+	0x87, 0x04, 0x07, 0xcb, 0xbb, 0x9e, 0x38, 0x56, 0x1d, 0xcf, 0xd6, 0xf6, 0xa6, 0x0d, 0x55, 0x2e,
+	0x95, 0xb8, 0x83, 0xb8, 0x70, 0x46, 0x70, 0xe1, 0xc2, 0x89, 0x13, 0xd7, 0x1e, 0x2a, 0x38, 0x50,
+	0x89, 0x0b, 0x42, 0x62, 0x41, 0x09, 0x07, 0xce, 0xfb, 0x17, 0x20, 0x8f, 0x9f, 0x9d, 0x5d, 0x6f,
+	0x36, 0xde, 0x0d, 0xea, 0x69, 0x77, 0xe7, 0xbd, 0xf7, 0xbd, 0xef, 0x7b, 0xef, 0xcd, 0xbc, 0xc5,
+	0x4b, 0x3c, 0xd8, 0xe7, 0x81, 0x13, 0x68, 0x55, 0xce, 0xdd, 0x65, 0xc7, 0xab, 0x30, 0x2f, 0x74,
+	0x0e, 0x58, 0xa0, 0x1d, 0xac, 0x94, 0x59, 0x68, 0xae, 0x68, 0x0f, 0x6a, 0xcc, 0x3f, 0x54, 0xab,
+	0x3e, 0x0f, 0x39, 0x91, 0xc1, 0x59, 0x8d, 0x9c, 0x4f, 0x7d, 0x55, 0xf0, 0x95, 0xa6, 0x6d, 0x6e,
+	0x73, 0xe1, 0xaa, 0x45, 0xdf, 0xe2, 0x28, 0xe9, 0xaa, 0xcd, 0xb9, 0xed, 0x32, 0xcd, 0xac, 0x3a,
+	0x9a, 0xe9, 0x79, 0x3c, 0x34, 0x43, 0x87, 0x7b, 0x01, 0x58, 0x65, 0xb0, 0x8a, 0x5f, 0xe5, 0xda,
+	0xae, 0x66, 0xd5, 0x7c, 0xe1, 0x90, 0xd8, 0x13, 0x82, 0x4d, 0xdc, 0x6c, 0xb3, 0x66, 0x33, 0xb0,
+	0xdf, 0xcc, 0x13, 0xd0, 0xc4, 0x53, 0x44, 0xd0, 0xbb, 0x78, 0xfa, 0xfd, 0x48, 0xd4, 0xbd, 0x08,
+	0x65, 0xdb, 0x0a, 0x74, 0xf6, 0xa0, 0xc6, 0x82, 0x90, 0x2c, 0xe1, 0x4b, 0x11, 0x86, 0xe1, 0x58,
+	0xb3, 0x68, 0x1e, 0x2d, 0x0e, 0x96, 0x48, 0xa3, 0xae, 0x5c, 0x3e, 0x34, 0xf7, 0xdd, 0x35, 0x0a,
+	0x06, 0xaa, 0x0f, 0x47, 0xdf, 0xb6, 0x2d, 0xfa, 0xf9, 0x00, 0x7e, 0x39, 0x83, 0x12, 0x54, 0xb9,
+	0x17, 0x30, 0xf2, 0x2d, 0xc2, 0x33, 0x82, 0xa0, 0xe1, 0x58, 0x81, 0xf1, 0xd0, 0x09, 0xf7, 0x8c,
+	0x44, 0xd2, 0x2c, 0x9a, 0x1f, 0x58, 0x1c, 0x2b, 0x6c, 0xab, 0xe7, 0xd7, 0x51, 0x3d, 0x13, 0x58,
+	0x85, 0x83, 0x8f, 0x9d, 0x70, 0x6f, 0x13, 0x00, 0x4b, 0xb4, 0x51, 0x57, 0xe4, 0x98, 0x62, 0x87,
+	0x9c, 0x54, 0x9f, 0xb6, 0x01, 0xa9, 0x39, 0x52, 0x7a, 0x8a, 0xf0, 0xd4, 0x19, 0x88, 0x44, 0xc5,
+	0x23, 0x09, 0x12, 0x94, 0x61, 0xaa, 0x51, 0x57, 0x5e, 0x6a, 0xcd, 0x41, 0xf5, 0x4b, 0x00, 0x4a,
+	0xde, 0xc1, 0x23, 0xa9, 0xbc, 0xfe, 0x79, 0xb4, 0x38, 0x56, 0x98, 0x53, 0xe3, 0x96, 0xaa, 0x49,
+	0x4b, 0xd5, 0x94, 0xee, 0xc8, 0xb3, 0xba, 0xd2, 0xf7, 0xf5, 0x5f, 0x0a, 0xd2, 0xd3, 0x20, 0xb2,
+	0x81, 0x25, 0x80, 0x4d, 0x0a, 0x61, 0x54, 0x99, 0x1f, 0x7d, 0x35, 0x6d, 0x36, 0x3b, 0x30, 0x8f,
+	0x16, 0x47, 0xf5, 0xd9, 0x38, 0x5b, 0xe2, 0xb0, 0x93, 0xda, 0xe9, 0x0c, 0xb4, 0x61, 0xd3, 0x09,
+	0x42, 0x7f, 0xdb, 0xdb, 0xe5, 0xd0, 0x4d, 0x7a, 0x84, 0xaf, 0x64, 0x0d, 0xd0, 0xa0, 0x0a, 0xc6,
+	0x56, 0x74, 0x68, 0x38, 0xde, 0x2e, 0x17, 0x1a, 0xc7, 0x0a, 0x37, 0xf2, 0x5a, 0x92, 0xc2, 0x94,
+	0xe6, 0x22, 0x0d, 0x8d, 0xba, 0x32, 0x19, 0x97, 0xe4, 0x14, 0x8a, 0xea, 0xa3, 0x56, 0xe2, 0x45,
+	0xa7, 0x31, 0x11, 0xe9, 0x77, 0x4c, 0xdf, 0xdc, 0x4f, 0x46, 0x8c, 0x7e, 0x8a, 0xa7, 0x5a, 0x4e,
+	0x81, 0xd1, 0x26, 0x1e, 0xae, 0x8a, 0x13, 0x60, 0xb3, 0x90, 0xc7, 0x26, 0x8e, 0x2f, 0x0d, 0x46,
+	0x54, 0x74, 0x88, 0xa5, 0x0a, 0x7e, 0x55, 0x80, 0xbf, 0xc7, 0x2b, 0xf7, 0xcd, 0xb2, 0xcb, 0x92,
+	0xaa, 0xa7, 0xd9, 0xbf, 0x44, 0x58, 0xee, 0xe4, 0x01, 0x4c, 0x38, 0x26, 0x2e, 0x18, 0xd3, 0x09,
+	0x0a, 0x60, 0x6c, 0xcf, 0xe9, 0xeb, 0x75, 0xa8, 0xc9, 0x5c, 0x5c, 0x93, 0x76, 0x08, 0x2a, 0x9a,
+	0x3e, 0xe9, 0x66, 0x13, 0xa7, 0xa4, 0x93, 0xde, 0x3a, 0x9f, 0x31, 0x6b, 0x87, 0x73, 0x37, 0x25,
+	0xfd, 0x27, 0xc2, 0x13, 0x59, 0x63, 0x4f, 0x57, 0x95, 0xb8, 0x78, 0xb2, 0x8d, 0x50, 0xfe, 0xa8,
+	0x5e, 0x03, 0x49, 0xb3, 0x1d, 0x24, 0xc5, 0x8a, 0x26, 0xb2, 0x8a, 0x5a, 0xee, 0xcf, 0x40, 0xfe,
+	0xfd, 0xa1, 0xdf, 0x25, 0x4d, 0x39, 0xa3, 0x02, 0xd0, 0x94, 0x27, 0x08, 0x13, 0xa7, 0xc9, 0x6a,
+	0x44, 0xc2, 0x92, 0xae, 0xdc, 0xcc, 0x9b, 0x95, 0x2c, 0x6e, 0xe9, 0xb5, 0xd6, 0x66, 0xb5, 0x23,
+	0x53, 0x7d, 0xd2, 0xc9, 0x92, 0xa1, 0xd7, 0xf1, 0xeb, 0x82, 0xe6, 0xd6, 0xa3, 0x90, 0xf9, 0x9e,
+	0xe9, 0xa6, 0x97, 0x51, 0x3c, 0x22, 0x4d, 0x13, 0x7e, 0xed, 0x7c, 0x37, 0xd0, 0x54, 0xc4, 0x83,
+	0x96, 0x19, 0x9a, 0xe9, 0x68, 0x25, 0x22, 0x9a, 0x04, 0x88, 0x08, 0x98, 0x71, 0xe1, 0x4c, 0xaf,
+	0x62, 0xe9, 0xf4, 0x69, 0xfc, 0x88, 0x87, 0xec, 0x03, 0xd3, 0x75, 0x0f, 0x93, 0xd4, 0xbf, 0x20,
+	0xfc, 0xca, 0x99, 0x66, 0x48, 0x79, 0x0f, 0x0f, 0x85, 0xd1, 0x01, 0xe4, 0x5c, 0xea, 0xea, 0xca,
+	0xeb, 0xac, 0xc2, 0x7d, 0x0b, 0x58, 0xc4, 0xf1, 0x99, 0x07, 0xa4, 0xff, 0xc5, 0x3c, 0x20, 0xef,
+	0xe2, 0x2b, 0xad, 0x62, 0xd2, 0x3d, 0xb5, 0x80, 0x87, 0x0e, 0x78, 0xc8, 0x7c, 0x31, 0xfa, 0xa3,
+	0xa5, 0x89, 0x46, 0x5d, 0x19, 0x8f, 0xa1, 0xc4, 0x31, 0xd5, 0x63, 0x33, 0xfd, 0x19, 0xe1, 0x99,
+	0x36, 0x08, 0xa8, 0xc5, 0x56, 0x8c, 0x91, 0x0c, 0x51, 0x2e, 0xfb, 0x14, 0x22, 0xa9, 0x84, 0x88,
+	0x26, 0x7b, 0x78, 0xfc, 0x80, 0x87, 0x8e, 0x67, 0x1b, 0x55, 0xfe, 0x90, 0xf9, 0xa2, 0x16, 0xa3,
+	0xa5, 0xad, 0xc8, 0xe5, 0x8f, 0xba, 0xb2, 0x60, 0x3b, 0xe1, 0x5e, 0xad, 0xac, 0x56, 0xf8, 0xbe,
+	0x56, 0x11, 0x09, 0xe0, 0x63, 0x39, 0xb0, 0xee, 0x6b, 0xe1, 0x61, 0x95, 0x05, 0xea, 0xb6, 0x17,
+	0x36, 0xea, 0xca, 0x54, 0xca, 0x3f, 0xc5, 0xa2, 0xfa, 0x58, 0xfc, 0x73, 0x27, 0xfa, 0x55, 0xf8,
+	0x7e, 0x1c, 0x0f, 0x09, 0x31, 0xe4, 0x47, 0x84, 0x47, 0x92, 0xdd, 0x48, 0x6e, 0xf5, 0xb8, 0x4a,
+	0x45, 0x05, 0xa5, 0xdb, 0x17, 0x5a, 0xc0, 0x74, 0xe3, 0xc9, 0x6f, 0xff, 0x7c, 0xd5, 0xbf, 0x4a,
+	0x6e, 0x69, 0x79, 0xff, 0x39, 0xc4, 0xe5, 0x5e, 0x76, 0xac, 0x40, 0x7b, 0x0c, 0xcf, 0xd1, 0x11,
+	0xf9, 0x01, 0xe1, 0xd1, 0x74, 0x08, 0x48, 0x77, 0x14, 0xb2, 0x5b, 0x4d, 0x5a, 0xed, 0x35, 0x0c,
+	0xa8, 0x17, 0x05, 0xf5, 0x65, 0xb2, 0x94, 0x4b, 0xfd, 0x74, 0x1c, 0xc9, 0x37, 0x08, 0x0f, 0xc7,
+	0x9b, 0x86, 0x14, 0xba, 0xca, 0xdb, 0xb2, 0xec, 0xa4, 0x62, 0x4f, 0x31, 0x40, 0x54, 0x13, 0x44,
+	0x6f, 0x90, 0x37, 0x72, 0x89, 0xc6, 0x5b, 0x8f, 0xfc, 0x8a, 0xf0, 0x64, 0xdb, 0x3e, 0x23, 0x6f,
+	0x77, 0x95, 0xbb, 0xd3, 0xa6, 0x94, 0xee, 0x5c, 0x34, 0x1c, 0x54, 0xac, 0x0b, 0x15, 0xb7, 0x49,
+	0x31, 0x57, 0x45, 0xfb, 0xaa, 0x14, 0x8a, 0xda, 0x96, 0x41, 0x97, 0x8a, 0x3a, 0xad, 0x51, 0xe9,
+	0xce, 0x45, 0xc3, 0x7b, 0x56, 0xd4, 0xbe, 0x4f, 0xc8, 0xbf, 0x08, 0xcf, 0x74, 0x58, 0x08, 0xe4,
+	0x6e, 0x57, 0xc4, 0xce, 0xdf, 0x3a, 0xd2, 0xe6, 0xff, 0x03, 0x01, 0x8d, 0x25, 0xa1, 0x71, 0x83,
+	0xac, 0xe5, 0x6a, 0x64, 0x80, 0xd4, 0xf4, 0x9f, 0xd5, 0x8e, 0xe5, 0x3c, 0x45, 0xf8, 0x72, 0xeb,
+	0xfe, 0x21, 0x6b, 0xdd, 0xbf, 0x36, 0xd9, 0x9d, 0x26, 0xad, 0x5f, 0x28, 0x16, 0xf4, 0xbc, 0x25,
+	0xf4, 0x14, 0xc9, 0x4a, 0x77, 0xef, 0x95, 0x11, 0xbd, 0xe9, 0x46, 0xbc, 0xe2, 0x7e, 0x42, 0x18,
+	0xa7, 0xa8, 0x01, 0x59, 0xed, 0x8d, 0x46, 0xda, 0x97, 0x37, 0x7b, 0x8e, 0xbb, 0xd8, 0x53, 0x2b,
+	0xa8, 0x07, 0xda, 0xe3, 0xe8, 0xc3, 0x3f, 0x2a, 0x7d, 0xf8, 0xec, 0x58, 0x46, 0xcf, 0x8f, 0x65,
+	0xf4, 0xf7, 0xb1, 0x8c, 0xbe, 0x38, 0x91, 0xfb, 0x9e, 0x9f, 0xc8, 0x7d, 0xbf, 0x9f, 0xc8, 0x7d,
+	0x9f, 0xac, 0x37, 0xad, 0x24, 0x40, 0x5e, 0x76, 0xcd, 0x72, 0x90, 0xa6, 0x39, 0x58, 0x29, 0x68,
+	0x8f, 0xda, 0x92, 0x89, 0x5d, 0x55, 0x1e, 0x16, 0xff, 0x12, 0x8b, 0xff, 0x0d, 0x00, 0x64, 0x9d,
+	0xae, 0x5b, 0x34, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -753,6 +952,11 @@ type QueryClient interface {
 	IncentivizedPools(ctx context.Context, in *QueryIncentivizedPoolsRequest, opts ...grpc.CallOption) (*QueryIncentivizedPoolsResponse, error)
 	// ExternalIncentiveGauges returns external incentive gauges.
 	ExternalIncentiveGauges(ctx context.Context, in *QueryExternalIncentiveGaugesRequest, opts ...grpc.CallOption) (*QueryExternalIncentiveGaugesResponse, error)
+	// GaugeVoteTally returns the voting power allocated to each gauge by the
+	// current gauge votes, and the distribution records derived from it.
+	GaugeVoteTally(ctx context.Context, in *QueryGaugeVoteTallyRequest, opts ...grpc.CallOption) (*QueryGaugeVoteTallyResponse, error)
+	// GaugeVotes returns the gauge votes and voting power of a voter.
+	GaugeVotes(ctx context.Context, in *QueryGaugeVotesRequest, opts ...grpc.CallOption) (*QueryGaugeVotesResponse, error)
 }
 
 type queryClient struct {
@@ -817,6 +1021,24 @@ func (c *queryClient) ExternalIncentiveGauges(ctx context.Context, in *QueryExte
 	return out, nil
 }
 
+func (c *queryClient) GaugeVoteTally(ctx context.Context, in *QueryGaugeVoteTallyRequest, opts ...grpc.CallOption) (*QueryGaugeVoteTallyResponse, error) {
+	out := new(QueryGaugeVoteTallyResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolincentives.v1beta1.Query/GaugeVoteTally", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GaugeVotes(ctx context.Context, in *QueryGaugeVotesRequest, opts ...grpc.CallOption) (*QueryGaugeVotesResponse, error) {
+	out := new(QueryGaugeVotesResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolincentives.v1beta1.Query/GaugeVotes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// GaugeIds takes the pool id and returns the matching gauge ids and durations
@@ -831,6 +1053,11 @@ type QueryServer interface {
 	IncentivizedPools(context.Context, *QueryIncentivizedPoolsRequest) (*QueryIncentivizedPoolsResponse, error)
 	// ExternalIncentiveGauges returns external incentive gauges.
 	ExternalIncentiveGauges(context.Context, *QueryExternalIncentiveGaugesRequest) (*QueryExternalIncentiveGaugesResponse, error)
+	// GaugeVoteTally returns the voting power allocated to each gauge by the
+	// current gauge votes, and the distribution records derived from it.
+	GaugeVoteTally(context.Context, *QueryGaugeVoteTallyRequest) (*QueryGaugeVoteTallyResponse, error)
+	// GaugeVotes returns the gauge votes and voting power of a voter.
+	GaugeVotes(context.Context, *QueryGaugeVotesRequest) (*QueryGaugeVotesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -855,6 +1082,12 @@ func (*UnimplementedQueryServer) IncentivizedPools(ctx context.Context, req *Que
 func (*UnimplementedQueryServer) ExternalIncentiveGauges(ctx context.Context, req *QueryExternalIncentiveGaugesRequest) (*QueryExternalIncentiveGaugesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExternalIncentiveGauges not implemented")
 }
+func (*UnimplementedQueryServer) GaugeVoteTally(ctx context.Context, req *QueryGaugeVoteTallyRequest) (*QueryGaugeVoteTallyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GaugeVoteTally not implemented")
+}
+func (*UnimplementedQueryServer) GaugeVotes(ctx context.Context, req *QueryGaugeVotesRequest) (*QueryGaugeVotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GaugeVotes not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -968,6 +1201,42 @@ func _Query_ExternalIncentiveGauges_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GaugeVoteTally_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGaugeVoteTallyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GaugeVoteTally(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolincentives.v1beta1.Query/GaugeVoteTally",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GaugeVoteTally(ctx, req.(*QueryGaugeVoteTallyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GaugeVotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGaugeVotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GaugeVotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolincentives.v1beta1.Query/GaugeVotes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GaugeVotes(ctx, req.(*QueryGaugeVotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.poolincentives.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -996,6 +1265,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ExternalIncentiveGauges",
 			Handler:    _Query_ExternalIncentiveGauges_Handler,
 		},
+		{
+			MethodName: "GaugeVoteTally",
+			Handler:    _Query_GaugeVoteTally_Handler,
+		},
+		{
+			MethodName: "GaugeVotes",
+			Handler:    _Query_GaugeVotes_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/pool-incentives/v1beta1/query.proto",
@@ -1440,68 +1717,215 @@ func (m *QueryExternalIncentiveGaugesResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryGaugeVoteTallyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryGaugeIdsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryGaugeVoteTallyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGaugeVoteTallyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGaugeIdsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGaugeVoteTallyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGaugeVoteTallyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGaugeVoteTallyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.GaugeIdsWithDuration) > 0 {
-		for _, e := range m.GaugeIdsWithDuration {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.DistrInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x12
+	if len(m.Tally) > 0 {
+		for iNdEx := len(m.Tally) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tally[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGaugeIdsResponse_GaugeIdWithDuration) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGaugeVotesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGaugeVotesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGaugeVotesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.GaugeId != 0 {
-		n += 1 + sovQuery(uint64(m.GaugeId))
-	}
-	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
-	n += 1 + l + sovQuery(uint64(l))
-	l = len(m.GaugeIncentivePercentage)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDistrInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *QueryGaugeVotesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGaugeVotesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGaugeVotesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.VotingPower.Size()
+		i -= size
+		if _, err := m.VotingPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Votes) > 0 {
+		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Votes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryGaugeIdsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryGaugeIdsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.GaugeIdsWithDuration) > 0 {
+		for _, e := range m.GaugeIdsWithDuration {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGaugeIdsResponse_GaugeIdWithDuration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GaugeId != 0 {
+		n += 1 + sovQuery(uint64(m.GaugeId))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.GaugeIncentivePercentage)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDistrInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	return n
 }
 
@@ -1625,6 +2049,62 @@ func (m *QueryExternalIncentiveGaugesResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryGaugeVoteTallyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGaugeVoteTallyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tally) > 0 {
+		for _, e := range m.Tally {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.DistrInfo.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGaugeVotesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGaugeVotesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Votes) > 0 {
+		for _, e := range m.Votes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.VotingPower.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2707,6 +3187,373 @@ func (m *QueryExternalIncentiveGaugesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryGaugeVoteTallyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGaugeVoteTallyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGaugeVoteTallyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGaugeVoteTallyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGaugeVoteTallyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGaugeVoteTallyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tally", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tally = append(m.Tally, DistrRecord{})
+			if err := m.Tally[len(m.Tally)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistrInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DistrInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGaugeVotesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGaugeVotesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGaugeVotesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGaugeVotesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGaugeVotesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGaugeVotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Votes = append(m.Votes, GaugeVote{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,5 +1,10 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
 // ValidateBasic is a basic validation test on recordd distribution gauges' weights.
 func (r DistrRecord) ValidateBasic() error {
 	if r.Weight.IsNegative() {
@@ -7,3 +12,14 @@ func (r DistrRecord) ValidateBasic() error {
 	}
 	return nil
 }
+
+// ValidateBasic is a basic validation test on a gauge vote's gauge ID and weight.
+func (v GaugeVote) ValidateBasic() error {
+	if v.GaugeId == 0 {
+		return sdkerrors.Wrap(ErrInvalidGaugeVote, "gauge ID cannot be 0")
+	}
+	if v.Weight.IsNil() || !v.Weight.IsPositive() || v.Weight.GT(sdk.OneDec()) {
+		return sdkerrors.Wrapf(ErrInvalidGaugeVote, "weight of gauge ID #%d must be positive and at most 1", v.GaugeId)
+	}
+	return nil
+}
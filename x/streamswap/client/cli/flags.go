@@ -0,0 +1,20 @@
+package cli
+
+import (
+	flag "github.com/spf13/pflag"
+)
+
+// Flags for streamswap module tx commands.
+const (
+	FlagMaxFee    = "max-fee"
+	FlagRecipient = "recipient"
+)
+
+// FlagSetCreateSale returns flags for creating sales.
+func FlagSetCreateSale() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagMaxFee, "", "Maximum sale creation fee the creator accepts to pay")
+	fs.String(FlagRecipient, "", "Account receiving the sale income, defaults to the creator")
+	return fs
+}
@@ -0,0 +1,256 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v12/x/streamswap/types"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		NewCreateSaleCmd(),
+		NewSubscribeCmd(),
+		NewWithdrawCmd(),
+		NewExitSaleCmd(),
+		NewFinalizeSaleCmd(),
+	)
+
+	return cmd
+}
+
+// NewCreateSaleCmd broadcasts MsgCreateSale.
+func NewCreateSaleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-sale [token-in-denom] [token-out] [start-time] [duration] [name] [url] [flags]",
+		Short: "create a new token sale streaming token-out for token-in",
+		Example: `osmosisd tx streamswap create-sale uosmo 1000000000ufoo 2023-01-01T00:00:00Z 168h "Foo sale" https://foo.io --max-fee 200000000uosmo --from mykey
+start-time can be given as a unix timestamp or in RFC3339 format.`,
+		Args: cobra.ExactArgs(6),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			tokenOut, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			startTime, err := parseTime(args[2])
+			if err != nil {
+				return err
+			}
+
+			duration, err := time.ParseDuration(args[3])
+			if err != nil {
+				return err
+			}
+
+			maxFeeStr, err := cmd.Flags().GetString(FlagMaxFee)
+			if err != nil {
+				return err
+			}
+			maxFee, err := sdk.ParseCoinsNormalized(maxFeeStr)
+			if err != nil {
+				return err
+			}
+
+			recipient, err := cmd.Flags().GetString(FlagRecipient)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgCreateSale{
+				Creator:   clientCtx.GetFromAddress().String(),
+				TokenIn:   args[0],
+				TokenOut:  tokenOut,
+				MaxFee:    maxFee,
+				StartTime: startTime,
+				Duration:  duration,
+				Recipient: recipient,
+				Name:      args[4],
+				Url:       args[5],
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetCreateSale())
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSubscribeCmd broadcasts MsgSubscribe.
+func NewSubscribeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "subscribe [sale-id] [amount] [flags]",
+		Short: "subscribe to a sale by staking amount of the sale token-in",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			saleId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			amount, ok := sdk.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid amount: %s", args[1])
+			}
+
+			msg := &types.MsgSubscribe{
+				Sender: clientCtx.GetFromAddress().String(),
+				SaleId: saleId,
+				Amount: amount,
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewWithdrawCmd broadcasts MsgWithdraw.
+func NewWithdrawCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw [sale-id] [amount] [flags]",
+		Short: "withdraw unspent token-in from a sale, all unspent tokens are withdrawn if amount is omitted",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			saleId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgWithdraw{
+				Sender: clientCtx.GetFromAddress().String(),
+				SaleId: saleId,
+			}
+			if len(args) == 2 {
+				amount, ok := sdk.NewIntFromString(args[1])
+				if !ok {
+					return fmt.Errorf("invalid amount: %s", args[1])
+				}
+				msg.Amount = &amount
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewExitSaleCmd broadcasts MsgExitSale.
+func NewExitSaleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exit-sale [sale-id] [flags]",
+		Short: "exit a finished sale and withdraw the purchased tokens",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			saleId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgExitSale{
+				Sender: clientCtx.GetFromAddress().String(),
+				SaleId: saleId,
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewFinalizeSaleCmd broadcasts MsgFinalizeSale.
+func NewFinalizeSaleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finalize-sale [sale-id] [flags]",
+		Short: "finalize a finished sale, sending the income to the sale treasury",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			saleId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgFinalizeSale{
+				Sender: clientCtx.GetFromAddress().String(),
+				SaleId: saleId,
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// parseTime parses either a unix timestamp or a RFC3339 formatted time.
+func parseTime(timeStr string) (time.Time, error) {
+	if timeUnix, err := strconv.ParseInt(timeStr, 10, 64); err == nil {
+		return time.Unix(timeUnix, 0), nil
+	}
+	if timeRFC, err := time.Parse(time.RFC3339, timeStr); err == nil {
+		return timeRFC, nil
+	}
+	return time.Time{}, errors.New("invalid time format, expected unix timestamp or RFC3339")
+}
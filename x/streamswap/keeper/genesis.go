@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v12/x/streamswap/types"
+)
+
+// InitGenesis initializes the streamswap module's state from a provided genesis
+// state.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
+	// ensure the module account exists
+	k.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+
+	k.SetParams(ctx, genState.Params)
+	k.SetNextSaleId(ctx, genState.NextSaleId)
+	for _, sale := range genState.Sales {
+		k.SetSale(ctx, sale)
+	}
+	for _, up := range genState.UserPositions {
+		user, err := sdk.AccAddressFromBech32(up.AccAddress)
+		if err != nil {
+			panic(err)
+		}
+		k.SetUserPosition(ctx, up.SaleId, user, up.UserPosition)
+	}
+}
+
+// ExportGenesis returns the streamswap module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return &types.GenesisState{
+		Sales:         k.GetAllSales(ctx),
+		UserPositions: k.GetAllUserPositions(ctx),
+		NextSaleId:    k.GetNextSaleId(ctx),
+		Params:        k.GetParams(ctx),
+	}
+}
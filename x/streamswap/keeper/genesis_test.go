@@ -0,0 +1,27 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v12/x/streamswap/types"
+)
+
+func (suite *KeeperTestSuite) TestGenesisExportImport() {
+	creator, alice := suite.TestAccs[0], suite.TestAccs[1]
+	saleId := suite.createSale(creator, time.Hour*24)
+	_, err := suite.msgServer.Subscribe(sdk.WrapSDKContext(suite.Ctx), &types.MsgSubscribe{Sender: alice.String(), SaleId: saleId, Amount: sdk.NewInt(300)})
+	suite.Require().NoError(err)
+
+	genesis := suite.App.StreamSwapKeeper.ExportGenesis(suite.Ctx)
+	suite.Require().NoError(genesis.Validate())
+	suite.Require().Len(genesis.Sales, 1)
+	suite.Require().Len(genesis.UserPositions, 1)
+	suite.Require().Equal(alice.String(), genesis.UserPositions[0].AccAddress)
+	suite.Require().Equal(saleId+1, genesis.NextSaleId)
+
+	suite.SetupTestForInitGenesis()
+	suite.App.StreamSwapKeeper.InitGenesis(suite.Ctx, *genesis)
+	suite.Require().Equal(genesis, suite.App.StreamSwapKeeper.ExportGenesis(suite.Ctx))
+}
@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/osmosis-labs/osmosis/v12/x/streamswap/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Sale and user position queries return the state streamed up to the current
+// block time. The updated state is not persisted.
+
+func (k Keeper) Sales(goCtx context.Context, req *types.QuerySales) (*types.QuerySalesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixSale)
+	sales := []types.Sale{}
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		sale, err := k.parseSale(value)
+		if err != nil {
+			return err
+		}
+		sale.UpdateRound(ctx.BlockTime())
+		sales = append(sales, sale)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySalesResponse{Sales: sales, Pagination: pageRes}, nil
+}
+
+func (k Keeper) Sale(goCtx context.Context, req *types.QuerySale) (*types.QuerySaleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sale, err := k.GetSale(ctx, req.SaleId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	sale.UpdateRound(ctx.BlockTime())
+
+	return &types.QuerySaleResponse{Sale: sale}, nil
+}
+
+func (k Keeper) UserPosition(goCtx context.Context, req *types.QueryUserPosition) (*types.QueryUserPositionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	user, err := sdk.AccAddressFromBech32(req.User)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	_, position, err := k.getSaleAndPosition(ctx, req.SaleId, user, false)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryUserPositionResponse{UserPosition: position}, nil
+}
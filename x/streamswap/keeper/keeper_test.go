@@ -0,0 +1,60 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/osmosis-labs/osmosis/v12/app/apptesting"
+	"github.com/osmosis-labs/osmosis/v12/x/streamswap/keeper"
+	"github.com/osmosis-labs/osmosis/v12/x/streamswap/types"
+)
+
+const (
+	tokenIn  = "uosmo"
+	tokenOut = "ufoo"
+)
+
+type KeeperTestSuite struct {
+	apptesting.KeeperTestHelper
+
+	queryClient types.QueryClient
+	msgServer   types.MsgServer
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.Setup()
+	params := types.DefaultParams()
+	fundAccsAmount := params.SaleCreationFee.Add(sdk.NewCoins(
+		sdk.NewInt64Coin(tokenIn, 1_000_000),
+		sdk.NewInt64Coin(tokenOut, 1_000_000),
+	)...)
+	for _, acc := range suite.TestAccs {
+		suite.FundAcc(acc, fundAccsAmount)
+	}
+
+	suite.queryClient = types.NewQueryClient(suite.QueryHelper)
+	suite.msgServer = keeper.NewMsgServerImpl(*suite.App.StreamSwapKeeper)
+}
+
+func (suite *KeeperTestSuite) createSale(creator sdk.AccAddress, duration time.Duration) uint64 {
+	params := suite.App.StreamSwapKeeper.GetParams(suite.Ctx)
+	res, err := suite.msgServer.CreateSale(sdk.WrapSDKContext(suite.Ctx), &types.MsgCreateSale{
+		Creator:   creator.String(),
+		TokenIn:   tokenIn,
+		TokenOut:  sdk.NewInt64Coin(tokenOut, 1000),
+		MaxFee:    params.SaleCreationFee,
+		StartTime: suite.Ctx.BlockTime().Add(params.MinDurationUntilStartTime),
+		Duration:  duration,
+		Name:      "foo sale",
+		Url:       "https://foo.io",
+	})
+	suite.Require().NoError(err)
+	return res.SaleId
+}
@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/gogo/protobuf/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	streamswaptypes "github.com/osmosis-labs/osmosis/v12/x/streamswap/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) streamswaptypes.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ streamswaptypes.MsgServer = msgServer{}
+
+func (server msgServer) CreateSale(goCtx context.Context, msg *streamswaptypes.MsgCreateSale) (*streamswaptypes.MsgCreateSaleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	saleId, err := server.Keeper.CreateSale(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &streamswaptypes.MsgCreateSaleResponse{SaleId: saleId}, nil
+}
+
+func (server msgServer) Subscribe(goCtx context.Context, msg *streamswaptypes.MsgSubscribe) (*types.Empty, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	if err := server.Keeper.Subscribe(ctx, sender, msg.SaleId, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.Empty{}, nil
+}
+
+func (server msgServer) Withdraw(goCtx context.Context, msg *streamswaptypes.MsgWithdraw) (*types.Empty, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := server.Keeper.Withdraw(ctx, sender, msg.SaleId, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.Empty{}, nil
+}
+
+func (server msgServer) ExitSale(goCtx context.Context, msg *streamswaptypes.MsgExitSale) (*streamswaptypes.MsgExitSaleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	purchased, err := server.Keeper.ExitSale(ctx, sender, msg.SaleId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &streamswaptypes.MsgExitSaleResponse{Purchased: purchased}, nil
+}
+
+func (server msgServer) FinalizeSale(goCtx context.Context, msg *streamswaptypes.MsgFinalizeSale) (*streamswaptypes.MsgFinalizeSaleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	income, err := server.Keeper.FinalizeSale(ctx, msg.SaleId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &streamswaptypes.MsgFinalizeSaleResponse{Income: income}, nil
+}
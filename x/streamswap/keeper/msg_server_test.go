@@ -0,0 +1,142 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v12/x/streamswap/types"
+)
+
+func (suite *KeeperTestSuite) TestCreateSale() {
+	params := suite.App.StreamSwapKeeper.GetParams(suite.Ctx)
+	validMsg := func() *types.MsgCreateSale {
+		return &types.MsgCreateSale{
+			Creator:   suite.TestAccs[0].String(),
+			TokenIn:   tokenIn,
+			TokenOut:  sdk.NewInt64Coin(tokenOut, 1000),
+			MaxFee:    params.SaleCreationFee,
+			StartTime: suite.Ctx.BlockTime().Add(params.MinDurationUntilStartTime),
+			Duration:  params.MinSaleDuration,
+			Name:      "foo sale",
+			Url:       "https://foo.io",
+		}
+	}
+
+	tests := map[string]struct {
+		modify    func(*types.MsgCreateSale)
+		expectErr error
+	}{
+		"valid sale": {
+			modify: func(*types.MsgCreateSale) {},
+		},
+		"start time too early": {
+			modify:    func(m *types.MsgCreateSale) { m.StartTime = suite.Ctx.BlockTime() },
+			expectErr: types.ErrInvalidSale,
+		},
+		"duration too short": {
+			modify:    func(m *types.MsgCreateSale) { m.Duration = time.Second },
+			expectErr: types.ErrInvalidSale,
+		},
+		"max fee too small": {
+			modify:    func(m *types.MsgCreateSale) { m.MaxFee = nil },
+			expectErr: types.ErrInsufficientFee,
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			creator := suite.TestAccs[0]
+			balanceBefore := suite.App.BankKeeper.GetAllBalances(suite.Ctx, creator)
+
+			msg := validMsg()
+			tc.modify(msg)
+			res, err := suite.msgServer.CreateSale(sdk.WrapSDKContext(suite.Ctx), msg)
+			if tc.expectErr != nil {
+				suite.Require().ErrorIs(err, tc.expectErr)
+				return
+			}
+			suite.Require().NoError(err)
+
+			sale, err := suite.App.StreamSwapKeeper.GetSale(suite.Ctx, res.SaleId)
+			suite.Require().NoError(err)
+			suite.Require().Equal(creator.String(), sale.Treasury)
+			suite.Require().Equal(msg.TokenOut.Amount, sale.OutRemaining)
+
+			balanceAfter := suite.App.BankKeeper.GetAllBalances(suite.Ctx, creator)
+			suite.Require().Equal(balanceBefore.Sub(params.SaleCreationFee.Add(msg.TokenOut)), balanceAfter)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSaleLifecycle() {
+	ctx := sdk.WrapSDKContext
+	creator, alice, bob := suite.TestAccs[0], suite.TestAccs[1], suite.TestAccs[2]
+	duration := time.Hour * 24
+	saleId := suite.createSale(creator, duration)
+	sale, err := suite.App.StreamSwapKeeper.GetSale(suite.Ctx, saleId)
+	suite.Require().NoError(err)
+
+	_, err = suite.msgServer.Subscribe(ctx(suite.Ctx), &types.MsgSubscribe{Sender: alice.String(), SaleId: saleId, Amount: sdk.NewInt(200)})
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Subscribe(ctx(suite.Ctx), &types.MsgSubscribe{Sender: bob.String(), SaleId: saleId, Amount: sdk.NewInt(200)})
+	suite.Require().NoError(err)
+
+	// can not exit or finalize before the end
+	suite.Ctx = suite.Ctx.WithBlockTime(sale.StartTime.Add(duration / 2))
+	_, err = suite.msgServer.ExitSale(ctx(suite.Ctx), &types.MsgExitSale{Sender: alice.String(), SaleId: saleId})
+	suite.Require().ErrorIs(err, types.ErrSaleNotEnded)
+	_, err = suite.msgServer.FinalizeSale(ctx(suite.Ctx), &types.MsgFinalizeSale{Sender: bob.String(), SaleId: saleId})
+	suite.Require().ErrorIs(err, types.ErrSaleNotEnded)
+
+	// bob withdraws all unspent tokens at the half of the sale
+	bobBalance := suite.App.BankKeeper.GetBalance(suite.Ctx, bob, tokenIn)
+	_, err = suite.msgServer.Withdraw(ctx(suite.Ctx), &types.MsgWithdraw{Sender: bob.String(), SaleId: saleId})
+	suite.Require().NoError(err)
+	suite.Require().Equal(bobBalance.AddAmount(sdk.NewInt(100)), suite.App.BankKeeper.GetBalance(suite.Ctx, bob, tokenIn))
+
+	res, err := suite.queryClient.UserPosition(ctx(suite.Ctx), &types.QueryUserPosition{SaleId: saleId, User: alice.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(100), res.UserPosition.Staked)
+
+	// sale ended
+	suite.Ctx = suite.Ctx.WithBlockTime(sale.EndTime)
+	_, err = suite.msgServer.Subscribe(ctx(suite.Ctx), &types.MsgSubscribe{Sender: bob.String(), SaleId: saleId, Amount: sdk.NewInt(100)})
+	suite.Require().ErrorIs(err, types.ErrSaleEnded)
+
+	aliceExit, err := suite.msgServer.ExitSale(ctx(suite.Ctx), &types.MsgExitSale{Sender: alice.String(), SaleId: saleId})
+	suite.Require().NoError(err)
+	bobExit, err := suite.msgServer.ExitSale(ctx(suite.Ctx), &types.MsgExitSale{Sender: bob.String(), SaleId: saleId})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(750), aliceExit.Purchased)
+	suite.Require().Equal(sdk.NewInt(250), bobExit.Purchased)
+
+	treasuryBalance := suite.App.BankKeeper.GetBalance(suite.Ctx, creator, tokenIn)
+	finalizeRes, err := suite.msgServer.FinalizeSale(ctx(suite.Ctx), &types.MsgFinalizeSale{Sender: bob.String(), SaleId: saleId})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(300), finalizeRes.Income)
+	suite.Require().Equal(treasuryBalance.AddAmount(sdk.NewInt(300)), suite.App.BankKeeper.GetBalance(suite.Ctx, creator, tokenIn))
+
+	_, err = suite.msgServer.FinalizeSale(ctx(suite.Ctx), &types.MsgFinalizeSale{Sender: bob.String(), SaleId: saleId})
+	suite.Require().ErrorIs(err, types.ErrSaleFinalized)
+
+	// all the escrowed tokens left the module
+	moduleAddr := suite.App.AccountKeeper.GetModuleAddress(types.ModuleName)
+	suite.Require().True(suite.App.BankKeeper.GetAllBalances(suite.Ctx, moduleAddr).IsZero())
+}
+
+func (suite *KeeperTestSuite) TestFinalizeSaleWithoutSubscribers() {
+	creator := suite.TestAccs[0]
+	saleId := suite.createSale(creator, time.Hour*24)
+	sale, err := suite.App.StreamSwapKeeper.GetSale(suite.Ctx, saleId)
+	suite.Require().NoError(err)
+
+	suite.Ctx = suite.Ctx.WithBlockTime(sale.EndTime.Add(time.Hour))
+	outBalance := suite.App.BankKeeper.GetBalance(suite.Ctx, creator, tokenOut)
+	_, err = suite.msgServer.FinalizeSale(sdk.WrapSDKContext(suite.Ctx), &types.MsgFinalizeSale{Sender: creator.String(), SaleId: saleId})
+	suite.Require().NoError(err)
+
+	// unsold tokens are returned to the treasury
+	suite.Require().Equal(outBalance.AddAmount(sale.TokenOutSupply), suite.App.BankKeeper.GetBalance(suite.Ctx, creator, tokenOut))
+}
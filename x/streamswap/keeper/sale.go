@@ -0,0 +1,224 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v12/x/streamswap/types"
+)
+
+// CreateSale validates the sale parameters against the module params, charges
+// the sale creation fee, escrows token_out in the module account and stores
+// the new sale. It returns the id of the created sale.
+func (k Keeper) CreateSale(ctx sdk.Context, msg *types.MsgCreateSale) (uint64, error) {
+	params := k.GetParams(ctx)
+	now := ctx.BlockTime()
+
+	if msg.StartTime.Before(now.Add(params.MinDurationUntilStartTime)) {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidSale,
+			"start time must be at least %s after the current block time", params.MinDurationUntilStartTime)
+	}
+	if msg.Duration < params.MinSaleDuration {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidSale, "sale duration must be at least %s", params.MinSaleDuration)
+	}
+	if !params.SaleCreationFee.IsZero() && !sdk.Coins(msg.MaxFee).IsAllGTE(params.SaleCreationFee) {
+		return 0, sdkerrors.Wrapf(types.ErrInsufficientFee, "max fee %s, sale creation fee %s",
+			sdk.Coins(msg.MaxFee), params.SaleCreationFee)
+	}
+
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return 0, err
+	}
+	if err := k.chargeSaleCreationFee(ctx, creator, params); err != nil {
+		return 0, err
+	}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, creator, types.ModuleName, sdk.NewCoins(msg.TokenOut)); err != nil {
+		return 0, err
+	}
+
+	id := k.GetNextSaleId(ctx)
+	sale := types.NewSale(id, msg.GetRecipient(), msg.TokenIn, msg.TokenOut, msg.StartTime, msg.Duration, msg.Name, msg.Url)
+	k.SetSale(ctx, sale)
+	k.SetNextSaleId(ctx, id+1)
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventCreateSale{
+		Id:       id,
+		Creator:  msg.Creator,
+		TokenIn:  msg.TokenIn,
+		TokenOut: msg.TokenOut,
+	})
+	return id, err
+}
+
+// chargeSaleCreationFee sends the sale creation fee to the fee recipient or,
+// if the recipient is not set, to the community pool.
+func (k Keeper) chargeSaleCreationFee(ctx sdk.Context, creator sdk.AccAddress, params types.Params) error {
+	if params.SaleCreationFee.IsZero() {
+		return nil
+	}
+	if params.SaleCreationFeeRecipient == "" {
+		return k.distrKeeper.FundCommunityPool(ctx, params.SaleCreationFee, creator)
+	}
+	recipient, err := sdk.AccAddressFromBech32(params.SaleCreationFeeRecipient)
+	if err != nil {
+		return err
+	}
+	return k.bankKeeper.SendCoins(ctx, creator, recipient, params.SaleCreationFee)
+}
+
+// getSaleAndPosition loads the sale and the user position (creating an empty
+// one if missing and allowed) and brings both up to date with the block time.
+func (k Keeper) getSaleAndPosition(ctx sdk.Context, saleId uint64, user sdk.AccAddress, create bool) (types.Sale, types.UserPosition, error) {
+	sale, err := k.GetSale(ctx, saleId)
+	if err != nil {
+		return types.Sale{}, types.UserPosition{}, err
+	}
+	position, err := k.GetUserPosition(ctx, saleId, user)
+	if err != nil {
+		if !create {
+			return types.Sale{}, types.UserPosition{}, err
+		}
+		position = types.NewUserPosition()
+	}
+
+	sale.UpdateRound(ctx.BlockTime())
+	sale.UpdatePosition(&position)
+	return sale, position, nil
+}
+
+// Subscribe stakes amount of sale.token_in from the sender into the sale.
+func (k Keeper) Subscribe(ctx sdk.Context, sender sdk.AccAddress, saleId uint64, amount sdk.Int) error {
+	sale, position, err := k.getSaleAndPosition(ctx, saleId, sender, true)
+	if err != nil {
+		return err
+	}
+	if sale.HasEnded(ctx.BlockTime()) {
+		return sdkerrors.Wrapf(types.ErrSaleEnded, "sale id: %d", saleId)
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(sale.TokenIn, amount))
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, coins); err != nil {
+		return err
+	}
+
+	sale.Subscribe(&position, amount)
+	k.SetSale(ctx, sale)
+	k.SetUserPosition(ctx, saleId, sender, position)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventSubscribe{
+		Sender: sender.String(),
+		SaleId: saleId,
+		Amount: amount.String(),
+	})
+}
+
+// Withdraw sends back amount of unspent token_in to the sender. If amount is
+// nil, all unspent tokens are withdrawn.
+func (k Keeper) Withdraw(ctx sdk.Context, sender sdk.AccAddress, saleId uint64, amount *sdk.Int) (sdk.Int, error) {
+	sale, position, err := k.getSaleAndPosition(ctx, saleId, sender, false)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	toWithdraw := position.Staked
+	if amount != nil {
+		toWithdraw = *amount
+	}
+	if err := sale.Withdraw(&position, toWithdraw); err != nil {
+		return sdk.Int{}, sdkerrors.Wrapf(err, "requested %s, available %s", toWithdraw, position.Staked)
+	}
+
+	if toWithdraw.IsPositive() {
+		coins := sdk.NewCoins(sdk.NewCoin(sale.TokenIn, toWithdraw))
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, coins); err != nil {
+			return sdk.Int{}, err
+		}
+	}
+	k.SetSale(ctx, sale)
+	k.SetUserPosition(ctx, saleId, sender, position)
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventWithdraw{
+		Sender: sender.String(),
+		SaleId: saleId,
+		Amount: toWithdraw.String(),
+	})
+	return toWithdraw, err
+}
+
+// ExitSale closes the sender position after the sale end, sending the
+// purchased token_out to the sender. It returns the purchased amount.
+func (k Keeper) ExitSale(ctx sdk.Context, sender sdk.AccAddress, saleId uint64) (sdk.Int, error) {
+	sale, position, err := k.getSaleAndPosition(ctx, saleId, sender, false)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	if !sale.HasEnded(ctx.BlockTime()) {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrSaleNotEnded, "sale id: %d", saleId)
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(sale.TokenOut, position.Purchased))
+	// At the sale end the whole stake is spent, this only returns rounding dust.
+	if position.Staked.IsPositive() {
+		coins = coins.Add(sdk.NewCoin(sale.TokenIn, position.Staked))
+	}
+	if !coins.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, coins); err != nil {
+			return sdk.Int{}, err
+		}
+	}
+	k.SetSale(ctx, sale)
+	k.deleteUserPosition(ctx, saleId, sender)
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventExit{
+		Sender:    sender.String(),
+		SaleId:    saleId,
+		Purchased: position.Purchased.String(),
+	})
+	return position.Purchased, err
+}
+
+// FinalizeSale sends the sale income, together with token_out which was not
+// sold because nobody was subscribed, to the sale treasury. It returns the
+// income amount.
+func (k Keeper) FinalizeSale(ctx sdk.Context, saleId uint64) (sdk.Int, error) {
+	sale, err := k.GetSale(ctx, saleId)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	if sale.IsFinalized() {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrSaleFinalized, "sale id: %d", saleId)
+	}
+	if !sale.HasEnded(ctx.BlockTime()) {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrSaleNotEnded, "sale id: %d", saleId)
+	}
+	sale.UpdateRound(ctx.BlockTime())
+
+	treasury, err := sdk.AccAddressFromBech32(sale.Treasury)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	// Stake left without any shares (rounding dust) is not owned by anyone
+	// and is treated as income.
+	income := sale.Income
+	if sale.Shares.IsZero() {
+		income = income.Add(sale.Staked)
+		sale.Staked = sdk.ZeroInt()
+	}
+	coins := sdk.NewCoins(sdk.NewCoin(sale.TokenIn, income), sdk.NewCoin(sale.TokenOut, sale.OutRemaining))
+	if !coins.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, treasury, coins); err != nil {
+			return sdk.Int{}, err
+		}
+	}
+
+	sale.Income = income
+	sale.Round = sale.EndRound + 1
+	k.SetSale(ctx, sale)
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventFinalizeSale{
+		SaleId: saleId,
+		Income: income.String(),
+	})
+	return income, err
+}
@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v12/osmoutils"
+	"github.com/osmosis-labs/osmosis/v12/x/streamswap/types"
+)
+
+// GetNextSaleId returns the id to be used by the next created sale.
+func (k Keeper) GetNextSaleId(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyNextSaleID)
+	if bz == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextSaleId sets the id to be used by the next created sale.
+func (k Keeper) SetNextSaleId(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.KeyNextSaleID, sdk.Uint64ToBigEndian(id))
+}
+
+// GetSale returns the sale with the given id.
+func (k Keeper) GetSale(ctx sdk.Context, id uint64) (types.Sale, error) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.SaleKey(id)
+	if !store.Has(key) {
+		return types.Sale{}, sdkerrors.Wrapf(types.ErrSaleNotFound, "sale id: %d", id)
+	}
+	var sale types.Sale
+	osmoutils.MustGet(store, key, &sale)
+	return sale, nil
+}
+
+// SetSale stores the sale under its id.
+func (k Keeper) SetSale(ctx sdk.Context, sale types.Sale) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.SaleKey(sale.Id), &sale)
+}
+
+// GetAllSales returns all sales ordered by their id.
+func (k Keeper) GetAllSales(ctx sdk.Context) []types.Sale {
+	sales, err := osmoutils.GatherValuesFromStore(ctx.KVStore(k.storeKey),
+		types.KeyPrefixSale, sdk.PrefixEndBytes(types.KeyPrefixSale), k.parseSale)
+	if err != nil {
+		panic(err)
+	}
+	return sales
+}
+
+func (k Keeper) parseSale(bz []byte) (types.Sale, error) {
+	var sale types.Sale
+	err := k.cdc.Unmarshal(bz, &sale)
+	return sale, err
+}
+
+// GetUserPosition returns the position of the user in the given sale.
+func (k Keeper) GetUserPosition(ctx sdk.Context, saleId uint64, user sdk.AccAddress) (types.UserPosition, error) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.UserPositionKey(saleId, user)
+	if !store.Has(key) {
+		return types.UserPosition{}, sdkerrors.Wrapf(types.ErrNoUserPosition, "sale id: %d, user: %s", saleId, user)
+	}
+	var position types.UserPosition
+	osmoutils.MustGet(store, key, &position)
+	return position, nil
+}
+
+// SetUserPosition stores the position of the user in the given sale.
+func (k Keeper) SetUserPosition(ctx sdk.Context, saleId uint64, user sdk.AccAddress, position types.UserPosition) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.UserPositionKey(saleId, user), &position)
+}
+
+// deleteUserPosition removes the position of the user in the given sale.
+func (k Keeper) deleteUserPosition(ctx sdk.Context, saleId uint64, user sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Delete(types.UserPositionKey(saleId, user))
+}
+
+// GetAllUserPositions returns all user positions of all sales.
+func (k Keeper) GetAllUserPositions(ctx sdk.Context) []types.UserPositionKV {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.KeyPrefixUserPosition)
+	defer iter.Close()
+
+	positions := []types.UserPositionKV{}
+	prefixLen := len(types.KeyPrefixUserPosition)
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()
+		saleId := sdk.BigEndianToUint64(key[prefixLen : prefixLen+8])
+		user := sdk.AccAddress(key[prefixLen+8:])
+
+		var position types.UserPosition
+		k.cdc.MustUnmarshal(iter.Value(), &position)
+		positions = append(positions, types.UserPositionKV{
+			AccAddress:   user.String(),
+			SaleId:       saleId,
+			UserPosition: position,
+		})
+	}
+	return positions
+}
@@ -0,0 +1,43 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	authzcodec "github.com/cosmos/cosmos-sdk/x/authz/codec"
+)
+
+func RegisterCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgCreateSale{}, "osmosis/streamswap/create-sale", nil)
+	cdc.RegisterConcrete(&MsgSubscribe{}, "osmosis/streamswap/subscribe", nil)
+	cdc.RegisterConcrete(&MsgWithdraw{}, "osmosis/streamswap/withdraw", nil)
+	cdc.RegisterConcrete(&MsgExitSale{}, "osmosis/streamswap/exit-sale", nil)
+	cdc.RegisterConcrete(&MsgFinalizeSale{}, "osmosis/streamswap/finalize-sale", nil)
+}
+
+func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgCreateSale{},
+		&MsgSubscribe{},
+		&MsgWithdraw{},
+		&MsgExitSale{},
+		&MsgFinalizeSale{},
+	)
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+var (
+	amino     = codec.NewLegacyAmino()
+	ModuleCdc = codec.NewAminoCodec(amino)
+)
+
+func init() {
+	RegisterCodec(amino)
+	sdk.RegisterLegacyAminoCodec(amino)
+	// Register all Amino interfaces and concrete types on the authz Amino codec so that this can later be
+	// used to properly serialize MsgGrant and MsgExec instances
+	RegisterCodec(authzcodec.Amino)
+	amino.Seal()
+}
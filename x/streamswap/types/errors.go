@@ -0,0 +1,20 @@
+package types
+
+// DONTCOVER
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/streamswap module sentinel errors
+var (
+	ErrSaleNotFound           = sdkerrors.Register(ModuleName, 2, "sale not found")
+	ErrSaleFinalized          = sdkerrors.Register(ModuleName, 3, "sale already finalized")
+	ErrSaleNotEnded           = sdkerrors.Register(ModuleName, 4, "sale has not ended yet")
+	ErrSaleEnded              = sdkerrors.Register(ModuleName, 5, "sale has already ended")
+	ErrNoUserPosition         = sdkerrors.Register(ModuleName, 6, "user has no position in the sale")
+	ErrInvalidSale            = sdkerrors.Register(ModuleName, 7, "invalid sale")
+	ErrInvalidGenesis         = sdkerrors.Register(ModuleName, 8, "invalid genesis")
+	ErrInsufficientFee        = sdkerrors.Register(ModuleName, 9, "max_fee is smaller than the sale creation fee")
+	ErrWithdrawAmountTooLarge = sdkerrors.Register(ModuleName, 10, "withdraw amount is bigger than the unspent user stake")
+)
@@ -0,0 +1,49 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// DefaultGenesis returns the default streamswap genesis state.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Sales:         []Sale{},
+		UserPositions: []UserPositionKV{},
+		NextSaleId:    1,
+		Params:        DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seenSales := map[uint64]bool{}
+	for _, s := range gs.Sales {
+		if s.Id >= gs.NextSaleId {
+			return sdkerrors.Wrapf(ErrInvalidGenesis, "sale id (%d) must be smaller than next sale id (%d)", s.Id, gs.NextSaleId)
+		}
+		if seenSales[s.Id] {
+			return sdkerrors.Wrapf(ErrInvalidGenesis, "duplicate sale id: %d", s.Id)
+		}
+		seenSales[s.Id] = true
+		if _, err := sdk.AccAddressFromBech32(s.Treasury); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidGenesis, "invalid treasury of sale %d (%s)", s.Id, err)
+		}
+	}
+
+	for _, up := range gs.UserPositions {
+		if !seenSales[up.SaleId] {
+			return sdkerrors.Wrapf(ErrInvalidGenesis, "user position references unknown sale: %d", up.SaleId)
+		}
+		if _, err := sdk.AccAddressFromBech32(up.AccAddress); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidGenesis, "invalid user position address (%s)", err)
+		}
+	}
+
+	return nil
+}
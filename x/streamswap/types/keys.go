@@ -0,0 +1,44 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "streamswap"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for streamswap
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
+
+var (
+	// KeyNextSaleID defines key to store the next Sale ID to be used.
+	KeyNextSaleID = []byte{0x01}
+	// KeyPrefixSale defines the prefix to store sales by their ID.
+	KeyPrefixSale = []byte{0x02}
+	// KeyPrefixUserPosition defines the prefix to store user positions.
+	KeyPrefixUserPosition = []byte{0x03}
+)
+
+// SaleKey returns the store key for the sale with the given id.
+func SaleKey(id uint64) []byte {
+	return append(append([]byte{}, KeyPrefixSale...), sdk.Uint64ToBigEndian(id)...)
+}
+
+// UserPositionPrefix returns the store prefix under which all positions of the
+// given sale are stored.
+func UserPositionPrefix(saleId uint64) []byte {
+	return append(append([]byte{}, KeyPrefixUserPosition...), sdk.Uint64ToBigEndian(saleId)...)
+}
+
+// UserPositionKey returns the store key for the user position in the given sale.
+func UserPositionKey(saleId uint64, user sdk.AccAddress) []byte {
+	return append(UserPositionPrefix(saleId), user...)
+}
@@ -0,0 +1,171 @@
+package types
+
+import (
+	"net/url"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// constants
+const (
+	TypeMsgCreateSale   = "create_sale"
+	TypeMsgSubscribe    = "subscribe"
+	TypeMsgWithdraw     = "withdraw"
+	TypeMsgExitSale     = "exit_sale"
+	TypeMsgFinalizeSale = "finalize_sale"
+
+	MinNameLength = 4
+	MaxNameLength = 40
+	MaxURLLength  = 120
+)
+
+var _ sdk.Msg = &MsgCreateSale{}
+
+func (msg MsgCreateSale) Route() string { return RouterKey }
+func (msg MsgCreateSale) Type() string  { return TypeMsgCreateSale }
+func (msg MsgCreateSale) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid creator address (%s)", err)
+	}
+	if msg.Recipient != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid recipient address (%s)", err)
+		}
+	}
+	if err := sdk.ValidateDenom(msg.TokenIn); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid token_in denom (%s)", err)
+	}
+	if !msg.TokenOut.IsValid() || !msg.TokenOut.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "token_out must be a positive coin, got %s", msg.TokenOut)
+	}
+	if msg.TokenIn == msg.TokenOut.Denom {
+		return sdkerrors.Wrap(ErrInvalidSale, "token_in and token_out must be different denoms")
+	}
+	if !sdk.Coins(msg.MaxFee).IsValid() && len(msg.MaxFee) != 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid max_fee (%s)", sdk.Coins(msg.MaxFee))
+	}
+	if msg.Duration <= 0 {
+		return sdkerrors.Wrap(ErrInvalidSale, "duration must be positive")
+	}
+	if msg.Duration > MaxSaleDuration {
+		return sdkerrors.Wrapf(ErrInvalidSale, "duration must be at most %s", MaxSaleDuration)
+	}
+	if msg.StartTime.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidSale, "start_time must be set")
+	}
+	if l := len(msg.Name); l < MinNameLength || l > MaxNameLength {
+		return sdkerrors.Wrapf(ErrInvalidSale, "name length must be between %d and %d characters", MinNameLength, MaxNameLength)
+	}
+	if len(msg.Url) > MaxURLLength {
+		return sdkerrors.Wrapf(ErrInvalidSale, "url must be at most %d characters", MaxURLLength)
+	}
+	if _, err := url.ParseRequestURI(msg.Url); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidSale, "invalid url (%s)", err)
+	}
+
+	return nil
+}
+
+func (msg MsgCreateSale) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCreateSale) GetSigners() []sdk.AccAddress {
+	creator, _ := sdk.AccAddressFromBech32(msg.Creator)
+	return []sdk.AccAddress{creator}
+}
+
+// GetRecipient returns the account receiving the sale income, defaulting to the creator.
+func (msg MsgCreateSale) GetRecipient() string {
+	if msg.Recipient == "" {
+		return msg.Creator
+	}
+	return msg.Recipient
+}
+
+var _ sdk.Msg = &MsgSubscribe{}
+
+func (msg MsgSubscribe) Route() string { return RouterKey }
+func (msg MsgSubscribe) Type() string  { return TypeMsgSubscribe }
+func (msg MsgSubscribe) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+	if msg.Amount.IsNil() || !msg.Amount.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "amount must be positive")
+	}
+	return nil
+}
+
+func (msg MsgSubscribe) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSubscribe) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgWithdraw{}
+
+func (msg MsgWithdraw) Route() string { return RouterKey }
+func (msg MsgWithdraw) Type() string  { return TypeMsgWithdraw }
+func (msg MsgWithdraw) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+	if msg.Amount != nil && !msg.Amount.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "amount must be positive when set")
+	}
+	return nil
+}
+
+func (msg MsgWithdraw) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgWithdraw) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgExitSale{}
+
+func (msg MsgExitSale) Route() string { return RouterKey }
+func (msg MsgExitSale) Type() string  { return TypeMsgExitSale }
+func (msg MsgExitSale) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+	return nil
+}
+
+func (msg MsgExitSale) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgExitSale) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgFinalizeSale{}
+
+func (msg MsgFinalizeSale) Route() string { return RouterKey }
+func (msg MsgFinalizeSale) Type() string  { return TypeMsgFinalizeSale }
+func (msg MsgFinalizeSale) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+	return nil
+}
+
+func (msg MsgFinalizeSale) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgFinalizeSale) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
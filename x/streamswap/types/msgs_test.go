@@ -0,0 +1,72 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/v12/x/streamswap/types"
+)
+
+func TestMsgCreateSaleValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress([]byte("addr1---------------")).String()
+	validMsg := func() types.MsgCreateSale {
+		return types.MsgCreateSale{
+			Creator:   addr,
+			TokenIn:   "uosmo",
+			TokenOut:  sdk.NewInt64Coin("ufoo", 1000),
+			StartTime: time.Unix(1000, 0),
+			Duration:  time.Hour,
+			Name:      "foo sale",
+			Url:       "https://foo.io",
+		}
+	}
+
+	tests := map[string]struct {
+		modify    func(*types.MsgCreateSale)
+		expectErr bool
+	}{
+		"valid": {
+			modify: func(*types.MsgCreateSale) {},
+		},
+		"invalid creator": {
+			modify:    func(m *types.MsgCreateSale) { m.Creator = "invalid" },
+			expectErr: true,
+		},
+		"same token in and out": {
+			modify:    func(m *types.MsgCreateSale) { m.TokenIn = "ufoo" },
+			expectErr: true,
+		},
+		"zero token out": {
+			modify:    func(m *types.MsgCreateSale) { m.TokenOut = sdk.NewInt64Coin("ufoo", 0) },
+			expectErr: true,
+		},
+		"zero duration": {
+			modify:    func(m *types.MsgCreateSale) { m.Duration = 0 },
+			expectErr: true,
+		},
+		"name too short": {
+			modify:    func(m *types.MsgCreateSale) { m.Name = "foo" },
+			expectErr: true,
+		},
+		"invalid url": {
+			modify:    func(m *types.MsgCreateSale) { m.Url = "foo" },
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			msg := validMsg()
+			tc.modify(&msg)
+			err := msg.ValidateBasic()
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
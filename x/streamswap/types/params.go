@@ -0,0 +1,111 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	appparams "github.com/osmosis-labs/osmosis/v12/app/params"
+)
+
+// Parameter store keys.
+var (
+	KeySaleCreationFee           = []byte("SaleCreationFee")
+	KeySaleCreationFeeRecipient  = []byte("SaleCreationFeeRecipient")
+	KeyMinDurationUntilStartTime = []byte("MinDurationUntilStartTime")
+	KeyMinSaleDuration           = []byte("MinSaleDuration")
+)
+
+// ParamKeyTable returns the key table for the streamswap module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func NewParams(saleCreationFee sdk.Coins, feeRecipient string, minDurationUntilStartTime, minSaleDuration time.Duration) Params {
+	return Params{
+		SaleCreationFee:           saleCreationFee,
+		SaleCreationFeeRecipient:  feeRecipient,
+		MinDurationUntilStartTime: minDurationUntilStartTime,
+		MinSaleDuration:           minSaleDuration,
+	}
+}
+
+// DefaultParams returns the default streamswap module parameters.
+func DefaultParams() Params {
+	return Params{
+		SaleCreationFee:           sdk.NewCoins(sdk.NewInt64Coin(appparams.BaseCoinUnit, 200_000_000)), // 200 OSMO
+		SaleCreationFeeRecipient:  "",
+		MinDurationUntilStartTime: time.Hour * 24,
+		MinSaleDuration:           time.Hour * 24,
+	}
+}
+
+// Validate validates params.
+func (p Params) Validate() error {
+	if err := validateSaleCreationFee(p.SaleCreationFee); err != nil {
+		return err
+	}
+	if err := validateFeeRecipient(p.SaleCreationFeeRecipient); err != nil {
+		return err
+	}
+	if err := validateDuration(p.MinDurationUntilStartTime); err != nil {
+		return err
+	}
+	return validateDuration(p.MinSaleDuration)
+}
+
+// Implements params.ParamSet.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeySaleCreationFee, &p.SaleCreationFee, validateSaleCreationFee),
+		paramtypes.NewParamSetPair(KeySaleCreationFeeRecipient, &p.SaleCreationFeeRecipient, validateFeeRecipient),
+		paramtypes.NewParamSetPair(KeyMinDurationUntilStartTime, &p.MinDurationUntilStartTime, validateDuration),
+		paramtypes.NewParamSetPair(KeyMinSaleDuration, &p.MinSaleDuration, validateDuration),
+	}
+}
+
+func validateSaleCreationFee(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.Validate() != nil {
+		return fmt.Errorf("invalid sale creation fee: %+v", i)
+	}
+
+	return nil
+}
+
+func validateFeeRecipient(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// an empty recipient means the fee is sent to the community pool.
+	if v == "" {
+		return nil
+	}
+	if _, err := sdk.AccAddressFromBech32(v); err != nil {
+		return fmt.Errorf("invalid sale creation fee recipient: %w", err)
+	}
+
+	return nil
+}
+
+func validateDuration(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return errors.New("duration must be non negative")
+	}
+
+	return nil
+}
@@ -0,0 +1,169 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// RoundDuration is the length of a single distribution round.
+	RoundDuration = time.Second
+
+	// MaxSaleDuration is the maximum duration of a sale. It bounds the number of
+	// rounds so that the round number never overflows.
+	MaxSaleDuration = time.Hour * 24 * 365 * 139
+)
+
+// outPerShareScale is the fixed point precision used for Sale.OutPerShare and
+// UserPosition.OutPerShare, which are stored as integers.
+var outPerShareScale = sdk.NewIntWithDecimal(1, 18)
+
+// NewSale creates a new sale which has not distributed anything yet.
+func NewSale(id uint64, treasury, tokenIn string, tokenOut sdk.Coin, start time.Time, duration time.Duration, name, url string) Sale {
+	return Sale{
+		Treasury:       treasury,
+		Id:             id,
+		TokenOut:       tokenOut.Denom,
+		TokenIn:        tokenIn,
+		TokenOutSupply: tokenOut.Amount,
+		StartTime:      start,
+		EndTime:        start.Add(duration),
+		Round:          0,
+		EndRound:       int64(duration / RoundDuration),
+		OutRemaining:   tokenOut.Amount,
+		OutSold:        sdk.ZeroInt(),
+		OutPerShare:    sdk.ZeroInt(),
+		Staked:         sdk.ZeroInt(),
+		Income:         sdk.ZeroInt(),
+		Shares:         sdk.ZeroInt(),
+		Name:           name,
+		Url:            url,
+	}
+}
+
+// NewUserPosition creates an empty user position.
+func NewUserPosition() UserPosition {
+	return UserPosition{
+		Shares:      sdk.ZeroInt(),
+		Staked:      sdk.ZeroInt(),
+		OutPerShare: sdk.ZeroInt(),
+		Spent:       sdk.ZeroInt(),
+		Purchased:   sdk.ZeroInt(),
+	}
+}
+
+// IsFinalized returns true if the sale income was already sent to the treasury.
+// A finalized sale has its round set past the end round.
+func (s Sale) IsFinalized() bool {
+	return s.Round > s.EndRound
+}
+
+// HasEnded returns true if no more tokens can be streamed at the given time.
+func (s Sale) HasEnded(now time.Time) bool {
+	return !now.Before(s.EndTime)
+}
+
+// currentRound returns the round for the given time, bounded to [0, EndRound].
+func (s Sale) currentRound(now time.Time) int64 {
+	if !now.After(s.StartTime) {
+		return 0
+	}
+	if s.HasEnded(now) {
+		return s.EndRound
+	}
+	return int64(now.Sub(s.StartTime) / RoundDuration)
+}
+
+// UpdateRound streams token_out to the subscribers and charges their stake for
+// all the rounds which have passed since the last update.
+// Tokens of rounds without any subscriber are carried over to the next rounds.
+func (s *Sale) UpdateRound(now time.Time) {
+	if s.IsFinalized() {
+		return
+	}
+	round := s.currentRound(now)
+	if round <= s.Round {
+		return
+	}
+	if s.Shares.IsPositive() {
+		diff := sdk.NewInt(round - s.Round)
+		remaining := sdk.NewInt(s.EndRound - s.Round)
+
+		dist := s.OutRemaining.Mul(diff).Quo(remaining)
+		spent := s.Staked.Mul(diff).Quo(remaining)
+
+		s.OutPerShare = s.OutPerShare.Add(dist.Mul(outPerShareScale).Quo(s.Shares))
+		s.OutRemaining = s.OutRemaining.Sub(dist)
+		s.OutSold = s.OutSold.Add(dist)
+		s.Staked = s.Staked.Sub(spent)
+		s.Income = s.Income.Add(spent)
+	}
+	s.Round = round
+}
+
+// SharesForAmount returns the number of shares issued for staking amount of token_in.
+func (s Sale) SharesForAmount(amount sdk.Int) sdk.Int {
+	if s.Shares.IsZero() || s.Staked.IsZero() {
+		return amount
+	}
+	return amount.Mul(s.Shares).Quo(s.Staked)
+}
+
+// sharesToWithdraw returns the number of shares to burn when withdrawing amount
+// of token_in. The result is rounded up in favor of the remaining subscribers.
+func (s Sale) sharesToWithdraw(amount sdk.Int) sdk.Int {
+	if s.Staked.IsZero() {
+		return s.Shares
+	}
+	num := amount.Mul(s.Shares)
+	shares := num.Quo(s.Staked)
+	if !num.Mod(s.Staked).IsZero() {
+		shares = shares.AddRaw(1)
+	}
+	return shares
+}
+
+// UpdatePosition settles the purchased tokens and the spent stake of the
+// user position against the current (already updated) sale state.
+func (s Sale) UpdatePosition(p *UserPosition) {
+	if p.Shares.IsPositive() {
+		p.Purchased = p.Purchased.Add(s.OutPerShare.Sub(p.OutPerShare).Mul(p.Shares).Quo(outPerShareScale))
+		staked := sdk.ZeroInt()
+		if s.Shares.IsPositive() {
+			staked = p.Shares.Mul(s.Staked).Quo(s.Shares)
+		}
+		if staked.LT(p.Staked) {
+			p.Spent = p.Spent.Add(p.Staked.Sub(staked))
+			p.Staked = staked
+		}
+	}
+	p.OutPerShare = s.OutPerShare
+}
+
+// Subscribe adds amount of token_in to the user position. Both sale and
+// position must be updated beforehand.
+func (s *Sale) Subscribe(p *UserPosition, amount sdk.Int) {
+	shares := s.SharesForAmount(amount)
+	s.Shares = s.Shares.Add(shares)
+	s.Staked = s.Staked.Add(amount)
+	p.Shares = p.Shares.Add(shares)
+	p.Staked = p.Staked.Add(amount)
+}
+
+// Withdraw removes amount of unspent token_in from the user position. Both
+// sale and position must be updated beforehand.
+func (s *Sale) Withdraw(p *UserPosition, amount sdk.Int) error {
+	if amount.GT(p.Staked) {
+		return ErrWithdrawAmountTooLarge
+	}
+	shares := sdk.MinInt(s.sharesToWithdraw(amount), p.Shares)
+	if amount.Equal(p.Staked) {
+		shares = p.Shares
+	}
+	s.Shares = s.Shares.Sub(shares)
+	s.Staked = s.Staked.Sub(amount)
+	p.Shares = p.Shares.Sub(shares)
+	p.Staked = p.Staked.Sub(amount)
+	return nil
+}
@@ -0,0 +1,92 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	defaultStart    = time.Unix(1_000_000, 0).UTC()
+	defaultDuration = time.Second * 100
+)
+
+func newTestSale() Sale {
+	return NewSale(1, "treasury", "uin", sdk.NewInt64Coin("uout", 1000), defaultStart, defaultDuration, "test", "https://test.io")
+}
+
+func TestUpdateRoundWithoutSubscribers(t *testing.T) {
+	s := newTestSale()
+	s.UpdateRound(defaultStart.Add(time.Second * 50))
+
+	require.Equal(t, int64(50), s.Round)
+	require.Equal(t, sdk.NewInt(1000), s.OutRemaining)
+	require.True(t, s.OutSold.IsZero())
+	require.True(t, s.OutPerShare.IsZero())
+}
+
+func TestSingleSubscriber(t *testing.T) {
+	s := newTestSale()
+	p := NewUserPosition()
+	s.Subscribe(&p, sdk.NewInt(500))
+
+	// half of the sale
+	s.UpdateRound(defaultStart.Add(time.Second * 50))
+	s.UpdatePosition(&p)
+	require.Equal(t, sdk.NewInt(500), s.OutSold)
+	require.Equal(t, sdk.NewInt(250), s.Income)
+	require.Equal(t, sdk.NewInt(500), p.Purchased)
+	require.Equal(t, sdk.NewInt(250), p.Staked)
+	require.Equal(t, sdk.NewInt(250), p.Spent)
+
+	// sale end
+	s.UpdateRound(defaultStart.Add(time.Hour))
+	s.UpdatePosition(&p)
+	require.Equal(t, s.EndRound, s.Round)
+	require.Equal(t, sdk.NewInt(1000), s.OutSold)
+	require.True(t, s.OutRemaining.IsZero())
+	require.Equal(t, sdk.NewInt(500), s.Income)
+	require.True(t, s.Staked.IsZero())
+	require.Equal(t, sdk.NewInt(1000), p.Purchased)
+	require.True(t, p.Staked.IsZero())
+	require.Equal(t, sdk.NewInt(500), p.Spent)
+}
+
+func TestSubscribersShareDistribution(t *testing.T) {
+	s := newTestSale()
+	p1, p2 := NewUserPosition(), NewUserPosition()
+	s.Subscribe(&p1, sdk.NewInt(300))
+	s.Subscribe(&p2, sdk.NewInt(100))
+
+	s.UpdateRound(defaultStart.Add(time.Second * 40))
+	s.UpdatePosition(&p1)
+	s.UpdatePosition(&p2)
+	// 400 out tokens were streamed, 3:1 between the subscribers
+	require.Equal(t, sdk.NewInt(300), p1.Purchased)
+	require.Equal(t, sdk.NewInt(100), p2.Purchased)
+
+	// the second subscriber leaves, the first one receives the rest
+	require.NoError(t, s.Withdraw(&p2, p2.Staked))
+	require.True(t, p2.Shares.IsZero())
+
+	s.UpdateRound(defaultStart.Add(defaultDuration))
+	s.UpdatePosition(&p1)
+	s.UpdatePosition(&p2)
+	require.Equal(t, sdk.NewInt(900), p1.Purchased)
+	require.Equal(t, sdk.NewInt(100), p2.Purchased)
+	require.Equal(t, sdk.NewInt(1000), s.OutSold)
+	require.Equal(t, s.Income, p1.Spent.Add(p2.Spent))
+}
+
+func TestWithdrawTooMuch(t *testing.T) {
+	s := newTestSale()
+	p := NewUserPosition()
+	s.Subscribe(&p, sdk.NewInt(100))
+
+	require.ErrorIs(t, s.Withdraw(&p, sdk.NewInt(101)), ErrWithdrawAmountTooLarge)
+	require.NoError(t, s.Withdraw(&p, sdk.NewInt(40)))
+	require.Equal(t, sdk.NewInt(60), p.Staked)
+	require.Equal(t, sdk.NewInt(60), s.Staked)
+}
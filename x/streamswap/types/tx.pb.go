@@ -12,12 +12,11 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
-	_ "github.com/gogo/protobuf/types"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	types1 "github.com/gogo/protobuf/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
-	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -393,7 +392,7 @@ func init() {
 func init() { proto.RegisterFile("osmosis/streamswap/v1/tx.proto", fileDescriptor_4988bf90ab6cecf3) }
 
 var fileDescriptor_4988bf90ab6cecf3 = []byte{
-	// 738 bytes of a gzipped FileDescriptorProto
+	// 735 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xdf, 0x4e, 0x13, 0x4f,
 	0x14, 0xee, 0xd2, 0xfe, 0xda, 0xee, 0x81, 0x9f, 0x9a, 0x51, 0x70, 0xa9, 0x66, 0x4b, 0x56, 0x43,
 	0x88, 0xca, 0x6e, 0x8a, 0x89, 0x31, 0xc6, 0xc4, 0xa4, 0x40, 0x23, 0x89, 0x8d, 0x49, 0x21, 0x9a,
@@ -439,8 +438,7 @@ var fileDescriptor_4988bf90ab6cecf3 = []byte{
 	0xf0, 0x5d, 0xcd, 0x1d, 0x1c, 0xab, 0xd2, 0xe1, 0xb1, 0x2a, 0x7d, 0x3b, 0x56, 0xa5, 0xfd, 0x13,
 	0x35, 0x77, 0x78, 0xa2, 0xe6, 0x3e, 0x9f, 0xa8, 0xb9, 0xcd, 0x47, 0x43, 0xd3, 0x90, 0xe8, 0x2e,
 	0x76, 0xb1, 0xc9, 0xd3, 0xc0, 0xe8, 0xd5, 0x96, 0x8c, 0xfe, 0xf0, 0xff, 0x6b, 0x34, 0x21, 0x66,
-	0x31, 0xaa, 0xd4, 0xc3, 0x9f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x58, 0xb3, 0x24, 0x42, 0x82, 0x07,
-	0x00, 0x00,
+	0x31, 0xaa, 0xd4, 0xc3, 0x9f, 0x03, 0x00, 0x58, 0xb3, 0x24, 0x42, 0x82, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -465,11 +463,11 @@ type MsgClient interface {
 	// the sale by sending `token_in` to the Sale through the Subscribe msg.
 	// During the sale, user `token_in` will be automatically charged every
 	// epoch to purchase `token_out`.
-	Subscribe(ctx context.Context, in *MsgSubscribe, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Subscribe(ctx context.Context, in *MsgSubscribe, opts ...grpc.CallOption) (*types1.Empty, error)
 	// Withdraw sends back `amount` of unspent tokens_in to the user.
 	// If `amount` is empty, it will default to all unspent tokens.
 	// User can do it any time unless his deposit is empty.
-	Withdraw(ctx context.Context, in *MsgWithdraw, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Withdraw(ctx context.Context, in *MsgWithdraw, opts ...grpc.CallOption) (*types1.Empty, error)
 	// ExitSale withdraws (by a user who subscribed to the sale) purchased
 	// tokens_out from the pool and remained tokens_in. Must be called after
 	// the sale end.
@@ -497,8 +495,8 @@ func (c *msgClient) CreateSale(ctx context.Context, in *MsgCreateSale, opts ...g
 	return out, nil
 }
 
-func (c *msgClient) Subscribe(ctx context.Context, in *MsgSubscribe, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	out := new(emptypb.Empty)
+func (c *msgClient) Subscribe(ctx context.Context, in *MsgSubscribe, opts ...grpc.CallOption) (*types1.Empty, error) {
+	out := new(types1.Empty)
 	err := c.cc.Invoke(ctx, "/osmosis.streamswap.v1.Msg/Subscribe", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -506,8 +504,8 @@ func (c *msgClient) Subscribe(ctx context.Context, in *MsgSubscribe, opts ...grp
 	return out, nil
 }
 
-func (c *msgClient) Withdraw(ctx context.Context, in *MsgWithdraw, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	out := new(emptypb.Empty)
+func (c *msgClient) Withdraw(ctx context.Context, in *MsgWithdraw, opts ...grpc.CallOption) (*types1.Empty, error) {
+	out := new(types1.Empty)
 	err := c.cc.Invoke(ctx, "/osmosis.streamswap.v1.Msg/Withdraw", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -545,11 +543,11 @@ type MsgServer interface {
 	// the sale by sending `token_in` to the Sale through the Subscribe msg.
 	// During the sale, user `token_in` will be automatically charged every
 	// epoch to purchase `token_out`.
-	Subscribe(context.Context, *MsgSubscribe) (*emptypb.Empty, error)
+	Subscribe(context.Context, *MsgSubscribe) (*types1.Empty, error)
 	// Withdraw sends back `amount` of unspent tokens_in to the user.
 	// If `amount` is empty, it will default to all unspent tokens.
 	// User can do it any time unless his deposit is empty.
-	Withdraw(context.Context, *MsgWithdraw) (*emptypb.Empty, error)
+	Withdraw(context.Context, *MsgWithdraw) (*types1.Empty, error)
 	// ExitSale withdraws (by a user who subscribed to the sale) purchased
 	// tokens_out from the pool and remained tokens_in. Must be called after
 	// the sale end.
@@ -567,10 +565,10 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) CreateSale(ctx context.Context, req *MsgCreateSale) (*MsgCreateSaleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateSale not implemented")
 }
-func (*UnimplementedMsgServer) Subscribe(ctx context.Context, req *MsgSubscribe) (*emptypb.Empty, error) {
+func (*UnimplementedMsgServer) Subscribe(ctx context.Context, req *MsgSubscribe) (*types1.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
 }
-func (*UnimplementedMsgServer) Withdraw(ctx context.Context, req *MsgWithdraw) (*emptypb.Empty, error) {
+func (*UnimplementedMsgServer) Withdraw(ctx context.Context, req *MsgWithdraw) (*types1.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Withdraw not implemented")
 }
 func (*UnimplementedMsgServer) ExitSale(ctx context.Context, req *MsgExitSale) (*MsgExitSaleResponse, error) {
@@ -31,6 +31,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdAssetMultiplier(),
 		GetCmdAllIntermediaryAccounts(),
 		GetCmdConnectedIntermediaryAccount(),
+		GetCmdLockValidatorSet(),
 		GetCmdSuperfluidDelegationAmount(),
 		GetCmdSuperfluidDelegationsByDelegator(),
 		GetCmdSuperfluidUndelegationsByDelegator(),
@@ -191,6 +192,49 @@ $ %s query superfluid all-intermediary-accounts
 	return cmd
 }
 
+// GetCmdLockValidatorSet returns the validator set a lock is superfluid delegated to.
+func GetCmdLockValidatorSet() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lock-validator-set [lock_id]",
+		Short: "Query the weighted validator set a lock is superfluid delegated to",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the weighted validator set a lock is superfluid delegated to.
+
+Example:
+$ %s query superfluid lock-validator-set 1
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			lockId, err := strconv.Atoi(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.LockValidatorSet(cmd.Context(), &types.LockValidatorSetRequest{
+				LockId: uint64(lockId),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdConnectedIntermediaryAccount returns connected intermediary account.
 func GetCmdConnectedIntermediaryAccount() *cobra.Command {
 	cmd := &cobra.Command{
@@ -33,6 +33,10 @@ func GetTxCmd() *cobra.Command {
 		// NewSuperfluidRedelegateCmd(),
 		NewCmdLockAndSuperfluidDelegate(),
 		NewCmdUnPoolWhitelistedPool(),
+		NewSuperfluidDelegateToValidatorSetCmd(),
+		NewSuperfluidUpdateValidatorSetCmd(),
+		NewSuperfluidPartialUndelegateCmd(),
+		NewSuperfluidUndelegateAndUnbondLockCmd(),
 	)
 
 	return cmd
@@ -402,3 +406,164 @@ func NewCmdUnPoolWhitelistedPool() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// NewSuperfluidDelegateToValidatorSetCmd broadcast MsgSuperfluidDelegateToValidatorSet.
+func NewSuperfluidDelegateToValidatorSetCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "delegate-to-validator-set [lock_id] [valoper-address=weight,...] [flags]",
+		Short:   "superfluid delegate a lock to a weighted validator set",
+		Example: "osmosisd tx superfluid delegate-to-validator-set 1 osmovaloper1abc=0.5,osmovaloper1def=0.5 --from mykey",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			lockId, err := strconv.Atoi(args[0])
+			if err != nil {
+				return err
+			}
+
+			validators, err := parseValidatorWeights(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSuperfluidDelegateToValidatorSet(clientCtx.GetFromAddress(), uint64(lockId), validators)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSuperfluidUpdateValidatorSetCmd broadcast MsgSuperfluidUpdateValidatorSet.
+func NewSuperfluidUpdateValidatorSetCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "update-validator-set [lock_id] [valoper-address=weight,...] [flags]",
+		Short:   "change the weighted validator set a lock is superfluid delegated to",
+		Example: "osmosisd tx superfluid update-validator-set 1 osmovaloper1abc=0.2,osmovaloper1ghi=0.8 --from mykey",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			lockId, err := strconv.Atoi(args[0])
+			if err != nil {
+				return err
+			}
+
+			validators, err := parseValidatorWeights(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSuperfluidUpdateValidatorSet(clientCtx.GetFromAddress(), uint64(lockId), validators)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSuperfluidPartialUndelegateCmd broadcast MsgSuperfluidPartialUndelegate.
+func NewSuperfluidPartialUndelegateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "partial-undelegate [lock_id] [coin] [flags]",
+		Short:   "superfluid undelegate a part of a lock, split off into a new lock",
+		Example: "osmosisd tx superfluid partial-undelegate 1 1000gamm/pool/1 --from mykey",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			lockId, err := strconv.Atoi(args[0])
+			if err != nil {
+				return err
+			}
+
+			coin, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSuperfluidPartialUndelegate(clientCtx.GetFromAddress(), uint64(lockId), coin)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSuperfluidUndelegateAndUnbondLockCmd broadcast MsgSuperfluidUndelegateAndUnbondLock.
+func NewSuperfluidUndelegateAndUnbondLockCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "undelegate-and-unbond-lock [lock_id] [coin] [flags]",
+		Short:   "superfluid undelegate a part of a lock, and unbond the undelegated part",
+		Example: "osmosisd tx superfluid undelegate-and-unbond-lock 1 1000gamm/pool/1 --from mykey",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			lockId, err := strconv.Atoi(args[0])
+			if err != nil {
+				return err
+			}
+
+			coin, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSuperfluidUndelegateAndUnbondLock(clientCtx.GetFromAddress(), uint64(lockId), coin)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func parseValidatorWeights(arg string) ([]types.ValidatorWeight, error) {
+	validators := []types.ValidatorWeight{}
+	for _, pair := range strings.Split(arg, ",") {
+		parts := strings.Split(pair, "=")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid validator weight %q, expected valoper-address=weight", pair)
+		}
+
+		weight, err := sdk.NewDecFromStr(parts[1])
+		if err != nil {
+			return nil, err
+		}
+
+		validators = append(validators, types.ValidatorWeight{
+			ValAddr: parts[0],
+			Weight:  weight,
+		})
+	}
+	return validators, nil
+}
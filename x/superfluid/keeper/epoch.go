@@ -124,8 +124,17 @@ func (k Keeper) UpdateOsmoEquivalentMultipliers(ctx sdk.Context, asset types.Sup
 			return err
 		}
 
-		multiplier := k.calculateOsmoBackingPerShare(pool, osmoPoolAsset)
-		k.SetOsmoEquivalentMultiplier(ctx, newEpochNumber, asset.Denom, multiplier)
+		multiplier, inputs, err := k.computeOsmoEquivalentMultiplier(ctx, asset.Denom, pool, osmoPoolAsset)
+		if err != nil {
+			k.Logger(ctx).Error(err.Error())
+			return err
+		}
+		k.setOsmoEquivalentMultiplierRecord(ctx, types.OsmoEquivalentMultiplierRecord{
+			EpochNumber: newEpochNumber,
+			Denom:       asset.Denom,
+			Multiplier:  multiplier,
+			Inputs:      &inputs,
+		})
 	} else if asset.AssetType == types.SuperfluidAssetTypeNative {
 		// TODO: Consider deleting superfluid asset type native
 		k.Logger(ctx).Error("unsupported superfluid asset type")
@@ -3,4 +3,7 @@ package keeper
 var (
 	StakingSyntheticDenom   = stakingSyntheticDenom
 	UnstakingSyntheticDenom = unstakingSyntheticDenom
+
+	IntermediaryAccountStakingSyntheticDenom   = intermediaryAccountStakingSyntheticDenom
+	IntermediaryAccountUnstakingSyntheticDenom = intermediaryAccountUnstakingSyntheticDenom
 )
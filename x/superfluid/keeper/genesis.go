@@ -37,6 +37,11 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		}
 		k.SetLockIdIntermediaryAccountConnection(ctx, connection.LockId, intermediaryAcc)
 	}
+
+	// initialize the validator sets of the locks superfluid delegated to a validator set
+	for _, set := range genState.LockValidatorSets {
+		k.SetLockValidatorSet(ctx, set)
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
@@ -47,5 +52,6 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		OsmoEquivalentMultipliers:     k.GetAllOsmoEquivalentMultipliers(ctx),
 		IntermediaryAccounts:          k.GetAllIntermediaryAccounts(ctx),
 		IntemediaryAccountConnections: k.GetAllLockIdIntermediaryAccountConnections(ctx),
+		LockValidatorSets:             k.GetAllLockValidatorSets(ctx),
 	}
 }
@@ -19,7 +19,8 @@ var now = time.Now().UTC()
 
 var testGenesis = types.GenesisState{
 	Params: types.Params{
-		MinimumRiskFactor: sdk.NewDecWithPrec(5, 1), // 50%
+		MinimumRiskFactor:   sdk.NewDecWithPrec(5, 1), // 50%
+		MaxMultiplierChange: sdk.ZeroDec(),
 	},
 	SuperfluidAssets: []types.SuperfluidAsset{
 		{
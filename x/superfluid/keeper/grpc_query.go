@@ -75,12 +75,17 @@ func (q Querier) AssetMultiplier(goCtx context.Context, req *types.AssetMultipli
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	epochInfo := q.Keeper.ek.GetEpochInfo(ctx, q.Keeper.GetEpochIdentifier(ctx))
+	priceRecord, found := q.Keeper.getOsmoEquivalentMultiplierRecord(ctx, req.Denom)
+	if !found {
+		priceRecord.Multiplier = sdk.ZeroDec()
+	}
 
 	return &types.AssetMultiplierResponse{
 		OsmoEquivalentMultiplier: &types.OsmoEquivalentMultiplierRecord{
 			EpochNumber: epochInfo.CurrentEpoch,
 			Denom:       req.Denom,
-			Multiplier:  q.Keeper.GetOsmoEquivalentMultiplier(ctx, req.Denom),
+			Multiplier:  priceRecord.Multiplier,
+			Inputs:      priceRecord.Inputs,
 		},
 	}, nil
 }
@@ -136,6 +141,21 @@ func (q Querier) ConnectedIntermediaryAccount(goCtx context.Context, req *types.
 	}, nil
 }
 
+// LockValidatorSet returns the weighted validator set a lock is superfluid delegated to.
+func (q Querier) LockValidatorSet(goCtx context.Context, req *types.LockValidatorSetRequest) (*types.LockValidatorSetResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	set, found := q.Keeper.GetLockValidatorSet(ctx, req.LockId)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrNotValidatorSetDelegated, "lock id : %d", req.LockId)
+	}
+
+	return &types.LockValidatorSetResponse{ValidatorSet: set}, nil
+}
+
 // SuperfluidDelegationAmount returns the coins superfluid delegated for a
 
 func (q Querier) SuperfluidDelegationAmount(goCtx context.Context, req *types.SuperfluidDelegationAmountRequest) (*types.SuperfluidDelegationAmountResponse, error) {
@@ -214,7 +234,7 @@ func (q Querier) SuperfluidDelegationsByDelegator(goCtx context.Context, req *ty
 		}
 
 		baseDenom := periodLock.Coins.GetDenomByIndex(0)
-		lockedCoins := sdk.NewCoin(baseDenom, periodLock.GetCoins().AmountOf(baseDenom))
+		lockedCoins := sdk.NewCoin(baseDenom, q.Keeper.superfluidLockedAmount(ctx, *periodLock, syntheticLock))
 		valAddr, err := ValidatorAddressFromSyntheticDenom(syntheticLock.SynthDenom)
 
 		// Find how many osmo tokens this delegation is worth at superfluids current risk adjustment
@@ -275,7 +295,7 @@ func (q Querier) SuperfluidUndelegationsByDelegator(goCtx context.Context, req *
 		}
 
 		baseDenom := periodLock.Coins.GetDenomByIndex(0)
-		lockedCoin := sdk.NewCoin(baseDenom, periodLock.GetCoins().AmountOf(baseDenom))
+		lockedCoin := sdk.NewCoin(baseDenom, q.Keeper.superfluidLockedAmount(ctx, *periodLock, syntheticLock))
 
 		valAddr, err := ValidatorAddressFromSyntheticDenom(syntheticLock.SynthDenom)
 		if err != nil {
@@ -24,9 +24,9 @@ func (suite *KeeperTestSuite) TestSuperfluidAfterEpochEnd() {
 			[]stakingtypes.BondStatus{stakingtypes.Bonded},
 			1,
 			[]superfluidDelegation{{0, 0, 0, 1000000}},
-			// bond denom staked in pool = 15_000_000
-			// with risk adjustment, the actual bond denom staked via superfluid would be 15_000_000 * (1 - 0.5) = 7_500_000
-			// we do an arbitrary swap to set spot price, which adjusts superfluid staked equivalent base denom 20_000_000 * (1 - 0.5) = 10_000_000 during begin block
+			// we do an arbitrary swap at the end of the epoch to set spot price, leaving bond denom in pool = 15_000_000
+			// the multiplier is computed from the twap over the epoch instead, so that the superfluid staked equivalent base denom
+			// stays around 20_000_000 * (1 - 0.5) = 10_000_000 during begin block
 			// delegation rewards are calculated using the equation (current period cumulative reward ratio - last period cumulative reward ratio) * asset amount
 			// in this test case, the calculation for expected reward would be the following (0.99999 - 0) * 10_000_000
 			// thus we expect 999_990 stake as rewards
@@ -39,11 +39,8 @@ func (suite *KeeperTestSuite) TestSuperfluidAfterEpochEnd() {
 			[]superfluidDelegation{{0, 0, 0, 1000000}, {1, 1, 0, 1000000}},
 			// reward for the first block propser / lock 0 that has been superfluid staked would be equivalent to calculations done above
 			// 999_990 stake as rewards.
-			// reward for the second delegation is expected to be different. Amount superfluid staked would be equivalently 7_500_000 stake.
-			// This would be the first block propsed by the second validator, current period cumulative reward ratio being 999_86.66684,
-			// last period cumulative reward ratio being 0
-			// Thus as rewards, we expect 999986stake, calculted using the following equation: (999_86.66684 - 0) * 7_500_000
-			[]sdk.Coins{{sdk.NewCoin("stake", sdk.NewInt(999990))}, {sdk.NewCoin("stake", sdk.NewInt(999986))}},
+			// reward for the second delegation is the same, as the swap does not change the amount superfluid staked.
+			[]sdk.Coins{{sdk.NewCoin("stake", sdk.NewInt(999990))}, {sdk.NewCoin("stake", sdk.NewInt(999990))}},
 		},
 	}
 
@@ -70,9 +67,11 @@ func (suite *KeeperTestSuite) TestSuperfluidAfterEpochEnd() {
 				suite.BeginNewBlockWithProposer(true, valAddr)
 			}
 
-			// check lptoken twap value set
+			// check lptoken twap value set, which barely moves with the swap at the end of the epoch, up to swap fees.
+			// The multiplier at the spot price would be 15.
 			newEpochMultiplier := suite.App.SuperfluidKeeper.GetOsmoEquivalentMultiplier(suite.Ctx, denoms[0])
-			suite.Require().Equal(newEpochMultiplier, sdk.NewDec(15))
+			suite.Require().True(newEpochMultiplier.GT(sdk.NewDec(20)), newEpochMultiplier.String())
+			suite.Require().True(newEpochMultiplier.LT(sdk.NewDecWithPrec(201, 1)), newEpochMultiplier.String())
 
 			for index, lock := range locks {
 				// check gauge creation in new block
@@ -92,7 +91,7 @@ func (suite *KeeperTestSuite) TestSuperfluidAfterEpochEnd() {
 				suite.Require().NoError(err)
 				delegation, found := suite.App.StakingKeeper.GetDelegation(suite.Ctx, acc.GetAccAddress(), valAddr)
 				suite.Require().True(found)
-				suite.Require().Equal(sdk.NewDec(7500000), delegation.Shares)
+				suite.Require().Equal(sdk.NewDec(10012991), delegation.Shares)
 			}
 
 			for index, delAddr := range delAddrs {
@@ -64,7 +64,18 @@ func (k Keeper) GetIntermediaryAccountsForVal(ctx sdk.Context, valAddr sdk.ValAd
 }
 
 func (k Keeper) GetOrCreateIntermediaryAccount(ctx sdk.Context, denom, valAddr string) (types.SuperfluidIntermediaryAccount, error) {
-	accountAddr := types.GetSuperfluidIntermediaryAccountAddr(denom, valAddr)
+	return k.getOrCreateIntermediaryAccount(ctx, types.NewSuperfluidIntermediaryAccount(denom, valAddr, 0))
+}
+
+// GetOrCreateValidatorSetIntermediaryAccount returns the intermediary account holding the delegation of a lock
+// superfluid delegated to a validator set, to one validator of the set.
+// Unlike the (denom, validator) intermediary accounts, these only ever hold the delegation of their lock.
+func (k Keeper) GetOrCreateValidatorSetIntermediaryAccount(ctx sdk.Context, denom, valAddr string, lockId uint64) (types.SuperfluidIntermediaryAccount, error) {
+	return k.getOrCreateIntermediaryAccount(ctx, types.NewValidatorSetIntermediaryAccount(denom, valAddr, lockId, 0))
+}
+
+func (k Keeper) getOrCreateIntermediaryAccount(ctx sdk.Context, intermediaryAcct types.SuperfluidIntermediaryAccount) (types.SuperfluidIntermediaryAccount, error) {
+	accountAddr := intermediaryAcct.GetAccAddress()
 	storeAccount := k.GetIntermediaryAccount(ctx, accountAddr)
 	// if storeAccount is in state, we return it.
 	if !storeAccount.Empty() {
@@ -75,7 +86,7 @@ func (k Keeper) GetOrCreateIntermediaryAccount(ctx sdk.Context, denom, valAddr s
 	gaugeID, err := k.ik.CreateGauge(ctx, true, accountAddr, sdk.Coins{}, lockuptypes.QueryCondition{
 		LockQueryType: lockuptypes.ByDuration,
 		// move this synthetic denom creation to a dedicated function
-		Denom:    stakingSyntheticDenom(denom, valAddr),
+		Denom:    intermediaryAccountStakingSyntheticDenom(intermediaryAcct),
 		Duration: k.sk.GetParams(ctx).UnbondingTime,
 	}, ctx.BlockTime(), 1)
 	if err != nil {
@@ -83,7 +94,7 @@ func (k Keeper) GetOrCreateIntermediaryAccount(ctx sdk.Context, denom, valAddr s
 		return types.SuperfluidIntermediaryAccount{}, err
 	}
 
-	intermediaryAcct := types.NewSuperfluidIntermediaryAccount(denom, valAddr, gaugeID)
+	intermediaryAcct.GaugeId = gaugeID
 	k.SetIntermediaryAccount(ctx, intermediaryAcct)
 
 	// If the intermediary account's address doesn't already have an auth account associated with it,
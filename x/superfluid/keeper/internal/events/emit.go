@@ -62,6 +62,36 @@ func newSuperfluidDelegateEvent(lockId uint64, valAddress string) sdk.Event {
 	)
 }
 
+func EmitSuperfluidDelegateToValidatorSetEvent(ctx sdk.Context, lockId uint64, validators []types.ValidatorWeight) {
+	if ctx.EventManager() == nil {
+		return
+	}
+
+	ctx.EventManager().EmitEvents(newValidatorSetEvents(types.TypeEvtSuperfluidDelegate, lockId, validators))
+}
+
+func EmitSuperfluidUpdateValidatorSetEvent(ctx sdk.Context, lockId uint64, validators []types.ValidatorWeight) {
+	if ctx.EventManager() == nil {
+		return
+	}
+
+	ctx.EventManager().EmitEvents(newValidatorSetEvents(types.TypeEvtSuperfluidUpdateValidatorSet, lockId, validators))
+}
+
+// newValidatorSetEvents returns an event per validator of the set, along with its weight.
+func newValidatorSetEvents(eventType string, lockId uint64, validators []types.ValidatorWeight) sdk.Events {
+	events := make(sdk.Events, 0, len(validators))
+	for _, validator := range validators {
+		events = append(events, sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeLockId, utils.Uint64ToString(lockId)),
+			sdk.NewAttribute(types.AttributeValidator, validator.ValAddr),
+			sdk.NewAttribute(types.AttributeWeight, validator.Weight.String()),
+		))
+	}
+	return events
+}
+
 func EmitSuperfluidIncreaseDelegationEvent(ctx sdk.Context, lockId uint64, amount sdk.Coins) {
 	if ctx.EventManager() == nil {
 		return
@@ -114,6 +144,36 @@ func newSuperfluidUnbondLockEvent(lockId uint64) sdk.Event {
 	)
 }
 
+func EmitSuperfluidPartialUndelegateEvent(ctx sdk.Context, lockId uint64, coin sdk.Coin, splitLockId uint64) {
+	if ctx.EventManager() == nil {
+		return
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		newSuperfluidSplitLockEvent(types.TypeEvtSuperfluidPartialUndelegate, lockId, coin, splitLockId),
+	})
+}
+
+func EmitSuperfluidUndelegateAndUnbondLockEvent(ctx sdk.Context, lockId uint64, coin sdk.Coin, splitLockId uint64) {
+	if ctx.EventManager() == nil {
+		return
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		newSuperfluidSplitLockEvent(types.TypeEvtSuperfluidUndelegateAndUnbondLock, lockId, coin, splitLockId),
+	})
+}
+
+// newSuperfluidSplitLockEvent returns an event for the coin of a lock that has been split off into the split lock.
+func newSuperfluidSplitLockEvent(eventType string, lockId uint64, coin sdk.Coin, splitLockId uint64) sdk.Event {
+	return sdk.NewEvent(
+		eventType,
+		sdk.NewAttribute(types.AttributeLockId, fmt.Sprintf("%d", lockId)),
+		sdk.NewAttribute(types.AttributeAmount, coin.String()),
+		sdk.NewAttribute(types.AttributeSplitLockId, fmt.Sprintf("%d", splitLockId)),
+	)
+}
+
 func EmitUnpoolIdEvent(ctx sdk.Context, sender string, lpShareDenom string, allExitedLockIDsSerialized []byte) {
 	if ctx.EventManager() == nil {
 		return
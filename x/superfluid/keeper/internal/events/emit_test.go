@@ -156,6 +156,63 @@ func (suite *SuperfluidEventsTestSuite) TestEmitSuperfluidDelegateEvent() {
 	}
 }
 
+func (suite *SuperfluidEventsTestSuite) TestEmitSuperfluidValidatorSetEvents() {
+	validators := []types.ValidatorWeight{
+		{ValAddr: sdk.ValAddress([]byte(addressString)).String(), Weight: sdk.MustNewDecFromStr("0.3")},
+		{ValAddr: sdk.ValAddress([]byte("addr2---------------")).String(), Weight: sdk.MustNewDecFromStr("0.7")},
+	}
+
+	testcases := map[string]struct {
+		ctx       sdk.Context
+		eventType string
+		emit      func(ctx sdk.Context, lockId uint64, validators []types.ValidatorWeight)
+	}{
+		"delegate to validator set": {
+			ctx:       suite.CreateTestContext(),
+			eventType: types.TypeEvtSuperfluidDelegate,
+			emit:      events.EmitSuperfluidDelegateToValidatorSetEvent,
+		},
+		"update validator set": {
+			ctx:       suite.CreateTestContext(),
+			eventType: types.TypeEvtSuperfluidUpdateValidatorSet,
+			emit:      events.EmitSuperfluidUpdateValidatorSetEvent,
+		},
+		"context with no event manager": {
+			ctx:  sdk.Context{},
+			emit: events.EmitSuperfluidDelegateToValidatorSetEvent,
+		},
+	}
+
+	for name, tc := range testcases {
+		suite.Run(name, func() {
+			expectedEvents := sdk.Events{}
+			for _, validator := range validators {
+				expectedEvents = append(expectedEvents, sdk.NewEvent(
+					tc.eventType,
+					sdk.NewAttribute(types.AttributeLockId, "1"),
+					sdk.NewAttribute(types.AttributeValidator, validator.ValAddr),
+					sdk.NewAttribute(types.AttributeWeight, validator.Weight.String()),
+				))
+			}
+
+			hasNoEventManager := tc.ctx.EventManager() == nil
+
+			// System under test.
+			tc.emit(tc.ctx, 1, validators)
+
+			// Assertions
+			if hasNoEventManager {
+				// If there is no event manager on context, this is a no-op.
+				return
+			}
+
+			eventManager := tc.ctx.EventManager()
+			actualEvents := eventManager.Events()
+			suite.Equal(expectedEvents, actualEvents)
+		})
+	}
+}
+
 func (suite *SuperfluidEventsTestSuite) TestEmitSuperfluidIncreaseDelegationEvent() {
 	testcases := map[string]struct {
 		ctx    sdk.Context
@@ -287,6 +344,60 @@ func (suite *SuperfluidEventsTestSuite) TestEmitSuperfluidUnbondLockEvent() {
 	}
 }
 
+func (suite *SuperfluidEventsTestSuite) TestEmitSuperfluidSplitLockEvents() {
+	testcases := map[string]struct {
+		ctx         sdk.Context
+		lockID      uint64
+		coin        sdk.Coin
+		splitLockID uint64
+	}{
+		"basic valid": {
+			ctx:         suite.CreateTestContext(),
+			lockID:      1,
+			coin:        sdk.NewInt64Coin("gamm/pool/1", 100),
+			splitLockID: 2,
+		},
+		"context with no event manager": {
+			ctx: sdk.Context{},
+		},
+	}
+
+	for name, tc := range testcases {
+		suite.Run(name, func() {
+			expectedEvents := sdk.Events{
+				sdk.NewEvent(
+					types.TypeEvtSuperfluidPartialUndelegate,
+					sdk.NewAttribute(types.AttributeLockId, fmt.Sprintf("%d", tc.lockID)),
+					sdk.NewAttribute(types.AttributeAmount, tc.coin.String()),
+					sdk.NewAttribute(types.AttributeSplitLockId, fmt.Sprintf("%d", tc.splitLockID)),
+				),
+				sdk.NewEvent(
+					types.TypeEvtSuperfluidUndelegateAndUnbondLock,
+					sdk.NewAttribute(types.AttributeLockId, fmt.Sprintf("%d", tc.lockID)),
+					sdk.NewAttribute(types.AttributeAmount, tc.coin.String()),
+					sdk.NewAttribute(types.AttributeSplitLockId, fmt.Sprintf("%d", tc.splitLockID)),
+				),
+			}
+
+			hasNoEventManager := tc.ctx.EventManager() == nil
+
+			// System under test.
+			events.EmitSuperfluidPartialUndelegateEvent(tc.ctx, tc.lockID, tc.coin, tc.splitLockID)
+			events.EmitSuperfluidUndelegateAndUnbondLockEvent(tc.ctx, tc.lockID, tc.coin, tc.splitLockID)
+
+			// Assertions
+			if hasNoEventManager {
+				// If there is no event manager on context, this is a no-op.
+				return
+			}
+
+			eventManager := tc.ctx.EventManager()
+			actualEvents := eventManager.Events()
+			suite.Equal(expectedEvents, actualEvents)
+		})
+	}
+}
+
 func (suite *SuperfluidEventsTestSuite) TestEmitUnpoolIdEvent() {
 	testAllExitedLockIDsSerialized, _ := json.Marshal([]uint64{1})
 
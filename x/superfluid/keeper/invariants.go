@@ -66,6 +66,13 @@ func TotalSuperfluidDelegationInvariant(keeper Keeper) sdk.Invariant {
 			totalExpectedSuperfluidAmount = totalExpectedSuperfluidAmount.Add(amount)
 		}
 
+		// Validator-set intermediary accounts have no connection, and hold the delegation of a single lock.
+		for _, acc := range accs {
+			if acc.LockId != 0 {
+				totalExpectedSuperfluidAmount = totalExpectedSuperfluidAmount.Add(keeper.GetExpectedDelegationAmount(ctx, acc))
+			}
+		}
+
 		if !totalExpectedSuperfluidAmount.Equal(totalSuperfluidDelegationTokens.TruncateInt()) {
 			return sdk.FormatInvariant(types.ModuleName,
 					totalSuperfluidDelegationInvariantName,
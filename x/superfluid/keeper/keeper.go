@@ -27,6 +27,7 @@ type Keeper struct {
 	ek types.EpochKeeper
 	lk types.LockupKeeper
 	gk types.GammKeeper
+	tk types.TwapKeeper
 	ik types.IncentivesKeeper
 
 	lms types.LockupMsgServer
@@ -35,7 +36,7 @@ type Keeper struct {
 var _ govtypes.StakingKeeper = (*Keeper)(nil)
 
 // NewKeeper returns an instance of Keeper.
-func NewKeeper(cdc codec.Codec, storeKey sdk.StoreKey, paramSpace paramtypes.Subspace, ak authkeeper.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper, dk types.CommunityPoolKeeper, ek types.EpochKeeper, lk types.LockupKeeper, gk types.GammKeeper, ik types.IncentivesKeeper, lms types.LockupMsgServer) *Keeper {
+func NewKeeper(cdc codec.Codec, storeKey sdk.StoreKey, paramSpace paramtypes.Subspace, ak authkeeper.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper, dk types.CommunityPoolKeeper, ek types.EpochKeeper, lk types.LockupKeeper, gk types.GammKeeper, tk types.TwapKeeper, ik types.IncentivesKeeper, lms types.LockupMsgServer) *Keeper {
 	// set KeyTable if it has not already been set
 	if !paramSpace.HasKeyTable() {
 		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
@@ -52,6 +53,7 @@ func NewKeeper(cdc codec.Codec, storeKey sdk.StoreKey, paramSpace paramtypes.Sub
 		ek:         ek,
 		lk:         lk,
 		gk:         gk,
+		tk:         tk,
 		ik:         ik,
 
 		lms: lms,
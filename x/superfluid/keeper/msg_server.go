@@ -120,6 +120,58 @@ func (server msgServer) LockAndSuperfluidDelegate(goCtx context.Context, msg *ty
 	}, err
 }
 
+// SuperfluidDelegateToValidatorSet superfluid delegates a lock to a weighted validator set.
+// The lock has the same pre-requisites as for SuperfluidDelegate. Its osmo equivalent is split across
+// the validators by weight, each share being delegated via an intermediary account dedicated to the lock.
+func (server msgServer) SuperfluidDelegateToValidatorSet(goCtx context.Context, msg *types.MsgSuperfluidDelegateToValidatorSet) (*types.MsgSuperfluidDelegateToValidatorSetResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := server.keeper.SuperfluidDelegateToValidatorSet(ctx, msg.Sender, msg.LockId, msg.Validators)
+	if err == nil {
+		events.EmitSuperfluidDelegateToValidatorSetEvent(ctx, msg.LockId, msg.Validators)
+	}
+	return &types.MsgSuperfluidDelegateToValidatorSetResponse{}, err
+}
+
+// SuperfluidUpdateValidatorSet changes the weighted validator set a lock is superfluid delegated to,
+// and rebalances the delegations of the lock to the new weights.
+func (server msgServer) SuperfluidUpdateValidatorSet(goCtx context.Context, msg *types.MsgSuperfluidUpdateValidatorSet) (*types.MsgSuperfluidUpdateValidatorSetResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := server.keeper.SuperfluidUpdateValidatorSet(ctx, msg.Sender, msg.LockId, msg.Validators)
+	if err == nil {
+		events.EmitSuperfluidUpdateValidatorSetEvent(ctx, msg.LockId, msg.Validators)
+	}
+	return &types.MsgSuperfluidUpdateValidatorSetResponse{}, err
+}
+
+// SuperfluidPartialUndelegate undelegates the given coin of a superfluid delegated lock.
+// Unless the coin is the whole lock, the coin is split off the lock into a new lock which gets undelegated,
+// while the rest of the lock stays superfluid delegated.
+// Locks superfluid delegated to a validator set can only be undelegated as a whole.
+func (server msgServer) SuperfluidPartialUndelegate(goCtx context.Context, msg *types.MsgSuperfluidPartialUndelegate) (*types.MsgSuperfluidPartialUndelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	lockId, err := server.keeper.SuperfluidPartialUndelegate(ctx, msg.Sender, msg.LockId, msg.Coin)
+	if err == nil {
+		events.EmitSuperfluidPartialUndelegateEvent(ctx, msg.LockId, msg.Coin, lockId)
+	}
+	return &types.MsgSuperfluidPartialUndelegateResponse{LockId: lockId}, err
+}
+
+// SuperfluidUndelegateAndUnbondLock undelegates the given coin of a lock the same way as SuperfluidPartialUndelegate,
+// and starts unbonding the lock holding the undelegated coin.
+// Locks that are already superfluid undelegating are only split, and the split lock starts unbonding.
+func (server msgServer) SuperfluidUndelegateAndUnbondLock(goCtx context.Context, msg *types.MsgSuperfluidUndelegateAndUnbondLock) (*types.MsgSuperfluidUndelegateAndUnbondLockResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	lockId, err := server.keeper.SuperfluidUndelegateAndUnbondLock(ctx, msg.Sender, msg.LockId, msg.Coin)
+	if err == nil {
+		events.EmitSuperfluidUndelegateAndUnbondLockEvent(ctx, msg.LockId, msg.Coin, lockId)
+	}
+	return &types.MsgSuperfluidUndelegateAndUnbondLockResponse{LockId: lockId}, err
+}
+
 func (server msgServer) UnPoolWhitelistedPool(goCtx context.Context, msg *types.MsgUnPoolWhitelistedPool) (*types.MsgUnPoolWhitelistedPoolResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -34,6 +34,17 @@ func (k Keeper) SlashLockupsForValidatorSlash(ctx sdk.Context, valAddr sdk.ValAd
 	// and then all of its unbonding delegations.
 	// We do these slashes as burns.
 	for _, acc := range accs {
+		// Validator-set intermediary accounts hold the delegation of a single lock, which
+		// only takes the slashing risk of its bonded and unbonding weights on the validator.
+		if acc.LockId != 0 {
+			weight := k.getValidatorSetSlashedWeight(ctx, acc)
+			if !weight.IsPositive() {
+				continue
+			}
+			k.slashSynthLock(ctx, &lockuptypes.SyntheticLock{UnderlyingLockId: acc.LockId}, slashFactor.Mul(weight))
+			continue
+		}
+
 		locks := k.lk.GetLocksLongerThanDurationDenom(ctx, acc.Denom, time.Second)
 		for _, lock := range locks {
 			// slashing only applies to synthetic lockup amount
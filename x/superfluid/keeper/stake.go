@@ -28,7 +28,11 @@ func (k Keeper) GetTotalSyntheticAssetsLocked(ctx sdk.Context, denom string) sdk
 // lead rounding errors from the true delegated amount.
 func (k Keeper) GetExpectedDelegationAmount(ctx sdk.Context, acc types.SuperfluidIntermediaryAccount) sdk.Int {
 	// (1) Find how many tokens total T are locked for (denom, validator) pair
-	totalSuperfluidDelegation := k.GetTotalSyntheticAssetsLocked(ctx, stakingSyntheticDenom(acc.Denom, acc.ValAddr))
+	totalSuperfluidDelegation := k.GetTotalSyntheticAssetsLocked(ctx, intermediaryAccountStakingSyntheticDenom(acc))
+	// Validator-set intermediary accounts only delegate the weight of their validator of their lock.
+	if acc.LockId != 0 {
+		totalSuperfluidDelegation = totalSuperfluidDelegation.ToDec().Mul(k.getValidatorSetWeight(ctx, acc)).TruncateInt()
+	}
 	// (2) Multiply the T tokens, by the number of superfluid osmo per token, to get the total amount
 	// of osmo we expect.
 	refreshedAmount := k.GetSuperfluidOSMOTokens(ctx, acc.Denom, totalSuperfluidDelegation)
@@ -42,55 +46,57 @@ func (k Keeper) RefreshIntermediaryDelegationAmounts(ctx sdk.Context) {
 	// iterate over all intermedairy accounts - every (denom, validator) pair
 	accs := k.GetAllIntermediaryAccounts(ctx)
 	for _, acc := range accs {
-		mAddr := acc.GetAccAddress()
-
-		valAddress, err := sdk.ValAddressFromBech32(acc.ValAddr)
+		err := k.refreshIntermediaryDelegationAmount(ctx, acc)
 		if err != nil {
-			panic(err)
-		}
-
-		validator, found := k.sk.GetValidator(ctx, valAddress)
-		if !found {
-			k.Logger(ctx).Error(fmt.Sprintf("validator not found or %s", acc.ValAddr))
-			continue
+			ctx.Logger().Error("Error in refreshIntermediaryDelegationAmount, state update reverted", "Intermediary", acc.GetAccAddress().String(), "Error", err)
 		}
+	}
+}
 
-		currentAmount := sdk.NewInt(0)
-		delegation, found := k.sk.GetDelegation(ctx, mAddr, valAddress)
-		if !found {
-			// continue if current delegation is 0, in case its really a dust delegation
-			// that becomes worth something after refresh.
-			k.Logger(ctx).Info(fmt.Sprintf("Existing delegation not found for %s with %s during superfluid refresh."+
-				" It may have been previously bonded, but now unbonded.", mAddr.String(), acc.ValAddr))
-		} else {
-			currentAmount = validator.TokensFromShares(delegation.Shares).RoundInt()
-		}
+// refreshIntermediaryDelegationAmount sets the delegation of the intermediary account to its expected delegation amount,
+// by minting and delegating the missing osmo, or instantly undelegating and burning the excess.
+func (k Keeper) refreshIntermediaryDelegationAmount(ctx sdk.Context, acc types.SuperfluidIntermediaryAccount) error {
+	mAddr := acc.GetAccAddress()
 
-		refreshedAmount := k.GetExpectedDelegationAmount(ctx, acc)
+	valAddress, err := sdk.ValAddressFromBech32(acc.ValAddr)
+	if err != nil {
+		panic(err)
+	}
 
-		if refreshedAmount.GT(currentAmount) {
-			adjustment := refreshedAmount.Sub(currentAmount)
-			err = k.mintOsmoTokensAndDelegate(ctx, adjustment, acc)
-			if err != nil {
-				ctx.Logger().Error("Error in forceUndelegateAndBurnOsmoTokens, state update reverted", err)
-			}
-		} else if currentAmount.GT(refreshedAmount) {
-			// In this case, we want to change the IA's delegated balance to be refreshed Amount
-			// which is less than what it already has.
-			// This means we need to "InstantUndelegate" some of its delegation (not going through the unbonding queue)
-			// and then burn that excessly delegated bits.
-			adjustment := currentAmount.Sub(refreshedAmount)
-
-			err := k.forceUndelegateAndBurnOsmoTokens(ctx, adjustment, acc)
-			if err != nil {
-				ctx.Logger().Error("Error in forceUndelegateAndBurnOsmoTokens, state update reverted", err)
-			}
-		} else {
-			ctx.Logger().Info("Intermediary account already has correct delegation amount?" +
-				" This with high probability implies the exact same spot price as the last epoch," +
-				"and no delegation changes.")
-		}
+	validator, found := k.sk.GetValidator(ctx, valAddress)
+	if !found {
+		return sdkerrors.Wrapf(stakingtypes.ErrNoValidatorFound, "validator not found or %s", acc.ValAddr)
 	}
+
+	currentAmount := sdk.NewInt(0)
+	delegation, found := k.sk.GetDelegation(ctx, mAddr, valAddress)
+	if !found {
+		// continue if current delegation is 0, in case its really a dust delegation
+		// that becomes worth something after refresh.
+		k.Logger(ctx).Info(fmt.Sprintf("Existing delegation not found for %s with %s during superfluid refresh."+
+			" It may have been previously bonded, but now unbonded.", mAddr.String(), acc.ValAddr))
+	} else {
+		currentAmount = validator.TokensFromShares(delegation.Shares).RoundInt()
+	}
+
+	refreshedAmount := k.GetExpectedDelegationAmount(ctx, acc)
+
+	if refreshedAmount.GT(currentAmount) {
+		adjustment := refreshedAmount.Sub(currentAmount)
+		return k.mintOsmoTokensAndDelegate(ctx, adjustment, acc)
+	} else if currentAmount.GT(refreshedAmount) {
+		// In this case, we want to change the IA's delegated balance to be refreshed Amount
+		// which is less than what it already has.
+		// This means we need to "InstantUndelegate" some of its delegation (not going through the unbonding queue)
+		// and then burn that excessly delegated bits.
+		adjustment := currentAmount.Sub(refreshedAmount)
+		return k.forceUndelegateAndBurnOsmoTokens(ctx, adjustment, acc)
+	}
+
+	ctx.Logger().Info("Intermediary account already has correct delegation amount?" +
+		" This with high probability implies the exact same spot price as the last epoch," +
+		"and no delegation changes.")
+	return nil
 }
 
 // IncreaseSuperfluidDelegation increases the amount of existing superfluid delegation.
@@ -98,6 +104,10 @@ func (k Keeper) RefreshIntermediaryDelegationAmounts(ctx sdk.Context) {
 func (k Keeper) IncreaseSuperfluidDelegation(ctx sdk.Context, lockID uint64, amount sdk.Coins) error {
 	acc, found := k.GetIntermediaryAccountFromLockId(ctx, lockID)
 	if !found {
+		// the delegations of a lock superfluid delegated to a validator set are refreshed to their weights of the new lock amount.
+		if k.isValidatorSetDelegated(ctx, lockID) {
+			return k.refreshValidatorSetDelegations(ctx, lockID)
+		}
 		return nil
 	}
 
@@ -209,6 +219,8 @@ func (k Keeper) SuperfluidDelegate(ctx sdk.Context, sender string, lockID uint64
 	}
 	// create connection record between lock id and intermediary account
 	k.SetLockIdIntermediaryAccountConnection(ctx, lockID, acc)
+	// drop the validator set of a past validator-set delegation of the lock, if any
+	k.DeleteLockValidatorSet(ctx, lockID)
 
 	// Register a synthetic lockup for superfluid staking
 	err = k.createSyntheticLockup(ctx, lockID, acc, bondedStatus)
@@ -244,6 +256,11 @@ func (k Keeper) SuperfluidUndelegate(ctx sdk.Context, sender string, lockID uint
 	// get the intermediate acct asscd. with lock id, and delete the connection.
 	intermediaryAcc, found := k.GetIntermediaryAccountFromLockId(ctx, lockID)
 	if !found {
+		// locks superfluid delegated to a validator set have no connection, but an intermediary account per validator.
+		if k.isValidatorSetDelegated(ctx, lockID) {
+			set, _ := k.GetLockValidatorSet(ctx, lockID)
+			return k.superfluidUndelegateFromValidatorSet(ctx, lock, set)
+		}
 		return types.ErrNotSuperfluidUsedLockup
 	}
 	k.DeleteLockIdIntermediaryAccountConnection(ctx, lockID)
@@ -278,15 +295,126 @@ func (k Keeper) SuperfluidUnbondLock(ctx sdk.Context, underlyingLockId uint64, s
 		return err
 	}
 	synthLocks := k.lk.GetAllSyntheticLockupsByLockup(ctx, underlyingLockId)
-	if len(synthLocks) != 1 {
+	if len(synthLocks) == 0 {
 		return types.ErrNotSuperfluidUsedLockup
 	}
-	if !synthLocks[0].IsUnlocking() {
-		return types.ErrBondingLockupNotSupported
+	// only locks superfluid delegated to a validator set have a synthetic lock per validator.
+	if _, found := k.GetLockValidatorSet(ctx, underlyingLockId); len(synthLocks) != 1 && !found {
+		return types.ErrNotSuperfluidUsedLockup
+	}
+	for _, synthLock := range synthLocks {
+		if !synthLock.IsUnlocking() {
+			return types.ErrBondingLockupNotSupported
+		}
 	}
 	return k.lk.BeginForceUnlock(ctx, underlyingLockId, sdk.Coins{})
 }
 
+// SuperfluidPartialUndelegate superfluid undelegates the given coin of a superfluid delegated lock.
+// The coin is split off the lock into a new lock, which gets a copy of the synthetic lockup of the lock,
+// and the new lock is then undelegated, while the rest of the lock stays delegated.
+// The ID of the lock holding the undelegated coin is returned, which is the lock itself
+// when the coin is the whole lock.
+func (k Keeper) SuperfluidPartialUndelegate(ctx sdk.Context, sender string, lockID uint64, coin sdk.Coin) (uint64, error) {
+	lock, err := k.lk.GetLockByID(ctx, lockID)
+	if err != nil {
+		return 0, err
+	}
+	whole, err := k.validateLockForSFPartialUndelegate(ctx, lock, sender, coin)
+	if err != nil {
+		return 0, err
+	}
+	if whole {
+		return lockID, k.SuperfluidUndelegate(ctx, sender, lockID)
+	}
+
+	intermediaryAcc, found := k.GetIntermediaryAccountFromLockId(ctx, lockID)
+	if !found {
+		return 0, types.ErrNotSuperfluidUsedLockup
+	}
+
+	// the delegation of the lock is reduced to the delegation of the rest of the lock,
+	// so that the delegation of the intermediary account stays the sum of the delegations of its locks.
+	lockedCoin := lock.Coins[0]
+	amount := k.GetSuperfluidOSMOTokens(ctx, intermediaryAcc.Denom, lockedCoin.Amount).Sub(
+		k.GetSuperfluidOSMOTokens(ctx, intermediaryAcc.Denom, lockedCoin.Amount.Sub(coin.Amount)))
+
+	splitLock, err := k.lk.SplitLock(ctx, lockID, sdk.NewCoins(coin))
+	if err != nil {
+		return 0, err
+	}
+
+	// Delete the synthetic lockup of the split lock, and create a new synthetic lockup representing the unstaking
+	synthdenom := stakingSyntheticDenom(lockedCoin.Denom, intermediaryAcc.ValAddr)
+	err = k.lk.DeleteSyntheticLockup(ctx, splitLock.ID, synthdenom)
+	if err != nil {
+		return 0, err
+	}
+
+	if amount.IsPositive() {
+		err = k.forceUndelegateAndBurnOsmoTokens(ctx, amount, intermediaryAcc)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return splitLock.ID, k.createSyntheticLockup(ctx, splitLock.ID, intermediaryAcc, unlockingStatus)
+}
+
+// SuperfluidUndelegateAndUnbondLock superfluid undelegates the given coin of a lock, and starts unbonding
+// the lock holding the undelegated coin. A lock that is already superfluid undelegating is split without
+// being undelegated again. The ID of the unbonding lock is returned.
+func (k Keeper) SuperfluidUndelegateAndUnbondLock(ctx sdk.Context, sender string, lockID uint64, coin sdk.Coin) (uint64, error) {
+	lock, err := k.lk.GetLockByID(ctx, lockID)
+	if err != nil {
+		return 0, err
+	}
+	whole, err := k.validateLockForSFPartialUndelegate(ctx, lock, sender, coin)
+	if err != nil {
+		return 0, err
+	}
+
+	unbondingLockID := lockID
+	if _, found := k.GetIntermediaryAccountFromLockId(ctx, lockID); found || k.isValidatorSetDelegated(ctx, lockID) {
+		unbondingLockID, err = k.SuperfluidPartialUndelegate(ctx, sender, lockID, coin)
+		if err != nil {
+			return 0, err
+		}
+	} else if !whole {
+		if len(k.lk.GetAllSyntheticLockupsByLockup(ctx, lockID)) == 0 {
+			return 0, types.ErrNotSuperfluidUsedLockup
+		}
+		splitLock, err := k.lk.SplitLock(ctx, lockID, sdk.NewCoins(coin))
+		if err != nil {
+			return 0, err
+		}
+		unbondingLockID = splitLock.ID
+	}
+
+	return unbondingLockID, k.SuperfluidUnbondLock(ctx, unbondingLockID, sender)
+}
+
+// validateLockForSFPartialUndelegate runs the basic superfluid lock validation, and checks that the coin
+// is a positive part of the locked coin. It returns true if the coin is the whole locked coin.
+// Locks superfluid delegated to a validator set can only be undelegated as a whole.
+func (k Keeper) validateLockForSFPartialUndelegate(ctx sdk.Context, lock *lockuptypes.PeriodLock, sender string, coin sdk.Coin) (bool, error) {
+	err := k.validateLockForSF(ctx, lock, sender)
+	if err != nil {
+		return false, err
+	}
+	lockedCoin := lock.Coins[0]
+	if coin.Denom != lockedCoin.Denom || !coin.Amount.IsPositive() || coin.Amount.GT(lockedCoin.Amount) {
+		return false, sdkerrors.Wrapf(types.ErrInvalidUndelegationAmount, "%s of locked %s", coin, lockedCoin)
+	}
+	if coin.Amount.Equal(lockedCoin.Amount) {
+		return true, nil
+	}
+	if _, found := k.GetLockValidatorSet(ctx, lock.ID); found {
+		return false, types.ErrValidatorSetPartialUndelegation
+	}
+	return false, nil
+}
+
 // alreadySuperfluidStaking returns true if underlying lock used in superfluid staking.
 // This method would also return true for undelegating position for the lock.
 func (k Keeper) alreadySuperfluidStaking(ctx sdk.Context, lockID uint64) bool {
@@ -412,7 +540,11 @@ func (k Keeper) IterateDelegations(ctx sdk.Context, delegator sdk.AccAddress, fn
 		// get locked coin from the lock ID
 		interim, ok := k.GetIntermediaryAccountFromLockId(ctx, lock.UnderlyingLockId)
 		if !ok {
-			continue
+			// locks superfluid delegated to a validator set have a bonded synthetic lock per validator
+			interim, ok = k.getValidatorSetIntermediaryAccount(ctx, lock)
+			if !ok {
+				continue
+			}
 		}
 
 		lock, err := k.lk.GetLockByID(ctx, lock.UnderlyingLockId)
@@ -429,6 +561,10 @@ func (k Keeper) IterateDelegations(ctx sdk.Context, delegator sdk.AccAddress, fn
 
 		// get osmo-equivalent token amount
 		amount := k.GetSuperfluidOSMOTokens(ctx, interim.Denom, coin.Amount)
+		if interim.LockId != 0 {
+			// validator-set intermediary accounts hold the delegation of this lock only
+			amount = k.GetExpectedDelegationAmount(ctx, interim)
+		}
 
 		// get validator shares equivalent to the token amount
 		valAddr, err := sdk.ValAddressFromBech32(interim.ValAddr)
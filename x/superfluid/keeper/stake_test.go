@@ -408,6 +408,247 @@ func (suite *KeeperTestSuite) TestSuperfluidUnbondLock() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestSuperfluidPartialUndelegate() {
+	testCases := []struct {
+		name          string
+		coin          func(denom string) sdk.Coin
+		sender        func(lock lockuptypes.PeriodLock) string
+		undelegated   bool
+		expSplit      bool
+		expRemaining  int64
+		expectedError error
+	}{
+		{
+			name:         "partial undelegation",
+			coin:         func(denom string) sdk.Coin { return sdk.NewInt64Coin(denom, 400000) },
+			expSplit:     true,
+			expRemaining: 600000,
+		},
+		{
+			name:         "dust partial undelegation",
+			coin:         func(denom string) sdk.Coin { return sdk.NewInt64Coin(denom, 1) },
+			expSplit:     true,
+			expRemaining: 999999,
+		},
+		{
+			name: "undelegation of the whole lock",
+			coin: func(denom string) sdk.Coin { return sdk.NewInt64Coin(denom, 1000000) },
+		},
+		{
+			name:          "undelegation of more than the lock",
+			coin:          func(denom string) sdk.Coin { return sdk.NewInt64Coin(denom, 1000001) },
+			expectedError: types.ErrInvalidUndelegationAmount,
+		},
+		{
+			name:          "undelegation of zero",
+			coin:          func(denom string) sdk.Coin { return sdk.NewInt64Coin(denom, 0) },
+			expectedError: types.ErrInvalidUndelegationAmount,
+		},
+		{
+			name:          "undelegation of another denom",
+			coin:          func(denom string) sdk.Coin { return sdk.NewInt64Coin("gamm/pool/2", 400000) },
+			expectedError: types.ErrInvalidUndelegationAmount,
+		},
+		{
+			name:          "undelegation by another address than the owner",
+			coin:          func(denom string) sdk.Coin { return sdk.NewInt64Coin(denom, 400000) },
+			sender:        func(lock lockuptypes.PeriodLock) string { return CreateRandomAccounts(1)[0].String() },
+			expectedError: lockuptypes.ErrNotLockOwner,
+		},
+		{
+			name:          "partial undelegation of an undelegating lock",
+			coin:          func(denom string) sdk.Coin { return sdk.NewInt64Coin(denom, 400000) },
+			undelegated:   true,
+			expectedError: types.ErrNotSuperfluidUsedLockup,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			valAddrs := suite.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded})
+			denoms, _ := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20)})
+
+			// two locks delegated via the same intermediary account
+			_, intermediaryAccs, locks := suite.setupSuperfluidDelegations(valAddrs, []superfluidDelegation{{0, 0, 0, 1000000}, {1, 0, 0, 1000000}}, denoms)
+			suite.Require().Len(intermediaryAccs, 1)
+			intermediaryAcc := intermediaryAccs[0]
+			lock := locks[0]
+
+			if tc.undelegated {
+				err := suite.App.SuperfluidKeeper.SuperfluidUndelegate(suite.Ctx, lock.Owner, lock.ID)
+				suite.Require().NoError(err)
+			}
+
+			sender := lock.Owner
+			if tc.sender != nil {
+				sender = tc.sender(lock)
+			}
+
+			lockID, err := suite.App.SuperfluidKeeper.SuperfluidPartialUndelegate(suite.Ctx, sender, lock.ID, tc.coin(denoms[0]))
+			if tc.expectedError != nil {
+				suite.Require().ErrorIs(err, tc.expectedError)
+				return
+			}
+			suite.Require().NoError(err)
+
+			stakingDenom := keeper.StakingSyntheticDenom(denoms[0], valAddrs[0].String())
+			unstakingDenom := keeper.UnstakingSyntheticDenom(denoms[0], valAddrs[0].String())
+			expDelegation := suite.App.SuperfluidKeeper.GetSuperfluidOSMOTokens(suite.Ctx, denoms[0], sdk.NewInt(1000000))
+			if !tc.expSplit {
+				// the whole lock is undelegated
+				suite.Require().Equal(lock.ID, lockID)
+				_, err = suite.App.LockupKeeper.GetSyntheticLockup(suite.Ctx, lock.ID, unstakingDenom)
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().NotEqual(lock.ID, lockID)
+
+				// the rest of the lock stays superfluid delegated
+				remainingLock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, lock.ID)
+				suite.Require().NoError(err)
+				suite.Require().Equal(sdk.NewInt(tc.expRemaining), remainingLock.Coins.AmountOf(denoms[0]))
+				_, err = suite.App.LockupKeeper.GetSyntheticLockup(suite.Ctx, lock.ID, stakingDenom)
+				suite.Require().NoError(err)
+				suite.Require().Equal(intermediaryAcc.GetAccAddress(), suite.App.SuperfluidKeeper.GetLockIdIntermediaryAccountConnection(suite.Ctx, lock.ID))
+				expDelegation = expDelegation.Add(suite.App.SuperfluidKeeper.GetSuperfluidOSMOTokens(suite.Ctx, denoms[0], sdk.NewInt(tc.expRemaining)))
+
+				// the split lock is superfluid undelegating
+				splitLock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, lockID)
+				suite.Require().NoError(err)
+				suite.Require().Equal(lock.Owner, splitLock.Owner)
+				suite.Require().Equal(lock.Duration, splitLock.Duration)
+				suite.Require().Equal(sdk.NewCoins(tc.coin(denoms[0])), splitLock.Coins)
+				suite.Require().False(splitLock.IsUnlocking())
+				synthLocks := suite.App.LockupKeeper.GetAllSyntheticLockupsByLockup(suite.Ctx, lockID)
+				suite.Require().Len(synthLocks, 1)
+				suite.Require().Equal(unstakingDenom, synthLocks[0].SynthDenom)
+				suite.Require().True(suite.App.SuperfluidKeeper.GetLockIdIntermediaryAccountConnection(suite.Ctx, lockID).Empty())
+			}
+
+			// the intermediary account delegates for the superfluid delegated locks only
+			delegation, found := suite.App.StakingKeeper.GetDelegation(suite.Ctx, intermediaryAcc.GetAccAddress(), valAddrs[0])
+			suite.Require().True(found)
+			validator, found := suite.App.StakingKeeper.GetValidator(suite.Ctx, valAddrs[0])
+			suite.Require().True(found)
+			suite.Require().Equal(expDelegation, validator.TokensFromShares(delegation.Shares).TruncateInt())
+
+			reason, broken := keeper.AllInvariants(*suite.App.SuperfluidKeeper)(suite.Ctx)
+			suite.Require().False(broken, reason)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSuperfluidPartialUndelegateValidatorSet() {
+	suite.SetupTest()
+
+	valAddrs := suite.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded})
+	denoms, _ := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20)})
+	lock, _ := suite.setupValidatorSetDelegation(valAddrs, []string{"0.4", "0.6"}, denoms[0])
+
+	// a lock delegated to a validator set can not be partially undelegated
+	_, err := suite.App.SuperfluidKeeper.SuperfluidPartialUndelegate(suite.Ctx, lock.Owner, lock.ID, sdk.NewInt64Coin(denoms[0], 400000))
+	suite.Require().ErrorIs(err, types.ErrValidatorSetPartialUndelegation)
+	_, err = suite.App.SuperfluidKeeper.SuperfluidUndelegateAndUnbondLock(suite.Ctx, lock.Owner, lock.ID, sdk.NewInt64Coin(denoms[0], 400000))
+	suite.Require().ErrorIs(err, types.ErrValidatorSetPartialUndelegation)
+
+	// but it can be undelegated and unbonded as a whole
+	lockID, err := suite.App.SuperfluidKeeper.SuperfluidUndelegateAndUnbondLock(suite.Ctx, lock.Owner, lock.ID, sdk.NewInt64Coin(denoms[0], 1000000))
+	suite.Require().NoError(err)
+	suite.Require().Equal(lock.ID, lockID)
+	updatedLock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, lock.ID)
+	suite.Require().NoError(err)
+	suite.Require().True(updatedLock.IsUnlocking())
+	suite.checkValidatorSetDelegations(lock, valAddrs, []sdk.Dec{sdk.ZeroDec(), sdk.ZeroDec()})
+}
+
+func (suite *KeeperTestSuite) TestSuperfluidUndelegateAndUnbondLock() {
+	testCases := []struct {
+		name          string
+		amount        int64
+		undelegated   bool
+		expSplit      bool
+		expDelegation int64
+	}{
+		{
+			name:          "partial undelegation and unbonding of a delegated lock",
+			amount:        400000,
+			expSplit:      true,
+			expDelegation: 600000,
+		},
+		{
+			name:   "undelegation and unbonding of a whole delegated lock",
+			amount: 1000000,
+		},
+		{
+			name:        "partial unbonding of an undelegating lock",
+			amount:      400000,
+			undelegated: true,
+			expSplit:    true,
+		},
+		{
+			name:        "unbonding of a whole undelegating lock",
+			amount:      1000000,
+			undelegated: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			valAddrs := suite.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded})
+			denoms, _ := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20)})
+			_, intermediaryAccs, locks := suite.setupSuperfluidDelegations(valAddrs, []superfluidDelegation{{0, 0, 0, 1000000}}, denoms)
+			lock := locks[0]
+
+			if tc.undelegated {
+				err := suite.App.SuperfluidKeeper.SuperfluidUndelegate(suite.Ctx, lock.Owner, lock.ID)
+				suite.Require().NoError(err)
+			}
+
+			lockID, err := suite.App.SuperfluidKeeper.SuperfluidUndelegateAndUnbondLock(suite.Ctx, lock.Owner, lock.ID, sdk.NewInt64Coin(denoms[0], tc.amount))
+			suite.Require().NoError(err)
+
+			// the lock holding the undelegated amount is unbonding
+			unbondingLock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, lockID)
+			suite.Require().NoError(err)
+			suite.Require().True(unbondingLock.IsUnlocking())
+			suite.Require().Equal(sdk.NewInt(tc.amount), unbondingLock.Coins.AmountOf(denoms[0]))
+			unstakingDenom := keeper.UnstakingSyntheticDenom(denoms[0], valAddrs[0].String())
+			_, err = suite.App.LockupKeeper.GetSyntheticLockup(suite.Ctx, lockID, unstakingDenom)
+			suite.Require().NoError(err)
+
+			if tc.expSplit {
+				suite.Require().NotEqual(lock.ID, lockID)
+				remainingLock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, lock.ID)
+				suite.Require().NoError(err)
+				suite.Require().False(remainingLock.IsUnlocking())
+				suite.Require().Equal(sdk.NewInt(1000000-tc.amount), remainingLock.Coins.AmountOf(denoms[0]))
+			} else {
+				suite.Require().Equal(lock.ID, lockID)
+			}
+
+			// check the delegation of the rest of the lock
+			expDelegation := suite.App.SuperfluidKeeper.GetSuperfluidOSMOTokens(suite.Ctx, denoms[0], sdk.NewInt(tc.expDelegation))
+			delegation, found := suite.App.StakingKeeper.GetDelegation(suite.Ctx, intermediaryAccs[0].GetAccAddress(), valAddrs[0])
+			if expDelegation.IsZero() {
+				suite.Require().False(found)
+			} else {
+				suite.Require().True(found)
+				validator, found := suite.App.StakingKeeper.GetValidator(suite.Ctx, valAddrs[0])
+				suite.Require().True(found)
+				suite.Require().Equal(expDelegation, validator.TokensFromShares(delegation.Shares).TruncateInt())
+			}
+
+			reason, broken := keeper.AllInvariants(*suite.App.SuperfluidKeeper)(suite.Ctx)
+			suite.Require().False(broken, reason)
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestRefreshIntermediaryDelegationAmounts() {
 	testCases := []struct {
 		name             string
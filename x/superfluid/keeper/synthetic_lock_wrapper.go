@@ -17,17 +17,35 @@ func unstakingSyntheticDenom(denom, valAddr string) string {
 	return fmt.Sprintf("%s/superunbonding/%s", denom, valAddr)
 }
 
+// intermediaryAccountStakingSyntheticDenom returns the synthetic denom of the locks bonded through the intermediary account.
+// Validator-set intermediary accounts suffix it with their lock ID, so that their gauge only rewards their own lock.
+func intermediaryAccountStakingSyntheticDenom(acc types.SuperfluidIntermediaryAccount) string {
+	if acc.LockId != 0 {
+		return fmt.Sprintf("%s/%d", stakingSyntheticDenom(acc.Denom, acc.ValAddr), acc.LockId)
+	}
+	return stakingSyntheticDenom(acc.Denom, acc.ValAddr)
+}
+
+// intermediaryAccountUnstakingSyntheticDenom returns the synthetic denom of the locks unbonding from the intermediary account.
+func intermediaryAccountUnstakingSyntheticDenom(acc types.SuperfluidIntermediaryAccount) string {
+	if acc.LockId != 0 {
+		return fmt.Sprintf("%s/%d", unstakingSyntheticDenom(acc.Denom, acc.ValAddr), acc.LockId)
+	}
+	return unstakingSyntheticDenom(acc.Denom, acc.ValAddr)
+}
+
 // quick fix for getting the validator addresss from a synthetic denom.
+// The lock ID suffix of validator-set synthetic denoms is dropped.
 func ValidatorAddressFromSyntheticDenom(syntheticDenom string) (string, error) {
 	if strings.Contains(syntheticDenom, "superbonding") {
 		splitString := strings.Split(syntheticDenom, "/superbonding/")
 		lastComponent := splitString[len(splitString)-1]
-		return lastComponent, nil
+		return strings.Split(lastComponent, "/")[0], nil
 	}
 	if strings.Contains(syntheticDenom, "superunbonding") {
 		splitString := strings.Split(syntheticDenom, "/superunbonding/")
 		lastComponent := splitString[len(splitString)-1]
-		return lastComponent, nil
+		return strings.Split(lastComponent, "/")[0], nil
 	}
 	return "", fmt.Errorf("%s is not a valid synthetic denom suffix", syntheticDenom)
 }
@@ -45,11 +63,11 @@ func (k Keeper) createSyntheticLockup(ctx sdk.Context,
 	unbondingDuration := k.sk.GetParams(ctx).UnbondingTime
 	if lockingStat == unlockingStatus {
 		isUnlocking := true
-		synthdenom := unstakingSyntheticDenom(intermediateAcc.Denom, intermediateAcc.ValAddr)
+		synthdenom := intermediaryAccountUnstakingSyntheticDenom(intermediateAcc)
 		return k.lk.CreateSyntheticLockup(ctx, underlyingLockId, synthdenom, unbondingDuration, isUnlocking)
 	} else {
 		notUnlocking := false
-		synthdenom := stakingSyntheticDenom(intermediateAcc.Denom, intermediateAcc.ValAddr)
+		synthdenom := intermediaryAccountStakingSyntheticDenom(intermediateAcc)
 		return k.lk.CreateSyntheticLockup(ctx, underlyingLockId, synthdenom, unbondingDuration, notUnlocking)
 	}
 }
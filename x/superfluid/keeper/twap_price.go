@@ -1,7 +1,12 @@
 package keeper
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/gogo/protobuf/proto"
+
+	"github.com/osmosis-labs/osmosis/v12/osmomath"
 	gammtypes "github.com/osmosis-labs/osmosis/v12/x/gamm/types"
 	"github.com/osmosis-labs/osmosis/v12/x/superfluid/types"
 
@@ -9,27 +14,161 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// This function calculates the osmo equivalent worth of an LP share.
-// It is intended to eventually use the TWAP of the worth of an LP share
-// once that is exposed from the gamm module.
+// This function calculates the osmo equivalent worth of an LP share at the spot price.
 func (k Keeper) calculateOsmoBackingPerShare(pool gammtypes.PoolI, osmoInPool sdk.Int) sdk.Dec {
-	twap := osmoInPool.ToDec().Quo(pool.GetTotalShares().ToDec())
-	return twap
+	return osmoInPool.ToDec().Quo(pool.GetTotalShares().ToDec())
+}
+
+// weightedPool is implemented by pools whose assets have weights, e.g. balancer pools.
+type weightedPool interface {
+	GetTokenWeight(denom string) (sdk.Int, error)
+}
+
+// calculateTwapOsmoBackingPerShare calculates the osmo equivalent worth of an LP share,
+// given the prices of the other pool assets in OSMO.
+//
+// Every pool asset balance implies the OSMO balance the pool would hold at these prices,
+// balance * price * osmoWeight / assetWeight. The OSMO backing of the pool is the geometric
+// mean of the implied OSMO balances, weighted by the asset weights. Unlike the OSMO balance
+// itself, it is left unchanged by swaps against the pool, up to swap fees, so moving the
+// pool price does not move it. At spot prices, every implied balance is the OSMO balance.
+// Assets of pools without weights are equally weighted.
+func (k Keeper) calculateTwapOsmoBackingPerShare(ctx sdk.Context, pool gammtypes.PoolI, bondDenom string, prices sdk.DecCoins) (sdk.Dec, error) {
+	getWeight := func(string) (sdk.Int, error) { return sdk.OneInt(), nil }
+	if weighted, ok := pool.(weightedPool); ok {
+		getWeight = weighted.GetTokenWeight
+	}
+	osmoWeight, err := getWeight(bondDenom)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	logBacking := osmomath.ZeroDec()
+	totalWeight := sdk.ZeroInt()
+	for _, asset := range pool.GetTotalPoolLiquidity(ctx) {
+		weight, err := getWeight(asset.Denom)
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		impliedOsmo := asset.Amount.ToDec()
+		if asset.Denom != bondDenom {
+			price := prices.AmountOf(asset.Denom)
+			if !price.IsPositive() {
+				return sdk.Dec{}, fmt.Errorf("no price in %s for %s", bondDenom, asset.Denom)
+			}
+			impliedOsmo = impliedOsmo.Mul(price).MulInt(osmoWeight).QuoInt(weight)
+		}
+		if !impliedOsmo.IsPositive() {
+			return sdk.Dec{}, fmt.Errorf("pool %d has no %s backing", pool.GetId(), asset.Denom)
+		}
+		logBacking = logBacking.Add(osmomath.BigDecFromSDKDec(impliedOsmo).LogBase2().Mul(osmomath.BigDecFromSDKDec(weight.ToDec())))
+		totalWeight = totalWeight.Add(weight)
+	}
+
+	osmoBacking := osmomath.Exp2(logBacking.Quo(osmomath.BigDecFromSDKDec(totalWeight.ToDec()))).SDKDec()
+	return osmoBacking.Quo(pool.GetTotalShares().ToDec()), nil
+}
+
+// getPoolAssetPrices returns the prices of the pool assets other than OSMO in OSMO, over the given time range
+// for twap price sources, or at the current block for the spot price source.
+func (k Keeper) getPoolAssetPrices(ctx sdk.Context, pool gammtypes.PoolI, bondDenom string, source types.MultiplierPriceSource, startTime, endTime time.Time) (sdk.DecCoins, error) {
+	prices := sdk.DecCoins{}
+	for _, asset := range pool.GetTotalPoolLiquidity(ctx) {
+		if asset.Denom == bondDenom {
+			continue
+		}
+		var price sdk.Dec
+		var err error
+		switch source {
+		case types.MultiplierPriceSourceArithmeticTwap:
+			price, err = k.tk.GetArithmeticTwap(ctx, pool.GetId(), asset.Denom, bondDenom, startTime, endTime)
+		case types.MultiplierPriceSourceGeometricTwap:
+			price, err = k.tk.GetGeometricTwap(ctx, pool.GetId(), asset.Denom, bondDenom, startTime, endTime)
+		default:
+			// the gamm spot price takes its assets in the reverse order of the twap
+			price, err = k.gk.CalculateSpotPrice(ctx, pool.GetId(), bondDenom, asset.Denom)
+		}
+		if err != nil {
+			return nil, err
+		}
+		prices = prices.Add(sdk.NewDecCoinFromDec(asset.Denom, price))
+	}
+	return prices, nil
+}
+
+// computeOsmoEquivalentMultiplier computes the OSMO equivalent multiplier of the LP shares of the pool
+// for the epoch starting at the current block, from the twap of the pool asset prices over the epoch that
+// just ended, along with the inputs it was computed from.
+//
+// If the twap can't be computed, e.g. for pools created during the epoch, it falls back to the
+// multiplier fallback param. The change from the previous multiplier is then capped by the max
+// multiplier change param.
+func (k Keeper) computeOsmoEquivalentMultiplier(ctx sdk.Context, denom string, pool gammtypes.PoolI, osmoInPool sdk.Int) (sdk.Dec, types.OsmoEquivalentMultiplierInputs, error) {
+	params := k.GetParams(ctx)
+	bondDenom := k.sk.BondDenom(ctx)
+	epochInfo := k.ek.GetEpochInfo(ctx, k.GetEpochIdentifier(ctx))
+	inputs := types.OsmoEquivalentMultiplierInputs{
+		PriceSource:   types.MultiplierPriceSourceArithmeticTwap,
+		TwapStartTime: ctx.BlockTime().Add(-epochInfo.Duration),
+		TwapEndTime:   ctx.BlockTime(),
+	}
+	if params.MultiplierTwapType == types.MultiplierTwapTypeGeometric {
+		inputs.PriceSource = types.MultiplierPriceSourceGeometricTwap
+	}
+	prevRecord, hasPrevRecord := k.getOsmoEquivalentMultiplierRecord(ctx, denom)
+	hasPrevRecord = hasPrevRecord && prevRecord.Multiplier.IsPositive()
+
+	prices, err := k.getPoolAssetPrices(ctx, pool, bondDenom, inputs.PriceSource, inputs.TwapStartTime, inputs.TwapEndTime)
+	multiplier := sdk.Dec{}
+	if err == nil {
+		multiplier, err = k.calculateTwapOsmoBackingPerShare(ctx, pool, bondDenom, prices)
+	}
+	if err != nil {
+		inputs.TwapError = err.Error()
+		if params.MultiplierFallback == types.MultiplierFallbackPrevious && hasPrevRecord {
+			inputs.PriceSource = types.MultiplierPriceSourcePrevious
+			if prevRecord.Inputs != nil {
+				inputs.Prices = prevRecord.Inputs.Prices
+			}
+			inputs.UncappedMultiplier = prevRecord.Multiplier
+			return prevRecord.Multiplier, inputs, nil
+		}
+
+		inputs.PriceSource = types.MultiplierPriceSourceSpot
+		// the spot multiplier does not depend on the spot prices, which are only recorded as inputs
+		prices, err = k.getPoolAssetPrices(ctx, pool, bondDenom, inputs.PriceSource, inputs.TwapStartTime, inputs.TwapEndTime)
+		if err != nil {
+			prices = sdk.DecCoins{}
+		}
+		multiplier = k.calculateOsmoBackingPerShare(pool, osmoInPool)
+	}
+	inputs.Prices = prices
+	inputs.UncappedMultiplier = multiplier
+
+	if hasPrevRecord && params.MaxMultiplierChange.IsPositive() {
+		maxChange := prevRecord.Multiplier.Mul(params.MaxMultiplierChange)
+		multiplier = sdk.MinDec(multiplier, prevRecord.Multiplier.Add(maxChange))
+		multiplier = sdk.MaxDec(multiplier, prevRecord.Multiplier.Sub(maxChange))
+	}
+	return multiplier, inputs, nil
 }
 
 func (k Keeper) SetOsmoEquivalentMultiplier(ctx sdk.Context, epoch int64, denom string, multiplier sdk.Dec) {
-	store := ctx.KVStore(k.storeKey)
-	prefixStore := prefix.NewStore(store, types.KeyPrefixTokenMultiplier)
-	priceRecord := types.OsmoEquivalentMultiplierRecord{
+	k.setOsmoEquivalentMultiplierRecord(ctx, types.OsmoEquivalentMultiplierRecord{
 		EpochNumber: epoch,
 		Denom:       denom,
 		Multiplier:  multiplier,
-	}
+	})
+}
+
+func (k Keeper) setOsmoEquivalentMultiplierRecord(ctx sdk.Context, priceRecord types.OsmoEquivalentMultiplierRecord) {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.KeyPrefixTokenMultiplier)
 	bz, err := proto.Marshal(&priceRecord)
 	if err != nil {
 		panic(err)
 	}
-	prefixStore.Set([]byte(denom), bz)
+	prefixStore.Set([]byte(priceRecord.Denom), bz)
 }
 
 func (k Keeper) GetSuperfluidOSMOTokens(ctx sdk.Context, denom string, amount sdk.Int) sdk.Int {
@@ -50,18 +189,26 @@ func (k Keeper) DeleteOsmoEquivalentMultiplier(ctx sdk.Context, denom string) {
 }
 
 func (k Keeper) GetOsmoEquivalentMultiplier(ctx sdk.Context, denom string) sdk.Dec {
+	priceRecord, found := k.getOsmoEquivalentMultiplierRecord(ctx, denom)
+	if !found {
+		return sdk.ZeroDec()
+	}
+	return priceRecord.Multiplier
+}
+
+func (k Keeper) getOsmoEquivalentMultiplierRecord(ctx sdk.Context, denom string) (types.OsmoEquivalentMultiplierRecord, bool) {
 	store := ctx.KVStore(k.storeKey)
 	prefixStore := prefix.NewStore(store, types.KeyPrefixTokenMultiplier)
 	bz := prefixStore.Get([]byte(denom))
 	if bz == nil {
-		return sdk.ZeroDec()
+		return types.OsmoEquivalentMultiplierRecord{}, false
 	}
 	priceRecord := types.OsmoEquivalentMultiplierRecord{}
 	err := proto.Unmarshal(bz, &priceRecord)
 	if err != nil {
 		panic(err)
 	}
-	return priceRecord.Multiplier
+	return priceRecord, true
 }
 
 func (k Keeper) GetAllOsmoEquivalentMultipliers(ctx sdk.Context) []types.OsmoEquivalentMultiplierRecord {
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"time"
+
 	"github.com/osmosis-labs/osmosis/v12/x/superfluid/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -50,3 +52,129 @@ func (suite *KeeperTestSuite) TestOsmoEquivalentMultiplierSetGetDeleteFlow() {
 	multiplier = suite.App.SuperfluidKeeper.GetOsmoEquivalentMultiplier(suite.Ctx, "gamm/pool/1")
 	suite.Require().Equal(multiplier, sdk.NewDec(0))
 }
+
+func (suite *KeeperTestSuite) TestUpdateOsmoEquivalentMultipliersFromTwap() {
+	testCases := map[string]struct {
+		twapType            types.MultiplierTwapType
+		fallback            types.MultiplierFallback
+		maxMultiplierChange sdk.Dec
+		prevMultiplier      sdk.Dec
+		poolCreatedInEpoch  bool
+
+		expSource     types.MultiplierPriceSource
+		expMultiplier sdk.Dec // nil for the twap of the multiplier
+		expTwapError  bool
+	}{
+		"arithmetic twap": {
+			twapType:  types.MultiplierTwapTypeArithmetic,
+			expSource: types.MultiplierPriceSourceArithmeticTwap,
+		},
+		"geometric twap": {
+			twapType:  types.MultiplierTwapTypeGeometric,
+			expSource: types.MultiplierPriceSourceGeometricTwap,
+		},
+		"pool created during the epoch, spot fallback": {
+			fallback:           types.MultiplierFallbackSpot,
+			poolCreatedInEpoch: true,
+			expSource:          types.MultiplierPriceSourceSpot,
+			expMultiplier:      sdk.NewDec(15),
+			expTwapError:       true,
+		},
+		"pool created during the epoch, previous multiplier fallback": {
+			fallback:           types.MultiplierFallbackPrevious,
+			prevMultiplier:     sdk.NewDec(18),
+			poolCreatedInEpoch: true,
+			expSource:          types.MultiplierPriceSourcePrevious,
+			expMultiplier:      sdk.NewDec(18),
+			expTwapError:       true,
+		},
+		"max multiplier change caps the increase": {
+			maxMultiplierChange: sdk.NewDecWithPrec(1, 1),
+			prevMultiplier:      sdk.NewDec(10),
+			expSource:           types.MultiplierPriceSourceArithmeticTwap,
+			expMultiplier:       sdk.NewDec(11),
+		},
+		"max multiplier change caps the decrease": {
+			maxMultiplierChange: sdk.NewDecWithPrec(1, 1),
+			prevMultiplier:      sdk.NewDec(30),
+			expSource:           types.MultiplierPriceSourceArithmeticTwap,
+			expMultiplier:       sdk.NewDec(27),
+		},
+		"max multiplier change within the cap": {
+			maxMultiplierChange: sdk.NewDecWithPrec(1, 1),
+			expSource:           types.MultiplierPriceSourceArithmeticTwap,
+		},
+	}
+
+	for name, tc := range testCases {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			epochDuration := suite.App.EpochsKeeper.GetEpochInfo(suite.Ctx, suite.App.SuperfluidKeeper.GetEpochIdentifier(suite.Ctx)).Duration
+			poolCreationTime := suite.Ctx.BlockTime()
+			denoms, poolIds := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20)})
+
+			params := suite.App.SuperfluidKeeper.GetParams(suite.Ctx)
+			params.MultiplierTwapType = tc.twapType
+			params.MultiplierFallback = tc.fallback
+			if !tc.maxMultiplierChange.IsNil() {
+				params.MaxMultiplierChange = tc.maxMultiplierChange
+			}
+			suite.App.SuperfluidKeeper.SetParams(suite.Ctx, params)
+			if !tc.prevMultiplier.IsNil() {
+				suite.App.SuperfluidKeeper.SetOsmoEquivalentMultiplier(suite.Ctx, 1, denoms[0], tc.prevMultiplier)
+			}
+
+			// move the pool price right before the end of the epoch, setting the spot multiplier to 15
+			epochEndTime := poolCreationTime.Add(epochDuration).Add(time.Minute)
+			if tc.poolCreatedInEpoch {
+				epochEndTime = poolCreationTime.Add(epochDuration).Add(-time.Minute)
+			}
+			suite.Ctx = suite.Ctx.WithBlockTime(epochEndTime.Add(-5 * time.Second))
+			pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolIds[0])
+			suite.Require().NoError(err)
+			coins := pool.GetTotalPoolLiquidity(suite.Ctx)
+			suite.SwapAndSetSpotPrice(poolIds[0], coins[1], coins[0])
+			suite.App.TwapKeeper.EndBlock(suite.Ctx)
+			suite.Ctx = suite.Ctx.WithBlockTime(epochEndTime)
+
+			err = suite.App.SuperfluidKeeper.UpdateOsmoEquivalentMultipliers(suite.Ctx, types.SuperfluidAsset{Denom: denoms[0], AssetType: types.SuperfluidAssetTypeLPShare}, 2)
+			suite.Require().NoError(err)
+
+			res, err := suite.querier.AssetMultiplier(sdk.WrapSDKContext(suite.Ctx), &types.AssetMultiplierRequest{Denom: denoms[0]})
+			suite.Require().NoError(err)
+			multiplier := res.OsmoEquivalentMultiplier.Multiplier
+			inputs := res.OsmoEquivalentMultiplier.Inputs
+			suite.Require().NotNil(inputs)
+			suite.Require().Equal(tc.expSource, inputs.PriceSource)
+			suite.Require().Equal(epochEndTime.Add(-epochDuration), inputs.TwapStartTime)
+			suite.Require().Equal(epochEndTime, inputs.TwapEndTime)
+			suite.Require().Equal(tc.expTwapError, inputs.TwapError != "", inputs.TwapError)
+			suite.Require().Equal(multiplier, suite.App.SuperfluidKeeper.GetOsmoEquivalentMultiplier(suite.Ctx, denoms[0]))
+
+			if tc.expMultiplier.IsNil() {
+				// the swap at the end of the epoch barely moves the multiplier, up to swap fees.
+				suite.Require().True(multiplier.GT(sdk.NewDec(20)), multiplier.String())
+				suite.Require().True(multiplier.LT(sdk.NewDecWithPrec(201, 1)), multiplier.String())
+			} else {
+				suite.Require().Equal(tc.expMultiplier, multiplier)
+			}
+			if tc.expSource != types.MultiplierPriceSourcePrevious {
+				suite.Require().Len(inputs.Prices, 1)
+				suite.Require().Equal(coins[1].Denom, inputs.Prices[0].Denom)
+				// every price source records the price of the asset in OSMO, which is far above one in this pool
+				suite.Require().True(inputs.Prices[0].Amount.GT(sdk.OneDec()), inputs.Prices[0].Amount.String())
+			}
+			if tc.expSource == types.MultiplierPriceSourceSpot {
+				bondDenom := suite.App.StakingKeeper.BondDenom(suite.Ctx)
+				spotPrice, err := suite.App.GAMMKeeper.CalculateSpotPrice(suite.Ctx, poolIds[0], bondDenom, coins[1].Denom)
+				suite.Require().NoError(err)
+				suite.Require().Equal(spotPrice, inputs.Prices[0].Amount)
+			}
+			if tc.expSource == types.MultiplierPriceSourceArithmeticTwap || tc.expSource == types.MultiplierPriceSourceGeometricTwap {
+				// the twap is at most changed by the max multiplier change
+				suite.Require().True(inputs.UncappedMultiplier.GT(sdk.NewDec(20)), inputs.UncappedMultiplier.String())
+				suite.Require().True(inputs.UncappedMultiplier.LT(sdk.NewDecWithPrec(201, 1)), inputs.UncappedMultiplier.String())
+			}
+		})
+	}
+}
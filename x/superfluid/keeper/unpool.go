@@ -138,8 +138,9 @@ func (k Keeper) unbondSuperfluidIfExists(ctx sdk.Context, sender sdk.AccAddress,
 	// Proxy for determining if a lock is superfluid delegated. This is because, every lock that is superfluid
 	// delegated, has a state entry mapping the lock ID, to an intermediary account.
 	// This state entry is deleted in Superfluid undelegate, hence detects if undelegating.
+	// Locks superfluid delegated to a validator set have no such entry, and are detected through their validator set.
 	_, found := k.GetIntermediaryAccountFromLockId(ctx, lockId)
-	if found {
+	if found || k.isValidatorSetDelegated(ctx, lockId) {
 		// superfluid undelegate first
 		// this undelegates delegation, breaks synthetic locks and
 		// create a new synthetic lock representing unstaking
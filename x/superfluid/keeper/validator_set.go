@@ -0,0 +1,416 @@
+package keeper
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	lockuptypes "github.com/osmosis-labs/osmosis/v12/x/lockup/types"
+	"github.com/osmosis-labs/osmosis/v12/x/superfluid/types"
+)
+
+// A lock superfluid delegated to a validator set splits its OSMO-equivalent across the validators of the set by weight.
+// Every validator of the set gets an intermediary account of its own for the lock, with a synthetic lock suffixed by the
+// lock ID. Hence each of these intermediary accounts holds the delegation of a single lock, delegating the validator's
+// weight of it, and its perpetual gauge only rewards that lock.
+// The validator set of a lock is kept while the lock is superfluid undelegating, as its unbonding synthetic locks
+// keep the slashing risk of their weights. Likewise, the shares of the lock that validators lose when the set is
+// updated go through an unbonding synthetic lock, and are tracked as the unbonding weights of the set.
+
+// GetLockValidatorSet returns the weighted validator set the lock is superfluid delegated to.
+func (k Keeper) GetLockValidatorSet(ctx sdk.Context, lockId uint64) (types.LockValidatorSet, bool) {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.KeyPrefixLockValidatorSet)
+
+	set := types.LockValidatorSet{}
+	bz := prefixStore.Get(sdk.Uint64ToBigEndian(lockId))
+	if bz == nil {
+		return set, false
+	}
+	err := proto.Unmarshal(bz, &set)
+	if err != nil {
+		panic(err)
+	}
+	return set, true
+}
+
+func (k Keeper) SetLockValidatorSet(ctx sdk.Context, set types.LockValidatorSet) {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.KeyPrefixLockValidatorSet)
+
+	bz, err := proto.Marshal(&set)
+	if err != nil {
+		panic(err)
+	}
+	prefixStore.Set(sdk.Uint64ToBigEndian(set.LockId), bz)
+}
+
+func (k Keeper) DeleteLockValidatorSet(ctx sdk.Context, lockId uint64) {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.KeyPrefixLockValidatorSet)
+	prefixStore.Delete(sdk.Uint64ToBigEndian(lockId))
+}
+
+func (k Keeper) GetAllLockValidatorSets(ctx sdk.Context) []types.LockValidatorSet {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.KeyPrefixLockValidatorSet)
+
+	iterator := prefixStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	sets := []types.LockValidatorSet{}
+	for ; iterator.Valid(); iterator.Next() {
+		set := types.LockValidatorSet{}
+		err := proto.Unmarshal(iterator.Value(), &set)
+		if err != nil {
+			panic(err)
+		}
+		sets = append(sets, set)
+	}
+	return sets
+}
+
+// isValidatorSetDelegated returns true if the lock is superfluid delegated to a validator set,
+// and not superfluid undelegating. Every validator of the set must have a bonded synthetic lock.
+func (k Keeper) isValidatorSetDelegated(ctx sdk.Context, lockId uint64) bool {
+	set, found := k.GetLockValidatorSet(ctx, lockId)
+	if !found || len(set.Validators) == 0 {
+		return false
+	}
+	lock, err := k.lk.GetLockByID(ctx, lockId)
+	if err != nil {
+		return false
+	}
+	coin, err := lock.SingleCoin()
+	if err != nil {
+		return false
+	}
+	for _, validator := range set.Validators {
+		acc := types.NewValidatorSetIntermediaryAccount(coin.Denom, validator.ValAddr, lockId, 0)
+		if _, err := k.lk.GetSyntheticLockup(ctx, lockId, intermediaryAccountStakingSyntheticDenom(acc)); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// getValidatorSetSlashedWeight returns the share of the lock of a validator-set intermediary account that is at risk
+// of being slashed for its validator: its bonded weight if it has a bonded synthetic lock, plus its unbonding weight
+// if it has an unbonding synthetic lock.
+func (k Keeper) getValidatorSetSlashedWeight(ctx sdk.Context, acc types.SuperfluidIntermediaryAccount) sdk.Dec {
+	set, found := k.GetLockValidatorSet(ctx, acc.LockId)
+	if !found {
+		return sdk.ZeroDec()
+	}
+	weight := sdk.ZeroDec()
+	if _, err := k.lk.GetSyntheticLockup(ctx, acc.LockId, intermediaryAccountStakingSyntheticDenom(acc)); err == nil {
+		weight = weight.Add(set.GetWeight(acc.ValAddr))
+	}
+	if _, err := k.lk.GetSyntheticLockup(ctx, acc.LockId, intermediaryAccountUnstakingSyntheticDenom(acc)); err == nil {
+		weight = weight.Add(set.GetUnbondingWeight(acc.ValAddr))
+	}
+	return weight
+}
+
+// pruneMaturedUnbondingWeights removes from the set the unbonding weights whose unbonding synthetic lock has matured.
+func (k Keeper) pruneMaturedUnbondingWeights(ctx sdk.Context, set *types.LockValidatorSet, denom string) {
+	unbonding := []types.ValidatorWeight{}
+	for _, validator := range set.UnbondingValidators {
+		acc := types.NewValidatorSetIntermediaryAccount(denom, validator.ValAddr, set.LockId, 0)
+		if _, err := k.lk.GetSyntheticLockup(ctx, set.LockId, intermediaryAccountUnstakingSyntheticDenom(acc)); err == nil {
+			unbonding = append(unbonding, validator)
+		}
+	}
+	set.UnbondingValidators = unbonding
+}
+
+// unbondValidatorSetWeight superfluid undelegates the given weight of the lock from the validator of the intermediary
+// account, by adding it to the unbonding weight of the validator and restarting its unbonding synthetic lock.
+// The unbonding synthetic lock keeps the slashing risk of the weight for the unbonding period, while the delegation
+// itself is undelegated and burnt by the next refresh of the account, as for SuperfluidUndelegate.
+// The matured unbonding weights of the set must have been pruned beforehand.
+func (k Keeper) unbondValidatorSetWeight(ctx sdk.Context, set *types.LockValidatorSet, acc types.SuperfluidIntermediaryAccount, weight sdk.Dec) error {
+	unbondingWeight := set.GetUnbondingWeight(acc.ValAddr)
+	if unbondingWeight.IsPositive() {
+		err := k.lk.DeleteSyntheticLockup(ctx, set.LockId, intermediaryAccountUnstakingSyntheticDenom(acc))
+		if err != nil {
+			return err
+		}
+	}
+	set.SetUnbondingWeight(acc.ValAddr, unbondingWeight.Add(weight))
+	return k.createSyntheticLockup(ctx, set.LockId, acc, unlockingStatus)
+}
+
+// getValidatorSetWeight returns the weight of the validator of a validator-set intermediary account
+// in the validator set of its lock.
+func (k Keeper) getValidatorSetWeight(ctx sdk.Context, acc types.SuperfluidIntermediaryAccount) sdk.Dec {
+	set, found := k.GetLockValidatorSet(ctx, acc.LockId)
+	if !found {
+		return sdk.ZeroDec()
+	}
+	return set.GetWeight(acc.ValAddr)
+}
+
+// getValidatorSetIntermediaryAccount returns the intermediary account the bonded synthetic lock of a
+// validator-set superfluid delegation is delegated through.
+func (k Keeper) getValidatorSetIntermediaryAccount(ctx sdk.Context, synthLock lockuptypes.SyntheticLock) (types.SuperfluidIntermediaryAccount, bool) {
+	if synthLock.IsUnlocking() {
+		return types.SuperfluidIntermediaryAccount{}, false
+	}
+	if _, found := k.GetLockValidatorSet(ctx, synthLock.UnderlyingLockId); !found {
+		return types.SuperfluidIntermediaryAccount{}, false
+	}
+	valAddr, err := ValidatorAddressFromSyntheticDenom(synthLock.SynthDenom)
+	if err != nil {
+		return types.SuperfluidIntermediaryAccount{}, false
+	}
+	lock, err := k.lk.GetLockByID(ctx, synthLock.UnderlyingLockId)
+	if err != nil {
+		return types.SuperfluidIntermediaryAccount{}, false
+	}
+	coin, err := lock.SingleCoin()
+	if err != nil {
+		return types.SuperfluidIntermediaryAccount{}, false
+	}
+
+	acc := k.GetIntermediaryAccount(ctx, types.GetValidatorSetIntermediaryAccountAddr(coin.Denom, valAddr, lock.ID))
+	if acc.Empty() || intermediaryAccountStakingSyntheticDenom(acc) != synthLock.SynthDenom {
+		return types.SuperfluidIntermediaryAccount{}, false
+	}
+	return acc, true
+}
+
+// superfluidLockedAmount returns the amount of the lock superfluid delegated through the synthetic lock.
+// This is the whole lock, unless the lock is superfluid delegated to a validator set, where the validator
+// of the synthetic lock gets its bonded or unbonding weight of it.
+func (k Keeper) superfluidLockedAmount(ctx sdk.Context, lock lockuptypes.PeriodLock, synthLock lockuptypes.SyntheticLock) sdk.Int {
+	coin, err := lock.SingleCoin()
+	if err != nil {
+		return sdk.ZeroInt()
+	}
+	set, found := k.GetLockValidatorSet(ctx, lock.ID)
+	if !found {
+		return coin.Amount
+	}
+	valAddr, err := ValidatorAddressFromSyntheticDenom(synthLock.SynthDenom)
+	if err != nil {
+		return coin.Amount
+	}
+	acc := types.NewValidatorSetIntermediaryAccount(coin.Denom, valAddr, lock.ID, 0)
+	switch synthLock.SynthDenom {
+	case intermediaryAccountStakingSyntheticDenom(acc):
+		return coin.Amount.ToDec().Mul(set.GetWeight(valAddr)).TruncateInt()
+	case intermediaryAccountUnstakingSyntheticDenom(acc):
+		return coin.Amount.ToDec().Mul(set.GetUnbondingWeight(valAddr)).TruncateInt()
+	default:
+		return coin.Amount
+	}
+}
+
+// SuperfluidDelegateToValidatorSet superfluid delegates the osmo equivalent amount of the given lock,
+// split across the validators of the set by weight.
+// The lock goes through the same checks as for SuperfluidDelegate. For every validator of the set, an intermediary account
+// dedicated to the lock is created, along with a synthetic lockup, and delegates the validator's weight of the lock.
+func (k Keeper) SuperfluidDelegateToValidatorSet(ctx sdk.Context, sender string, lockID uint64, validators []types.ValidatorWeight) error {
+	err := types.ValidateValidatorWeights(validators)
+	if err != nil {
+		return err
+	}
+
+	lock, err := k.lk.GetLockByID(ctx, lockID)
+	if err != nil {
+		return err
+	}
+	err = k.validateLockForSFDelegate(ctx, lock, sender)
+	if err != nil {
+		return err
+	}
+	lockedCoin := lock.Coins[0]
+
+	for _, validator := range validators {
+		_, err = k.validateValAddrForDelegate(ctx, validator.ValAddr)
+		if err != nil {
+			return err
+		}
+	}
+
+	if k.GetSuperfluidOSMOTokens(ctx, lockedCoin.Denom, lockedCoin.Amount).IsZero() {
+		return types.ErrOsmoEquivalentZeroNotAllowed
+	}
+
+	// The validator set is stored first, as it sets the share of the lock delegated by every intermediary account.
+	k.SetLockValidatorSet(ctx, types.LockValidatorSet{LockId: lockID, Validators: validators})
+
+	for _, validator := range validators {
+		acc, err := k.GetOrCreateValidatorSetIntermediaryAccount(ctx, lockedCoin.Denom, validator.ValAddr, lockID)
+		if err != nil {
+			return err
+		}
+
+		err = k.createSyntheticLockup(ctx, lockID, acc, bondedStatus)
+		if err != nil {
+			return err
+		}
+
+		// the intermediary account has no delegation yet, so this mints and delegates the validator's weight of the lock.
+		err = k.refreshIntermediaryDelegationAmount(ctx, acc)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SuperfluidUpdateValidatorSet replaces the validator set the lock is superfluid delegated to, and rebalances
+// the delegations of the lock to the new weights.
+// The weight that validators lose, whether they leave the set or have their weight reduced, is superfluid undelegated
+// from them: it goes through an unbonding synthetic lock, which keeps its slashing risk for the unbonding period.
+// Validators leaving the set also have their bonded synthetic lock deleted, and validators joining it get an
+// intermediary account and a bonded synthetic lock. Every validator then has its delegation minted or burnt
+// to its new weight of the lock.
+func (k Keeper) SuperfluidUpdateValidatorSet(ctx sdk.Context, sender string, lockID uint64, validators []types.ValidatorWeight) error {
+	err := types.ValidateValidatorWeights(validators)
+	if err != nil {
+		return err
+	}
+
+	lock, err := k.lk.GetLockByID(ctx, lockID)
+	if err != nil {
+		return err
+	}
+	err = k.validateLockForSF(ctx, lock, sender)
+	if err != nil {
+		return err
+	}
+	lockedCoin := lock.Coins[0]
+
+	oldSet, found := k.GetLockValidatorSet(ctx, lockID)
+	if !found || !k.isValidatorSetDelegated(ctx, lockID) {
+		return sdkerrors.Wrapf(types.ErrNotValidatorSetDelegated, "lock id : %d", lockID)
+	}
+
+	for _, validator := range validators {
+		_, err = k.validateValAddrForDelegate(ctx, validator.ValAddr)
+		if err != nil {
+			return err
+		}
+	}
+
+	newSet := types.LockValidatorSet{LockId: lockID, Validators: validators, UnbondingValidators: oldSet.UnbondingValidators}
+	k.pruneMaturedUnbondingWeights(ctx, &newSet, lockedCoin.Denom)
+
+	// First unbond the weight lost by the validators of the old set.
+	unbondingAccs := []types.SuperfluidIntermediaryAccount{}
+	for _, validator := range oldSet.Validators {
+		newWeight := newSet.GetWeight(validator.ValAddr)
+		if newWeight.GTE(validator.Weight) {
+			continue
+		}
+
+		acc := k.GetIntermediaryAccount(ctx, types.GetValidatorSetIntermediaryAccountAddr(lockedCoin.Denom, validator.ValAddr, lockID))
+		err = k.unbondValidatorSetWeight(ctx, &newSet, acc, validator.Weight.Sub(newWeight))
+		if err != nil {
+			return err
+		}
+
+		if !newWeight.IsPositive() {
+			err = k.lk.DeleteSyntheticLockup(ctx, lockID, intermediaryAccountStakingSyntheticDenom(acc))
+			if err != nil {
+				return err
+			}
+			// with its bonded synthetic lockup deleted, the intermediary account is expected to have no delegation left
+			unbondingAccs = append(unbondingAccs, acc)
+		}
+	}
+
+	// The new set is stored before refreshing the delegations, as it sets their weights.
+	k.SetLockValidatorSet(ctx, newSet)
+
+	for _, acc := range unbondingAccs {
+		err = k.refreshIntermediaryDelegationAmount(ctx, acc)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Then rebalance the delegations of the validators of the new set.
+	for _, validator := range validators {
+		acc, err := k.GetOrCreateValidatorSetIntermediaryAccount(ctx, lockedCoin.Denom, validator.ValAddr, lockID)
+		if err != nil {
+			return err
+		}
+
+		if !oldSet.GetWeight(validator.ValAddr).IsPositive() {
+			err = k.createSyntheticLockup(ctx, lockID, acc, bondedStatus)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = k.refreshIntermediaryDelegationAmount(ctx, acc)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// superfluidUndelegateFromValidatorSet superfluid undelegates a lock delegated to a validator set.
+// For every validator of the set, the bonded synthetic lockup is deleted, the weight of the validator is unbonded,
+// and the delegation of its intermediary account is undelegated and burnt.
+func (k Keeper) superfluidUndelegateFromValidatorSet(ctx sdk.Context, lock *lockuptypes.PeriodLock, set types.LockValidatorSet) error {
+	lockedCoin := lock.Coins[0]
+	k.pruneMaturedUnbondingWeights(ctx, &set, lockedCoin.Denom)
+
+	for _, validator := range set.Validators {
+		acc := k.GetIntermediaryAccount(ctx, types.GetValidatorSetIntermediaryAccountAddr(lockedCoin.Denom, validator.ValAddr, lock.ID))
+
+		err := k.lk.DeleteSyntheticLockup(ctx, lock.ID, intermediaryAccountStakingSyntheticDenom(acc))
+		if err != nil {
+			return err
+		}
+
+		// with its synthetic lockup deleted, the intermediary account is expected to have no delegation left
+		err = k.refreshIntermediaryDelegationAmount(ctx, acc)
+		if err != nil {
+			return err
+		}
+
+		err = k.unbondValidatorSetWeight(ctx, &set, acc, validator.Weight)
+		if err != nil {
+			return err
+		}
+	}
+
+	k.SetLockValidatorSet(ctx, set)
+	return nil
+}
+
+// refreshValidatorSetDelegations refreshes the delegations of every validator of the set the lock is delegated to.
+func (k Keeper) refreshValidatorSetDelegations(ctx sdk.Context, lockID uint64) error {
+	set, found := k.GetLockValidatorSet(ctx, lockID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrNotValidatorSetDelegated, "lock id : %d", lockID)
+	}
+	lock, err := k.lk.GetLockByID(ctx, lockID)
+	if err != nil {
+		return err
+	}
+	coin, err := lock.SingleCoin()
+	if err != nil {
+		return err
+	}
+
+	for _, validator := range set.Validators {
+		acc := k.GetIntermediaryAccount(ctx, types.GetValidatorSetIntermediaryAccountAddr(coin.Denom, validator.ValAddr, lockID))
+		err = k.refreshIntermediaryDelegationAmount(ctx, acc)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
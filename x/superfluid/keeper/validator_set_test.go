@@ -0,0 +1,398 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	lockuptypes "github.com/osmosis-labs/osmosis/v12/x/lockup/types"
+	"github.com/osmosis-labs/osmosis/v12/x/superfluid/keeper"
+	"github.com/osmosis-labs/osmosis/v12/x/superfluid/types"
+)
+
+// setupValidatorSetDelegation locks 1000000 shares of the first superfluid denom,
+// and superfluid delegates the lock to the validator set with the given weights.
+func (suite *KeeperTestSuite) setupValidatorSetDelegation(valAddrs []sdk.ValAddress, weights []string, denom string) (lockuptypes.PeriodLock, []types.ValidatorWeight) {
+	unbondingDuration := suite.App.StakingKeeper.GetParams(suite.Ctx).UnbondingTime
+	delAddr := CreateRandomAccounts(1)[0]
+	lockID := suite.LockTokens(delAddr, sdk.NewCoins(sdk.NewInt64Coin(denom, 1000000)), unbondingDuration)
+	lock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, lockID)
+	suite.Require().NoError(err)
+
+	validators := validatorWeights(valAddrs, weights)
+	err = suite.App.SuperfluidKeeper.SuperfluidDelegateToValidatorSet(suite.Ctx, lock.Owner, lock.ID, validators)
+	suite.Require().NoError(err)
+	return *lock, validators
+}
+
+func validatorWeights(valAddrs []sdk.ValAddress, weights []string) []types.ValidatorWeight {
+	validators := []types.ValidatorWeight{}
+	for i, weight := range weights {
+		validators = append(validators, types.ValidatorWeight{ValAddr: valAddrs[i].String(), Weight: sdk.MustNewDecFromStr(weight)})
+	}
+	return validators
+}
+
+// checkValidatorSetDelegations checks the delegation of the validator-set intermediary account of the lock to every validator.
+func (suite *KeeperTestSuite) checkValidatorSetDelegations(lock lockuptypes.PeriodLock, valAddrs []sdk.ValAddress, expDelegations []sdk.Dec) {
+	for i, valAddr := range valAddrs {
+		accAddr := types.GetValidatorSetIntermediaryAccountAddr(lock.Coins[0].Denom, valAddr.String(), lock.ID)
+		delegation, found := suite.App.StakingKeeper.GetDelegation(suite.Ctx, accAddr, valAddr)
+		if expDelegations[i].IsZero() {
+			suite.Require().False(found, "validator %d", i)
+			continue
+		}
+		suite.Require().True(found, "validator %d", i)
+		suite.Require().Equal(expDelegations[i], delegation.Shares, "validator %d", i)
+	}
+
+	reason, broken := keeper.AllInvariants(*suite.App.SuperfluidKeeper)(suite.Ctx)
+	suite.Require().False(broken, reason)
+}
+
+func (suite *KeeperTestSuite) TestSuperfluidDelegateToValidatorSet() {
+	testCases := []struct {
+		name           string
+		validatorStats []stakingtypes.BondStatus
+		weights        []string
+		expDelegations []sdk.Dec
+	}{
+		{
+			"with a single validator",
+			[]stakingtypes.BondStatus{stakingtypes.Bonded},
+			[]string{"1"},
+			[]sdk.Dec{sdk.NewDec(10000000)}, // 50% x 20 x 1000000
+		},
+		{
+			"with multiple validators",
+			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded, stakingtypes.Unbonded},
+			[]string{"0.3", "0.5", "0.2"},
+			[]sdk.Dec{sdk.NewDec(3000000), sdk.NewDec(5000000), sdk.NewDec(2000000)}, // weight x 50% x 20 x 1000000
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			unbondingDuration := suite.App.StakingKeeper.GetParams(suite.Ctx).UnbondingTime
+			valAddrs := suite.SetupValidators(tc.validatorStats)
+			denoms, _ := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20), sdk.NewDec(20)})
+
+			lock, validators := suite.setupValidatorSetDelegation(valAddrs, tc.weights, denoms[0])
+
+			// the validator set is stored, and the lock has no single intermediary account connection
+			set, found := suite.App.SuperfluidKeeper.GetLockValidatorSet(suite.Ctx, lock.ID)
+			suite.Require().True(found)
+			suite.Require().Equal(types.LockValidatorSet{LockId: lock.ID, Validators: validators}, set)
+			suite.Require().True(suite.App.SuperfluidKeeper.GetLockIdIntermediaryAccountConnection(suite.Ctx, lock.ID).Empty())
+
+			for _, valAddr := range valAddrs {
+				acc := suite.App.SuperfluidKeeper.GetIntermediaryAccount(suite.Ctx, types.GetValidatorSetIntermediaryAccountAddr(denoms[0], valAddr.String(), lock.ID))
+				suite.Require().Equal(types.NewValidatorSetIntermediaryAccount(denoms[0], valAddr.String(), lock.ID, acc.GaugeId), acc)
+
+				// the synthetic lock and the gauge of the intermediary account only concern this lock
+				synthDenom := keeper.IntermediaryAccountStakingSyntheticDenom(acc)
+				suite.Require().NotEqual(keeper.StakingSyntheticDenom(denoms[0], valAddr.String()), synthDenom)
+				synthLock, err := suite.App.LockupKeeper.GetSyntheticLockup(suite.Ctx, lock.ID, synthDenom)
+				suite.Require().NoError(err)
+				suite.Require().False(synthLock.IsUnlocking())
+
+				gauge, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, acc.GaugeId)
+				suite.Require().NoError(err)
+				suite.Require().True(gauge.IsPerpetual)
+				suite.Require().Equal(lockuptypes.QueryCondition{
+					LockQueryType: lockuptypes.ByDuration,
+					Denom:         synthDenom,
+					Duration:      unbondingDuration,
+				}, gauge.DistributeTo)
+
+				valAddrFromDenom, err := keeper.ValidatorAddressFromSyntheticDenom(synthDenom)
+				suite.Require().NoError(err)
+				suite.Require().Equal(valAddr.String(), valAddrFromDenom)
+			}
+
+			suite.checkValidatorSetDelegations(lock, valAddrs, tc.expDelegations)
+
+			// the delegations of the lock are reported per validator
+			delegationsRes, err := suite.querier.SuperfluidDelegationsByDelegator(sdk.WrapSDKContext(suite.Ctx), &types.SuperfluidDelegationsByDelegatorRequest{DelegatorAddress: lock.Owner})
+			suite.Require().NoError(err)
+			suite.Require().Len(delegationsRes.SuperfluidDelegationRecords, len(valAddrs))
+			suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(denoms[0], 1000000)), delegationsRes.TotalDelegatedCoins)
+			suite.Require().Equal(sdk.NewInt(10000000), delegationsRes.TotalEquivalentStakedAmount.Amount)
+
+			// the lock can't be superfluid delegated again
+			err = suite.App.SuperfluidKeeper.SuperfluidDelegateToValidatorSet(suite.Ctx, lock.Owner, lock.ID, validators)
+			suite.Require().ErrorIs(err, types.ErrAlreadyUsedSuperfluidLockup)
+			err = suite.App.SuperfluidKeeper.SuperfluidDelegate(suite.Ctx, lock.Owner, lock.ID, valAddrs[0].String())
+			suite.Require().ErrorIs(err, types.ErrAlreadyUsedSuperfluidLockup)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSuperfluidDelegateToValidatorSetErrors() {
+	suite.SetupTest()
+	unbondingDuration := suite.App.StakingKeeper.GetParams(suite.Ctx).UnbondingTime
+	valAddrs := suite.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded})
+	denoms, _ := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20), sdk.NewDec(20)})
+	delAddr := CreateRandomAccounts(1)[0]
+	lockID := suite.LockTokens(delAddr, sdk.NewCoins(sdk.NewInt64Coin(denoms[0], 1000000)), unbondingDuration)
+
+	testCases := map[string]struct {
+		sender      string
+		validators  []types.ValidatorWeight
+		expectedErr error
+	}{
+		"weights not adding up to one": {
+			sender:      delAddr.String(),
+			validators:  validatorWeights(valAddrs, []string{"0.5", "0.4"}),
+			expectedErr: types.ErrInvalidValidatorSet,
+		},
+		"duplicate validator": {
+			sender:      delAddr.String(),
+			validators:  validatorWeights([]sdk.ValAddress{valAddrs[0], valAddrs[0]}, []string{"0.5", "0.5"}),
+			expectedErr: types.ErrInvalidValidatorSet,
+		},
+		"non existent validator": {
+			sender:      delAddr.String(),
+			validators:  validatorWeights([]sdk.ValAddress{valAddrs[0], sdk.ValAddress(delAddr)}, []string{"0.5", "0.5"}),
+			expectedErr: stakingtypes.ErrNoValidatorFound,
+		},
+		"not the lock owner": {
+			sender:      valAddrs[0].String(),
+			validators:  validatorWeights(valAddrs, []string{"0.5", "0.5"}),
+			expectedErr: lockuptypes.ErrNotLockOwner,
+		},
+	}
+
+	for name, tc := range testCases {
+		suite.Run(name, func() {
+			err := suite.App.SuperfluidKeeper.SuperfluidDelegateToValidatorSet(suite.Ctx, tc.sender, lockID, tc.validators)
+			suite.Require().ErrorIs(err, tc.expectedErr)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSuperfluidUpdateValidatorSet() {
+	suite.SetupTest()
+	valAddrs := suite.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded, stakingtypes.Bonded})
+	denoms, _ := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20), sdk.NewDec(20)})
+
+	lock, _ := suite.setupValidatorSetDelegation(valAddrs[:2], []string{"0.5", "0.5"}, denoms[0])
+	suite.checkValidatorSetDelegations(lock, valAddrs, []sdk.Dec{sdk.NewDec(5000000), sdk.NewDec(5000000), sdk.ZeroDec()})
+
+	// the first validator leaves the set, the third one joins it
+	newValidators := validatorWeights(valAddrs[1:], []string{"0.2", "0.8"})
+	err := suite.App.SuperfluidKeeper.SuperfluidUpdateValidatorSet(suite.Ctx, lock.Owner, lock.ID, newValidators)
+	suite.Require().NoError(err)
+	suite.checkValidatorSetDelegations(lock, valAddrs, []sdk.Dec{sdk.ZeroDec(), sdk.NewDec(2000000), sdk.NewDec(8000000)})
+
+	set, found := suite.App.SuperfluidKeeper.GetLockValidatorSet(suite.Ctx, lock.ID)
+	suite.Require().True(found)
+	suite.Require().Equal(newValidators, set.Validators)
+
+	// the validators of the new set have a bonded synthetic lock, and the weight lost by the validators of the old set
+	// is unbonding
+	suite.Require().Equal([]types.ValidatorWeight{
+		{ValAddr: valAddrs[0].String(), Weight: sdk.MustNewDecFromStr("0.5")},
+		{ValAddr: valAddrs[1].String(), Weight: sdk.MustNewDecFromStr("0.3")},
+	}, set.UnbondingValidators)
+	suite.Require().False(suite.hasValidatorSetSynthLock(lock, valAddrs[0], false))
+	suite.Require().True(suite.hasValidatorSetSynthLock(lock, valAddrs[0], true))
+	suite.Require().True(suite.hasValidatorSetSynthLock(lock, valAddrs[1], false))
+	suite.Require().True(suite.hasValidatorSetSynthLock(lock, valAddrs[1], true))
+	suite.Require().True(suite.hasValidatorSetSynthLock(lock, valAddrs[2], false))
+	suite.Require().False(suite.hasValidatorSetSynthLock(lock, valAddrs[2], true))
+
+	// the lock is still delegated to the validator set, and can be updated again, adding to the unbonding weights
+	err = suite.App.SuperfluidKeeper.SuperfluidUpdateValidatorSet(suite.Ctx, lock.Owner, lock.ID, validatorWeights(valAddrs[2:], []string{"1"}))
+	suite.Require().NoError(err)
+	suite.checkValidatorSetDelegations(lock, valAddrs, []sdk.Dec{sdk.ZeroDec(), sdk.ZeroDec(), sdk.NewDec(10000000)})
+	set, found = suite.App.SuperfluidKeeper.GetLockValidatorSet(suite.Ctx, lock.ID)
+	suite.Require().True(found)
+	suite.Require().Equal([]types.ValidatorWeight{
+		{ValAddr: valAddrs[0].String(), Weight: sdk.MustNewDecFromStr("0.5")},
+		{ValAddr: valAddrs[1].String(), Weight: sdk.MustNewDecFromStr("0.5")},
+	}, set.UnbondingValidators)
+
+	// once the unbonding synthetic locks mature, the unbonding weights are dropped by the next update
+	unbondingDuration := suite.App.StakingKeeper.GetParams(suite.Ctx).UnbondingTime
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(unbondingDuration).Add(time.Second))
+	suite.App.LockupKeeper.DeleteAllMaturedSyntheticLocks(suite.Ctx)
+	err = suite.App.SuperfluidKeeper.SuperfluidUpdateValidatorSet(suite.Ctx, lock.Owner, lock.ID, newValidators)
+	suite.Require().NoError(err)
+	set, found = suite.App.SuperfluidKeeper.GetLockValidatorSet(suite.Ctx, lock.ID)
+	suite.Require().True(found)
+	suite.Require().Equal([]types.ValidatorWeight{
+		{ValAddr: valAddrs[2].String(), Weight: sdk.MustNewDecFromStr("0.2")},
+	}, set.UnbondingValidators)
+
+	// only the owner can update the validator set
+	err = suite.App.SuperfluidKeeper.SuperfluidUpdateValidatorSet(suite.Ctx, valAddrs[0].String(), lock.ID, newValidators)
+	suite.Require().ErrorIs(err, lockuptypes.ErrNotLockOwner)
+
+	// locks not delegated to a validator set can't be updated
+	singleLock := suite.setupSuperfluidDelegate(CreateRandomAccounts(1)[0], valAddrs[0], denoms[0], 1000000)
+	err = suite.App.SuperfluidKeeper.SuperfluidUpdateValidatorSet(suite.Ctx, singleLock.Owner, singleLock.ID, newValidators)
+	suite.Require().ErrorIs(err, types.ErrNotValidatorSetDelegated)
+}
+
+// hasValidatorSetSynthLock returns true if the validator-set intermediary account of the lock to the validator
+// has a bonded synthetic lock, or an unbonding one if unbonding is true.
+func (suite *KeeperTestSuite) hasValidatorSetSynthLock(lock lockuptypes.PeriodLock, valAddr sdk.ValAddress, unbonding bool) bool {
+	acc := types.NewValidatorSetIntermediaryAccount(lock.Coins[0].Denom, valAddr.String(), lock.ID, 0)
+	synthDenom := keeper.IntermediaryAccountStakingSyntheticDenom(acc)
+	if unbonding {
+		synthDenom = keeper.IntermediaryAccountUnstakingSyntheticDenom(acc)
+	}
+	_, err := suite.App.LockupKeeper.GetSyntheticLockup(suite.Ctx, lock.ID, synthDenom)
+	return err == nil
+}
+
+func (suite *KeeperTestSuite) TestValidatorSetLockAddTokensAndRefresh() {
+	suite.SetupTest()
+	unbondingDuration := suite.App.StakingKeeper.GetParams(suite.Ctx).UnbondingTime
+	valAddrs := suite.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded})
+	denoms, _ := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20), sdk.NewDec(20)})
+
+	lock, _ := suite.setupValidatorSetDelegation(valAddrs, []string{"0.25", "0.75"}, denoms[0])
+
+	// adding tokens to the lock increases the delegation to every validator by weight
+	owner := lock.OwnerAddress()
+	lockID := suite.LockTokens(owner, sdk.NewCoins(sdk.NewInt64Coin(denoms[0], 1000000)), unbondingDuration)
+	suite.Require().Equal(lock.ID, lockID)
+	suite.checkValidatorSetDelegations(lock, valAddrs, []sdk.Dec{sdk.NewDec(5000000), sdk.NewDec(15000000)})
+
+	// a multiplier change is applied by the refresh
+	suite.App.SuperfluidKeeper.SetOsmoEquivalentMultiplier(suite.Ctx, 2, denoms[0], sdk.NewDec(10))
+	suite.App.SuperfluidKeeper.RefreshIntermediaryDelegationAmounts(suite.Ctx)
+	suite.checkValidatorSetDelegations(lock, valAddrs, []sdk.Dec{sdk.NewDec(2500000), sdk.NewDec(7500000)})
+
+	// the lock votes with its delegations to both validators
+	delegated := sdk.ZeroInt()
+	suite.App.SuperfluidKeeper.IterateDelegations(suite.Ctx, owner, func(_ int64, delegation stakingtypes.DelegationI) bool {
+		delegated = delegated.Add(delegation.GetShares().TruncateInt())
+		return false
+	})
+	suite.Require().Equal(sdk.NewInt(10000000), delegated)
+}
+
+func (suite *KeeperTestSuite) TestSuperfluidUndelegateFromValidatorSet() {
+	suite.SetupTest()
+	valAddrs := suite.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded})
+	denoms, _ := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20), sdk.NewDec(20)})
+	bondDenom := suite.App.StakingKeeper.BondDenom(suite.Ctx)
+	presupplyWithOffset := suite.App.BankKeeper.GetSupplyWithOffset(suite.Ctx, bondDenom)
+
+	lock, _ := suite.setupValidatorSetDelegation(valAddrs, []string{"0.4", "0.6"}, denoms[0])
+
+	// the lock can't be unbonded while bonded
+	err := suite.App.SuperfluidKeeper.SuperfluidUnbondLock(suite.Ctx, lock.ID, lock.Owner)
+	suite.Require().ErrorIs(err, types.ErrBondingLockupNotSupported)
+
+	err = suite.App.SuperfluidKeeper.SuperfluidUndelegate(suite.Ctx, lock.Owner, lock.ID)
+	suite.Require().NoError(err)
+	suite.checkValidatorSetDelegations(lock, valAddrs, []sdk.Dec{sdk.ZeroDec(), sdk.ZeroDec()})
+	suite.Require().Equal(presupplyWithOffset, suite.App.BankKeeper.GetSupplyWithOffset(suite.Ctx, bondDenom))
+
+	// every validator of the set has an unbonding synthetic lock
+	for _, valAddr := range valAddrs {
+		acc := types.NewValidatorSetIntermediaryAccount(denoms[0], valAddr.String(), lock.ID, 0)
+		synthLock, err := suite.App.LockupKeeper.GetSyntheticLockup(suite.Ctx, lock.ID, keeper.IntermediaryAccountUnstakingSyntheticDenom(acc))
+		suite.Require().NoError(err)
+		suite.Require().True(synthLock.IsUnlocking())
+	}
+
+	// undelegating twice fails
+	err = suite.App.SuperfluidKeeper.SuperfluidUndelegate(suite.Ctx, lock.Owner, lock.ID)
+	suite.Require().ErrorIs(err, types.ErrNotSuperfluidUsedLockup)
+
+	// the underlying lock can then be unbonded, and the validator set is dropped once it is unlocked
+	err = suite.App.SuperfluidKeeper.SuperfluidUnbondLock(suite.Ctx, lock.ID, lock.Owner)
+	suite.Require().NoError(err)
+
+	unbondingDuration := suite.App.StakingKeeper.GetParams(suite.Ctx).UnbondingTime
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(unbondingDuration).Add(time.Second))
+	suite.App.LockupKeeper.DeleteAllMaturedSyntheticLocks(suite.Ctx)
+	suite.App.LockupKeeper.WithdrawAllMaturedLocks(suite.Ctx)
+	_, err = suite.App.LockupKeeper.GetLockByID(suite.Ctx, lock.ID)
+	suite.Require().Error(err)
+	_, found := suite.App.SuperfluidKeeper.GetLockValidatorSet(suite.Ctx, lock.ID)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestSlashValidatorSetLock() {
+	suite.SetupTest()
+	valAddrs := suite.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded})
+	denoms, _ := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20), sdk.NewDec(20)})
+
+	lock, _ := suite.setupValidatorSetDelegation(valAddrs, []string{"0.4", "0.6"}, denoms[0])
+	singleLock := suite.setupSuperfluidDelegate(CreateRandomAccounts(1)[0], valAddrs[0], denoms[0], 1000000)
+
+	// slash the first validator
+	slashFactor := sdk.NewDecWithPrec(5, 2)
+	validator, found := suite.App.StakingKeeper.GetValidator(suite.Ctx, valAddrs[0])
+	suite.Require().True(found)
+	suite.Ctx = suite.Ctx.WithBlockHeight(100)
+	consAddr, err := validator.GetConsAddr()
+	suite.Require().NoError(err)
+	power := sdk.TokensToConsensusPower(validator.Tokens, sdk.DefaultPowerReduction)
+	suite.App.StakingKeeper.Slash(suite.Ctx, consAddr, 80, power, slashFactor)
+
+	// the lock delegated to the validator set is only slashed for the weight of the validator
+	gotLock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, lock.ID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(1000000-20000).String(), gotLock.Coins.AmountOf(denoms[0]).String()) // 1000000 x 0.4 x 5%
+
+	gotSingleLock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, singleLock.ID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(1000000-50000).String(), gotSingleLock.Coins.AmountOf(denoms[0]).String()) // 1000000 x 5%
+
+	// the delegations of the validator set follow the slashed lock on refresh
+	suite.App.SuperfluidKeeper.RefreshIntermediaryDelegationAmounts(suite.Ctx)
+	accAddr := types.GetValidatorSetIntermediaryAccountAddr(denoms[0], valAddrs[1].String(), lock.ID)
+	delegation, found := suite.App.StakingKeeper.GetDelegation(suite.Ctx, accAddr, valAddrs[1])
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewDec(5880000), delegation.Shares) // 980000 x 0.6 x 50% x 20
+}
+
+func (suite *KeeperTestSuite) TestSlashValidatorSetLockAfterUpdate() {
+	suite.SetupTest()
+	valAddrs := suite.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded})
+	denoms, _ := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20), sdk.NewDec(20)})
+
+	lock, _ := suite.setupValidatorSetDelegation(valAddrs, []string{"0.4", "0.6"}, denoms[0])
+
+	// moving the whole lock to the second validator does not escape the slashing of the first one
+	err := suite.App.SuperfluidKeeper.SuperfluidUpdateValidatorSet(suite.Ctx, lock.Owner, lock.ID, validatorWeights(valAddrs[1:], []string{"1"}))
+	suite.Require().NoError(err)
+	suite.checkValidatorSetDelegations(lock, valAddrs, []sdk.Dec{sdk.ZeroDec(), sdk.NewDec(10000000)})
+
+	slashFactor := sdk.NewDecWithPrec(5, 2)
+	validator, found := suite.App.StakingKeeper.GetValidator(suite.Ctx, valAddrs[0])
+	suite.Require().True(found)
+	suite.Ctx = suite.Ctx.WithBlockHeight(100)
+	consAddr, err := validator.GetConsAddr()
+	suite.Require().NoError(err)
+	power := sdk.TokensToConsensusPower(validator.Tokens, sdk.DefaultPowerReduction)
+	suite.App.StakingKeeper.Slash(suite.Ctx, consAddr, 80, power, slashFactor)
+
+	gotLock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, lock.ID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(1000000-20000).String(), gotLock.Coins.AmountOf(denoms[0]).String()) // 1000000 x 0.4 x 5%
+
+	// the second validator is slashed for both its bonded weight and the weight it is unbonding after a reduction
+	err = suite.App.SuperfluidKeeper.SuperfluidUpdateValidatorSet(suite.Ctx, lock.Owner, lock.ID, validatorWeights(valAddrs, []string{"0.5", "0.5"}))
+	suite.Require().NoError(err)
+	validator, found = suite.App.StakingKeeper.GetValidator(suite.Ctx, valAddrs[1])
+	suite.Require().True(found)
+	consAddr, err = validator.GetConsAddr()
+	suite.Require().NoError(err)
+	power = sdk.TokensToConsensusPower(validator.Tokens, sdk.DefaultPowerReduction)
+	suite.App.StakingKeeper.Slash(suite.Ctx, consAddr, 80, power, slashFactor)
+
+	gotLock, err = suite.App.LockupKeeper.GetLockByID(suite.Ctx, lock.ID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(980000-49000).String(), gotLock.Coins.AmountOf(denoms[0]).String()) // 980000 x (0.5 + 0.5) x 5%
+}
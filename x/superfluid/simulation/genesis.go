@@ -14,7 +14,10 @@ import (
 func RandomizedGenState(simState *module.SimulationState) {
 	superfluidGenesis := &types.GenesisState{
 		Params: types.Params{
-			MinimumRiskFactor: sdk.NewDecWithPrec(5, 2), // 5%
+			MinimumRiskFactor:   sdk.NewDecWithPrec(5, 2), // 5%
+			MultiplierTwapType:  types.MultiplierTwapTypeArithmetic,
+			MultiplierFallback:  types.MultiplierFallbackSpot,
+			MaxMultiplierChange: sdk.ZeroDec(),
 		},
 		SuperfluidAssets:          []types.SuperfluidAsset{},
 		OsmoEquivalentMultipliers: []types.OsmoEquivalentMultiplierRecord{},
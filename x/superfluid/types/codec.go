@@ -17,6 +17,10 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&SetSuperfluidAssetsProposal{}, "osmosis/set-superfluid-assets-proposal", nil)
 	cdc.RegisterConcrete(&RemoveSuperfluidAssetsProposal{}, "osmosis/del-superfluid-assets-proposal", nil)
 	cdc.RegisterConcrete(&MsgUnPoolWhitelistedPool{}, "osmosis/unpool-whitelisted-pool", nil)
+	cdc.RegisterConcrete(&MsgSuperfluidDelegateToValidatorSet{}, "osmosis/superfluid-delegate-to-validator-set", nil)
+	cdc.RegisterConcrete(&MsgSuperfluidUpdateValidatorSet{}, "osmosis/superfluid-update-validator-set", nil)
+	cdc.RegisterConcrete(&MsgSuperfluidPartialUndelegate{}, "osmosis/superfluid-partial-undelegate", nil)
+	cdc.RegisterConcrete(&MsgSuperfluidUndelegateAndUnbondLock{}, "osmosis/superfluid-undelegate-and-unbond-lock", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -27,6 +31,10 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgLockAndSuperfluidDelegate{},
 		&MsgSuperfluidUnbondLock{},
 		&MsgUnPoolWhitelistedPool{},
+		&MsgSuperfluidDelegateToValidatorSet{},
+		&MsgSuperfluidUpdateValidatorSet{},
+		&MsgSuperfluidPartialUndelegate{},
+		&MsgSuperfluidUndelegateAndUnbondLock{},
 	)
 
 	registry.RegisterImplementations(
@@ -18,6 +18,12 @@ var (
 
 	ErrNonSuperfluidAsset = sdkerrors.Register(ModuleName, 10, "provided asset is not supported for superfluid staking")
 
+	ErrInvalidValidatorSet      = sdkerrors.Register(ModuleName, 11, "invalid superfluid validator set")
+	ErrNotValidatorSetDelegated = sdkerrors.Register(ModuleName, 12, "lockup is not superfluid delegated to a validator set")
+
+	ErrInvalidUndelegationAmount       = sdkerrors.Register(ModuleName, 13, "undelegation amount must be a positive part of the locked tokens")
+	ErrValidatorSetPartialUndelegation = sdkerrors.Register(ModuleName, 14, "partial undelegation of a lockup delegated to a validator set is not supported")
+
 	ErrPoolNotWhitelisted   = sdkerrors.Register(ModuleName, 41, "pool not whitelisted to unpool")
 	ErrLockUnpoolNotAllowed = sdkerrors.Register(ModuleName, 42, "lock not eligible for unpooling")
 	ErrLockLengthMismatch   = sdkerrors.Register(ModuleName, 43, "lock has more than one asset")
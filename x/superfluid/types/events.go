@@ -8,6 +8,10 @@ const (
 	TypeEvtSuperfluidIncreaseDelegation = "superfluid_increase_delegation"
 	TypeEvtSuperfluidUndelegate         = "superfluid_undelegate"
 	TypeEvtSuperfluidUnbondLock         = "superfluid_unbond_lock"
+	TypeEvtSuperfluidUpdateValidatorSet = "superfluid_update_validator_set"
+
+	TypeEvtSuperfluidPartialUndelegate       = "superfluid_partial_undelegate"
+	TypeEvtSuperfluidUndelegateAndUnbondLock = "superfluid_undelegate_and_unbond_lock"
 
 	TypeEvtUnpoolId     = "unpool_pool_id"
 	AttributeNewLockIds = "new_lock_ids"
@@ -16,5 +20,7 @@ const (
 	AttributeSuperfluidAssetType = "superfluid_asset_type"
 	AttributeLockId              = "lock_id"
 	AttributeValidator           = "validator"
+	AttributeWeight              = "weight"
 	AttributeAmount              = "amount"
+	AttributeSplitLockId         = "split_lock_id"
 )
@@ -36,6 +36,9 @@ type GenesisState struct {
 	// plays an intermediary role between validators and the delegators.
 	IntermediaryAccounts          []SuperfluidIntermediaryAccount       `protobuf:"bytes,4,rep,name=intermediary_accounts,json=intermediaryAccounts,proto3" json:"intermediary_accounts"`
 	IntemediaryAccountConnections []LockIdIntermediaryAccountConnection `protobuf:"bytes,5,rep,name=intemediary_account_connections,json=intemediaryAccountConnections,proto3" json:"intemediary_account_connections"`
+	// lock_validator_sets are the weighted validator sets of the locks
+	// superfluid delegated to a validator set.
+	LockValidatorSets []LockValidatorSet `protobuf:"bytes,6,rep,name=lock_validator_sets,json=lockValidatorSets,proto3" json:"lock_validator_sets"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -106,6 +109,13 @@ func (m *GenesisState) GetIntemediaryAccountConnections() []LockIdIntermediaryAc
 	return nil
 }
 
+func (m *GenesisState) GetLockValidatorSets() []LockValidatorSet {
+	if m != nil {
+		return m.LockValidatorSets
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "osmosis.superfluid.GenesisState")
 }
@@ -113,31 +123,34 @@ func init() {
 func init() { proto.RegisterFile("osmosis/superfluid/genesis.proto", fileDescriptor_d5256ebb7c83fff3) }
 
 var fileDescriptor_d5256ebb7c83fff3 = []byte{
-	// 383 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xc1, 0x4e, 0xf2, 0x40,
-	0x10, 0xc7, 0xdb, 0x0f, 0x3e, 0x0e, 0xc5, 0x83, 0x36, 0x98, 0x54, 0x8c, 0x85, 0xc8, 0x85, 0x8b,
-	0x6d, 0xa8, 0x89, 0x7a, 0x05, 0x63, 0x0c, 0x89, 0x46, 0x02, 0x89, 0x07, 0x2f, 0xcd, 0x52, 0xd6,
-	0xba, 0xb1, 0xed, 0xd6, 0x9d, 0x2d, 0x81, 0x07, 0xf0, 0xce, 0x63, 0x71, 0xe4, 0xe8, 0xc9, 0x18,
-	0x78, 0x11, 0xd3, 0x76, 0x2d, 0x28, 0xd5, 0xdb, 0xb4, 0xf3, 0xfb, 0xcf, 0x6f, 0x36, 0x19, 0xa5,
-	0x4e, 0xc1, 0xa7, 0x40, 0xc0, 0x84, 0x28, 0xc4, 0xec, 0xd1, 0x8b, 0xc8, 0xc8, 0x74, 0x71, 0x80,
-	0x81, 0x80, 0x11, 0x32, 0xca, 0xa9, 0xaa, 0x0a, 0xc2, 0x58, 0x13, 0xd5, 0x8a, 0x4b, 0x5d, 0x9a,
-	0xb4, 0xcd, 0xb8, 0x4a, 0xc9, 0x6a, 0x23, 0x67, 0xd6, 0xba, 0x14, 0x50, 0x2d, 0x07, 0x0a, 0x11,
-	0x43, 0xbe, 0xf0, 0x1d, 0xcf, 0x8a, 0xca, 0xce, 0x75, 0xba, 0xc1, 0x80, 0x23, 0x8e, 0xd5, 0x0b,
-	0xa5, 0x94, 0x02, 0x9a, 0x5c, 0x97, 0x9b, 0x65, 0xab, 0x6a, 0x6c, 0x6f, 0x64, 0xf4, 0x12, 0xa2,
-	0x53, 0x9c, 0xbf, 0xd7, 0xa4, 0xbe, 0xe0, 0xd5, 0x7b, 0x65, 0x6f, 0x8d, 0xd8, 0x08, 0x00, 0x73,
-	0xd0, 0xfe, 0xd5, 0x0b, 0xcd, 0xb2, 0xd5, 0xc8, 0x1b, 0x32, 0xc8, 0xca, 0x76, 0xcc, 0x8a, 0x69,
-	0xbb, 0xf0, 0xfd, 0x37, 0xa8, 0x13, 0xe5, 0x30, 0x4e, 0xdb, 0xf8, 0x25, 0x22, 0x63, 0xe4, 0xe1,
-	0x80, 0xdb, 0x7e, 0xe4, 0x71, 0x12, 0x7a, 0x04, 0x33, 0xd0, 0x0a, 0x89, 0xc1, 0xca, 0x33, 0xdc,
-	0x81, 0x4f, 0xaf, 0xb2, 0xd4, 0x6d, 0x16, 0xea, 0x63, 0x87, 0xb2, 0x91, 0x10, 0x1e, 0xd0, 0x5f,
-	0x28, 0x50, 0x3d, 0x65, 0x9f, 0x04, 0x1c, 0x33, 0x1f, 0x8f, 0x08, 0x62, 0x53, 0x1b, 0x39, 0x0e,
-	0x8d, 0x02, 0x0e, 0x5a, 0x31, 0x71, 0xb6, 0xfe, 0x7e, 0x55, 0x77, 0x23, 0xda, 0x4e, 0x93, 0x42,
-	0x59, 0x21, 0xdb, 0x2d, 0x50, 0x5f, 0x65, 0xa5, 0x16, 0x37, 0x7e, 0xd8, 0x6c, 0x87, 0x06, 0x01,
-	0x76, 0x38, 0xa1, 0x01, 0x68, 0xff, 0x13, 0xf1, 0x79, 0x9e, 0xf8, 0x86, 0x3a, 0xcf, 0xdd, 0x3c,
-	0xe9, 0x65, 0x96, 0x17, 0xfa, 0xa3, 0x0d, 0xcb, 0x16, 0x03, 0x9d, 0xde, 0x7c, 0xa9, 0xcb, 0x8b,
-	0xa5, 0x2e, 0x7f, 0x2c, 0x75, 0x79, 0xb6, 0xd2, 0xa5, 0xc5, 0x4a, 0x97, 0xde, 0x56, 0xba, 0xf4,
-	0x70, 0xe6, 0x12, 0xfe, 0x14, 0x0d, 0x0d, 0x87, 0xfa, 0xa6, 0xd8, 0xe0, 0xc4, 0x43, 0x43, 0xf8,
-	0xfa, 0x30, 0xc7, 0x2d, 0xcb, 0x9c, 0x6c, 0xde, 0x1a, 0x9f, 0x86, 0x18, 0x86, 0xa5, 0xe4, 0xd6,
-	0x4e, 0x3f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x3f, 0x81, 0x5c, 0x80, 0xff, 0x02, 0x00, 0x00,
+	// 418 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0x1b, 0x56, 0x7a, 0xf0, 0x38, 0x30, 0x33, 0xa4, 0x50, 0x44, 0x5a, 0x31, 0x0e, 0xbb,
+	0x90, 0x68, 0x41, 0x02, 0xae, 0x1b, 0x42, 0x68, 0x12, 0x88, 0x69, 0x95, 0x76, 0xd8, 0x25, 0x72,
+	0x1d, 0x13, 0xac, 0x39, 0x79, 0xc1, 0xcf, 0xa9, 0xb6, 0x0f, 0xc0, 0x9d, 0x8f, 0xb5, 0xe3, 0x8e,
+	0x9c, 0x10, 0x6a, 0xaf, 0x7c, 0x08, 0x14, 0xc7, 0x4b, 0xcb, 0x9a, 0x72, 0x7b, 0xf1, 0xfb, 0xfd,
+	0xdf, 0xcf, 0x91, 0x1f, 0x19, 0x03, 0xe6, 0x80, 0x12, 0x23, 0xac, 0x4a, 0xa1, 0xbf, 0xa8, 0x4a,
+	0xa6, 0x51, 0x26, 0x0a, 0x81, 0x12, 0xc3, 0x52, 0x83, 0x01, 0x4a, 0x1d, 0x11, 0x2e, 0x89, 0xe1,
+	0x6e, 0x06, 0x19, 0xd8, 0x76, 0x54, 0x57, 0x0d, 0x39, 0xdc, 0xeb, 0x98, 0xb5, 0x2c, 0x1d, 0x34,
+	0xea, 0x80, 0x4a, 0xa6, 0x59, 0xee, 0x7c, 0xcf, 0xff, 0xf4, 0xc9, 0x83, 0x0f, 0xcd, 0x0d, 0x26,
+	0x86, 0x19, 0x41, 0xdf, 0x92, 0x41, 0x03, 0xf8, 0xde, 0xd8, 0xdb, 0xdf, 0x8e, 0x87, 0xe1, 0xfa,
+	0x8d, 0xc2, 0x13, 0x4b, 0x1c, 0xf5, 0xaf, 0x7f, 0x8d, 0x7a, 0xa7, 0x8e, 0xa7, 0x67, 0x64, 0x67,
+	0x89, 0x24, 0x0c, 0x51, 0x18, 0xf4, 0xef, 0x8d, 0xb7, 0xf6, 0xb7, 0xe3, 0xbd, 0xae, 0x21, 0x93,
+	0xb6, 0x3c, 0xac, 0x59, 0x37, 0xed, 0x21, 0xfe, 0x7b, 0x8c, 0xf4, 0x92, 0x3c, 0xad, 0xd3, 0x89,
+	0xf8, 0x56, 0xc9, 0x19, 0x53, 0xa2, 0x30, 0x49, 0x5e, 0x29, 0x23, 0x4b, 0x25, 0x85, 0x46, 0x7f,
+	0xcb, 0x1a, 0xe2, 0x2e, 0xc3, 0x67, 0xcc, 0xe1, 0x7d, 0x9b, 0xfa, 0xd4, 0x86, 0x4e, 0x05, 0x07,
+	0x9d, 0x3a, 0xe1, 0x13, 0xd8, 0x40, 0x21, 0x55, 0xe4, 0xb1, 0x2c, 0x8c, 0xd0, 0xb9, 0x48, 0x25,
+	0xd3, 0x57, 0x09, 0xe3, 0x1c, 0xaa, 0xc2, 0xa0, 0xdf, 0xb7, 0xce, 0x83, 0xff, 0xff, 0xd5, 0xf1,
+	0x4a, 0xf4, 0xb0, 0x49, 0x3a, 0xe5, 0xae, 0x5c, 0x6f, 0x21, 0xfd, 0xee, 0x91, 0x51, 0xdd, 0xb8,
+	0x63, 0x4b, 0x38, 0x14, 0x85, 0xe0, 0x46, 0x42, 0x81, 0xfe, 0x7d, 0x2b, 0x7e, 0xd3, 0x25, 0xfe,
+	0x08, 0xfc, 0xe2, 0xb8, 0x4b, 0xfa, 0xae, 0xcd, 0x3b, 0xfd, 0xb3, 0x15, 0xcb, 0x1a, 0x83, 0xf4,
+	0x9c, 0x3c, 0x52, 0xc0, 0x2f, 0x92, 0x19, 0x53, 0x32, 0x65, 0x06, 0x74, 0x62, 0x5f, 0x72, 0x60,
+	0xd5, 0x2f, 0x36, 0xa9, 0xcf, 0x6e, 0xe9, 0x49, 0xfb, 0x94, 0x3b, 0xea, 0xce, 0x39, 0x1e, 0x9d,
+	0x5c, 0xcf, 0x03, 0xef, 0x66, 0x1e, 0x78, 0xbf, 0xe7, 0x81, 0xf7, 0x63, 0x11, 0xf4, 0x6e, 0x16,
+	0x41, 0xef, 0xe7, 0x22, 0xe8, 0x9d, 0xbf, 0xce, 0xa4, 0xf9, 0x5a, 0x4d, 0x43, 0x0e, 0x79, 0xe4,
+	0x14, 0x2f, 0x15, 0x9b, 0xe2, 0xed, 0x47, 0x34, 0x3b, 0x88, 0xa3, 0xcb, 0xd5, 0x3d, 0x36, 0x57,
+	0xa5, 0xc0, 0xe9, 0xc0, 0xee, 0xf1, 0xab, 0xbf, 0x03, 0x00, 0x35, 0xf2, 0xaf, 0x4f, 0x5b, 0x03,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -160,6 +173,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.LockValidatorSets) > 0 {
+		for iNdEx := len(m.LockValidatorSets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockValidatorSets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.IntemediaryAccountConnections) > 0 {
 		for iNdEx := len(m.IntemediaryAccountConnections) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -272,6 +299,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.LockValidatorSets) > 0 {
+		for _, e := range m.LockValidatorSets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -479,6 +512,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockValidatorSets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockValidatorSets = append(m.LockValidatorSets, LockValidatorSet{})
+			if err := m.LockValidatorSets[len(m.LockValidatorSets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -27,4 +27,7 @@ var (
 
 	// KeyUnpoolAllowedPools defines key to unpool allowed pools.
 	KeyUnpoolAllowedPools = []byte{0x06}
+
+	// KeyPrefixLockValidatorSet defines prefix to connect lockId and the validator set it is superfluid delegated to.
+	KeyPrefixLockValidatorSet = []byte{0x07}
 )
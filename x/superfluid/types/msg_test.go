@@ -56,6 +56,43 @@ func TestAuthzMsg(t *testing.T) {
 				LockId: 1,
 			},
 		},
+		{
+			name: "MsgSuperfluidDelegateToValidatorSet",
+			msg: &types.MsgSuperfluidDelegateToValidatorSet{
+				Sender: addr1,
+				LockId: 1,
+				Validators: []types.ValidatorWeight{
+					{ValAddr: "valoper1xyz", Weight: sdk.NewDecWithPrec(4, 1)},
+					{ValAddr: "valoper1abc", Weight: sdk.NewDecWithPrec(6, 1)},
+				},
+			},
+		},
+		{
+			name: "MsgSuperfluidUpdateValidatorSet",
+			msg: &types.MsgSuperfluidUpdateValidatorSet{
+				Sender: addr1,
+				LockId: 1,
+				Validators: []types.ValidatorWeight{
+					{ValAddr: "valoper1xyz", Weight: sdk.OneDec()},
+				},
+			},
+		},
+		{
+			name: "MsgSuperfluidPartialUndelegate",
+			msg: &types.MsgSuperfluidPartialUndelegate{
+				Sender: addr1,
+				LockId: 1,
+				Coin:   sdk.NewInt64Coin("gamm/pool/1", 100),
+			},
+		},
+		{
+			name: "MsgSuperfluidUndelegateAndUnbondLock",
+			msg: &types.MsgSuperfluidUndelegateAndUnbondLock{
+				Sender: addr1,
+				LockId: 1,
+				Coin:   sdk.NewInt64Coin("gamm/pool/1", 100),
+			},
+		},
 		{
 			name: "MsgUnPoolWhitelistedPool",
 			msg: &types.MsgUnPoolWhitelistedPool{
@@ -15,6 +15,12 @@ const (
 	TypeMsgSuperfluidUnbondLock      = "superfluid_unbond_underlying_lock"
 	TypeMsgLockAndSuperfluidDelegate = "lock_and_superfluid_delegate"
 	TypeMsgUnPoolWhitelistedPool     = "unpool_whitelisted_pool"
+
+	TypeMsgSuperfluidDelegateToValidatorSet = "superfluid_delegate_to_validator_set"
+	TypeMsgSuperfluidUpdateValidatorSet     = "superfluid_update_validator_set"
+
+	TypeMsgSuperfluidPartialUndelegate       = "superfluid_partial_undelegate"
+	TypeMsgSuperfluidUndelegateAndUnbondLock = "superfluid_undelegate_and_unbond_lock"
 )
 
 var _ sdk.Msg = &MsgSuperfluidDelegate{}
@@ -219,3 +225,143 @@ func (msg MsgUnPoolWhitelistedPool) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{sender}
 }
+
+var _ sdk.Msg = &MsgSuperfluidDelegateToValidatorSet{}
+
+// NewMsgSuperfluidDelegateToValidatorSet creates a message to do superfluid delegation to a weighted validator set.
+func NewMsgSuperfluidDelegateToValidatorSet(sender sdk.AccAddress, lockId uint64, validators []ValidatorWeight) *MsgSuperfluidDelegateToValidatorSet {
+	return &MsgSuperfluidDelegateToValidatorSet{
+		Sender:     sender.String(),
+		LockId:     lockId,
+		Validators: validators,
+	}
+}
+
+func (m MsgSuperfluidDelegateToValidatorSet) Route() string { return RouterKey }
+func (m MsgSuperfluidDelegateToValidatorSet) Type() string {
+	return TypeMsgSuperfluidDelegateToValidatorSet
+}
+
+func (m MsgSuperfluidDelegateToValidatorSet) ValidateBasic() error {
+	if m.Sender == "" {
+		return fmt.Errorf("sender should not be an empty address")
+	}
+	if m.LockId == 0 {
+		return fmt.Errorf("lock id should be positive: %d < 0", m.LockId)
+	}
+	return ValidateValidatorWeights(m.Validators)
+}
+
+func (m MsgSuperfluidDelegateToValidatorSet) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSuperfluidDelegateToValidatorSet) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSuperfluidUpdateValidatorSet{}
+
+// NewMsgSuperfluidUpdateValidatorSet creates a message to change the validator set a lock is superfluid delegated to.
+func NewMsgSuperfluidUpdateValidatorSet(sender sdk.AccAddress, lockId uint64, validators []ValidatorWeight) *MsgSuperfluidUpdateValidatorSet {
+	return &MsgSuperfluidUpdateValidatorSet{
+		Sender:     sender.String(),
+		LockId:     lockId,
+		Validators: validators,
+	}
+}
+
+func (m MsgSuperfluidUpdateValidatorSet) Route() string { return RouterKey }
+func (m MsgSuperfluidUpdateValidatorSet) Type() string  { return TypeMsgSuperfluidUpdateValidatorSet }
+func (m MsgSuperfluidUpdateValidatorSet) ValidateBasic() error {
+	if m.Sender == "" {
+		return fmt.Errorf("sender should not be an empty address")
+	}
+	if m.LockId == 0 {
+		return fmt.Errorf("lock id should be positive: %d < 0", m.LockId)
+	}
+	return ValidateValidatorWeights(m.Validators)
+}
+
+func (m MsgSuperfluidUpdateValidatorSet) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSuperfluidUpdateValidatorSet) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSuperfluidPartialUndelegate{}
+
+// NewMsgSuperfluidPartialUndelegate creates a message to superfluid undelegate a part of a lock.
+func NewMsgSuperfluidPartialUndelegate(sender sdk.AccAddress, lockId uint64, coin sdk.Coin) *MsgSuperfluidPartialUndelegate {
+	return &MsgSuperfluidPartialUndelegate{
+		Sender: sender.String(),
+		LockId: lockId,
+		Coin:   coin,
+	}
+}
+
+func (m MsgSuperfluidPartialUndelegate) Route() string { return RouterKey }
+func (m MsgSuperfluidPartialUndelegate) Type() string  { return TypeMsgSuperfluidPartialUndelegate }
+func (m MsgSuperfluidPartialUndelegate) ValidateBasic() error {
+	if m.Sender == "" {
+		return fmt.Errorf("sender should not be an empty address")
+	}
+	if m.LockId == 0 {
+		return fmt.Errorf("lock id should be positive: %d < 0", m.LockId)
+	}
+	if !m.Coin.IsValid() || !m.Coin.IsPositive() {
+		return fmt.Errorf("amount to undelegate should be a positive coin: %s", m.Coin)
+	}
+	return nil
+}
+
+func (m MsgSuperfluidPartialUndelegate) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSuperfluidPartialUndelegate) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSuperfluidUndelegateAndUnbondLock{}
+
+// NewMsgSuperfluidUndelegateAndUnbondLock creates a message to superfluid undelegate a part of a lock,
+// and unbond the lock holding the undelegated part.
+func NewMsgSuperfluidUndelegateAndUnbondLock(sender sdk.AccAddress, lockId uint64, coin sdk.Coin) *MsgSuperfluidUndelegateAndUnbondLock {
+	return &MsgSuperfluidUndelegateAndUnbondLock{
+		Sender: sender.String(),
+		LockId: lockId,
+		Coin:   coin,
+	}
+}
+
+func (m MsgSuperfluidUndelegateAndUnbondLock) Route() string { return RouterKey }
+func (m MsgSuperfluidUndelegateAndUnbondLock) Type() string {
+	return TypeMsgSuperfluidUndelegateAndUnbondLock
+}
+func (m MsgSuperfluidUndelegateAndUnbondLock) ValidateBasic() error {
+	if m.Sender == "" {
+		return fmt.Errorf("sender should not be an empty address")
+	}
+	if m.LockId == 0 {
+		return fmt.Errorf("lock id should be positive: %d < 0", m.LockId)
+	}
+	if !m.Coin.IsValid() || !m.Coin.IsPositive() {
+		return fmt.Errorf("amount to undelegate should be a positive coin: %s", m.Coin)
+	}
+	return nil
+}
+
+func (m MsgSuperfluidUndelegateAndUnbondLock) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSuperfluidUndelegateAndUnbondLock) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
@@ -12,6 +12,11 @@ import (
 var (
 	KeyMinimumRiskFactor     = []byte("MinimumRiskFactor")
 	defaultMinimumRiskFactor = sdk.NewDecWithPrec(5, 1) // 50%
+
+	KeyMultiplierTwapType      = []byte("MultiplierTwapType")
+	KeyMultiplierFallback      = []byte("MultiplierFallback")
+	KeyMaxMultiplierChange     = []byte("MaxMultiplierChange")
+	defaultMaxMultiplierChange = sdk.ZeroDec() // no limit
 )
 
 // ParamTable for minting module.
@@ -19,28 +24,46 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
-func NewParams(minimumRiskFactor sdk.Dec) Params {
+func NewParams(minimumRiskFactor sdk.Dec, multiplierTwapType MultiplierTwapType, multiplierFallback MultiplierFallback, maxMultiplierChange sdk.Dec) Params {
 	return Params{
-		MinimumRiskFactor: minimumRiskFactor,
+		MinimumRiskFactor:   minimumRiskFactor,
+		MultiplierTwapType:  multiplierTwapType,
+		MultiplierFallback:  multiplierFallback,
+		MaxMultiplierChange: maxMultiplierChange,
 	}
 }
 
 // default minting module parameters.
 func DefaultParams() Params {
 	return Params{
-		MinimumRiskFactor: defaultMinimumRiskFactor, // 5%
+		MinimumRiskFactor:   defaultMinimumRiskFactor, // 5%
+		MultiplierTwapType:  MultiplierTwapTypeArithmetic,
+		MultiplierFallback:  MultiplierFallbackSpot,
+		MaxMultiplierChange: defaultMaxMultiplierChange,
 	}
 }
 
 // validate params.
 func (p Params) Validate() error {
-	return nil
+	if err := ValidateMinimumRiskFactor(p.MinimumRiskFactor); err != nil {
+		return err
+	}
+	if err := ValidateMultiplierTwapType(p.MultiplierTwapType); err != nil {
+		return err
+	}
+	if err := ValidateMultiplierFallback(p.MultiplierFallback); err != nil {
+		return err
+	}
+	return ValidateMaxMultiplierChange(p.MaxMultiplierChange)
 }
 
 // Implements params.ParamSet.
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyMinimumRiskFactor, &p.MinimumRiskFactor, ValidateMinimumRiskFactor),
+		paramtypes.NewParamSetPair(KeyMultiplierTwapType, &p.MultiplierTwapType, ValidateMultiplierTwapType),
+		paramtypes.NewParamSetPair(KeyMultiplierFallback, &p.MultiplierFallback, ValidateMultiplierFallback),
+		paramtypes.NewParamSetPair(KeyMaxMultiplierChange, &p.MaxMultiplierChange, ValidateMaxMultiplierChange),
 	}
 }
 
@@ -57,6 +80,45 @@ func ValidateMinimumRiskFactor(i interface{}) error {
 	return nil
 }
 
+func ValidateMultiplierTwapType(i interface{}) error {
+	v, ok := i.(MultiplierTwapType)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := MultiplierTwapType_name[int32(v)]; !ok {
+		return fmt.Errorf("invalid multiplier twap type: %d", v)
+	}
+
+	return nil
+}
+
+func ValidateMultiplierFallback(i interface{}) error {
+	v, ok := i.(MultiplierFallback)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := MultiplierFallback_name[int32(v)]; !ok {
+		return fmt.Errorf("invalid multiplier fallback: %d", v)
+	}
+
+	return nil
+}
+
+func ValidateMaxMultiplierChange(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("max multiplier change should be between 0 - 1: %s", v)
+	}
+
+	return nil
+}
+
 func ValidateUnbondingDuration(i interface{}) error {
 	v, ok := i.(time.Duration)
 	if !ok {
@@ -25,6 +25,63 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// MultiplierTwapType is the type of twap OSMO equivalent multipliers are
+// computed from.
+type MultiplierTwapType int32
+
+const (
+	MultiplierTwapTypeArithmetic MultiplierTwapType = 0
+	MultiplierTwapTypeGeometric  MultiplierTwapType = 1
+)
+
+var MultiplierTwapType_name = map[int32]string{
+	0: "MultiplierTwapTypeArithmetic",
+	1: "MultiplierTwapTypeGeometric",
+}
+
+var MultiplierTwapType_value = map[string]int32{
+	"MultiplierTwapTypeArithmetic": 0,
+	"MultiplierTwapTypeGeometric":  1,
+}
+
+func (x MultiplierTwapType) String() string {
+	return proto.EnumName(MultiplierTwapType_name, int32(x))
+}
+
+func (MultiplierTwapType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0985261dfaf2a82e, []int{0}
+}
+
+// MultiplierFallback is how OSMO equivalent multipliers are computed when the
+// twap can't be.
+type MultiplierFallback int32
+
+const (
+	// use the spot prices at the epoch boundary.
+	MultiplierFallbackSpot MultiplierFallback = 0
+	// keep the multiplier of the previous epoch, or use the spot prices if there
+	// is none.
+	MultiplierFallbackPrevious MultiplierFallback = 1
+)
+
+var MultiplierFallback_name = map[int32]string{
+	0: "MultiplierFallbackSpot",
+	1: "MultiplierFallbackPrevious",
+}
+
+var MultiplierFallback_value = map[string]int32{
+	"MultiplierFallbackSpot":     0,
+	"MultiplierFallbackPrevious": 1,
+}
+
+func (x MultiplierFallback) String() string {
+	return proto.EnumName(MultiplierFallback_name, int32(x))
+}
+
+func (MultiplierFallback) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0985261dfaf2a82e, []int{1}
+}
+
 // Params holds parameters for the superfluid module
 type Params struct {
 	// minimum_risk_factor is to be cut on OSMO equivalent value of lp tokens for
@@ -32,6 +89,16 @@ type Params struct {
 	// to counter-balance the staked amount on chain's exposure to various asset
 	// volatilities, and have base staking be 'resistant' to volatility.
 	MinimumRiskFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=minimum_risk_factor,json=minimumRiskFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"minimum_risk_factor" yaml:"minimum_risk_factor"`
+	// multiplier_twap_type is the type of twap of the pool assets prices over an
+	// epoch, the OSMO equivalent multipliers of LP shares are computed from.
+	MultiplierTwapType MultiplierTwapType `protobuf:"varint,2,opt,name=multiplier_twap_type,json=multiplierTwapType,proto3,enum=osmosis.superfluid.MultiplierTwapType" json:"multiplier_twap_type,omitempty" yaml:"multiplier_twap_type"`
+	// multiplier_fallback is used when the twap can't be computed, e.g. for pools
+	// created during the epoch.
+	MultiplierFallback MultiplierFallback `protobuf:"varint,3,opt,name=multiplier_fallback,json=multiplierFallback,proto3,enum=osmosis.superfluid.MultiplierFallback" json:"multiplier_fallback,omitempty" yaml:"multiplier_fallback"`
+	// max_multiplier_change is the maximum relative change of an OSMO equivalent
+	// multiplier from an epoch to the next one, e.g. 0.1 for 10%. Zero means no
+	// limit.
+	MaxMultiplierChange github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=max_multiplier_change,json=maxMultiplierChange,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_multiplier_change" yaml:"max_multiplier_change"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -67,31 +134,59 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetMultiplierTwapType() MultiplierTwapType {
+	if m != nil {
+		return m.MultiplierTwapType
+	}
+	return MultiplierTwapTypeArithmetic
+}
+
+func (m *Params) GetMultiplierFallback() MultiplierFallback {
+	if m != nil {
+		return m.MultiplierFallback
+	}
+	return MultiplierFallbackSpot
+}
+
 func init() {
+	proto.RegisterEnum("osmosis.superfluid.MultiplierTwapType", MultiplierTwapType_name, MultiplierTwapType_value)
+	proto.RegisterEnum("osmosis.superfluid.MultiplierFallback", MultiplierFallback_name, MultiplierFallback_value)
 	proto.RegisterType((*Params)(nil), "osmosis.superfluid.Params")
 }
 
 func init() { proto.RegisterFile("osmosis/superfluid/params.proto", fileDescriptor_0985261dfaf2a82e) }
 
 var fileDescriptor_0985261dfaf2a82e = []byte{
-	// 260 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcf, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0x2f, 0x2e, 0x2d, 0x48, 0x2d, 0x4a, 0xcb, 0x29, 0xcd, 0x4c, 0xd1, 0x2f,
-	0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x82, 0x2a, 0xd0,
-	0x43, 0x28, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x4b, 0xeb, 0x83, 0x58, 0x10, 0x95, 0x52,
-	0x72, 0xe9, 0xf9, 0xf9, 0xe9, 0x39, 0xa9, 0xfa, 0x60, 0x5e, 0x52, 0x69, 0x9a, 0x7e, 0x4a, 0x69,
-	0x51, 0x62, 0x49, 0x66, 0x7e, 0x1e, 0x44, 0x5e, 0xa9, 0x8d, 0x91, 0x8b, 0x2d, 0x00, 0x6c, 0xb4,
-	0x50, 0x0d, 0x97, 0x70, 0x6e, 0x66, 0x5e, 0x66, 0x6e, 0x69, 0x6e, 0x7c, 0x51, 0x66, 0x71, 0x76,
-	0x7c, 0x5a, 0x62, 0x72, 0x49, 0x7e, 0x91, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xa7, 0x93, 0xcf, 0x89,
-	0x7b, 0xf2, 0x0c, 0xb7, 0xee, 0xc9, 0xab, 0xa5, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7,
-	0xe7, 0xea, 0x27, 0x83, 0x5d, 0x01, 0xa5, 0x74, 0x8b, 0x53, 0xb2, 0xf5, 0x4b, 0x2a, 0x0b, 0x52,
-	0x8b, 0xf5, 0x5c, 0x52, 0x93, 0x3f, 0xdd, 0x93, 0x97, 0xaa, 0x4c, 0xcc, 0xcd, 0xb1, 0x52, 0xc2,
-	0x62, 0xa4, 0x52, 0x90, 0x20, 0x54, 0x34, 0x28, 0xb3, 0x38, 0xdb, 0x0d, 0x2c, 0xe6, 0x14, 0x70,
-	0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7,
-	0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x66, 0x48, 0x56, 0x42, 0xfd, 0xad,
-	0x9b, 0x93, 0x98, 0x54, 0x0c, 0xe3, 0xe8, 0x97, 0x19, 0x1a, 0xe9, 0x57, 0x20, 0x87, 0x15, 0xd8,
-	0x19, 0x49, 0x6c, 0x60, 0x1f, 0x1a, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0xdf, 0xda, 0x5d, 0xb3,
-	0x4e, 0x01, 0x00, 0x00,
+	// 463 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x41, 0x6b, 0x13, 0x41,
+	0x14, 0xde, 0xb5, 0xa5, 0xe0, 0x1c, 0x24, 0x4e, 0xaa, 0x84, 0x6d, 0x99, 0x0d, 0x7b, 0x28, 0xa5,
+	0xd0, 0x1d, 0xac, 0xe0, 0xc1, 0x9b, 0x51, 0xea, 0x45, 0x25, 0xc4, 0xe2, 0x41, 0x90, 0x65, 0x76,
+	0x33, 0xd9, 0x0c, 0xd9, 0xc9, 0x0c, 0x33, 0xb3, 0x4d, 0x02, 0x9e, 0x3c, 0x79, 0xf4, 0x3f, 0xf8,
+	0x67, 0x7a, 0xec, 0x51, 0x3c, 0x2c, 0x92, 0xfc, 0x83, 0xfe, 0x01, 0xa5, 0xd3, 0x4d, 0xbb, 0x32,
+	0x39, 0xe8, 0x69, 0xf7, 0x7d, 0xef, 0x7b, 0xdf, 0x7b, 0xef, 0x1b, 0x1e, 0x08, 0x85, 0xe6, 0x42,
+	0x33, 0x8d, 0x75, 0x29, 0xa9, 0x1a, 0x15, 0x25, 0x1b, 0x62, 0x49, 0x14, 0xe1, 0x3a, 0x96, 0x4a,
+	0x18, 0x01, 0x61, 0x4d, 0x88, 0xef, 0x08, 0xc1, 0x6e, 0x2e, 0x72, 0x61, 0xd3, 0xf8, 0xfa, 0xef,
+	0x86, 0x19, 0xa0, 0x5c, 0x88, 0xbc, 0xa0, 0xd8, 0x46, 0x69, 0x39, 0xc2, 0xc3, 0x52, 0x11, 0xc3,
+	0xc4, 0xf4, 0x26, 0x1f, 0xfd, 0xde, 0x02, 0x3b, 0x7d, 0x2b, 0x0d, 0x3f, 0x83, 0x36, 0x67, 0x53,
+	0xc6, 0x4b, 0x9e, 0x28, 0xa6, 0x27, 0xc9, 0x88, 0x64, 0x46, 0xa8, 0x8e, 0xdf, 0xf5, 0x0f, 0xef,
+	0xf7, 0xde, 0x5c, 0x54, 0xa1, 0xf7, 0xb3, 0x0a, 0x0f, 0x72, 0x66, 0xc6, 0x65, 0x1a, 0x67, 0x82,
+	0xe3, 0xcc, 0x4e, 0x51, 0x7f, 0x8e, 0xf5, 0x70, 0x82, 0xcd, 0x42, 0x52, 0x1d, 0xbf, 0xa2, 0xd9,
+	0x55, 0x15, 0x06, 0x0b, 0xc2, 0x8b, 0xe7, 0xd1, 0x06, 0xc9, 0x68, 0xf0, 0xb0, 0x46, 0x07, 0x4c,
+	0x4f, 0x4e, 0x2d, 0x06, 0x17, 0x60, 0x97, 0x97, 0x85, 0x61, 0xb2, 0x60, 0x54, 0x25, 0x66, 0x46,
+	0x64, 0x72, 0xad, 0xd6, 0xb9, 0xd7, 0xf5, 0x0f, 0x1f, 0x9c, 0x1c, 0xc4, 0xee, 0xc6, 0xf1, 0xdb,
+	0x5b, 0xfe, 0xd9, 0x8c, 0xc8, 0xb3, 0x85, 0xa4, 0xbd, 0xf0, 0xaa, 0x0a, 0xf7, 0xea, 0xc6, 0x1b,
+	0xd4, 0xa2, 0x01, 0xe4, 0x4e, 0x11, 0x9c, 0x81, 0x76, 0x83, 0x3c, 0x22, 0x45, 0x91, 0x92, 0x6c,
+	0xd2, 0xd9, 0xfa, 0x97, 0xce, 0xa7, 0x35, 0xbb, 0x87, 0x1a, 0x2b, 0xbb, 0x62, 0x7f, 0x35, 0x5e,
+	0xd7, 0xc0, 0x2f, 0x3e, 0x78, 0xc4, 0xc9, 0x3c, 0x69, 0x14, 0x64, 0x63, 0x32, 0xcd, 0x69, 0x67,
+	0xdb, 0x9a, 0xfe, 0xee, 0xbf, 0x4d, 0xdf, 0xaf, 0x27, 0xd8, 0x24, 0x1a, 0x0d, 0xda, 0x9c, 0xcc,
+	0xef, 0x46, 0x7f, 0x69, 0xd1, 0xa3, 0x4f, 0x00, 0xba, 0x46, 0xc2, 0x2e, 0xd8, 0x77, 0xd1, 0x17,
+	0x8a, 0x99, 0x31, 0xa7, 0x86, 0x65, 0x2d, 0x0f, 0x86, 0x60, 0xcf, 0x65, 0xbc, 0xa6, 0x82, 0x53,
+	0xa3, 0x58, 0xd6, 0xf2, 0x83, 0xed, 0xaf, 0xdf, 0x91, 0x77, 0xf4, 0xa1, 0x29, 0x7f, 0xbb, 0x79,
+	0x00, 0x1e, 0xbb, 0xe8, 0x7b, 0x29, 0x4c, 0xcb, 0x83, 0x08, 0x04, 0x6e, 0xae, 0xaf, 0xe8, 0x39,
+	0x13, 0xa5, 0x5e, 0xeb, 0xf6, 0xfa, 0x17, 0x4b, 0xe4, 0x5f, 0x2e, 0x91, 0xff, 0x6b, 0x89, 0xfc,
+	0x6f, 0x2b, 0xe4, 0x5d, 0xae, 0x90, 0xf7, 0x63, 0x85, 0xbc, 0x8f, 0xcf, 0x1a, 0x6e, 0xd5, 0x6f,
+	0x77, 0x5c, 0x90, 0x54, 0xaf, 0x03, 0x7c, 0xfe, 0xe4, 0x04, 0xcf, 0x9b, 0xb7, 0x65, 0x1d, 0x4c,
+	0x77, 0xec, 0x45, 0x3c, 0xfd, 0x33, 0x00, 0x1b, 0xaf, 0x36, 0x27, 0x7e, 0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -114,6 +209,26 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MaxMultiplierChange.Size()
+		i -= size
+		if _, err := m.MaxMultiplierChange.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.MultiplierFallback != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MultiplierFallback))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MultiplierTwapType != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MultiplierTwapType))
+		i--
+		dAtA[i] = 0x10
+	}
 	{
 		size := m.MinimumRiskFactor.Size()
 		i -= size
@@ -146,6 +261,14 @@ func (m *Params) Size() (n int) {
 	_ = l
 	l = m.MinimumRiskFactor.Size()
 	n += 1 + l + sovParams(uint64(l))
+	if m.MultiplierTwapType != 0 {
+		n += 1 + sovParams(uint64(m.MultiplierTwapType))
+	}
+	if m.MultiplierFallback != 0 {
+		n += 1 + sovParams(uint64(m.MultiplierFallback))
+	}
+	l = m.MaxMultiplierChange.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -218,6 +341,78 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultiplierTwapType", wireType)
+			}
+			m.MultiplierTwapType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MultiplierTwapType |= MultiplierTwapType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultiplierFallback", wireType)
+			}
+			m.MultiplierFallback = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MultiplierFallback |= MultiplierFallback(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMultiplierChange", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxMultiplierChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
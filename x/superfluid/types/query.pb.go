@@ -624,6 +624,94 @@ func (m *ConnectedIntermediaryAccountResponse) GetAccount() *SuperfluidIntermedi
 	return nil
 }
 
+type LockValidatorSetRequest struct {
+	LockId uint64 `protobuf:"varint,1,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
+}
+
+func (m *LockValidatorSetRequest) Reset()         { *m = LockValidatorSetRequest{} }
+func (m *LockValidatorSetRequest) String() string { return proto.CompactTextString(m) }
+func (*LockValidatorSetRequest) ProtoMessage()    {}
+func (*LockValidatorSetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3d9448e4ed3943f, []int{13}
+}
+func (m *LockValidatorSetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockValidatorSetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockValidatorSetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LockValidatorSetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockValidatorSetRequest.Merge(m, src)
+}
+func (m *LockValidatorSetRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockValidatorSetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockValidatorSetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockValidatorSetRequest proto.InternalMessageInfo
+
+func (m *LockValidatorSetRequest) GetLockId() uint64 {
+	if m != nil {
+		return m.LockId
+	}
+	return 0
+}
+
+type LockValidatorSetResponse struct {
+	ValidatorSet LockValidatorSet `protobuf:"bytes,1,opt,name=validator_set,json=validatorSet,proto3" json:"validator_set"`
+}
+
+func (m *LockValidatorSetResponse) Reset()         { *m = LockValidatorSetResponse{} }
+func (m *LockValidatorSetResponse) String() string { return proto.CompactTextString(m) }
+func (*LockValidatorSetResponse) ProtoMessage()    {}
+func (*LockValidatorSetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3d9448e4ed3943f, []int{14}
+}
+func (m *LockValidatorSetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockValidatorSetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockValidatorSetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LockValidatorSetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockValidatorSetResponse.Merge(m, src)
+}
+func (m *LockValidatorSetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockValidatorSetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockValidatorSetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockValidatorSetResponse proto.InternalMessageInfo
+
+func (m *LockValidatorSetResponse) GetValidatorSet() LockValidatorSet {
+	if m != nil {
+		return m.ValidatorSet
+	}
+	return LockValidatorSet{}
+}
+
 type TotalSuperfluidDelegationsRequest struct {
 }
 
@@ -631,7 +719,7 @@ func (m *TotalSuperfluidDelegationsRequest) Reset()         { *m = TotalSuperflu
 func (m *TotalSuperfluidDelegationsRequest) String() string { return proto.CompactTextString(m) }
 func (*TotalSuperfluidDelegationsRequest) ProtoMessage()    {}
 func (*TotalSuperfluidDelegationsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{13}
+	return fileDescriptor_e3d9448e4ed3943f, []int{15}
 }
 func (m *TotalSuperfluidDelegationsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -668,7 +756,7 @@ func (m *TotalSuperfluidDelegationsResponse) Reset()         { *m = TotalSuperfl
 func (m *TotalSuperfluidDelegationsResponse) String() string { return proto.CompactTextString(m) }
 func (*TotalSuperfluidDelegationsResponse) ProtoMessage()    {}
 func (*TotalSuperfluidDelegationsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{14}
+	return fileDescriptor_e3d9448e4ed3943f, []int{16}
 }
 func (m *TotalSuperfluidDelegationsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -707,7 +795,7 @@ func (m *SuperfluidDelegationAmountRequest) Reset()         { *m = SuperfluidDel
 func (m *SuperfluidDelegationAmountRequest) String() string { return proto.CompactTextString(m) }
 func (*SuperfluidDelegationAmountRequest) ProtoMessage()    {}
 func (*SuperfluidDelegationAmountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{15}
+	return fileDescriptor_e3d9448e4ed3943f, []int{17}
 }
 func (m *SuperfluidDelegationAmountRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -765,7 +853,7 @@ func (m *SuperfluidDelegationAmountResponse) Reset()         { *m = SuperfluidDe
 func (m *SuperfluidDelegationAmountResponse) String() string { return proto.CompactTextString(m) }
 func (*SuperfluidDelegationAmountResponse) ProtoMessage()    {}
 func (*SuperfluidDelegationAmountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{16}
+	return fileDescriptor_e3d9448e4ed3943f, []int{18}
 }
 func (m *SuperfluidDelegationAmountResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -811,7 +899,7 @@ func (m *SuperfluidDelegationsByDelegatorRequest) Reset() {
 func (m *SuperfluidDelegationsByDelegatorRequest) String() string { return proto.CompactTextString(m) }
 func (*SuperfluidDelegationsByDelegatorRequest) ProtoMessage()    {}
 func (*SuperfluidDelegationsByDelegatorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{17}
+	return fileDescriptor_e3d9448e4ed3943f, []int{19}
 }
 func (m *SuperfluidDelegationsByDelegatorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -859,7 +947,7 @@ func (m *SuperfluidDelegationsByDelegatorResponse) Reset() {
 func (m *SuperfluidDelegationsByDelegatorResponse) String() string { return proto.CompactTextString(m) }
 func (*SuperfluidDelegationsByDelegatorResponse) ProtoMessage()    {}
 func (*SuperfluidDelegationsByDelegatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{18}
+	return fileDescriptor_e3d9448e4ed3943f, []int{20}
 }
 func (m *SuperfluidDelegationsByDelegatorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -922,7 +1010,7 @@ func (m *SuperfluidUndelegationsByDelegatorRequest) String() string {
 }
 func (*SuperfluidUndelegationsByDelegatorRequest) ProtoMessage() {}
 func (*SuperfluidUndelegationsByDelegatorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{19}
+	return fileDescriptor_e3d9448e4ed3943f, []int{21}
 }
 func (m *SuperfluidUndelegationsByDelegatorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -979,7 +1067,7 @@ func (m *SuperfluidUndelegationsByDelegatorResponse) String() string {
 }
 func (*SuperfluidUndelegationsByDelegatorResponse) ProtoMessage() {}
 func (*SuperfluidUndelegationsByDelegatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{20}
+	return fileDescriptor_e3d9448e4ed3943f, []int{22}
 }
 func (m *SuperfluidUndelegationsByDelegatorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1042,7 +1130,7 @@ func (m *SuperfluidDelegationsByValidatorDenomRequest) String() string {
 }
 func (*SuperfluidDelegationsByValidatorDenomRequest) ProtoMessage() {}
 func (*SuperfluidDelegationsByValidatorDenomRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{21}
+	return fileDescriptor_e3d9448e4ed3943f, []int{23}
 }
 func (m *SuperfluidDelegationsByValidatorDenomRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1097,7 +1185,7 @@ func (m *SuperfluidDelegationsByValidatorDenomResponse) String() string {
 }
 func (*SuperfluidDelegationsByValidatorDenomResponse) ProtoMessage() {}
 func (*SuperfluidDelegationsByValidatorDenomResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{22}
+	return fileDescriptor_e3d9448e4ed3943f, []int{24}
 }
 func (m *SuperfluidDelegationsByValidatorDenomResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1146,7 +1234,7 @@ func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomRequest) String() stri
 }
 func (*EstimateSuperfluidDelegatedAmountByValidatorDenomRequest) ProtoMessage() {}
 func (*EstimateSuperfluidDelegatedAmountByValidatorDenomRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{23}
+	return fileDescriptor_e3d9448e4ed3943f, []int{25}
 }
 func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1201,7 +1289,7 @@ func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomResponse) String() str
 }
 func (*EstimateSuperfluidDelegatedAmountByValidatorDenomResponse) ProtoMessage() {}
 func (*EstimateSuperfluidDelegatedAmountByValidatorDenomResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{24}
+	return fileDescriptor_e3d9448e4ed3943f, []int{26}
 }
 func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1247,7 +1335,7 @@ func (m *QueryTotalDelegationByDelegatorRequest) Reset() {
 func (m *QueryTotalDelegationByDelegatorRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalDelegationByDelegatorRequest) ProtoMessage()    {}
 func (*QueryTotalDelegationByDelegatorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{25}
+	return fileDescriptor_e3d9448e4ed3943f, []int{27}
 }
 func (m *QueryTotalDelegationByDelegatorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1296,7 +1384,7 @@ func (m *QueryTotalDelegationByDelegatorResponse) Reset() {
 func (m *QueryTotalDelegationByDelegatorResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalDelegationByDelegatorResponse) ProtoMessage()    {}
 func (*QueryTotalDelegationByDelegatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{26}
+	return fileDescriptor_e3d9448e4ed3943f, []int{28}
 }
 func (m *QueryTotalDelegationByDelegatorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1367,6 +1455,8 @@ func init() {
 	proto.RegisterType((*AllIntermediaryAccountsResponse)(nil), "osmosis.superfluid.AllIntermediaryAccountsResponse")
 	proto.RegisterType((*ConnectedIntermediaryAccountRequest)(nil), "osmosis.superfluid.ConnectedIntermediaryAccountRequest")
 	proto.RegisterType((*ConnectedIntermediaryAccountResponse)(nil), "osmosis.superfluid.ConnectedIntermediaryAccountResponse")
+	proto.RegisterType((*LockValidatorSetRequest)(nil), "osmosis.superfluid.LockValidatorSetRequest")
+	proto.RegisterType((*LockValidatorSetResponse)(nil), "osmosis.superfluid.LockValidatorSetResponse")
 	proto.RegisterType((*TotalSuperfluidDelegationsRequest)(nil), "osmosis.superfluid.TotalSuperfluidDelegationsRequest")
 	proto.RegisterType((*TotalSuperfluidDelegationsResponse)(nil), "osmosis.superfluid.TotalSuperfluidDelegationsResponse")
 	proto.RegisterType((*SuperfluidDelegationAmountRequest)(nil), "osmosis.superfluid.SuperfluidDelegationAmountRequest")
@@ -1386,111 +1476,116 @@ func init() {
 func init() { proto.RegisterFile("osmosis/superfluid/query.proto", fileDescriptor_e3d9448e4ed3943f) }
 
 var fileDescriptor_e3d9448e4ed3943f = []byte{
-	// 1662 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0x4b, 0x6c, 0xd4, 0xc6,
-	0x1b, 0xcf, 0x24, 0x21, 0x81, 0x0f, 0x09, 0x92, 0x01, 0xfe, 0x09, 0x06, 0x36, 0xe0, 0x84, 0x24,
-	0xff, 0x00, 0x76, 0x13, 0x0a, 0xa4, 0x50, 0x10, 0x1b, 0x02, 0x34, 0x52, 0xd2, 0xd0, 0x25, 0x09,
-	0x52, 0x1f, 0xb2, 0x9c, 0xf5, 0xb0, 0x58, 0xf1, 0xda, 0x9b, 0x1d, 0x6f, 0xca, 0x0a, 0xa1, 0x4a,
-	0x54, 0x95, 0x8a, 0x7a, 0x68, 0x25, 0x4e, 0x3d, 0xb5, 0x57, 0x7a, 0xe8, 0xb5, 0x97, 0x5e, 0xaa,
-	0x5e, 0x90, 0xaa, 0x4a, 0x48, 0xbd, 0x54, 0x3d, 0x40, 0x05, 0x3d, 0xb6, 0x97, 0x1e, 0xdb, 0x4b,
-	0xe5, 0x99, 0xf1, 0x63, 0xb3, 0x7e, 0x6c, 0x02, 0x85, 0x9e, 0xb2, 0xf6, 0xf7, 0xfc, 0x7d, 0xaf,
-	0x99, 0xcf, 0x81, 0x9c, 0x43, 0xcb, 0x0e, 0x35, 0xa9, 0x4a, 0x6b, 0x15, 0x52, 0xbd, 0x6e, 0xd5,
-	0x4c, 0x43, 0x5d, 0xad, 0x91, 0x6a, 0x5d, 0xa9, 0x54, 0x1d, 0xd7, 0xc1, 0x58, 0xd0, 0x95, 0x90,
-	0x2e, 0xed, 0x2e, 0x39, 0x25, 0x87, 0x91, 0x55, 0xef, 0x17, 0xe7, 0x94, 0x72, 0x45, 0xc6, 0xaa,
-	0x2e, 0xeb, 0x94, 0xa8, 0x6b, 0xe3, 0xcb, 0xc4, 0xd5, 0xc7, 0xd5, 0xa2, 0x63, 0xda, 0x82, 0xbe,
-	0xbf, 0xe4, 0x38, 0x25, 0x8b, 0xa8, 0x7a, 0xc5, 0x54, 0x75, 0xdb, 0x76, 0x5c, 0xdd, 0x35, 0x1d,
-	0x9b, 0x0a, 0xea, 0x80, 0xa0, 0xb2, 0xa7, 0xe5, 0xda, 0x75, 0xd5, 0x35, 0xcb, 0x84, 0xba, 0x7a,
-	0xb9, 0xe2, 0xab, 0x5f, 0xcf, 0x60, 0xd4, 0xaa, 0x4c, 0x83, 0xa0, 0x0f, 0xc6, 0x00, 0x09, 0x7f,
-	0xfa, 0x56, 0x62, 0x98, 0x2a, 0x7a, 0x55, 0x2f, 0xfb, 0x6e, 0xec, 0xf5, 0x19, 0x2c, 0xa7, 0xb8,
-	0x52, 0xab, 0xb0, 0x3f, 0x82, 0x34, 0x16, 0xc5, 0xc7, 0x42, 0x14, 0xa0, 0xac, 0xe8, 0x25, 0xd3,
-	0x8e, 0x3a, 0x33, 0x24, 0x78, 0xa9, 0xab, 0xaf, 0x98, 0x76, 0x29, 0x60, 0x14, 0xcf, 0x9c, 0x4b,
-	0xde, 0x0d, 0xf8, 0x2d, 0x4f, 0xcf, 0x15, 0xe6, 0x41, 0x81, 0xac, 0xd6, 0x08, 0x75, 0xe5, 0x79,
-	0xd8, 0xd5, 0xf0, 0x96, 0x56, 0x1c, 0x9b, 0x12, 0x3c, 0x09, 0x5d, 0xdc, 0xd3, 0x7e, 0x74, 0x10,
-	0x8d, 0x6e, 0x9f, 0x90, 0x94, 0xe6, 0xcc, 0x28, 0x5c, 0x66, 0xaa, 0xf3, 0xc1, 0xa3, 0x81, 0xb6,
-	0x82, 0xe0, 0x97, 0x47, 0xa1, 0x27, 0x4f, 0x29, 0x71, 0x17, 0xea, 0x15, 0x22, 0x8c, 0xe0, 0xdd,
-	0xb0, 0xc5, 0x20, 0xb6, 0x53, 0x66, 0xca, 0xb6, 0x15, 0xf8, 0x83, 0xfc, 0x0e, 0xf4, 0x46, 0x38,
-	0x85, 0xe1, 0x4b, 0x00, 0xba, 0xf7, 0x52, 0x73, 0xeb, 0x15, 0xc2, 0xf8, 0x77, 0x4c, 0x8c, 0xc4,
-	0x19, 0xbf, 0x1a, 0xfc, 0x0c, 0x95, 0x6c, 0xd3, 0xfd, 0x9f, 0x32, 0x86, 0x9e, 0xbc, 0x65, 0x31,
-	0x52, 0x80, 0x75, 0x09, 0x7a, 0x23, 0xef, 0x84, 0xc1, 0x3c, 0x74, 0x31, 0x29, 0x0f, 0x69, 0xc7,
-	0xe8, 0xf6, 0x89, 0xc1, 0x16, 0x8c, 0xf9, 0x90, 0xb9, 0xa0, 0xac, 0xc0, 0xff, 0xd8, 0xeb, 0xb9,
-	0x9a, 0xe5, 0x9a, 0x15, 0xcb, 0x24, 0xd5, 0x74, 0xe0, 0x9f, 0x20, 0xe8, 0x6b, 0x12, 0x10, 0xee,
-	0x54, 0x40, 0xf2, 0xec, 0x6b, 0x64, 0xb5, 0x66, 0xae, 0xe9, 0x16, 0xb1, 0x5d, 0xad, 0x1c, 0x70,
-	0x89, 0x64, 0x4c, 0xc4, 0xb9, 0x38, 0x4f, 0xcb, 0xce, 0xc5, 0x40, 0x28, 0xaa, 0xb9, 0xe8, 0x54,
-	0x8d, 0x42, 0xbf, 0x93, 0x40, 0x97, 0xef, 0x22, 0x38, 0x14, 0xe2, 0x9b, 0xb1, 0x5d, 0x52, 0x2d,
-	0x13, 0xc3, 0xd4, 0xab, 0xf5, 0x7c, 0xb1, 0xe8, 0xd4, 0x6c, 0x77, 0xc6, 0xbe, 0xee, 0xc4, 0x23,
-	0xc1, 0x7b, 0x61, 0xeb, 0x9a, 0x6e, 0x69, 0xba, 0x61, 0x54, 0xfb, 0xdb, 0x19, 0xa1, 0x7b, 0x4d,
-	0xb7, 0xf2, 0x86, 0x51, 0xf5, 0x48, 0x25, 0xbd, 0x56, 0x22, 0x9a, 0x69, 0xf4, 0x77, 0x1c, 0x44,
-	0xa3, 0x9d, 0x85, 0x6e, 0xf6, 0x3c, 0x63, 0xe0, 0x7e, 0xe8, 0xf6, 0x24, 0x08, 0xa5, 0xfd, 0x9d,
-	0x5c, 0x48, 0x3c, 0xca, 0x37, 0x20, 0x97, 0xb7, 0xac, 0x18, 0x1f, 0xfc, 0x1c, 0x7a, 0xf5, 0x11,
-	0xd6, 0xbf, 0x88, 0xc7, 0xb0, 0xc2, 0x1b, 0x40, 0xf1, 0x9a, 0x45, 0xe1, 0xf3, 0x44, 0xf4, 0x80,
-	0x72, 0x45, 0x2f, 0xf9, 0x65, 0x58, 0x88, 0x48, 0xca, 0xdf, 0x23, 0x18, 0x48, 0x34, 0x25, 0x72,
-	0x71, 0x0d, 0xb6, 0xea, 0xe2, 0x9d, 0x28, 0x8e, 0x13, 0xe9, 0xc5, 0x91, 0x10, 0x3c, 0x51, 0x2e,
-	0x81, 0x32, 0x7c, 0xb9, 0x01, 0x44, 0x3b, 0x03, 0x31, 0x92, 0x09, 0x82, 0x7b, 0xd5, 0x80, 0xe2,
-	0x1c, 0x0c, 0x5e, 0x70, 0x6c, 0x9b, 0x14, 0x5d, 0x12, 0x67, 0xdc, 0x0f, 0x5a, 0x1f, 0x74, 0x7b,
-	0xa3, 0xc5, 0x4b, 0x05, 0x62, 0xa9, 0xe8, 0xf2, 0x1e, 0x67, 0x0c, 0xf9, 0x7d, 0x18, 0x4a, 0x97,
-	0x17, 0x91, 0x98, 0x87, 0x6e, 0xe1, 0xbc, 0x08, 0xf9, 0xe6, 0x02, 0x51, 0xf0, 0xb5, 0xc8, 0x83,
-	0x70, 0x68, 0xc1, 0x71, 0x75, 0x2b, 0x14, 0x99, 0x26, 0x16, 0x29, 0xf1, 0x21, 0xed, 0xf7, 0xeb,
-	0x7d, 0x04, 0x72, 0x1a, 0x97, 0x70, 0xee, 0x0e, 0x82, 0x5e, 0xd7, 0x63, 0xd3, 0x8c, 0x90, 0xca,
-	0xeb, 0x74, 0x6a, 0xd1, 0x8b, 0xfc, 0x2f, 0x8f, 0x06, 0x86, 0x4b, 0xa6, 0x7b, 0xa3, 0xb6, 0xac,
-	0x14, 0x9d, 0xb2, 0x2a, 0xa6, 0x25, 0xff, 0x73, 0x8c, 0x1a, 0x2b, 0xaa, 0x37, 0x6b, 0xa8, 0x32,
-	0x63, 0xbb, 0x7f, 0x3e, 0x1a, 0x18, 0xac, 0xeb, 0x65, 0xeb, 0xb4, 0xcc, 0x15, 0x86, 0xe0, 0xa2,
-	0xba, 0xe5, 0x42, 0x0f, 0x23, 0x47, 0x9c, 0x91, 0xef, 0x35, 0x74, 0x51, 0x48, 0xc9, 0x97, 0xa3,
-	0x89, 0x38, 0x02, 0xbd, 0x42, 0x8f, 0x53, 0xd5, 0xfc, 0x1e, 0xe0, 0x1d, 0xd5, 0x13, 0x10, 0xf2,
-	0xfc, 0xbd, 0xc7, 0xbc, 0xa6, 0x5b, 0xa6, 0xd1, 0xc0, 0xcc, 0xbb, 0xac, 0x27, 0x20, 0xf8, 0xcc,
-	0x41, 0x7f, 0x76, 0x44, 0x27, 0xcd, 0x5d, 0x04, 0x72, 0x9a, 0x57, 0x22, 0x82, 0x45, 0xe8, 0xd2,
-	0xcb, 0x22, 0xbb, 0x5e, 0x99, 0xef, 0x6d, 0xa8, 0x45, 0xbf, 0x0a, 0x2f, 0x38, 0xa6, 0x3d, 0xf5,
-	0x8a, 0x17, 0xd0, 0xaf, 0x1e, 0x0f, 0x8c, 0xb6, 0x10, 0x50, 0x4f, 0x80, 0x16, 0x84, 0x6a, 0x79,
-	0x09, 0x46, 0x62, 0xf3, 0x38, 0x55, 0x9f, 0xf6, 0x91, 0x6f, 0x26, 0x4c, 0xf2, 0x37, 0x1d, 0x30,
-	0x9a, 0xad, 0x58, 0x20, 0xbd, 0x09, 0x07, 0x62, 0x73, 0xaa, 0x55, 0xd9, 0x98, 0xf4, 0xfb, 0x5c,
-	0x49, 0x2f, 0xef, 0xd0, 0x08, 0x9f, 0xae, 0xa2, 0xc1, 0xf7, 0xd1, 0x44, 0x0e, 0x8a, 0x3f, 0x80,
-	0x3d, 0x0d, 0x45, 0x4a, 0x0c, 0xcd, 0xbb, 0xae, 0x78, 0x19, 0x7d, 0xee, 0x21, 0xdf, 0x15, 0x2d,
-	0x4f, 0x62, 0xb0, 0x97, 0xf8, 0x53, 0x04, 0x39, 0xee, 0x41, 0xe4, 0x6c, 0xf1, 0xae, 0x08, 0xc4,
-	0xd0, 0x44, 0xf6, 0x3b, 0x58, 0x6f, 0xa7, 0xb8, 0xa2, 0x0a, 0x57, 0x46, 0x5a, 0x74, 0xa5, 0xb0,
-	0x8f, 0x59, 0x0c, 0xcf, 0x9d, 0xab, 0xcc, 0x1e, 0x2f, 0x3f, 0xd9, 0x86, 0xff, 0x87, 0x31, 0x5d,
-	0xb4, 0x8d, 0xe7, 0x56, 0x13, 0x61, 0x37, 0xb4, 0x47, 0xbb, 0xe1, 0xaf, 0x76, 0x18, 0x6b, 0xc5,
-	0xe0, 0x4b, 0xaf, 0x95, 0x0f, 0x11, 0xf4, 0xf1, 0x54, 0xd5, 0xec, 0x17, 0x50, 0x2e, 0xbc, 0x30,
-	0x17, 0x43, 0x53, 0xbc, 0x60, 0x66, 0x61, 0x27, 0xad, 0xdb, 0xee, 0x0d, 0xe2, 0x9a, 0x45, 0xcd,
-	0x3b, 0x30, 0x68, 0x7f, 0x07, 0x33, 0x7e, 0x20, 0x40, 0xcc, 0xef, 0xad, 0xca, 0x55, 0x9f, 0x6d,
-	0xd6, 0x29, 0xae, 0x08, 0x80, 0x3b, 0x68, 0xf4, 0x25, 0x95, 0x57, 0xe1, 0x68, 0x42, 0x97, 0x2e,
-	0xf9, 0xb3, 0x6c, 0xda, 0xcb, 0x52, 0x24, 0xdf, 0xcd, 0xd3, 0x0f, 0x65, 0x4d, 0xbf, 0x86, 0x7c,
-	0xdf, 0x47, 0x70, 0xac, 0x45, 0x9b, 0x2f, 0x3b, 0xe5, 0xf2, 0x6d, 0x98, 0xbc, 0x48, 0x5d, 0xb3,
-	0xac, 0xbb, 0xa4, 0x49, 0x91, 0xdf, 0x30, 0xff, 0x62, 0xa8, 0xbe, 0x45, 0xf0, 0xda, 0x26, 0xec,
-	0x8b, 0xb0, 0x25, 0xce, 0x36, 0xf4, 0x62, 0x66, 0x9b, 0xbc, 0x08, 0xc3, 0x6c, 0x8b, 0x59, 0x68,
-	0x3c, 0x96, 0x9f, 0xf5, 0x68, 0xf9, 0xbc, 0x13, 0x46, 0x32, 0xf5, 0xbe, 0xf4, 0x69, 0xa1, 0xc3,
-	0xae, 0x06, 0x73, 0xdc, 0x21, 0x31, 0x28, 0xc6, 0xfc, 0xd8, 0xfb, 0xcb, 0xa0, 0x1f, 0xfe, 0xa8,
-	0x1e, 0x2e, 0x21, 0x6c, 0x61, 0xa3, 0x89, 0x92, 0x9c, 0xe0, 0x8e, 0xff, 0xce, 0xe1, 0xd5, 0xf9,
-	0x42, 0x0f, 0xaf, 0x89, 0x2f, 0xf6, 0xc0, 0x16, 0x56, 0x1b, 0xf8, 0x23, 0x04, 0x5d, 0x7c, 0x15,
-	0xc6, 0xc3, 0x71, 0xd9, 0x6d, 0xde, 0xba, 0xa5, 0x91, 0x4c, 0x3e, 0x1e, 0x78, 0x79, 0xec, 0xce,
-	0x4f, 0xbf, 0xdd, 0x6b, 0x1f, 0xc2, 0xb2, 0x1a, 0xf3, 0x2d, 0x21, 0xfc, 0x20, 0xc0, 0x8c, 0x7f,
-	0x8c, 0x60, 0x5b, 0xb0, 0x0b, 0xe3, 0xa1, 0x38, 0x13, 0xeb, 0x37, 0x73, 0xe9, 0x70, 0x06, 0x97,
-	0x70, 0x43, 0x61, 0x6e, 0x8c, 0xe2, 0xe1, 0x34, 0x37, 0xc2, 0xbd, 0x9d, 0xbb, 0xe2, 0xaf, 0xda,
-	0x09, 0xae, 0xac, 0xdb, 0xce, 0x13, 0x5c, 0x59, 0xbf, 0xaf, 0xb7, 0xe8, 0x8a, 0x65, 0x69, 0x7c,
-	0x39, 0xc7, 0x5f, 0x22, 0xd8, 0xb9, 0x6e, 0xd9, 0xc6, 0x63, 0x89, 0xa8, 0x9b, 0x56, 0x78, 0xe9,
-	0x48, 0x4b, 0xbc, 0xc2, 0xb9, 0x57, 0x99, 0x73, 0x0a, 0x3e, 0x9a, 0x1d, 0xa7, 0x70, 0xab, 0xc7,
-	0xdf, 0x21, 0xe8, 0x4b, 0xd8, 0x45, 0xf1, 0x44, 0x42, 0x54, 0x52, 0x76, 0x64, 0xe9, 0xf8, 0x86,
-	0x64, 0x84, 0xeb, 0x67, 0x99, 0xeb, 0xa7, 0xf0, 0x89, 0xac, 0xb8, 0x9a, 0x11, 0x2d, 0x5a, 0xb0,
-	0xd2, 0x3e, 0x46, 0xb0, 0x3f, 0x6d, 0x95, 0xc4, 0xa7, 0xe2, 0x9c, 0x6a, 0x61, 0x79, 0x95, 0x26,
-	0x37, 0x2e, 0x28, 0x20, 0xcd, 0x32, 0x48, 0x97, 0xf0, 0x74, 0x1a, 0xa4, 0xa2, 0xaf, 0x29, 0x16,
-	0x98, 0x7a, 0x4b, 0x2c, 0xce, 0xb7, 0xf1, 0x0f, 0x08, 0xa4, 0xe4, 0x6d, 0x14, 0xc7, 0x6e, 0xc4,
-	0x99, 0x3b, 0xae, 0x74, 0x72, 0xa3, 0x62, 0x02, 0xdb, 0x39, 0x86, 0x6d, 0x12, 0x9f, 0xcc, 0x4a,
-	0x57, 0xfc, 0x0a, 0x8b, 0x7f, 0x44, 0x20, 0x25, 0x6f, 0x86, 0xf8, 0x44, 0xab, 0xc7, 0x54, 0xc3,
-	0x7e, 0x1b, 0x8f, 0x26, 0x7b, 0x01, 0x95, 0xcf, 0x33, 0x34, 0xa7, 0xf1, 0x64, 0x1a, 0x9a, 0xf8,
-	0xe3, 0x95, 0x4f, 0x7f, 0xfc, 0x07, 0x82, 0x83, 0x59, 0x5b, 0x20, 0x3e, 0xd3, 0xaa, 0x7b, 0x31,
-	0x0b, 0x88, 0xf4, 0xfa, 0xe6, 0x84, 0x05, 0xc2, 0x37, 0x19, 0xc2, 0x37, 0xf0, 0xa5, 0x0d, 0x23,
-	0xa4, 0xea, 0xad, 0xa6, 0x1b, 0xcb, 0x6d, 0x7c, 0xa7, 0x3d, 0xba, 0xd9, 0x27, 0xed, 0x32, 0xf8,
-	0x6c, 0xba, 0xd3, 0x19, 0x4b, 0x97, 0x74, 0x6e, 0xb3, 0xe2, 0x02, 0xf5, 0x7b, 0x0c, 0xf5, 0x35,
-	0xbc, 0xd8, 0x22, 0xea, 0x5a, 0x54, 0xa1, 0xb6, 0x5c, 0xd7, 0x02, 0xe4, 0xb1, 0x41, 0xf8, 0x1b,
-	0xc1, 0xe1, 0x96, 0x2e, 0xf8, 0xf8, 0xfc, 0x06, 0x92, 0x17, 0x7b, 0xc9, 0x96, 0xf2, 0xcf, 0xa0,
-	0x41, 0x44, 0x63, 0x8e, 0x45, 0xe3, 0x32, 0xbe, 0xb8, 0xf1, 0x1a, 0xf0, 0x62, 0x11, 0xde, 0xf1,
-	0xf9, 0xc7, 0xd7, 0xaf, 0xdb, 0x61, 0x7c, 0xc3, 0x77, 0x76, 0x3c, 0x1b, 0x87, 0x63, 0xb3, 0xab,
-	0x87, 0x34, 0xf7, 0x9c, 0xb4, 0x89, 0x08, 0xbd, 0xcb, 0x22, 0xb4, 0x84, 0x17, 0xd2, 0x22, 0x44,
-	0x84, 0x7a, 0x2d, 0x6d, 0x20, 0xc4, 0x05, 0xec, 0x77, 0x7f, 0x82, 0xc7, 0xde, 0xe4, 0xf1, 0xe9,
-	0xc4, 0x4b, 0x59, 0xe6, 0x5a, 0x21, 0x9d, 0xd9, 0x94, 0xac, 0x40, 0xbd, 0xc8, 0x50, 0xcf, 0xe3,
-	0xb9, 0x34, 0xd4, 0xeb, 0xbf, 0x70, 0x66, 0x76, 0xc7, 0xd4, 0x95, 0x07, 0x4f, 0x72, 0xe8, 0xe1,
-	0x93, 0x1c, 0xfa, 0xf5, 0x49, 0x0e, 0x7d, 0xf6, 0x34, 0xd7, 0xf6, 0xf0, 0x69, 0xae, 0xed, 0xe7,
-	0xa7, 0xb9, 0xb6, 0xb7, 0x4f, 0x46, 0x6e, 0xc0, 0xc2, 0xe4, 0x31, 0x4b, 0x5f, 0xa6, 0x81, 0xfd,
-	0xb5, 0xf1, 0x09, 0xf5, 0x66, 0xd4, 0x0b, 0x76, 0x2b, 0x5e, 0xee, 0x62, 0xff, 0x49, 0x3a, 0xfe,
-	0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x57, 0xa3, 0x99, 0xea, 0xc7, 0x1b, 0x00, 0x00,
+	// 1731 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0x4d, 0x6c, 0xd4, 0xc6,
+	0x17, 0x8f, 0x93, 0x90, 0xc0, 0xe3, 0xff, 0x87, 0x64, 0xe0, 0x4f, 0x16, 0x03, 0x9b, 0xe0, 0x84,
+	0x24, 0xff, 0x00, 0x76, 0xb3, 0x94, 0x90, 0x42, 0x41, 0x6c, 0x08, 0xd0, 0x48, 0x49, 0x43, 0x37,
+	0x1f, 0x48, 0xfd, 0x90, 0xe5, 0xac, 0x87, 0xc5, 0x8a, 0xd7, 0xde, 0xec, 0x78, 0x53, 0x56, 0x08,
+	0x55, 0xa2, 0xaa, 0x54, 0xd4, 0x43, 0x2b, 0x71, 0xea, 0xad, 0x57, 0x7a, 0x68, 0x8f, 0xbd, 0xf4,
+	0x52, 0xf5, 0x82, 0x54, 0x55, 0xa2, 0xea, 0xa5, 0xea, 0x01, 0x2a, 0xe8, 0xb1, 0xbd, 0xf4, 0xd8,
+	0x5e, 0x2a, 0xcf, 0x8c, 0x3f, 0x36, 0xeb, 0xb5, 0x77, 0x17, 0x0a, 0x3d, 0x65, 0xed, 0x79, 0x1f,
+	0xbf, 0xdf, 0x7b, 0x6f, 0xde, 0xf8, 0x4d, 0x20, 0x6d, 0x93, 0xa2, 0x4d, 0x0c, 0xa2, 0x90, 0x4a,
+	0x09, 0x97, 0xaf, 0x99, 0x15, 0x43, 0x57, 0x36, 0x2a, 0xb8, 0x5c, 0x95, 0x4b, 0x65, 0xdb, 0xb1,
+	0x11, 0xe2, 0xeb, 0x72, 0xb0, 0x2e, 0xee, 0x2d, 0xd8, 0x05, 0x9b, 0x2e, 0x2b, 0xee, 0x2f, 0x26,
+	0x29, 0xa6, 0xf3, 0x54, 0x54, 0x59, 0xd3, 0x08, 0x56, 0x36, 0x27, 0xd7, 0xb0, 0xa3, 0x4d, 0x2a,
+	0x79, 0xdb, 0xb0, 0xf8, 0xfa, 0xc1, 0x82, 0x6d, 0x17, 0x4c, 0xac, 0x68, 0x25, 0x43, 0xd1, 0x2c,
+	0xcb, 0x76, 0x34, 0xc7, 0xb0, 0x2d, 0xc2, 0x57, 0x07, 0xf9, 0x2a, 0x7d, 0x5a, 0xab, 0x5c, 0x53,
+	0x1c, 0xa3, 0x88, 0x89, 0xa3, 0x15, 0x4b, 0x9e, 0xf9, 0xad, 0x02, 0x7a, 0xa5, 0x4c, 0x2d, 0xf0,
+	0xf5, 0xe1, 0x08, 0x22, 0xc1, 0x4f, 0xcf, 0x4b, 0x84, 0x50, 0x49, 0x2b, 0x6b, 0x45, 0x0f, 0xc6,
+	0x7e, 0x4f, 0xc0, 0xb4, 0xf3, 0xeb, 0x95, 0x12, 0xfd, 0xc3, 0x97, 0x26, 0xc2, 0xfc, 0x68, 0x88,
+	0x7c, 0x96, 0x25, 0xad, 0x60, 0x58, 0x61, 0x30, 0x23, 0x5c, 0x96, 0x38, 0xda, 0xba, 0x61, 0x15,
+	0x7c, 0x41, 0xfe, 0xcc, 0xa4, 0xa4, 0xbd, 0x80, 0xde, 0x70, 0xed, 0x5c, 0xa1, 0x08, 0x72, 0x78,
+	0xa3, 0x82, 0x89, 0x23, 0x2d, 0xc2, 0x9e, 0x9a, 0xb7, 0xa4, 0x64, 0x5b, 0x04, 0xa3, 0x69, 0xe8,
+	0x61, 0x48, 0x53, 0xc2, 0x90, 0x30, 0xbe, 0x33, 0x23, 0xca, 0xf5, 0x99, 0x91, 0x99, 0xce, 0x4c,
+	0xf7, 0xfd, 0x87, 0x83, 0x1d, 0x39, 0x2e, 0x2f, 0x8d, 0x43, 0x5f, 0x96, 0x10, 0xec, 0x2c, 0x57,
+	0x4b, 0x98, 0x3b, 0x41, 0x7b, 0x61, 0x9b, 0x8e, 0x2d, 0xbb, 0x48, 0x8d, 0xed, 0xc8, 0xb1, 0x07,
+	0xe9, 0x2d, 0xe8, 0x0f, 0x49, 0x72, 0xc7, 0x97, 0x00, 0x34, 0xf7, 0xa5, 0xea, 0x54, 0x4b, 0x98,
+	0xca, 0xef, 0xca, 0x8c, 0x45, 0x39, 0x5f, 0xf2, 0x7f, 0x06, 0x46, 0x76, 0x68, 0xde, 0x4f, 0x09,
+	0x41, 0x5f, 0xd6, 0x34, 0xe9, 0x92, 0xcf, 0x75, 0x15, 0xfa, 0x43, 0xef, 0xb8, 0xc3, 0x2c, 0xf4,
+	0x50, 0x2d, 0x97, 0x69, 0xd7, 0xf8, 0xce, 0xcc, 0x70, 0x13, 0xce, 0x3c, 0xca, 0x4c, 0x51, 0x92,
+	0x61, 0x1f, 0x7d, 0xbd, 0x50, 0x31, 0x1d, 0xa3, 0x64, 0x1a, 0xb8, 0x1c, 0x4f, 0xfc, 0x23, 0x01,
+	0x06, 0xea, 0x14, 0x38, 0x9c, 0x12, 0x88, 0xae, 0x7f, 0x15, 0x6f, 0x54, 0x8c, 0x4d, 0xcd, 0xc4,
+	0x96, 0xa3, 0x16, 0x7d, 0x29, 0x9e, 0x8c, 0x4c, 0x14, 0xc4, 0x45, 0x52, 0xb4, 0x2f, 0xfa, 0x4a,
+	0x61, 0xcb, 0x79, 0xbb, 0xac, 0xe7, 0x52, 0x76, 0x83, 0x75, 0xe9, 0x8e, 0x00, 0x87, 0x03, 0x7e,
+	0x73, 0x96, 0x83, 0xcb, 0x45, 0xac, 0x1b, 0x5a, 0xb9, 0x9a, 0xcd, 0xe7, 0xed, 0x8a, 0xe5, 0xcc,
+	0x59, 0xd7, 0xec, 0x68, 0x26, 0x68, 0x3f, 0x6c, 0xdf, 0xd4, 0x4c, 0x55, 0xd3, 0xf5, 0x72, 0xaa,
+	0x93, 0x2e, 0xf4, 0x6e, 0x6a, 0x66, 0x56, 0xd7, 0xcb, 0xee, 0x52, 0x41, 0xab, 0x14, 0xb0, 0x6a,
+	0xe8, 0xa9, 0xae, 0x21, 0x61, 0xbc, 0x3b, 0xd7, 0x4b, 0x9f, 0xe7, 0x74, 0x94, 0x82, 0x5e, 0x57,
+	0x03, 0x13, 0x92, 0xea, 0x66, 0x4a, 0xfc, 0x51, 0xba, 0x0e, 0xe9, 0xac, 0x69, 0x46, 0x60, 0xf0,
+	0x72, 0xe8, 0xd6, 0x47, 0x50, 0xff, 0x3c, 0x1e, 0xa3, 0x32, 0xdb, 0x00, 0xb2, 0xbb, 0x59, 0x64,
+	0xd6, 0x4f, 0xf8, 0x1e, 0x90, 0xaf, 0x68, 0x05, 0xaf, 0x0c, 0x73, 0x21, 0x4d, 0xe9, 0x5b, 0x01,
+	0x06, 0x1b, 0xba, 0xe2, 0xb9, 0xb8, 0x0a, 0xdb, 0x35, 0xfe, 0x8e, 0x17, 0xc7, 0xc9, 0xf8, 0xe2,
+	0x68, 0x10, 0x3c, 0x5e, 0x2e, 0xbe, 0x31, 0x74, 0xb9, 0x86, 0x44, 0x27, 0x25, 0x31, 0x96, 0x48,
+	0x82, 0xa1, 0xaa, 0x61, 0x71, 0x0e, 0x86, 0x2f, 0xd8, 0x96, 0x85, 0xf3, 0x0e, 0x8e, 0x72, 0xee,
+	0x05, 0x6d, 0x00, 0x7a, 0xdd, 0xd6, 0xe2, 0xa6, 0x42, 0xa0, 0xa9, 0xe8, 0x71, 0x1f, 0xe7, 0x74,
+	0xe9, 0x5d, 0x18, 0x89, 0xd7, 0xe7, 0x91, 0x58, 0x84, 0x5e, 0x0e, 0x9e, 0x87, 0xbc, 0xbd, 0x40,
+	0xe4, 0x3c, 0x2b, 0x52, 0x06, 0x06, 0xe6, 0xed, 0xfc, 0xfa, 0xaa, 0x66, 0x1a, 0xba, 0xe6, 0xd8,
+	0xe5, 0x25, 0x9c, 0x0c, 0x76, 0x1d, 0x52, 0xf5, 0x3a, 0x3e, 0xc0, 0xff, 0x6e, 0x7a, 0xef, 0x55,
+	0x82, 0x3d, 0x98, 0x23, 0x51, 0x30, 0xb7, 0x1a, 0xe1, 0xe9, 0xf9, 0xcf, 0x66, 0xe8, 0x9d, 0x34,
+	0x0c, 0x87, 0x97, 0x6d, 0x47, 0x33, 0x03, 0x4e, 0xb3, 0xd8, 0xc4, 0x05, 0x76, 0x8a, 0x78, 0x0d,
+	0xe5, 0x9e, 0x00, 0x52, 0x9c, 0x14, 0x07, 0x77, 0x5b, 0x80, 0x7e, 0xc7, 0x15, 0x53, 0xf5, 0x60,
+	0x95, 0x6d, 0xa4, 0x99, 0x15, 0xd7, 0xf7, 0xcf, 0x0f, 0x07, 0x47, 0x0b, 0x86, 0x73, 0xbd, 0xb2,
+	0x26, 0xe7, 0xed, 0xa2, 0xc2, 0xdb, 0x39, 0xfb, 0x73, 0x9c, 0xe8, 0xeb, 0x8a, 0xdb, 0x0c, 0x89,
+	0x3c, 0x67, 0x39, 0x7f, 0x3c, 0x1c, 0x1c, 0xae, 0x6a, 0x45, 0xf3, 0xb4, 0xc4, 0x0c, 0x06, 0xb4,
+	0xc2, 0xb6, 0xa5, 0x5c, 0x1f, 0x5d, 0x0e, 0x81, 0x91, 0xee, 0xd6, 0x6c, 0xf3, 0x60, 0x25, 0x5b,
+	0x0c, 0x57, 0xca, 0x51, 0xe8, 0xe7, 0x76, 0xec, 0xb2, 0xea, 0x6d, 0x52, 0xb6, 0xe5, 0xfb, 0xfc,
+	0x85, 0x2c, 0x7b, 0xef, 0x0a, 0x07, 0x41, 0xf7, 0x84, 0x59, 0x1b, 0xe8, 0xf3, 0x17, 0x3c, 0x61,
+	0xbf, 0x81, 0x74, 0x85, 0x5b, 0xe1, 0x1d, 0x01, 0xa4, 0x38, 0x54, 0x3c, 0x82, 0x79, 0xe8, 0xd1,
+	0x8a, 0xbc, 0xfc, 0xdc, 0x7d, 0xb8, 0xbf, 0x66, 0xb3, 0x78, 0xdb, 0xe4, 0x82, 0x6d, 0x58, 0x33,
+	0x2f, 0xb9, 0x01, 0xfd, 0xfc, 0xd1, 0xe0, 0x78, 0x13, 0x01, 0x75, 0x15, 0x48, 0x8e, 0x9b, 0x96,
+	0x56, 0x61, 0x2c, 0x32, 0x8f, 0x33, 0xd5, 0x59, 0x8f, 0x79, 0x3b, 0x61, 0x92, 0xbe, 0xea, 0x82,
+	0xf1, 0x64, 0xc3, 0x9c, 0xe9, 0x0d, 0x38, 0x14, 0x99, 0x53, 0xb5, 0x4c, 0xfb, 0xb8, 0xd7, 0x88,
+	0xe4, 0xf8, 0xfd, 0x17, 0x38, 0x61, 0xed, 0x9f, 0x97, 0xf8, 0x01, 0xd2, 0x50, 0x82, 0xa0, 0xf7,
+	0xe0, 0x7f, 0x35, 0x45, 0x8a, 0x75, 0xd5, 0xfd, 0x9e, 0x72, 0x33, 0xfa, 0xcc, 0x43, 0xbe, 0x27,
+	0x5c, 0x9e, 0x58, 0xa7, 0x2f, 0xd1, 0xc7, 0x02, 0xa4, 0x19, 0x82, 0xd0, 0xe1, 0xe7, 0x7e, 0xc3,
+	0x60, 0x5d, 0xe5, 0xd9, 0xef, 0x1a, 0x12, 0xe2, 0xa1, 0x28, 0x1c, 0xca, 0x58, 0x93, 0x50, 0x72,
+	0x07, 0xa8, 0xc7, 0xe0, 0x60, 0x5c, 0xa2, 0xfe, 0x58, 0xf9, 0x49, 0x16, 0xfc, 0x3f, 0x88, 0xe9,
+	0x8a, 0xa5, 0x3f, 0xb3, 0x9a, 0x08, 0x76, 0x43, 0x67, 0x78, 0x37, 0xfc, 0xd9, 0x09, 0x13, 0xcd,
+	0x38, 0x7c, 0xe1, 0xb5, 0xf2, 0xbe, 0x00, 0x03, 0x2c, 0x55, 0x15, 0xeb, 0x39, 0x94, 0x0b, 0x2b,
+	0xcc, 0x95, 0xc0, 0x15, 0x2b, 0x98, 0x79, 0xd8, 0x4d, 0xaa, 0x96, 0x73, 0x1d, 0x3b, 0x46, 0x5e,
+	0x75, 0x0f, 0x09, 0x92, 0xea, 0xa2, 0xce, 0x0f, 0xf9, 0x8c, 0xd9, 0x87, 0xb5, 0xbc, 0xe4, 0x89,
+	0xb9, 0xbd, 0x9f, 0x13, 0xdc, 0x45, 0xc2, 0x2f, 0x89, 0xb4, 0x01, 0xc7, 0x1a, 0xec, 0x52, 0xff,
+	0xb0, 0x98, 0x75, 0xb3, 0x14, 0xca, 0x77, 0x7d, 0xf7, 0x13, 0x92, 0xba, 0x5f, 0x4d, 0xbe, 0xef,
+	0x09, 0x70, 0xbc, 0x49, 0x9f, 0x2f, 0x3a, 0xe5, 0xd2, 0x2d, 0x98, 0xbe, 0x48, 0x1c, 0xa3, 0xa8,
+	0x39, 0xb8, 0xce, 0x90, 0xb7, 0x61, 0xfe, 0xc1, 0x50, 0x7d, 0x2d, 0xc0, 0x2b, 0x6d, 0xf8, 0xe7,
+	0x61, 0x6b, 0xd8, 0xdb, 0x84, 0xe7, 0xd3, 0xdb, 0xa4, 0x15, 0x18, 0xa5, 0x63, 0xd6, 0x72, 0xed,
+	0xb1, 0xfc, 0xb4, 0x47, 0xcb, 0xa7, 0xdd, 0x30, 0x96, 0x68, 0xf7, 0x85, 0x77, 0x0b, 0x0d, 0xf6,
+	0xd4, 0xb8, 0x63, 0x80, 0x78, 0xa3, 0x98, 0xf0, 0x62, 0xef, 0x4d, 0xab, 0x5e, 0xf8, 0xc3, 0x76,
+	0x98, 0x06, 0xf7, 0x85, 0xf4, 0xba, 0x95, 0xc6, 0x09, 0xee, 0xfa, 0xf7, 0x1c, 0x5e, 0xdd, 0xcf,
+	0xf5, 0xf0, 0xca, 0xfc, 0xb0, 0x0f, 0xb6, 0xd1, 0xda, 0x40, 0x1f, 0x08, 0xd0, 0xc3, 0x66, 0x75,
+	0x34, 0x1a, 0x95, 0xdd, 0xfa, 0x6b, 0x01, 0x71, 0x2c, 0x51, 0x8e, 0x05, 0x5e, 0x9a, 0xb8, 0xfd,
+	0xe3, 0xaf, 0x77, 0x3b, 0x47, 0x90, 0xa4, 0x44, 0x5c, 0x76, 0x04, 0x37, 0x16, 0xd4, 0xf9, 0x87,
+	0x02, 0xec, 0xf0, 0x87, 0x75, 0x14, 0xf9, 0x6d, 0xbe, 0xf5, 0xea, 0x40, 0x3c, 0x92, 0x20, 0xc5,
+	0x61, 0xc8, 0x14, 0xc6, 0x38, 0x1a, 0x8d, 0x83, 0x11, 0x5c, 0x2c, 0x30, 0x28, 0xde, 0x5d, 0x40,
+	0x03, 0x28, 0x5b, 0xae, 0x0f, 0xc4, 0x23, 0x09, 0x52, 0x2d, 0x41, 0x31, 0x4d, 0x55, 0x63, 0xce,
+	0x3f, 0x13, 0x60, 0xf7, 0x96, 0xdb, 0x00, 0x34, 0xd1, 0x90, 0x75, 0xdd, 0x1d, 0x83, 0x78, 0xb4,
+	0x29, 0x59, 0x0e, 0xee, 0x65, 0x0a, 0x4e, 0x46, 0xc7, 0x92, 0xe3, 0x14, 0x5c, 0x3b, 0xa0, 0x6f,
+	0xdc, 0x0b, 0x8b, 0xe8, 0x61, 0x19, 0x65, 0x1a, 0x44, 0x25, 0x66, 0x88, 0x17, 0x4f, 0xb4, 0xa4,
+	0xc3, 0xa1, 0x9f, 0xa5, 0xd0, 0x4f, 0xa1, 0x93, 0x49, 0x71, 0x35, 0x42, 0x56, 0x54, 0x7f, 0xe6,
+	0x7e, 0x24, 0xc0, 0xc1, 0xb8, 0x59, 0x17, 0x9d, 0x8a, 0x02, 0xd5, 0xc4, 0x74, 0x2d, 0x4e, 0xb7,
+	0xae, 0xc8, 0x29, 0xcd, 0x53, 0x4a, 0x97, 0xd0, 0x6c, 0x1c, 0xa5, 0xbc, 0x67, 0x29, 0x92, 0x98,
+	0x72, 0x93, 0x0f, 0xcb, 0xb7, 0xd0, 0x97, 0x02, 0xf4, 0x6d, 0x9d, 0x6d, 0xd1, 0xd1, 0x66, 0x26,
+	0x60, 0x8f, 0xc9, 0xb1, 0xe6, 0x84, 0x39, 0xfa, 0xf3, 0x14, 0xfd, 0x69, 0x34, 0x1d, 0x87, 0x9e,
+	0xa2, 0xab, 0x19, 0xcd, 0x43, 0x88, 0xbf, 0x13, 0x40, 0x6c, 0x3c, 0x3f, 0xa3, 0xc8, 0x4b, 0x86,
+	0xc4, 0xa9, 0x5c, 0x9c, 0x6a, 0x55, 0x8d, 0xf3, 0x39, 0x47, 0xf9, 0x4c, 0xa3, 0xa9, 0xa4, 0x02,
+	0x8b, 0x1e, 0xba, 0xd1, 0xf7, 0x02, 0x88, 0x8d, 0x67, 0x59, 0x74, 0xb2, 0xd9, 0x83, 0xb5, 0x66,
+	0x22, 0x17, 0xa7, 0x5a, 0x55, 0x6b, 0x25, 0x3b, 0xd1, 0x1f, 0x04, 0xec, 0xbc, 0x42, 0xbf, 0x0b,
+	0x30, 0x94, 0x34, 0xb7, 0xa2, 0x33, 0xcd, 0xc2, 0x8b, 0x18, 0x99, 0xc4, 0x57, 0xdb, 0x53, 0xe6,
+	0x0c, 0x5f, 0xa7, 0x0c, 0x5f, 0x43, 0x97, 0x5a, 0x66, 0x48, 0x94, 0x9b, 0x75, 0xdf, 0x58, 0xb7,
+	0xd0, 0xed, 0xce, 0xf0, 0x5d, 0x44, 0xa3, 0xe9, 0x0b, 0x9d, 0x8d, 0x07, 0x9d, 0x30, 0x26, 0x8a,
+	0xe7, 0xda, 0x55, 0xe7, 0xac, 0xdf, 0xa1, 0xac, 0xaf, 0xa2, 0x95, 0x26, 0x59, 0x57, 0xc2, 0x06,
+	0xd5, 0xb5, 0xaa, 0xea, 0x33, 0x8f, 0x0c, 0xc2, 0x5f, 0x02, 0x1c, 0x69, 0x6a, 0x24, 0x41, 0xe7,
+	0x5b, 0x48, 0x5e, 0xe4, 0x58, 0x20, 0x66, 0x9f, 0xc2, 0x02, 0x8f, 0xc6, 0x02, 0x8d, 0xc6, 0x65,
+	0x74, 0xb1, 0xf5, 0x1a, 0x70, 0x63, 0x11, 0x34, 0x26, 0x76, 0x9f, 0xfd, 0x45, 0x27, 0x4c, 0xb6,
+	0x3c, 0x65, 0xa0, 0xf9, 0x28, 0x1e, 0xed, 0x0e, 0x4b, 0xe2, 0xc2, 0x33, 0xb2, 0xc6, 0x23, 0xf4,
+	0x36, 0x8d, 0xd0, 0x2a, 0x5a, 0x8e, 0x8b, 0x10, 0xe6, 0xe6, 0xd5, 0xb8, 0x86, 0x10, 0x15, 0xb0,
+	0xdf, 0xbc, 0x0e, 0x1e, 0x39, 0x7b, 0xa0, 0xd3, 0x0d, 0x3f, 0x23, 0x13, 0x07, 0x21, 0xf1, 0x4c,
+	0x5b, 0xba, 0x9c, 0xf5, 0x0a, 0x65, 0xbd, 0x88, 0x16, 0xe2, 0x58, 0x6f, 0xbd, 0x93, 0x4d, 0xdc,
+	0x1d, 0x33, 0x57, 0xee, 0x3f, 0x4e, 0x0b, 0x0f, 0x1e, 0xa7, 0x85, 0x5f, 0x1e, 0xa7, 0x85, 0x4f,
+	0x9e, 0xa4, 0x3b, 0x1e, 0x3c, 0x49, 0x77, 0xfc, 0xf4, 0x24, 0xdd, 0xf1, 0xe6, 0x54, 0xe8, 0x9b,
+	0x9d, 0xbb, 0x3c, 0x6e, 0x6a, 0x6b, 0xc4, 0xf7, 0xbf, 0x39, 0x99, 0x51, 0x6e, 0x84, 0x51, 0xd0,
+	0xef, 0xf8, 0xb5, 0x1e, 0xfa, 0xcf, 0xb9, 0x13, 0x7f, 0x0f, 0x00, 0xfc, 0x7a, 0x2b, 0x5e, 0x1a,
+	0x1d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1518,6 +1613,8 @@ type QueryClient interface {
 	AllIntermediaryAccounts(ctx context.Context, in *AllIntermediaryAccountsRequest, opts ...grpc.CallOption) (*AllIntermediaryAccountsResponse, error)
 	// Returns intermediary account connected to a superfluid staked lock by id
 	ConnectedIntermediaryAccount(ctx context.Context, in *ConnectedIntermediaryAccountRequest, opts ...grpc.CallOption) (*ConnectedIntermediaryAccountResponse, error)
+	// Returns the weighted validator set a lock is superfluid delegated to
+	LockValidatorSet(ctx context.Context, in *LockValidatorSetRequest, opts ...grpc.CallOption) (*LockValidatorSetResponse, error)
 	// Returns the total amount of osmo superfluidly staked.
 	// Response is denominated in uosmo.
 	TotalSuperfluidDelegations(ctx context.Context, in *TotalSuperfluidDelegationsRequest, opts ...grpc.CallOption) (*TotalSuperfluidDelegationsResponse, error)
@@ -1601,6 +1698,15 @@ func (c *queryClient) ConnectedIntermediaryAccount(ctx context.Context, in *Conn
 	return out, nil
 }
 
+func (c *queryClient) LockValidatorSet(ctx context.Context, in *LockValidatorSetRequest, opts ...grpc.CallOption) (*LockValidatorSetResponse, error) {
+	out := new(LockValidatorSetResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/LockValidatorSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) TotalSuperfluidDelegations(ctx context.Context, in *TotalSuperfluidDelegationsRequest, opts ...grpc.CallOption) (*TotalSuperfluidDelegationsResponse, error) {
 	out := new(TotalSuperfluidDelegationsResponse)
 	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/TotalSuperfluidDelegations", in, out, opts...)
@@ -1679,6 +1785,8 @@ type QueryServer interface {
 	AllIntermediaryAccounts(context.Context, *AllIntermediaryAccountsRequest) (*AllIntermediaryAccountsResponse, error)
 	// Returns intermediary account connected to a superfluid staked lock by id
 	ConnectedIntermediaryAccount(context.Context, *ConnectedIntermediaryAccountRequest) (*ConnectedIntermediaryAccountResponse, error)
+	// Returns the weighted validator set a lock is superfluid delegated to
+	LockValidatorSet(context.Context, *LockValidatorSetRequest) (*LockValidatorSetResponse, error)
 	// Returns the total amount of osmo superfluidly staked.
 	// Response is denominated in uosmo.
 	TotalSuperfluidDelegations(context.Context, *TotalSuperfluidDelegationsRequest) (*TotalSuperfluidDelegationsResponse, error)
@@ -1722,6 +1830,9 @@ func (*UnimplementedQueryServer) AllIntermediaryAccounts(ctx context.Context, re
 func (*UnimplementedQueryServer) ConnectedIntermediaryAccount(ctx context.Context, req *ConnectedIntermediaryAccountRequest) (*ConnectedIntermediaryAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConnectedIntermediaryAccount not implemented")
 }
+func (*UnimplementedQueryServer) LockValidatorSet(ctx context.Context, req *LockValidatorSetRequest) (*LockValidatorSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockValidatorSet not implemented")
+}
 func (*UnimplementedQueryServer) TotalSuperfluidDelegations(ctx context.Context, req *TotalSuperfluidDelegationsRequest) (*TotalSuperfluidDelegationsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TotalSuperfluidDelegations not implemented")
 }
@@ -1856,6 +1967,24 @@ func _Query_ConnectedIntermediaryAccount_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_LockValidatorSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LockValidatorSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LockValidatorSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.superfluid.Query/LockValidatorSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LockValidatorSet(ctx, req.(*LockValidatorSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_TotalSuperfluidDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TotalSuperfluidDelegationsRequest)
 	if err := dec(in); err != nil {
@@ -2010,6 +2139,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ConnectedIntermediaryAccount",
 			Handler:    _Query_ConnectedIntermediaryAccount_Handler,
 		},
+		{
+			MethodName: "LockValidatorSet",
+			Handler:    _Query_LockValidatorSet_Handler,
+		},
 		{
 			MethodName: "TotalSuperfluidDelegations",
 			Handler:    _Query_TotalSuperfluidDelegations_Handler,
@@ -2478,6 +2611,67 @@ func (m *ConnectedIntermediaryAccountResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
+func (m *LockValidatorSetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockValidatorSetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockValidatorSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LockId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LockId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LockValidatorSetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockValidatorSetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockValidatorSetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ValidatorSet.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *TotalSuperfluidDelegationsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3248,6 +3442,29 @@ func (m *ConnectedIntermediaryAccountResponse) Size() (n int) {
 	return n
 }
 
+func (m *LockValidatorSetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LockId != 0 {
+		n += 1 + sovQuery(uint64(m.LockId))
+	}
+	return n
+}
+
+func (m *LockValidatorSetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ValidatorSet.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func (m *TotalSuperfluidDelegationsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4608,6 +4825,158 @@ func (m *ConnectedIntermediaryAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *LockValidatorSetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockValidatorSetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockValidatorSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
+			}
+			m.LockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LockValidatorSetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockValidatorSetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockValidatorSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *TotalSuperfluidDelegationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
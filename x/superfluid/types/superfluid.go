@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -21,6 +23,17 @@ func NewSuperfluidIntermediaryAccount(denom string, valAddr string, gaugeId uint
 	}
 }
 
+// NewValidatorSetIntermediaryAccount returns the intermediary account holding the delegation
+// of a lock superfluid delegated to a validator set, to one validator of the set.
+func NewValidatorSetIntermediaryAccount(denom string, valAddr string, lockId uint64, gaugeId uint64) SuperfluidIntermediaryAccount {
+	return SuperfluidIntermediaryAccount{
+		Denom:   denom,
+		ValAddr: valAddr,
+		GaugeId: gaugeId,
+		LockId:  lockId,
+	}
+}
+
 func (a SuperfluidIntermediaryAccount) Empty() bool {
 	// if intermediary account isn't set in state, we get the default intermediary account.
 	// if it set, then the denom is non-blank
@@ -28,6 +41,9 @@ func (a SuperfluidIntermediaryAccount) Empty() bool {
 }
 
 func (a SuperfluidIntermediaryAccount) GetAccAddress() sdk.AccAddress {
+	if a.LockId != 0 {
+		return GetValidatorSetIntermediaryAccountAddr(a.Denom, a.ValAddr, a.LockId)
+	}
 	return GetSuperfluidIntermediaryAccountAddr(a.Denom, a.ValAddr)
 }
 
@@ -37,3 +53,10 @@ func GetSuperfluidIntermediaryAccountAddr(denom, valAddr string) sdk.AccAddress
 	// We are launching with the address as is, so this will have to be done as a migration in the future.
 	return authtypes.NewModuleAddress(denom + valAddr)
 }
+
+// GetValidatorSetIntermediaryAccountAddr returns the address of the intermediary account holding
+// the delegation of the lock to the validator, for a lock superfluid delegated to a validator set.
+// Validator addresses never contain a '/', so these can't collide with the shared intermediary accounts.
+func GetValidatorSetIntermediaryAccountAddr(denom, valAddr string, lockId uint64) sdk.AccAddress {
+	return authtypes.NewModuleAddress(fmt.Sprintf("%s%s/%d", denom, valAddr, lockId))
+}
@@ -6,19 +6,22 @@ package types
 import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
-	types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -53,6 +56,40 @@ func (SuperfluidAssetType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_79d3c29d82dbb734, []int{0}
 }
 
+// MultiplierPriceSource indicates the prices an OSMO equivalent multiplier was
+// computed from.
+type MultiplierPriceSource int32
+
+const (
+	MultiplierPriceSourceSpot           MultiplierPriceSource = 0
+	MultiplierPriceSourceArithmeticTwap MultiplierPriceSource = 1
+	MultiplierPriceSourceGeometricTwap  MultiplierPriceSource = 2
+	// the previous multiplier was kept, as the twap could not be computed.
+	MultiplierPriceSourcePrevious MultiplierPriceSource = 3
+)
+
+var MultiplierPriceSource_name = map[int32]string{
+	0: "MultiplierPriceSourceSpot",
+	1: "MultiplierPriceSourceArithmeticTwap",
+	2: "MultiplierPriceSourceGeometricTwap",
+	3: "MultiplierPriceSourcePrevious",
+}
+
+var MultiplierPriceSource_value = map[string]int32{
+	"MultiplierPriceSourceSpot":           0,
+	"MultiplierPriceSourceArithmeticTwap": 1,
+	"MultiplierPriceSourceGeometricTwap":  2,
+	"MultiplierPriceSourcePrevious":       3,
+}
+
+func (x MultiplierPriceSource) String() string {
+	return proto.EnumName(MultiplierPriceSource_name, int32(x))
+}
+
+func (MultiplierPriceSource) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_79d3c29d82dbb734, []int{1}
+}
+
 // SuperfluidAsset stores the pair of superfluid asset type and denom pair
 type SuperfluidAsset struct {
 	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
@@ -103,6 +140,11 @@ type SuperfluidIntermediaryAccount struct {
 	ValAddr string `protobuf:"bytes,2,opt,name=val_addr,json=valAddr,proto3" json:"val_addr,omitempty"`
 	// perpetual gauge for rewards distribution
 	GaugeId uint64 `protobuf:"varint,3,opt,name=gauge_id,json=gaugeId,proto3" json:"gauge_id,omitempty"`
+	// lock_id is set for the intermediary accounts of validator-set superfluid
+	// delegations, which hold the delegation of a single lock to one validator of
+	// its set. It is zero for the intermediary accounts shared by every lock
+	// delegated to a (denom, validator) pair.
+	LockId uint64 `protobuf:"varint,4,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
 }
 
 func (m *SuperfluidIntermediaryAccount) Reset()         { *m = SuperfluidIntermediaryAccount{} }
@@ -159,18 +201,28 @@ func (m *SuperfluidIntermediaryAccount) GetGaugeId() uint64 {
 	return 0
 }
 
+func (m *SuperfluidIntermediaryAccount) GetLockId() uint64 {
+	if m != nil {
+		return m.LockId
+	}
+	return 0
+}
+
 // The Osmo-Equivalent-Multiplier Record for epoch N refers to the osmo worth we
 // treat an LP share as having, for all of epoch N. Eventually this is intended
 // to be set as the Time-weighted-average-osmo-backing for the entire duration
 // of epoch N-1. (Thereby locking whats in use for epoch N as based on the prior
-// epochs rewards) However for now, this is not the TWAP but instead the spot
-// price at the boundary. For different types of assets in the future, it could
-// change.
+// epochs rewards) The multiplier is computed from the TWAP of the pool assets
+// over epoch N-1, see OsmoEquivalentMultiplierInputs. For different types of
+// assets in the future, it could change.
 type OsmoEquivalentMultiplierRecord struct {
 	EpochNumber int64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
 	// superfluid asset denom, can be LP token or native token
 	Denom      string                                 `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 	Multiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=multiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"multiplier" yaml:"multiplier"`
+	// inputs the multiplier was computed from at the epoch boundary, unset for
+	// multipliers not computed at an epoch boundary.
+	Inputs *OsmoEquivalentMultiplierInputs `protobuf:"bytes,4,opt,name=inputs,proto3" json:"inputs,omitempty" yaml:"inputs"`
 }
 
 func (m *OsmoEquivalentMultiplierRecord) Reset()         { *m = OsmoEquivalentMultiplierRecord{} }
@@ -220,20 +272,112 @@ func (m *OsmoEquivalentMultiplierRecord) GetDenom() string {
 	return ""
 }
 
+func (m *OsmoEquivalentMultiplierRecord) GetInputs() *OsmoEquivalentMultiplierInputs {
+	if m != nil {
+		return m.Inputs
+	}
+	return nil
+}
+
+// OsmoEquivalentMultiplierInputs holds the inputs an OSMO equivalent multiplier
+// was computed from.
+type OsmoEquivalentMultiplierInputs struct {
+	PriceSource MultiplierPriceSource `protobuf:"varint,1,opt,name=price_source,json=priceSource,proto3,enum=osmosis.superfluid.MultiplierPriceSource" json:"price_source,omitempty" yaml:"price_source"`
+	// twap_start_time and twap_end_time bound the epoch the twap was computed
+	// over.
+	TwapStartTime time.Time `protobuf:"bytes,2,opt,name=twap_start_time,json=twapStartTime,proto3,stdtime" json:"twap_start_time" yaml:"twap_start_time"`
+	TwapEndTime   time.Time `protobuf:"bytes,3,opt,name=twap_end_time,json=twapEndTime,proto3,stdtime" json:"twap_end_time" yaml:"twap_end_time"`
+	// prices of the pool assets other than OSMO, in OSMO.
+	Prices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,4,rep,name=prices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"prices" yaml:"prices"`
+	// multiplier computed from the prices, before the maximum multiplier change
+	// per epoch is applied.
+	UncappedMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=uncapped_multiplier,json=uncappedMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"uncapped_multiplier" yaml:"uncapped_multiplier"`
+	// error of the twap computation, when the fallback price source was used.
+	TwapError string `protobuf:"bytes,6,opt,name=twap_error,json=twapError,proto3" json:"twap_error,omitempty" yaml:"twap_error"`
+}
+
+func (m *OsmoEquivalentMultiplierInputs) Reset()         { *m = OsmoEquivalentMultiplierInputs{} }
+func (m *OsmoEquivalentMultiplierInputs) String() string { return proto.CompactTextString(m) }
+func (*OsmoEquivalentMultiplierInputs) ProtoMessage()    {}
+func (*OsmoEquivalentMultiplierInputs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79d3c29d82dbb734, []int{3}
+}
+func (m *OsmoEquivalentMultiplierInputs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OsmoEquivalentMultiplierInputs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OsmoEquivalentMultiplierInputs.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OsmoEquivalentMultiplierInputs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OsmoEquivalentMultiplierInputs.Merge(m, src)
+}
+func (m *OsmoEquivalentMultiplierInputs) XXX_Size() int {
+	return m.Size()
+}
+func (m *OsmoEquivalentMultiplierInputs) XXX_DiscardUnknown() {
+	xxx_messageInfo_OsmoEquivalentMultiplierInputs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OsmoEquivalentMultiplierInputs proto.InternalMessageInfo
+
+func (m *OsmoEquivalentMultiplierInputs) GetPriceSource() MultiplierPriceSource {
+	if m != nil {
+		return m.PriceSource
+	}
+	return MultiplierPriceSourceSpot
+}
+
+func (m *OsmoEquivalentMultiplierInputs) GetTwapStartTime() time.Time {
+	if m != nil {
+		return m.TwapStartTime
+	}
+	return time.Time{}
+}
+
+func (m *OsmoEquivalentMultiplierInputs) GetTwapEndTime() time.Time {
+	if m != nil {
+		return m.TwapEndTime
+	}
+	return time.Time{}
+}
+
+func (m *OsmoEquivalentMultiplierInputs) GetPrices() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Prices
+	}
+	return nil
+}
+
+func (m *OsmoEquivalentMultiplierInputs) GetTwapError() string {
+	if m != nil {
+		return m.TwapError
+	}
+	return ""
+}
+
 // SuperfluidDelegationRecord is a struct used to indicate superfluid
 // delegations of an account in the state machine in a user friendly form.
 type SuperfluidDelegationRecord struct {
-	DelegatorAddress       string      `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
-	ValidatorAddress       string      `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
-	DelegationAmount       types.Coin  `protobuf:"bytes,3,opt,name=delegation_amount,json=delegationAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coin" json:"delegation_amount"`
-	EquivalentStakedAmount *types.Coin `protobuf:"bytes,4,opt,name=equivalent_staked_amount,json=equivalentStakedAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coin" json:"equivalent_staked_amount,omitempty"`
+	DelegatorAddress       string       `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	ValidatorAddress       string       `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	DelegationAmount       types1.Coin  `protobuf:"bytes,3,opt,name=delegation_amount,json=delegationAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coin" json:"delegation_amount"`
+	EquivalentStakedAmount *types1.Coin `protobuf:"bytes,4,opt,name=equivalent_staked_amount,json=equivalentStakedAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coin" json:"equivalent_staked_amount,omitempty"`
 }
 
 func (m *SuperfluidDelegationRecord) Reset()         { *m = SuperfluidDelegationRecord{} }
 func (m *SuperfluidDelegationRecord) String() string { return proto.CompactTextString(m) }
 func (*SuperfluidDelegationRecord) ProtoMessage()    {}
 func (*SuperfluidDelegationRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_79d3c29d82dbb734, []int{3}
+	return fileDescriptor_79d3c29d82dbb734, []int{4}
 }
 func (m *SuperfluidDelegationRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -276,14 +420,14 @@ func (m *SuperfluidDelegationRecord) GetValidatorAddress() string {
 	return ""
 }
 
-func (m *SuperfluidDelegationRecord) GetDelegationAmount() types.Coin {
+func (m *SuperfluidDelegationRecord) GetDelegationAmount() types1.Coin {
 	if m != nil {
 		return m.DelegationAmount
 	}
-	return types.Coin{}
+	return types1.Coin{}
 }
 
-func (m *SuperfluidDelegationRecord) GetEquivalentStakedAmount() *types.Coin {
+func (m *SuperfluidDelegationRecord) GetEquivalentStakedAmount() *types1.Coin {
 	if m != nil {
 		return m.EquivalentStakedAmount
 	}
@@ -302,7 +446,7 @@ func (m *LockIdIntermediaryAccountConnection) Reset()         { *m = LockIdInter
 func (m *LockIdIntermediaryAccountConnection) String() string { return proto.CompactTextString(m) }
 func (*LockIdIntermediaryAccountConnection) ProtoMessage()    {}
 func (*LockIdIntermediaryAccountConnection) Descriptor() ([]byte, []int) {
-	return fileDescriptor_79d3c29d82dbb734, []int{4}
+	return fileDescriptor_79d3c29d82dbb734, []int{5}
 }
 func (m *LockIdIntermediaryAccountConnection) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -353,7 +497,7 @@ func (m *UnpoolWhitelistedPools) Reset()         { *m = UnpoolWhitelistedPools{}
 func (m *UnpoolWhitelistedPools) String() string { return proto.CompactTextString(m) }
 func (*UnpoolWhitelistedPools) ProtoMessage()    {}
 func (*UnpoolWhitelistedPools) Descriptor() ([]byte, []int) {
-	return fileDescriptor_79d3c29d82dbb734, []int{5}
+	return fileDescriptor_79d3c29d82dbb734, []int{6}
 }
 func (m *UnpoolWhitelistedPools) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -389,14 +533,135 @@ func (m *UnpoolWhitelistedPools) GetIds() []uint64 {
 	return nil
 }
 
+// ValidatorWeight is a validator of a superfluid validator set along with the
+// share of the lock delegated to it.
+type ValidatorWeight struct {
+	ValAddr string                                 `protobuf:"bytes,1,opt,name=val_addr,json=valAddr,proto3" json:"val_addr,omitempty" yaml:"val_addr"`
+	Weight  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight" yaml:"weight"`
+}
+
+func (m *ValidatorWeight) Reset()         { *m = ValidatorWeight{} }
+func (m *ValidatorWeight) String() string { return proto.CompactTextString(m) }
+func (*ValidatorWeight) ProtoMessage()    {}
+func (*ValidatorWeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79d3c29d82dbb734, []int{7}
+}
+func (m *ValidatorWeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorWeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorWeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorWeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorWeight.Merge(m, src)
+}
+func (m *ValidatorWeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorWeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorWeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorWeight proto.InternalMessageInfo
+
+func (m *ValidatorWeight) GetValAddr() string {
+	if m != nil {
+		return m.ValAddr
+	}
+	return ""
+}
+
+// LockValidatorSet is the weighted validator set a lock is superfluid
+// delegated to. The lock's OSMO-equivalent is split across the validators
+// by weight, each validator share being delegated through an intermediary
+// account of its own.
+type LockValidatorSet struct {
+	LockId     uint64            `protobuf:"varint,1,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
+	Validators []ValidatorWeight `protobuf:"bytes,2,rep,name=validators,proto3" json:"validators"`
+	// unbonding_validators are the shares of the lock superfluid undelegating
+	// from validators, through the unbonding synthetic lock of their
+	// intermediary account. They keep the slashing risk of the validators until
+	// the synthetic locks mature. Validators removed from the set, or whose
+	// weight is reduced, unbond the removed share, and superfluid undelegating
+	// the lock unbonds the share of every validator of the set.
+	UnbondingValidators []ValidatorWeight `protobuf:"bytes,3,rep,name=unbonding_validators,json=unbondingValidators,proto3" json:"unbonding_validators" yaml:"unbonding_validators"`
+}
+
+func (m *LockValidatorSet) Reset()         { *m = LockValidatorSet{} }
+func (m *LockValidatorSet) String() string { return proto.CompactTextString(m) }
+func (*LockValidatorSet) ProtoMessage()    {}
+func (*LockValidatorSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79d3c29d82dbb734, []int{8}
+}
+func (m *LockValidatorSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockValidatorSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockValidatorSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LockValidatorSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockValidatorSet.Merge(m, src)
+}
+func (m *LockValidatorSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockValidatorSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockValidatorSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockValidatorSet proto.InternalMessageInfo
+
+func (m *LockValidatorSet) GetLockId() uint64 {
+	if m != nil {
+		return m.LockId
+	}
+	return 0
+}
+
+func (m *LockValidatorSet) GetValidators() []ValidatorWeight {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+func (m *LockValidatorSet) GetUnbondingValidators() []ValidatorWeight {
+	if m != nil {
+		return m.UnbondingValidators
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("osmosis.superfluid.SuperfluidAssetType", SuperfluidAssetType_name, SuperfluidAssetType_value)
+	proto.RegisterEnum("osmosis.superfluid.MultiplierPriceSource", MultiplierPriceSource_name, MultiplierPriceSource_value)
 	proto.RegisterType((*SuperfluidAsset)(nil), "osmosis.superfluid.SuperfluidAsset")
 	proto.RegisterType((*SuperfluidIntermediaryAccount)(nil), "osmosis.superfluid.SuperfluidIntermediaryAccount")
 	proto.RegisterType((*OsmoEquivalentMultiplierRecord)(nil), "osmosis.superfluid.OsmoEquivalentMultiplierRecord")
+	proto.RegisterType((*OsmoEquivalentMultiplierInputs)(nil), "osmosis.superfluid.OsmoEquivalentMultiplierInputs")
 	proto.RegisterType((*SuperfluidDelegationRecord)(nil), "osmosis.superfluid.SuperfluidDelegationRecord")
 	proto.RegisterType((*LockIdIntermediaryAccountConnection)(nil), "osmosis.superfluid.LockIdIntermediaryAccountConnection")
 	proto.RegisterType((*UnpoolWhitelistedPools)(nil), "osmosis.superfluid.UnpoolWhitelistedPools")
+	proto.RegisterType((*ValidatorWeight)(nil), "osmosis.superfluid.ValidatorWeight")
+	proto.RegisterType((*LockValidatorSet)(nil), "osmosis.superfluid.LockValidatorSet")
 }
 
 func init() {
@@ -404,51 +669,79 @@ func init() {
 }
 
 var fileDescriptor_79d3c29d82dbb734 = []byte{
-	// 694 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4f, 0x4f, 0xd4, 0x40,
-	0x14, 0xdf, 0xb2, 0x2b, 0xb0, 0x83, 0xd1, 0xa5, 0x10, 0x5c, 0x36, 0xa1, 0x8b, 0x25, 0x91, 0x0d,
-	0x84, 0x36, 0x8b, 0x89, 0x07, 0x6e, 0x0b, 0x68, 0x42, 0x82, 0x48, 0x8a, 0xc6, 0x84, 0xcb, 0x66,
-	0xda, 0x19, 0xba, 0x93, 0x9d, 0x76, 0x4a, 0x67, 0x5a, 0xdd, 0x9b, 0x47, 0x8e, 0x7e, 0x04, 0x12,
-	0x6f, 0x7e, 0x08, 0xcf, 0x1c, 0x39, 0x1a, 0x0f, 0x68, 0xe0, 0xe2, 0x99, 0x4f, 0x60, 0x3a, 0xed,
-	0x76, 0x2b, 0x60, 0xd4, 0x53, 0x67, 0xde, 0xef, 0xbd, 0xdf, 0xfb, 0xbd, 0x3f, 0x1d, 0xb0, 0xc4,
-	0xb8, 0xc7, 0x38, 0xe1, 0x26, 0x8f, 0x02, 0x1c, 0x1e, 0xd1, 0x88, 0xa0, 0xc2, 0xd1, 0x08, 0x42,
-	0x26, 0x98, 0xaa, 0x66, 0x4e, 0xc6, 0x08, 0x69, 0xcc, 0xba, 0xcc, 0x65, 0x12, 0x36, 0x93, 0x53,
-	0xea, 0xd9, 0xd0, 0x5c, 0xc6, 0x5c, 0x8a, 0x4d, 0x79, 0xb3, 0xa3, 0x23, 0x13, 0x45, 0x21, 0x14,
-	0x84, 0xf9, 0x19, 0xde, 0xbc, 0x89, 0x0b, 0xe2, 0x61, 0x2e, 0xa0, 0x17, 0x0c, 0x09, 0x1c, 0x99,
-	0xcb, 0xb4, 0x21, 0xc7, 0x66, 0xdc, 0xb6, 0xb1, 0x80, 0x6d, 0xd3, 0x61, 0x24, 0x23, 0xd0, 0x07,
-	0xe0, 0xe1, 0x41, 0x2e, 0xa2, 0xc3, 0x39, 0x16, 0xea, 0x2c, 0xb8, 0x87, 0xb0, 0xcf, 0xbc, 0xba,
-	0xb2, 0xa8, 0xb4, 0xaa, 0x56, 0x7a, 0x51, 0x5f, 0x00, 0x00, 0x13, 0xb8, 0x2b, 0x06, 0x01, 0xae,
-	0x8f, 0x2d, 0x2a, 0xad, 0x07, 0xeb, 0xcb, 0xc6, 0xed, 0x42, 0x8c, 0x1b, 0x74, 0xaf, 0x07, 0x01,
-	0xb6, 0xaa, 0x70, 0x78, 0xdc, 0x98, 0x3c, 0x39, 0x6d, 0x96, 0x7e, 0x9e, 0x36, 0x15, 0xbd, 0x0f,
-	0x16, 0x46, 0xbe, 0x3b, 0xbe, 0xc0, 0xa1, 0x87, 0x11, 0x81, 0xe1, 0xa0, 0xe3, 0x38, 0x2c, 0xf2,
-	0xff, 0x24, 0x64, 0x1e, 0x4c, 0xc6, 0x90, 0x76, 0x21, 0x42, 0xa1, 0x94, 0x51, 0xb5, 0x26, 0x62,
-	0x48, 0x3b, 0x08, 0x85, 0x09, 0xe4, 0xc2, 0xc8, 0xc5, 0x5d, 0x82, 0xea, 0xe5, 0x45, 0xa5, 0x55,
-	0xb1, 0x26, 0xe4, 0x7d, 0x07, 0xe9, 0x5f, 0x14, 0xa0, 0xbd, 0xe2, 0x1e, 0x7b, 0x7e, 0x1c, 0x91,
-	0x18, 0x52, 0xec, 0x8b, 0x97, 0x11, 0x15, 0x24, 0xa0, 0x04, 0x87, 0x16, 0x76, 0x58, 0x88, 0xd4,
-	0xc7, 0xe0, 0x3e, 0x0e, 0x98, 0xd3, 0xeb, 0xfa, 0x91, 0x67, 0xe3, 0x50, 0x66, 0x2d, 0x5b, 0x53,
-	0xd2, 0xb6, 0x27, 0x4d, 0x23, 0x45, 0x63, 0x45, 0x45, 0x0e, 0x00, 0x5e, 0x4e, 0x26, 0x13, 0x57,
-	0x37, 0xb7, 0xce, 0x2e, 0x9a, 0xa5, 0x6f, 0x17, 0xcd, 0x27, 0x2e, 0x11, 0xbd, 0xc8, 0x36, 0x1c,
-	0xe6, 0x99, 0xd9, 0x28, 0xd2, 0xcf, 0x1a, 0x47, 0x7d, 0x33, 0xe9, 0x25, 0x37, 0xb6, 0xb1, 0x73,
-	0x7d, 0xd1, 0x9c, 0x1e, 0x40, 0x8f, 0x6e, 0xe8, 0x23, 0x26, 0xdd, 0x2a, 0xd0, 0xea, 0xd7, 0x63,
-	0xa0, 0x31, 0x6a, 0xd7, 0x36, 0xa6, 0xd8, 0x95, 0x8b, 0x90, 0x89, 0x5f, 0x05, 0xd3, 0x28, 0xb5,
-	0xb1, 0x50, 0xf6, 0x06, 0x73, 0x9e, 0xf5, 0xad, 0x96, 0x03, 0x9d, 0xd4, 0x9e, 0x38, 0xc7, 0x90,
-	0x12, 0xf4, 0x9b, 0x73, 0x5a, 0x52, 0x2d, 0x07, 0x86, 0xce, 0xef, 0x72, 0x66, 0xc2, 0xfc, 0x2e,
-	0xf4, 0x92, 0xd1, 0xc8, 0x22, 0xa7, 0xd6, 0xe7, 0x8d, 0xb4, 0x16, 0x23, 0xd9, 0x2e, 0x23, 0xdb,
-	0x2e, 0x63, 0x8b, 0x11, 0x7f, 0xd3, 0x4c, 0xea, 0xff, 0xfc, 0xbd, 0xb9, 0xfc, 0x0f, 0xf5, 0x27,
-	0x01, 0xb9, 0x4a, 0xc2, 0xfc, 0x8e, 0xcc, 0xa1, 0x7e, 0x50, 0x40, 0x1d, 0xe7, 0xe3, 0xea, 0x72,
-	0x01, 0xfb, 0x18, 0x0d, 0x05, 0x54, 0xfe, 0x26, 0x60, 0xf5, 0x7f, 0x92, 0xcf, 0x8d, 0xf2, 0x1c,
-	0xc8, 0x34, 0xa9, 0x04, 0xfd, 0x18, 0x2c, 0xed, 0x32, 0xa7, 0xbf, 0x73, 0xd7, 0x7a, 0x6e, 0x31,
-	0xdf, 0xc7, 0x4e, 0xa2, 0x57, 0x7d, 0x04, 0x26, 0x28, 0x73, 0xfa, 0xc9, 0xda, 0x29, 0x72, 0xed,
-	0xc6, 0xa9, 0x8c, 0x52, 0xdb, 0x60, 0x96, 0x14, 0x22, 0xbb, 0x30, 0x0d, 0xcd, 0x7a, 0x3d, 0x43,
-	0x6e, 0xb3, 0xea, 0x2b, 0x60, 0xee, 0x8d, 0x1f, 0x30, 0x46, 0xdf, 0xf6, 0x88, 0xc0, 0x94, 0x70,
-	0x81, 0xd1, 0x3e, 0x63, 0x94, 0xab, 0x35, 0x50, 0x26, 0x28, 0x19, 0x6a, 0xb9, 0x55, 0xb1, 0x92,
-	0xe3, 0xca, 0x21, 0x98, 0xb9, 0xe3, 0x6f, 0x53, 0x17, 0xc0, 0xfc, 0x1d, 0xe6, 0x3d, 0x28, 0x48,
-	0x8c, 0x6b, 0x25, 0x55, 0x2b, 0x2e, 0x52, 0x0e, 0xef, 0xee, 0x1f, 0xf4, 0x60, 0x88, 0x6b, 0x4a,
-	0xa3, 0x72, 0xf2, 0x49, 0x2b, 0x6d, 0xee, 0x9f, 0x5d, 0x6a, 0xca, 0xf9, 0xa5, 0xa6, 0xfc, 0xb8,
-	0xd4, 0x94, 0x8f, 0x57, 0x5a, 0xe9, 0xfc, 0x4a, 0x2b, 0x7d, 0xbd, 0xd2, 0x4a, 0x87, 0xcf, 0x0a,
-	0x5d, 0xcd, 0xfe, 0xff, 0x35, 0x0a, 0x6d, 0x3e, 0xbc, 0x98, 0x71, 0x7b, 0xdd, 0x7c, 0x5f, 0x7c,
-	0x00, 0x65, 0xa7, 0xed, 0x71, 0xf9, 0xe2, 0x3c, 0xfd, 0x15, 0x00, 0x00, 0xff, 0xff, 0xe9, 0x5d,
-	0xb4, 0xdb, 0x23, 0x05, 0x00, 0x00,
+	// 1141 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xcf, 0x4f, 0xdb, 0x56,
+	0x1c, 0x8f, 0x49, 0x1a, 0xca, 0x4b, 0x29, 0xc1, 0xa1, 0x14, 0xb2, 0x11, 0x53, 0x23, 0x15, 0x06,
+	0xaa, 0x2d, 0xb2, 0x69, 0x87, 0x5e, 0xa6, 0x04, 0xba, 0x09, 0x89, 0x75, 0xc8, 0x61, 0x43, 0xaa,
+	0x34, 0x79, 0x2f, 0xf6, 0x23, 0x79, 0xc2, 0xf6, 0x73, 0xfd, 0x9e, 0xc3, 0xd8, 0x34, 0x69, 0x9a,
+	0x76, 0xe8, 0xb1, 0xe7, 0x49, 0x93, 0x2a, 0xed, 0xd6, 0xd3, 0xfe, 0x8c, 0x1e, 0x7b, 0x9c, 0x76,
+	0x08, 0x13, 0x5c, 0x76, 0xce, 0xfe, 0x81, 0xe9, 0x3d, 0xdb, 0xb1, 0x07, 0x66, 0x85, 0x53, 0xfc,
+	0xbe, 0x3f, 0x3e, 0xdf, 0xcf, 0xfb, 0xfe, 0x7a, 0x01, 0x2b, 0x84, 0xba, 0x84, 0x62, 0xaa, 0xd3,
+	0xd0, 0x47, 0xc1, 0xa1, 0x13, 0x62, 0x3b, 0xf3, 0xa9, 0xf9, 0x01, 0x61, 0x44, 0x96, 0x63, 0x23,
+	0x2d, 0xd5, 0xd4, 0xe7, 0x7a, 0xa4, 0x47, 0x84, 0x5a, 0xe7, 0x5f, 0x91, 0x65, 0xbd, 0xd1, 0x23,
+	0xa4, 0xe7, 0x20, 0x5d, 0x9c, 0xba, 0xe1, 0xa1, 0x6e, 0x87, 0x01, 0x64, 0x98, 0x78, 0xb1, 0x5e,
+	0xb9, 0xa8, 0x67, 0xd8, 0x45, 0x94, 0x41, 0xd7, 0x4f, 0x00, 0x2c, 0x11, 0x4b, 0xef, 0x42, 0x8a,
+	0xf4, 0xc1, 0x66, 0x17, 0x31, 0xb8, 0xa9, 0x5b, 0x04, 0xc7, 0x00, 0xea, 0x09, 0x98, 0xe9, 0x8c,
+	0x49, 0xb4, 0x28, 0x45, 0x4c, 0x9e, 0x03, 0xb7, 0x6c, 0xe4, 0x11, 0x77, 0x41, 0x5a, 0x96, 0xd6,
+	0xa6, 0x8c, 0xe8, 0x20, 0x7f, 0x0a, 0x00, 0xe4, 0x6a, 0x93, 0x9d, 0xf8, 0x68, 0x61, 0x62, 0x59,
+	0x5a, 0xbb, 0xdb, 0x5c, 0xd5, 0x2e, 0x5f, 0x44, 0xbb, 0x00, 0xb7, 0x7f, 0xe2, 0x23, 0x63, 0x0a,
+	0x26, 0x9f, 0x8f, 0x6f, 0xbf, 0x78, 0xa5, 0x14, 0xfe, 0x7e, 0xa5, 0x48, 0xea, 0xcf, 0x12, 0x58,
+	0x4a, 0x8d, 0x77, 0x3c, 0x86, 0x02, 0x17, 0xd9, 0x18, 0x06, 0x27, 0x2d, 0xcb, 0x22, 0xa1, 0x77,
+	0x15, 0x93, 0x45, 0x70, 0x7b, 0x00, 0x1d, 0x13, 0xda, 0x76, 0x20, 0x78, 0x4c, 0x19, 0x93, 0x03,
+	0xe8, 0xb4, 0x6c, 0x3b, 0xe0, 0xaa, 0x1e, 0x0c, 0x7b, 0xc8, 0xc4, 0xf6, 0x42, 0x71, 0x59, 0x5a,
+	0x2b, 0x19, 0x93, 0xe2, 0xbc, 0x63, 0xcb, 0xf7, 0xc1, 0xa4, 0x43, 0xac, 0x23, 0xae, 0x29, 0x09,
+	0x4d, 0x99, 0x1f, 0x77, 0x6c, 0xf5, 0xd7, 0x09, 0xd0, 0xf8, 0x82, 0xba, 0xe4, 0xc9, 0xf3, 0x10,
+	0x0f, 0xa0, 0x83, 0x3c, 0xf6, 0x79, 0xe8, 0x30, 0xec, 0x3b, 0x18, 0x05, 0x06, 0xb2, 0x48, 0x60,
+	0xcb, 0x0f, 0xc0, 0x1d, 0xe4, 0x13, 0xab, 0x6f, 0x7a, 0xa1, 0xdb, 0x45, 0x81, 0xa0, 0x53, 0x34,
+	0x2a, 0x42, 0xf6, 0x54, 0x88, 0x52, 0xaa, 0x13, 0x59, 0xaa, 0x16, 0x00, 0xee, 0x18, 0x4c, 0x30,
+	0x9a, 0x6a, 0x6f, 0xbd, 0x19, 0x2a, 0x85, 0x3f, 0x87, 0xca, 0xc3, 0x1e, 0x66, 0xfd, 0xb0, 0xab,
+	0x59, 0xc4, 0xd5, 0xe3, 0x22, 0x45, 0x3f, 0x8f, 0xa8, 0x7d, 0xa4, 0xf3, 0x2c, 0x53, 0x6d, 0x1b,
+	0x59, 0xa3, 0xa1, 0x32, 0x7b, 0x02, 0x5d, 0xe7, 0xb1, 0x9a, 0x22, 0xa9, 0x46, 0x06, 0x56, 0xfe,
+	0x1a, 0x94, 0xb1, 0xe7, 0x87, 0x8c, 0x8a, 0x8b, 0x55, 0x9a, 0xcd, 0xbc, 0xaa, 0x5c, 0x75, 0xc3,
+	0x1d, 0xe1, 0xd9, 0x9e, 0x1d, 0x0d, 0x95, 0xe9, 0x28, 0x4c, 0x84, 0xa5, 0x1a, 0x31, 0xa8, 0xfa,
+	0xd3, 0x2d, 0xd0, 0xf8, 0x7f, 0x6f, 0x19, 0x81, 0x3b, 0x7e, 0x80, 0x2d, 0x64, 0x52, 0x12, 0x06,
+	0x16, 0x12, 0xf9, 0xb9, 0xdb, 0xfc, 0x20, 0x8f, 0x47, 0xea, 0xbb, 0xc7, 0x3d, 0x3a, 0xc2, 0xa1,
+	0x7d, 0x7f, 0x34, 0x54, 0x6a, 0x51, 0xf8, 0x2c, 0x90, 0x6a, 0x54, 0xfc, 0xd4, 0x4a, 0x3e, 0x04,
+	0x33, 0xec, 0x18, 0xfa, 0x26, 0x65, 0x30, 0x60, 0x26, 0xef, 0x74, 0x91, 0xed, 0x4a, 0xb3, 0xae,
+	0x45, 0x63, 0xa0, 0x25, 0x63, 0xa0, 0xed, 0x27, 0x63, 0xd0, 0x56, 0x79, 0xba, 0x47, 0x43, 0x65,
+	0x3e, 0x82, 0xbf, 0x00, 0xa0, 0xbe, 0x3c, 0x55, 0x24, 0x63, 0x9a, 0x4b, 0x3b, 0x5c, 0xc8, 0xfd,
+	0xe4, 0x6f, 0x80, 0x10, 0x98, 0xc8, 0xb3, 0xa3, 0x28, 0xc5, 0x77, 0x46, 0x59, 0x8e, 0xa3, 0xcc,
+	0x65, 0xa2, 0x24, 0xee, 0x51, 0x8c, 0x0a, 0x97, 0x3d, 0xf1, 0x6c, 0x11, 0xe1, 0x3b, 0x50, 0x16,
+	0x17, 0xe3, 0x25, 0x2b, 0xae, 0x55, 0x9a, 0xef, 0x6b, 0x51, 0xe9, 0x35, 0x3e, 0xa6, 0x5a, 0x3c,
+	0xa6, 0xbc, 0xfa, 0x5b, 0x04, 0x7b, 0xed, 0xed, 0x18, 0x7c, 0x3a, 0x93, 0x21, 0xaa, 0xbe, 0x3e,
+	0x55, 0x36, 0xae, 0xd7, 0x42, 0x1c, 0x84, 0x1a, 0x71, 0x44, 0xf9, 0x07, 0x50, 0x0b, 0x3d, 0x0b,
+	0xfa, 0x3e, 0xb2, 0xcd, 0x4c, 0x73, 0xde, 0x12, 0xcd, 0xb9, 0x7b, 0xe3, 0xe6, 0xac, 0x47, 0xa4,
+	0x72, 0x20, 0x55, 0x43, 0x4e, 0xa4, 0x69, 0xd5, 0xe5, 0x8f, 0x00, 0x88, 0xb2, 0x13, 0x04, 0x24,
+	0x58, 0x28, 0x8b, 0xa8, 0xf7, 0xd2, 0x26, 0x4f, 0x75, 0xaa, 0x31, 0x25, 0x52, 0x26, 0xbe, 0x47,
+	0x13, 0xa0, 0x9e, 0xee, 0x8a, 0x6d, 0xe4, 0xa0, 0x9e, 0x58, 0x83, 0xf1, 0x80, 0x6e, 0x80, 0x59,
+	0x3b, 0x92, 0x91, 0x40, 0x2c, 0x06, 0x44, 0x69, 0xbc, 0x34, 0xaa, 0x63, 0x45, 0x2b, 0x92, 0x73,
+	0xe3, 0x01, 0x74, 0xb0, 0xfd, 0x1f, 0xe3, 0x68, 0x6c, 0xab, 0x63, 0x45, 0x62, 0x7c, 0x3c, 0x46,
+	0xc6, 0xc4, 0x33, 0xa1, 0xcb, 0xf7, 0x52, 0xdc, 0x0f, 0x8b, 0xb9, 0x45, 0x13, 0x15, 0xd3, 0x79,
+	0x1a, 0x5f, 0x9f, 0x2a, 0xab, 0xd7, 0x48, 0x23, 0x77, 0x18, 0xb3, 0xc4, 0xc4, 0x6b, 0x89, 0x18,
+	0xf2, 0x8f, 0x12, 0x58, 0x40, 0xe3, 0x91, 0xe3, 0x2d, 0x7b, 0x84, 0xec, 0x84, 0x40, 0xe9, 0x5d,
+	0x04, 0x36, 0x6e, 0x12, 0x7c, 0x3e, 0x8d, 0xd3, 0x11, 0x61, 0x22, 0x0a, 0xea, 0x73, 0xb0, 0xb2,
+	0x2b, 0x76, 0x64, 0xce, 0x6e, 0xde, 0x22, 0x9e, 0x87, 0x2c, 0xce, 0x37, 0xbb, 0x59, 0xa5, 0xec,
+	0x66, 0x95, 0x37, 0xc1, 0x1c, 0xce, 0x78, 0x9a, 0x30, 0x72, 0x8d, 0x73, 0x5d, 0xc3, 0x97, 0x51,
+	0xd5, 0x75, 0x30, 0xff, 0xa5, 0xe7, 0x13, 0xe2, 0x1c, 0xf4, 0x31, 0x43, 0x0e, 0xa6, 0x0c, 0xd9,
+	0x7b, 0x84, 0x38, 0x54, 0xae, 0x82, 0x22, 0xb6, 0x79, 0x51, 0x8b, 0x6b, 0x25, 0x83, 0x7f, 0xaa,
+	0xbf, 0x48, 0x60, 0xe6, 0xab, 0xa4, 0x5e, 0x07, 0x08, 0xf7, 0xfa, 0x4c, 0xd6, 0x32, 0x6f, 0x83,
+	0xa8, 0x7f, 0xbb, 0x36, 0x1a, 0x2a, 0x33, 0x51, 0x6f, 0x25, 0x1a, 0x35, 0x7d, 0x30, 0x0e, 0x40,
+	0xf9, 0x58, 0x78, 0x46, 0xa4, 0xda, 0x9f, 0xdc, 0xb8, 0xff, 0xe3, 0xa1, 0x8c, 0x50, 0x54, 0x23,
+	0x86, 0x53, 0xff, 0x91, 0x40, 0x95, 0x27, 0x6f, 0x4c, 0xb0, 0x83, 0xd8, 0xd5, 0x99, 0xda, 0x01,
+	0x60, 0xdc, 0x79, 0xbc, 0x17, 0xf9, 0x4e, 0x58, 0xc9, 0x5b, 0x9f, 0x17, 0xee, 0xdb, 0x2e, 0x71,
+	0xbe, 0x46, 0xc6, 0x59, 0xfe, 0x1e, 0xcc, 0x85, 0x5e, 0x97, 0x78, 0x36, 0xf6, 0x7a, 0x66, 0x06,
+	0xb4, 0x78, 0x7d, 0xd0, 0x95, 0x78, 0xdf, 0xbc, 0x97, 0x8c, 0xf6, 0x65, 0x38, 0xd5, 0xa8, 0x8d,
+	0xc5, 0x63, 0x77, 0xba, 0xfe, 0x0c, 0xd4, 0x72, 0x9e, 0x7f, 0x79, 0x09, 0x2c, 0xe6, 0x88, 0x9f,
+	0x42, 0x86, 0x07, 0xa8, 0x5a, 0x90, 0x1b, 0xa0, 0x9e, 0xa3, 0xde, 0xdd, 0xeb, 0xf4, 0x61, 0x80,
+	0xaa, 0x52, 0xbd, 0xf4, 0xe2, 0xb7, 0x46, 0x61, 0xfd, 0x77, 0x09, 0xdc, 0xcb, 0x7d, 0x3d, 0x38,
+	0x7c, 0xae, 0xa2, 0xe3, 0x13, 0x56, 0x2d, 0xc8, 0xab, 0x60, 0x25, 0x57, 0xdd, 0x0a, 0x30, 0xeb,
+	0xbb, 0x88, 0x61, 0x6b, 0xff, 0x18, 0xfa, 0x55, 0x49, 0x7e, 0x08, 0xd4, 0x5c, 0xc3, 0xcf, 0x10,
+	0x71, 0x11, 0x0b, 0x62, 0xbb, 0x09, 0xf9, 0x01, 0x58, 0xca, 0xb5, 0xdb, 0x0b, 0xd0, 0x00, 0x93,
+	0x90, 0x56, 0x8b, 0x11, 0xe5, 0xf6, 0xde, 0x9b, 0xb3, 0x86, 0xf4, 0xf6, 0xac, 0x21, 0xfd, 0x75,
+	0xd6, 0x90, 0x5e, 0x9e, 0x37, 0x0a, 0x6f, 0xcf, 0x1b, 0x85, 0x3f, 0xce, 0x1b, 0x85, 0x67, 0x1f,
+	0x67, 0xfa, 0x2b, 0xae, 0xc8, 0x23, 0x07, 0x76, 0x69, 0x72, 0xd0, 0x07, 0x9b, 0x4d, 0xfd, 0xdb,
+	0xec, 0x9f, 0x48, 0xd1, 0x73, 0xdd, 0xb2, 0x78, 0x7b, 0x3e, 0xfc, 0x77, 0x00, 0x06, 0xae, 0x6a,
+	0xc3, 0x67, 0x0a, 0x00, 0x00,
 }
 
 func (this *SuperfluidAsset) Equal(that interface{}) bool {
@@ -533,6 +826,11 @@ func (m *SuperfluidIntermediaryAccount) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if m.LockId != 0 {
+		i = encodeVarintSuperfluid(dAtA, i, uint64(m.LockId))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.GaugeId != 0 {
 		i = encodeVarintSuperfluid(dAtA, i, uint64(m.GaugeId))
 		i--
@@ -575,6 +873,18 @@ func (m *OsmoEquivalentMultiplierRecord) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if m.Inputs != nil {
+		{
+			size, err := m.Inputs.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSuperfluid(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	{
 		size := m.Multiplier.Size()
 		i -= size
@@ -600,6 +910,81 @@ func (m *OsmoEquivalentMultiplierRecord) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *OsmoEquivalentMultiplierInputs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OsmoEquivalentMultiplierInputs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OsmoEquivalentMultiplierInputs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TwapError) > 0 {
+		i -= len(m.TwapError)
+		copy(dAtA[i:], m.TwapError)
+		i = encodeVarintSuperfluid(dAtA, i, uint64(len(m.TwapError)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size := m.UncappedMultiplier.Size()
+		i -= size
+		if _, err := m.UncappedMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSuperfluid(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Prices) > 0 {
+		for iNdEx := len(m.Prices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Prices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSuperfluid(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.TwapEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.TwapEndTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintSuperfluid(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.TwapStartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.TwapStartTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintSuperfluid(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	if m.PriceSource != 0 {
+		i = encodeVarintSuperfluid(dAtA, i, uint64(m.PriceSource))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SuperfluidDelegationRecord) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -715,26 +1100,122 @@ func (m *UnpoolWhitelistedPools) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	var l int
 	_ = l
 	if len(m.Ids) > 0 {
-		dAtA4 := make([]byte, len(m.Ids)*10)
-		var j3 int
+		dAtA7 := make([]byte, len(m.Ids)*10)
+		var j6 int
 		for _, num := range m.Ids {
 			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j3++
+				j6++
 			}
-			dAtA4[j3] = uint8(num)
-			j3++
+			dAtA7[j6] = uint8(num)
+			j6++
+		}
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintSuperfluid(dAtA, i, uint64(j6))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorWeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorWeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
-		i -= j3
-		copy(dAtA[i:], dAtA4[:j3])
-		i = encodeVarintSuperfluid(dAtA, i, uint64(j3))
+		i = encodeVarintSuperfluid(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValAddr) > 0 {
+		i -= len(m.ValAddr)
+		copy(dAtA[i:], m.ValAddr)
+		i = encodeVarintSuperfluid(dAtA, i, uint64(len(m.ValAddr)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
+func (m *LockValidatorSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockValidatorSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockValidatorSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UnbondingValidators) > 0 {
+		for iNdEx := len(m.UnbondingValidators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnbondingValidators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSuperfluid(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSuperfluid(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.LockId != 0 {
+		i = encodeVarintSuperfluid(dAtA, i, uint64(m.LockId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintSuperfluid(dAtA []byte, offset int, v uint64) int {
 	offset -= sovSuperfluid(v)
 	base := offset
@@ -779,8 +1260,11 @@ func (m *SuperfluidIntermediaryAccount) Size() (n int) {
 	if m.GaugeId != 0 {
 		n += 1 + sovSuperfluid(uint64(m.GaugeId))
 	}
-	return n
-}
+	if m.LockId != 0 {
+		n += 1 + sovSuperfluid(uint64(m.LockId))
+	}
+	return n
+}
 
 func (m *OsmoEquivalentMultiplierRecord) Size() (n int) {
 	if m == nil {
@@ -797,6 +1281,38 @@ func (m *OsmoEquivalentMultiplierRecord) Size() (n int) {
 	}
 	l = m.Multiplier.Size()
 	n += 1 + l + sovSuperfluid(uint64(l))
+	if m.Inputs != nil {
+		l = m.Inputs.Size()
+		n += 1 + l + sovSuperfluid(uint64(l))
+	}
+	return n
+}
+
+func (m *OsmoEquivalentMultiplierInputs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PriceSource != 0 {
+		n += 1 + sovSuperfluid(uint64(m.PriceSource))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.TwapStartTime)
+	n += 1 + l + sovSuperfluid(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.TwapEndTime)
+	n += 1 + l + sovSuperfluid(uint64(l))
+	if len(m.Prices) > 0 {
+		for _, e := range m.Prices {
+			l = e.Size()
+			n += 1 + l + sovSuperfluid(uint64(l))
+		}
+	}
+	l = m.UncappedMultiplier.Size()
+	n += 1 + l + sovSuperfluid(uint64(l))
+	l = len(m.TwapError)
+	if l > 0 {
+		n += 1 + l + sovSuperfluid(uint64(l))
+	}
 	return n
 }
 
@@ -855,6 +1371,45 @@ func (m *UnpoolWhitelistedPools) Size() (n int) {
 	return n
 }
 
+func (m *ValidatorWeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValAddr)
+	if l > 0 {
+		n += 1 + l + sovSuperfluid(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovSuperfluid(uint64(l))
+	return n
+}
+
+func (m *LockValidatorSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LockId != 0 {
+		n += 1 + sovSuperfluid(uint64(m.LockId))
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovSuperfluid(uint64(l))
+		}
+	}
+	if len(m.UnbondingValidators) > 0 {
+		for _, e := range m.UnbondingValidators {
+			l = e.Size()
+			n += 1 + l + sovSuperfluid(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovSuperfluid(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1074,6 +1629,25 @@ func (m *SuperfluidIntermediaryAccount) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
+			}
+			m.LockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSuperfluid(dAtA[iNdEx:])
@@ -1209,6 +1783,42 @@ func (m *OsmoEquivalentMultiplierRecord) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Inputs == nil {
+				m.Inputs = &OsmoEquivalentMultiplierInputs{}
+			}
+			if err := m.Inputs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSuperfluid(dAtA[iNdEx:])
@@ -1230,7 +1840,7 @@ func (m *OsmoEquivalentMultiplierRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SuperfluidDelegationRecord) Unmarshal(dAtA []byte) error {
+func (m *OsmoEquivalentMultiplierInputs) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1253,17 +1863,36 @@ func (m *SuperfluidDelegationRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SuperfluidDelegationRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: OsmoEquivalentMultiplierInputs: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SuperfluidDelegationRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OsmoEquivalentMultiplierInputs: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceSource", wireType)
+			}
+			m.PriceSource = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriceSource |= MultiplierPriceSource(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapStartTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSuperfluid
@@ -1273,29 +1902,30 @@ func (m *SuperfluidDelegationRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSuperfluid
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthSuperfluid
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.TwapStartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapEndTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSuperfluid
@@ -1305,27 +1935,28 @@ func (m *SuperfluidDelegationRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSuperfluid
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthSuperfluid
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.TwapEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegationAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1352,15 +1983,16 @@ func (m *SuperfluidDelegationRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DelegationAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Prices = append(m.Prices, types1.DecCoin{})
+			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EquivalentStakedAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UncappedMultiplier", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSuperfluid
@@ -1370,28 +2002,58 @@ func (m *SuperfluidDelegationRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSuperfluid
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSuperfluid
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EquivalentStakedAmount == nil {
-				m.EquivalentStakedAmount = &types.Coin{}
-			}
-			if err := m.EquivalentStakedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.UncappedMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TwapError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSuperfluid(dAtA[iNdEx:])
@@ -1413,7 +2075,7 @@ func (m *SuperfluidDelegationRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LockIdIntermediaryAccountConnection) Unmarshal(dAtA []byte) error {
+func (m *SuperfluidDelegationRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1436,17 +2098,17 @@ func (m *LockIdIntermediaryAccountConnection) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LockIdIntermediaryAccountConnection: wiretype end group for non-group")
+			return fmt.Errorf("proto: SuperfluidDelegationRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LockIdIntermediaryAccountConnection: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SuperfluidDelegationRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
-			m.LockId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSuperfluid
@@ -1456,14 +2118,27 @@ func (m *LockIdIntermediaryAccountConnection) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LockId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IntermediaryAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1491,19 +2166,189 @@ func (m *LockIdIntermediaryAccountConnection) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IntermediaryAccount = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSuperfluid(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationAmount", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthSuperfluid
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelegationAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EquivalentStakedAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EquivalentStakedAmount == nil {
+				m.EquivalentStakedAmount = &types1.Coin{}
+			}
+			if err := m.EquivalentStakedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSuperfluid(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LockIdIntermediaryAccountConnection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSuperfluid
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockIdIntermediaryAccountConnection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockIdIntermediaryAccountConnection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
+			}
+			m.LockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntermediaryAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IntermediaryAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSuperfluid(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
 		}
@@ -1640,6 +2485,259 @@ func (m *UnpoolWhitelistedPools) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ValidatorWeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSuperfluid
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorWeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorWeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSuperfluid(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LockValidatorSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSuperfluid
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockValidatorSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockValidatorSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
+			}
+			m.LockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, ValidatorWeight{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingValidators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondingValidators = append(m.UnbondingValidators, ValidatorWeight{})
+			if err := m.UnbondingValidators[len(m.UnbondingValidators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSuperfluid(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipSuperfluid(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
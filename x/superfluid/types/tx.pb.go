@@ -514,6 +514,433 @@ func (m *MsgUnPoolWhitelistedPoolResponse) GetExitedLockIds() []uint64 {
 	return nil
 }
 
+// MsgSuperfluidDelegateToValidatorSet superfluid delegates a lock to a weighted
+// validator set. The OSMO-equivalent of the lock is split across the
+// validators by weight, and the weights must add up to one.
+type MsgSuperfluidDelegateToValidatorSet struct {
+	Sender     string            `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	LockId     uint64            `protobuf:"varint,2,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
+	Validators []ValidatorWeight `protobuf:"bytes,3,rep,name=validators,proto3" json:"validators"`
+}
+
+func (m *MsgSuperfluidDelegateToValidatorSet) Reset()         { *m = MsgSuperfluidDelegateToValidatorSet{} }
+func (m *MsgSuperfluidDelegateToValidatorSet) String() string { return proto.CompactTextString(m) }
+func (*MsgSuperfluidDelegateToValidatorSet) ProtoMessage()    {}
+func (*MsgSuperfluidDelegateToValidatorSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55b645f187d22814, []int{10}
+}
+func (m *MsgSuperfluidDelegateToValidatorSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSuperfluidDelegateToValidatorSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSuperfluidDelegateToValidatorSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSuperfluidDelegateToValidatorSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSuperfluidDelegateToValidatorSet.Merge(m, src)
+}
+func (m *MsgSuperfluidDelegateToValidatorSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSuperfluidDelegateToValidatorSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSuperfluidDelegateToValidatorSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSuperfluidDelegateToValidatorSet proto.InternalMessageInfo
+
+func (m *MsgSuperfluidDelegateToValidatorSet) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSuperfluidDelegateToValidatorSet) GetLockId() uint64 {
+	if m != nil {
+		return m.LockId
+	}
+	return 0
+}
+
+func (m *MsgSuperfluidDelegateToValidatorSet) GetValidators() []ValidatorWeight {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+type MsgSuperfluidDelegateToValidatorSetResponse struct {
+}
+
+func (m *MsgSuperfluidDelegateToValidatorSetResponse) Reset() {
+	*m = MsgSuperfluidDelegateToValidatorSetResponse{}
+}
+func (m *MsgSuperfluidDelegateToValidatorSetResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgSuperfluidDelegateToValidatorSetResponse) ProtoMessage() {}
+func (*MsgSuperfluidDelegateToValidatorSetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55b645f187d22814, []int{11}
+}
+func (m *MsgSuperfluidDelegateToValidatorSetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSuperfluidDelegateToValidatorSetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSuperfluidDelegateToValidatorSetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSuperfluidDelegateToValidatorSetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSuperfluidDelegateToValidatorSetResponse.Merge(m, src)
+}
+func (m *MsgSuperfluidDelegateToValidatorSetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSuperfluidDelegateToValidatorSetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSuperfluidDelegateToValidatorSetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSuperfluidDelegateToValidatorSetResponse proto.InternalMessageInfo
+
+// MsgSuperfluidUpdateValidatorSet replaces the weighted validator set a lock is
+// superfluid delegated to, and rebalances the delegations of the lock to the
+// new weights.
+type MsgSuperfluidUpdateValidatorSet struct {
+	Sender     string            `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	LockId     uint64            `protobuf:"varint,2,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
+	Validators []ValidatorWeight `protobuf:"bytes,3,rep,name=validators,proto3" json:"validators"`
+}
+
+func (m *MsgSuperfluidUpdateValidatorSet) Reset()         { *m = MsgSuperfluidUpdateValidatorSet{} }
+func (m *MsgSuperfluidUpdateValidatorSet) String() string { return proto.CompactTextString(m) }
+func (*MsgSuperfluidUpdateValidatorSet) ProtoMessage()    {}
+func (*MsgSuperfluidUpdateValidatorSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55b645f187d22814, []int{12}
+}
+func (m *MsgSuperfluidUpdateValidatorSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSuperfluidUpdateValidatorSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSuperfluidUpdateValidatorSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSuperfluidUpdateValidatorSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSuperfluidUpdateValidatorSet.Merge(m, src)
+}
+func (m *MsgSuperfluidUpdateValidatorSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSuperfluidUpdateValidatorSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSuperfluidUpdateValidatorSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSuperfluidUpdateValidatorSet proto.InternalMessageInfo
+
+func (m *MsgSuperfluidUpdateValidatorSet) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSuperfluidUpdateValidatorSet) GetLockId() uint64 {
+	if m != nil {
+		return m.LockId
+	}
+	return 0
+}
+
+func (m *MsgSuperfluidUpdateValidatorSet) GetValidators() []ValidatorWeight {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+type MsgSuperfluidUpdateValidatorSetResponse struct {
+}
+
+func (m *MsgSuperfluidUpdateValidatorSetResponse) Reset() {
+	*m = MsgSuperfluidUpdateValidatorSetResponse{}
+}
+func (m *MsgSuperfluidUpdateValidatorSetResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSuperfluidUpdateValidatorSetResponse) ProtoMessage()    {}
+func (*MsgSuperfluidUpdateValidatorSetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55b645f187d22814, []int{13}
+}
+func (m *MsgSuperfluidUpdateValidatorSetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSuperfluidUpdateValidatorSetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSuperfluidUpdateValidatorSetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSuperfluidUpdateValidatorSetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSuperfluidUpdateValidatorSetResponse.Merge(m, src)
+}
+func (m *MsgSuperfluidUpdateValidatorSetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSuperfluidUpdateValidatorSetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSuperfluidUpdateValidatorSetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSuperfluidUpdateValidatorSetResponse proto.InternalMessageInfo
+
+// MsgSuperfluidPartialUndelegate superfluid undelegates the given amount of a
+// superfluid delegated lock. Unless the amount is the whole lock, the amount is
+// split off into a new lock along with its synthetic lockups, and the new lock
+// is undelegated.
+type MsgSuperfluidPartialUndelegate struct {
+	Sender string     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	LockId uint64     `protobuf:"varint,2,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
+	Coin   types.Coin `protobuf:"bytes,3,opt,name=coin,proto3" json:"coin"`
+}
+
+func (m *MsgSuperfluidPartialUndelegate) Reset()         { *m = MsgSuperfluidPartialUndelegate{} }
+func (m *MsgSuperfluidPartialUndelegate) String() string { return proto.CompactTextString(m) }
+func (*MsgSuperfluidPartialUndelegate) ProtoMessage()    {}
+func (*MsgSuperfluidPartialUndelegate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55b645f187d22814, []int{14}
+}
+func (m *MsgSuperfluidPartialUndelegate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSuperfluidPartialUndelegate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSuperfluidPartialUndelegate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSuperfluidPartialUndelegate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSuperfluidPartialUndelegate.Merge(m, src)
+}
+func (m *MsgSuperfluidPartialUndelegate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSuperfluidPartialUndelegate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSuperfluidPartialUndelegate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSuperfluidPartialUndelegate proto.InternalMessageInfo
+
+func (m *MsgSuperfluidPartialUndelegate) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSuperfluidPartialUndelegate) GetLockId() uint64 {
+	if m != nil {
+		return m.LockId
+	}
+	return 0
+}
+
+func (m *MsgSuperfluidPartialUndelegate) GetCoin() types.Coin {
+	if m != nil {
+		return m.Coin
+	}
+	return types.Coin{}
+}
+
+type MsgSuperfluidPartialUndelegateResponse struct {
+	// lock_id is the ID of the lock holding the undelegated amount
+	LockId uint64 `protobuf:"varint,1,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
+}
+
+func (m *MsgSuperfluidPartialUndelegateResponse) Reset() {
+	*m = MsgSuperfluidPartialUndelegateResponse{}
+}
+func (m *MsgSuperfluidPartialUndelegateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSuperfluidPartialUndelegateResponse) ProtoMessage()    {}
+func (*MsgSuperfluidPartialUndelegateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55b645f187d22814, []int{15}
+}
+func (m *MsgSuperfluidPartialUndelegateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSuperfluidPartialUndelegateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSuperfluidPartialUndelegateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSuperfluidPartialUndelegateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSuperfluidPartialUndelegateResponse.Merge(m, src)
+}
+func (m *MsgSuperfluidPartialUndelegateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSuperfluidPartialUndelegateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSuperfluidPartialUndelegateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSuperfluidPartialUndelegateResponse proto.InternalMessageInfo
+
+func (m *MsgSuperfluidPartialUndelegateResponse) GetLockId() uint64 {
+	if m != nil {
+		return m.LockId
+	}
+	return 0
+}
+
+// MsgSuperfluidUndelegateAndUnbondLock superfluid undelegates the given amount
+// of a lock, and starts unbonding the lock holding the undelegated amount. The
+// lock is split the same way as for MsgSuperfluidPartialUndelegate.
+type MsgSuperfluidUndelegateAndUnbondLock struct {
+	Sender string     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	LockId uint64     `protobuf:"varint,2,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
+	Coin   types.Coin `protobuf:"bytes,3,opt,name=coin,proto3" json:"coin"`
+}
+
+func (m *MsgSuperfluidUndelegateAndUnbondLock) Reset()         { *m = MsgSuperfluidUndelegateAndUnbondLock{} }
+func (m *MsgSuperfluidUndelegateAndUnbondLock) String() string { return proto.CompactTextString(m) }
+func (*MsgSuperfluidUndelegateAndUnbondLock) ProtoMessage()    {}
+func (*MsgSuperfluidUndelegateAndUnbondLock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55b645f187d22814, []int{16}
+}
+func (m *MsgSuperfluidUndelegateAndUnbondLock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSuperfluidUndelegateAndUnbondLock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSuperfluidUndelegateAndUnbondLock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSuperfluidUndelegateAndUnbondLock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSuperfluidUndelegateAndUnbondLock.Merge(m, src)
+}
+func (m *MsgSuperfluidUndelegateAndUnbondLock) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSuperfluidUndelegateAndUnbondLock) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSuperfluidUndelegateAndUnbondLock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSuperfluidUndelegateAndUnbondLock proto.InternalMessageInfo
+
+func (m *MsgSuperfluidUndelegateAndUnbondLock) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSuperfluidUndelegateAndUnbondLock) GetLockId() uint64 {
+	if m != nil {
+		return m.LockId
+	}
+	return 0
+}
+
+func (m *MsgSuperfluidUndelegateAndUnbondLock) GetCoin() types.Coin {
+	if m != nil {
+		return m.Coin
+	}
+	return types.Coin{}
+}
+
+type MsgSuperfluidUndelegateAndUnbondLockResponse struct {
+	// lock_id is the ID of the lock holding the undelegated amount
+	LockId uint64 `protobuf:"varint,1,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
+}
+
+func (m *MsgSuperfluidUndelegateAndUnbondLockResponse) Reset() {
+	*m = MsgSuperfluidUndelegateAndUnbondLockResponse{}
+}
+func (m *MsgSuperfluidUndelegateAndUnbondLockResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgSuperfluidUndelegateAndUnbondLockResponse) ProtoMessage() {}
+func (*MsgSuperfluidUndelegateAndUnbondLockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55b645f187d22814, []int{17}
+}
+func (m *MsgSuperfluidUndelegateAndUnbondLockResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSuperfluidUndelegateAndUnbondLockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSuperfluidUndelegateAndUnbondLockResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSuperfluidUndelegateAndUnbondLockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSuperfluidUndelegateAndUnbondLockResponse.Merge(m, src)
+}
+func (m *MsgSuperfluidUndelegateAndUnbondLockResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSuperfluidUndelegateAndUnbondLockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSuperfluidUndelegateAndUnbondLockResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSuperfluidUndelegateAndUnbondLockResponse proto.InternalMessageInfo
+
+func (m *MsgSuperfluidUndelegateAndUnbondLockResponse) GetLockId() uint64 {
+	if m != nil {
+		return m.LockId
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*MsgSuperfluidDelegate)(nil), "osmosis.superfluid.MsgSuperfluidDelegate")
 	proto.RegisterType((*MsgSuperfluidDelegateResponse)(nil), "osmosis.superfluid.MsgSuperfluidDelegateResponse")
@@ -525,50 +952,72 @@ func init() {
 	proto.RegisterType((*MsgLockAndSuperfluidDelegateResponse)(nil), "osmosis.superfluid.MsgLockAndSuperfluidDelegateResponse")
 	proto.RegisterType((*MsgUnPoolWhitelistedPool)(nil), "osmosis.superfluid.MsgUnPoolWhitelistedPool")
 	proto.RegisterType((*MsgUnPoolWhitelistedPoolResponse)(nil), "osmosis.superfluid.MsgUnPoolWhitelistedPoolResponse")
+	proto.RegisterType((*MsgSuperfluidDelegateToValidatorSet)(nil), "osmosis.superfluid.MsgSuperfluidDelegateToValidatorSet")
+	proto.RegisterType((*MsgSuperfluidDelegateToValidatorSetResponse)(nil), "osmosis.superfluid.MsgSuperfluidDelegateToValidatorSetResponse")
+	proto.RegisterType((*MsgSuperfluidUpdateValidatorSet)(nil), "osmosis.superfluid.MsgSuperfluidUpdateValidatorSet")
+	proto.RegisterType((*MsgSuperfluidUpdateValidatorSetResponse)(nil), "osmosis.superfluid.MsgSuperfluidUpdateValidatorSetResponse")
+	proto.RegisterType((*MsgSuperfluidPartialUndelegate)(nil), "osmosis.superfluid.MsgSuperfluidPartialUndelegate")
+	proto.RegisterType((*MsgSuperfluidPartialUndelegateResponse)(nil), "osmosis.superfluid.MsgSuperfluidPartialUndelegateResponse")
+	proto.RegisterType((*MsgSuperfluidUndelegateAndUnbondLock)(nil), "osmosis.superfluid.MsgSuperfluidUndelegateAndUnbondLock")
+	proto.RegisterType((*MsgSuperfluidUndelegateAndUnbondLockResponse)(nil), "osmosis.superfluid.MsgSuperfluidUndelegateAndUnbondLockResponse")
 }
 
 func init() { proto.RegisterFile("osmosis/superfluid/tx.proto", fileDescriptor_55b645f187d22814) }
 
 var fileDescriptor_55b645f187d22814 = []byte{
-	// 608 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0x8e, 0x93, 0x90, 0xc0, 0xa2, 0x16, 0x61, 0xb5, 0xaa, 0x63, 0xc0, 0x0e, 0x06, 0xa1, 0xa0,
-	0x52, 0x6f, 0x93, 0xa2, 0x0a, 0x71, 0x6b, 0xc8, 0x25, 0xa8, 0x91, 0x2a, 0xa3, 0x0a, 0x09, 0x09,
-	0x45, 0x76, 0x76, 0xeb, 0x5a, 0xd9, 0x78, 0x23, 0xaf, 0x63, 0xa5, 0xe2, 0x01, 0xb8, 0xf2, 0x1c,
-	0xbc, 0x08, 0x3d, 0xf6, 0xc8, 0x29, 0xa0, 0xe4, 0x0d, 0x2a, 0x1e, 0x00, 0xf9, 0x37, 0x14, 0xec,
-	0x90, 0x08, 0x7a, 0xf2, 0xce, 0xcc, 0xb7, 0xdf, 0x7c, 0xa3, 0x99, 0xf1, 0x82, 0x7b, 0x94, 0x0d,
-	0x28, 0xb3, 0x18, 0x64, 0xa3, 0x21, 0x76, 0x4e, 0xc8, 0xc8, 0x42, 0xd0, 0x1d, 0xab, 0x43, 0x87,
-	0xba, 0x94, 0xe7, 0xa3, 0xa0, 0x3a, 0x0f, 0x8a, 0x1b, 0x26, 0x35, 0x69, 0x10, 0x86, 0xfe, 0x29,
-	0x44, 0x8a, 0x92, 0x49, 0xa9, 0x49, 0x30, 0x0c, 0x2c, 0x63, 0x74, 0x02, 0xd1, 0xc8, 0xd1, 0x5d,
-	0x8b, 0xda, 0x71, 0xbc, 0x17, 0x50, 0x41, 0x43, 0x67, 0x18, 0x7a, 0x75, 0x03, 0xbb, 0x7a, 0x1d,
-	0xf6, 0xa8, 0x15, 0xc7, 0x1f, 0xa5, 0xc8, 0x98, 0x1f, 0x43, 0x90, 0xe2, 0x81, 0xcd, 0x0e, 0x33,
-	0xdf, 0x24, 0xee, 0x16, 0x26, 0xd8, 0xd4, 0x5d, 0xcc, 0x3f, 0x05, 0x25, 0x86, 0x6d, 0x84, 0x1d,
-	0x81, 0xab, 0x72, 0xb5, 0x5b, 0xcd, 0xbb, 0x97, 0x13, 0x79, 0xed, 0x4c, 0x1f, 0x90, 0x97, 0x4a,
-	0xe8, 0x57, 0xb4, 0x08, 0xc0, 0x6f, 0x81, 0x32, 0xa1, 0xbd, 0x7e, 0xd7, 0x42, 0x42, 0xbe, 0xca,
-	0xd5, 0x8a, 0x5a, 0xc9, 0x37, 0xdb, 0x88, 0xaf, 0x80, 0x9b, 0x9e, 0x4e, 0xba, 0x3a, 0x42, 0x8e,
-	0x50, 0xf0, 0x59, 0xb4, 0xb2, 0xa7, 0x93, 0x03, 0x84, 0x1c, 0x45, 0x06, 0x0f, 0x52, 0xf3, 0x6a,
-	0x98, 0x0d, 0xa9, 0xcd, 0xb0, 0xf2, 0x1e, 0x6c, 0x5d, 0x01, 0x1c, 0xdb, 0xe8, 0x3f, 0x4a, 0x53,
-	0x1e, 0x02, 0x39, 0x83, 0x7e, 0x81, 0x02, 0x83, 0xda, 0xe8, 0x90, 0xf6, 0xfa, 0xd7, 0xa4, 0x20,
-	0xa6, 0x4f, 0x14, 0x7c, 0xe1, 0xc0, 0xfd, 0x0e, 0x33, 0x7d, 0xdf, 0x81, 0x8d, 0xfe, 0xad, 0x49,
-	0x3a, 0xb8, 0xe1, 0xcf, 0x06, 0x13, 0xf2, 0xd5, 0x42, 0xed, 0x76, 0xa3, 0xa2, 0x86, 0xd3, 0xa3,
-	0xfa, 0xd3, 0xa3, 0x46, 0xd3, 0xa3, 0xbe, 0xa2, 0x96, 0xdd, 0xdc, 0x3d, 0x9f, 0xc8, 0xb9, 0xcf,
-	0xdf, 0xe4, 0x9a, 0x69, 0xb9, 0xa7, 0x23, 0x43, 0xed, 0xd1, 0x01, 0x8c, 0x46, 0x2d, 0xfc, 0xec,
-	0x30, 0xd4, 0x87, 0xee, 0xd9, 0x10, 0xb3, 0xe0, 0x02, 0xd3, 0x42, 0xe6, 0x45, 0xed, 0xde, 0x07,
-	0x8f, 0x17, 0x15, 0x12, 0x57, 0xcc, 0xaf, 0x83, 0x7c, 0xbb, 0x15, 0x14, 0x53, 0xd4, 0xf2, 0xed,
-	0x96, 0xe2, 0x00, 0xa1, 0xc3, 0xcc, 0x63, 0xfb, 0x88, 0x52, 0xf2, 0xf6, 0xd4, 0x72, 0x31, 0xb1,
-	0x98, 0x8b, 0x91, 0x6f, 0xae, 0x52, 0xfc, 0x36, 0x28, 0x0f, 0x29, 0x25, 0x49, 0x13, 0x9a, 0xfc,
-	0xe5, 0x44, 0x5e, 0x0f, 0xb1, 0x51, 0x40, 0xd1, 0x4a, 0xfe, 0xa9, 0x8d, 0x94, 0xd7, 0xa0, 0x9a,
-	0x95, 0x33, 0xd1, 0xf9, 0x04, 0xdc, 0xc1, 0x63, 0xcb, 0xc5, 0xa8, 0x1b, 0x35, 0x97, 0x09, 0x5c,
-	0xb5, 0x50, 0x2b, 0x6a, 0x6b, 0xa1, 0xfb, 0x30, 0xe8, 0x31, 0x6b, 0xfc, 0x28, 0x82, 0x42, 0x87,
-	0x99, 0xbc, 0x03, 0xf8, 0xb4, 0xf6, 0xa9, 0x7f, 0xfe, 0x0c, 0xd4, 0xd4, 0xb5, 0x10, 0xeb, 0x4b,
-	0x43, 0x13, 0x8d, 0x63, 0xb0, 0x91, 0xba, 0x3e, 0xdb, 0x7f, 0xa5, 0x9a, 0x83, 0xc5, 0xbd, 0x15,
-	0xc0, 0x59, 0x99, 0x93, 0xb5, 0x59, 0x26, 0x73, 0x0c, 0x5e, 0x2a, 0xf3, 0xef, 0x1b, 0xc3, 0x7f,
-	0xe4, 0x40, 0x25, 0x7b, 0x5d, 0x76, 0x33, 0x28, 0x33, 0x6f, 0x88, 0x2f, 0x56, 0xbd, 0x91, 0x28,
-	0xf9, 0x00, 0x36, 0xd3, 0xc7, 0xf6, 0x59, 0x06, 0x65, 0x2a, 0x5a, 0x7c, 0xbe, 0x0a, 0x3a, 0x4e,
-	0xde, 0x3c, 0x3a, 0x9f, 0x4a, 0xdc, 0xc5, 0x54, 0xe2, 0xbe, 0x4f, 0x25, 0xee, 0xd3, 0x4c, 0xca,
-	0x5d, 0xcc, 0xa4, 0xdc, 0xd7, 0x99, 0x94, 0x7b, 0xb7, 0xff, 0xcb, 0x52, 0x47, 0xcc, 0x3b, 0x44,
-	0x37, 0x58, 0x6c, 0x40, 0xaf, 0xde, 0x80, 0xe3, 0x2b, 0x2f, 0x97, 0xbf, 0xe8, 0x46, 0x29, 0x78,
-	0x2e, 0xf6, 0x7e, 0x06, 0x00, 0x00, 0xff, 0xff, 0x79, 0x4e, 0xaf, 0x04, 0xdc, 0x06, 0x00, 0x00,
+	// 829 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcd, 0x4e, 0xeb, 0x46,
+	0x14, 0xce, 0x24, 0x69, 0xe8, 0x3d, 0xd5, 0xbd, 0x55, 0xad, 0x8b, 0x08, 0x86, 0x3a, 0xc1, 0x20,
+	0x1a, 0x04, 0xd8, 0x24, 0xa9, 0x28, 0xa2, 0x8b, 0x36, 0x29, 0x52, 0x95, 0x8a, 0x48, 0xc8, 0x94,
+	0x22, 0x55, 0xaa, 0x90, 0x93, 0x19, 0x8c, 0x85, 0xf1, 0x44, 0x1e, 0x27, 0x0a, 0xea, 0x03, 0x74,
+	0x55, 0x89, 0x6d, 0xa5, 0x4a, 0xec, 0xbb, 0xea, 0xa2, 0xef, 0x50, 0x96, 0x2c, 0x59, 0xd1, 0x0a,
+	0xde, 0x80, 0x27, 0xa8, 0x6c, 0xc7, 0x13, 0x52, 0x9c, 0xdf, 0xa6, 0xd2, 0x5d, 0x65, 0x7e, 0xbe,
+	0xef, 0x9c, 0xef, 0xe8, 0xfc, 0x4c, 0x0c, 0x0b, 0x94, 0x5d, 0x50, 0x66, 0x32, 0x95, 0x35, 0x1b,
+	0xc4, 0x39, 0xb5, 0x9a, 0x26, 0x56, 0xdd, 0xb6, 0xd2, 0x70, 0xa8, 0x4b, 0x05, 0xa1, 0x73, 0xa9,
+	0x74, 0x2f, 0xc5, 0xb7, 0x06, 0x35, 0xa8, 0x7f, 0xad, 0x7a, 0xab, 0x00, 0x29, 0x4a, 0x06, 0xa5,
+	0x86, 0x45, 0x54, 0x7f, 0x57, 0x6b, 0x9e, 0xaa, 0xb8, 0xe9, 0xe8, 0xae, 0x49, 0xed, 0xf0, 0xbe,
+	0xee, 0x9b, 0x52, 0x6b, 0x3a, 0x23, 0x6a, 0x2b, 0x5f, 0x23, 0xae, 0x9e, 0x57, 0xeb, 0xd4, 0x0c,
+	0xef, 0x97, 0x23, 0x64, 0x74, 0x97, 0x01, 0x48, 0x6e, 0xc1, 0x6c, 0x95, 0x19, 0x87, 0xfc, 0x78,
+	0x8f, 0x58, 0xc4, 0xd0, 0x5d, 0x22, 0xac, 0x41, 0x8a, 0x11, 0x1b, 0x13, 0x27, 0x8d, 0xb2, 0x28,
+	0xf7, 0xaa, 0xfc, 0xd1, 0xd3, 0x7d, 0xe6, 0xf5, 0xa5, 0x7e, 0x61, 0xed, 0xca, 0xc1, 0xb9, 0xac,
+	0x75, 0x00, 0xc2, 0x1c, 0xcc, 0x58, 0xb4, 0x7e, 0x7e, 0x62, 0xe2, 0x74, 0x3c, 0x8b, 0x72, 0x49,
+	0x2d, 0xe5, 0x6d, 0x2b, 0x58, 0x98, 0x87, 0xf7, 0x5b, 0xba, 0x75, 0xa2, 0x63, 0xec, 0xa4, 0x13,
+	0x9e, 0x15, 0x6d, 0xa6, 0xa5, 0x5b, 0x25, 0x8c, 0x1d, 0x39, 0x03, 0x1f, 0x47, 0xfa, 0xd5, 0x08,
+	0x6b, 0x50, 0x9b, 0x11, 0xf9, 0x07, 0x98, 0xeb, 0x01, 0x1c, 0xd9, 0x78, 0x8a, 0xd2, 0xe4, 0x25,
+	0xc8, 0xf4, 0x31, 0x3f, 0x40, 0x41, 0x8d, 0xda, 0x78, 0x9f, 0xd6, 0xcf, 0xff, 0x27, 0x05, 0xa1,
+	0x79, 0xae, 0xe0, 0x4f, 0x04, 0x8b, 0x55, 0x66, 0x78, 0x67, 0x25, 0x1b, 0xff, 0xb7, 0x24, 0xe9,
+	0xf0, 0x9e, 0x57, 0x1b, 0x2c, 0x1d, 0xcf, 0x26, 0x72, 0x1f, 0x14, 0xe6, 0x95, 0xa0, 0x7a, 0x14,
+	0xaf, 0x7a, 0x94, 0x4e, 0xf5, 0x28, 0x5f, 0x51, 0xd3, 0x2e, 0x6f, 0xdd, 0xdc, 0x67, 0x62, 0xbf,
+	0xfd, 0x95, 0xc9, 0x19, 0xa6, 0x7b, 0xd6, 0xac, 0x29, 0x75, 0x7a, 0xa1, 0x76, 0x4a, 0x2d, 0xf8,
+	0xd9, 0x64, 0xf8, 0x5c, 0x75, 0x2f, 0x1b, 0x84, 0xf9, 0x04, 0xa6, 0x05, 0x96, 0x07, 0xa5, 0x7b,
+	0x1b, 0x56, 0x06, 0x05, 0x12, 0x46, 0x2c, 0xbc, 0x81, 0x78, 0x65, 0xcf, 0x0f, 0x26, 0xa9, 0xc5,
+	0x2b, 0x7b, 0xb2, 0x03, 0xe9, 0x2a, 0x33, 0x8e, 0xec, 0x03, 0x4a, 0xad, 0xe3, 0x33, 0xd3, 0x25,
+	0x96, 0xc9, 0x5c, 0x82, 0xbd, 0xed, 0x38, 0xc1, 0xaf, 0xc3, 0x4c, 0x83, 0x52, 0x8b, 0x27, 0xa1,
+	0x2c, 0x3c, 0xdd, 0x67, 0xde, 0x04, 0xd8, 0xce, 0x85, 0xac, 0xa5, 0xbc, 0x55, 0x05, 0xcb, 0xdf,
+	0x40, 0xb6, 0x9f, 0x4f, 0xae, 0x73, 0x15, 0x3e, 0x24, 0x6d, 0xd3, 0x25, 0xf8, 0xa4, 0x93, 0x5c,
+	0x96, 0x46, 0xd9, 0x44, 0x2e, 0xa9, 0xbd, 0x0e, 0x8e, 0xf7, 0xfd, 0x1c, 0x33, 0xf9, 0x0f, 0x04,
+	0xcb, 0x91, 0x75, 0xfe, 0x2d, 0xfd, 0x4e, 0xb7, 0x4c, 0xac, 0xbb, 0xd4, 0x39, 0x24, 0xee, 0x54,
+	0xba, 0xad, 0x02, 0xd0, 0x0a, 0x6d, 0xb2, 0x74, 0xc2, 0x4f, 0xf3, 0xb2, 0xf2, 0x72, 0xdc, 0x28,
+	0xdc, 0xf3, 0x31, 0x31, 0x8d, 0x33, 0xb7, 0x9c, 0xf4, 0x12, 0xae, 0x3d, 0x23, 0xcb, 0x9b, 0xb0,
+	0x3e, 0x82, 0x6a, 0x5e, 0xa7, 0xbf, 0xa3, 0x7f, 0xd7, 0x72, 0x03, 0xeb, 0x2e, 0x79, 0x97, 0x23,
+	0x5c, 0x83, 0x4f, 0x86, 0x28, 0xe6, 0xd1, 0xfd, 0x82, 0x40, 0xea, 0xc1, 0x1e, 0xe8, 0x8e, 0x6b,
+	0xea, 0xd6, 0x74, 0x27, 0x92, 0x50, 0x84, 0xa4, 0xd7, 0x46, 0x7e, 0xe7, 0x0c, 0xec, 0xcf, 0x20,
+	0x18, 0x1f, 0x2c, 0x97, 0x60, 0x75, 0xb0, 0x34, 0x5e, 0xb1, 0xcf, 0xfc, 0xa2, 0x9e, 0x39, 0x74,
+	0x8d, 0x60, 0xa5, 0xc7, 0x46, 0x97, 0x5c, 0xb2, 0xa7, 0x3c, 0xf4, 0x26, 0x0b, 0xf2, 0x6b, 0xd8,
+	0x18, 0x45, 0xe0, 0xd0, 0x50, 0x0b, 0x77, 0xaf, 0x20, 0x51, 0x65, 0x86, 0xe0, 0x80, 0x10, 0x35,
+	0x4c, 0xa3, 0x2a, 0x29, 0xb2, 0x0d, 0xc4, 0xfc, 0xc8, 0x50, 0x2e, 0xaa, 0x0d, 0x6f, 0x23, 0x1f,
+	0xb3, 0xf5, 0xa1, 0xa6, 0xba, 0x60, 0xb1, 0x38, 0x06, 0xb8, 0x9f, 0x67, 0x9e, 0xcf, 0x51, 0x3c,
+	0x87, 0x60, 0xb1, 0x38, 0x06, 0x98, 0x7b, 0xfe, 0x09, 0xc1, 0x7c, 0xff, 0xc7, 0x6b, 0xab, 0x8f,
+	0xc9, 0xbe, 0x0c, 0x71, 0x67, 0x5c, 0x06, 0x57, 0xf2, 0x23, 0xcc, 0x46, 0x3f, 0x22, 0x1b, 0x7d,
+	0x4c, 0x46, 0xa2, 0xc5, 0x4f, 0xc7, 0x41, 0x73, 0xe7, 0xbf, 0x22, 0xc8, 0x0e, 0x7d, 0x01, 0x3e,
+	0x1b, 0xb9, 0xa4, 0x7a, 0x89, 0xe2, 0x17, 0x13, 0x12, 0xb9, 0xbc, 0x2b, 0x04, 0x8b, 0x03, 0x47,
+	0xf7, 0x08, 0xb9, 0x7f, 0x41, 0x12, 0x3f, 0x9f, 0x80, 0xc4, 0x25, 0xfd, 0x8c, 0x60, 0x61, 0xd0,
+	0xbc, 0x2d, 0x0c, 0x35, 0xfe, 0x82, 0x23, 0xee, 0x8e, 0xcf, 0xe1, 0x7a, 0xae, 0x11, 0x2c, 0x0d,
+	0x1f, 0x90, 0x3b, 0x63, 0x74, 0x67, 0x0f, 0x53, 0xfc, 0x72, 0x52, 0x66, 0xa8, 0xb0, 0x7c, 0x70,
+	0xf3, 0x20, 0xa1, 0xdb, 0x07, 0x09, 0xfd, 0xfd, 0x20, 0xa1, 0xab, 0x47, 0x29, 0x76, 0xfb, 0x28,
+	0xc5, 0xee, 0x1e, 0xa5, 0xd8, 0xf7, 0xdb, 0xcf, 0xfe, 0xc6, 0x75, 0xbc, 0x6c, 0x5a, 0x7a, 0x8d,
+	0x85, 0x1b, 0xb5, 0x95, 0x2f, 0xa8, 0xed, 0x9e, 0x6f, 0x15, 0xef, 0xaf, 0x5d, 0x2d, 0xe5, 0x7f,
+	0x20, 0x14, 0xff, 0x19, 0x00, 0x4a, 0x6f, 0xc9, 0x99, 0xce, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -593,6 +1042,18 @@ type MsgClient interface {
 	// Execute lockup lock and superfluid delegation in a single msg
 	LockAndSuperfluidDelegate(ctx context.Context, in *MsgLockAndSuperfluidDelegate, opts ...grpc.CallOption) (*MsgLockAndSuperfluidDelegateResponse, error)
 	UnPoolWhitelistedPool(ctx context.Context, in *MsgUnPoolWhitelistedPool, opts ...grpc.CallOption) (*MsgUnPoolWhitelistedPoolResponse, error)
+	// Execute superfluid delegation for a lockup, split across a weighted
+	// validator set
+	SuperfluidDelegateToValidatorSet(ctx context.Context, in *MsgSuperfluidDelegateToValidatorSet, opts ...grpc.CallOption) (*MsgSuperfluidDelegateToValidatorSetResponse, error)
+	// Change the weighted validator set a lockup is superfluid delegated to,
+	// rebalancing its delegations
+	SuperfluidUpdateValidatorSet(ctx context.Context, in *MsgSuperfluidUpdateValidatorSet, opts ...grpc.CallOption) (*MsgSuperfluidUpdateValidatorSetResponse, error)
+	// Execute superfluid undelegation for a part of a lockup, splitting it off
+	// the lockup into a new lockup
+	SuperfluidPartialUndelegate(ctx context.Context, in *MsgSuperfluidPartialUndelegate, opts ...grpc.CallOption) (*MsgSuperfluidPartialUndelegateResponse, error)
+	// Execute superfluid undelegation for a part of a lockup, and also unbond
+	// the underlying lockup of the undelegated part
+	SuperfluidUndelegateAndUnbondLock(ctx context.Context, in *MsgSuperfluidUndelegateAndUnbondLock, opts ...grpc.CallOption) (*MsgSuperfluidUndelegateAndUnbondLockResponse, error)
 }
 
 type msgClient struct {
@@ -648,10 +1109,46 @@ func (c *msgClient) UnPoolWhitelistedPool(ctx context.Context, in *MsgUnPoolWhit
 	return out, nil
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// Execute superfluid delegation for a lockup
-	SuperfluidDelegate(context.Context, *MsgSuperfluidDelegate) (*MsgSuperfluidDelegateResponse, error)
+func (c *msgClient) SuperfluidDelegateToValidatorSet(ctx context.Context, in *MsgSuperfluidDelegateToValidatorSet, opts ...grpc.CallOption) (*MsgSuperfluidDelegateToValidatorSetResponse, error) {
+	out := new(MsgSuperfluidDelegateToValidatorSetResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Msg/SuperfluidDelegateToValidatorSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SuperfluidUpdateValidatorSet(ctx context.Context, in *MsgSuperfluidUpdateValidatorSet, opts ...grpc.CallOption) (*MsgSuperfluidUpdateValidatorSetResponse, error) {
+	out := new(MsgSuperfluidUpdateValidatorSetResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Msg/SuperfluidUpdateValidatorSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SuperfluidPartialUndelegate(ctx context.Context, in *MsgSuperfluidPartialUndelegate, opts ...grpc.CallOption) (*MsgSuperfluidPartialUndelegateResponse, error) {
+	out := new(MsgSuperfluidPartialUndelegateResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Msg/SuperfluidPartialUndelegate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SuperfluidUndelegateAndUnbondLock(ctx context.Context, in *MsgSuperfluidUndelegateAndUnbondLock, opts ...grpc.CallOption) (*MsgSuperfluidUndelegateAndUnbondLockResponse, error) {
+	out := new(MsgSuperfluidUndelegateAndUnbondLockResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Msg/SuperfluidUndelegateAndUnbondLock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// Execute superfluid delegation for a lockup
+	SuperfluidDelegate(context.Context, *MsgSuperfluidDelegate) (*MsgSuperfluidDelegateResponse, error)
 	// Execute superfluid undelegation for a lockup
 	SuperfluidUndelegate(context.Context, *MsgSuperfluidUndelegate) (*MsgSuperfluidUndelegateResponse, error)
 	// For a given lock that is being superfluidly undelegated,
@@ -660,6 +1157,18 @@ type MsgServer interface {
 	// Execute lockup lock and superfluid delegation in a single msg
 	LockAndSuperfluidDelegate(context.Context, *MsgLockAndSuperfluidDelegate) (*MsgLockAndSuperfluidDelegateResponse, error)
 	UnPoolWhitelistedPool(context.Context, *MsgUnPoolWhitelistedPool) (*MsgUnPoolWhitelistedPoolResponse, error)
+	// Execute superfluid delegation for a lockup, split across a weighted
+	// validator set
+	SuperfluidDelegateToValidatorSet(context.Context, *MsgSuperfluidDelegateToValidatorSet) (*MsgSuperfluidDelegateToValidatorSetResponse, error)
+	// Change the weighted validator set a lockup is superfluid delegated to,
+	// rebalancing its delegations
+	SuperfluidUpdateValidatorSet(context.Context, *MsgSuperfluidUpdateValidatorSet) (*MsgSuperfluidUpdateValidatorSetResponse, error)
+	// Execute superfluid undelegation for a part of a lockup, splitting it off
+	// the lockup into a new lockup
+	SuperfluidPartialUndelegate(context.Context, *MsgSuperfluidPartialUndelegate) (*MsgSuperfluidPartialUndelegateResponse, error)
+	// Execute superfluid undelegation for a part of a lockup, and also unbond
+	// the underlying lockup of the undelegated part
+	SuperfluidUndelegateAndUnbondLock(context.Context, *MsgSuperfluidUndelegateAndUnbondLock) (*MsgSuperfluidUndelegateAndUnbondLockResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -681,6 +1190,18 @@ func (*UnimplementedMsgServer) LockAndSuperfluidDelegate(ctx context.Context, re
 func (*UnimplementedMsgServer) UnPoolWhitelistedPool(ctx context.Context, req *MsgUnPoolWhitelistedPool) (*MsgUnPoolWhitelistedPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnPoolWhitelistedPool not implemented")
 }
+func (*UnimplementedMsgServer) SuperfluidDelegateToValidatorSet(ctx context.Context, req *MsgSuperfluidDelegateToValidatorSet) (*MsgSuperfluidDelegateToValidatorSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuperfluidDelegateToValidatorSet not implemented")
+}
+func (*UnimplementedMsgServer) SuperfluidUpdateValidatorSet(ctx context.Context, req *MsgSuperfluidUpdateValidatorSet) (*MsgSuperfluidUpdateValidatorSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuperfluidUpdateValidatorSet not implemented")
+}
+func (*UnimplementedMsgServer) SuperfluidPartialUndelegate(ctx context.Context, req *MsgSuperfluidPartialUndelegate) (*MsgSuperfluidPartialUndelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuperfluidPartialUndelegate not implemented")
+}
+func (*UnimplementedMsgServer) SuperfluidUndelegateAndUnbondLock(ctx context.Context, req *MsgSuperfluidUndelegateAndUnbondLock) (*MsgSuperfluidUndelegateAndUnbondLockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuperfluidUndelegateAndUnbondLock not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -776,6 +1297,78 @@ func _Msg_UnPoolWhitelistedPool_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SuperfluidDelegateToValidatorSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSuperfluidDelegateToValidatorSet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SuperfluidDelegateToValidatorSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.superfluid.Msg/SuperfluidDelegateToValidatorSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SuperfluidDelegateToValidatorSet(ctx, req.(*MsgSuperfluidDelegateToValidatorSet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SuperfluidUpdateValidatorSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSuperfluidUpdateValidatorSet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SuperfluidUpdateValidatorSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.superfluid.Msg/SuperfluidUpdateValidatorSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SuperfluidUpdateValidatorSet(ctx, req.(*MsgSuperfluidUpdateValidatorSet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SuperfluidPartialUndelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSuperfluidPartialUndelegate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SuperfluidPartialUndelegate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.superfluid.Msg/SuperfluidPartialUndelegate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SuperfluidPartialUndelegate(ctx, req.(*MsgSuperfluidPartialUndelegate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SuperfluidUndelegateAndUnbondLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSuperfluidUndelegateAndUnbondLock)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SuperfluidUndelegateAndUnbondLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.superfluid.Msg/SuperfluidUndelegateAndUnbondLock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SuperfluidUndelegateAndUnbondLock(ctx, req.(*MsgSuperfluidUndelegateAndUnbondLock))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.superfluid.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -800,6 +1393,22 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UnPoolWhitelistedPool",
 			Handler:    _Msg_UnPoolWhitelistedPool_Handler,
 		},
+		{
+			MethodName: "SuperfluidDelegateToValidatorSet",
+			Handler:    _Msg_SuperfluidDelegateToValidatorSet_Handler,
+		},
+		{
+			MethodName: "SuperfluidUpdateValidatorSet",
+			Handler:    _Msg_SuperfluidUpdateValidatorSet_Handler,
+		},
+		{
+			MethodName: "SuperfluidPartialUndelegate",
+			Handler:    _Msg_SuperfluidPartialUndelegate_Handler,
+		},
+		{
+			MethodName: "SuperfluidUndelegateAndUnbondLock",
+			Handler:    _Msg_SuperfluidUndelegateAndUnbondLock_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/superfluid/tx.proto",
@@ -1141,89 +1750,379 @@ func (m *MsgUnPoolWhitelistedPoolResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgSuperfluidDelegateToValidatorSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgSuperfluidDelegate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgSuperfluidDelegateToValidatorSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSuperfluidDelegateToValidatorSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
 	if m.LockId != 0 {
-		n += 1 + sovTx(uint64(m.LockId))
+		i = encodeVarintTx(dAtA, i, uint64(m.LockId))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.ValAddr)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSuperfluidDelegateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSuperfluidDelegateToValidatorSetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSuperfluidDelegateToValidatorSetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSuperfluidDelegateToValidatorSetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSuperfluidUndelegate) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSuperfluidUpdateValidatorSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSuperfluidUpdateValidatorSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSuperfluidUpdateValidatorSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
 	if m.LockId != 0 {
-		n += 1 + sovTx(uint64(m.LockId))
+		i = encodeVarintTx(dAtA, i, uint64(m.LockId))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSuperfluidUndelegateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSuperfluidUpdateValidatorSetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSuperfluidUpdateValidatorSetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSuperfluidUpdateValidatorSetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSuperfluidUnbondLock) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSuperfluidPartialUndelegate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSuperfluidPartialUndelegate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSuperfluidPartialUndelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Coin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x1a
 	if m.LockId != 0 {
-		n += 1 + sovTx(uint64(m.LockId))
+		i = encodeVarintTx(dAtA, i, uint64(m.LockId))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
-}
-
-func (m *MsgSuperfluidUnbondLockResponse) Size() (n int) {
-	if m == nil {
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSuperfluidPartialUndelegateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSuperfluidPartialUndelegateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSuperfluidPartialUndelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LockId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LockId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSuperfluidUndelegateAndUnbondLock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSuperfluidUndelegateAndUnbondLock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSuperfluidUndelegateAndUnbondLock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Coin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.LockId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LockId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSuperfluidUndelegateAndUnbondLockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSuperfluidUndelegateAndUnbondLockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSuperfluidUndelegateAndUnbondLockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LockId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LockId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSuperfluidDelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LockId != 0 {
+		n += 1 + sovTx(uint64(m.LockId))
+	}
+	l = len(m.ValAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSuperfluidDelegateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSuperfluidUndelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LockId != 0 {
+		n += 1 + sovTx(uint64(m.LockId))
+	}
+	return n
+}
+
+func (m *MsgSuperfluidUndelegateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSuperfluidUnbondLock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LockId != 0 {
+		n += 1 + sovTx(uint64(m.LockId))
+	}
+	return n
+}
+
+func (m *MsgSuperfluidUnbondLockResponse) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
@@ -1247,64 +2146,888 @@ func (m *MsgLockAndSuperfluidDelegate) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
-	l = len(m.ValAddr)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
-}
-
-func (m *MsgLockAndSuperfluidDelegateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ID != 0 {
-		n += 1 + sovTx(uint64(m.ID))
-	}
-	return n
-}
+	l = len(m.ValAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgLockAndSuperfluidDelegateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovTx(uint64(m.ID))
+	}
+	return n
+}
+
+func (m *MsgUnPoolWhitelistedPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *MsgUnPoolWhitelistedPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ExitedLockIds) > 0 {
+		l = 0
+		for _, e := range m.ExitedLockIds {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgSuperfluidDelegateToValidatorSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LockId != 0 {
+		n += 1 + sovTx(uint64(m.LockId))
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSuperfluidDelegateToValidatorSetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSuperfluidUpdateValidatorSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LockId != 0 {
+		n += 1 + sovTx(uint64(m.LockId))
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSuperfluidUpdateValidatorSetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSuperfluidPartialUndelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LockId != 0 {
+		n += 1 + sovTx(uint64(m.LockId))
+	}
+	l = m.Coin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSuperfluidPartialUndelegateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LockId != 0 {
+		n += 1 + sovTx(uint64(m.LockId))
+	}
+	return n
+}
+
+func (m *MsgSuperfluidUndelegateAndUnbondLock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LockId != 0 {
+		n += 1 + sovTx(uint64(m.LockId))
+	}
+	l = m.Coin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSuperfluidUndelegateAndUnbondLockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LockId != 0 {
+		n += 1 + sovTx(uint64(m.LockId))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSuperfluidDelegate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSuperfluidDelegate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSuperfluidDelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
+			}
+			m.LockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSuperfluidDelegateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSuperfluidDelegateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSuperfluidDelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSuperfluidUndelegate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSuperfluidUndelegate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSuperfluidUndelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
+			}
+			m.LockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSuperfluidUndelegateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSuperfluidUndelegateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSuperfluidUndelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSuperfluidUnbondLock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSuperfluidUnbondLock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSuperfluidUnbondLock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
+			}
+			m.LockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSuperfluidUnbondLockResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSuperfluidUnbondLockResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSuperfluidUnbondLockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLockAndSuperfluidDelegate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLockAndSuperfluidDelegate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLockAndSuperfluidDelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgUnPoolWhitelistedPool) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.PoolId != 0 {
-		n += 1 + sovTx(uint64(m.PoolId))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *MsgUnPoolWhitelistedPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.ExitedLockIds) > 0 {
-		l = 0
-		for _, e := range m.ExitedLockIds {
-			l += sovTx(uint64(e))
+func (m *MsgLockAndSuperfluidDelegateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLockAndSuperfluidDelegateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLockAndSuperfluidDelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		n += 1 + sovTx(uint64(l)) + l
 	}
-	return n
-}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgSuperfluidDelegate) Unmarshal(dAtA []byte) error {
+func (m *MsgUnPoolWhitelistedPool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1327,10 +3050,10 @@ func (m *MsgSuperfluidDelegate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSuperfluidDelegate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnPoolWhitelistedPool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSuperfluidDelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnPoolWhitelistedPool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1367,28 +3090,9 @@ func (m *MsgSuperfluidDelegate) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
-			}
-			m.LockId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LockId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var stringLen uint64
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1398,24 +3102,11 @@ func (m *MsgSuperfluidDelegate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ValAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1437,7 +3128,7 @@ func (m *MsgSuperfluidDelegate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSuperfluidDelegateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnPoolWhitelistedPoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1460,12 +3151,88 @@ func (m *MsgSuperfluidDelegateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSuperfluidDelegateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnPoolWhitelistedPoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSuperfluidDelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnPoolWhitelistedPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ExitedLockIds = append(m.ExitedLockIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ExitedLockIds) == 0 {
+					m.ExitedLockIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ExitedLockIds = append(m.ExitedLockIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitedLockIds", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1487,7 +3254,7 @@ func (m *MsgSuperfluidDelegateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSuperfluidUndelegate) Unmarshal(dAtA []byte) error {
+func (m *MsgSuperfluidDelegateToValidatorSet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1510,10 +3277,10 @@ func (m *MsgSuperfluidUndelegate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSuperfluidUndelegate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSuperfluidDelegateToValidatorSet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSuperfluidUndelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSuperfluidDelegateToValidatorSet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1567,6 +3334,40 @@ func (m *MsgSuperfluidUndelegate) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, ValidatorWeight{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1588,7 +3389,7 @@ func (m *MsgSuperfluidUndelegate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSuperfluidUndelegateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSuperfluidDelegateToValidatorSetResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1611,10 +3412,10 @@ func (m *MsgSuperfluidUndelegateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSuperfluidUndelegateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSuperfluidDelegateToValidatorSetResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSuperfluidUndelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSuperfluidDelegateToValidatorSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1638,7 +3439,7 @@ func (m *MsgSuperfluidUndelegateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSuperfluidUnbondLock) Unmarshal(dAtA []byte) error {
+func (m *MsgSuperfluidUpdateValidatorSet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1661,10 +3462,10 @@ func (m *MsgSuperfluidUnbondLock) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSuperfluidUnbondLock: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSuperfluidUpdateValidatorSet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSuperfluidUnbondLock: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSuperfluidUpdateValidatorSet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1718,6 +3519,40 @@ func (m *MsgSuperfluidUnbondLock) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, ValidatorWeight{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1739,7 +3574,7 @@ func (m *MsgSuperfluidUnbondLock) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSuperfluidUnbondLockResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSuperfluidUpdateValidatorSetResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1762,10 +3597,10 @@ func (m *MsgSuperfluidUnbondLockResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSuperfluidUnbondLockResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSuperfluidUpdateValidatorSetResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSuperfluidUnbondLockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSuperfluidUpdateValidatorSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1789,7 +3624,7 @@ func (m *MsgSuperfluidUnbondLockResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLockAndSuperfluidDelegate) Unmarshal(dAtA []byte) error {
+func (m *MsgSuperfluidPartialUndelegate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1812,10 +3647,10 @@ func (m *MsgLockAndSuperfluidDelegate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLockAndSuperfluidDelegate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSuperfluidPartialUndelegate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLockAndSuperfluidDelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSuperfluidPartialUndelegate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1851,10 +3686,10 @@ func (m *MsgLockAndSuperfluidDelegate) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
 			}
-			var msglen int
+			m.LockId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1864,31 +3699,16 @@ func (m *MsgLockAndSuperfluidDelegate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LockId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Coins = append(m.Coins, types.Coin{})
-			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1898,23 +3718,24 @@ func (m *MsgLockAndSuperfluidDelegate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValAddr = string(dAtA[iNdEx:postIndex])
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1937,7 +3758,7 @@ func (m *MsgLockAndSuperfluidDelegate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLockAndSuperfluidDelegateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSuperfluidPartialUndelegateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1960,17 +3781,17 @@ func (m *MsgLockAndSuperfluidDelegateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLockAndSuperfluidDelegateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSuperfluidPartialUndelegateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLockAndSuperfluidDelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSuperfluidPartialUndelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
 			}
-			m.ID = 0
+			m.LockId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1980,7 +3801,7 @@ func (m *MsgLockAndSuperfluidDelegateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ID |= uint64(b&0x7F) << shift
+				m.LockId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2006,7 +3827,7 @@ func (m *MsgLockAndSuperfluidDelegateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnPoolWhitelistedPool) Unmarshal(dAtA []byte) error {
+func (m *MsgSuperfluidUndelegateAndUnbondLock) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2029,10 +3850,10 @@ func (m *MsgUnPoolWhitelistedPool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnPoolWhitelistedPool: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSuperfluidUndelegateAndUnbondLock: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnPoolWhitelistedPool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSuperfluidUndelegateAndUnbondLock: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2069,9 +3890,9 @@ func (m *MsgUnPoolWhitelistedPool) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
 			}
-			m.PoolId = 0
+			m.LockId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2081,11 +3902,44 @@ func (m *MsgUnPoolWhitelistedPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				m.LockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2107,7 +3961,7 @@ func (m *MsgUnPoolWhitelistedPool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnPoolWhitelistedPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSuperfluidUndelegateAndUnbondLockResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2130,87 +3984,30 @@ func (m *MsgUnPoolWhitelistedPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnPoolWhitelistedPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSuperfluidUndelegateAndUnbondLockResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnPoolWhitelistedPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSuperfluidUndelegateAndUnbondLockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.ExitedLockIds = append(m.ExitedLockIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
+			}
+			m.LockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.ExitedLockIds) == 0 {
-					m.ExitedLockIds = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.ExitedLockIds = append(m.ExitedLockIds, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExitedLockIds", wireType)
 			}
 		default:
 			iNdEx = preIndex
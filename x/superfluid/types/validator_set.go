@@ -0,0 +1,67 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ValidateValidatorWeights checks that the validator set is not empty, holds valid and unique
+// validator addresses, and that its weights are positive and add up to one.
+func ValidateValidatorWeights(validators []ValidatorWeight) error {
+	if len(validators) == 0 {
+		return sdkerrors.Wrap(ErrInvalidValidatorSet, "validator set is empty")
+	}
+
+	seen := make(map[string]bool, len(validators))
+	totalWeight := sdk.ZeroDec()
+	for _, validator := range validators {
+		if _, err := sdk.ValAddressFromBech32(validator.ValAddr); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidValidatorSet, "invalid validator address %s: %s", validator.ValAddr, err)
+		}
+		if seen[validator.ValAddr] {
+			return sdkerrors.Wrapf(ErrInvalidValidatorSet, "duplicate validator %s", validator.ValAddr)
+		}
+		seen[validator.ValAddr] = true
+
+		if validator.Weight.IsNil() || !validator.Weight.IsPositive() {
+			return sdkerrors.Wrapf(ErrInvalidValidatorSet, "weight of validator %s must be positive", validator.ValAddr)
+		}
+		totalWeight = totalWeight.Add(validator.Weight)
+	}
+
+	if !totalWeight.Equal(sdk.OneDec()) {
+		return sdkerrors.Wrapf(ErrInvalidValidatorSet, "weights add up to %s instead of 1", totalWeight)
+	}
+	return nil
+}
+
+// GetWeight returns the weight of the validator in the set, which is zero for validators not in it.
+func (s LockValidatorSet) GetWeight(valAddr string) sdk.Dec {
+	for _, validator := range s.Validators {
+		if validator.ValAddr == valAddr {
+			return validator.Weight
+		}
+	}
+	return sdk.ZeroDec()
+}
+
+// GetUnbondingWeight returns the share of the lock superfluid undelegating from the validator.
+func (s LockValidatorSet) GetUnbondingWeight(valAddr string) sdk.Dec {
+	for _, validator := range s.UnbondingValidators {
+		if validator.ValAddr == valAddr {
+			return validator.Weight
+		}
+	}
+	return sdk.ZeroDec()
+}
+
+// SetUnbondingWeight sets the share of the lock superfluid undelegating from the validator.
+func (s *LockValidatorSet) SetUnbondingWeight(valAddr string, weight sdk.Dec) {
+	for i, validator := range s.UnbondingValidators {
+		if validator.ValAddr == valAddr {
+			s.UnbondingValidators[i].Weight = weight
+			return
+		}
+	}
+	s.UnbondingValidators = append(s.UnbondingValidators, ValidatorWeight{ValAddr: valAddr, Weight: weight})
+}
@@ -0,0 +1,24 @@
+package cli
+
+import (
+	flag "github.com/spf13/pflag"
+
+	"github.com/osmosis-labs/osmosis/v12/x/swaprouter/types"
+)
+
+// Flags for swaprouter module tx commands.
+const (
+	FlagRoutesFile = "routes-file"
+)
+
+type preferredRoutesInputs struct {
+	DenomPairRoutes []types.DenomPairRoutes `json:"denom_pair_routes"`
+}
+
+// FlagSetPreferredRoutes returns flags for setting preferred routes.
+func FlagSetPreferredRoutes() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagRoutesFile, "", "Routes json file path")
+	return fs
+}
@@ -0,0 +1,152 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+
+	"github.com/osmosis-labs/osmosis/v12/x/swaprouter/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetCmdDenomPairRoutes(),
+		GetCmdAllDenomPairRoutes(),
+		GetCmdEstimateSwapExactAmountIn(),
+		GetCmdEstimateSwapExactAmountOut(),
+	)
+
+	return cmd
+}
+
+// GetCmdDenomPairRoutes returns the preferred routes of a denom pair.
+func GetCmdDenomPairRoutes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "routes [token-in-denom] [token-out-denom] [flags]",
+		Short:   "Query the preferred routes to swap a denom for another",
+		Example: "osmosisd query swaprouter routes uatom uosmo",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DenomPairRoutes(cmd.Context(), &types.QueryDenomPairRoutesRequest{
+				TokenInDenom:  args[0],
+				TokenOutDenom: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdAllDenomPairRoutes returns the preferred routes of all of the denom pairs.
+func GetCmdAllDenomPairRoutes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "all-routes [flags]",
+		Short: "Query the preferred routes of all of the denom pairs",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AllDenomPairRoutes(cmd.Context(), &types.QueryAllDenomPairRoutesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdEstimateSwapExactAmountIn returns the best preferred route to swap an exact amount in, along with its estimated amount out.
+func GetCmdEstimateSwapExactAmountIn() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "estimate-swap-exact-amount-in [token-in] [token-out-denom] [flags]",
+		Short:   "Query the best preferred route to swap an exact amount in, along with its estimated amount out",
+		Example: "osmosisd query swaprouter estimate-swap-exact-amount-in 1000000uatom uosmo",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EstimateSwapExactAmountIn(cmd.Context(), &types.QueryEstimateSwapExactAmountInRequest{
+				TokenIn:       args[0],
+				TokenOutDenom: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdEstimateSwapExactAmountOut returns the best preferred route to swap for an exact amount out, along with its estimated amount in.
+func GetCmdEstimateSwapExactAmountOut() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "estimate-swap-exact-amount-out [token-in-denom] [token-out] [flags]",
+		Short:   "Query the best preferred route to swap for an exact amount out, along with its estimated amount in",
+		Example: "osmosisd query swaprouter estimate-swap-exact-amount-out uosmo 1000000uatom",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EstimateSwapExactAmountOut(cmd.Context(), &types.QueryEstimateSwapExactAmountOutRequest{
+				TokenInDenom: args[0],
+				TokenOut:     args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,244 @@
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/osmosis-labs/osmosis/v12/x/swaprouter/types"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		NewSwapExactAmountInCmd(),
+		NewSwapExactAmountOutCmd(),
+	)
+
+	return cmd
+}
+
+// NewSwapExactAmountInCmd broadcasts MsgSwapExactAmountIn.
+func NewSwapExactAmountInCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "swap-exact-amount-in [token-in] [token-out-denom] [token-out-min-amount]",
+		Short:   "swap an exact amount in for a minimum amount out, through the best preferred route of the denom pair",
+		Example: "osmosisd tx swaprouter swap-exact-amount-in 1000000uatom uosmo 9000000 --from mykey",
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			tokenIn, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			tokenOutMinAmount, ok := sdk.NewIntFromString(args[2])
+			if !ok {
+				return errors.New("invalid token out min amount")
+			}
+
+			msg := &types.MsgSwapExactAmountIn{
+				Sender:            clientCtx.GetFromAddress().String(),
+				TokenIn:           tokenIn,
+				TokenOutDenom:     args[1],
+				TokenOutMinAmount: tokenOutMinAmount,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSwapExactAmountOutCmd broadcasts MsgSwapExactAmountOut.
+func NewSwapExactAmountOutCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "swap-exact-amount-out [token-in-denom] [token-in-max-amount] [token-out]",
+		Short:   "swap a maximum amount in for an exact amount out, through the best preferred route of the denom pair",
+		Example: "osmosisd tx swaprouter swap-exact-amount-out uosmo 11000000 1000000uatom --from mykey",
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			tokenInMaxAmount, ok := sdk.NewIntFromString(args[1])
+			if !ok {
+				return errors.New("invalid token in max amount")
+			}
+
+			tokenOut, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSwapExactAmountOut{
+				Sender:           clientCtx.GetFromAddress().String(),
+				TokenInDenom:     args[0],
+				TokenInMaxAmount: tokenInMaxAmount,
+				TokenOut:         tokenOut,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdSubmitSetPreferredRoutesProposal implements a command handler for submitting a preferred routes set proposal transaction.
+func NewCmdSubmitSetPreferredRoutesProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-preferred-routes-proposal [flags]",
+		Args:  cobra.ExactArgs(0),
+		Short: "Submit a preferred routes set proposal",
+		Long:  "Submit a proposal setting the preferred routes of denom pairs, read from a routes json file (--routes-file)",
+		Example: `Sample routes json file contents, routing uatom to uion through pool 1 then pool 2:
+{
+	"denom_pair_routes": [
+		{
+			"token_in_denom": "uatom",
+			"token_out_denom": "uion",
+			"routes": [{"pools": [{"pool_id": 1, "token_out_denom": "uosmo"}, {"pool_id": 2, "token_out_denom": "uion"}]}]
+		}
+	]
+}
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			content, err := parseSetPreferredRoutesArgsToContent(cmd)
+			if err != nil {
+				return err
+			}
+
+			return submitProposal(clientCtx, cmd, content)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	cmd.Flags().AddFlagSet(FlagSetPreferredRoutes())
+	_ = cmd.MarkFlagRequired(FlagRoutesFile)
+
+	return cmd
+}
+
+// NewCmdSubmitRemovePreferredRoutesProposal implements a command handler for submitting a preferred routes remove proposal transaction.
+func NewCmdSubmitRemovePreferredRoutesProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-preferred-routes-proposal [token-in-denom] [token-out-denom] [flags]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a preferred routes remove proposal",
+		Long:  "Submit a proposal removing all of the preferred routes of a denom pair",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			denomPairs := []types.DenomPair{{TokenInDenom: args[0], TokenOutDenom: args[1]}}
+			content := types.NewRemovePreferredRoutesProposal(title, description, denomPairs)
+
+			return submitProposal(clientCtx, cmd, content)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
+
+func submitProposal(clientCtx client.Context, cmd *cobra.Command, content govtypes.Content) error {
+	depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+	if err != nil {
+		return err
+	}
+	deposit, err := sdk.ParseCoinsNormalized(depositStr)
+	if err != nil {
+		return err
+	}
+
+	msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+	if err != nil {
+		return err
+	}
+
+	if err = msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+}
+
+func parseSetPreferredRoutesArgsToContent(cmd *cobra.Command) (govtypes.Content, error) {
+	title, err := cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return nil, err
+	}
+
+	description, err := cmd.Flags().GetString(govcli.FlagDescription)
+	if err != nil {
+		return nil, err
+	}
+
+	routesFile, err := cmd.Flags().GetString(FlagRoutesFile)
+	if err != nil {
+		return nil, err
+	}
+
+	contents, err := os.ReadFile(routesFile)
+	if err != nil {
+		return nil, err
+	}
+
+	routes := &preferredRoutesInputs{}
+	if err = json.Unmarshal(contents, routes); err != nil {
+		return nil, err
+	}
+
+	return types.NewSetPreferredRoutesProposal(title, description, routes.DenomPairRoutes), nil
+}
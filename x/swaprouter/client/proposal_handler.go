@@ -0,0 +1,13 @@
+package client
+
+import (
+	"github.com/osmosis-labs/osmosis/v12/x/swaprouter/client/cli"
+	"github.com/osmosis-labs/osmosis/v12/x/swaprouter/client/rest"
+
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+)
+
+var (
+	SetPreferredRoutesProposalHandler    = govclient.NewProposalHandler(cli.NewCmdSubmitSetPreferredRoutesProposal, rest.ProposalSetPreferredRoutesRESTHandler)
+	RemovePreferredRoutesProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitRemovePreferredRoutesProposal, rest.ProposalRemovePreferredRoutesRESTHandler)
+)
@@ -0,0 +1,32 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+)
+
+func ProposalSetPreferredRoutesRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "set-preferred-routes",
+		Handler:  newSetPreferredRoutesHandler(clientCtx),
+	}
+}
+
+func newSetPreferredRoutesHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+	}
+}
+
+func ProposalRemovePreferredRoutesRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "remove-preferred-routes",
+		Handler:  newRemovePreferredRoutesHandler(clientCtx),
+	}
+}
+
+func newRemovePreferredRoutesHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+	}
+}
@@ -0,0 +1,23 @@
+package swaprouter
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/osmosis-labs/osmosis/v12/x/swaprouter/keeper"
+	"github.com/osmosis-labs/osmosis/v12/x/swaprouter/types"
+)
+
+func NewSwapRouterProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.SetPreferredRoutesProposal:
+			return k.HandleSetPreferredRoutesProposal(ctx, c)
+		case *types.RemovePreferredRoutesProposal:
+			return k.HandleRemovePreferredRoutesProposal(ctx, c)
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized swaprouter proposal content type: %T", c)
+		}
+	}
+}
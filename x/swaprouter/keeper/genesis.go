@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v12/x/swaprouter/types"
+)
+
+// InitGenesis initializes the swaprouter module's state from a provided genesis
+// state.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
+	for _, routes := range genState.DenomPairRoutes {
+		if err := k.SetDenomPairRoutes(ctx, routes); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// ExportGenesis returns the swaprouter module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return &types.GenesisState{
+		DenomPairRoutes: k.GetAllDenomPairRoutes(ctx),
+	}
+}
@@ -0,0 +1,16 @@
+package keeper_test
+
+func (suite *KeeperTestSuite) TestGenesisExportImport() {
+	suite.SetupTest()
+	suite.setAtomIonRoutes()
+
+	genesis := suite.App.SwapRouterKeeper.ExportGenesis(suite.Ctx)
+	suite.Require().NoError(genesis.Validate())
+	suite.Require().Len(genesis.DenomPairRoutes, 1)
+
+	// the pools of the routes are kept, as the gamm genesis is initialized first
+	err := suite.App.SwapRouterKeeper.DeleteDenomPairRoutes(suite.Ctx, "uatom", "uion")
+	suite.Require().NoError(err)
+	suite.App.SwapRouterKeeper.InitGenesis(suite.Ctx, *genesis)
+	suite.Require().Equal(genesis, suite.App.SwapRouterKeeper.ExportGenesis(suite.Ctx))
+}
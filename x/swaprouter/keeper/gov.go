@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v12/x/swaprouter/types"
+)
+
+func (k Keeper) HandleSetPreferredRoutesProposal(ctx sdk.Context, p *types.SetPreferredRoutesProposal) error {
+	for _, routes := range p.DenomPairRoutes {
+		if err := k.SetDenomPairRoutes(ctx, routes); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (k Keeper) HandleRemovePreferredRoutesProposal(ctx sdk.Context, p *types.RemovePreferredRoutesProposal) error {
+	for _, denomPair := range p.DenomPairs {
+		if err := k.DeleteDenomPairRoutes(ctx, denomPair.TokenInDenom, denomPair.TokenOutDenom); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+package keeper_test
+
+import (
+	"github.com/osmosis-labs/osmosis/v12/x/swaprouter/types"
+)
+
+func (suite *KeeperTestSuite) TestPreferredRoutesProposals() {
+	suite.SetupTest()
+	k := suite.App.SwapRouterKeeper
+
+	atomIonRoutes := types.DenomPairRoutes{
+		TokenInDenom:  "uatom",
+		TokenOutDenom: "uion",
+		Routes:        []types.Route{suite.directRoute(), suite.osmoRoute()},
+	}
+	setProposal := types.NewSetPreferredRoutesProposal("title", "description", []types.DenomPairRoutes{atomIonRoutes}).(*types.SetPreferredRoutesProposal)
+	suite.Require().NoError(setProposal.ValidateBasic())
+	err := k.HandleSetPreferredRoutesProposal(suite.Ctx, setProposal)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.DenomPairRoutes{atomIonRoutes}, k.GetAllDenomPairRoutes(suite.Ctx))
+
+	// setting the routes of a denom pair again replaces them
+	atomIonRoutes.Routes = []types.Route{suite.osmoRoute()}
+	setProposal.DenomPairRoutes = []types.DenomPairRoutes{atomIonRoutes}
+	err = k.HandleSetPreferredRoutesProposal(suite.Ctx, setProposal)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.DenomPairRoutes{atomIonRoutes}, k.GetAllDenomPairRoutes(suite.Ctx))
+
+	removeProposal := types.NewRemovePreferredRoutesProposal("title", "description", []types.DenomPair{atomIonRoutes.DenomPair()}).(*types.RemovePreferredRoutesProposal)
+	suite.Require().NoError(removeProposal.ValidateBasic())
+	err = k.HandleRemovePreferredRoutesProposal(suite.Ctx, removeProposal)
+	suite.Require().NoError(err)
+	suite.Require().Empty(k.GetAllDenomPairRoutes(suite.Ctx))
+
+	// the routes of the denom pair are already removed
+	err = k.HandleRemovePreferredRoutesProposal(suite.Ctx, removeProposal)
+	suite.Require().ErrorIs(err, types.ErrNoRoutes)
+}
@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/osmosis-labs/osmosis/v12/x/swaprouter/types"
+)
+
+var _ types.QueryServer = Querier{}
+
+// Querier defines a wrapper around the x/swaprouter keeper providing gRPC method
+// handlers.
+type Querier struct {
+	Keeper
+}
+
+func NewQuerier(k Keeper) Querier {
+	return Querier{Keeper: k}
+}
+
+// DenomPairRoutes returns the preferred routes to swap a denom for another.
+func (q Querier) DenomPairRoutes(goCtx context.Context, req *types.QueryDenomPairRoutesRequest) (*types.QueryDenomPairRoutesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	routes, err := q.Keeper.GetDenomPairRoutes(ctx, req.TokenInDenom, req.TokenOutDenom)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryDenomPairRoutesResponse{DenomPairRoutes: routes}, nil
+}
+
+// AllDenomPairRoutes returns the preferred routes of all of the denom pairs.
+func (q Querier) AllDenomPairRoutes(goCtx context.Context, req *types.QueryAllDenomPairRoutesRequest) (*types.QueryAllDenomPairRoutesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.QueryAllDenomPairRoutesResponse{DenomPairRoutes: q.Keeper.GetAllDenomPairRoutes(ctx)}, nil
+}
+
+// EstimateSwapExactAmountIn returns the preferred route with the best estimated amount out of a swap, along with that amount.
+func (q Querier) EstimateSwapExactAmountIn(goCtx context.Context, req *types.QueryEstimateSwapExactAmountInRequest) (*types.QueryEstimateSwapExactAmountInResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	tokenIn, err := sdk.ParseCoinNormalized(req.TokenIn)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token: %s", err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	route, tokenOutAmount, err := q.Keeper.EstimateSwapExactAmountIn(ctx, tokenIn, req.TokenOutDenom)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryEstimateSwapExactAmountInResponse{
+		Route:          route,
+		TokenOutAmount: tokenOutAmount,
+	}, nil
+}
+
+// EstimateSwapExactAmountOut returns the preferred route with the best estimated amount in of a swap, along with that amount.
+func (q Querier) EstimateSwapExactAmountOut(goCtx context.Context, req *types.QueryEstimateSwapExactAmountOutRequest) (*types.QueryEstimateSwapExactAmountOutResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	tokenOut, err := sdk.ParseCoinNormalized(req.TokenOut)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token: %s", err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	route, tokenInAmount, err := q.Keeper.EstimateSwapExactAmountOut(ctx, req.TokenInDenom, tokenOut)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryEstimateSwapExactAmountOutResponse{
+		Route:         route,
+		TokenInAmount: tokenInAmount,
+	}, nil
+}
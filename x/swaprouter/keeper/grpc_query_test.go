@@ -0,0 +1,56 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v12/x/swaprouter/types"
+)
+
+func (suite *KeeperTestSuite) TestQueryDenomPairRoutes() {
+	suite.SetupTest()
+	routes := suite.setAtomIonRoutes()
+
+	res, err := suite.queryClient.DenomPairRoutes(sdk.WrapSDKContext(suite.Ctx), &types.QueryDenomPairRoutesRequest{
+		TokenInDenom:  "uatom",
+		TokenOutDenom: "uion",
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(routes, res.DenomPairRoutes)
+
+	_, err = suite.queryClient.DenomPairRoutes(sdk.WrapSDKContext(suite.Ctx), &types.QueryDenomPairRoutesRequest{
+		TokenInDenom:  "uion",
+		TokenOutDenom: "uatom",
+	})
+	suite.Require().Error(err)
+
+	allRes, err := suite.queryClient.AllDenomPairRoutes(sdk.WrapSDKContext(suite.Ctx), &types.QueryAllDenomPairRoutesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.DenomPairRoutes{routes}, allRes.DenomPairRoutes)
+}
+
+func (suite *KeeperTestSuite) TestQueryEstimateSwap() {
+	suite.SetupTest()
+	suite.setAtomIonRoutes()
+
+	inRes, err := suite.queryClient.EstimateSwapExactAmountIn(sdk.WrapSDKContext(suite.Ctx), &types.QueryEstimateSwapExactAmountInRequest{
+		TokenIn:       "10000uatom",
+		TokenOutDenom: "uion",
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.osmoRoute(), inRes.Route)
+	suite.Require().True(inRes.TokenOutAmount.IsPositive())
+
+	outRes, err := suite.queryClient.EstimateSwapExactAmountOut(sdk.WrapSDKContext(suite.Ctx), &types.QueryEstimateSwapExactAmountOutRequest{
+		TokenInDenom: "uatom",
+		TokenOut:     "100uion",
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.directRoute(), outRes.Route)
+	suite.Require().True(outRes.TokenInAmount.IsPositive())
+
+	_, err = suite.queryClient.EstimateSwapExactAmountIn(sdk.WrapSDKContext(suite.Ctx), &types.QueryEstimateSwapExactAmountInRequest{
+		TokenIn:       "invalid",
+		TokenOutDenom: "uion",
+	})
+	suite.Require().Error(err)
+}
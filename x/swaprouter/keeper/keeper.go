@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v12/x/swaprouter/types"
+)
+
+type Keeper struct {
+	cdc      codec.BinaryCodec
+	storeKey sdk.StoreKey
+
+	gammKeeper types.GAMMKeeper
+}
+
+// NewKeeper returns a new instance of the x/swaprouter keeper.
+func NewKeeper(cdc codec.BinaryCodec, storeKey sdk.StoreKey, gammKeeper types.GAMMKeeper) Keeper {
+	return Keeper{
+		cdc:        cdc,
+		storeKey:   storeKey,
+		gammKeeper: gammKeeper,
+	}
+}
+
+// Logger returns a logger for the x/swaprouter module.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
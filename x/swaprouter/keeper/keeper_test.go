@@ -0,0 +1,74 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/osmosis-labs/osmosis/v12/app/apptesting"
+	gammtypes "github.com/osmosis-labs/osmosis/v12/x/gamm/types"
+	"github.com/osmosis-labs/osmosis/v12/x/swaprouter/keeper"
+	"github.com/osmosis-labs/osmosis/v12/x/swaprouter/types"
+)
+
+type KeeperTestSuite struct {
+	apptesting.KeeperTestHelper
+
+	queryClient types.QueryClient
+	msgServer   types.MsgServer
+
+	// shallow pool of uatom against uion
+	directPoolId uint64
+	// deep pools of uatom against uosmo, and of uosmo against uion
+	atomOsmoPoolId, osmoIonPoolId uint64
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.Setup()
+	for _, acc := range suite.TestAccs {
+		suite.FundAcc(acc, sdk.NewCoins(
+			sdk.NewInt64Coin("uatom", 10_000_000),
+			sdk.NewInt64Coin("uion", 10_000_000),
+			sdk.NewInt64Coin("uosmo", 10_000_000),
+		))
+	}
+
+	suite.directPoolId = suite.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin("uatom", 10_000), sdk.NewInt64Coin("uion", 10_000))
+	suite.atomOsmoPoolId = suite.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin("uatom", 1_000_000), sdk.NewInt64Coin("uosmo", 1_000_000))
+	suite.osmoIonPoolId = suite.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin("uosmo", 1_000_000), sdk.NewInt64Coin("uion", 1_000_000))
+
+	suite.queryClient = types.NewQueryClient(suite.QueryHelper)
+	suite.msgServer = keeper.NewMsgServerImpl(*suite.App.SwapRouterKeeper)
+}
+
+// directRoute swaps uatom for uion through the shallow uatom/uion pool.
+func (suite *KeeperTestSuite) directRoute() types.Route {
+	return types.Route{Pools: []gammtypes.SwapAmountInRoute{
+		{PoolId: suite.directPoolId, TokenOutDenom: "uion"},
+	}}
+}
+
+// osmoRoute swaps uatom for uion through the deep uatom/uosmo and uosmo/uion pools.
+func (suite *KeeperTestSuite) osmoRoute() types.Route {
+	return types.Route{Pools: []gammtypes.SwapAmountInRoute{
+		{PoolId: suite.atomOsmoPoolId, TokenOutDenom: "uosmo"},
+		{PoolId: suite.osmoIonPoolId, TokenOutDenom: "uion"},
+	}}
+}
+
+// setAtomIonRoutes sets both the direct and the osmo routes as the preferred routes to swap uatom for uion.
+func (suite *KeeperTestSuite) setAtomIonRoutes() types.DenomPairRoutes {
+	routes := types.DenomPairRoutes{
+		TokenInDenom:  "uatom",
+		TokenOutDenom: "uion",
+		Routes:        []types.Route{suite.directRoute(), suite.osmoRoute()},
+	}
+	err := suite.App.SwapRouterKeeper.SetDenomPairRoutes(suite.Ctx, routes)
+	suite.Require().NoError(err)
+	return routes
+}
@@ -0,0 +1,44 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	gammtypes "github.com/osmosis-labs/osmosis/v12/x/gamm/types"
+	"github.com/osmosis-labs/osmosis/v12/x/swaprouter/types"
+)
+
+func (suite *KeeperTestSuite) TestMsgSwapExactAmountIn() {
+	suite.SetupTest()
+	suite.setAtomIonRoutes()
+	ctx := suite.Ctx.WithEventManager(sdk.NewEventManager())
+
+	res, err := suite.msgServer.SwapExactAmountIn(sdk.WrapSDKContext(ctx), &types.MsgSwapExactAmountIn{
+		Sender:            suite.TestAccs[1].String(),
+		TokenIn:           sdk.NewInt64Coin("uatom", 10_000),
+		TokenOutDenom:     "uion",
+		TokenOutMinAmount: sdk.OneInt(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().True(res.TokenOutAmount.IsPositive())
+
+	// the large swap goes through both pools of the osmo route
+	suite.AssertEventEmitted(ctx, gammtypes.TypeEvtTokenSwapped, 2)
+}
+
+func (suite *KeeperTestSuite) TestMsgSwapExactAmountOut() {
+	suite.SetupTest()
+	suite.setAtomIonRoutes()
+	ctx := suite.Ctx.WithEventManager(sdk.NewEventManager())
+
+	res, err := suite.msgServer.SwapExactAmountOut(sdk.WrapSDKContext(ctx), &types.MsgSwapExactAmountOut{
+		Sender:           suite.TestAccs[1].String(),
+		TokenInDenom:     "uatom",
+		TokenInMaxAmount: sdk.NewInt(1_000),
+		TokenOut:         sdk.NewInt64Coin("uion", 100),
+	})
+	suite.Require().NoError(err)
+	suite.Require().True(res.TokenInAmount.IsPositive())
+
+	// the small swap goes through the direct pool
+	suite.AssertEventEmitted(ctx, gammtypes.TypeEvtTokenSwapped, 1)
+}
@@ -0,0 +1,157 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	gammtypes "github.com/osmosis-labs/osmosis/v12/x/gamm/types"
+	"github.com/osmosis-labs/osmosis/v12/x/swaprouter/types"
+)
+
+// SwapExactAmountIn swaps tokenIn for at least tokenOutMinAmount of tokenOutDenom, through the
+// preferred route of the denom pair with the best estimated amount out.
+func (k Keeper) SwapExactAmountIn(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	tokenOutMinAmount sdk.Int,
+) (tokenOutAmount sdk.Int, err error) {
+	route, _, err := k.EstimateSwapExactAmountIn(ctx, tokenIn, tokenOutDenom)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	return k.gammKeeper.MultihopSwapExactAmountIn(ctx, sender, route.Pools, tokenIn, tokenOutMinAmount)
+}
+
+// SwapExactAmountOut swaps at most tokenInMaxAmount of tokenInDenom for tokenOut, through the
+// preferred route of the denom pair with the best estimated amount in.
+func (k Keeper) SwapExactAmountOut(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	tokenInDenom string,
+	tokenInMaxAmount sdk.Int,
+	tokenOut sdk.Coin,
+) (tokenInAmount sdk.Int, err error) {
+	route, _, err := k.EstimateSwapExactAmountOut(ctx, tokenInDenom, tokenOut)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	return k.gammKeeper.MultihopSwapExactAmountOut(ctx, sender, route.SwapAmountOutRoutes(tokenInDenom), tokenInMaxAmount, tokenOut)
+}
+
+// EstimateSwapExactAmountIn returns the preferred route with the best estimated amount out of
+// swapping tokenIn for tokenOutDenom, along with that amount out.
+// Routes that can not be swapped through, e.g. because one of their pools is inactive, are skipped.
+func (k Keeper) EstimateSwapExactAmountIn(ctx sdk.Context, tokenIn sdk.Coin, tokenOutDenom string) (bestRoute types.Route, tokenOutAmount sdk.Int, err error) {
+	denomPairRoutes, err := k.GetDenomPairRoutes(ctx, tokenIn.Denom, tokenOutDenom)
+	if err != nil {
+		return types.Route{}, sdk.Int{}, err
+	}
+
+	tokenOutAmount = sdk.ZeroInt()
+	for _, route := range denomPairRoutes.Routes {
+		routeOutAmount, err := k.estimateRouteSwapExactAmountIn(ctx, route, tokenIn)
+		if err != nil {
+			continue
+		}
+		if routeOutAmount.GT(tokenOutAmount) {
+			bestRoute, tokenOutAmount = route, routeOutAmount
+		}
+	}
+
+	if !tokenOutAmount.IsPositive() {
+		return types.Route{}, sdk.Int{}, sdkerrors.Wrapf(types.ErrNoExecutableRoute, "swapping %s for %s", tokenIn, tokenOutDenom)
+	}
+	return bestRoute, tokenOutAmount, nil
+}
+
+// EstimateSwapExactAmountOut returns the preferred route with the best estimated amount in of
+// swapping tokenInDenom for tokenOut, along with that amount in.
+// Routes that can not be swapped through, e.g. because one of their pools is inactive, are skipped.
+func (k Keeper) EstimateSwapExactAmountOut(ctx sdk.Context, tokenInDenom string, tokenOut sdk.Coin) (bestRoute types.Route, tokenInAmount sdk.Int, err error) {
+	denomPairRoutes, err := k.GetDenomPairRoutes(ctx, tokenInDenom, tokenOut.Denom)
+	if err != nil {
+		return types.Route{}, sdk.Int{}, err
+	}
+
+	tokenInAmount = sdk.ZeroInt()
+	for _, route := range denomPairRoutes.Routes {
+		routeInAmount, err := k.estimateRouteSwapExactAmountOut(ctx, route, tokenInDenom, tokenOut)
+		if err != nil {
+			continue
+		}
+		if tokenInAmount.IsZero() || routeInAmount.LT(tokenInAmount) {
+			bestRoute, tokenInAmount = route, routeInAmount
+		}
+	}
+
+	if !tokenInAmount.IsPositive() {
+		return types.Route{}, sdk.Int{}, sdkerrors.Wrapf(types.ErrNoExecutableRoute, "swapping %s for %s", tokenInDenom, tokenOut)
+	}
+	return bestRoute, tokenInAmount, nil
+}
+
+// estimateRouteSwapExactAmountIn estimates the amount out of swapping tokenIn through the route,
+// using the swap fee of every pool. It does not account for the discounted swap fee of osmo routed multihops.
+func (k Keeper) estimateRouteSwapExactAmountIn(ctx sdk.Context, route types.Route, tokenIn sdk.Coin) (tokenOutAmount sdk.Int, err error) {
+	defer recoverRouteEstimatePanic(route, &err)
+
+	for _, hop := range route.Pools {
+		pool, err := k.gammKeeper.GetPoolForSwap(ctx, hop.PoolId)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+
+		tokenOut, err := pool.CalcOutAmtGivenIn(ctx, sdk.NewCoins(tokenIn), hop.TokenOutDenom, pool.GetSwapFee(ctx))
+		if err != nil {
+			return sdk.Int{}, err
+		}
+		if !tokenOut.IsPositive() {
+			return sdk.Int{}, sdkerrors.Wrapf(gammtypes.ErrInvalidMathApprox, "pool %d returns no %s", hop.PoolId, hop.TokenOutDenom)
+		}
+		tokenIn = tokenOut
+	}
+	return tokenIn.Amount, nil
+}
+
+// estimateRouteSwapExactAmountOut estimates the amount in of swapping tokenInDenom through the route for tokenOut,
+// using the swap fee of every pool. It does not account for the discounted swap fee of osmo routed multihops.
+func (k Keeper) estimateRouteSwapExactAmountOut(ctx sdk.Context, route types.Route, tokenInDenom string, tokenOut sdk.Coin) (tokenInAmount sdk.Int, err error) {
+	defer recoverRouteEstimatePanic(route, &err)
+
+	outRoutes := route.SwapAmountOutRoutes(tokenInDenom)
+	for i := len(outRoutes) - 1; i >= 0; i-- {
+		hop := outRoutes[i]
+		pool, err := k.gammKeeper.GetPoolForSwap(ctx, hop.PoolId)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+
+		tokenIn, err := pool.CalcInAmtGivenOut(ctx, sdk.NewCoins(tokenOut), hop.TokenInDenom, pool.GetSwapFee(ctx))
+		if err != nil {
+			return sdk.Int{}, err
+		}
+		if !tokenIn.IsPositive() {
+			return sdk.Int{}, sdkerrors.Wrapf(gammtypes.ErrInvalidMathApprox, "pool %d requires no %s", hop.PoolId, hop.TokenInDenom)
+		}
+		tokenOut = tokenIn
+	}
+	return tokenOut.Amount, nil
+}
+
+// recoverRouteEstimatePanic turns a panic of the pool math, e.g. when swapping more than the pool liquidity,
+// into an error of the route estimate. Out of gas panics are not recovered.
+func recoverRouteEstimatePanic(route types.Route, err *error) {
+	if r := recover(); r != nil {
+		if _, isOutOfGas := r.(storetypes.ErrorOutOfGas); isOutOfGas {
+			panic(r)
+		}
+		*err = fmt.Errorf("estimating the swap through pools %v panicked: %v", route.PoolIds(), r)
+	}
+}
@@ -0,0 +1,171 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	gammtypes "github.com/osmosis-labs/osmosis/v12/x/gamm/types"
+	"github.com/osmosis-labs/osmosis/v12/x/swaprouter/types"
+)
+
+func (suite *KeeperTestSuite) TestSwapExactAmountIn() {
+	tests := []struct {
+		name              string
+		tokenIn           sdk.Coin
+		tokenOutMinAmount sdk.Int
+		expectedRoute     func() types.Route
+		expectedErr       error
+	}{
+		{
+			name:              "small swap through the direct pool",
+			tokenIn:           sdk.NewInt64Coin("uatom", 100),
+			tokenOutMinAmount: sdk.OneInt(),
+			expectedRoute:     suite.directRoute,
+		},
+		{
+			name:              "large swap through the deep osmo pools",
+			tokenIn:           sdk.NewInt64Coin("uatom", 10_000),
+			tokenOutMinAmount: sdk.OneInt(),
+			expectedRoute:     suite.osmoRoute,
+		},
+		{
+			name:              "amount out lesser than min amount",
+			tokenIn:           sdk.NewInt64Coin("uatom", 10_000),
+			tokenOutMinAmount: sdk.NewInt(10_000),
+			expectedErr:       gammtypes.ErrLimitMinAmount,
+		},
+		{
+			name:              "no routes for the denom pair",
+			tokenIn:           sdk.NewInt64Coin("uosmo", 10_000),
+			tokenOutMinAmount: sdk.OneInt(),
+			expectedErr:       types.ErrNoRoutes,
+		},
+	}
+
+	for _, test := range tests {
+		suite.Run(test.name, func() {
+			suite.SetupTest()
+			suite.setAtomIonRoutes()
+			sender := suite.TestAccs[1]
+			k := suite.App.SwapRouterKeeper
+
+			if test.expectedErr != nil {
+				_, err := k.SwapExactAmountIn(suite.Ctx, sender, test.tokenIn, "uion", test.tokenOutMinAmount)
+				suite.Require().ErrorIs(err, test.expectedErr)
+				return
+			}
+
+			// the amount out of the expected route, swapped directly through gamm
+			cacheCtx, _ := suite.Ctx.CacheContext()
+			expectedRoute := test.expectedRoute()
+			expectedTokenOutAmount, err := suite.App.GAMMKeeper.MultihopSwapExactAmountIn(cacheCtx, sender, expectedRoute.Pools, test.tokenIn, test.tokenOutMinAmount)
+			suite.Require().NoError(err)
+
+			route, estimatedTokenOutAmount, err := k.EstimateSwapExactAmountIn(suite.Ctx, test.tokenIn, "uion")
+			suite.Require().NoError(err)
+			suite.Require().Equal(expectedRoute, route)
+			suite.Require().Equal(expectedTokenOutAmount, estimatedTokenOutAmount)
+
+			balancesBefore := suite.App.BankKeeper.GetAllBalances(suite.Ctx, sender)
+			tokenOutAmount, err := k.SwapExactAmountIn(suite.Ctx, sender, test.tokenIn, "uion", test.tokenOutMinAmount)
+			suite.Require().NoError(err)
+			suite.Require().Equal(expectedTokenOutAmount, tokenOutAmount)
+
+			expectedBalances := balancesBefore.Sub(sdk.NewCoins(test.tokenIn)).Add(sdk.NewCoin("uion", tokenOutAmount))
+			suite.Require().Equal(expectedBalances, suite.App.BankKeeper.GetAllBalances(suite.Ctx, sender))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSwapExactAmountOut() {
+	tests := []struct {
+		name             string
+		tokenOut         sdk.Coin
+		tokenInMaxAmount sdk.Int
+		expectedRoute    func() types.Route
+		expectedErr      error
+	}{
+		{
+			name:             "small swap through the direct pool",
+			tokenOut:         sdk.NewInt64Coin("uion", 100),
+			tokenInMaxAmount: sdk.NewInt(1_000_000),
+			expectedRoute:    suite.directRoute,
+		},
+		{
+			name:             "large swap through the deep osmo pools",
+			tokenOut:         sdk.NewInt64Coin("uion", 5_000),
+			tokenInMaxAmount: sdk.NewInt(1_000_000),
+			expectedRoute:    suite.osmoRoute,
+		},
+		{
+			name:             "amount in greater than max amount",
+			tokenOut:         sdk.NewInt64Coin("uion", 5_000),
+			tokenInMaxAmount: sdk.NewInt(5_000),
+			expectedErr:      gammtypes.ErrLimitMaxAmount,
+		},
+		{
+			name:             "route pools can not provide the amount out",
+			tokenOut:         sdk.NewInt64Coin("uion", 5_000_000),
+			tokenInMaxAmount: sdk.NewInt(1_000_000),
+			expectedErr:      types.ErrNoExecutableRoute,
+		},
+	}
+
+	for _, test := range tests {
+		suite.Run(test.name, func() {
+			suite.SetupTest()
+			routes := suite.setAtomIonRoutes()
+			sender := suite.TestAccs[1]
+			k := suite.App.SwapRouterKeeper
+
+			if test.expectedErr != nil {
+				_, err := k.SwapExactAmountOut(suite.Ctx, sender, "uatom", test.tokenInMaxAmount, test.tokenOut)
+				suite.Require().ErrorIs(err, test.expectedErr)
+				return
+			}
+
+			// the amount in of the expected route, swapped directly through gamm
+			cacheCtx, _ := suite.Ctx.CacheContext()
+			expectedRoute := test.expectedRoute()
+			expectedTokenInAmount, err := suite.App.GAMMKeeper.MultihopSwapExactAmountOut(cacheCtx, sender, expectedRoute.SwapAmountOutRoutes(routes.TokenInDenom), test.tokenInMaxAmount, test.tokenOut)
+			suite.Require().NoError(err)
+
+			route, estimatedTokenInAmount, err := k.EstimateSwapExactAmountOut(suite.Ctx, "uatom", test.tokenOut)
+			suite.Require().NoError(err)
+			suite.Require().Equal(expectedRoute, route)
+			suite.Require().Equal(expectedTokenInAmount, estimatedTokenInAmount)
+
+			balancesBefore := suite.App.BankKeeper.GetAllBalances(suite.Ctx, sender)
+			tokenInAmount, err := k.SwapExactAmountOut(suite.Ctx, sender, "uatom", test.tokenInMaxAmount, test.tokenOut)
+			suite.Require().NoError(err)
+			suite.Require().Equal(expectedTokenInAmount, tokenInAmount)
+
+			expectedBalances := balancesBefore.Sub(sdk.NewCoins(sdk.NewCoin("uatom", tokenInAmount))).Add(test.tokenOut)
+			suite.Require().Equal(expectedBalances, suite.App.BankKeeper.GetAllBalances(suite.Ctx, sender))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestEstimateSkipsUnswappableRoutes() {
+	suite.SetupTest()
+	suite.setAtomIonRoutes()
+	tokenIn := sdk.NewInt64Coin("uatom", 100)
+
+	route, _, err := suite.App.SwapRouterKeeper.EstimateSwapExactAmountIn(suite.Ctx, tokenIn, "uion")
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.directRoute(), route)
+
+	// once the direct pool is gone, the osmo route is the best one left
+	err = suite.App.GAMMKeeper.DeletePool(suite.Ctx, suite.directPoolId)
+	suite.Require().NoError(err)
+
+	route, _, err = suite.App.SwapRouterKeeper.EstimateSwapExactAmountIn(suite.Ctx, tokenIn, "uion")
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.osmoRoute(), route)
+
+	// without any swappable route, the swap fails
+	err = suite.App.GAMMKeeper.DeletePool(suite.Ctx, suite.osmoIonPoolId)
+	suite.Require().NoError(err)
+
+	_, err = suite.App.SwapRouterKeeper.SwapExactAmountIn(suite.Ctx, suite.TestAccs[1], tokenIn, "uion", sdk.OneInt())
+	suite.Require().ErrorIs(err, types.ErrNoExecutableRoute)
+}
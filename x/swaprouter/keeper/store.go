@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v12/osmoutils"
+	"github.com/osmosis-labs/osmosis/v12/x/swaprouter/types"
+)
+
+// GetDenomPairRoutes returns the preferred routes to swap tokenInDenom for tokenOutDenom.
+func (k Keeper) GetDenomPairRoutes(ctx sdk.Context, tokenInDenom, tokenOutDenom string) (types.DenomPairRoutes, error) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.DenomPairRoutesKey(tokenInDenom, tokenOutDenom)
+	if !store.Has(key) {
+		return types.DenomPairRoutes{}, sdkerrors.Wrapf(types.ErrNoRoutes, "%s to %s", tokenInDenom, tokenOutDenom)
+	}
+	var routes types.DenomPairRoutes
+	osmoutils.MustGet(store, key, &routes)
+	return routes, nil
+}
+
+// SetDenomPairRoutes sets the preferred routes of their denom pair, replacing the previous ones.
+// Every hop of the routes must go through a pool holding both its token in and token out denoms.
+func (k Keeper) SetDenomPairRoutes(ctx sdk.Context, routes types.DenomPairRoutes) error {
+	if err := routes.Validate(); err != nil {
+		return err
+	}
+
+	for i, route := range routes.Routes {
+		if err := k.validateRoutePools(ctx, routes.TokenInDenom, route); err != nil {
+			return sdkerrors.Wrapf(err, "route %d of %s to %s", i, routes.TokenInDenom, routes.TokenOutDenom)
+		}
+	}
+
+	k.setDenomPairRoutes(ctx, routes)
+	return nil
+}
+
+func (k Keeper) setDenomPairRoutes(ctx sdk.Context, routes types.DenomPairRoutes) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.DenomPairRoutesKey(routes.TokenInDenom, routes.TokenOutDenom), &routes)
+}
+
+// DeleteDenomPairRoutes deletes all of the preferred routes to swap tokenInDenom for tokenOutDenom.
+func (k Keeper) DeleteDenomPairRoutes(ctx sdk.Context, tokenInDenom, tokenOutDenom string) error {
+	store := ctx.KVStore(k.storeKey)
+	key := types.DenomPairRoutesKey(tokenInDenom, tokenOutDenom)
+	if !store.Has(key) {
+		return sdkerrors.Wrapf(types.ErrNoRoutes, "%s to %s", tokenInDenom, tokenOutDenom)
+	}
+	store.Delete(key)
+	return nil
+}
+
+// GetAllDenomPairRoutes returns the preferred routes of all of the denom pairs.
+func (k Keeper) GetAllDenomPairRoutes(ctx sdk.Context) []types.DenomPairRoutes {
+	routes, err := osmoutils.GatherValuesFromStore(ctx.KVStore(k.storeKey),
+		types.KeyPrefixDenomPairRoutes, sdk.PrefixEndBytes(types.KeyPrefixDenomPairRoutes), k.parseDenomPairRoutes)
+	if err != nil {
+		panic(err)
+	}
+	return routes
+}
+
+func (k Keeper) parseDenomPairRoutes(bz []byte) (types.DenomPairRoutes, error) {
+	var routes types.DenomPairRoutes
+	err := k.cdc.Unmarshal(bz, &routes)
+	return routes, err
+}
+
+// validateRoutePools checks that every pool of the route holds both the token in and token out denoms of its hop.
+func (k Keeper) validateRoutePools(ctx sdk.Context, tokenInDenom string, route types.Route) error {
+	for _, hop := range route.Pools {
+		poolDenoms, err := k.gammKeeper.GetPoolDenoms(ctx, hop.PoolId)
+		if err != nil {
+			return sdkerrors.Wrap(types.ErrInvalidRoute, err.Error())
+		}
+		if !containsDenom(poolDenoms, tokenInDenom) || !containsDenom(poolDenoms, hop.TokenOutDenom) {
+			return sdkerrors.Wrapf(types.ErrInvalidRoute, "pool %d does not hold both %s and %s", hop.PoolId, tokenInDenom, hop.TokenOutDenom)
+		}
+		tokenInDenom = hop.TokenOutDenom
+	}
+	return nil
+}
+
+func containsDenom(denoms []string, denom string) bool {
+	for _, d := range denoms {
+		if d == denom {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,87 @@
+package keeper_test
+
+import (
+	gammtypes "github.com/osmosis-labs/osmosis/v12/x/gamm/types"
+	"github.com/osmosis-labs/osmosis/v12/x/swaprouter/types"
+)
+
+func (suite *KeeperTestSuite) TestSetDenomPairRoutes() {
+	tests := []struct {
+		name        string
+		routes      []types.Route
+		expectedErr error
+	}{
+		{
+			name:   "direct and multihop routes",
+			routes: []types.Route{suite.directRoute(), suite.osmoRoute()},
+		},
+		{
+			name:        "no routes",
+			routes:      []types.Route{},
+			expectedErr: types.ErrInvalidRoute,
+		},
+		{
+			name: "route not ending in the token out denom",
+			routes: []types.Route{{Pools: []gammtypes.SwapAmountInRoute{
+				{PoolId: suite.atomOsmoPoolId, TokenOutDenom: "uosmo"},
+			}}},
+			expectedErr: types.ErrInvalidRoute,
+		},
+		{
+			name: "pool not holding the denoms of its hop",
+			routes: []types.Route{{Pools: []gammtypes.SwapAmountInRoute{
+				{PoolId: suite.osmoIonPoolId, TokenOutDenom: "uion"},
+			}}},
+			expectedErr: types.ErrInvalidRoute,
+		},
+		{
+			name: "non existent pool",
+			routes: []types.Route{{Pools: []gammtypes.SwapAmountInRoute{
+				{PoolId: 10, TokenOutDenom: "uion"},
+			}}},
+			expectedErr: types.ErrInvalidRoute,
+		},
+	}
+
+	for _, test := range tests {
+		suite.Run(test.name, func() {
+			suite.SetupTest()
+			k := suite.App.SwapRouterKeeper
+
+			routes := types.DenomPairRoutes{TokenInDenom: "uatom", TokenOutDenom: "uion", Routes: test.routes}
+			err := k.SetDenomPairRoutes(suite.Ctx, routes)
+
+			if test.expectedErr != nil {
+				suite.Require().ErrorIs(err, test.expectedErr)
+				_, err = k.GetDenomPairRoutes(suite.Ctx, "uatom", "uion")
+				suite.Require().ErrorIs(err, types.ErrNoRoutes)
+				return
+			}
+
+			suite.Require().NoError(err)
+			storedRoutes, err := k.GetDenomPairRoutes(suite.Ctx, "uatom", "uion")
+			suite.Require().NoError(err)
+			suite.Require().Equal(routes, storedRoutes)
+			suite.Require().Equal([]types.DenomPairRoutes{routes}, k.GetAllDenomPairRoutes(suite.Ctx))
+
+			// routes are directed
+			_, err = k.GetDenomPairRoutes(suite.Ctx, "uion", "uatom")
+			suite.Require().ErrorIs(err, types.ErrNoRoutes)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestDeleteDenomPairRoutes() {
+	suite.SetupTest()
+	k := suite.App.SwapRouterKeeper
+	suite.setAtomIonRoutes()
+
+	err := k.DeleteDenomPairRoutes(suite.Ctx, "uatom", "uion")
+	suite.Require().NoError(err)
+	_, err = k.GetDenomPairRoutes(suite.Ctx, "uatom", "uion")
+	suite.Require().ErrorIs(err, types.ErrNoRoutes)
+	suite.Require().Empty(k.GetAllDenomPairRoutes(suite.Ctx))
+
+	err = k.DeleteDenomPairRoutes(suite.Ctx, "uatom", "uion")
+	suite.Require().ErrorIs(err, types.ErrNoRoutes)
+}
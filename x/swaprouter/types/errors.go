@@ -0,0 +1,18 @@
+package types
+
+// DONTCOVER
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/swaprouter module sentinel errors
+var (
+	ErrInvalidDenomPair    = sdkerrors.Register(ModuleName, 2, "invalid denom pair")
+	ErrInvalidRoute        = sdkerrors.Register(ModuleName, 3, "invalid route")
+	ErrNoRoutes            = sdkerrors.Register(ModuleName, 4, "no preferred routes for denom pair")
+	ErrNoExecutableRoute   = sdkerrors.Register(ModuleName, 5, "none of the preferred routes of the denom pair can be swapped through")
+	ErrInvalidGenesis      = sdkerrors.Register(ModuleName, 6, "invalid genesis")
+	ErrDuplicateDenomPair  = sdkerrors.Register(ModuleName, 7, "duplicate denom pair")
+	ErrNotPositiveCriteria = sdkerrors.Register(ModuleName, 8, "min out amount or max in amount should be positive")
+)
@@ -0,0 +1,15 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	gammtypes "github.com/osmosis-labs/osmosis/v12/x/gamm/types"
+)
+
+// GAMMKeeper defines the expected interface needed to estimate and execute swaps through gamm pools.
+type GAMMKeeper interface {
+	GetPoolForSwap(ctx sdk.Context, poolId uint64) (gammtypes.PoolI, error)
+	GetPoolDenoms(ctx sdk.Context, poolId uint64) ([]string, error)
+	MultihopSwapExactAmountIn(ctx sdk.Context, sender sdk.AccAddress, routes []gammtypes.SwapAmountInRoute, tokenIn sdk.Coin, tokenOutMinAmount sdk.Int) (tokenOutAmount sdk.Int, err error)
+	MultihopSwapExactAmountOut(ctx sdk.Context, sender sdk.AccAddress, routes []gammtypes.SwapAmountOutRoute, tokenInMaxAmount sdk.Int, tokenOut sdk.Coin) (tokenInAmount sdk.Int, err error)
+}
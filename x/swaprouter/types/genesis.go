@@ -0,0 +1,21 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// DefaultGenesis returns the default swaprouter genesis state.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		DenomPairRoutes: []DenomPairRoutes{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := ValidateDenomPairRoutes(gs.DenomPairRoutes); err != nil {
+		return sdkerrors.Wrap(ErrInvalidGenesis, err.Error())
+	}
+	return nil
+}
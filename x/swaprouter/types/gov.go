@@ -0,0 +1,116 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	ProposalTypeSetPreferredRoutes    = "SetPreferredRoutes"
+	ProposalTypeRemovePreferredRoutes = "RemovePreferredRoutes"
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeSetPreferredRoutes)
+	govtypes.RegisterProposalTypeCodec(&SetPreferredRoutesProposal{}, "osmosis/SetPreferredRoutesProposal")
+	govtypes.RegisterProposalType(ProposalTypeRemovePreferredRoutes)
+	govtypes.RegisterProposalTypeCodec(&RemovePreferredRoutesProposal{}, "osmosis/RemovePreferredRoutesProposal")
+}
+
+var (
+	_ govtypes.Content = &SetPreferredRoutesProposal{}
+	_ govtypes.Content = &RemovePreferredRoutesProposal{}
+)
+
+func NewSetPreferredRoutesProposal(title, description string, denomPairRoutes []DenomPairRoutes) govtypes.Content {
+	return &SetPreferredRoutesProposal{
+		Title:           title,
+		Description:     description,
+		DenomPairRoutes: denomPairRoutes,
+	}
+}
+
+func (p *SetPreferredRoutesProposal) GetTitle() string { return p.Title }
+
+func (p *SetPreferredRoutesProposal) GetDescription() string { return p.Description }
+
+func (p *SetPreferredRoutesProposal) ProposalRoute() string { return RouterKey }
+
+func (p *SetPreferredRoutesProposal) ProposalType() string {
+	return ProposalTypeSetPreferredRoutes
+}
+
+func (p *SetPreferredRoutesProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if len(p.DenomPairRoutes) == 0 {
+		return fmt.Errorf("no denom pair routes to set")
+	}
+
+	return ValidateDenomPairRoutes(p.DenomPairRoutes)
+}
+
+func (p SetPreferredRoutesProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Set Preferred Routes Proposal:
+  Title:       %s
+  Description: %s
+  Routes:
+`, p.Title, p.Description))
+	for _, routes := range p.DenomPairRoutes {
+		b.WriteString(fmt.Sprintf("    %s to %s: %+v\n", routes.TokenInDenom, routes.TokenOutDenom, routes.Routes))
+	}
+	return b.String()
+}
+
+func NewRemovePreferredRoutesProposal(title, description string, denomPairs []DenomPair) govtypes.Content {
+	return &RemovePreferredRoutesProposal{
+		Title:       title,
+		Description: description,
+		DenomPairs:  denomPairs,
+	}
+}
+
+func (p *RemovePreferredRoutesProposal) GetTitle() string { return p.Title }
+
+func (p *RemovePreferredRoutesProposal) GetDescription() string { return p.Description }
+
+func (p *RemovePreferredRoutesProposal) ProposalRoute() string { return RouterKey }
+
+func (p *RemovePreferredRoutesProposal) ProposalType() string {
+	return ProposalTypeRemovePreferredRoutes
+}
+
+func (p *RemovePreferredRoutesProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if len(p.DenomPairs) == 0 {
+		return fmt.Errorf("no denom pairs to remove the routes of")
+	}
+
+	for _, denomPair := range p.DenomPairs {
+		if err := denomPair.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p RemovePreferredRoutesProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Remove Preferred Routes Proposal:
+  Title:       %s
+  Description: %s
+  DenomPairs:  %+v
+`, p.Title, p.Description, p.DenomPairs))
+	return b.String()
+}
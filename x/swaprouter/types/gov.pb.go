@@ -0,0 +1,684 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/swaprouter/v1beta1/gov.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SetPreferredRoutesProposal is a gov Content type to set the preferred routes
+// of denom pairs. The routes of a denom pair replace the ones previously set.
+type SetPreferredRoutesProposal struct {
+	Title           string            `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description     string            `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	DenomPairRoutes []DenomPairRoutes `protobuf:"bytes,3,rep,name=denom_pair_routes,json=denomPairRoutes,proto3" json:"denom_pair_routes"`
+}
+
+func (m *SetPreferredRoutesProposal) Reset()      { *m = SetPreferredRoutesProposal{} }
+func (*SetPreferredRoutesProposal) ProtoMessage() {}
+func (*SetPreferredRoutesProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f70a4b3cbf9fe898, []int{0}
+}
+func (m *SetPreferredRoutesProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetPreferredRoutesProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetPreferredRoutesProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetPreferredRoutesProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetPreferredRoutesProposal.Merge(m, src)
+}
+func (m *SetPreferredRoutesProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetPreferredRoutesProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetPreferredRoutesProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetPreferredRoutesProposal proto.InternalMessageInfo
+
+// RemovePreferredRoutesProposal is a gov Content type to remove all of the
+// preferred routes of denom pairs.
+type RemovePreferredRoutesProposal struct {
+	Title       string      `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string      `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	DenomPairs  []DenomPair `protobuf:"bytes,3,rep,name=denom_pairs,json=denomPairs,proto3" json:"denom_pairs"`
+}
+
+func (m *RemovePreferredRoutesProposal) Reset()      { *m = RemovePreferredRoutesProposal{} }
+func (*RemovePreferredRoutesProposal) ProtoMessage() {}
+func (*RemovePreferredRoutesProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f70a4b3cbf9fe898, []int{1}
+}
+func (m *RemovePreferredRoutesProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemovePreferredRoutesProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemovePreferredRoutesProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RemovePreferredRoutesProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemovePreferredRoutesProposal.Merge(m, src)
+}
+func (m *RemovePreferredRoutesProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemovePreferredRoutesProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemovePreferredRoutesProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemovePreferredRoutesProposal proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*SetPreferredRoutesProposal)(nil), "osmosis.swaprouter.v1beta1.SetPreferredRoutesProposal")
+	proto.RegisterType((*RemovePreferredRoutesProposal)(nil), "osmosis.swaprouter.v1beta1.RemovePreferredRoutesProposal")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/swaprouter/v1beta1/gov.proto", fileDescriptor_f70a4b3cbf9fe898)
+}
+
+var fileDescriptor_f70a4b3cbf9fe898 = []byte{
+	// 325 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x91, 0xbf, 0x4a, 0x03, 0x41,
+	0x10, 0xc6, 0x6f, 0x8d, 0x8a, 0x6e, 0x0a, 0xf1, 0x48, 0x11, 0x0e, 0xbc, 0x84, 0xa0, 0x10, 0x08,
+	0xde, 0x92, 0x08, 0x16, 0x96, 0xc1, 0xd2, 0xe2, 0x38, 0x3b, 0x41, 0xc2, 0x5e, 0x6e, 0x3c, 0x17,
+	0x72, 0x99, 0x65, 0x77, 0x73, 0xea, 0x1b, 0x58, 0x5a, 0x5a, 0xe6, 0x0d, 0x7c, 0x02, 0xfb, 0x94,
+	0x29, 0xad, 0x44, 0x92, 0x17, 0x91, 0x5c, 0x2e, 0xe6, 0x2c, 0x14, 0x0b, 0xbb, 0xf9, 0xf3, 0xcd,
+	0xcc, 0xef, 0x63, 0xe8, 0x21, 0xea, 0x04, 0xb5, 0xd0, 0x4c, 0xdf, 0x71, 0xa9, 0x70, 0x64, 0x40,
+	0xb1, 0xb4, 0x1d, 0x82, 0xe1, 0x6d, 0x16, 0x63, 0xea, 0x49, 0x85, 0x06, 0x6d, 0x27, 0x57, 0x79,
+	0x6b, 0x95, 0x97, 0xab, 0x9c, 0x4a, 0x8c, 0x31, 0x66, 0x32, 0xb6, 0x88, 0x96, 0x13, 0x4e, 0xeb,
+	0x97, 0xbd, 0x85, 0x25, 0x99, 0xb8, 0xf1, 0x4a, 0xa8, 0x73, 0x09, 0xc6, 0x57, 0x70, 0x03, 0x4a,
+	0x41, 0x14, 0x2c, 0x9a, 0xda, 0x57, 0x28, 0x51, 0xf3, 0x81, 0x5d, 0xa1, 0x5b, 0x46, 0x98, 0x01,
+	0x54, 0x49, 0x9d, 0x34, 0x77, 0x83, 0x65, 0x62, 0xd7, 0x69, 0x39, 0x02, 0xdd, 0x57, 0x42, 0x1a,
+	0x81, 0xc3, 0xea, 0x46, 0xd6, 0x2b, 0x96, 0xec, 0x6b, 0xba, 0x1f, 0xc1, 0x10, 0x93, 0x9e, 0xe4,
+	0x42, 0xf5, 0xb2, 0x8b, 0xba, 0x5a, 0xaa, 0x97, 0x9a, 0xe5, 0x4e, 0xcb, 0xfb, 0xd9, 0x91, 0x77,
+	0xbe, 0x18, 0xf2, 0xb9, 0x50, 0x4b, 0x8e, 0xee, 0xe6, 0xe4, 0xbd, 0x66, 0x05, 0x7b, 0xd1, 0xf7,
+	0xf2, 0xd9, 0xce, 0xe3, 0xb8, 0x66, 0x3d, 0x8f, 0x6b, 0x56, 0xe3, 0x85, 0xd0, 0x83, 0x00, 0x12,
+	0x4c, 0xe1, 0xbf, 0x2d, 0x5c, 0xd0, 0xf2, 0xda, 0xc2, 0x0a, 0xfe, 0xe8, 0x4f, 0xf0, 0x39, 0x36,
+	0xfd, 0xc2, 0x2e, 0x10, 0x77, 0xfd, 0xc9, 0xcc, 0x25, 0xd3, 0x99, 0x4b, 0x3e, 0x66, 0x2e, 0x79,
+	0x9a, 0xbb, 0xd6, 0x74, 0xee, 0x5a, 0x6f, 0x73, 0xd7, 0xba, 0x3a, 0x8d, 0x85, 0xb9, 0x1d, 0x85,
+	0x5e, 0x1f, 0x13, 0x96, 0x9f, 0x39, 0x1e, 0xf0, 0x50, 0xaf, 0x12, 0x96, 0xb6, 0x3b, 0xec, 0xbe,
+	0xf8, 0x56, 0xf3, 0x20, 0x41, 0x87, 0xdb, 0xd9, 0x2b, 0x4f, 0x3e, 0x07, 0x00, 0x88, 0xb4, 0x70,
+	0x80, 0x51, 0x02, 0x00, 0x00,
+}
+
+func (m *SetPreferredRoutesProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetPreferredRoutesProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetPreferredRoutesProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DenomPairRoutes) > 0 {
+		for iNdEx := len(m.DenomPairRoutes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomPairRoutes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemovePreferredRoutesProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemovePreferredRoutesProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemovePreferredRoutesProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DenomPairs) > 0 {
+		for iNdEx := len(m.DenomPairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomPairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGov(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SetPreferredRoutesProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.DenomPairRoutes) > 0 {
+		for _, e := range m.DenomPairRoutes {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RemovePreferredRoutesProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.DenomPairs) > 0 {
+		for _, e := range m.DenomPairs {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGov(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGov(x uint64) (n int) {
+	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SetPreferredRoutesProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetPreferredRoutesProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetPreferredRoutesProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomPairRoutes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomPairRoutes = append(m.DenomPairRoutes, DenomPairRoutes{})
+			if err := m.DenomPairRoutes[len(m.DenomPairRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemovePreferredRoutesProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemovePreferredRoutesProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemovePreferredRoutesProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomPairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomPairs = append(m.DenomPairs, DenomPair{})
+			if err := m.DenomPairs[len(m.DenomPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGov(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGov
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGov
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGov
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGov        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGov          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGov = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,31 @@
+package types
+
+import (
+	"strings"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "swaprouter"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for swaprouter
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+
+	// KeySeparator separates the denoms of a denom pair in store keys.
+	// Denoms can not contain it.
+	KeySeparator = "|"
+)
+
+// KeyPrefixDenomPairRoutes defines the prefix to store the preferred routes of denom pairs.
+var KeyPrefixDenomPairRoutes = []byte{0x01}
+
+// DenomPairRoutesKey returns the store key for the preferred routes of the given denom pair.
+func DenomPairRoutesKey(tokenInDenom, tokenOutDenom string) []byte {
+	return append(append([]byte{}, KeyPrefixDenomPairRoutes...), []byte(strings.Join([]string{tokenInDenom, tokenOutDenom}, KeySeparator))...)
+}
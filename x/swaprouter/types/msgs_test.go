@@ -0,0 +1,114 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/v12/x/swaprouter/types"
+)
+
+func TestMsgSwapExactAmountInValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress([]byte("addr1---------------")).String()
+	validMsg := func() types.MsgSwapExactAmountIn {
+		return types.MsgSwapExactAmountIn{
+			Sender:            addr,
+			TokenIn:           sdk.NewInt64Coin("uatom", 1000),
+			TokenOutDenom:     "uosmo",
+			TokenOutMinAmount: sdk.OneInt(),
+		}
+	}
+
+	tests := map[string]struct {
+		modify    func(*types.MsgSwapExactAmountIn)
+		expectErr bool
+	}{
+		"valid": {
+			modify: func(*types.MsgSwapExactAmountIn) {},
+		},
+		"invalid sender": {
+			modify:    func(m *types.MsgSwapExactAmountIn) { m.Sender = "invalid" },
+			expectErr: true,
+		},
+		"zero token in": {
+			modify:    func(m *types.MsgSwapExactAmountIn) { m.TokenIn = sdk.NewInt64Coin("uatom", 0) },
+			expectErr: true,
+		},
+		"same token in and out denoms": {
+			modify:    func(m *types.MsgSwapExactAmountIn) { m.TokenOutDenom = "uatom" },
+			expectErr: true,
+		},
+		"invalid token out denom": {
+			modify:    func(m *types.MsgSwapExactAmountIn) { m.TokenOutDenom = "1" },
+			expectErr: true,
+		},
+		"zero token out min amount": {
+			modify:    func(m *types.MsgSwapExactAmountIn) { m.TokenOutMinAmount = sdk.ZeroInt() },
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			msg := validMsg()
+			tc.modify(&msg)
+			err := msg.ValidateBasic()
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgSwapExactAmountOutValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress([]byte("addr1---------------")).String()
+	validMsg := func() types.MsgSwapExactAmountOut {
+		return types.MsgSwapExactAmountOut{
+			Sender:           addr,
+			TokenInDenom:     "uatom",
+			TokenInMaxAmount: sdk.NewInt(1000),
+			TokenOut:         sdk.NewInt64Coin("uosmo", 1000),
+		}
+	}
+
+	tests := map[string]struct {
+		modify    func(*types.MsgSwapExactAmountOut)
+		expectErr bool
+	}{
+		"valid": {
+			modify: func(*types.MsgSwapExactAmountOut) {},
+		},
+		"invalid sender": {
+			modify:    func(m *types.MsgSwapExactAmountOut) { m.Sender = "invalid" },
+			expectErr: true,
+		},
+		"zero token out": {
+			modify:    func(m *types.MsgSwapExactAmountOut) { m.TokenOut = sdk.NewInt64Coin("uosmo", 0) },
+			expectErr: true,
+		},
+		"same token in and out denoms": {
+			modify:    func(m *types.MsgSwapExactAmountOut) { m.TokenInDenom = "uosmo" },
+			expectErr: true,
+		},
+		"zero token in max amount": {
+			modify:    func(m *types.MsgSwapExactAmountOut) { m.TokenInMaxAmount = sdk.ZeroInt() },
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			msg := validMsg()
+			tc.modify(&msg)
+			err := msg.ValidateBasic()
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,1979 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/swaprouter/v1beta1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// =============================== DenomPairRoutes
+type QueryDenomPairRoutesRequest struct {
+	TokenInDenom  string `protobuf:"bytes,1,opt,name=token_in_denom,json=tokenInDenom,proto3" json:"token_in_denom,omitempty" yaml:"token_in_denom"`
+	TokenOutDenom string `protobuf:"bytes,2,opt,name=token_out_denom,json=tokenOutDenom,proto3" json:"token_out_denom,omitempty" yaml:"token_out_denom"`
+}
+
+func (m *QueryDenomPairRoutesRequest) Reset()         { *m = QueryDenomPairRoutesRequest{} }
+func (m *QueryDenomPairRoutesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomPairRoutesRequest) ProtoMessage()    {}
+func (*QueryDenomPairRoutesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4d9de31afe32e1e0, []int{0}
+}
+func (m *QueryDenomPairRoutesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomPairRoutesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomPairRoutesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomPairRoutesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomPairRoutesRequest.Merge(m, src)
+}
+func (m *QueryDenomPairRoutesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomPairRoutesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomPairRoutesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomPairRoutesRequest proto.InternalMessageInfo
+
+func (m *QueryDenomPairRoutesRequest) GetTokenInDenom() string {
+	if m != nil {
+		return m.TokenInDenom
+	}
+	return ""
+}
+
+func (m *QueryDenomPairRoutesRequest) GetTokenOutDenom() string {
+	if m != nil {
+		return m.TokenOutDenom
+	}
+	return ""
+}
+
+type QueryDenomPairRoutesResponse struct {
+	DenomPairRoutes DenomPairRoutes `protobuf:"bytes,1,opt,name=denom_pair_routes,json=denomPairRoutes,proto3" json:"denom_pair_routes" yaml:"denom_pair_routes"`
+}
+
+func (m *QueryDenomPairRoutesResponse) Reset()         { *m = QueryDenomPairRoutesResponse{} }
+func (m *QueryDenomPairRoutesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomPairRoutesResponse) ProtoMessage()    {}
+func (*QueryDenomPairRoutesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4d9de31afe32e1e0, []int{1}
+}
+func (m *QueryDenomPairRoutesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomPairRoutesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomPairRoutesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomPairRoutesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomPairRoutesResponse.Merge(m, src)
+}
+func (m *QueryDenomPairRoutesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomPairRoutesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomPairRoutesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomPairRoutesResponse proto.InternalMessageInfo
+
+func (m *QueryDenomPairRoutesResponse) GetDenomPairRoutes() DenomPairRoutes {
+	if m != nil {
+		return m.DenomPairRoutes
+	}
+	return DenomPairRoutes{}
+}
+
+// =============================== AllDenomPairRoutes
+type QueryAllDenomPairRoutesRequest struct {
+}
+
+func (m *QueryAllDenomPairRoutesRequest) Reset()         { *m = QueryAllDenomPairRoutesRequest{} }
+func (m *QueryAllDenomPairRoutesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllDenomPairRoutesRequest) ProtoMessage()    {}
+func (*QueryAllDenomPairRoutesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4d9de31afe32e1e0, []int{2}
+}
+func (m *QueryAllDenomPairRoutesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllDenomPairRoutesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllDenomPairRoutesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllDenomPairRoutesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllDenomPairRoutesRequest.Merge(m, src)
+}
+func (m *QueryAllDenomPairRoutesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllDenomPairRoutesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllDenomPairRoutesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllDenomPairRoutesRequest proto.InternalMessageInfo
+
+type QueryAllDenomPairRoutesResponse struct {
+	DenomPairRoutes []DenomPairRoutes `protobuf:"bytes,1,rep,name=denom_pair_routes,json=denomPairRoutes,proto3" json:"denom_pair_routes" yaml:"denom_pair_routes"`
+}
+
+func (m *QueryAllDenomPairRoutesResponse) Reset()         { *m = QueryAllDenomPairRoutesResponse{} }
+func (m *QueryAllDenomPairRoutesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllDenomPairRoutesResponse) ProtoMessage()    {}
+func (*QueryAllDenomPairRoutesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4d9de31afe32e1e0, []int{3}
+}
+func (m *QueryAllDenomPairRoutesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllDenomPairRoutesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllDenomPairRoutesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllDenomPairRoutesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllDenomPairRoutesResponse.Merge(m, src)
+}
+func (m *QueryAllDenomPairRoutesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllDenomPairRoutesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllDenomPairRoutesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllDenomPairRoutesResponse proto.InternalMessageInfo
+
+func (m *QueryAllDenomPairRoutesResponse) GetDenomPairRoutes() []DenomPairRoutes {
+	if m != nil {
+		return m.DenomPairRoutes
+	}
+	return nil
+}
+
+// =============================== EstimateSwapExactAmountIn
+type QueryEstimateSwapExactAmountInRequest struct {
+	TokenIn       string `protobuf:"bytes,1,opt,name=token_in,json=tokenIn,proto3" json:"token_in,omitempty" yaml:"token_in"`
+	TokenOutDenom string `protobuf:"bytes,2,opt,name=token_out_denom,json=tokenOutDenom,proto3" json:"token_out_denom,omitempty" yaml:"token_out_denom"`
+}
+
+func (m *QueryEstimateSwapExactAmountInRequest) Reset()         { *m = QueryEstimateSwapExactAmountInRequest{} }
+func (m *QueryEstimateSwapExactAmountInRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateSwapExactAmountInRequest) ProtoMessage()    {}
+func (*QueryEstimateSwapExactAmountInRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4d9de31afe32e1e0, []int{4}
+}
+func (m *QueryEstimateSwapExactAmountInRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimateSwapExactAmountInRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateSwapExactAmountInRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEstimateSwapExactAmountInRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateSwapExactAmountInRequest.Merge(m, src)
+}
+func (m *QueryEstimateSwapExactAmountInRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimateSwapExactAmountInRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateSwapExactAmountInRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEstimateSwapExactAmountInRequest proto.InternalMessageInfo
+
+func (m *QueryEstimateSwapExactAmountInRequest) GetTokenIn() string {
+	if m != nil {
+		return m.TokenIn
+	}
+	return ""
+}
+
+func (m *QueryEstimateSwapExactAmountInRequest) GetTokenOutDenom() string {
+	if m != nil {
+		return m.TokenOutDenom
+	}
+	return ""
+}
+
+type QueryEstimateSwapExactAmountInResponse struct {
+	Route          Route                                  `protobuf:"bytes,1,opt,name=route,proto3" json:"route" yaml:"route"`
+	TokenOutAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=token_out_amount,json=tokenOutAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"token_out_amount" yaml:"token_out_amount"`
+}
+
+func (m *QueryEstimateSwapExactAmountInResponse) Reset() {
+	*m = QueryEstimateSwapExactAmountInResponse{}
+}
+func (m *QueryEstimateSwapExactAmountInResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateSwapExactAmountInResponse) ProtoMessage()    {}
+func (*QueryEstimateSwapExactAmountInResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4d9de31afe32e1e0, []int{5}
+}
+func (m *QueryEstimateSwapExactAmountInResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimateSwapExactAmountInResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateSwapExactAmountInResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEstimateSwapExactAmountInResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateSwapExactAmountInResponse.Merge(m, src)
+}
+func (m *QueryEstimateSwapExactAmountInResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimateSwapExactAmountInResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateSwapExactAmountInResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEstimateSwapExactAmountInResponse proto.InternalMessageInfo
+
+func (m *QueryEstimateSwapExactAmountInResponse) GetRoute() Route {
+	if m != nil {
+		return m.Route
+	}
+	return Route{}
+}
+
+// =============================== EstimateSwapExactAmountOut
+type QueryEstimateSwapExactAmountOutRequest struct {
+	TokenInDenom string `protobuf:"bytes,1,opt,name=token_in_denom,json=tokenInDenom,proto3" json:"token_in_denom,omitempty" yaml:"token_in_denom"`
+	TokenOut     string `protobuf:"bytes,2,opt,name=token_out,json=tokenOut,proto3" json:"token_out,omitempty" yaml:"token_out"`
+}
+
+func (m *QueryEstimateSwapExactAmountOutRequest) Reset() {
+	*m = QueryEstimateSwapExactAmountOutRequest{}
+}
+func (m *QueryEstimateSwapExactAmountOutRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateSwapExactAmountOutRequest) ProtoMessage()    {}
+func (*QueryEstimateSwapExactAmountOutRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4d9de31afe32e1e0, []int{6}
+}
+func (m *QueryEstimateSwapExactAmountOutRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimateSwapExactAmountOutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateSwapExactAmountOutRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEstimateSwapExactAmountOutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateSwapExactAmountOutRequest.Merge(m, src)
+}
+func (m *QueryEstimateSwapExactAmountOutRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimateSwapExactAmountOutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateSwapExactAmountOutRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEstimateSwapExactAmountOutRequest proto.InternalMessageInfo
+
+func (m *QueryEstimateSwapExactAmountOutRequest) GetTokenInDenom() string {
+	if m != nil {
+		return m.TokenInDenom
+	}
+	return ""
+}
+
+func (m *QueryEstimateSwapExactAmountOutRequest) GetTokenOut() string {
+	if m != nil {
+		return m.TokenOut
+	}
+	return ""
+}
+
+type QueryEstimateSwapExactAmountOutResponse struct {
+	Route         Route                                  `protobuf:"bytes,1,opt,name=route,proto3" json:"route" yaml:"route"`
+	TokenInAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=token_in_amount,json=tokenInAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"token_in_amount" yaml:"token_in_amount"`
+}
+
+func (m *QueryEstimateSwapExactAmountOutResponse) Reset() {
+	*m = QueryEstimateSwapExactAmountOutResponse{}
+}
+func (m *QueryEstimateSwapExactAmountOutResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateSwapExactAmountOutResponse) ProtoMessage()    {}
+func (*QueryEstimateSwapExactAmountOutResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4d9de31afe32e1e0, []int{7}
+}
+func (m *QueryEstimateSwapExactAmountOutResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimateSwapExactAmountOutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateSwapExactAmountOutResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEstimateSwapExactAmountOutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateSwapExactAmountOutResponse.Merge(m, src)
+}
+func (m *QueryEstimateSwapExactAmountOutResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimateSwapExactAmountOutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateSwapExactAmountOutResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEstimateSwapExactAmountOutResponse proto.InternalMessageInfo
+
+func (m *QueryEstimateSwapExactAmountOutResponse) GetRoute() Route {
+	if m != nil {
+		return m.Route
+	}
+	return Route{}
+}
+
+func init() {
+	proto.RegisterType((*QueryDenomPairRoutesRequest)(nil), "osmosis.swaprouter.v1beta1.QueryDenomPairRoutesRequest")
+	proto.RegisterType((*QueryDenomPairRoutesResponse)(nil), "osmosis.swaprouter.v1beta1.QueryDenomPairRoutesResponse")
+	proto.RegisterType((*QueryAllDenomPairRoutesRequest)(nil), "osmosis.swaprouter.v1beta1.QueryAllDenomPairRoutesRequest")
+	proto.RegisterType((*QueryAllDenomPairRoutesResponse)(nil), "osmosis.swaprouter.v1beta1.QueryAllDenomPairRoutesResponse")
+	proto.RegisterType((*QueryEstimateSwapExactAmountInRequest)(nil), "osmosis.swaprouter.v1beta1.QueryEstimateSwapExactAmountInRequest")
+	proto.RegisterType((*QueryEstimateSwapExactAmountInResponse)(nil), "osmosis.swaprouter.v1beta1.QueryEstimateSwapExactAmountInResponse")
+	proto.RegisterType((*QueryEstimateSwapExactAmountOutRequest)(nil), "osmosis.swaprouter.v1beta1.QueryEstimateSwapExactAmountOutRequest")
+	proto.RegisterType((*QueryEstimateSwapExactAmountOutResponse)(nil), "osmosis.swaprouter.v1beta1.QueryEstimateSwapExactAmountOutResponse")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/swaprouter/v1beta1/query.proto", fileDescriptor_4d9de31afe32e1e0)
+}
+
+var fileDescriptor_4d9de31afe32e1e0 = []byte{
+	// 732 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x96, 0x4f, 0x4f, 0x13, 0x4f,
+	0x18, 0xc7, 0x3b, 0xfc, 0x02, 0x3f, 0x18, 0x81, 0xe2, 0x88, 0x08, 0x2b, 0xe9, 0xe2, 0x44, 0xd1,
+	0x48, 0xd8, 0x4d, 0x31, 0xf1, 0x0f, 0x98, 0x98, 0xae, 0x12, 0xed, 0x81, 0x80, 0xeb, 0xcd, 0x4b,
+	0xb3, 0x85, 0x49, 0xdd, 0xd0, 0xce, 0x2c, 0x9d, 0x59, 0xa0, 0x21, 0x5e, 0x7c, 0x05, 0x26, 0x9e,
+	0x8c, 0x9c, 0x7c, 0x17, 0xbe, 0x03, 0x8e, 0x24, 0x5c, 0x8c, 0x87, 0x8d, 0x01, 0x3d, 0x68, 0xf4,
+	0xd2, 0x57, 0x60, 0x3a, 0x3b, 0xa5, 0x7f, 0xa0, 0xbb, 0xe1, 0x8f, 0x9e, 0xda, 0xce, 0x3c, 0xf3,
+	0x9d, 0xef, 0xe7, 0x79, 0x9e, 0x79, 0x52, 0x38, 0xc9, 0x78, 0x89, 0x71, 0x97, 0x9b, 0x7c, 0xc3,
+	0xf1, 0xca, 0xcc, 0x17, 0xa4, 0x6c, 0xae, 0xa7, 0xf3, 0x44, 0x38, 0x69, 0x73, 0xcd, 0x27, 0xe5,
+	0x8a, 0xe1, 0x95, 0x99, 0x60, 0x48, 0x53, 0x71, 0x46, 0x23, 0xce, 0x50, 0x71, 0xda, 0x70, 0x81,
+	0x15, 0x98, 0x0c, 0x33, 0x6b, 0xdf, 0xc2, 0x13, 0xda, 0x78, 0x81, 0xb1, 0x42, 0x91, 0x98, 0x8e,
+	0xe7, 0x9a, 0x0e, 0xa5, 0x4c, 0x38, 0xc2, 0x65, 0x94, 0xab, 0xdd, 0xa9, 0x88, 0x7b, 0x9b, 0xae,
+	0x90, 0xc1, 0xf8, 0x23, 0x80, 0x57, 0x9f, 0xd7, 0xcc, 0x3c, 0x21, 0x94, 0x95, 0x96, 0x1c, 0xb7,
+	0x6c, 0xd7, 0xb6, 0xb9, 0x4d, 0xd6, 0x7c, 0xc2, 0x05, 0x7a, 0x04, 0x07, 0x05, 0x5b, 0x25, 0x34,
+	0xe7, 0xd2, 0xdc, 0x4a, 0x2d, 0x64, 0x14, 0x4c, 0x80, 0x5b, 0x7d, 0xd6, 0x58, 0x35, 0xd0, 0x2f,
+	0x57, 0x9c, 0x52, 0x71, 0x16, 0xb7, 0xee, 0x63, 0xbb, 0x5f, 0x2e, 0x64, 0xa9, 0x54, 0x44, 0x16,
+	0x4c, 0x86, 0x01, 0xcc, 0x17, 0x4a, 0xa1, 0x4b, 0x2a, 0x68, 0xd5, 0x40, 0x1f, 0x69, 0x56, 0x38,
+	0x0c, 0xc0, 0xf6, 0x80, 0x5c, 0x59, 0xf4, 0x85, 0xd4, 0xc0, 0xef, 0x01, 0x1c, 0x3f, 0xde, 0x24,
+	0xf7, 0x18, 0xe5, 0x04, 0x55, 0xe0, 0x45, 0x79, 0x32, 0xe7, 0x39, 0x6e, 0x39, 0x27, 0x01, 0xb9,
+	0x34, 0x7a, 0x61, 0x66, 0xca, 0xe8, 0x9c, 0x5e, 0xa3, 0x4d, 0xcf, 0x9a, 0xd8, 0x09, 0xf4, 0x44,
+	0x35, 0xd0, 0x47, 0x43, 0x5f, 0x47, 0x34, 0xb1, 0x9d, 0x5c, 0x69, 0x3d, 0x82, 0x27, 0x60, 0x4a,
+	0x5a, 0xcb, 0x14, 0x8b, 0xc7, 0xa7, 0x10, 0x6f, 0x03, 0xa8, 0x77, 0x0c, 0x89, 0x06, 0xf8, 0xef,
+	0x1f, 0x00, 0x7c, 0x00, 0xf0, 0x86, 0xb4, 0x37, 0xcf, 0x85, 0x5b, 0x72, 0x04, 0x79, 0xb1, 0xe1,
+	0x78, 0xf3, 0x9b, 0xce, 0xb2, 0xc8, 0x94, 0x98, 0x4f, 0x45, 0x96, 0xd6, 0x7b, 0xc1, 0x80, 0xbd,
+	0xf5, 0x5a, 0xab, 0x2e, 0xb8, 0x54, 0x0d, 0xf4, 0x64, 0x6b, 0x17, 0x60, 0xfb, 0x7f, 0x55, 0xff,
+	0x73, 0x29, 0xfd, 0x2f, 0x00, 0x27, 0xe3, 0xdc, 0xa9, 0x1c, 0x2e, 0xc0, 0x6e, 0x09, 0xa9, 0x0a,
+	0x7f, 0x2d, 0x2a, 0x6f, 0x92, 0xdd, 0x1a, 0x56, 0xd9, 0xea, 0x0f, 0xbd, 0xc8, 0x18, 0x6c, 0x87,
+	0x2a, 0x88, 0xc3, 0xa1, 0x86, 0x39, 0x47, 0x5e, 0xa6, 0xec, 0x67, 0x6b, 0xc7, 0xbe, 0x04, 0xfa,
+	0x64, 0xc1, 0x15, 0xaf, 0xfc, 0xbc, 0xb1, 0xcc, 0x4a, 0xe6, 0xb2, 0xbc, 0x4c, 0x7d, 0x4c, 0xf3,
+	0x95, 0x55, 0x53, 0x54, 0x3c, 0xc2, 0x8d, 0x2c, 0x15, 0xd5, 0x40, 0xbf, 0xd2, 0x0e, 0x1b, 0xea,
+	0x61, 0x7b, 0xb0, 0x4e, 0x1b, 0xd2, 0xe0, 0xed, 0x18, 0xdc, 0x45, 0x5f, 0x9c, 0xdb, 0xcb, 0x4c,
+	0xc3, 0xbe, 0x43, 0x43, 0x8a, 0x6c, 0xb8, 0x1a, 0xe8, 0x43, 0x6d, 0x5e, 0xb1, 0xdd, 0x5b, 0x37,
+	0x89, 0x7f, 0x02, 0x78, 0x33, 0xd6, 0xde, 0xdf, 0x29, 0x87, 0x57, 0x6f, 0x26, 0x97, 0xb6, 0x56,
+	0xe3, 0xd9, 0x89, 0xab, 0x31, 0xd2, 0x96, 0x9d, 0x7a, 0x31, 0x06, 0x54, 0x7a, 0x42, 0x96, 0x99,
+	0xdf, 0x3d, 0xb0, 0x5b, 0xc2, 0xa2, 0x3d, 0x00, 0x93, 0x6d, 0x2f, 0x0d, 0xdd, 0x8b, 0xe2, 0x89,
+	0x98, 0xa8, 0xda, 0xfd, 0x93, 0x1f, 0x0c, 0x33, 0x8a, 0x17, 0xde, 0xec, 0x7d, 0x7b, 0xd7, 0xf5,
+	0x14, 0xcd, 0x9b, 0x11, 0x13, 0x5e, 0xfe, 0xe4, 0xe6, 0x56, 0x6b, 0xed, 0x5f, 0x9b, 0x5b, 0x8d,
+	0xe6, 0x0b, 0x57, 0xd0, 0x27, 0x00, 0xd1, 0xd1, 0x91, 0x84, 0x66, 0x63, 0xfd, 0x75, 0x1c, 0x75,
+	0xda, 0xdc, 0xa9, 0xce, 0x2a, 0xbc, 0xdb, 0x12, 0xef, 0x3a, 0xc2, 0xf1, 0x78, 0xe8, 0x3b, 0x80,
+	0x63, 0x1d, 0x27, 0x02, 0xca, 0xc4, 0xda, 0x88, 0x9b, 0x75, 0x9a, 0x75, 0x16, 0x09, 0x05, 0x94,
+	0x91, 0x40, 0x73, 0xe8, 0x41, 0x14, 0x10, 0x51, 0x32, 0x72, 0x2f, 0x47, 0x6a, 0x42, 0xaa, 0x21,
+	0x73, 0x2e, 0x45, 0x3f, 0x00, 0xd4, 0x3a, 0xbf, 0x35, 0x74, 0x6a, 0x97, 0x8d, 0x39, 0xa2, 0x3d,
+	0x3e, 0x93, 0x86, 0x42, 0xb5, 0x24, 0xea, 0x43, 0x34, 0x7b, 0x4a, 0x54, 0xe6, 0x0b, 0x6b, 0x69,
+	0x67, 0x3f, 0x05, 0x76, 0xf7, 0x53, 0xe0, 0xeb, 0x7e, 0x0a, 0xbc, 0x3d, 0x48, 0x25, 0x76, 0x0f,
+	0x52, 0x89, 0xcf, 0x07, 0xa9, 0xc4, 0xcb, 0xbb, 0x4d, 0x4f, 0x5b, 0xe9, 0x4f, 0x17, 0x9d, 0x3c,
+	0x3f, 0xbc, 0x6c, 0x3d, 0x3d, 0x63, 0x6e, 0x36, 0x5f, 0x29, 0x9f, 0x7b, 0xbe, 0x47, 0xfe, 0xc7,
+	0xb9, 0xf3, 0x67, 0x00, 0xc4, 0x06, 0xae, 0x1e, 0x8a, 0x09, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// DenomPairRoutes returns the preferred routes to swap token_in_denom for
+	// token_out_denom.
+	DenomPairRoutes(ctx context.Context, in *QueryDenomPairRoutesRequest, opts ...grpc.CallOption) (*QueryDenomPairRoutesResponse, error)
+	// AllDenomPairRoutes returns the preferred routes of all of the denom pairs.
+	AllDenomPairRoutes(ctx context.Context, in *QueryAllDenomPairRoutesRequest, opts ...grpc.CallOption) (*QueryAllDenomPairRoutesResponse, error)
+	// EstimateSwapExactAmountIn returns the preferred route with the best
+	// estimated amount out of swapping token_in for token_out_denom, along with
+	// that amount out.
+	EstimateSwapExactAmountIn(ctx context.Context, in *QueryEstimateSwapExactAmountInRequest, opts ...grpc.CallOption) (*QueryEstimateSwapExactAmountInResponse, error)
+	// EstimateSwapExactAmountOut returns the preferred route with the best
+	// estimated amount in of swapping token_in_denom for token_out, along with
+	// that amount in.
+	EstimateSwapExactAmountOut(ctx context.Context, in *QueryEstimateSwapExactAmountOutRequest, opts ...grpc.CallOption) (*QueryEstimateSwapExactAmountOutResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) DenomPairRoutes(ctx context.Context, in *QueryDenomPairRoutesRequest, opts ...grpc.CallOption) (*QueryDenomPairRoutesResponse, error) {
+	out := new(QueryDenomPairRoutesResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.swaprouter.v1beta1.Query/DenomPairRoutes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllDenomPairRoutes(ctx context.Context, in *QueryAllDenomPairRoutesRequest, opts ...grpc.CallOption) (*QueryAllDenomPairRoutesResponse, error) {
+	out := new(QueryAllDenomPairRoutesResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.swaprouter.v1beta1.Query/AllDenomPairRoutes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EstimateSwapExactAmountIn(ctx context.Context, in *QueryEstimateSwapExactAmountInRequest, opts ...grpc.CallOption) (*QueryEstimateSwapExactAmountInResponse, error) {
+	out := new(QueryEstimateSwapExactAmountInResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.swaprouter.v1beta1.Query/EstimateSwapExactAmountIn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EstimateSwapExactAmountOut(ctx context.Context, in *QueryEstimateSwapExactAmountOutRequest, opts ...grpc.CallOption) (*QueryEstimateSwapExactAmountOutResponse, error) {
+	out := new(QueryEstimateSwapExactAmountOutResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.swaprouter.v1beta1.Query/EstimateSwapExactAmountOut", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// DenomPairRoutes returns the preferred routes to swap token_in_denom for
+	// token_out_denom.
+	DenomPairRoutes(context.Context, *QueryDenomPairRoutesRequest) (*QueryDenomPairRoutesResponse, error)
+	// AllDenomPairRoutes returns the preferred routes of all of the denom pairs.
+	AllDenomPairRoutes(context.Context, *QueryAllDenomPairRoutesRequest) (*QueryAllDenomPairRoutesResponse, error)
+	// EstimateSwapExactAmountIn returns the preferred route with the best
+	// estimated amount out of swapping token_in for token_out_denom, along with
+	// that amount out.
+	EstimateSwapExactAmountIn(context.Context, *QueryEstimateSwapExactAmountInRequest) (*QueryEstimateSwapExactAmountInResponse, error)
+	// EstimateSwapExactAmountOut returns the preferred route with the best
+	// estimated amount in of swapping token_in_denom for token_out, along with
+	// that amount in.
+	EstimateSwapExactAmountOut(context.Context, *QueryEstimateSwapExactAmountOutRequest) (*QueryEstimateSwapExactAmountOutResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) DenomPairRoutes(ctx context.Context, req *QueryDenomPairRoutesRequest) (*QueryDenomPairRoutesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomPairRoutes not implemented")
+}
+func (*UnimplementedQueryServer) AllDenomPairRoutes(ctx context.Context, req *QueryAllDenomPairRoutesRequest) (*QueryAllDenomPairRoutesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllDenomPairRoutes not implemented")
+}
+func (*UnimplementedQueryServer) EstimateSwapExactAmountIn(ctx context.Context, req *QueryEstimateSwapExactAmountInRequest) (*QueryEstimateSwapExactAmountInResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateSwapExactAmountIn not implemented")
+}
+func (*UnimplementedQueryServer) EstimateSwapExactAmountOut(ctx context.Context, req *QueryEstimateSwapExactAmountOutRequest) (*QueryEstimateSwapExactAmountOutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateSwapExactAmountOut not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_DenomPairRoutes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomPairRoutesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomPairRoutes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.swaprouter.v1beta1.Query/DenomPairRoutes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomPairRoutes(ctx, req.(*QueryDenomPairRoutesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllDenomPairRoutes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllDenomPairRoutesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllDenomPairRoutes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.swaprouter.v1beta1.Query/AllDenomPairRoutes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllDenomPairRoutes(ctx, req.(*QueryAllDenomPairRoutesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EstimateSwapExactAmountIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEstimateSwapExactAmountInRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateSwapExactAmountIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.swaprouter.v1beta1.Query/EstimateSwapExactAmountIn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateSwapExactAmountIn(ctx, req.(*QueryEstimateSwapExactAmountInRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EstimateSwapExactAmountOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEstimateSwapExactAmountOutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateSwapExactAmountOut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.swaprouter.v1beta1.Query/EstimateSwapExactAmountOut",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateSwapExactAmountOut(ctx, req.(*QueryEstimateSwapExactAmountOutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "osmosis.swaprouter.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "DenomPairRoutes",
+			Handler:    _Query_DenomPairRoutes_Handler,
+		},
+		{
+			MethodName: "AllDenomPairRoutes",
+			Handler:    _Query_AllDenomPairRoutes_Handler,
+		},
+		{
+			MethodName: "EstimateSwapExactAmountIn",
+			Handler:    _Query_EstimateSwapExactAmountIn_Handler,
+		},
+		{
+			MethodName: "EstimateSwapExactAmountOut",
+			Handler:    _Query_EstimateSwapExactAmountOut_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "osmosis/swaprouter/v1beta1/query.proto",
+}
+
+func (m *QueryDenomPairRoutesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomPairRoutesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomPairRoutesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenOutDenom) > 0 {
+		i -= len(m.TokenOutDenom)
+		copy(dAtA[i:], m.TokenOutDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenOutDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TokenInDenom) > 0 {
+		i -= len(m.TokenInDenom)
+		copy(dAtA[i:], m.TokenInDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenInDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomPairRoutesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomPairRoutesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomPairRoutesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.DenomPairRoutes.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllDenomPairRoutesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllDenomPairRoutesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllDenomPairRoutesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllDenomPairRoutesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllDenomPairRoutesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllDenomPairRoutesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DenomPairRoutes) > 0 {
+		for iNdEx := len(m.DenomPairRoutes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomPairRoutes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimateSwapExactAmountInRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateSwapExactAmountInRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateSwapExactAmountInRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenOutDenom) > 0 {
+		i -= len(m.TokenOutDenom)
+		copy(dAtA[i:], m.TokenOutDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenOutDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TokenIn) > 0 {
+		i -= len(m.TokenIn)
+		copy(dAtA[i:], m.TokenIn)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenIn)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimateSwapExactAmountInResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateSwapExactAmountInResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateSwapExactAmountInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TokenOutAmount.Size()
+		i -= size
+		if _, err := m.TokenOutAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Route.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimateSwapExactAmountOutRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateSwapExactAmountOutRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateSwapExactAmountOutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenOut) > 0 {
+		i -= len(m.TokenOut)
+		copy(dAtA[i:], m.TokenOut)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenOut)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TokenInDenom) > 0 {
+		i -= len(m.TokenInDenom)
+		copy(dAtA[i:], m.TokenInDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenInDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimateSwapExactAmountOutResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateSwapExactAmountOutResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateSwapExactAmountOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TokenInAmount.Size()
+		i -= size
+		if _, err := m.TokenInAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Route.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryDenomPairRoutesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenInDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TokenOutDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomPairRoutesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DenomPairRoutes.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllDenomPairRoutesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAllDenomPairRoutesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DenomPairRoutes) > 0 {
+		for _, e := range m.DenomPairRoutes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryEstimateSwapExactAmountInRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenIn)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TokenOutDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEstimateSwapExactAmountInResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Route.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TokenOutAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEstimateSwapExactAmountOutRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenInDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TokenOut)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEstimateSwapExactAmountOutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Route.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TokenInAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryDenomPairRoutesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomPairRoutesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomPairRoutesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenInDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomPairRoutesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomPairRoutesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomPairRoutesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomPairRoutes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DenomPairRoutes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllDenomPairRoutesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllDenomPairRoutesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllDenomPairRoutesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllDenomPairRoutesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllDenomPairRoutesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllDenomPairRoutesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomPairRoutes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomPairRoutes = append(m.DenomPairRoutes, DenomPairRoutes{})
+			if err := m.DenomPairRoutes[len(m.DenomPairRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEstimateSwapExactAmountInRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEstimateSwapExactAmountInRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEstimateSwapExactAmountInRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenIn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEstimateSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEstimateSwapExactAmountInResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEstimateSwapExactAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Route.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEstimateSwapExactAmountOutRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEstimateSwapExactAmountOutRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEstimateSwapExactAmountOutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenInDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOut = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEstimateSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEstimateSwapExactAmountOutResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEstimateSwapExactAmountOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Route.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
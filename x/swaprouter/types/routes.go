@@ -0,0 +1,108 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	gammtypes "github.com/osmosis-labs/osmosis/v12/x/gamm/types"
+)
+
+// ValidateDenomPair checks that both denoms are valid and that they differ.
+func ValidateDenomPair(tokenInDenom, tokenOutDenom string) error {
+	if err := sdk.ValidateDenom(tokenInDenom); err != nil {
+		return sdkerrors.Wrap(ErrInvalidDenomPair, err.Error())
+	}
+	if err := sdk.ValidateDenom(tokenOutDenom); err != nil {
+		return sdkerrors.Wrap(ErrInvalidDenomPair, err.Error())
+	}
+	if tokenInDenom == tokenOutDenom {
+		return sdkerrors.Wrapf(ErrInvalidDenomPair, "token in and token out denoms are both %s", tokenInDenom)
+	}
+	return nil
+}
+
+// Validate checks that the denom pair is valid.
+func (p DenomPair) Validate() error {
+	return ValidateDenomPair(p.TokenInDenom, p.TokenOutDenom)
+}
+
+// Validate checks that the route goes through at least one pool and that it ends in tokenOutDenom,
+// without going through tokenInDenom.
+func (r Route) Validate(tokenInDenom, tokenOutDenom string) error {
+	if err := gammtypes.SwapAmountInRoutes(r.Pools).Validate(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidRoute, err.Error())
+	}
+
+	for i, pool := range r.Pools {
+		if pool.TokenOutDenom == tokenInDenom {
+			return sdkerrors.Wrapf(ErrInvalidRoute, "hop %d swaps back to token in denom %s", i, tokenInDenom)
+		}
+	}
+
+	if routeOutDenom := r.Pools[len(r.Pools)-1].TokenOutDenom; routeOutDenom != tokenOutDenom {
+		return sdkerrors.Wrapf(ErrInvalidRoute, "route ends in %s instead of %s", routeOutDenom, tokenOutDenom)
+	}
+	return nil
+}
+
+// PoolIds returns the ids of the pools of the route, in swap order.
+func (r Route) PoolIds() []uint64 {
+	poolIds := make([]uint64, 0, len(r.Pools))
+	for _, pool := range r.Pools {
+		poolIds = append(poolIds, pool.PoolId)
+	}
+	return poolIds
+}
+
+// SwapAmountOutRoutes returns the route in the format of the gamm swap exact amount out messages,
+// in which every hop references its token in denom rather than its token out denom.
+func (r Route) SwapAmountOutRoutes(tokenInDenom string) []gammtypes.SwapAmountOutRoute {
+	routes := make([]gammtypes.SwapAmountOutRoute, 0, len(r.Pools))
+	for _, pool := range r.Pools {
+		routes = append(routes, gammtypes.SwapAmountOutRoute{
+			PoolId:       pool.PoolId,
+			TokenInDenom: tokenInDenom,
+		})
+		tokenInDenom = pool.TokenOutDenom
+	}
+	return routes
+}
+
+// DenomPair returns the denom pair of the routes.
+func (r DenomPairRoutes) DenomPair() DenomPair {
+	return DenomPair{TokenInDenom: r.TokenInDenom, TokenOutDenom: r.TokenOutDenom}
+}
+
+// Validate checks that the denom pair is valid and that there is at least one route,
+// with every route going from the token in denom to the token out denom.
+func (r DenomPairRoutes) Validate() error {
+	if err := ValidateDenomPair(r.TokenInDenom, r.TokenOutDenom); err != nil {
+		return err
+	}
+
+	if len(r.Routes) == 0 {
+		return sdkerrors.Wrapf(ErrInvalidRoute, "no routes for %s to %s", r.TokenInDenom, r.TokenOutDenom)
+	}
+
+	for i, route := range r.Routes {
+		if err := route.Validate(r.TokenInDenom, r.TokenOutDenom); err != nil {
+			return sdkerrors.Wrapf(err, "route %d of %s to %s", i, r.TokenInDenom, r.TokenOutDenom)
+		}
+	}
+	return nil
+}
+
+// ValidateDenomPairRoutes checks that all of the routes are valid, with at most one entry per denom pair.
+func ValidateDenomPairRoutes(denomPairRoutes []DenomPairRoutes) error {
+	seenDenomPairs := map[DenomPair]bool{}
+	for _, routes := range denomPairRoutes {
+		if err := routes.Validate(); err != nil {
+			return err
+		}
+		if seenDenomPairs[routes.DenomPair()] {
+			return sdkerrors.Wrapf(ErrDuplicateDenomPair, "%s to %s", routes.TokenInDenom, routes.TokenOutDenom)
+		}
+		seenDenomPairs[routes.DenomPair()] = true
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	gammtypes "github.com/osmosis-labs/osmosis/v12/x/gamm/types"
+	"github.com/osmosis-labs/osmosis/v12/x/swaprouter/types"
+)
+
+func TestValidateDenomPairRoutes(t *testing.T) {
+	atomIonRoutes := func() types.DenomPairRoutes {
+		return types.DenomPairRoutes{
+			TokenInDenom:  "uatom",
+			TokenOutDenom: "uion",
+			Routes: []types.Route{
+				{Pools: []gammtypes.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "uion"}}},
+				{Pools: []gammtypes.SwapAmountInRoute{{PoolId: 2, TokenOutDenom: "uosmo"}, {PoolId: 3, TokenOutDenom: "uion"}}},
+			},
+		}
+	}
+
+	tests := map[string]struct {
+		routes      func() []types.DenomPairRoutes
+		expectedErr error
+	}{
+		"valid": {
+			routes: func() []types.DenomPairRoutes { return []types.DenomPairRoutes{atomIonRoutes()} },
+		},
+		"same token in and out denoms": {
+			routes: func() []types.DenomPairRoutes {
+				routes := atomIonRoutes()
+				routes.TokenOutDenom = "uatom"
+				return []types.DenomPairRoutes{routes}
+			},
+			expectedErr: types.ErrInvalidDenomPair,
+		},
+		"no routes": {
+			routes: func() []types.DenomPairRoutes {
+				routes := atomIonRoutes()
+				routes.Routes = nil
+				return []types.DenomPairRoutes{routes}
+			},
+			expectedErr: types.ErrInvalidRoute,
+		},
+		"route without pools": {
+			routes: func() []types.DenomPairRoutes {
+				routes := atomIonRoutes()
+				routes.Routes = append(routes.Routes, types.Route{})
+				return []types.DenomPairRoutes{routes}
+			},
+			expectedErr: types.ErrInvalidRoute,
+		},
+		"route ending in another denom": {
+			routes: func() []types.DenomPairRoutes {
+				routes := atomIonRoutes()
+				routes.Routes[1].Pools = routes.Routes[1].Pools[:1]
+				return []types.DenomPairRoutes{routes}
+			},
+			expectedErr: types.ErrInvalidRoute,
+		},
+		"route swapping back to the token in denom": {
+			routes: func() []types.DenomPairRoutes {
+				routes := atomIonRoutes()
+				routes.Routes[1].Pools[0].TokenOutDenom = "uatom"
+				return []types.DenomPairRoutes{routes}
+			},
+			expectedErr: types.ErrInvalidRoute,
+		},
+		"duplicate denom pair": {
+			routes:      func() []types.DenomPairRoutes { return []types.DenomPairRoutes{atomIonRoutes(), atomIonRoutes()} },
+			expectedErr: types.ErrDuplicateDenomPair,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := types.ValidateDenomPairRoutes(tc.routes())
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSwapAmountOutRoutes(t *testing.T) {
+	route := types.Route{Pools: []gammtypes.SwapAmountInRoute{
+		{PoolId: 2, TokenOutDenom: "uosmo"},
+		{PoolId: 3, TokenOutDenom: "uion"},
+	}}
+
+	require.Equal(t, []gammtypes.SwapAmountOutRoute{
+		{PoolId: 2, TokenInDenom: "uatom"},
+		{PoolId: 3, TokenInDenom: "uosmo"},
+	}, route.SwapAmountOutRoutes("uatom"))
+	require.Equal(t, []uint64{2, 3}, route.PoolIds())
+}
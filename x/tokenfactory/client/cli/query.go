@@ -29,8 +29,12 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		GetParams(),
+		GetCmdDenomCreationFee(),
 		GetCmdDenomAuthorityMetadata(),
 		GetCmdDenomsFromCreator(),
+		GetCmdBeforeSendHookAddress(),
+		GetCmdFrozenAccounts(),
+		GetCmdIsFrozen(),
 	)
 
 	return cmd
@@ -63,6 +67,33 @@ func GetParams() *cobra.Command {
 	return cmd
 }
 
+// GetCmdDenomCreationFee returns the denom creation fee in each accepted fee denom
+func GetCmdDenomCreationFee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-creation-fee [flags]",
+		Short: "Get the denom creation fee when paid in each accepted fee denom",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DenomCreationFee(cmd.Context(), &types.QueryDenomCreationFeeRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdDenomAuthorityMetadata returns the authority metadata for a queried denom
 func GetCmdDenomAuthorityMetadata() *cobra.Command {
 	cmd := &cobra.Command{
@@ -120,3 +151,91 @@ func GetCmdDenomsFromCreator() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdBeforeSendHookAddress returns the before send hook contract address of a denom
+func GetCmdBeforeSendHookAddress() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "before-send-hook [denom] [flags]",
+		Short: "Get the before send hook contract address for a specific denom",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BeforeSendHookAddress(cmd.Context(), &types.QueryBeforeSendHookAddressRequest{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdFrozenAccounts returns the accounts frozen for a denom
+func GetCmdFrozenAccounts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "frozen-accounts [denom] [flags]",
+		Short: "Get the accounts frozen for a specific denom",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FrozenAccounts(cmd.Context(), &types.QueryFrozenAccountsRequest{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdIsFrozen returns whether an account is frozen for a denom
+func GetCmdIsFrozen() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "is-frozen [denom] [address] [flags]",
+		Short: "Get whether an account is frozen for a specific denom",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.IsFrozen(cmd.Context(), &types.QueryIsFrozenRequest{
+				Denom:   args[0],
+				Address: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -14,6 +14,13 @@ import (
 	"github.com/osmosis-labs/osmosis/v12/x/tokenfactory/types"
 )
 
+const (
+	// FlagCapabilities is the flag for the capabilities enabled when creating a denom.
+	FlagCapabilities = "capabilities"
+	// FlagFeeDenom is the flag for the fee token the denom creation fee is paid in.
+	FlagFeeDenom = "fee-denom"
+)
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -28,8 +35,11 @@ func GetTxCmd() *cobra.Command {
 		NewCreateDenomCmd(),
 		NewMintCmd(),
 		NewBurnCmd(),
-		// NewForceTransferCmd(),
+		NewForceTransferCmd(),
 		NewChangeAdminCmd(),
+		NewSetBeforeSendHookCmd(),
+		NewFreezeCmd(),
+		NewUnfreezeCmd(),
 	)
 
 	return cmd
@@ -39,7 +49,7 @@ func GetTxCmd() *cobra.Command {
 func NewCreateDenomCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "create-denom [subdenom] [flags]",
-		Short: "create a new denom from an account",
+		Short: "create a new denom from an account, optionally enabling the force_transfer and freeze capabilities of the admin with --capabilities",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -49,15 +59,29 @@ func NewCreateDenomCmd() *cobra.Command {
 
 			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
 
+			capabilities, err := cmd.Flags().GetStringSlice(FlagCapabilities)
+			if err != nil {
+				return err
+			}
+
+			feeDenom, err := cmd.Flags().GetString(FlagFeeDenom)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgCreateDenom(
 				clientCtx.GetFromAddress().String(),
 				args[0],
+				capabilities...,
 			)
+			msg.FeeDenom = feeDenom
 
 			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
 		},
 	}
 
+	cmd.Flags().StringSlice(FlagCapabilities, nil, "Comma separated capabilities of the admin over the denom, which can be force_transfer and freeze")
+	cmd.Flags().String(FlagFeeDenom, "", "Fee token to pay the denom creation fee in, see the denom-creation-fee query")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -126,39 +150,39 @@ func NewBurnCmd() *cobra.Command {
 	return cmd
 }
 
-// // NewForceTransferCmd broadcast MsgForceTransfer
-// func NewForceTransferCmd() *cobra.Command {
-// 	cmd := &cobra.Command{
-// 		Use:   "force-transfer [amount] [transfer-from-address] [transfer-to-address] [flags]",
-// 		Short: "Force transfer tokens from one address to another address. Must have admin authority to do so.",
-// 		Args:  cobra.ExactArgs(3),
-// 		RunE: func(cmd *cobra.Command, args []string) error {
-// 			clientCtx, err := client.GetClientTxContext(cmd)
-// 			if err != nil {
-// 				return err
-// 			}
-
-// 			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
-
-// 			amount, err := sdk.ParseCoinNormalized(args[0])
-// 			if err != nil {
-// 				return err
-// 			}
-
-// 			msg := types.NewMsgForceTransfer(
-// 				clientCtx.GetFromAddress().String(),
-// 				amount,
-// 				args[1],
-// 				args[2],
-// 			)
-
-// 			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
-// 		},
-// 	}
-
-// 	flags.AddTxFlagsToCmd(cmd)
-// 	return cmd
-// }
+// NewForceTransferCmd broadcast MsgForceTransfer
+func NewForceTransferCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "force-transfer [amount] [transfer-from-address] [transfer-to-address] [flags]",
+		Short: "Force transfer tokens from one address to another address. Must have admin authority and the force_transfer capability to do so.",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgForceTransfer(
+				clientCtx.GetFromAddress().String(),
+				amount,
+				args[1],
+				args[2],
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
 
 // NewChangeAdminCmd broadcast MsgChangeAdmin
 func NewChangeAdminCmd() *cobra.Command {
@@ -187,3 +211,89 @@ func NewChangeAdminCmd() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// NewSetBeforeSendHookCmd broadcast MsgSetBeforeSendHook
+func NewSetBeforeSendHookCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-before-send-hook [denom] [cosmwasm-address] [flags]",
+		Short: "Sets the cosmwasm contract sudo called before every send of a factory-created denom, or removes it when the address is empty. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			msg := types.NewMsgSetBeforeSendHook(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewFreezeCmd broadcast MsgFreeze freezing an account
+func NewFreezeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "freeze [denom] [address] [flags]",
+		Short: "Freezes an account, so that it can neither send nor receive a factory-created denom. Must have admin authority and the freeze capability to do so.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			msg := types.NewMsgFreeze(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+				true,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewUnfreezeCmd broadcast MsgFreeze unfreezing an account
+func NewUnfreezeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unfreeze [denom] [address] [flags]",
+		Short: "Unfreezes an account for a factory-created denom. Must have admin authority and the freeze capability to do so.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			msg := types.NewMsgFreeze(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+				false,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -2,6 +2,8 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/osmosis-labs/osmosis/v12/x/tokenfactory/types"
 )
@@ -51,7 +53,9 @@ func (k Keeper) burnFrom(ctx sdk.Context, amount sdk.Coin, burnFrom string) erro
 	return k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(amount))
 }
 
-// nolint: unused
+// forceTransfer transfers the amount from one account to another. It explicitly bypasses the before
+// send hook and frozen checks of the denom, and so transfers from and to frozen accounts. Module
+// accounts are excluded, as moving their funds would break the accounting of their module.
 func (k Keeper) forceTransfer(ctx sdk.Context, amount sdk.Coin, fromAddr string, toAddr string) error {
 	// verify that denom is an x/tokenfactory denom
 	_, _, err := types.DeconstructDenom(amount.Denom)
@@ -69,5 +73,11 @@ func (k Keeper) forceTransfer(ctx sdk.Context, amount sdk.Coin, fromAddr string,
 		return err
 	}
 
-	return k.bankKeeper.SendCoins(ctx, fromSdkAddr, toSdkAddr, sdk.NewCoins(amount))
+	for _, addr := range []sdk.AccAddress{fromSdkAddr, toSdkAddr} {
+		if _, isModuleAccount := k.accountKeeper.GetAccount(ctx, addr).(authtypes.ModuleAccountI); isModuleAccount {
+			return sdkerrors.Wrapf(types.ErrModuleAccountTransfer, "%s is a module account", addr)
+		}
+	}
+
+	return k.bankKeeper.SendCoins(withoutBeforeSendHooks(ctx), fromSdkAddr, toSdkAddr, sdk.NewCoins(amount))
 }
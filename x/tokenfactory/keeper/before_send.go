@@ -0,0 +1,164 @@
+package keeper
+
+import (
+	"encoding/json"
+	"strings"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/osmosis-labs/osmosis/v12/x/tokenfactory/types"
+)
+
+// SetContractKeeper sets the contract keeper used to sudo call the before send hooks of denoms.
+// It is set after the keeper is created, as the wasm keeper depends on the tokenfactory keeper.
+func (k *Keeper) SetContractKeeper(contractKeeper types.ContractKeeper) {
+	k.contractKeeper = contractKeeper
+}
+
+// setBeforeSendHook sets the CosmWasm contract that is sudo called before every send of the denom.
+// An empty address removes the before send hook of the denom.
+func (k Keeper) setBeforeSendHook(ctx sdk.Context, denom string, cosmwasmAddress string) error {
+	if cosmwasmAddress != "" {
+		contractAddr, err := sdk.AccAddressFromBech32(cosmwasmAddress)
+		if err != nil {
+			return err
+		}
+		if k.contractKeeper == nil || !k.contractKeeper.HasContractInfo(ctx, contractAddr) {
+			return sdkerrors.Wrapf(types.ErrInvalidBeforeSendHook, "%s is not a cosmwasm contract", cosmwasmAddress)
+		}
+	}
+
+	k.storeBeforeSendHook(ctx, denom, cosmwasmAddress)
+	return nil
+}
+
+func (k Keeper) storeBeforeSendHook(ctx sdk.Context, denom string, cosmwasmAddress string) {
+	store := k.GetDenomPrefixStore(ctx, denom)
+	if cosmwasmAddress == "" {
+		store.Delete([]byte(types.BeforeSendHookAddressKey))
+		return
+	}
+	store.Set([]byte(types.BeforeSendHookAddressKey), []byte(cosmwasmAddress))
+}
+
+// GetBeforeSendHook returns the address of the CosmWasm contract registered as the before send hook
+// of the denom, which is empty if the denom has no before send hook.
+func (k Keeper) GetBeforeSendHook(ctx sdk.Context, denom string) string {
+	bz := k.GetDenomPrefixStore(ctx, denom).Get([]byte(types.BeforeSendHookAddressKey))
+	return string(bz)
+}
+
+// skipBeforeSendHooksKey is the context key marking sends that bypass the before send hooks and
+// frozen checks of tokenfactory denoms.
+type skipBeforeSendHooksKey struct{}
+
+// withoutBeforeSendHooks returns a context whose sends bypass the before send hooks and frozen checks
+// of tokenfactory denoms. Other bank hooks are still called.
+func withoutBeforeSendHooks(ctx sdk.Context) sdk.Context {
+	return ctx.WithValue(skipBeforeSendHooksKey{}, true)
+}
+
+// Hooks wraps the keeper to implement the bank hooks calling the before send hooks of denoms.
+type Hooks struct {
+	k Keeper
+}
+
+var _ banktypes.BankHooks = Hooks{}
+
+// Hooks returns the bank hooks of the tokenfactory module.
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// BeforeSend rejects sends of tokenfactory denoms from or to accounts frozen for the denom, and sudo
+// calls the before send hook contract of every tokenfactory denom being sent, rejecting the send if
+// any of them returns an error.
+func (h Hooks) BeforeSend(ctx sdk.Context, from, to sdk.AccAddress, amount sdk.Coins) error {
+	return h.k.callBeforeSendListener(ctx, from, to, amount)
+}
+
+func (k Keeper) callBeforeSendListener(ctx sdk.Context, from, to sdk.AccAddress, amount sdk.Coins) error {
+	// force transfers bypass the before send hooks and frozen checks
+	if skip, _ := ctx.Value(skipBeforeSendHooksKey{}).(bool); skip {
+		return nil
+	}
+
+	// Sends from the tokenfactory module account mint the denom, which must not be blocked by its
+	// before send hook. Sends from other module accounts, such as pools, are subject to the hook.
+	fromTokenFactory := from.Equals(k.accountKeeper.GetModuleAddress(types.ModuleName))
+
+	for _, coin := range amount {
+		if !strings.HasPrefix(coin.Denom, types.ModuleDenomPrefix+"/") {
+			continue
+		}
+
+		frozen := k.IsAccountFrozen(ctx, coin.Denom, from) || k.IsAccountFrozen(ctx, coin.Denom, to)
+		cosmwasmAddress := k.GetBeforeSendHook(ctx, coin.Denom)
+		if !frozen && cosmwasmAddress == "" {
+			continue
+		}
+
+		if fromTokenFactory {
+			return nil
+		}
+
+		if frozen {
+			return sdkerrors.Wrapf(types.ErrAccountFrozen, "denom %s: sending from %s to %s", coin.Denom, from, to)
+		}
+
+		if err := k.sudoBeforeSendHook(ctx, cosmwasmAddress, from, to, coin); err != nil {
+			return sdkerrors.Wrapf(types.ErrBeforeSendHookFailed, "denom %s: %s", coin.Denom, err)
+		}
+	}
+
+	return nil
+}
+
+// sudoBeforeSendHook sudo calls the before send hook contract with a gas meter capped at the
+// before send hook gas limit, charging the gas consumed to the context. The state changes of the
+// contract are only written if it does not return an error.
+func (k Keeper) sudoBeforeSendHook(ctx sdk.Context, cosmwasmAddress string, from, to sdk.AccAddress, coin sdk.Coin) (err error) {
+	if k.contractKeeper == nil {
+		return sdkerrors.Wrap(types.ErrInvalidBeforeSendHook, "contract keeper is not set")
+	}
+
+	contractAddr, err := sdk.AccAddressFromBech32(cosmwasmAddress)
+	if err != nil {
+		return err
+	}
+
+	msg, err := json.Marshal(types.BlockBeforeSendMsg{
+		BlockBeforeSend: types.BlockBeforeSendSudoMsg{
+			From:   from.String(),
+			To:     to.String(),
+			Amount: coin,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	childCtx := cacheCtx.WithGasMeter(sdk.NewGasMeter(types.BeforeSendHookGasLimit))
+	defer func() {
+		ctx.GasMeter().ConsumeGas(childCtx.GasMeter().GasConsumedToLimit(), "before send hook")
+
+		if r := recover(); r != nil {
+			outOfGas, ok := r.(storetypes.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+			err = sdkerrors.Wrapf(sdkerrors.ErrOutOfGas, "before send hook exceeded gas limit of %d: %s", types.BeforeSendHookGasLimit, outOfGas.Descriptor)
+		}
+	}()
+
+	if _, err = k.contractKeeper.Sudo(childCtx, contractAddr, msg); err != nil {
+		return err
+	}
+
+	write()
+	return nil
+}
@@ -0,0 +1,279 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v12/x/tokenfactory/keeper"
+	"github.com/osmosis-labs/osmosis/v12/x/tokenfactory/types"
+)
+
+// mockContractKeeper records the sudo calls made to a single contract, and rejects them or
+// consumes gas as configured.
+type mockContractKeeper struct {
+	contract sdk.AccAddress
+	sudoMsgs [][]byte
+	sudoErr  error
+	gasToUse sdk.Gas
+	storeKey sdk.StoreKey
+	stateKey []byte
+}
+
+func (m *mockContractKeeper) HasContractInfo(_ sdk.Context, contractAddress sdk.AccAddress) bool {
+	return contractAddress.Equals(m.contract)
+}
+
+func (m *mockContractKeeper) Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+	m.sudoMsgs = append(m.sudoMsgs, msg)
+	// write to a store, so that tests can check whether the state changes of the call are kept
+	ctx.KVStore(m.storeKey).Set(m.stateKey, msg)
+	ctx.GasMeter().ConsumeGas(m.gasToUse, "mock sudo")
+	return nil, m.sudoErr
+}
+
+func (suite *KeeperTestSuite) setupMockContractKeeper() *mockContractKeeper {
+	mock := &mockContractKeeper{
+		contract: suite.TestAccs[2],
+		storeKey: suite.App.GetKey(types.StoreKey),
+		stateKey: []byte("mock-sudo"),
+	}
+	suite.App.TokenFactoryKeeper.SetContractKeeper(mock)
+	suite.msgServer = keeper.NewMsgServerImpl(*suite.App.TokenFactoryKeeper)
+	return mock
+}
+
+func (suite *KeeperTestSuite) TestSetBeforeSendHook() {
+	for _, tc := range []struct {
+		desc        string
+		senderIndex int
+		// hookIndex is the index of the test account set as the hook, with -1 removing the hook
+		hookIndex int
+		valid     bool
+	}{
+		{
+			desc:        "not the denom admin",
+			senderIndex: 1,
+			hookIndex:   2,
+			valid:       false,
+		},
+		{
+			desc:        "not a contract",
+			senderIndex: 0,
+			hookIndex:   1,
+			valid:       false,
+		},
+		{
+			desc:        "success case",
+			senderIndex: 0,
+			hookIndex:   2,
+			valid:       true,
+		},
+		{
+			desc:        "empty address removes the hook",
+			senderIndex: 0,
+			hookIndex:   -1,
+			valid:       true,
+		},
+	} {
+		suite.Run(tc.desc, func() {
+			suite.SetupTest()
+			mock := suite.setupMockContractKeeper()
+			suite.CreateDefaultDenom()
+
+			// start every case with a hook to see whether it gets replaced
+			_, err := suite.msgServer.SetBeforeSendHook(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetBeforeSendHook(suite.TestAccs[0].String(), suite.defaultDenom, mock.contract.String()))
+			suite.Require().NoError(err)
+
+			cosmwasmAddress := ""
+			if tc.hookIndex >= 0 {
+				cosmwasmAddress = suite.TestAccs[tc.hookIndex].String()
+			}
+			ctx := suite.Ctx.WithEventManager(sdk.NewEventManager())
+			_, err = suite.msgServer.SetBeforeSendHook(sdk.WrapSDKContext(ctx), types.NewMsgSetBeforeSendHook(suite.TestAccs[tc.senderIndex].String(), suite.defaultDenom, cosmwasmAddress))
+
+			res, queryErr := suite.queryClient.BeforeSendHookAddress(ctx.Context(), &types.QueryBeforeSendHookAddressRequest{Denom: suite.defaultDenom})
+			suite.Require().NoError(queryErr)
+			if tc.valid {
+				suite.Require().NoError(err)
+				suite.Require().Equal(cosmwasmAddress, res.CosmwasmAddress)
+				suite.AssertEventEmitted(ctx, types.TypeMsgSetBeforeSendHook, 1)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Equal(mock.contract.String(), res.CosmwasmAddress)
+				suite.AssertEventEmitted(ctx, types.TypeMsgSetBeforeSendHook, 0)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestBeforeSendHook() {
+	for _, tc := range []struct {
+		desc           string
+		sudoErr        error
+		gasToUse       sdk.Gas
+		fromModule     bool
+		noHook         bool
+		expectSudo     bool
+		expectErr      string
+		expectedGasUse sdk.Gas
+	}{
+		{
+			desc:           "contract allows the send",
+			gasToUse:       1000,
+			expectSudo:     true,
+			expectedGasUse: 1000,
+		},
+		{
+			desc:           "contract rejects the send",
+			sudoErr:        errors.New("frozen"),
+			gasToUse:       1000,
+			expectSudo:     true,
+			expectErr:      "frozen",
+			expectedGasUse: 1000,
+		},
+		{
+			desc:           "contract exceeds the gas limit",
+			gasToUse:       types.BeforeSendHookGasLimit + 1,
+			expectSudo:     true,
+			expectErr:      "exceeded gas limit",
+			expectedGasUse: types.BeforeSendHookGasLimit,
+		},
+		{
+			desc:       "sends from the tokenfactory module account skip the hook",
+			sudoErr:    errors.New("frozen"),
+			fromModule: true,
+		},
+		{
+			desc:    "denom without a hook",
+			sudoErr: errors.New("frozen"),
+			noHook:  true,
+		},
+	} {
+		suite.Run(tc.desc, func() {
+			suite.SetupTest()
+			mock := suite.setupMockContractKeeper()
+			mock.sudoErr = tc.sudoErr
+			mock.gasToUse = tc.gasToUse
+			suite.CreateDefaultDenom()
+
+			if !tc.noHook {
+				_, err := suite.msgServer.SetBeforeSendHook(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetBeforeSendHook(suite.TestAccs[0].String(), suite.defaultDenom, mock.contract.String()))
+				suite.Require().NoError(err)
+			}
+
+			from := suite.TestAccs[0]
+			if tc.fromModule {
+				from = suite.App.AccountKeeper.GetModuleAddress(types.ModuleName)
+			}
+			to := suite.TestAccs[1]
+			amount := sdk.NewCoins(sdk.NewInt64Coin(suite.defaultDenom, 10), sdk.NewInt64Coin("uosmo", 10))
+
+			ctx := suite.Ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+			err := suite.App.TokenFactoryKeeper.Hooks().BeforeSend(ctx, from, to, amount)
+
+			if tc.expectErr != "" {
+				suite.Require().ErrorIs(err, types.ErrBeforeSendHookFailed)
+				suite.Require().ErrorContains(err, tc.expectErr)
+			} else {
+				suite.Require().NoError(err)
+			}
+
+			if !tc.expectSudo {
+				suite.Require().Len(mock.sudoMsgs, 0)
+				return
+			}
+
+			// only the tokenfactory denom is sent to the contract
+			suite.Require().Len(mock.sudoMsgs, 1)
+			var sudoMsg types.BlockBeforeSendMsg
+			suite.Require().NoError(json.Unmarshal(mock.sudoMsgs[0], &sudoMsg))
+			suite.Require().Equal(types.BlockBeforeSendSudoMsg{
+				From:   from.String(),
+				To:     to.String(),
+				Amount: sdk.NewInt64Coin(suite.defaultDenom, 10),
+			}, sudoMsg.BlockBeforeSend)
+
+			// the gas used by the contract is charged, and its state changes are only kept on success
+			suite.Require().GreaterOrEqual(ctx.GasMeter().GasConsumed(), tc.expectedGasUse)
+			stateKept := ctx.KVStore(mock.storeKey).Has(mock.stateKey)
+			suite.Require().Equal(tc.expectErr == "", stateKept)
+		})
+	}
+}
+
+// instantiateRejectingContract instantiates a contract rejecting every before send hook call, as hackatom
+// does not handle the block before send sudo message.
+func (suite *KeeperTestSuite) instantiateRejectingContract() sdk.AccAddress {
+	wasmCode, err := os.ReadFile("../../../wasmbinding/testdata/hackatom.wasm")
+	suite.Require().NoError(err)
+	suite.App.WasmKeeper.SetParams(suite.Ctx, wasmtypes.DefaultParams())
+	contractKeeper := wasmkeeper.NewDefaultPermissionKeeper(suite.App.WasmKeeper)
+	codeID, err := contractKeeper.Create(suite.Ctx, suite.TestAccs[0], wasmCode, nil)
+	suite.Require().NoError(err)
+	initMsg, err := json.Marshal(map[string]string{
+		"verifier":    suite.TestAccs[0].String(),
+		"beneficiary": suite.TestAccs[0].String(),
+	})
+	suite.Require().NoError(err)
+	contractAddr, _, err := contractKeeper.Instantiate(suite.Ctx, codeID, suite.TestAccs[0], suite.TestAccs[0], initMsg, "hook", nil)
+	suite.Require().NoError(err)
+	return contractAddr
+}
+
+// TestBeforeSendHookPoolSwap tests that swapping a denom out of a pool calls the before send hook
+// of the denom, as the pool is not exempt from it like the tokenfactory module account.
+func (suite *KeeperTestSuite) TestBeforeSendHookPoolSwap() {
+	suite.SetupTest()
+	suite.CreateDefaultDenom()
+	contractAddr := suite.instantiateRejectingContract()
+
+	poolId := suite.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(suite.defaultDenom, 1000000), sdk.NewInt64Coin("uosmo", 1000000))
+	_, err := suite.msgServer.SetBeforeSendHook(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetBeforeSendHook(suite.TestAccs[0].String(), suite.defaultDenom, contractAddr.String()))
+	suite.Require().NoError(err)
+
+	_, err = suite.App.GAMMKeeper.SwapExactAmountIn(suite.Ctx, suite.TestAccs[1], poolId, sdk.NewInt64Coin("uosmo", 1000), suite.defaultDenom, sdk.OneInt())
+	suite.Require().ErrorIs(err, types.ErrBeforeSendHookFailed)
+	suite.Require().True(suite.App.BankKeeper.GetBalance(suite.Ctx, suite.TestAccs[1], suite.defaultDenom).IsZero())
+
+	// the swap succeeds once the hook is removed
+	_, err = suite.msgServer.SetBeforeSendHook(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetBeforeSendHook(suite.TestAccs[0].String(), suite.defaultDenom, ""))
+	suite.Require().NoError(err)
+	_, err = suite.App.GAMMKeeper.SwapExactAmountIn(suite.Ctx, suite.TestAccs[1], poolId, sdk.NewInt64Coin("uosmo", 1000), suite.defaultDenom, sdk.OneInt())
+	suite.Require().NoError(err)
+	suite.Require().True(suite.App.BankKeeper.GetBalance(suite.Ctx, suite.TestAccs[1], suite.defaultDenom).IsPositive())
+}
+
+// TestBeforeSendHookWasm tests that a before send hook contract erroring on the sudo message
+// blocks bank sends of the denom, until the hook is removed.
+func (suite *KeeperTestSuite) TestBeforeSendHookWasm() {
+	suite.SetupTest()
+	suite.CreateDefaultDenom()
+
+	contractAddr := suite.instantiateRejectingContract()
+
+	_, err := suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMint(suite.TestAccs[0].String(), sdk.NewInt64Coin(suite.defaultDenom, 100)))
+	suite.Require().NoError(err)
+
+	_, err = suite.msgServer.SetBeforeSendHook(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetBeforeSendHook(suite.TestAccs[0].String(), suite.defaultDenom, contractAddr.String()))
+	suite.Require().NoError(err)
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin(suite.defaultDenom, 10))
+	err = suite.App.BankKeeper.SendCoins(suite.Ctx, suite.TestAccs[0], suite.TestAccs[1], coins)
+	suite.Require().ErrorIs(err, types.ErrBeforeSendHookFailed)
+
+	// minting more of the denom is not blocked, as it is sent from the module account
+	_, err = suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMint(suite.TestAccs[0].String(), sdk.NewInt64Coin(suite.defaultDenom, 100)))
+	suite.Require().NoError(err)
+
+	_, err = suite.msgServer.SetBeforeSendHook(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetBeforeSendHook(suite.TestAccs[0].String(), suite.defaultDenom, ""))
+	suite.Require().NoError(err)
+
+	err = suite.App.BankKeeper.SendCoins(suite.Ctx, suite.TestAccs[0], suite.TestAccs[1], coins)
+	suite.Require().NoError(err)
+	suite.Require().Equal(coins[0], suite.App.BankKeeper.GetBalance(suite.Ctx, suite.TestAccs[1], suite.defaultDenom))
+}
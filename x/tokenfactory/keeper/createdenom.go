@@ -4,30 +4,36 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/osmosis-labs/osmosis/v12/x/tokenfactory/types"
 )
 
-// ConvertToBaseToken converts a fee amount in a whitelisted fee token to the base fee token amount
-func (k Keeper) CreateDenom(ctx sdk.Context, creatorAddr string, subdenom string) (newTokenDenom string, err error) {
+// CreateDenom creates a new denom with the creator as its admin, enabling the given capabilities of the
+// admin over the denom. The denom creation fee is paid in feeDenom, or as configured if it is empty.
+func (k Keeper) CreateDenom(ctx sdk.Context, creatorAddr string, subdenom string, capabilities []string, feeDenom string) (newTokenDenom string, err error) {
+	if err := types.ValidateCapabilities(capabilities); err != nil {
+		return "", err
+	}
+
 	denom, err := k.validateCreateDenom(ctx, creatorAddr, subdenom)
 	if err != nil {
 		return "", err
 	}
 
-	err = k.chargeForCreateDenom(ctx, creatorAddr, subdenom)
+	err = k.chargeForCreateDenom(ctx, creatorAddr, feeDenom)
 	if err != nil {
 		return "", err
 	}
 
-	err = k.createDenomAfterValidation(ctx, creatorAddr, denom)
+	err = k.createDenomAfterValidation(ctx, creatorAddr, denom, capabilities)
 	return denom, err
 }
 
 // Runs CreateDenom logic after the charge and all denom validation has been handled.
 // Made into a second function for genesis initialization.
-func (k Keeper) createDenomAfterValidation(ctx sdk.Context, creatorAddr string, denom string) (err error) {
+func (k Keeper) createDenomAfterValidation(ctx sdk.Context, creatorAddr string, denom string, capabilities []string) (err error) {
 	denomMetaData := banktypes.Metadata{
 		DenomUnits: []*banktypes.DenomUnit{{
 			Denom:    denom,
@@ -39,7 +45,8 @@ func (k Keeper) createDenomAfterValidation(ctx sdk.Context, creatorAddr string,
 	k.bankKeeper.SetDenomMetaData(ctx, denomMetaData)
 
 	authorityMetadata := types.DenomAuthorityMetadata{
-		Admin: creatorAddr,
+		Admin:        creatorAddr,
+		Capabilities: capabilities,
 	}
 	err = k.setAuthorityMetadata(ctx, denom, authorityMetadata)
 	if err != nil {
@@ -70,17 +77,83 @@ func (k Keeper) validateCreateDenom(ctx sdk.Context, creatorAddr string, subdeno
 	return denom, nil
 }
 
-func (k Keeper) chargeForCreateDenom(ctx sdk.Context, creatorAddr string, subdenom string) (err error) {
-	// Send creation fee to community pool
-	creationFee := k.GetParams(ctx).DenomCreationFee
+// chargeForCreateDenom charges the denom creation fee in feeDenom, burning the burn fraction of it
+// and sending the rest to the community pool.
+func (k Keeper) chargeForCreateDenom(ctx sdk.Context, creatorAddr string, feeDenom string) (err error) {
 	accAddr, err := sdk.AccAddressFromBech32(creatorAddr)
 	if err != nil {
 		return err
 	}
-	if creationFee != nil {
-		if err := k.communityPoolKeeper.FundCommunityPool(ctx, creationFee, accAddr); err != nil {
+	creationFee, err := k.GetDenomCreationFee(ctx, feeDenom)
+	if err != nil {
+		return err
+	}
+	if creationFee.IsZero() {
+		return nil
+	}
+
+	burnFraction := k.GetParams(ctx).DenomCreationFeeBurnFraction
+	burnFee := sdk.Coins{}
+	for _, coin := range creationFee {
+		burnFee = burnFee.Add(sdk.NewCoin(coin.Denom, burnFraction.MulInt(coin.Amount).TruncateInt()))
+	}
+	if !burnFee.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, accAddr, types.ModuleName, burnFee); err != nil {
+			return err
+		}
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, burnFee); err != nil {
+			return err
+		}
+	}
+
+	// Send the rest of the creation fee to community pool
+	communityPoolFee := creationFee.Sub(burnFee)
+	if !communityPoolFee.IsZero() {
+		if err := k.communityPoolKeeper.FundCommunityPool(ctx, communityPoolFee, accAddr); err != nil {
 			return err
 		}
 	}
 	return nil
 }
+
+// GetDenomCreationFee returns the denom creation fee when paid in feeDenom. The amount of the fee in
+// the x/txfees base denom is converted to the equivalent amount of feeDenom, rounded up, at the
+// arithmetic TWAP of the fee token pool over the last DenomCreationFeeTwapWindow, while the rest of
+// the fee is charged as configured. The fee is returned as configured if feeDenom is empty.
+func (k Keeper) GetDenomCreationFee(ctx sdk.Context, feeDenom string) (sdk.Coins, error) {
+	creationFee := k.GetParams(ctx).DenomCreationFee
+	if feeDenom == "" {
+		return creationFee, nil
+	}
+
+	baseDenom, err := k.txFeesKeeper.GetBaseDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+	baseAmount := creationFee.AmountOf(baseDenom)
+	if feeDenom == baseDenom || !baseAmount.IsPositive() {
+		return creationFee, nil
+	}
+
+	feeToken, err := k.txFeesKeeper.GetFeeToken(ctx, feeDenom)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidFeeDenom, "%s: %s", feeDenom, err)
+	}
+	startTime := ctx.BlockTime().Add(-types.DenomCreationFeeTwapWindow)
+	twap, err := k.twapKeeper.GetArithmeticTwapToNow(ctx, feeToken.PoolID, feeDenom, baseDenom, startTime)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidFeeDenom, "%s: %s", feeDenom, err)
+	}
+	if !twap.IsPositive() {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidFeeDenom, "%s has no positive twap", feeDenom)
+	}
+
+	feeAmount := baseAmount.ToDec().Quo(twap).Ceil().TruncateInt()
+	// make sure that rounding in the conversion never lets the fee be paid with less value
+	if twap.MulInt(feeAmount).LT(baseAmount.ToDec()) {
+		feeAmount = feeAmount.AddRaw(1)
+	}
+
+	baseFee := sdk.NewCoin(baseDenom, baseAmount)
+	return creationFee.Sub(sdk.NewCoins(baseFee)).Add(sdk.NewCoin(feeDenom, feeAmount)), nil
+}
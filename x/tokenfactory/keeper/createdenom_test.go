@@ -7,6 +7,7 @@ import (
 
 	"github.com/osmosis-labs/osmosis/v12/app/apptesting"
 	"github.com/osmosis-labs/osmosis/v12/x/tokenfactory/types"
+	txfeestypes "github.com/osmosis-labs/osmosis/v12/x/txfees/types"
 )
 
 func (suite *KeeperTestSuite) TestMsgCreateDenom() {
@@ -65,10 +66,10 @@ func (suite *KeeperTestSuite) TestCreateDenom() {
 	var (
 		primaryDenom            = types.DefaultParams().DenomCreationFee[0].Denom
 		secondaryDenom          = apptesting.SecondaryDenom
-		defaultDenomCreationFee = types.Params{DenomCreationFee: sdk.NewCoins(sdk.NewCoin(primaryDenom, sdk.NewInt(50000000)))}
-		twoDenomCreationFee     = types.Params{DenomCreationFee: sdk.NewCoins(sdk.NewCoin(primaryDenom, sdk.NewInt(50000000)), sdk.NewCoin(secondaryDenom, sdk.NewInt(50000000)))}
-		nilCreationFee          = types.Params{DenomCreationFee: nil}
-		largeCreationFee        = types.Params{DenomCreationFee: sdk.NewCoins(sdk.NewCoin(primaryDenom, sdk.NewInt(5000000000)))}
+		defaultDenomCreationFee = types.NewParams(sdk.NewCoins(sdk.NewCoin(primaryDenom, sdk.NewInt(50000000))), sdk.ZeroDec())
+		twoDenomCreationFee     = types.NewParams(sdk.NewCoins(sdk.NewCoin(primaryDenom, sdk.NewInt(50000000)), sdk.NewCoin(secondaryDenom, sdk.NewInt(50000000))), sdk.ZeroDec())
+		nilCreationFee          = types.NewParams(nil, sdk.ZeroDec())
+		largeCreationFee        = types.NewParams(sdk.NewCoins(sdk.NewCoin(primaryDenom, sdk.NewInt(5000000000))), sdk.ZeroDec())
 	)
 
 	for _, tc := range []struct {
@@ -76,6 +77,7 @@ func (suite *KeeperTestSuite) TestCreateDenom() {
 		denomCreationFee types.Params
 		setup            func()
 		subdenom         string
+		capabilities     []string
 		valid            bool
 	}{
 		{
@@ -124,6 +126,27 @@ func (suite *KeeperTestSuite) TestCreateDenom() {
 			subdenom:         "bit/***///&&&/coin",
 			valid:            false,
 		},
+		{
+			desc:             "success case: capabilities",
+			denomCreationFee: defaultDenomCreationFee,
+			subdenom:         "usdc",
+			capabilities:     []string{types.CapabilityForceTransfer, types.CapabilityFreeze},
+			valid:            true,
+		},
+		{
+			desc:             "unsupported capability",
+			denomCreationFee: defaultDenomCreationFee,
+			subdenom:         "usdc",
+			capabilities:     []string{"clawback"},
+			valid:            false,
+		},
+		{
+			desc:             "duplicate capability",
+			denomCreationFee: defaultDenomCreationFee,
+			subdenom:         "usdc",
+			capabilities:     []string{types.CapabilityFreeze, types.CapabilityFreeze},
+			valid:            false,
+		},
 	} {
 		suite.SetupTest()
 		suite.Run(fmt.Sprintf("Case %s", tc.desc), func() {
@@ -139,7 +162,7 @@ func (suite *KeeperTestSuite) TestCreateDenom() {
 
 			// note balance, create a tokenfactory denom, then note balance again
 			preCreateBalance := bankKeeper.GetAllBalances(suite.Ctx, suite.TestAccs[0])
-			res, err := suite.msgServer.CreateDenom(sdk.WrapSDKContext(suite.Ctx), types.NewMsgCreateDenom(suite.TestAccs[0].String(), tc.subdenom))
+			res, err := suite.msgServer.CreateDenom(sdk.WrapSDKContext(suite.Ctx), types.NewMsgCreateDenom(suite.TestAccs[0].String(), tc.subdenom, tc.capabilities...))
 			postCreateBalance := bankKeeper.GetAllBalances(suite.Ctx, suite.TestAccs[0])
 			if tc.valid {
 				suite.Require().NoError(err)
@@ -152,6 +175,7 @@ func (suite *KeeperTestSuite) TestCreateDenom() {
 
 				suite.Require().NoError(err)
 				suite.Require().Equal(suite.TestAccs[0].String(), queryRes.AuthorityMetadata.Admin)
+				suite.Require().Equal(tc.capabilities, queryRes.AuthorityMetadata.Capabilities)
 
 			} else {
 				suite.Require().Error(err)
@@ -161,3 +185,141 @@ func (suite *KeeperTestSuite) TestCreateDenom() {
 		})
 	}
 }
+
+// setupFeeToken makes the secondary denom a fee token, priced by a pool against the denom creation fee denom.
+// setupFeeToken sets up the secondary denom as a fee token, with a pool old enough for the denom
+// creation fee twap.
+func (suite *KeeperTestSuite) setupFeeToken() {
+	baseDenom := types.DefaultParams().DenomCreationFee[0].Denom
+	err := suite.App.TxFeesKeeper.SetBaseDenom(suite.Ctx, baseDenom)
+	suite.Require().NoError(err)
+	suite.addFeeToken(apptesting.SecondaryDenom)
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(types.DenomCreationFeeTwapWindow))
+}
+
+// addFeeToken adds a fee token, with a new pool against the base denom.
+func (suite *KeeperTestSuite) addFeeToken(denom string) {
+	baseDenom := types.DefaultParams().DenomCreationFee[0].Denom
+	poolID := suite.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(baseDenom, 1_000_000), sdk.NewInt64Coin(denom, 3_000_000))
+	feeTokens := append(suite.App.TxFeesKeeper.GetFeeTokens(suite.Ctx), txfeestypes.FeeToken{Denom: denom, PoolID: poolID})
+	err := suite.App.TxFeesKeeper.SetFeeTokens(suite.Ctx, feeTokens)
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestCreateDenomFee() {
+	var (
+		baseDenom    = types.DefaultParams().DenomCreationFee[0].Denom
+		feeTokenDiff = sdk.NewInt64Coin(apptesting.SecondaryDenom, 1)
+	)
+
+	for _, tc := range []struct {
+		desc         string
+		feeDenom     string
+		burnFraction sdk.Dec
+		expectedErr  error
+	}{
+		{
+			desc:         "paid as configured",
+			feeDenom:     "",
+			burnFraction: sdk.ZeroDec(),
+		},
+		{
+			desc:         "paid in the base denom",
+			feeDenom:     baseDenom,
+			burnFraction: sdk.ZeroDec(),
+		},
+		{
+			desc:         "paid in a fee token",
+			feeDenom:     apptesting.SecondaryDenom,
+			burnFraction: sdk.ZeroDec(),
+		},
+		{
+			desc:         "paid in a fee token, half burned",
+			feeDenom:     apptesting.SecondaryDenom,
+			burnFraction: sdk.NewDecWithPrec(5, 1),
+		},
+		{
+			desc:         "paid as configured, all burned",
+			feeDenom:     "",
+			burnFraction: sdk.OneDec(),
+		},
+		{
+			desc:         "not a fee token",
+			feeDenom:     "uatom",
+			burnFraction: sdk.ZeroDec(),
+			expectedErr:  types.ErrInvalidFeeDenom,
+		},
+		{
+			desc:         "fee token with a pool younger than the twap window",
+			feeDenom:     "ufee",
+			burnFraction: sdk.ZeroDec(),
+			expectedErr:  types.ErrInvalidFeeDenom,
+		},
+	} {
+		suite.Run(tc.desc, func() {
+			suite.SetupTest()
+			suite.setupFeeToken()
+			suite.addFeeToken("ufee")
+			params := types.NewParams(sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 10_000_000)), tc.burnFraction)
+			suite.App.TokenFactoryKeeper.SetParams(suite.Ctx, params)
+
+			creator := suite.TestAccs[1]
+			preCreateBalance := suite.App.BankKeeper.GetAllBalances(suite.Ctx, creator)
+			preCommunityPool := suite.App.DistrKeeper.GetFeePoolCommunityCoins(suite.Ctx)
+
+			msg := types.NewMsgCreateDenom(creator.String(), "bitcoin")
+			msg.FeeDenom = tc.feeDenom
+			_, err := suite.msgServer.CreateDenom(sdk.WrapSDKContext(suite.Ctx), msg)
+
+			postCreateBalance := suite.App.BankKeeper.GetAllBalances(suite.Ctx, creator)
+			if tc.expectedErr != nil {
+				suite.Require().ErrorIs(err, tc.expectedErr)
+				suite.Require().True(preCreateBalance.IsEqual(postCreateBalance))
+				return
+			}
+			suite.Require().NoError(err)
+
+			fee, err := suite.App.TokenFactoryKeeper.GetDenomCreationFee(suite.Ctx, tc.feeDenom)
+			suite.Require().NoError(err)
+			suite.Require().True(preCreateBalance.Sub(postCreateBalance).IsEqual(fee))
+			if tc.feeDenom == apptesting.SecondaryDenom {
+				// the fee token amount is the least that is worth the configured fee
+				suite.Require().Equal(apptesting.SecondaryDenom, fee[0].Denom)
+				feeToken, err := suite.App.TxFeesKeeper.GetFeeToken(suite.Ctx, apptesting.SecondaryDenom)
+				suite.Require().NoError(err)
+				startTime := suite.Ctx.BlockTime().Add(-types.DenomCreationFeeTwapWindow)
+				twap, err := suite.App.TwapKeeper.GetArithmeticTwapToNow(suite.Ctx, feeToken.PoolID, apptesting.SecondaryDenom, baseDenom, startTime)
+				suite.Require().NoError(err)
+				suite.Require().True(twap.MulInt(fee[0].Amount).GTE(params.DenomCreationFee.AmountOf(baseDenom).ToDec()))
+				suite.Require().True(twap.MulInt(fee[0].Sub(feeTokenDiff).Amount).LT(params.DenomCreationFee.AmountOf(baseDenom).ToDec()))
+			} else {
+				suite.Require().Equal(params.DenomCreationFee, fee)
+			}
+
+			// the burn fraction of the fee is burned, rounded down, and the rest goes to the community pool
+			burnedFee, _ := sdk.NewDecCoinsFromCoins(fee...).MulDec(tc.burnFraction).TruncateDecimal()
+			communityPoolFee := fee.Sub(burnedFee)
+			postCommunityPool := suite.App.DistrKeeper.GetFeePoolCommunityCoins(suite.Ctx)
+			suite.Require().True(sdk.NewDecCoinsFromCoins(communityPoolFee...).IsEqual(postCommunityPool.Sub(preCommunityPool)))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestDenomCreationFeeQuery() {
+	suite.SetupTest()
+	suite.setupFeeToken()
+	suite.addFeeToken("ufee")
+
+	res, err := suite.App.TokenFactoryKeeper.DenomCreationFee(sdk.WrapSDKContext(suite.Ctx), &types.QueryDenomCreationFeeRequest{})
+	suite.Require().NoError(err)
+
+	feeTokenFee, err := suite.App.TokenFactoryKeeper.GetDenomCreationFee(suite.Ctx, apptesting.SecondaryDenom)
+	suite.Require().NoError(err)
+	_, youngFeeTokenErr := suite.App.TokenFactoryKeeper.GetDenomCreationFee(suite.Ctx, "ufee")
+	suite.Require().ErrorIs(youngFeeTokenErr, types.ErrInvalidFeeDenom)
+	suite.Require().ElementsMatch([]types.DenomCreationFeeOption{
+		{FeeDenom: "", Fee: types.DefaultParams().DenomCreationFee},
+		{FeeDenom: apptesting.SecondaryDenom, Fee: feeTokenFee},
+		{FeeDenom: "ufee", UnavailableReason: youngFeeTokenErr.Error()},
+	}, res.Fees)
+}
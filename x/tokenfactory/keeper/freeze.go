@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v12/x/tokenfactory/types"
+)
+
+// setFrozen freezes or unfreezes an account for the denom. A frozen account can neither send nor
+// receive the denom, except through force transfers and mints. Accounts are keyed by their canonical
+// bech32 address, so that every encoding of an address freezes the same account.
+func (k Keeper) setFrozen(ctx sdk.Context, denom string, address sdk.AccAddress, frozen bool) {
+	store := k.GetDenomPrefixStore(ctx, denom)
+	if !frozen {
+		store.Delete(types.GetFrozenAccountKey(address.String()))
+		return
+	}
+	store.Set(types.GetFrozenAccountKey(address.String()), []byte(address.String()))
+}
+
+// IsAccountFrozen returns whether the account is frozen for the denom.
+func (k Keeper) IsAccountFrozen(ctx sdk.Context, denom string, address sdk.AccAddress) bool {
+	return k.GetDenomPrefixStore(ctx, denom).Has(types.GetFrozenAccountKey(address.String()))
+}
+
+// GetFrozenAccounts returns the addresses of the accounts frozen for the denom.
+func (k Keeper) GetFrozenAccounts(ctx sdk.Context, denom string) []string {
+	store := prefix.NewStore(k.GetDenomPrefixStore(ctx, denom), types.GetFrozenAccountPrefix())
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var addresses []string
+	for ; iterator.Valid(); iterator.Next() {
+		addresses = append(addresses, string(iterator.Value()))
+	}
+	return addresses
+}
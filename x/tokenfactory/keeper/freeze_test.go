@@ -0,0 +1,242 @@
+package keeper_test
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v12/x/tokenfactory/types"
+)
+
+func (suite *KeeperTestSuite) TestForceTransfer() {
+	for _, tc := range []struct {
+		desc         string
+		capabilities []string
+		senderIndex  int
+		freezeHolder bool
+		fromModule   bool
+		expectedErr  error
+	}{
+		{
+			desc:         "not the denom admin",
+			capabilities: []string{types.CapabilityForceTransfer},
+			senderIndex:  1,
+			expectedErr:  types.ErrUnauthorized,
+		},
+		{
+			desc:        "denom without the force transfer capability",
+			senderIndex: 0,
+			expectedErr: types.ErrCapabilityNotEnabled,
+		},
+		{
+			desc:         "from a module account",
+			capabilities: []string{types.CapabilityForceTransfer},
+			senderIndex:  0,
+			fromModule:   true,
+			expectedErr:  types.ErrModuleAccountTransfer,
+		},
+		{
+			desc:         "success case",
+			capabilities: []string{types.CapabilityForceTransfer},
+			senderIndex:  0,
+		},
+		{
+			desc:         "success case: from a frozen account",
+			capabilities: []string{types.CapabilityForceTransfer, types.CapabilityFreeze},
+			senderIndex:  0,
+			freezeHolder: true,
+		},
+	} {
+		suite.Run(fmt.Sprintf("Case %s", tc.desc), func() {
+			suite.SetupTest()
+
+			res, err := suite.msgServer.CreateDenom(sdk.WrapSDKContext(suite.Ctx), types.NewMsgCreateDenom(suite.TestAccs[0].String(), "usdc", tc.capabilities...))
+			suite.Require().NoError(err)
+			denom := res.GetNewTokenDenom()
+
+			holder := suite.TestAccs[1]
+			recipient := suite.TestAccs[2]
+			_, err = suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMint(suite.TestAccs[0].String(), sdk.NewInt64Coin(denom, 10)))
+			suite.Require().NoError(err)
+			err = suite.App.BankKeeper.SendCoins(suite.Ctx, suite.TestAccs[0], holder, sdk.NewCoins(sdk.NewInt64Coin(denom, 10)))
+			suite.Require().NoError(err)
+
+			if tc.freezeHolder {
+				_, err = suite.msgServer.Freeze(sdk.WrapSDKContext(suite.Ctx), types.NewMsgFreeze(suite.TestAccs[0].String(), denom, holder.String(), true))
+				suite.Require().NoError(err)
+			}
+
+			from := holder
+			if tc.fromModule {
+				from = suite.App.AccountKeeper.GetModuleAddress(types.ModuleName)
+			}
+			ctx := suite.Ctx.WithEventManager(sdk.NewEventManager())
+			_, err = suite.msgServer.ForceTransfer(sdk.WrapSDKContext(ctx), types.NewMsgForceTransfer(suite.TestAccs[tc.senderIndex].String(), sdk.NewInt64Coin(denom, 10), from.String(), recipient.String()))
+
+			if tc.expectedErr != nil {
+				suite.Require().ErrorIs(err, tc.expectedErr)
+				suite.AssertEventEmitted(ctx, types.TypeMsgForceTransfer, 0)
+				suite.Require().Equal(sdk.NewInt64Coin(denom, 10), suite.App.BankKeeper.GetBalance(suite.Ctx, holder, denom))
+				return
+			}
+			suite.Require().NoError(err)
+			suite.AssertEventEmitted(ctx, types.TypeMsgForceTransfer, 1)
+			suite.Require().True(suite.App.BankKeeper.GetBalance(suite.Ctx, holder, denom).IsZero())
+			suite.Require().Equal(sdk.NewInt64Coin(denom, 10), suite.App.BankKeeper.GetBalance(suite.Ctx, recipient, denom))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestFreeze() {
+	for _, tc := range []struct {
+		desc         string
+		capabilities []string
+		senderIndex  int
+		expectedErr  error
+	}{
+		{
+			desc:         "not the denom admin",
+			capabilities: []string{types.CapabilityFreeze},
+			senderIndex:  1,
+			expectedErr:  types.ErrUnauthorized,
+		},
+		{
+			desc:         "denom without the freeze capability",
+			capabilities: []string{types.CapabilityForceTransfer},
+			senderIndex:  0,
+			expectedErr:  types.ErrCapabilityNotEnabled,
+		},
+		{
+			desc:         "success case",
+			capabilities: []string{types.CapabilityFreeze},
+			senderIndex:  0,
+		},
+	} {
+		suite.Run(fmt.Sprintf("Case %s", tc.desc), func() {
+			suite.SetupTest()
+
+			res, err := suite.msgServer.CreateDenom(sdk.WrapSDKContext(suite.Ctx), types.NewMsgCreateDenom(suite.TestAccs[0].String(), "usdc", tc.capabilities...))
+			suite.Require().NoError(err)
+			denom := res.GetNewTokenDenom()
+
+			admin := suite.TestAccs[0]
+			frozenAcc := suite.TestAccs[1]
+			otherAcc := suite.TestAccs[2]
+			coins := sdk.NewCoins(sdk.NewInt64Coin(denom, 10))
+			_, err = suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMint(admin.String(), sdk.NewInt64Coin(denom, 100)))
+			suite.Require().NoError(err)
+			err = suite.App.BankKeeper.SendCoins(suite.Ctx, admin, frozenAcc, coins)
+			suite.Require().NoError(err)
+
+			ctx := suite.Ctx.WithEventManager(sdk.NewEventManager())
+			_, err = suite.msgServer.Freeze(sdk.WrapSDKContext(ctx), types.NewMsgFreeze(suite.TestAccs[tc.senderIndex].String(), denom, frozenAcc.String(), true))
+
+			frozenRes, queryErr := suite.queryClient.IsFrozen(suite.Ctx.Context(), &types.QueryIsFrozenRequest{Denom: denom, Address: frozenAcc.String()})
+			suite.Require().NoError(queryErr)
+			if tc.expectedErr != nil {
+				suite.Require().ErrorIs(err, tc.expectedErr)
+				suite.AssertEventEmitted(ctx, types.TypeMsgFreeze, 0)
+				suite.Require().False(frozenRes.Frozen)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.AssertEventEmitted(ctx, types.TypeMsgFreeze, 1)
+			suite.Require().True(frozenRes.Frozen)
+
+			frozenAccountsRes, err := suite.queryClient.FrozenAccounts(suite.Ctx.Context(), &types.QueryFrozenAccountsRequest{Denom: denom})
+			suite.Require().NoError(err)
+			suite.Require().Equal([]string{frozenAcc.String()}, frozenAccountsRes.Addresses)
+
+			// the frozen account can neither send nor receive the denom
+			err = suite.App.BankKeeper.SendCoins(suite.Ctx, frozenAcc, otherAcc, coins)
+			suite.Require().ErrorIs(err, types.ErrAccountFrozen)
+			err = suite.App.BankKeeper.SendCoins(suite.Ctx, admin, frozenAcc, coins)
+			suite.Require().ErrorIs(err, types.ErrAccountFrozen)
+
+			// other denoms are not affected
+			err = suite.App.BankKeeper.SendCoins(suite.Ctx, frozenAcc, otherAcc, sdk.NewCoins(sdk.NewInt64Coin("uosmo", 10)))
+			suite.Require().NoError(err)
+
+			// sends from module accounts are not blocked
+			err = suite.App.BankKeeper.SendCoinsFromAccountToModule(suite.Ctx, admin, types.ModuleName, coins)
+			suite.Require().NoError(err)
+			err = suite.App.BankKeeper.SendCoinsFromModuleToAccount(suite.Ctx, types.ModuleName, frozenAcc, coins)
+			suite.Require().NoError(err)
+
+			// unfreezing the account allows it to send the denom again
+			_, err = suite.msgServer.Freeze(sdk.WrapSDKContext(suite.Ctx), types.NewMsgFreeze(admin.String(), denom, frozenAcc.String(), false))
+			suite.Require().NoError(err)
+			suite.Require().False(suite.App.TokenFactoryKeeper.IsAccountFrozen(suite.Ctx, denom, frozenAcc))
+			err = suite.App.BankKeeper.SendCoins(suite.Ctx, frozenAcc, otherAcc, coins)
+			suite.Require().NoError(err)
+		})
+	}
+}
+
+// TestFreezeNonCanonicalAddress tests that an account frozen by a non-canonical encoding of its address,
+// such as its uppercase bech32 address, is frozen.
+func (suite *KeeperTestSuite) TestFreezeNonCanonicalAddress() {
+	suite.SetupTest()
+
+	res, err := suite.msgServer.CreateDenom(sdk.WrapSDKContext(suite.Ctx), types.NewMsgCreateDenom(suite.TestAccs[0].String(), "usdc", types.CapabilityFreeze))
+	suite.Require().NoError(err)
+	denom := res.GetNewTokenDenom()
+
+	admin := suite.TestAccs[0]
+	frozenAcc := suite.TestAccs[1]
+	coins := sdk.NewCoins(sdk.NewInt64Coin(denom, 10))
+	_, err = suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMint(admin.String(), sdk.NewInt64Coin(denom, 100)))
+	suite.Require().NoError(err)
+
+	upperAddress := strings.ToUpper(frozenAcc.String())
+	_, err = suite.msgServer.Freeze(sdk.WrapSDKContext(suite.Ctx), types.NewMsgFreeze(admin.String(), denom, upperAddress, true))
+	suite.Require().NoError(err)
+	err = suite.App.BankKeeper.SendCoins(suite.Ctx, admin, frozenAcc, coins)
+	suite.Require().ErrorIs(err, types.ErrAccountFrozen)
+
+	frozenRes, err := suite.queryClient.IsFrozen(suite.Ctx.Context(), &types.QueryIsFrozenRequest{Denom: denom, Address: upperAddress})
+	suite.Require().NoError(err)
+	suite.Require().True(frozenRes.Frozen)
+	frozenAccountsRes, err := suite.queryClient.FrozenAccounts(suite.Ctx.Context(), &types.QueryFrozenAccountsRequest{Denom: denom})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{frozenAcc.String()}, frozenAccountsRes.Addresses)
+
+	// the account is unfrozen by its canonical address
+	_, err = suite.msgServer.Freeze(sdk.WrapSDKContext(suite.Ctx), types.NewMsgFreeze(admin.String(), denom, frozenAcc.String(), false))
+	suite.Require().NoError(err)
+	err = suite.App.BankKeeper.SendCoins(suite.Ctx, admin, frozenAcc, coins)
+	suite.Require().NoError(err)
+}
+
+// TestForceTransferBypassesHooks tests that force transfers bypass the before send hook and frozen
+// checks of the denom, which the bank keeper of the tokenfactory keeper calls.
+func (suite *KeeperTestSuite) TestForceTransferBypassesHooks() {
+	suite.SetupTest()
+
+	res, err := suite.msgServer.CreateDenom(sdk.WrapSDKContext(suite.Ctx), types.NewMsgCreateDenom(suite.TestAccs[0].String(), "usdc", types.CapabilityForceTransfer, types.CapabilityFreeze))
+	suite.Require().NoError(err)
+	denom := res.GetNewTokenDenom()
+
+	holder := suite.TestAccs[1]
+	recipient := suite.TestAccs[2]
+	_, err = suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMint(suite.TestAccs[0].String(), sdk.NewInt64Coin(denom, 10)))
+	suite.Require().NoError(err)
+	err = suite.App.BankKeeper.SendCoins(suite.Ctx, suite.TestAccs[0], holder, sdk.NewCoins(sdk.NewInt64Coin(denom, 10)))
+	suite.Require().NoError(err)
+
+	// the holder is frozen, and the denom has a hook rejecting every send
+	_, err = suite.msgServer.Freeze(sdk.WrapSDKContext(suite.Ctx), types.NewMsgFreeze(suite.TestAccs[0].String(), denom, holder.String(), true))
+	suite.Require().NoError(err)
+	contractAddr := suite.instantiateRejectingContract()
+	_, err = suite.msgServer.SetBeforeSendHook(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetBeforeSendHook(suite.TestAccs[0].String(), denom, contractAddr.String()))
+	suite.Require().NoError(err)
+	err = suite.App.BankKeeper.SendCoins(suite.Ctx, holder, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 10)))
+	suite.Require().ErrorIs(err, types.ErrAccountFrozen)
+
+	// the force transfer goes through the app's tokenfactory keeper, whose bank keeper calls the bank hooks
+	_, err = suite.msgServer.ForceTransfer(sdk.WrapSDKContext(suite.Ctx), types.NewMsgForceTransfer(suite.TestAccs[0].String(), sdk.NewInt64Coin(denom, 10), holder.String(), recipient.String()))
+	suite.Require().NoError(err)
+	suite.Require().True(suite.App.BankKeeper.GetBalance(suite.Ctx, holder, denom).IsZero())
+	suite.Require().Equal(sdk.NewInt64Coin(denom, 10), suite.App.BankKeeper.GetBalance(suite.Ctx, recipient, denom))
+}
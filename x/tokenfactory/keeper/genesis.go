@@ -14,6 +14,9 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 	if genState.Params.DenomCreationFee == nil {
 		genState.Params.DenomCreationFee = sdk.NewCoins()
 	}
+	if genState.Params.DenomCreationFeeBurnFraction.IsNil() {
+		genState.Params.DenomCreationFeeBurnFraction = sdk.ZeroDec()
+	}
 	k.SetParams(ctx, genState.Params)
 
 	for _, genDenom := range genState.GetFactoryDenoms() {
@@ -21,7 +24,7 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		if err != nil {
 			panic(err)
 		}
-		err = k.createDenomAfterValidation(ctx, creator, genDenom.GetDenom())
+		err = k.createDenomAfterValidation(ctx, creator, genDenom.GetDenom(), genDenom.GetAuthorityMetadata().Capabilities)
 		if err != nil {
 			panic(err)
 		}
@@ -29,6 +32,15 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		if err != nil {
 			panic(err)
 		}
+		// the contract is not checked to exist, as wasm genesis runs after tokenfactory genesis
+		k.storeBeforeSendHook(ctx, genDenom.GetDenom(), genDenom.GetBeforeSendHookAddress())
+		for _, address := range genDenom.GetFrozenAccounts() {
+			frozenAddr, err := sdk.AccAddressFromBech32(address)
+			if err != nil {
+				panic(err)
+			}
+			k.setFrozen(ctx, genDenom.GetDenom(), frozenAddr, true)
+		}
 	}
 }
 
@@ -46,8 +58,10 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		}
 
 		genDenoms = append(genDenoms, types.GenesisDenom{
-			Denom:             denom,
-			AuthorityMetadata: authorityMetadata,
+			Denom:                 denom,
+			AuthorityMetadata:     authorityMetadata,
+			BeforeSendHookAddress: k.GetBeforeSendHook(ctx, denom),
+			FrozenAccounts:        k.GetFrozenAccounts(ctx, denom),
 		})
 	}
 
@@ -9,6 +9,9 @@ import (
 
 func (suite *KeeperTestSuite) TestGenesis() {
 	genesisState := types.GenesisState{
+		Params: types.Params{
+			DenomCreationFeeBurnFraction: sdk.NewDecWithPrec(5, 1),
+		},
 		FactoryDenoms: []types.GenesisDenom{
 			{
 				Denom: "factory/osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44/bitcoin",
@@ -25,8 +28,11 @@ func (suite *KeeperTestSuite) TestGenesis() {
 			{
 				Denom: "factory/osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44/litecoin",
 				AuthorityMetadata: types.DenomAuthorityMetadata{
-					Admin: "osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44",
+					Admin:        "osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44",
+					Capabilities: []string{types.CapabilityForceTransfer, types.CapabilityFreeze},
 				},
+				BeforeSendHookAddress: "osmo14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9sq2r9g9",
+				FrozenAccounts:        []string{"osmo15czt5nhlnvayqq37xun9s9yus0d6y26dw9xnzn"},
 			},
 		},
 	}
@@ -46,7 +52,7 @@ func (suite *KeeperTestSuite) TestGenesis() {
 	tokenfactoryModuleAccount := app.AccountKeeper.GetAccount(suite.Ctx, app.AccountKeeper.GetModuleAddress(types.ModuleName))
 	suite.Require().Nil(tokenfactoryModuleAccount)
 
-	app.TokenFactoryKeeper.SetParams(suite.Ctx, types.Params{DenomCreationFee: sdk.Coins{sdk.NewInt64Coin("uosmo", 100)}})
+	app.TokenFactoryKeeper.SetParams(suite.Ctx, types.NewParams(sdk.Coins{sdk.NewInt64Coin("uosmo", 100)}, sdk.ZeroDec()))
 	app.TokenFactoryKeeper.InitGenesis(suite.Ctx, genesisState)
 
 	// check that the module account is now initialized
@@ -17,6 +17,24 @@ func (k Keeper) Params(ctx context.Context, req *types.QueryParamsRequest) (*typ
 	return &types.QueryParamsResponse{Params: params}, nil
 }
 
+func (k Keeper) DenomCreationFee(ctx context.Context, req *types.QueryDenomCreationFeeRequest) (*types.QueryDenomCreationFeeResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	fees := []types.DenomCreationFeeOption{{Fee: k.GetParams(sdkCtx).DenomCreationFee}}
+	for _, feeToken := range k.txFeesKeeper.GetFeeTokens(sdkCtx) {
+		fee, err := k.GetDenomCreationFee(sdkCtx, feeToken.Denom)
+		// fee tokens that the fee can not currently be converted to, such as those whose pool is younger
+		// than the twap window, are returned with the reason
+		if err != nil {
+			fees = append(fees, types.DenomCreationFeeOption{FeeDenom: feeToken.Denom, UnavailableReason: err.Error()})
+			continue
+		}
+		fees = append(fees, types.DenomCreationFeeOption{FeeDenom: feeToken.Denom, Fee: fee})
+	}
+
+	return &types.QueryDenomCreationFeeResponse{Fees: fees}, nil
+}
+
 func (k Keeper) DenomAuthorityMetadata(ctx context.Context, req *types.QueryDenomAuthorityMetadataRequest) (*types.QueryDenomAuthorityMetadataResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
@@ -33,3 +51,25 @@ func (k Keeper) DenomsFromCreator(ctx context.Context, req *types.QueryDenomsFro
 	denoms := k.getDenomsFromCreator(sdkCtx, req.GetCreator())
 	return &types.QueryDenomsFromCreatorResponse{Denoms: denoms}, nil
 }
+
+func (k Keeper) BeforeSendHookAddress(ctx context.Context, req *types.QueryBeforeSendHookAddressRequest) (*types.QueryBeforeSendHookAddressResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	cosmwasmAddress := k.GetBeforeSendHook(sdkCtx, req.GetDenom())
+	return &types.QueryBeforeSendHookAddressResponse{CosmwasmAddress: cosmwasmAddress}, nil
+}
+
+func (k Keeper) FrozenAccounts(ctx context.Context, req *types.QueryFrozenAccountsRequest) (*types.QueryFrozenAccountsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	addresses := k.GetFrozenAccounts(sdkCtx, req.GetDenom())
+	return &types.QueryFrozenAccountsResponse{Addresses: addresses}, nil
+}
+
+func (k Keeper) IsFrozen(ctx context.Context, req *types.QueryIsFrozenRequest) (*types.QueryIsFrozenResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	address, err := sdk.AccAddressFromBech32(req.GetAddress())
+	if err != nil {
+		return nil, err
+	}
+	frozen := k.IsAccountFrozen(sdkCtx, req.GetDenom(), address)
+	return &types.QueryIsFrozenResponse{Frozen: frozen}, nil
+}
@@ -23,6 +23,9 @@ type (
 		accountKeeper       types.AccountKeeper
 		bankKeeper          types.BankKeeper
 		communityPoolKeeper types.CommunityPoolKeeper
+		txFeesKeeper        types.TxFeesKeeper
+		twapKeeper          types.TwapKeeper
+		contractKeeper      types.ContractKeeper
 	}
 )
 
@@ -33,6 +36,8 @@ func NewKeeper(
 	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
 	communityPoolKeeper types.CommunityPoolKeeper,
+	txFeesKeeper types.TxFeesKeeper,
+	twapKeeper types.TwapKeeper,
 ) Keeper {
 	if !paramSpace.HasKeyTable() {
 		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
@@ -45,6 +50,8 @@ func NewKeeper(
 		accountKeeper:       accountKeeper,
 		bankKeeper:          bankKeeper,
 		communityPoolKeeper: communityPoolKeeper,
+		txFeesKeeper:        txFeesKeeper,
+		twapKeeper:          twapKeeper,
 	}
 }
 
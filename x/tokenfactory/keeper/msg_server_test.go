@@ -91,7 +91,7 @@ func (suite *KeeperTestSuite) TestBurnDenomMsg() {
 
 // TestCreateDenomMsg tests TypeMsgCreateDenom message is emitted on a successful denom creation
 func (suite *KeeperTestSuite) TestCreateDenomMsg() {
-	defaultDenomCreationFee := types.Params{DenomCreationFee: sdk.NewCoins(sdk.NewCoin("uosmo", sdk.NewInt(50000000)))}
+	defaultDenomCreationFee := types.NewParams(sdk.NewCoins(sdk.NewCoin("uosmo", sdk.NewInt(50000000))), sdk.ZeroDec())
 	for _, tc := range []struct {
 		desc                  string
 		denomCreationFee      types.Params
@@ -2,8 +2,32 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
+// capabilities of the admin over a denom, which are enabled when the denom is created
+const (
+	// CapabilityForceTransfer allows the admin to transfer the denom from any account to any other account.
+	CapabilityForceTransfer = "force_transfer"
+	// CapabilityFreeze allows the admin to freeze accounts, which can then neither send nor receive the denom.
+	CapabilityFreeze = "freeze"
+)
+
+// ValidateCapabilities validates that the capabilities are supported and not duplicated.
+func ValidateCapabilities(capabilities []string) error {
+	seen := make(map[string]bool, len(capabilities))
+	for _, capability := range capabilities {
+		if capability != CapabilityForceTransfer && capability != CapabilityFreeze {
+			return sdkerrors.Wrapf(ErrInvalidAuthorityMetadata, "unsupported capability %s", capability)
+		}
+		if seen[capability] {
+			return sdkerrors.Wrapf(ErrInvalidAuthorityMetadata, "duplicate capability %s", capability)
+		}
+		seen[capability] = true
+	}
+	return nil
+}
+
 func (metadata DenomAuthorityMetadata) Validate() error {
 	if metadata.Admin != "" {
 		_, err := sdk.AccAddressFromBech32(metadata.Admin)
@@ -11,5 +35,15 @@ func (metadata DenomAuthorityMetadata) Validate() error {
 			return err
 		}
 	}
-	return nil
+	return ValidateCapabilities(metadata.Capabilities)
+}
+
+// HasCapability returns whether the capability is enabled for the denom.
+func (metadata DenomAuthorityMetadata) HasCapability(capability string) bool {
+	for _, c := range metadata.Capabilities {
+		if c == capability {
+			return true
+		}
+	}
+	return false
 }
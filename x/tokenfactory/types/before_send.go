@@ -0,0 +1,20 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BeforeSendHookGasLimit is the maximum gas a before send hook contract can consume on a single send.
+const BeforeSendHookGasLimit = 500_000
+
+// BlockBeforeSendMsg is the sudo message the before send hook contract of a denom is called with
+// before a send of the denom. The send is rejected if the contract returns an error.
+type BlockBeforeSendMsg struct {
+	BlockBeforeSend BlockBeforeSendSudoMsg `json:"block_before_send"`
+}
+
+type BlockBeforeSendSudoMsg struct {
+	From   string   `json:"from"`
+	To     string   `json:"to"`
+	Amount sdk.Coin `json:"amount"`
+}
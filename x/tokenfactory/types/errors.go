@@ -19,4 +19,10 @@ var (
 	ErrSubdenomTooLong          = sdkerrors.Register(ModuleName, 8, fmt.Sprintf("subdenom too long, max length is %d bytes", MaxSubdenomLength))
 	ErrCreatorTooLong           = sdkerrors.Register(ModuleName, 9, fmt.Sprintf("creator too long, max length is %d bytes", MaxCreatorLength))
 	ErrDenomDoesNotExist        = sdkerrors.Register(ModuleName, 10, "denom does not exist")
+	ErrInvalidBeforeSendHook    = sdkerrors.Register(ModuleName, 11, "invalid before send hook")
+	ErrBeforeSendHookFailed     = sdkerrors.Register(ModuleName, 12, "before send hook failed")
+	ErrCapabilityNotEnabled     = sdkerrors.Register(ModuleName, 13, "capability not enabled for denom")
+	ErrAccountFrozen            = sdkerrors.Register(ModuleName, 14, "account is frozen for denom")
+	ErrModuleAccountTransfer    = sdkerrors.Register(ModuleName, 15, "force transfers from or to module accounts are not allowed")
+	ErrInvalidFeeDenom          = sdkerrors.Register(ModuleName, 16, "invalid denom creation fee denom")
 )
@@ -13,4 +13,8 @@ const (
 	AttributeDenom               = "denom"
 	AttributeNewAdmin            = "new_admin"
 	AttributeDenomMetadata       = "denom_metadata"
+	AttributeBeforeSendHook      = "before_send_hook_address"
+	AttributeCapabilities        = "capabilities"
+	AttributeAddress             = "address"
+	AttributeFrozen              = "frozen"
 )
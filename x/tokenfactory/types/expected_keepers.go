@@ -1,9 +1,13 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	txfeestypes "github.com/osmosis-labs/osmosis/v12/x/txfees/types"
 )
 
 type BankKeeper interface {
@@ -24,9 +28,29 @@ type BankKeeper interface {
 
 type AccountKeeper interface {
 	SetModuleAccount(ctx sdk.Context, macc authtypes.ModuleAccountI)
+	GetAccount(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI
+	GetModuleAddress(moduleName string) sdk.AccAddress
 }
 
 // CommunityPoolKeeper defines the contract needed to be fulfilled for community pool interactions.
 type CommunityPoolKeeper interface {
 	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
 }
+
+// TxFeesKeeper defines the contract needed to be fulfilled for paying the denom creation fee in fee tokens.
+type TxFeesKeeper interface {
+	GetBaseDenom(ctx sdk.Context) (denom string, err error)
+	GetFeeTokens(ctx sdk.Context) (feetokens []txfeestypes.FeeToken)
+	GetFeeToken(ctx sdk.Context, denom string) (txfeestypes.FeeToken, error)
+}
+
+// TwapKeeper defines the contract needed to be fulfilled for pricing the denom creation fee in fee tokens.
+type TwapKeeper interface {
+	GetArithmeticTwapToNow(ctx sdk.Context, poolId uint64, baseAssetDenom string, quoteAssetDenom string, startTime time.Time) (sdk.Dec, error)
+}
+
+// ContractKeeper defines the contract needed to be fulfilled for sudo calling the before send hooks of denoms.
+type ContractKeeper interface {
+	HasContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress) bool
+	Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error)
+}
@@ -45,6 +45,32 @@ func (gs GenesisState) Validate() error {
 				return sdkerrors.Wrapf(ErrInvalidAuthorityMetadata, "Invalid admin address (%s)", err)
 			}
 		}
+
+		if err := ValidateCapabilities(denom.AuthorityMetadata.Capabilities); err != nil {
+			return err
+		}
+
+		if denom.BeforeSendHookAddress != "" {
+			_, err = sdk.AccAddressFromBech32(denom.BeforeSendHookAddress)
+			if err != nil {
+				return sdkerrors.Wrapf(ErrInvalidBeforeSendHook, "Invalid before send hook address (%s)", err)
+			}
+		}
+
+		if len(denom.FrozenAccounts) > 0 && !denom.AuthorityMetadata.HasCapability(CapabilityFreeze) {
+			return sdkerrors.Wrapf(ErrCapabilityNotEnabled, "denom %s has frozen accounts without the %s capability", denom.GetDenom(), CapabilityFreeze)
+		}
+		seenFrozenAccounts := map[string]bool{}
+		for _, address := range denom.FrozenAccounts {
+			_, err = sdk.AccAddressFromBech32(address)
+			if err != nil {
+				return sdkerrors.Wrapf(ErrInvalidGenesis, "Invalid frozen account address (%s)", err)
+			}
+			if seenFrozenAccounts[address] {
+				return sdkerrors.Wrapf(ErrInvalidGenesis, "duplicate frozen account %s for denom %s", address, denom.GetDenom())
+			}
+			seenFrozenAccounts[address] = true
+		}
 	}
 
 	return nil
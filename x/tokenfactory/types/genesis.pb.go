@@ -83,6 +83,11 @@ func (m *GenesisState) GetFactoryDenoms() []GenesisDenom {
 type GenesisDenom struct {
 	Denom             string                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
 	AuthorityMetadata DenomAuthorityMetadata `protobuf:"bytes,2,opt,name=authority_metadata,json=authorityMetadata,proto3" json:"authority_metadata" yaml:"authority_metadata"`
+	// before_send_hook_address is the address of the CosmWasm contract
+	// registered as the before send hook of the denom, if any.
+	BeforeSendHookAddress string `protobuf:"bytes,3,opt,name=before_send_hook_address,json=beforeSendHookAddress,proto3" json:"before_send_hook_address,omitempty" yaml:"before_send_hook_address"`
+	// frozen_accounts are the addresses of the accounts frozen for the denom.
+	FrozenAccounts []string `protobuf:"bytes,4,rep,name=frozen_accounts,json=frozenAccounts,proto3" json:"frozen_accounts,omitempty" yaml:"frozen_accounts"`
 }
 
 func (m *GenesisDenom) Reset()         { *m = GenesisDenom{} }
@@ -132,6 +137,20 @@ func (m *GenesisDenom) GetAuthorityMetadata() DenomAuthorityMetadata {
 	return DenomAuthorityMetadata{}
 }
 
+func (m *GenesisDenom) GetBeforeSendHookAddress() string {
+	if m != nil {
+		return m.BeforeSendHookAddress
+	}
+	return ""
+}
+
+func (m *GenesisDenom) GetFrozenAccounts() []string {
+	if m != nil {
+		return m.FrozenAccounts
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "osmosis.tokenfactory.v1beta1.GenesisState")
 	proto.RegisterType((*GenesisDenom)(nil), "osmosis.tokenfactory.v1beta1.GenesisDenom")
@@ -142,30 +161,36 @@ func init() {
 }
 
 var fileDescriptor_5749c3f71850298b = []byte{
-	// 365 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xca, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0x2f, 0xc9, 0xcf, 0x4e, 0xcd, 0x4b, 0x4b, 0x4c, 0x2e, 0xc9, 0x2f, 0xaa,
-	0xd4, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c,
-	0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x81, 0xaa, 0xd5, 0x43, 0x56, 0xab, 0x07, 0x55,
-	0x2b, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x56, 0xa8, 0x0f, 0x62, 0x41, 0xf4, 0x48, 0x99, 0xe0,
-	0x35, 0x3f, 0xb1, 0xb4, 0x24, 0x23, 0xbf, 0x28, 0xb3, 0xa4, 0xd2, 0x37, 0xb5, 0x24, 0x31, 0x25,
-	0xb1, 0x24, 0x11, 0xaa, 0x4b, 0x13, 0xaf, 0xae, 0x82, 0xc4, 0xa2, 0xc4, 0x5c, 0xa8, 0xa3, 0x94,
-	0x8e, 0x30, 0x72, 0xf1, 0xb8, 0x43, 0x9c, 0x19, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0xe4, 0xc4, 0xc5,
-	0x06, 0x51, 0x20, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x6d, 0xa4, 0xa2, 0x87, 0xcf, 0xd9, 0x7a, 0x01,
-	0x60, 0xb5, 0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33, 0x04, 0x41, 0x75, 0x0a, 0x15, 0x70, 0xf1, 0x41,
-	0xd5, 0xc5, 0xa7, 0xa4, 0xe6, 0xe5, 0xe7, 0x16, 0x4b, 0x30, 0x29, 0x30, 0x6b, 0x70, 0x1b, 0x69,
-	0xe1, 0x37, 0x0b, 0xea, 0x0e, 0x17, 0x90, 0x16, 0x27, 0x59, 0x90, 0x89, 0x9f, 0xee, 0xc9, 0x8b,
-	0x56, 0x26, 0xe6, 0xe6, 0x58, 0x29, 0xa1, 0x9a, 0xa7, 0x14, 0xc4, 0x0b, 0x15, 0x70, 0x81, 0xf0,
-	0x8f, 0x22, 0xbc, 0x01, 0x16, 0x11, 0x52, 0xe3, 0x62, 0x05, 0x2b, 0x05, 0xfb, 0x82, 0xd3, 0x49,
-	0xe0, 0xd3, 0x3d, 0x79, 0x1e, 0x88, 0x49, 0x60, 0x61, 0xa5, 0x20, 0x88, 0xb4, 0x50, 0x1b, 0x23,
-	0x97, 0x10, 0x3c, 0x18, 0xe3, 0x73, 0xa1, 0xe1, 0x28, 0xc1, 0x04, 0xf6, 0xbb, 0x09, 0x7e, 0xf7,
-	0x82, 0x6d, 0x72, 0x44, 0x8f, 0x03, 0x27, 0x45, 0xa8, 0xcb, 0x25, 0x21, 0xf6, 0x61, 0x9a, 0xae,
-	0x14, 0x24, 0x88, 0x11, 0x73, 0x56, 0x2c, 0x2f, 0x16, 0xc8, 0x33, 0x3a, 0x05, 0x9d, 0x78, 0x24,
-	0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78,
-	0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x45, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e,
-	0x72, 0x7e, 0xae, 0x3e, 0xd4, 0x55, 0xba, 0x39, 0x89, 0x49, 0xc5, 0x30, 0x8e, 0x7e, 0x99, 0xa1,
-	0x91, 0x7e, 0x05, 0x6a, 0x8c, 0x97, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x63, 0xda, 0x18,
-	0x10, 0x00, 0x00, 0xff, 0xff, 0x16, 0xe9, 0x52, 0x6a, 0xac, 0x02, 0x00, 0x00,
+	// 453 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0xc7, 0xeb, 0xb6, 0x4c, 0x9a, 0x37, 0x06, 0x58, 0x0c, 0x85, 0x0a, 0x92, 0x12, 0x10, 0x2a,
+	0x93, 0x48, 0xd4, 0xb2, 0x03, 0xda, 0xad, 0x61, 0x12, 0x5c, 0x90, 0x50, 0x76, 0x43, 0x48, 0x91,
+	0xd3, 0xb8, 0x69, 0xd4, 0x25, 0x5f, 0x14, 0xbb, 0x13, 0xe1, 0x01, 0x38, 0xf3, 0x08, 0x3c, 0x0c,
+	0x87, 0x1d, 0x77, 0xe4, 0x14, 0xa1, 0xf6, 0xb2, 0x73, 0x9e, 0x00, 0xd5, 0x36, 0x13, 0xa5, 0x22,
+	0x37, 0xfb, 0xef, 0xdf, 0xf7, 0xf7, 0xff, 0xfb, 0x6c, 0x7c, 0x04, 0x3c, 0x05, 0x9e, 0x70, 0x57,
+	0xc0, 0x9c, 0x65, 0x53, 0x3a, 0x11, 0x50, 0x94, 0xee, 0xc5, 0x30, 0x64, 0x82, 0x0e, 0xdd, 0x98,
+	0x65, 0x8c, 0x27, 0xdc, 0xc9, 0x0b, 0x10, 0x40, 0x1e, 0x69, 0xd6, 0xf9, 0x9b, 0x75, 0x34, 0xdb,
+	0xbb, 0x1f, 0x43, 0x0c, 0x12, 0x74, 0xd7, 0x2b, 0x55, 0xd3, 0x3b, 0x6e, 0xf4, 0xa7, 0x0b, 0x31,
+	0x83, 0x22, 0x11, 0xe5, 0x7b, 0x26, 0x68, 0x44, 0x05, 0xd5, 0x55, 0x2f, 0x1a, 0xab, 0x72, 0x5a,
+	0xd0, 0x54, 0x87, 0xb2, 0x7f, 0x20, 0xbc, 0xff, 0x56, 0xc5, 0x3c, 0x13, 0x54, 0x30, 0xe2, 0xe1,
+	0x1d, 0x05, 0x18, 0xa8, 0x8f, 0x06, 0x7b, 0xa3, 0x67, 0x4e, 0x53, 0x6c, 0xe7, 0x83, 0x64, 0xbd,
+	0xee, 0x65, 0x65, 0xb5, 0x7c, 0x5d, 0x49, 0x72, 0x7c, 0xa0, 0xb9, 0x20, 0x62, 0x19, 0xa4, 0xdc,
+	0x68, 0xf7, 0x3b, 0x83, 0xbd, 0xd1, 0x51, 0xb3, 0x97, 0xce, 0x71, 0xba, 0x2e, 0xf1, 0x1e, 0xaf,
+	0x1d, 0xeb, 0xca, 0x3a, 0x2c, 0x69, 0x7a, 0x7e, 0x62, 0x6f, 0xfa, 0xd9, 0xfe, 0x6d, 0x2d, 0x9c,
+	0xaa, 0xfd, 0x75, 0xfb, 0xa6, 0x0d, 0xa9, 0x90, 0xe7, 0xf8, 0x96, 0x44, 0x65, 0x17, 0xbb, 0xde,
+	0xdd, 0xba, 0xb2, 0xf6, 0x95, 0x93, 0x94, 0x6d, 0x5f, 0x1d, 0x93, 0xaf, 0x08, 0x93, 0x9b, 0x31,
+	0x06, 0xa9, 0x9e, 0xa3, 0xd1, 0x96, 0xbd, 0x1f, 0x37, 0xe7, 0x95, 0x37, 0x8d, 0xff, 0x7d, 0x03,
+	0xef, 0x89, 0x4e, 0xfe, 0x50, 0xdd, 0xb7, 0xed, 0x6e, 0xfb, 0xf7, 0xb6, 0x5e, 0x8e, 0x7c, 0xc2,
+	0x46, 0xc8, 0xa6, 0x50, 0xb0, 0x80, 0xb3, 0x2c, 0x0a, 0x66, 0x00, 0xf3, 0x80, 0x46, 0x51, 0xc1,
+	0x38, 0x37, 0x3a, 0xb2, 0x87, 0xa7, 0x75, 0x65, 0x59, 0xca, 0xf3, 0x7f, 0xa4, 0xed, 0x1f, 0xaa,
+	0xa3, 0x33, 0x96, 0x45, 0xef, 0x00, 0xe6, 0x63, 0xa5, 0x93, 0x37, 0xf8, 0xce, 0xb4, 0x80, 0x2f,
+	0x2c, 0x0b, 0xe8, 0x64, 0x02, 0x8b, 0x4c, 0x70, 0xa3, 0xdb, 0xef, 0x0c, 0x76, 0xbd, 0x5e, 0x5d,
+	0x59, 0x0f, 0xf4, 0x88, 0x37, 0x01, 0xdb, 0x3f, 0x50, 0xca, 0x58, 0x0b, 0x27, 0xdd, 0xeb, 0xef,
+	0x16, 0xf2, 0xfc, 0xcb, 0xa5, 0x89, 0xae, 0x96, 0x26, 0xfa, 0xb5, 0x34, 0xd1, 0xb7, 0x95, 0xd9,
+	0xba, 0x5a, 0x99, 0xad, 0x9f, 0x2b, 0xb3, 0xf5, 0xf1, 0x75, 0x9c, 0x88, 0xd9, 0x22, 0x74, 0x26,
+	0x90, 0xba, 0x7a, 0x70, 0x2f, 0xcf, 0x69, 0xc8, 0xff, 0x6c, 0xdc, 0x8b, 0xe1, 0xc8, 0xfd, 0xbc,
+	0xf9, 0x29, 0x45, 0x99, 0x33, 0x1e, 0xee, 0xc8, 0xcf, 0xf8, 0xea, 0xf7, 0x00, 0xce, 0xb1, 0x8a,
+	0x8c, 0x4f, 0x03, 0x00, 0x00,
 }
 
 func (this *GenesisDenom) Equal(that interface{}) bool {
@@ -193,6 +218,17 @@ func (this *GenesisDenom) Equal(that interface{}) bool {
 	if !this.AuthorityMetadata.Equal(&that1.AuthorityMetadata) {
 		return false
 	}
+	if this.BeforeSendHookAddress != that1.BeforeSendHookAddress {
+		return false
+	}
+	if len(this.FrozenAccounts) != len(that1.FrozenAccounts) {
+		return false
+	}
+	for i := range this.FrozenAccounts {
+		if this.FrozenAccounts[i] != that1.FrozenAccounts[i] {
+			return false
+		}
+	}
 	return true
 }
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -262,6 +298,22 @@ func (m *GenesisDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FrozenAccounts) > 0 {
+		for iNdEx := len(m.FrozenAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FrozenAccounts[iNdEx])
+			copy(dAtA[i:], m.FrozenAccounts[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.FrozenAccounts[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.BeforeSendHookAddress) > 0 {
+		i -= len(m.BeforeSendHookAddress)
+		copy(dAtA[i:], m.BeforeSendHookAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.BeforeSendHookAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	{
 		size, err := m.AuthorityMetadata.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -322,6 +374,16 @@ func (m *GenesisDenom) Size() (n int) {
 	}
 	l = m.AuthorityMetadata.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	l = len(m.BeforeSendHookAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.FrozenAccounts) > 0 {
+		for _, s := range m.FrozenAccounts {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -542,6 +604,70 @@ func (m *GenesisDenom) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeforeSendHookAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BeforeSendHookAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenAccounts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrozenAccounts = append(m.FrozenAccounts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
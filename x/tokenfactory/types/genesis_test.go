@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/osmosis-labs/osmosis/v12/x/tokenfactory/types"
@@ -126,6 +127,83 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "frozen accounts with the freeze capability",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin:        "osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44",
+							Capabilities: []string{types.CapabilityForceTransfer, types.CapabilityFreeze},
+						},
+						FrozenAccounts: []string{"osmo15czt5nhlnvayqq37xun9s9yus0d6y26dw9xnzn"},
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "unsupported capability",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin:        "osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44",
+							Capabilities: []string{"clawback"},
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "frozen accounts without the freeze capability",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin:        "osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44",
+							Capabilities: []string{types.CapabilityForceTransfer},
+						},
+						FrozenAccounts: []string{"osmo15czt5nhlnvayqq37xun9s9yus0d6y26dw9xnzn"},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid frozen account",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin:        "osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44",
+							Capabilities: []string{types.CapabilityFreeze},
+						},
+						FrozenAccounts: []string{"moose"},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "denom creation fee burn fraction above one",
+			genState: &types.GenesisState{
+				Params: types.NewParams(sdk.NewCoins(), sdk.NewDecWithPrec(11, 1)),
+			},
+			valid: false,
+		},
+		{
+			desc: "negative denom creation fee burn fraction",
+			genState: &types.GenesisState{
+				Params: types.NewParams(sdk.NewCoins(), sdk.NewDec(-1)),
+			},
+			valid: false,
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			err := tc.genState.Validate()
@@ -26,6 +26,8 @@ const KeySeparator = "|"
 
 var (
 	DenomAuthorityMetadataKey = "authoritymetadata"
+	BeforeSendHookAddressKey  = "beforesendhook"
+	FrozenAccountPrefixKey    = "frozen"
 	DenomsPrefixKey           = "denoms"
 	CreatorPrefixKey          = "creator"
 	AdminPrefixKey            = "admin"
@@ -47,3 +49,15 @@ func GetCreatorPrefix(creator string) []byte {
 func GetCreatorsPrefix() []byte {
 	return []byte(strings.Join([]string{CreatorPrefixKey, ""}, KeySeparator))
 }
+
+// GetFrozenAccountPrefix returns the prefix, within the store of a denom, where the accounts frozen
+// for the denom are stored
+func GetFrozenAccountPrefix() []byte {
+	return []byte(strings.Join([]string{FrozenAccountPrefixKey, ""}, KeySeparator))
+}
+
+// GetFrozenAccountKey returns the key, within the store of a denom, that is set if the account is
+// frozen for the denom
+func GetFrozenAccountKey(address string) []byte {
+	return []byte(strings.Join([]string{FrozenAccountPrefixKey, address}, KeySeparator))
+}
@@ -58,6 +58,32 @@ func TestAuthzMsg(t *testing.T) {
 				NewAdmin: "osmo1q8tq5qhrhw6t970egemuuwywhlhpnmdmts6xnu",
 			},
 		},
+		{
+			name: "MsgForceTransfer",
+			msg: &types.MsgForceTransfer{
+				Sender:              addr1,
+				Amount:              coin,
+				TransferFromAddress: "osmo1q8tq5qhrhw6t970egemuuwywhlhpnmdmts6xnu",
+				TransferToAddress:   addr1,
+			},
+		},
+		{
+			name: "MsgFreeze",
+			msg: &types.MsgFreeze{
+				Sender:  addr1,
+				Denom:   "denom",
+				Address: "osmo1q8tq5qhrhw6t970egemuuwywhlhpnmdmts6xnu",
+				Frozen:  true,
+			},
+		},
+		{
+			name: "MsgSetBeforeSendHook",
+			msg: &types.MsgSetBeforeSendHook{
+				Sender:          addr1,
+				Denom:           "denom",
+				CosmwasmAddress: "osmo1q8tq5qhrhw6t970egemuuwywhlhpnmdmts6xnu",
+			},
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -120,6 +146,46 @@ func TestMsgCreateDenom(t *testing.T) {
 			}),
 			expectPass: false,
 		},
+		{
+			name: "fee denom",
+			msg: createMsg(func(msg types.MsgCreateDenom) types.MsgCreateDenom {
+				msg.FeeDenom = "uion"
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid fee denom",
+			msg: createMsg(func(msg types.MsgCreateDenom) types.MsgCreateDenom {
+				msg.FeeDenom = "!ion"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "capabilities",
+			msg: createMsg(func(msg types.MsgCreateDenom) types.MsgCreateDenom {
+				msg.Capabilities = []string{types.CapabilityForceTransfer, types.CapabilityFreeze}
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "unsupported capability",
+			msg: createMsg(func(msg types.MsgCreateDenom) types.MsgCreateDenom {
+				msg.Capabilities = []string{"clawback"}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "duplicate capability",
+			msg: createMsg(func(msg types.MsgCreateDenom) types.MsgCreateDenom {
+				msg.Capabilities = []string{types.CapabilityFreeze, types.CapabilityFreeze}
+				return msg
+			}),
+			expectPass: false,
+		},
 	}
 
 	for _, test := range tests {
@@ -453,3 +519,263 @@ func TestMsgSetDenomMetadata(t *testing.T) {
 		}
 	}
 }
+
+// TestMsgSetBeforeSendHook tests if valid/invalid set before send hook messages are properly validated/invalidated
+func TestMsgSetBeforeSendHook(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	pk2 := ed25519.GenPrivKey().PubKey()
+	addr2 := sdk.AccAddress(pk2.Address())
+	tokenFactoryDenom := fmt.Sprintf("factory/%s/bitcoin", addr1.String())
+
+	// make a proper setBeforeSendHook message
+	baseMsg := types.NewMsgSetBeforeSendHook(
+		addr1.String(),
+		tokenFactoryDenom,
+		addr2.String(),
+	)
+
+	// validate setBeforeSendHook message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "set_before_send_hook")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() *types.MsgSetBeforeSendHook
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() *types.MsgSetBeforeSendHook {
+				msg := baseMsg
+				return msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty cosmwasm address removes the hook",
+			msg: func() *types.MsgSetBeforeSendHook {
+				msg := *baseMsg
+				msg.CosmwasmAddress = ""
+				return &msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty sender",
+			msg: func() *types.MsgSetBeforeSendHook {
+				msg := *baseMsg
+				msg.Sender = ""
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid cosmwasm address",
+			msg: func() *types.MsgSetBeforeSendHook {
+				msg := *baseMsg
+				msg.CosmwasmAddress = "invalid"
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: func() *types.MsgSetBeforeSendHook {
+				msg := *baseMsg
+				msg.Denom = "bitcoin"
+				return &msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+// TestMsgForceTransfer tests if valid/invalid force transfer messages are properly validated/invalidated
+func TestMsgForceTransfer(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	pk2 := ed25519.GenPrivKey().PubKey()
+	addr2 := sdk.AccAddress(pk2.Address())
+	tokenFactoryDenom := fmt.Sprintf("factory/%s/bitcoin", addr1.String())
+
+	// make a proper forceTransfer message
+	baseMsg := types.NewMsgForceTransfer(
+		addr1.String(),
+		sdk.NewInt64Coin(tokenFactoryDenom, 10),
+		addr2.String(),
+		addr1.String(),
+	)
+
+	// validate forceTransfer message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "force_transfer")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() *types.MsgForceTransfer
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() *types.MsgForceTransfer {
+				msg := baseMsg
+				return msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty sender",
+			msg: func() *types.MsgForceTransfer {
+				msg := *baseMsg
+				msg.Sender = ""
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid transfer from address",
+			msg: func() *types.MsgForceTransfer {
+				msg := *baseMsg
+				msg.TransferFromAddress = "invalid"
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid transfer to address",
+			msg: func() *types.MsgForceTransfer {
+				msg := *baseMsg
+				msg.TransferToAddress = ""
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "zero amount",
+			msg: func() *types.MsgForceTransfer {
+				msg := *baseMsg
+				msg.Amount = sdk.NewInt64Coin(tokenFactoryDenom, 0)
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: func() *types.MsgForceTransfer {
+				msg := *baseMsg
+				msg.Amount = sdk.NewInt64Coin("bitcoin", 10)
+				return &msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+// TestMsgFreeze tests if valid/invalid freeze messages are properly validated/invalidated
+func TestMsgFreeze(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	pk2 := ed25519.GenPrivKey().PubKey()
+	addr2 := sdk.AccAddress(pk2.Address())
+	tokenFactoryDenom := fmt.Sprintf("factory/%s/bitcoin", addr1.String())
+
+	// make a proper freeze message
+	baseMsg := types.NewMsgFreeze(
+		addr1.String(),
+		tokenFactoryDenom,
+		addr2.String(),
+		true,
+	)
+
+	// validate freeze message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "freeze")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() *types.MsgFreeze
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() *types.MsgFreeze {
+				msg := baseMsg
+				return msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "unfreeze",
+			msg: func() *types.MsgFreeze {
+				msg := *baseMsg
+				msg.Frozen = false
+				return &msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty sender",
+			msg: func() *types.MsgFreeze {
+				msg := *baseMsg
+				msg.Sender = ""
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "empty address",
+			msg: func() *types.MsgFreeze {
+				msg := *baseMsg
+				msg.Address = ""
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: func() *types.MsgFreeze {
+				msg := *baseMsg
+				msg.Denom = "bitcoin"
+				return &msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
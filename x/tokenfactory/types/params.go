@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	appparams "github.com/osmosis-labs/osmosis/v12/app/params"
 
@@ -11,24 +12,31 @@ import (
 
 // Parameter store keys.
 var (
-	KeyDenomCreationFee = []byte("DenomCreationFee")
+	KeyDenomCreationFee             = []byte("DenomCreationFee")
+	KeyDenomCreationFeeBurnFraction = []byte("DenomCreationFeeBurnFraction")
 )
 
+// DenomCreationFeeTwapWindow is the window of the arithmetic TWAP used to convert the denom creation fee
+// to fee tokens, so that the fee can not be lowered by moving the spot price of a pool.
+const DenomCreationFeeTwapWindow = time.Hour
+
 // ParamTable for gamm module.
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
-func NewParams(denomCreationFee sdk.Coins) Params {
+func NewParams(denomCreationFee sdk.Coins, denomCreationFeeBurnFraction sdk.Dec) Params {
 	return Params{
-		DenomCreationFee: denomCreationFee,
+		DenomCreationFee:             denomCreationFee,
+		DenomCreationFeeBurnFraction: denomCreationFeeBurnFraction,
 	}
 }
 
 // default gamm module parameters.
 func DefaultParams() Params {
 	return Params{
-		DenomCreationFee: sdk.NewCoins(sdk.NewInt64Coin(appparams.BaseCoinUnit, 10_000_000)), // 10 OSMO
+		DenomCreationFee:             sdk.NewCoins(sdk.NewInt64Coin(appparams.BaseCoinUnit, 10_000_000)), // 10 OSMO
+		DenomCreationFeeBurnFraction: sdk.ZeroDec(),                                                      // all to the community pool
 	}
 }
 
@@ -38,6 +46,10 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateDenomCreationFeeBurnFraction(p.DenomCreationFeeBurnFraction); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -45,6 +57,7 @@ func (p Params) Validate() error {
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyDenomCreationFee, &p.DenomCreationFee, validateDenomCreationFee),
+		paramtypes.NewParamSetPair(KeyDenomCreationFeeBurnFraction, &p.DenomCreationFeeBurnFraction, validateDenomCreationFeeBurnFraction),
 	}
 }
 
@@ -60,3 +73,21 @@ func validateDenomCreationFee(i interface{}) error {
 
 	return nil
 }
+
+func validateDenomCreationFeeBurnFraction(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// an unset burn fraction, as in genesis files from before it was added, is stored as zero
+	if v.IsNil() {
+		return nil
+	}
+
+	if v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("denom creation fee burn fraction must be between 0 and 1: %s", v)
+	}
+
+	return nil
+}
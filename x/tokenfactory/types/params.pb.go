@@ -28,7 +28,12 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the tokenfactory module.
 type Params struct {
+	// denom_creation_fee is the fee for creating a denom. Its amount in the
+	// x/txfees base denom can be paid in the equivalent value of any fee token.
 	DenomCreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=denom_creation_fee,json=denomCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"denom_creation_fee" yaml:"denom_creation_fee"`
+	// denom_creation_fee_burn_fraction is the fraction of the denom creation
+	// fee that is burned, with the rest funding the community pool.
+	DenomCreationFeeBurnFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=denom_creation_fee_burn_fraction,json=denomCreationFeeBurnFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"denom_creation_fee_burn_fraction" yaml:"denom_creation_fee_burn_fraction"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -80,27 +85,30 @@ func init() {
 }
 
 var fileDescriptor_cc8299d306f3ff47 = []byte{
-	// 309 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0xbf, 0x4e, 0xf3, 0x30,
-	0x14, 0xc5, 0x63, 0x7d, 0x52, 0x87, 0x7e, 0x0b, 0xaa, 0x18, 0x68, 0x85, 0x1c, 0x94, 0xa9, 0x0c,
-	0xb5, 0x95, 0xc2, 0x80, 0x18, 0x5b, 0x89, 0xad, 0x12, 0xea, 0xc8, 0x12, 0xdd, 0x24, 0x6e, 0x6a,
-	0xb5, 0xc9, 0x8d, 0x62, 0xb7, 0x22, 0x6f, 0xc1, 0xc4, 0xce, 0xca, 0x93, 0x74, 0xec, 0xc8, 0x54,
-	0x50, 0xf2, 0x06, 0x3c, 0x01, 0xaa, 0xe3, 0xa2, 0x22, 0x24, 0x26, 0xfb, 0xe8, 0x9e, 0xf3, 0xbb,
-	0x7f, 0xda, 0x97, 0xa8, 0x52, 0x54, 0x52, 0x71, 0x8d, 0x0b, 0x91, 0xcd, 0x20, 0xd2, 0x58, 0x94,
-	0x7c, 0xed, 0x87, 0x42, 0x83, 0xcf, 0x73, 0x28, 0x20, 0x55, 0x2c, 0x2f, 0x50, 0x63, 0xe7, 0xdc,
-	0x5a, 0xd9, 0xb1, 0x95, 0x59, 0x6b, 0xef, 0x34, 0xc1, 0x04, 0x8d, 0x91, 0xef, 0x7f, 0x4d, 0xa6,
-	0x77, 0xfd, 0x27, 0x1e, 0x56, 0x7a, 0x8e, 0x85, 0xd4, 0xe5, 0x44, 0x68, 0x88, 0x41, 0x83, 0x4d,
-	0x75, 0x23, 0x13, 0x0b, 0x1a, 0x5c, 0x23, 0x6c, 0x89, 0x36, 0x8a, 0x87, 0xa0, 0xc4, 0x37, 0x27,
-	0x42, 0x99, 0x35, 0x75, 0xef, 0x85, 0xb4, 0x5b, 0xf7, 0x66, 0xea, 0xce, 0x33, 0x69, 0x77, 0x62,
-	0x91, 0x61, 0x1a, 0x44, 0x85, 0x00, 0x2d, 0x31, 0x0b, 0x66, 0x42, 0x9c, 0x91, 0x8b, 0x7f, 0xfd,
-	0xff, 0xc3, 0x2e, 0xb3, 0xd8, 0x3d, 0xe8, 0xb0, 0x04, 0x1b, 0xa3, 0xcc, 0x46, 0x93, 0xcd, 0xce,
-	0x75, 0x3e, 0x77, 0x6e, 0xb7, 0x84, 0x74, 0x79, 0xeb, 0xfd, 0x46, 0x78, 0xaf, 0xef, 0x6e, 0x3f,
-	0x91, 0x7a, 0xbe, 0x0a, 0x59, 0x84, 0xa9, 0x1d, 0xd0, 0x3e, 0x03, 0x15, 0x2f, 0xb8, 0x2e, 0x73,
-	0xa1, 0x0c, 0x4d, 0x4d, 0x4f, 0x0c, 0x60, 0x6c, 0xf3, 0x77, 0x42, 0x8c, 0xa6, 0x9b, 0x8a, 0x92,
-	0x6d, 0x45, 0xc9, 0x47, 0x45, 0xc9, 0x53, 0x4d, 0x9d, 0x6d, 0x4d, 0x9d, 0xb7, 0x9a, 0x3a, 0x0f,
-	0x37, 0x47, 0x54, 0x7b, 0xb9, 0xc1, 0x12, 0x42, 0x75, 0x10, 0x7c, 0xed, 0x0f, 0xf9, 0xe3, 0xcf,
-	0x63, 0x9a, 0x5e, 0x61, 0xcb, 0xac, 0x7f, 0xf5, 0x15, 0x00, 0x00, 0xff, 0xff, 0xa0, 0x89, 0xb8,
-	0xda, 0xd0, 0x01, 0x00, 0x00,
+	// 362 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0x31, 0x4f, 0xc2, 0x40,
+	0x14, 0xc7, 0x5b, 0x4c, 0x48, 0xac, 0x8b, 0x69, 0x1c, 0x80, 0x90, 0x96, 0x74, 0x50, 0x1c, 0xe8,
+	0x05, 0x74, 0x30, 0x8e, 0xc5, 0xb0, 0x91, 0x18, 0x36, 0x5d, 0x9a, 0x6b, 0x39, 0xa0, 0x81, 0xf6,
+	0x91, 0xbb, 0x2b, 0xb1, 0xdf, 0xc2, 0xc9, 0xdd, 0xd5, 0xef, 0x61, 0xc2, 0xc8, 0x68, 0x1c, 0xaa,
+	0x81, 0x6f, 0xc0, 0x27, 0x30, 0xbd, 0x1e, 0x06, 0x24, 0x1a, 0xa7, 0xf6, 0xdd, 0x7b, 0xff, 0xdf,
+	0xfd, 0x2e, 0x4f, 0x3b, 0x07, 0x16, 0x02, 0x0b, 0x18, 0xe2, 0x30, 0x26, 0xd1, 0x00, 0xfb, 0x1c,
+	0x68, 0x82, 0x66, 0x4d, 0x8f, 0x70, 0xdc, 0x44, 0x53, 0x4c, 0x71, 0xc8, 0xec, 0x29, 0x05, 0x0e,
+	0x7a, 0x55, 0x8e, 0xda, 0xdb, 0xa3, 0xb6, 0x1c, 0xad, 0x9c, 0x0c, 0x61, 0x08, 0x62, 0x10, 0x65,
+	0x7f, 0x79, 0xa6, 0x72, 0xf9, 0x27, 0x1e, 0xc7, 0x7c, 0x04, 0x34, 0xe0, 0x49, 0x97, 0x70, 0xdc,
+	0xc7, 0x1c, 0xcb, 0x54, 0xd9, 0x17, 0x31, 0x37, 0xc7, 0xe5, 0x85, 0x6c, 0x19, 0x79, 0x85, 0x3c,
+	0xcc, 0xc8, 0x37, 0xc7, 0x87, 0x20, 0xca, 0xfb, 0xd6, 0x6b, 0x41, 0x2b, 0xde, 0x0a, 0x6b, 0xfd,
+	0x49, 0xd5, 0xf4, 0x3e, 0x89, 0x20, 0x74, 0x7d, 0x4a, 0x30, 0x0f, 0x20, 0x72, 0x07, 0x84, 0x94,
+	0xd4, 0xda, 0x41, 0xfd, 0xa8, 0x55, 0xb6, 0x25, 0x36, 0x03, 0x6d, 0x1e, 0x61, 0xb7, 0x21, 0x88,
+	0x9c, 0xee, 0x3c, 0x35, 0x95, 0x75, 0x6a, 0x96, 0x13, 0x1c, 0x4e, 0xae, 0xad, 0x7d, 0x84, 0xf5,
+	0xf2, 0x61, 0xd6, 0x87, 0x01, 0x1f, 0xc5, 0x9e, 0xed, 0x43, 0x28, 0x05, 0xe5, 0xa7, 0xc1, 0xfa,
+	0x63, 0xc4, 0x93, 0x29, 0x61, 0x82, 0xc6, 0x7a, 0xc7, 0x02, 0xd0, 0x96, 0xf9, 0x0e, 0x21, 0xfa,
+	0xb3, 0xaa, 0xd5, 0xf6, 0xa9, 0xae, 0x17, 0xd3, 0xc8, 0x1d, 0x50, 0xec, 0x67, 0x27, 0xa5, 0x42,
+	0x4d, 0xad, 0x1f, 0x3a, 0x77, 0x99, 0xcb, 0x7b, 0x6a, 0x9e, 0xfe, 0xe3, 0xba, 0x1b, 0xe2, 0xaf,
+	0x53, 0xf3, 0xec, 0x37, 0xeb, 0x5d, 0xbe, 0xd5, 0xab, 0xfe, 0xf4, 0x72, 0x62, 0x1a, 0x75, 0x64,
+	0xdb, 0xe9, 0xcd, 0x97, 0x86, 0xba, 0x58, 0x1a, 0xea, 0xe7, 0xd2, 0x50, 0x1f, 0x57, 0x86, 0xb2,
+	0x58, 0x19, 0xca, 0xdb, 0xca, 0x50, 0xee, 0xaf, 0xb6, 0x54, 0xe4, 0x76, 0x1b, 0x13, 0xec, 0xb1,
+	0x4d, 0x81, 0x66, 0xcd, 0x16, 0x7a, 0xd8, 0x5d, 0xb8, 0x10, 0xf4, 0x8a, 0x62, 0x45, 0x17, 0x5f,
+	0x03, 0x00, 0x04, 0xc6, 0xcf, 0xd1, 0x74, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -123,6 +131,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.DenomCreationFeeBurnFraction.Size()
+		i -= size
+		if _, err := m.DenomCreationFeeBurnFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if len(m.DenomCreationFee) > 0 {
 		for iNdEx := len(m.DenomCreationFee) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -163,6 +181,8 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovParams(uint64(l))
 		}
 	}
+	l = m.DenomCreationFeeBurnFraction.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -235,6 +255,40 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomCreationFeeBurnFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DenomCreationFeeBurnFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
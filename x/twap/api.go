@@ -18,16 +18,17 @@ import (
 // the state machine will interpolate the accumulator values for those times
 // from the latest Twap accumulation record prior to the provided time.
 //
-// startTime must be within 48 hours of ctx.BlockTime(), if you need older TWAPs,
-// you will have to maintain the accumulator yourself.
+// Records are kept for the record history keep period (48 hours by default). For older
+// startTimes, the accumulator values are interpolated from downsampled checkpoint records instead,
+// which are kept hourly for 30 days and daily for a year. Past the record history keep period,
+// the twap is thus only as precise as the checkpoint resolution: a price change is accounted for
+// from the checkpoint following it.
 //
 // This function will error if:
 // * startTime > endTime
 // * endTime in the future
-// * startTime older than 48 hours OR pool creation
+// * startTime older than the kept records and checkpoints OR pool creation
 // * pool with id poolId does not exist, or does not contain quoteAssetDenom, baseAssetDenom
-//
-// N.B. If there is a notable use case, the state machine could maintain more historical records, e.g. at one per hour.
 func (k Keeper) GetArithmeticTwap(
 	ctx sdk.Context,
 	poolId uint64,
@@ -35,12 +36,206 @@ func (k Keeper) GetArithmeticTwap(
 	quoteAssetDenom string,
 	startTime time.Time,
 	endTime time.Time,
+) (sdk.Dec, error) {
+	return k.getTwap(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, endTime, computeArithmeticTwap)
+}
+
+// GetArithmeticTwapToNow returns GetArithmeticTwap on the input, with endTime being fixed to ctx.BlockTime()
+// This function does not mutate records.
+func (k Keeper) GetArithmeticTwapToNow(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+) (sdk.Dec, error) {
+	return k.getTwapToNow(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, computeArithmeticTwap)
+}
+
+// GetGeometricTwap returns a geometric time weighted average price.
+// The returned twap is the time weighted geometric mean of the price of:
+// * the base asset, in units of the quote asset (1 unit of base = x units of quote)
+// * from (startTime, endTime),
+// * as determined by prices from AMM pool `poolId`.
+//
+// Compared to the arithmetic TWAP, the geometric TWAP is less sensitive to
+// short lived price outliers, and the geometric TWAP of the quote asset in units of
+// the base asset is exactly the reciprocal of the one of the base asset in units of the quote asset.
+//
+// The same time range restrictions and errors as GetArithmeticTwap apply.
+func (k Keeper) GetGeometricTwap(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+	endTime time.Time,
+) (sdk.Dec, error) {
+	return k.getTwap(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, endTime, computeGeometricTwap)
+}
+
+// GetGeometricTwapToNow returns GetGeometricTwap on the input, with endTime being fixed to ctx.BlockTime()
+// This function does not mutate records.
+func (k Keeper) GetGeometricTwapToNow(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+) (sdk.Dec, error) {
+	return k.getTwapToNow(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, computeGeometricTwap)
+}
+
+// GetTwapMetadata returns metadata about the records the twap of the pool `poolId`
+// and the given assets from (startTime, endTime) is computed from:
+// * the height and time of the most recent record, i.e. when the pool's price last changed,
+// * the last spot price error time as of endTime, and whether it is within (startTime, endTime).
+// In the latter case, getting the twap returns it along with types.ErrSpotPriceErrorInWindow.
+//
+// The same time range restrictions and errors as GetArithmeticTwap apply.
+func (k Keeper) GetTwapMetadata(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+	endTime time.Time,
+) (types.TwapMetadata, error) {
+	if startTime.After(endTime) {
+		return types.TwapMetadata{}, types.StartTimeAfterEndTimeError{StartTime: startTime, EndTime: endTime}
+	}
+	if endTime.After(ctx.BlockTime()) {
+		return types.TwapMetadata{}, types.EndTimeInFutureError{EndTime: endTime, BlockTime: ctx.BlockTime()}
+	}
+	lastRecord, err := k.getMostRecentRecordStoreRepresentation(ctx, poolId, baseAssetDenom, quoteAssetDenom)
+	if err != nil {
+		return types.TwapMetadata{}, err
+	}
+	endRecord := lastRecord
+	if endTime.Before(ctx.BlockTime()) {
+		endRecord, err = k.getInterpolatedRecord(ctx, poolId, endTime, baseAssetDenom, quoteAssetDenom)
+		if err != nil {
+			return types.TwapMetadata{}, err
+		}
+	}
+	return types.TwapMetadata{
+		LastRecordHeight: lastRecord.Height,
+		LastRecordTime:   lastRecord.Time,
+		LastErrorTime:    endRecord.LastErrorTime,
+		WindowHasError:   !endRecord.LastErrorTime.Before(startTime),
+	}, nil
+}
+
+// GetArithmeticTwapRouted returns an arithmetic time weighted average price across a route of pools,
+// for asset pairs without a pool holding both assets, e.g. an asset priced in USDC through OSMO.
+// The returned twap is the price of:
+// * the base asset, in units of the quote asset of the last hop of the route,
+// * from (startTime, endTime),
+// * as the product of the arithmetic twaps of every hop of the route, each as returned by GetArithmeticTwap.
+//
+// Note that the product of arithmetic twaps is not the arithmetic twap of the product of the prices.
+// GetGeometricTwapRouted does not have this caveat, as geometric means compose exactly.
+//
+// Along with the twap, this returns the twap and staleness of every hop. The staleness of a hop is
+// the time since its pool's price last changed.
+// The same time range restrictions and errors as GetArithmeticTwap apply to every hop,
+// and are returned as a types.RouteHopError describing the failing hop and its staleness.
+// As for GetArithmeticTwap, a spot price error in the time range of a hop returns the twap along with the error.
+func (k Keeper) GetArithmeticTwapRouted(
+	ctx sdk.Context,
+	baseAssetDenom string,
+	route []types.TwapRouteHop,
+	startTime time.Time,
+	endTime time.Time,
+) (sdk.Dec, []types.TwapHopInfo, error) {
+	return k.getTwapRouted(ctx, baseAssetDenom, route, startTime, endTime, computeArithmeticTwap)
+}
+
+// GetGeometricTwapRouted returns a geometric time weighted average price across a route of pools.
+// It is the product of the geometric twaps of every hop of the route, each as returned by GetGeometricTwap,
+// which is exactly the geometric twap of the product of the prices of every hop.
+//
+// The same return values and errors as GetArithmeticTwapRouted apply.
+func (k Keeper) GetGeometricTwapRouted(
+	ctx sdk.Context,
+	baseAssetDenom string,
+	route []types.TwapRouteHop,
+	startTime time.Time,
+	endTime time.Time,
+) (sdk.Dec, []types.TwapHopInfo, error) {
+	return k.getTwapRouted(ctx, baseAssetDenom, route, startTime, endTime, computeGeometricTwap)
+}
+
+// getTwapRouted computes a twap across the given route from startTime to endTime,
+// with the given compute function for every hop.
+func (k Keeper) getTwapRouted(
+	ctx sdk.Context,
+	baseAssetDenom string,
+	route []types.TwapRouteHop,
+	startTime time.Time,
+	endTime time.Time,
+	computeTwap computeTwapFn,
+) (sdk.Dec, []types.TwapHopInfo, error) {
+	if len(route) == 0 {
+		return sdk.Dec{}, nil, types.EmptyRouteError{}
+	}
+
+	twap := sdk.OneDec()
+	hops := make([]types.TwapHopInfo, 0, len(route))
+	// a spot price error in a hop still returns the twap, so we keep going to return the
+	// twap across the whole route, along with the first such error.
+	var spotPriceErr error
+	hopBaseAsset := baseAssetDenom
+	for i, hop := range route {
+		hopInfo := types.TwapHopInfo{PoolId: hop.PoolId, BaseAsset: hopBaseAsset, QuoteAsset: hop.QuoteAsset}
+		// if there is no record, getting the twap errors below, so we leave the staleness blank.
+		if record, err := k.getMostRecentRecordStoreRepresentation(ctx, hop.PoolId, hopBaseAsset, hop.QuoteAsset); err == nil {
+			hopInfo.LastRecordTime = record.Time
+			hopInfo.Staleness = ctx.BlockTime().Sub(record.Time)
+		}
+
+		hopTwap, err := k.getTwap(ctx, hop.PoolId, hopBaseAsset, hop.QuoteAsset, startTime, endTime, computeTwap)
+		if err != nil {
+			err = types.RouteHopError{
+				HopIndex:       i,
+				PoolId:         hop.PoolId,
+				BaseAsset:      hopBaseAsset,
+				QuoteAsset:     hop.QuoteAsset,
+				LastRecordTime: hopInfo.LastRecordTime,
+				Staleness:      hopInfo.Staleness,
+				Err:            err,
+			}
+			if hopTwap.IsNil() {
+				return sdk.Dec{}, hops, err
+			}
+			if spotPriceErr == nil {
+				spotPriceErr = err
+			}
+		}
+
+		hopInfo.Twap = hopTwap
+		hops = append(hops, hopInfo)
+		twap = twap.Mul(hopTwap)
+		hopBaseAsset = hop.QuoteAsset
+	}
+	return twap, hops, spotPriceErr
+}
+
+// getTwap computes a twap from startTime to endTime with the given compute function.
+func (k Keeper) getTwap(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+	endTime time.Time,
+	computeTwap computeTwapFn,
 ) (sdk.Dec, error) {
 	if startTime.After(endTime) {
 		return sdk.Dec{}, types.StartTimeAfterEndTimeError{StartTime: startTime, EndTime: endTime}
 	}
 	if endTime.Equal(ctx.BlockTime()) {
-		return k.GetArithmeticTwapToNow(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime)
+		return k.getTwapToNow(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, computeTwap)
 	} else if endTime.After(ctx.BlockTime()) {
 		return sdk.Dec{}, types.EndTimeInFutureError{EndTime: endTime, BlockTime: ctx.BlockTime()}
 	}
@@ -52,17 +247,17 @@ func (k Keeper) GetArithmeticTwap(
 	if err != nil {
 		return sdk.Dec{}, err
 	}
-	return computeArithmeticTwap(startRecord, endRecord, quoteAssetDenom)
+	return computeTwap(startRecord, endRecord, quoteAssetDenom)
 }
 
-// GetArithmeticTwapToNow returns GetArithmeticTwap on the input, with endTime being fixed to ctx.BlockTime()
-// This function does not mutate records.
-func (k Keeper) GetArithmeticTwapToNow(
+// getTwapToNow computes a twap from startTime to ctx.BlockTime() with the given compute function.
+func (k Keeper) getTwapToNow(
 	ctx sdk.Context,
 	poolId uint64,
 	baseAssetDenom string,
 	quoteAssetDenom string,
 	startTime time.Time,
+	computeTwap computeTwapFn,
 ) (sdk.Dec, error) {
 	if startTime.After(ctx.BlockTime()) {
 		return sdk.Dec{}, types.StartTimeAfterEndTimeError{StartTime: startTime, EndTime: ctx.BlockTime()}
@@ -76,7 +271,7 @@ func (k Keeper) GetArithmeticTwapToNow(
 	if err != nil {
 		return sdk.Dec{}, err
 	}
-	return computeArithmeticTwap(startRecord, endRecord, quoteAssetDenom)
+	return computeTwap(startRecord, endRecord, quoteAssetDenom)
 }
 
 // GetBeginBlockAccumulatorRecord returns a TwapRecord struct corresponding to the state of pool `poolId`
@@ -61,7 +61,7 @@ var (
 		OneSec.MulInt64(3),           // accum B
 		OneSec.MulInt64(20*10+10*10)) // accum C
 
-	spotPriceError = errors.New("twap: error in pool spot price occurred between start and end time, twap result may be faulty")
+	spotPriceError = types.ErrSpotPriceErrorInWindow
 )
 
 func (s *TestSuite) TestGetBeginBlockAccumulatorRecord() {
@@ -92,7 +92,7 @@ func (s *TestSuite) TestGetBeginBlockAccumulatorRecord() {
 		"idempotent overwrite2":                             {initStartRecord, recordWithUpdatedAccum(initStartRecord, OneSec, OneSec), tPlusOne, 1, denomA, denomB, nil},
 		"diff spot price": {
 			zeroAccumTenPoint1Record,
-			recordWithUpdatedAccum(zeroAccumTenPoint1Record, OneSec.MulInt64(10), OneSec.QuoInt64(10)),
+			withGeometricAccum(recordWithUpdatedAccum(zeroAccumTenPoint1Record, OneSec.MulInt64(10), OneSec.QuoInt64(10)), OneSec.Mul(logTen)),
 			tPlusOne, 1, denomA, denomB, nil,
 		},
 	}
@@ -787,3 +787,360 @@ func (s *TestSuite) TestGetArithmeticTwapToNow_ThreeAsset() {
 		})
 	}
 }
+
+func (s *TestSuite) TestGetGeometricTwap() {
+	// geometric accumulators updated from a record with
+	// t = baseTime, sp0 = 4 (log2(sp0) = 2), geometric accumulator = 0
+	geomBaseRecord := newTwoAssetPoolTwapRecordWithDefaults(baseTime, sdk.NewDec(4), sdk.ZeroDec(), sdk.ZeroDec())
+	// t = baseTime + 10, sp0 = 1 (log2(sp0) = 0), geometric accumulator = 10 seconds * 2
+	geomTPlus10sp1Record := withGeometricAccum(
+		newTwoAssetPoolTwapRecordWithDefaults(baseTime.Add(10*time.Second), sdk.OneDec(), OneSec.MulInt64(10*4), OneSec.MulInt64(10).QuoInt64(4)),
+		OneSec.MulInt64(10*2))
+
+	tests := map[string]struct {
+		recordsToSet []types.TwapRecord
+		ctxTime      time.Time
+		input        getTwapInput
+		expTwap      sdk.Dec
+		expectError  error
+	}{
+		"(1 record) start and end point to same record": {
+			recordsToSet: []types.TwapRecord{geomBaseRecord},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(baseTime, tPlusOne, baseQuoteAB),
+			expTwap:      sdk.NewDec(4),
+		},
+		"(1 record) start and end point to same record, use sp1": {
+			recordsToSet: []types.TwapRecord{geomBaseRecord},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(baseTime, tPlusOne, baseQuoteBA),
+			expTwap:      sdk.NewDecWithPrec(25, 2),
+		},
+		"(2 record) start exact, end after second record": {
+			recordsToSet: []types.TwapRecord{geomBaseRecord, geomTPlus10sp1Record},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(baseTime, baseTime.Add(20*time.Second), baseQuoteAB),
+			expTwap:      sdk.NewDec(2), // 4 for 10s, 1 for 10s
+		},
+		"(2 record) start exact, end after second record, sp1": {
+			recordsToSet: []types.TwapRecord{geomBaseRecord, geomTPlus10sp1Record},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(baseTime, baseTime.Add(20*time.Second), baseQuoteBA),
+			expTwap:      sdk.NewDecWithPrec(5, 1), // .25 for 10s, 1 for 10s
+		},
+		"(2 record) start exact, end time = now": {
+			recordsToSet: []types.TwapRecord{geomBaseRecord, geomTPlus10sp1Record},
+			ctxTime:      baseTime.Add(20 * time.Second),
+			input:        makeSimpleTwapInput(baseTime, baseTime.Add(20*time.Second), baseQuoteAB),
+			expTwap:      sdk.NewDec(2),
+		},
+		"start time after end time": {
+			recordsToSet: []types.TwapRecord{geomBaseRecord},
+			ctxTime:      baseTime,
+			input:        makeSimpleTwapInput(tPlusOne, baseTime, baseQuoteAB),
+			expectError:  types.StartTimeAfterEndTimeError{StartTime: tPlusOne, EndTime: baseTime},
+		},
+		"spot price error in record": {
+			recordsToSet: []types.TwapRecord{withLastErrTime(geomBaseRecord, tPlusOne)},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(baseTime, tPlusOneMin, baseQuoteAB),
+			expTwap:      sdk.NewDec(4),
+			expectError:  spotPriceError,
+		},
+	}
+	for name, test := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.preSetRecords(test.recordsToSet)
+			s.Ctx = s.Ctx.WithBlockTime(test.ctxTime)
+
+			twap, err := s.twapkeeper.GetGeometricTwap(s.Ctx, test.input.poolId,
+				test.input.quoteAssetDenom, test.input.baseAssetDenom,
+				test.input.startTime, test.input.endTime)
+
+			if test.expectError != nil {
+				s.Require().Error(err)
+				s.Require().Equal(test.expectError, err)
+				s.Require().Equal(test.expTwap, twap)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(test.expTwap, twap)
+
+			if test.input.endTime.Equal(test.ctxTime) {
+				twapToNow, err := s.twapkeeper.GetGeometricTwapToNow(s.Ctx, test.input.poolId,
+					test.input.quoteAssetDenom, test.input.baseAssetDenom, test.input.startTime)
+				s.Require().NoError(err)
+				s.Require().Equal(twap, twapToNow)
+			}
+		})
+	}
+}
+
+func (s *TestSuite) TestGetTwapMetadata() {
+	tPlus10 := baseTime.Add(10 * time.Second)
+	// a record with a spot price error at its time, at height 2.
+	tPlus10ErrRecord := withLastErrTime(tPlus10sp5Record, tPlus10)
+	tPlus10ErrRecord.Height = 2
+
+	tests := map[string]struct {
+		recordsToSet []types.TwapRecord
+		ctxTime      time.Time
+		input        getTwapInput
+		expMetadata  types.TwapMetadata
+		expectError  error
+	}{
+		"(1 record) no spot price error": {
+			recordsToSet: []types.TwapRecord{baseRecord},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(baseTime, tPlusOne, baseQuoteAB),
+			expMetadata:  types.TwapMetadata{LastRecordTime: baseTime},
+		},
+		"(2 record) spot price error in window, end time = now": {
+			recordsToSet: []types.TwapRecord{baseRecord, tPlus10ErrRecord},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(baseTime, tPlusOneMin, baseQuoteAB),
+			expMetadata:  types.TwapMetadata{LastRecordHeight: 2, LastRecordTime: tPlus10, LastErrorTime: tPlus10, WindowHasError: true},
+		},
+		"(2 record) spot price error in window, end time in past": {
+			recordsToSet: []types.TwapRecord{baseRecord, tPlus10ErrRecord},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(baseTime, baseTime.Add(20*time.Second), baseQuoteBA),
+			expMetadata:  types.TwapMetadata{LastRecordHeight: 2, LastRecordTime: tPlus10, LastErrorTime: tPlus10, WindowHasError: true},
+		},
+		"(2 record) spot price error at start time": {
+			recordsToSet: []types.TwapRecord{baseRecord, tPlus10ErrRecord},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(tPlus10, tPlusOneMin, baseQuoteAB),
+			expMetadata:  types.TwapMetadata{LastRecordHeight: 2, LastRecordTime: tPlus10, LastErrorTime: tPlus10, WindowHasError: true},
+		},
+		"(2 record) spot price error before window": {
+			recordsToSet: []types.TwapRecord{baseRecord, tPlus10ErrRecord},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(baseTime.Add(20*time.Second), tPlusOneMin, baseQuoteAB),
+			expMetadata:  types.TwapMetadata{LastRecordHeight: 2, LastRecordTime: tPlus10, LastErrorTime: tPlus10},
+		},
+		"(2 record) spot price error after window": {
+			recordsToSet: []types.TwapRecord{baseRecord, tPlus10ErrRecord},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(baseTime, baseTime.Add(5*time.Second), baseQuoteAB),
+			expMetadata:  types.TwapMetadata{LastRecordHeight: 2, LastRecordTime: tPlus10},
+		},
+		"start time after end time": {
+			recordsToSet: []types.TwapRecord{baseRecord},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(tPlusOne, baseTime, baseQuoteAB),
+			expectError:  types.StartTimeAfterEndTimeError{StartTime: tPlusOne, EndTime: baseTime},
+		},
+		"end time in future": {
+			recordsToSet: []types.TwapRecord{baseRecord},
+			ctxTime:      baseTime,
+			input:        makeSimpleTwapInput(baseTime, tPlusOne, baseQuoteAB),
+			expectError:  types.EndTimeInFutureError{EndTime: tPlusOne, BlockTime: baseTime},
+		},
+	}
+	for name, test := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.preSetRecords(test.recordsToSet)
+			s.Ctx = s.Ctx.WithBlockTime(test.ctxTime)
+
+			metadata, err := s.twapkeeper.GetTwapMetadata(s.Ctx, test.input.poolId,
+				test.input.quoteAssetDenom, test.input.baseAssetDenom,
+				test.input.startTime, test.input.endTime)
+
+			if test.expectError != nil {
+				s.Require().Error(err)
+				s.Require().Equal(test.expectError, err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(test.expMetadata, metadata)
+
+			// the metadata reports a spot price error in the window iff getting the twap returns one.
+			_, err = s.twapkeeper.GetArithmeticTwap(s.Ctx, test.input.poolId,
+				test.input.quoteAssetDenom, test.input.baseAssetDenom,
+				test.input.startTime, test.input.endTime)
+			s.Require().Equal(metadata.WindowHasError, errors.Is(err, types.ErrSpotPriceErrorInWindow))
+		})
+	}
+}
+
+// TestGetArithmeticTwap_Checkpoints tests that twaps with a start time older than the record history
+// keep period are served from checkpoints, and are equal to the twaps computed from the full record history.
+func (s *TestSuite) TestGetArithmeticTwap_Checkpoints() {
+	poolId, denomA, denomB := s.setupDefaultPool()
+
+	// change the spot price a few times, over several days.
+	for _, swapTime := range []time.Duration{26*time.Hour + 13*time.Minute, 3*24*time.Hour + 5*time.Hour, 6*24*time.Hour + 30*time.Minute} {
+		s.Ctx = s.Ctx.WithBlockTime(baseTime.Add(swapTime))
+		s.RunBasicSwap(poolId)
+		s.twapkeeper.EndBlock(s.Ctx)
+	}
+	s.Ctx = s.Ctx.WithBlockTime(baseTime.Add(10 * 24 * time.Hour))
+
+	startTimes := []time.Time{
+		baseTime.Add(12 * time.Hour),
+		baseTime.Add(4 * 24 * time.Hour),
+		baseTime.Add(6*24*time.Hour + 2*time.Hour),
+	}
+	expectedTwaps := []sdk.Dec{}
+	for _, startTime := range startTimes {
+		twap, err := s.twapkeeper.GetArithmeticTwapToNow(s.Ctx, poolId, denomA, denomB, startTime)
+		s.Require().NoError(err)
+		expectedTwaps = append(expectedTwaps, twap)
+	}
+
+	// only the most recent record is kept, so the first two start times are older than all records.
+	err := s.twapkeeper.PruneRecords(s.Ctx)
+	s.Require().NoError(err)
+	_, err = s.twapkeeper.GetRecordAtOrBeforeTime(s.Ctx, poolId, startTimes[1], denomA, denomB)
+	s.Require().Error(err)
+
+	for i, startTime := range startTimes {
+		twap, err := s.twapkeeper.GetArithmeticTwapToNow(s.Ctx, poolId, denomA, denomB, startTime)
+		s.Require().NoError(err)
+		s.Require().Equal(expectedTwaps[i], twap, "start time %s", startTime)
+	}
+}
+
+func (s *TestSuite) TestGetTwapRouted() {
+	// pool 1: A/B, sp0 = 4, so 1 A = 0.25 B.
+	// pool 2: B/C, sp0 = 2, so 1 B = 0.5 C.
+	poolOneRecord := newTwoAssetPoolTwapRecordWithDefaults(baseTime, sdk.NewDec(4), sdk.ZeroDec(), sdk.ZeroDec())
+	poolTwoRecord := newTwoAssetPoolTwapRecordWithDefaults(baseTime, sdk.NewDec(2), sdk.ZeroDec(), sdk.ZeroDec())
+	poolTwoRecord.PoolId, poolTwoRecord.Asset0Denom, poolTwoRecord.Asset1Denom = 2, denom1, denom2
+	ctxTime := tPlusOneMin
+	endTime := baseTime.Add(10 * time.Second)
+
+	hopInfo := func(poolId uint64, base, quote string, twap sdk.Dec) types.TwapHopInfo {
+		return types.TwapHopInfo{
+			PoolId: poolId, BaseAsset: base, QuoteAsset: quote, Twap: twap,
+			LastRecordTime: baseTime, Staleness: ctxTime.Sub(baseTime),
+		}
+	}
+
+	tests := map[string]struct {
+		recordsToSet   []types.TwapRecord
+		baseAsset      string
+		route          []types.TwapRouteHop
+		startTime      time.Time
+		expTwap        sdk.Dec
+		expHops        []types.TwapHopInfo
+		expectError    error
+		expectHopError *types.RouteHopError
+	}{
+		"single hop": {
+			recordsToSet: []types.TwapRecord{poolOneRecord, poolTwoRecord},
+			baseAsset:    denom0,
+			route:        []types.TwapRouteHop{{PoolId: 1, QuoteAsset: denom1}},
+			startTime:    baseTime,
+			expTwap:      sdk.NewDecWithPrec(25, 2),
+			expHops:      []types.TwapHopInfo{hopInfo(1, denom0, denom1, sdk.NewDecWithPrec(25, 2))},
+		},
+		"two hops": {
+			recordsToSet: []types.TwapRecord{poolOneRecord, poolTwoRecord},
+			baseAsset:    denom0,
+			route:        []types.TwapRouteHop{{PoolId: 1, QuoteAsset: denom1}, {PoolId: 2, QuoteAsset: denom2}},
+			startTime:    baseTime,
+			expTwap:      sdk.NewDecWithPrec(125, 3),
+			expHops: []types.TwapHopInfo{
+				hopInfo(1, denom0, denom1, sdk.NewDecWithPrec(25, 2)),
+				hopInfo(2, denom1, denom2, sdk.NewDecWithPrec(5, 1)),
+			},
+		},
+		"two hops, reversed": {
+			recordsToSet: []types.TwapRecord{poolOneRecord, poolTwoRecord},
+			baseAsset:    denom2,
+			route:        []types.TwapRouteHop{{PoolId: 2, QuoteAsset: denom1}, {PoolId: 1, QuoteAsset: denom0}},
+			startTime:    baseTime,
+			expTwap:      sdk.NewDec(8),
+			expHops: []types.TwapHopInfo{
+				hopInfo(2, denom2, denom1, sdk.NewDec(2)),
+				hopInfo(1, denom1, denom0, sdk.NewDec(4)),
+			},
+		},
+		"empty route": {
+			recordsToSet: []types.TwapRecord{poolOneRecord, poolTwoRecord},
+			baseAsset:    denom0,
+			route:        []types.TwapRouteHop{},
+			startTime:    baseTime,
+			expectError:  types.EmptyRouteError{},
+		},
+		"quote asset not in pool": {
+			recordsToSet: []types.TwapRecord{poolOneRecord, poolTwoRecord},
+			baseAsset:    denom0,
+			route:        []types.TwapRouteHop{{PoolId: 1, QuoteAsset: denom2}},
+			startTime:    baseTime,
+			expectHopError: &types.RouteHopError{
+				HopIndex: 0, PoolId: 1, BaseAsset: denom0, QuoteAsset: denom2,
+			},
+		},
+		"start time too old for second hop": {
+			recordsToSet: []types.TwapRecord{withTime(poolOneRecord, tMinOne), poolTwoRecord},
+			baseAsset:    denom0,
+			route:        []types.TwapRouteHop{{PoolId: 1, QuoteAsset: denom1}, {PoolId: 2, QuoteAsset: denom2}},
+			startTime:    tMinOne,
+			expectHopError: &types.RouteHopError{
+				HopIndex: 1, PoolId: 2, BaseAsset: denom1, QuoteAsset: denom2,
+				LastRecordTime: baseTime, Staleness: ctxTime.Sub(baseTime),
+				Err: twap.TimeTooOldError{Time: tMinOne},
+			},
+		},
+		"spot price error in second hop": {
+			recordsToSet: []types.TwapRecord{poolOneRecord, withLastErrTime(poolTwoRecord, baseTime)},
+			baseAsset:    denom0,
+			route:        []types.TwapRouteHop{{PoolId: 1, QuoteAsset: denom1}, {PoolId: 2, QuoteAsset: denom2}},
+			startTime:    baseTime,
+			expTwap:      sdk.NewDecWithPrec(125, 3),
+			expectHopError: &types.RouteHopError{
+				HopIndex: 1, PoolId: 2, BaseAsset: denom1, QuoteAsset: denom2,
+				LastRecordTime: baseTime, Staleness: ctxTime.Sub(baseTime),
+				Err: spotPriceError,
+			},
+		},
+	}
+	for name, test := range tests {
+		for _, isGeometric := range []bool{false, true} {
+			s.Run(fmt.Sprintf("%s, geometric: %t", name, isGeometric), func() {
+				s.SetupTest()
+				s.preSetRecords(test.recordsToSet)
+				s.Ctx = s.Ctx.WithBlockTime(ctxTime)
+
+				getTwapRouted := s.twapkeeper.GetArithmeticTwapRouted
+				if isGeometric {
+					getTwapRouted = s.twapkeeper.GetGeometricTwapRouted
+				}
+				twap, hops, err := getTwapRouted(s.Ctx, test.baseAsset, test.route, test.startTime, endTime)
+
+				if test.expectError != nil {
+					s.Require().Equal(test.expectError, err)
+					return
+				}
+				if test.expectHopError != nil {
+					var hopErr types.RouteHopError
+					s.Require().ErrorAs(err, &hopErr)
+					s.Require().Equal(test.expectHopError.HopIndex, hopErr.HopIndex)
+					s.Require().Equal(test.expectHopError.PoolId, hopErr.PoolId)
+					s.Require().Equal(test.expectHopError.BaseAsset, hopErr.BaseAsset)
+					s.Require().Equal(test.expectHopError.QuoteAsset, hopErr.QuoteAsset)
+					s.Require().Equal(test.expectHopError.LastRecordTime, hopErr.LastRecordTime)
+					s.Require().Equal(test.expectHopError.Staleness, hopErr.Staleness)
+					if test.expectHopError.Err != nil {
+						s.Require().Equal(test.expectHopError.Err, hopErr.Err)
+					}
+					if test.expTwap.IsNil() {
+						s.Require().True(twap.IsNil())
+						return
+					}
+				} else {
+					s.Require().NoError(err)
+				}
+				s.Require().Equal(test.expTwap, twap)
+				if test.expHops != nil {
+					s.Require().Equal(test.expHops, hops)
+				}
+			})
+		}
+	}
+}
@@ -30,6 +30,36 @@ func (q Querier) Params(grpcCtx context.Context,
 	return q.Q.Params(ctx, *req)
 }
 
+func (q Querier) GeometricTwapToNow(grpcCtx context.Context,
+	req *queryproto.GeometricTwapToNowRequest,
+) (*queryproto.GeometricTwapToNowResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.GeometricTwapToNow(ctx, *req)
+}
+
+func (q Querier) GeometricTwapRouted(grpcCtx context.Context,
+	req *queryproto.GeometricTwapRoutedRequest,
+) (*queryproto.GeometricTwapRoutedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.GeometricTwapRouted(ctx, *req)
+}
+
+func (q Querier) GeometricTwap(grpcCtx context.Context,
+	req *queryproto.GeometricTwapRequest,
+) (*queryproto.GeometricTwapResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.GeometricTwap(ctx, *req)
+}
+
 func (q Querier) ArithmeticTwapToNow(grpcCtx context.Context,
 	req *queryproto.ArithmeticTwapToNowRequest,
 ) (*queryproto.ArithmeticTwapToNowResponse, error) {
@@ -40,6 +70,16 @@ func (q Querier) ArithmeticTwapToNow(grpcCtx context.Context,
 	return q.Q.ArithmeticTwapToNow(ctx, *req)
 }
 
+func (q Querier) ArithmeticTwapRouted(grpcCtx context.Context,
+	req *queryproto.ArithmeticTwapRoutedRequest,
+) (*queryproto.ArithmeticTwapRoutedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.ArithmeticTwapRouted(ctx, *req)
+}
+
 func (q Querier) ArithmeticTwap(grpcCtx context.Context,
 	req *queryproto.ArithmeticTwapRequest,
 ) (*queryproto.ArithmeticTwapResponse, error) {
@@ -1,12 +1,14 @@
 package client
 
 import (
+	"errors"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/v12/x/twap"
 	"github.com/osmosis-labs/osmosis/v12/x/twap/client/queryproto"
+	"github.com/osmosis-labs/osmosis/v12/x/twap/types"
 )
 
 // This file should evolve to being code gen'd, off of `proto/twap/v1beta/query.yml`
@@ -18,19 +20,79 @@ type Querier struct {
 func (q Querier) ArithmeticTwap(ctx sdk.Context,
 	req queryproto.ArithmeticTwapRequest,
 ) (*queryproto.ArithmeticTwapResponse, error) {
-	if (req.EndTime == nil || *req.EndTime == time.Time{}) {
-		*req.EndTime = ctx.BlockTime()
+	endTime := ctx.BlockTime()
+	if req.EndTime != nil && !req.EndTime.IsZero() {
+		endTime = *req.EndTime
 	}
 
-	twap, err := q.K.GetArithmeticTwap(ctx, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime, *req.EndTime)
-	return &queryproto.ArithmeticTwapResponse{ArithmeticTwap: twap}, err
+	twap, err := q.K.GetArithmeticTwap(ctx, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime, endTime)
+	metadata, err := q.twapMetadata(ctx, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime, endTime, req.Lenient, err)
+	return &queryproto.ArithmeticTwapResponse{ArithmeticTwap: twap, Metadata: metadata}, err
 }
 
 func (q Querier) ArithmeticTwapToNow(ctx sdk.Context,
 	req queryproto.ArithmeticTwapToNowRequest,
 ) (*queryproto.ArithmeticTwapToNowResponse, error) {
 	twap, err := q.K.GetArithmeticTwapToNow(ctx, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime)
-	return &queryproto.ArithmeticTwapToNowResponse{ArithmeticTwap: twap}, err
+	metadata, err := q.twapMetadata(ctx, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime, ctx.BlockTime(), req.Lenient, err)
+	return &queryproto.ArithmeticTwapToNowResponse{ArithmeticTwap: twap, Metadata: metadata}, err
+}
+
+func (q Querier) GeometricTwap(ctx sdk.Context,
+	req queryproto.GeometricTwapRequest,
+) (*queryproto.GeometricTwapResponse, error) {
+	endTime := ctx.BlockTime()
+	if req.EndTime != nil && !req.EndTime.IsZero() {
+		endTime = *req.EndTime
+	}
+
+	twap, err := q.K.GetGeometricTwap(ctx, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime, endTime)
+	metadata, err := q.twapMetadata(ctx, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime, endTime, req.Lenient, err)
+	return &queryproto.GeometricTwapResponse{GeometricTwap: twap, Metadata: metadata}, err
+}
+
+func (q Querier) GeometricTwapToNow(ctx sdk.Context,
+	req queryproto.GeometricTwapToNowRequest,
+) (*queryproto.GeometricTwapToNowResponse, error) {
+	twap, err := q.K.GetGeometricTwapToNow(ctx, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime)
+	metadata, err := q.twapMetadata(ctx, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime, ctx.BlockTime(), req.Lenient, err)
+	return &queryproto.GeometricTwapToNowResponse{GeometricTwap: twap, Metadata: metadata}, err
+}
+
+func (q Querier) ArithmeticTwapRouted(ctx sdk.Context,
+	req queryproto.ArithmeticTwapRoutedRequest,
+) (*queryproto.ArithmeticTwapRoutedResponse, error) {
+	endTime := ctx.BlockTime()
+	if req.EndTime != nil && !req.EndTime.IsZero() {
+		endTime = *req.EndTime
+	}
+
+	twap, hops, err := q.K.GetArithmeticTwapRouted(ctx, req.BaseAsset, req.Routes, req.StartTime, endTime)
+	return &queryproto.ArithmeticTwapRoutedResponse{ArithmeticTwap: twap, Hops: hops}, err
+}
+
+func (q Querier) GeometricTwapRouted(ctx sdk.Context,
+	req queryproto.GeometricTwapRoutedRequest,
+) (*queryproto.GeometricTwapRoutedResponse, error) {
+	endTime := ctx.BlockTime()
+	if req.EndTime != nil && !req.EndTime.IsZero() {
+		endTime = *req.EndTime
+	}
+
+	twap, hops, err := q.K.GetGeometricTwapRouted(ctx, req.BaseAsset, req.Routes, req.StartTime, endTime)
+	return &queryproto.GeometricTwapRoutedResponse{GeometricTwap: twap, Hops: hops}, err
+}
+
+// twapMetadata returns the metadata of a twap, given the error getting the twap returned.
+// If lenient is set, a spot price error within the twap window is not returned,
+// as the metadata reports it.
+func (q Querier) twapMetadata(ctx sdk.Context, poolId uint64, baseAsset string, quoteAsset string,
+	startTime time.Time, endTime time.Time, lenient bool, twapErr error,
+) (types.TwapMetadata, error) {
+	if twapErr != nil && !(lenient && errors.Is(twapErr, types.ErrSpotPriceErrorInWindow)) {
+		return types.TwapMetadata{}, twapErr
+	}
+	return q.K.GetTwapMetadata(ctx, poolId, baseAsset, quoteAsset, startTime, endTime)
 }
 
 func (q Querier) Params(ctx sdk.Context,
@@ -32,6 +32,18 @@ func (k Keeper) GetAllHistoricalTimeIndexedTWAPs(ctx sdk.Context) ([]types.TwapR
 	return k.getAllHistoricalTimeIndexedTWAPs(ctx)
 }
 
+func (k Keeper) StoreCheckpoint(ctx sdk.Context, resolution types.CheckpointResolution, record types.TwapRecord) {
+	k.storeCheckpoint(ctx, resolution, record)
+}
+
+func (k Keeper) StoreCheckpoints(ctx sdk.Context, record types.TwapRecord, newTime time.Time) {
+	k.storeCheckpoints(ctx, record, newTime)
+}
+
+func (k Keeper) GetAllCheckpoints(ctx sdk.Context) ([]types.TwapCheckpoint, error) {
+	return k.getAllCheckpoints(ctx)
+}
+
 func (k Keeper) GetAllHistoricalPoolIndexedTWAPs(ctx sdk.Context) ([]types.TwapRecord, error) {
 	return k.getAllHistoricalPoolIndexedTWAPs(ctx)
 }
@@ -68,6 +80,10 @@ func ComputeArithmeticTwap(startRecord types.TwapRecord, endRecord types.TwapRec
 	return computeArithmeticTwap(startRecord, endRecord, quoteAsset)
 }
 
+func ComputeGeometricTwap(startRecord types.TwapRecord, endRecord types.TwapRecord, quoteAsset string) (sdk.Dec, error) {
+	return computeGeometricTwap(startRecord, endRecord, quoteAsset)
+}
+
 func RecordWithUpdatedAccumulators(record types.TwapRecord, t time.Time) types.TwapRecord {
 	return recordWithUpdatedAccumulators(record, t)
 }
@@ -67,6 +67,12 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 	for _, twap := range genState.Twaps {
 		k.storeNewRecord(ctx, twap)
 	}
+
+	for _, checkpoint := range genState.Checkpoints {
+		// validated above, so the resolution is known.
+		resolution, _ := types.GetCheckpointResolution(checkpoint.Resolution)
+		k.storeCheckpoint(ctx, resolution, checkpoint.Record)
+	}
 }
 
 // ExportGenesis returns the twap module's exported genesis.
@@ -78,8 +84,14 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(err)
 	}
 
+	checkpoints, err := k.getAllCheckpoints(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	return &types.GenesisState{
-		Params: k.GetParams(ctx),
-		Twaps:  twapRecords,
+		Params:      k.GetParams(ctx),
+		Twaps:       twapRecords,
+		Checkpoints: checkpoints,
 	}
 }
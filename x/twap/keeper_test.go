@@ -56,6 +56,7 @@ var (
 		P1LastSpotPrice:             sdk.OneDec(),
 		P0ArithmeticTwapAccumulator: sdk.OneDec(),
 		P1ArithmeticTwapAccumulator: sdk.OneDec(),
+		GeometricTwapAccumulator:    sdk.ZeroDec(),
 	}
 
 	basicCustomGenesis = types.NewGenesisState(
@@ -77,6 +78,7 @@ var (
 				P1LastSpotPrice:             sdk.OneDec(),
 				P0ArithmeticTwapAccumulator: sdk.OneDec(),
 				P1ArithmeticTwapAccumulator: sdk.OneDec(),
+				GeometricTwapAccumulator:    sdk.ZeroDec(),
 			},
 			{
 				PoolId:                      basePoolId,
@@ -88,6 +90,7 @@ var (
 				P1LastSpotPrice:             sdk.OneDec(),
 				P0ArithmeticTwapAccumulator: sdk.OneDec(),
 				P1ArithmeticTwapAccumulator: sdk.OneDec(),
+				GeometricTwapAccumulator:    sdk.ZeroDec(),
 			},
 			mostRecentRecordPoolOne,
 		})
@@ -102,6 +105,7 @@ var (
 		P1LastSpotPrice:             sdk.OneDec(),
 		P0ArithmeticTwapAccumulator: sdk.OneDec(),
 		P1ArithmeticTwapAccumulator: sdk.OneDec(),
+		GeometricTwapAccumulator:    sdk.ZeroDec(),
 	}
 
 	decreasingOrderByTimeRecordsPoolTwo = types.NewGenesisState(
@@ -118,6 +122,7 @@ var (
 				P1LastSpotPrice:             sdk.OneDec(),
 				P0ArithmeticTwapAccumulator: sdk.OneDec(),
 				P1ArithmeticTwapAccumulator: sdk.OneDec(),
+				GeometricTwapAccumulator:    sdk.ZeroDec(),
 			},
 			{
 				PoolId:                      basePoolId,
@@ -129,9 +134,20 @@ var (
 				P1LastSpotPrice:             sdk.OneDec(),
 				P0ArithmeticTwapAccumulator: sdk.OneDec(),
 				P1ArithmeticTwapAccumulator: sdk.OneDec(),
+				GeometricTwapAccumulator:    sdk.ZeroDec(),
 			},
 		})
 
+	checkpointsGenesis = &types.GenesisState{
+		Params: basicParams,
+		Twaps:  []types.TwapRecord{mostRecentRecordPoolOne},
+		Checkpoints: []types.TwapCheckpoint{
+			{Resolution: types.HourlyCheckpointResolution.Name, Record: withTime(mostRecentRecordPoolOne, baseTime.Add(-time.Hour))},
+			{Resolution: types.HourlyCheckpointResolution.Name, Record: withTime(mostRecentRecordPoolOne, baseTime)},
+			{Resolution: types.DailyCheckpointResolution.Name, Record: withTime(mostRecentRecordPoolOne, baseTime.Add(-23*time.Hour))},
+		},
+	}
+
 	bothPoolsGenesis = types.NewGenesisState(
 		basicParams,
 		append(increasingOrderByTimeRecordsPoolOne.Twaps, decreasingOrderByTimeRecordsPoolTwo.Twaps...),
@@ -143,6 +159,11 @@ func withPoolId(twap types.TwapRecord, poolId uint64) types.TwapRecord {
 	return twap
 }
 
+func withTime(twap types.TwapRecord, t time.Time) types.TwapRecord {
+	twap.Time = t
+	return twap
+}
+
 func withLastErrTime(twap types.TwapRecord, lastErrorTime time.Time) types.TwapRecord {
 	twap.LastErrorTime = lastErrorTime
 	return twap
@@ -158,6 +179,11 @@ func withSp1(twap types.TwapRecord, sp sdk.Dec) types.TwapRecord {
 	return twap
 }
 
+func withGeometricAccum(twap types.TwapRecord, accum sdk.Dec) types.TwapRecord {
+	twap.GeometricTwapAccumulator = accum
+	return twap
+}
+
 // TestTWAPInitGenesis tests that genesis is initialized correctly
 // with different parameters and state.
 // Asserts that the most recent records are set correctly.
@@ -214,6 +240,7 @@ func (suite *TestSuite) TestTwapInitGenesis() {
 						P1LastSpotPrice:             sdk.OneDec(),
 						P0ArithmeticTwapAccumulator: sdk.OneDec(),
 						P1ArithmeticTwapAccumulator: sdk.OneDec(),
+						GeometricTwapAccumulator:    sdk.ZeroDec(),
 					},
 				}),
 
@@ -267,6 +294,9 @@ func (suite *TestSuite) TestTWAPExportGenesis() {
 		"custom multi-record; decreasing": {
 			expectedGenesis: decreasingOrderByTimeRecordsPoolTwo,
 		},
+		"custom genesis with checkpoints": {
+			expectedGenesis: checkpointsGenesis,
+		},
 	}
 
 	for name, tc := range testCases {
@@ -292,6 +322,7 @@ func (suite *TestSuite) TestTWAPExportGenesis() {
 			})
 
 			suite.Require().Equal(tc.expectedGenesis.Twaps, actualGenesis.Twaps)
+			suite.Require().ElementsMatch(tc.expectedGenesis.Checkpoints, actualGenesis.Checkpoints)
 		})
 	}
 }
@@ -410,6 +441,7 @@ func newTwoAssetPoolTwapRecordWithDefaults(t time.Time, sp0, accum0, accum1 sdk.
 		P1LastSpotPrice:             sdk.OneDec().Quo(sp0),
 		P0ArithmeticTwapAccumulator: accum0,
 		P1ArithmeticTwapAccumulator: accum1,
+		GeometricTwapAccumulator:    sdk.ZeroDec(),
 	}
 }
 
@@ -430,6 +462,7 @@ func newThreeAssetPoolTwapRecordWithDefaults(t time.Time, sp0, accumA, accumB, a
 		P1LastSpotPrice:             spB,
 		P0ArithmeticTwapAccumulator: accumA,
 		P1ArithmeticTwapAccumulator: accumB,
+		GeometricTwapAccumulator:    sdk.ZeroDec(),
 	}
 	twapAC := twapAB
 	twapAC.Asset1Denom = denom2
@@ -454,6 +487,7 @@ func newEmptyPriceRecord(poolId uint64, t time.Time, asset0 string, asset1 strin
 		P1LastSpotPrice:             sdk.ZeroDec(),
 		P0ArithmeticTwapAccumulator: sdk.ZeroDec(),
 		P1ArithmeticTwapAccumulator: sdk.ZeroDec(),
+		GeometricTwapAccumulator:    sdk.ZeroDec(),
 	}
 }
 
@@ -468,6 +502,7 @@ func newRecord(poolId uint64, t time.Time, sp0, accum0, accum1 sdk.Dec) types.Tw
 		// make new copies
 		P0ArithmeticTwapAccumulator: accum0.Add(sdk.ZeroDec()),
 		P1ArithmeticTwapAccumulator: accum1.Add(sdk.ZeroDec()),
+		GeometricTwapAccumulator:    sdk.ZeroDec(),
 	}
 }
 
@@ -479,6 +514,7 @@ func newExpRecord(accum0, accum1 sdk.Dec) types.TwapRecord {
 		// make new copies
 		P0ArithmeticTwapAccumulator: accum0.Add(sdk.ZeroDec()),
 		P1ArithmeticTwapAccumulator: accum1.Add(sdk.ZeroDec()),
+		GeometricTwapAccumulator:    sdk.ZeroDec(),
 	}
 }
 
@@ -496,6 +532,7 @@ func newThreeAssetRecord(poolId uint64, t time.Time, sp0, accumA, accumB, accumC
 		// make new copies
 		P0ArithmeticTwapAccumulator: accumA.Add(sdk.ZeroDec()),
 		P1ArithmeticTwapAccumulator: accumB.Add(sdk.ZeroDec()),
+		GeometricTwapAccumulator:    sdk.ZeroDec(),
 	}
 	twapAC := twapAB
 	twapAC.Asset1Denom = denom2
@@ -517,6 +554,7 @@ func newThreeAssetExpRecord(poolId uint64, accumA, accumB, accumC sdk.Dec) []typ
 		// make new copies
 		P0ArithmeticTwapAccumulator: accumA.Add(sdk.ZeroDec()),
 		P1ArithmeticTwapAccumulator: accumB.Add(sdk.ZeroDec()),
+		GeometricTwapAccumulator:    sdk.ZeroDec(),
 	}
 	twapAC := twapAB
 	twapAC.Asset1Denom = denom2
@@ -7,6 +7,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/osmosis-labs/osmosis/v12/osmomath"
 	"github.com/osmosis-labs/osmosis/v12/x/twap/types"
 )
 
@@ -27,6 +28,7 @@ func newTwapRecord(k types.AmmInterface, ctx sdk.Context, poolId uint64, denom0,
 		P1LastSpotPrice:             sp1,
 		P0ArithmeticTwapAccumulator: sdk.ZeroDec(),
 		P1ArithmeticTwapAccumulator: sdk.ZeroDec(),
+		GeometricTwapAccumulator:    sdk.ZeroDec(),
 		LastErrorTime:               lastErrorTime,
 	}, nil
 }
@@ -133,11 +135,31 @@ func (k Keeper) updateRecords(ctx sdk.Context, poolId uint64) error {
 
 	for _, record := range records {
 		newRecord := k.updateRecord(ctx, record)
+		k.storeCheckpoints(ctx, record, newRecord.Time)
 		k.storeNewRecord(ctx, newRecord)
 	}
 	return nil
 }
 
+// storeCheckpoints stores, for every checkpoint resolution, the checkpoints in (record.Time, newTime]
+// interpolated from the given record. As the record's last spot price is in effect for this entire
+// time range, the checkpoint accumulators are exact.
+// Only the first and the last checkpoint in the time range are stored, since interpolating
+// from the first one gives the same accumulators as any checkpoint in between would have.
+func (k Keeper) storeCheckpoints(ctx sdk.Context, record types.TwapRecord, newTime time.Time) {
+	for _, resolution := range types.CheckpointResolutions {
+		lastCheckpointTime := resolution.CheckpointTime(newTime)
+		if !lastCheckpointTime.After(record.Time) {
+			continue
+		}
+		firstCheckpointTime := resolution.CheckpointTime(record.Time).Add(resolution.Interval)
+		k.storeCheckpoint(ctx, resolution, recordWithUpdatedAccumulators(record, firstCheckpointTime))
+		if lastCheckpointTime.After(firstCheckpointTime) {
+			k.storeCheckpoint(ctx, resolution, recordWithUpdatedAccumulators(record, lastCheckpointTime))
+		}
+	}
+}
+
 // updateRecord returns a new record with updated accumulators and block time
 // for the current block time.
 func (k Keeper) updateRecord(ctx sdk.Context, record types.TwapRecord) types.TwapRecord {
@@ -160,11 +182,22 @@ func (k Keeper) updateRecord(ctx sdk.Context, record types.TwapRecord) types.Twa
 // Such record is preserved for each pool.
 // See TWAP keeper's `pruneRecordsBeforeTimeButNewest(...)` for more details about the reasons for
 // keeping this record.
+// It also prunes the checkpoints of every resolution that are older than the resolution's keep period.
 func (k Keeper) pruneRecords(ctx sdk.Context) error {
 	recordHistoryKeepPeriod := k.RecordHistoryKeepPeriod(ctx)
 
 	lastKeptTime := ctx.BlockTime().Add(-recordHistoryKeepPeriod)
-	return k.pruneRecordsBeforeTimeButNewest(ctx, lastKeptTime)
+	if err := k.pruneRecordsBeforeTimeButNewest(ctx, lastKeptTime); err != nil {
+		return err
+	}
+
+	for _, resolution := range types.CheckpointResolutions {
+		lastKeptCheckpointTime := ctx.BlockTime().Add(-resolution.KeepPeriod)
+		if err := k.pruneCheckpointsBeforeTime(ctx, resolution, lastKeptCheckpointTime); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // recordWithUpdatedAccumulators returns a record, with updated accumulator values and time for provided newTime,
@@ -190,18 +223,31 @@ func recordWithUpdatedAccumulators(record types.TwapRecord, newTime time.Time) t
 	p1NewAccum := types.SpotPriceMulDuration(record.P1LastSpotPrice, timeDelta)
 	newRecord.P1ArithmeticTwapAccumulator = newRecord.P1ArithmeticTwapAccumulator.Add(p1NewAccum)
 
+	// log2 is undefined at zero. A zero spot price can only come from an error in
+	// getting the spot price, which is already reflected in the record's LastErrorTime,
+	// so we do not accumulate anything for it.
+	if record.P0LastSpotPrice.IsPositive() {
+		geometricNewAccum := types.SpotPriceMulDuration(twapLog(record.P0LastSpotPrice), timeDelta)
+		newRecord.GeometricTwapAccumulator = newRecord.GeometricTwapAccumulator.Add(geometricNewAccum)
+	}
+
 	return newRecord
 }
 
 // getInterpolatedRecord returns a record for this pool, representing its accumulator state at time `t`.
 // This is achieved by getting the record `r` that is at, or immediately preceding in state time `t`.
 // To be clear: the record r s.t. `t - r.Time` is minimized AND `t >= r.Time`
+// If `t` is older than all records kept in state, this falls back to the checkpoint at, or
+// immediately preceding `t`, of the finest checkpoint resolution that has one.
 func (k Keeper) getInterpolatedRecord(ctx sdk.Context, poolId uint64, t time.Time, assetA, assetB string) (types.TwapRecord, error) {
 	assetA, assetB, err := types.LexicographicalOrderDenoms(assetA, assetB)
 	if err != nil {
 		return types.TwapRecord{}, err
 	}
 	record, err := k.getRecordAtOrBeforeTime(ctx, poolId, t, assetA, assetB)
+	if errors.As(err, &timeTooOldError{}) {
+		record, err = k.getCheckpointAtOrBeforeTime(ctx, poolId, t, assetA, assetB)
+	}
 	if err != nil {
 		return types.TwapRecord{}, err
 	}
@@ -222,6 +268,26 @@ func (k Keeper) getMostRecentRecord(ctx sdk.Context, poolId uint64, assetA, asse
 	return record, nil
 }
 
+// computeTwapFn computes a TWAP of the quote asset between two records.
+type computeTwapFn func(startRecord types.TwapRecord, endRecord types.TwapRecord, quoteAsset string) (sdk.Dec, error)
+
+// spotPriceError returns an error if there was an error in getting the
+// pool spot price between the start and end record.
+func spotPriceError(startRecord types.TwapRecord, endRecord types.TwapRecord) error {
+	if endRecord.LastErrorTime.After(startRecord.Time) || endRecord.LastErrorTime.Equal(startRecord.Time) {
+		return types.ErrSpotPriceErrorInWindow
+	}
+	return nil
+}
+
+// lastSpotPrice returns the last spot price of the record for the given quote asset.
+func lastSpotPrice(record types.TwapRecord, quoteAsset string) sdk.Dec {
+	if quoteAsset == record.Asset0Denom {
+		return record.P0LastSpotPrice
+	}
+	return record.P1LastSpotPrice
+}
+
 // computeArithmeticTwap computes and returns an arithmetic TWAP between
 // two records given the quote asset.
 // precondition: endRecord.Time >= startRecord.Time
@@ -231,17 +297,11 @@ func (k Keeper) getMostRecentRecord(ctx sdk.Context, poolId uint64, assetA, asse
 // (endRecord.Accumulator - startRecord.Accumulator) / (endRecord.Time - startRecord.Time)
 func computeArithmeticTwap(startRecord types.TwapRecord, endRecord types.TwapRecord, quoteAsset string) (sdk.Dec, error) {
 	// see if we need to return an error, due to spot price issues
-	var err error = nil
-	if endRecord.LastErrorTime.After(startRecord.Time) || endRecord.LastErrorTime.Equal(startRecord.Time) {
-		err = errors.New("twap: error in pool spot price occurred between start and end time, twap result may be faulty")
-	}
+	err := spotPriceError(startRecord, endRecord)
 	timeDelta := endRecord.Time.Sub(startRecord.Time)
 	// if time difference is 0, then return the last spot price based off of start.
 	if timeDelta == time.Duration(0) {
-		if quoteAsset == startRecord.Asset0Denom {
-			return endRecord.P0LastSpotPrice, err
-		}
-		return endRecord.P1LastSpotPrice, err
+		return lastSpotPrice(endRecord, quoteAsset), err
 	}
 	var accumDiff sdk.Dec
 	if quoteAsset == startRecord.Asset0Denom {
@@ -251,3 +311,39 @@ func computeArithmeticTwap(startRecord types.TwapRecord, endRecord types.TwapRec
 	}
 	return types.AccumDiffDivDuration(accumDiff, timeDelta), err
 }
+
+// computeGeometricTwap computes and returns a geometric TWAP between
+// two records given the quote asset.
+// precondition: endRecord.Time >= startRecord.Time
+// if endRecord.LastErrorTime is after startRecord.Time, return an error at end + result
+// if (endRecord.Time == startRecord.Time) returns endRecord.LastSpotPrice
+// else returns
+// 2^((endRecord.GeometricAccumulator - startRecord.GeometricAccumulator) / (endRecord.Time - startRecord.Time))
+// The accumulator tracks the asset0 price, so the asset1 price is computed as the reciprocal.
+func computeGeometricTwap(startRecord types.TwapRecord, endRecord types.TwapRecord, quoteAsset string) (sdk.Dec, error) {
+	// see if we need to return an error, due to spot price issues
+	err := spotPriceError(startRecord, endRecord)
+	timeDelta := endRecord.Time.Sub(startRecord.Time)
+	// if time difference is 0, then return the last spot price based off of start.
+	if timeDelta == time.Duration(0) {
+		return lastSpotPrice(endRecord, quoteAsset), err
+	}
+
+	accumDiff := endRecord.GeometricTwapAccumulator.Sub(startRecord.GeometricTwapAccumulator)
+	arithmeticMeanOfLogPrices := types.AccumDiffDivDuration(accumDiff, timeDelta)
+	result := twapPow(arithmeticMeanOfLogPrices)
+	if quoteAsset == startRecord.Asset1Denom {
+		result = sdk.OneDec().Quo(result)
+	}
+	return result, err
+}
+
+// twapLog returns the logarithm base 2 of a spot price, used for the geometric accumulator.
+func twapLog(price sdk.Dec) sdk.Dec {
+	return osmomath.BigDecFromSDKDec(price).LogBase2().SDKDec()
+}
+
+// twapPow exponentiates 2 to the given exponent, inverting twapLog.
+func twapPow(exponent sdk.Dec) sdk.Dec {
+	return osmomath.Exp2(osmomath.BigDecFromSDKDec(exponent)).SDKDec()
+}
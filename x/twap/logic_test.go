@@ -21,6 +21,8 @@ var (
 	oneDec  = sdk.OneDec()
 	twoDec  = oneDec.Add(oneDec)
 	OneSec  = sdk.MustNewDecFromStr("1000.000000000000000000")
+	// logTen is log_2(10), truncated to 18 decimals.
+	logTen = sdk.MustNewDecFromStr("3.321928094887362347")
 )
 
 func (s *TestSuite) TestGetSpotPrices() {
@@ -160,7 +162,7 @@ func (s *TestSuite) TestUpdateRecord() {
 	baseTimeMinusOne := time.Unix(1, 0).UTC()
 
 	zeroAccumNoErrSp10Record := newRecord(poolId, baseTime, sdk.NewDec(10), zeroDec, zeroDec)
-	sp10OneTimeUnitAccumRecord := newExpRecord(OneSec.MulInt64(10), OneSec.QuoInt64(10))
+	sp10OneTimeUnitAccumRecord := withGeometricAccum(newExpRecord(OneSec.MulInt64(10), OneSec.QuoInt64(10)), OneSec.Mul(logTen))
 	// all tests occur with updateTime = base time + time.Unix(1, 0)
 	tests := map[string]struct {
 		record           types.TwapRecord
@@ -244,17 +246,22 @@ func TestRecordWithUpdatedAccumulators(t *testing.T) {
 		"accum with zero value": {
 			record:    newRecord(poolId, time.Unix(1, 0), sdk.NewDec(10), zeroDec, zeroDec),
 			newTime:   time.Unix(2, 0),
-			expRecord: newExpRecord(OneSec.MulInt64(10), OneSec.QuoInt64(10)),
+			expRecord: withGeometricAccum(newExpRecord(OneSec.MulInt64(10), OneSec.QuoInt64(10)), OneSec.Mul(logTen)),
 		},
 		"small starting accumulators": {
 			record:    defaultRecord,
 			newTime:   time.Unix(2, 0),
-			expRecord: newExpRecord(oneDec.Add(OneSec.MulInt64(10)), twoDec.Add(OneSec.QuoInt64(10))),
+			expRecord: withGeometricAccum(newExpRecord(oneDec.Add(OneSec.MulInt64(10)), twoDec.Add(OneSec.QuoInt64(10))), OneSec.Mul(logTen)),
 		},
 		"larger time interval": {
 			record:    newRecord(poolId, time.Unix(11, 0), sdk.NewDec(10), oneDec, twoDec),
 			newTime:   time.Unix(55, 0),
-			expRecord: newExpRecord(oneDec.Add(OneSec.MulInt64(44*10)), twoDec.Add(OneSec.MulInt64(44).QuoInt64(10))),
+			expRecord: withGeometricAccum(newExpRecord(oneDec.Add(OneSec.MulInt64(44*10)), twoDec.Add(OneSec.MulInt64(44).QuoInt64(10))), OneSec.MulInt64(44).Mul(logTen)),
+		},
+		"zero spot price, geometric accumulator should not change": {
+			record:    withSp0(newRecord(poolId, time.Unix(1, 0), sdk.NewDec(10), oneDec, twoDec), sdk.ZeroDec()),
+			newTime:   time.Unix(2, 0),
+			expRecord: newExpRecord(oneDec, twoDec.Add(OneSec.QuoInt64(10))),
 		},
 		"same time, accumulator should not change": {
 			record:    defaultRecord,
@@ -283,21 +290,27 @@ func TestRecordWithUpdatedAccumulators_ThreeAsset(t *testing.T) {
 		record          []types.TwapRecord
 		interpolateTime time.Time
 		expRecord       []types.TwapRecord
+		// expGeometricAccum is the expected geometric accumulator of the AB and AC records.
+		// The BC record has a spot price of 0.1, so its geometric accumulator is the negation.
+		expGeometricAccum sdk.Dec
 	}{
 		"accum with zero value": {
-			record:          newThreeAssetRecord(poolId, time.Unix(1, 0), sdk.NewDec(10), zeroDec, zeroDec, zeroDec),
-			interpolateTime: time.Unix(2, 0),
-			expRecord:       newThreeAssetExpRecord(poolId, OneSec.MulInt64(10), OneSec.QuoInt64(10), OneSec.MulInt64(20)),
+			record:            newThreeAssetRecord(poolId, time.Unix(1, 0), sdk.NewDec(10), zeroDec, zeroDec, zeroDec),
+			interpolateTime:   time.Unix(2, 0),
+			expRecord:         newThreeAssetExpRecord(poolId, OneSec.MulInt64(10), OneSec.QuoInt64(10), OneSec.MulInt64(20)),
+			expGeometricAccum: OneSec.Mul(logTen),
 		},
 		"small starting accumulators": {
-			record:          newThreeAssetRecord(poolId, time.Unix(1, 0), sdk.NewDec(10), twoDec, oneDec, twoDec),
-			interpolateTime: time.Unix(2, 0),
-			expRecord:       newThreeAssetExpRecord(poolId, twoDec.Add(OneSec.MulInt64(10)), oneDec.Add(OneSec.QuoInt64(10)), twoDec.Add(OneSec.MulInt64(20))),
+			record:            newThreeAssetRecord(poolId, time.Unix(1, 0), sdk.NewDec(10), twoDec, oneDec, twoDec),
+			interpolateTime:   time.Unix(2, 0),
+			expRecord:         newThreeAssetExpRecord(poolId, twoDec.Add(OneSec.MulInt64(10)), oneDec.Add(OneSec.QuoInt64(10)), twoDec.Add(OneSec.MulInt64(20))),
+			expGeometricAccum: OneSec.Mul(logTen),
 		},
 		"larger time interval": {
-			record:          newThreeAssetRecord(poolId, time.Unix(11, 0), sdk.NewDec(10), twoDec, oneDec, twoDec),
-			interpolateTime: time.Unix(55, 0),
-			expRecord:       newThreeAssetExpRecord(poolId, twoDec.Add(OneSec.MulInt64(44*10)), oneDec.Add(OneSec.MulInt64(44).QuoInt64(10)), twoDec.Add(OneSec.MulInt64(44*20))),
+			record:            newThreeAssetRecord(poolId, time.Unix(11, 0), sdk.NewDec(10), twoDec, oneDec, twoDec),
+			interpolateTime:   time.Unix(55, 0),
+			expRecord:         newThreeAssetExpRecord(poolId, twoDec.Add(OneSec.MulInt64(44*10)), oneDec.Add(OneSec.MulInt64(44).QuoInt64(10)), twoDec.Add(OneSec.MulInt64(44*20))),
+			expGeometricAccum: OneSec.MulInt64(44).Mul(logTen),
 		},
 	}
 
@@ -308,6 +321,10 @@ func TestRecordWithUpdatedAccumulators_ThreeAsset(t *testing.T) {
 				test.expRecord[i].Time = test.interpolateTime
 				test.expRecord[i].P0LastSpotPrice = test.record[i].P0LastSpotPrice
 				test.expRecord[i].P1LastSpotPrice = test.record[i].P1LastSpotPrice
+				test.expRecord[i].GeometricTwapAccumulator = test.expGeometricAccum
+				if i == 2 {
+					test.expRecord[i].GeometricTwapAccumulator = test.expGeometricAccum.Neg()
+				}
 
 				gotRecord := twap.RecordWithUpdatedAccumulators(test.record[i], test.interpolateTime)
 				require.Equal(t, test.expRecord[i], gotRecord)
@@ -396,6 +413,124 @@ func (s *TestSuite) TestGetInterpolatedRecord() {
 	}
 }
 
+func (s *TestSuite) TestGetInterpolatedRecord_Checkpoints() {
+	// the oldest record kept in state is at baseTime.
+	baseRecord := newTwoAssetPoolTwapRecordWithDefaults(baseTime, sdk.OneDec(), OneSec.MulInt64(100), OneSec.MulInt64(100))
+	fiveDaysAgo := baseTime.Add(-5 * 24 * time.Hour)
+	fortyDaysAgo := baseTime.Add(-40 * 24 * time.Hour)
+	// baseTime is at 23:00 UTC, so daily checkpoints are 23 hours before it.
+	hourlyCheckpoint := newTwoAssetPoolTwapRecordWithDefaults(fiveDaysAgo.Add(-time.Hour), sdk.NewDec(2), OneSec.MulInt64(50), OneSec.MulInt64(50))
+	dailyCheckpoint := newTwoAssetPoolTwapRecordWithDefaults(fortyDaysAgo.Add(-23*time.Hour), sdk.NewDec(4), OneSec.MulInt64(10), OneSec.MulInt64(10))
+
+	tests := map[string]struct {
+		testTime          time.Time
+		expectedRecord    types.TwapRecord
+		expectedInterpDur time.Duration
+		expectedErr       error
+	}{
+		"time kept in records": {
+			testTime:       baseTime,
+			expectedRecord: baseRecord,
+		},
+		"time older than records, interpolated from hourly checkpoint": {
+			testTime:          fiveDaysAgo,
+			expectedRecord:    hourlyCheckpoint,
+			expectedInterpDur: time.Hour,
+		},
+		"time at hourly checkpoint": {
+			testTime:       fiveDaysAgo.Add(-time.Hour),
+			expectedRecord: hourlyCheckpoint,
+		},
+		"time older than hourly checkpoints, interpolated from daily checkpoint": {
+			testTime:          fortyDaysAgo,
+			expectedRecord:    dailyCheckpoint,
+			expectedInterpDur: 23 * time.Hour,
+		},
+		"time older than all checkpoints": {
+			testTime:    fortyDaysAgo.Add(-24 * time.Hour),
+			expectedErr: twap.TimeTooOldError{Time: fortyDaysAgo.Add(-24 * time.Hour)},
+		},
+	}
+
+	for name, test := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.twapkeeper.StoreNewRecord(s.Ctx, baseRecord)
+			s.twapkeeper.StoreCheckpoint(s.Ctx, types.HourlyCheckpointResolution, hourlyCheckpoint)
+			s.twapkeeper.StoreCheckpoint(s.Ctx, types.DailyCheckpointResolution, dailyCheckpoint)
+
+			interpolatedRecord, err := s.twapkeeper.GetInterpolatedRecord(s.Ctx, baseRecord.PoolId, denom0, denom1, test.testTime)
+			if test.expectedErr != nil {
+				s.Require().Error(err)
+				s.Require().Equal(test.expectedErr, err)
+				return
+			}
+			s.Require().NoError(err)
+
+			expectedRecord := test.expectedRecord
+			expectedRecord.Time = test.testTime
+			interpMs := int64(test.expectedInterpDur / time.Millisecond)
+			expectedRecord.P0ArithmeticTwapAccumulator = expectedRecord.P0ArithmeticTwapAccumulator.Add(expectedRecord.P0LastSpotPrice.MulInt64(interpMs))
+			expectedRecord.P1ArithmeticTwapAccumulator = expectedRecord.P1ArithmeticTwapAccumulator.Add(expectedRecord.P1LastSpotPrice.MulInt64(interpMs))
+			s.Require().Equal(expectedRecord.P0ArithmeticTwapAccumulator, interpolatedRecord.P0ArithmeticTwapAccumulator)
+			s.Require().Equal(expectedRecord.P1ArithmeticTwapAccumulator, interpolatedRecord.P1ArithmeticTwapAccumulator)
+			s.Require().Equal(expectedRecord.P0LastSpotPrice, interpolatedRecord.P0LastSpotPrice)
+			s.Require().Equal(expectedRecord.Time, interpolatedRecord.Time)
+		})
+	}
+}
+
+func (s *TestSuite) TestStoreCheckpoints() {
+	// baseTime is at 23:00 UTC, so baseTime + 1 hour is both an hourly and a daily checkpoint time.
+	record := newTwoAssetPoolTwapRecordWithDefaults(baseTime.Add(30*time.Minute), sdk.NewDec(10), sdk.ZeroDec(), sdk.ZeroDec())
+	hourly, daily := types.HourlyCheckpointResolution.Name, types.DailyCheckpointResolution.Name
+
+	tests := map[string]struct {
+		record              types.TwapRecord
+		newTime             time.Time
+		expectedCheckpoints []types.TwapCheckpoint
+	}{
+		"no checkpoint time passed": {
+			record:              record,
+			newTime:             baseTime.Add(50 * time.Minute),
+			expectedCheckpoints: []types.TwapCheckpoint{},
+		},
+		"new time at checkpoint time": {
+			record:  record,
+			newTime: baseTime.Add(time.Hour),
+			expectedCheckpoints: []types.TwapCheckpoint{
+				{Resolution: hourly, Record: twap.RecordWithUpdatedAccumulators(record, baseTime.Add(time.Hour))},
+				{Resolution: daily, Record: twap.RecordWithUpdatedAccumulators(record, baseTime.Add(time.Hour))},
+			},
+		},
+		"several hourly checkpoint times passed, only first and last are stored": {
+			record:  record,
+			newTime: baseTime.Add(3*time.Hour + 30*time.Minute),
+			expectedCheckpoints: []types.TwapCheckpoint{
+				{Resolution: hourly, Record: twap.RecordWithUpdatedAccumulators(record, baseTime.Add(time.Hour))},
+				{Resolution: hourly, Record: twap.RecordWithUpdatedAccumulators(record, baseTime.Add(3*time.Hour))},
+				{Resolution: daily, Record: twap.RecordWithUpdatedAccumulators(record, baseTime.Add(time.Hour))},
+			},
+		},
+		"record at checkpoint time": {
+			record:              newTwoAssetPoolTwapRecordWithDefaults(baseTime, sdk.NewDec(10), sdk.ZeroDec(), sdk.ZeroDec()),
+			newTime:             baseTime.Add(30 * time.Minute),
+			expectedCheckpoints: []types.TwapCheckpoint{},
+		},
+	}
+
+	for name, test := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.twapkeeper.StoreCheckpoints(s.Ctx, test.record, test.newTime)
+
+			checkpoints, err := s.twapkeeper.GetAllCheckpoints(s.Ctx)
+			s.Require().NoError(err)
+			s.Require().Equal(test.expectedCheckpoints, checkpoints)
+		})
+	}
+}
+
 func (s *TestSuite) TestGetInterpolatedRecord_ThreeAsset() {
 	baseRecord := newThreeAssetRecord(2, baseTime, sdk.NewDec(10), sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
 	// all tests occur with updateTime = base time + time.Unix(1, 0)
@@ -657,6 +792,58 @@ func TestComputeArithmeticTwapWithSpotPriceError(t *testing.T) {
 	}
 }
 
+func TestComputeGeometricTwap(t *testing.T) {
+	newGeometricRecord := func(time time.Time, accum sdk.Dec) types.TwapRecord {
+		record := newEmptyPriceRecord(1, time, denom0, denom1)
+		record.P0LastSpotPrice = sdk.NewDec(4)
+		record.P1LastSpotPrice = sdk.NewDecWithPrec(25, 2)
+		record.GeometricTwapAccumulator = accum
+		return record
+	}
+	testCaseFromDeltas := func(startAccum, accumDiff sdk.Dec, timeDelta time.Duration, quoteAsset string, expectedTwap sdk.Dec) computeArithmeticTwapTestCase {
+		return computeArithmeticTwapTestCase{
+			newGeometricRecord(baseTime, startAccum),
+			newGeometricRecord(baseTime.Add(timeDelta), startAccum.Add(accumDiff)),
+			quoteAsset,
+			expectedTwap,
+			false,
+		}
+	}
+	tests := map[string]computeArithmeticTwapTestCase{
+		"same record: denom0, end spot price = 4": testCaseFromDeltas(
+			sdk.ZeroDec(), sdk.ZeroDec(), 0, denom0, sdk.NewDec(4)),
+		"same record: denom1, end spot price = 0.25": testCaseFromDeltas(
+			sdk.ZeroDec(), sdk.ZeroDec(), 0, denom1, sdk.NewDecWithPrec(25, 2)),
+		"log2 price = 2 for one second, 0 base accum": testCaseFromDeltas(
+			sdk.ZeroDec(), OneSec.MulInt64(2), time.Second, denom0, sdk.NewDec(4)),
+		"log2 price = 2 for one second, 0 base accum (asset 1)": testCaseFromDeltas(
+			sdk.ZeroDec(), OneSec.MulInt64(2), time.Second, denom1, sdk.NewDecWithPrec(25, 2)),
+		"accumulator = 6*OneSec, t=3s. 10*second base accum": testCaseFromDeltas(
+			OneSec.MulInt64(10), OneSec.MulInt64(6), 3*time.Second, denom0, sdk.NewDec(4)),
+		"accumulator = -6*OneSec, t=3s. 0 base accum": testCaseFromDeltas(
+			sdk.ZeroDec(), OneSec.MulInt64(-6), 3*time.Second, denom0, sdk.NewDecWithPrec(25, 2)),
+		"accumulator = -6*OneSec, t=3s. 0 base accum (asset 1)": testCaseFromDeltas(
+			sdk.ZeroDec(), OneSec.MulInt64(-6), 3*time.Second, denom1, sdk.NewDec(4)),
+		// log2(1) * 5s + log2(4) * 5s. The geometric mean is 2, while the arithmetic mean would be 2.5
+		"price 1 for 5s then price 4 for 5s": testCaseFromDeltas(
+			sdk.ZeroDec(), OneSec.MulInt64(5*2), 10*time.Second, denom0, sdk.NewDec(2)),
+		"spot price error at end time": {
+			startRecord: newGeometricRecord(baseTime, sdk.ZeroDec()),
+			endRecord:   withLastErrTime(newGeometricRecord(tPlusOne, OneSec.MulInt64(2)), tPlusOne),
+			quoteAsset:  denom0,
+			expTwap:     sdk.NewDec(4),
+			expErr:      true,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			actualTwap, err := twap.ComputeGeometricTwap(test.startRecord, test.endRecord, test.quoteAsset)
+			require.Equal(t, test.expTwap, actualTwap)
+			osmoassert.ConditionalError(t, test.expErr, err)
+		})
+	}
+}
+
 // TestPruneRecords tests that twap records earlier than
 // current block time - RecordHistoryKeepPeriod are pruned from the store
 // while keeping the newest record before the above time threshold.
@@ -666,15 +853,15 @@ func (s *TestSuite) TestPruneRecords() {
 
 	pool1OlderMin2MsRecord, // deleted
 		pool2OlderMin1MsRecordAB, pool2OlderMin1MsRecordAC, pool2OlderMin1MsRecordBC, // deleted
-		pool3OlderBaseRecord, // kept as newest under keep period
+		pool3OlderBaseRecord,    // kept as newest under keep period
 		pool4OlderPlus1Record := // kept as newest under keep period
-	s.createTestRecordsFromTime(baseTime.Add(2 * -recordHistoryKeepPeriod))
+		s.createTestRecordsFromTime(baseTime.Add(2 * -recordHistoryKeepPeriod))
 
 	pool1Min2MsRecord, // kept as newest under keep period
 		pool2Min1MsRecordAB, pool2Min1MsRecordAC, pool2Min1MsRecordBC, // kept as newest under keep period
-		pool3BaseRecord, // kept as it is at the keep period boundary
+		pool3BaseRecord,    // kept as it is at the keep period boundary
 		pool4Plus1Record := // kept as it is above the keep period boundary
-	s.createTestRecordsFromTime(baseTime.Add(-recordHistoryKeepPeriod))
+		s.createTestRecordsFromTime(baseTime.Add(-recordHistoryKeepPeriod))
 
 	// non-ascending insertion order.
 	recordsToPreSet := []types.TwapRecord{
@@ -713,6 +900,38 @@ func (s *TestSuite) TestPruneRecords() {
 	s.validateExpectedRecords(expectedKeptRecords)
 }
 
+// TestPruneRecords_Checkpoints tests that checkpoints are pruned when older
+// than the keep period of their resolution.
+func (s *TestSuite) TestPruneRecords_Checkpoints() {
+	hourly, daily := types.HourlyCheckpointResolution, types.DailyCheckpointResolution
+	newCheckpoint := func(resolution types.CheckpointResolution, t time.Time) types.TwapCheckpoint {
+		return types.TwapCheckpoint{
+			Resolution: resolution.Name,
+			Record:     newTwoAssetPoolTwapRecordWithDefaults(t, sdk.OneDec(), sdk.ZeroDec(), sdk.ZeroDec()),
+		}
+	}
+
+	prunedHourly := newCheckpoint(hourly, baseTime.Add(-hourly.KeepPeriod-time.Hour))
+	keptHourlyAtBoundary := newCheckpoint(hourly, baseTime.Add(-hourly.KeepPeriod))
+	keptHourly := newCheckpoint(hourly, baseTime.Add(-time.Hour))
+	prunedDaily := newCheckpoint(daily, baseTime.Add(-daily.KeepPeriod-23*time.Hour))
+	keptDaily := newCheckpoint(daily, baseTime.Add(-hourly.KeepPeriod-23*time.Hour))
+
+	s.SetupTest()
+	for _, checkpoint := range []types.TwapCheckpoint{keptHourly, prunedDaily, keptHourlyAtBoundary, keptDaily, prunedHourly} {
+		resolution, err := types.GetCheckpointResolution(checkpoint.Resolution)
+		s.Require().NoError(err)
+		s.twapkeeper.StoreCheckpoint(s.Ctx, resolution, checkpoint.Record)
+	}
+
+	err := s.twapkeeper.PruneRecords(s.Ctx.WithBlockTime(baseTime))
+	s.Require().NoError(err)
+
+	checkpoints, err := s.twapkeeper.GetAllCheckpoints(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal([]types.TwapCheckpoint{keptHourlyAtBoundary, keptHourly, keptDaily}, checkpoints)
+}
+
 // TestUpdateRecords tests that the records are updated correctly.
 // It tests the following:
 // - two-asset pools
@@ -1050,7 +1269,7 @@ func (s *TestSuite) TestUpdateRecords() {
 		"multi-asset pool; pre-set at t and t + 1; creates new records": {
 			preSetRecords: []types.TwapRecord{threeAssetRecordAB, threeAssetRecordAC, threeAssetRecordBC, tPlus10sp5ThreeAssetRecordAB, tPlus10sp5ThreeAssetRecordAC, tPlus10sp5ThreeAssetRecordBC},
 			poolId:        threeAssetRecordAB.PoolId,
-			blockTime: threeAssetRecordAB.Time.Add(time.Second * 11),
+			blockTime:     threeAssetRecordAB.Time.Add(time.Second * 11),
 			spOverrides: []spOverride{
 				{
 					baseDenom:  threeAssetRecordAB.Asset0Denom,
@@ -1138,7 +1357,7 @@ func (s *TestSuite) TestUpdateRecords() {
 		"multi-asset pool; pre-set at t and t + 1 with err, large spot price, overwrites error time": {
 			preSetRecords: []types.TwapRecord{threeAssetRecordAB, threeAssetRecordAC, threeAssetRecordBC, withLastErrTime(tPlus10sp5ThreeAssetRecordAB, tPlus10sp5ThreeAssetRecordAB.Time), tPlus10sp5ThreeAssetRecordAC, tPlus10sp5ThreeAssetRecordBC},
 			poolId:        threeAssetRecordAB.PoolId,
-			blockTime: threeAssetRecordAB.Time.Add(time.Second * 11),
+			blockTime:     threeAssetRecordAB.Time.Add(time.Second * 11),
 			spOverrides: []spOverride{
 				{
 					baseDenom:  threeAssetRecordAB.Asset0Denom,
@@ -1146,10 +1365,10 @@ func (s *TestSuite) TestUpdateRecords() {
 					overrideSp: sdk.OneDec(),
 				},
 				{
-					baseDenom:   threeAssetRecordAB.Asset1Denom,
-					quoteDenom:  threeAssetRecordAB.Asset0Denom,
-					overrideSp:  sdk.OneDec().Add(sdk.OneDec()),
- 				},
+					baseDenom:  threeAssetRecordAB.Asset1Denom,
+					quoteDenom: threeAssetRecordAB.Asset0Denom,
+					overrideSp: sdk.OneDec().Add(sdk.OneDec()),
+				},
 				{
 					baseDenom:  threeAssetRecordAC.Asset0Denom,
 					quoteDenom: threeAssetRecordAC.Asset1Denom,
@@ -1167,9 +1386,9 @@ func (s *TestSuite) TestUpdateRecords() {
 					overrideSp: sdk.OneDec(),
 				},
 				{
-					baseDenom:   threeAssetRecordBC.Asset1Denom,
-					quoteDenom:  threeAssetRecordBC.Asset0Denom,
-					overrideSp:  sdk.OneDec().Add(sdk.OneDec()),
+					baseDenom:  threeAssetRecordBC.Asset1Denom,
+					quoteDenom: threeAssetRecordBC.Asset0Denom,
+					overrideSp: sdk.OneDec().Add(sdk.OneDec()),
 				},
 			},
 
@@ -1188,7 +1407,7 @@ func (s *TestSuite) TestUpdateRecords() {
 				// The new record AB added.
 				{
 					spotPriceA:    sdk.OneDec(),
-					spotPriceB:    sdk.OneDec().Add(sdk.OneDec()),                            
+					spotPriceB:    sdk.OneDec().Add(sdk.OneDec()),
 					lastErrorTime: tPlus10sp5ThreeAssetRecordAB.Time,
 					isMostRecent:  true,
 				},
@@ -1199,8 +1418,8 @@ func (s *TestSuite) TestUpdateRecords() {
 				},
 				// The original record AC at t + 1.
 				{
-					spotPriceA:    tPlus10sp5ThreeAssetRecordAC.P0LastSpotPrice,
-					spotPriceB:    tPlus10sp5ThreeAssetRecordAC.P1LastSpotPrice,
+					spotPriceA: tPlus10sp5ThreeAssetRecordAC.P0LastSpotPrice,
+					spotPriceB: tPlus10sp5ThreeAssetRecordAC.P1LastSpotPrice,
 				},
 				// The new record AC added.
 				{
@@ -1216,14 +1435,14 @@ func (s *TestSuite) TestUpdateRecords() {
 				},
 				// The original record BC at t + 1.
 				{
-					spotPriceA:    tPlus10sp5ThreeAssetRecordBC.P0LastSpotPrice,
-					spotPriceB:    tPlus10sp5ThreeAssetRecordBC.P1LastSpotPrice,
+					spotPriceA: tPlus10sp5ThreeAssetRecordBC.P0LastSpotPrice,
+					spotPriceB: tPlus10sp5ThreeAssetRecordBC.P1LastSpotPrice,
 				},
 				// The new record BC added.
 				{
-					spotPriceA:    sdk.OneDec(),
-					spotPriceB:    sdk.OneDec().Add(sdk.OneDec()),                            
-					isMostRecent:  true,
+					spotPriceA:   sdk.OneDec(),
+					spotPriceB:   sdk.OneDec().Add(sdk.OneDec()),
+					isMostRecent: true,
 				},
 			},
 		},
@@ -2,6 +2,8 @@ package twap
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v12/x/twap/types"
 )
 
 // MigrateExistingPools iterates through all pools and creates state entry for the twap module.
@@ -14,3 +16,39 @@ func (k Keeper) MigrateExistingPools(ctx sdk.Context, latestPoolId uint64) error
 	}
 	return nil
 }
+
+// MigrateGeometricTwapAccumulators backfills the geometric twap accumulator of every
+// record in state, as records stored before it was introduced do not have it set.
+// For every (pool, asset0, asset1) triplet, the oldest record starts with a zero accumulator,
+// and every following record's accumulator is interpolated from the previous one,
+// exactly as if the accumulator had been tracked when the records were created.
+func (k Keeper) MigrateGeometricTwapAccumulators(ctx sdk.Context) error {
+	// These are ordered in increasing order of time, guaranteed by the iterator
+	// that is prefixed by time.
+	records, err := k.getAllHistoricalTimeIndexedTWAPs(ctx)
+	if err != nil {
+		return err
+	}
+
+	type uniqueTriplet struct {
+		poolId uint64
+		asset0 string
+		asset1 string
+	}
+	previousRecords := map[uniqueTriplet]types.TwapRecord{}
+
+	// storeNewRecord rewrites the historical indexes, and as records are processed in time order,
+	// the last record stored for a triplet is its most recent record.
+	for _, record := range records {
+		triplet := uniqueTriplet{poolId: record.PoolId, asset0: record.Asset0Denom, asset1: record.Asset1Denom}
+		previousRecord, found := previousRecords[triplet]
+		if found {
+			record.GeometricTwapAccumulator = recordWithUpdatedAccumulators(previousRecord, record.Time).GeometricTwapAccumulator
+		} else {
+			record.GeometricTwapAccumulator = sdk.ZeroDec()
+		}
+		k.storeNewRecord(ctx, record)
+		previousRecords[triplet] = record
+	}
+	return nil
+}
@@ -3,6 +3,8 @@ package twap_test
 import (
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/osmosis-labs/osmosis/v12/x/twap/types"
 )
 
@@ -64,3 +66,43 @@ func (s *TestSuite) TestMigrateExistingPoolsError() {
 	err := s.twapkeeper.MigrateExistingPools(s.Ctx, latestPoolIdPlusOne)
 	s.Require().Error(err)
 }
+
+func (s *TestSuite) TestMigrateGeometricTwapAccumulators() {
+	// records stored before the geometric accumulator was introduced do not have it set.
+	withoutGeometricAccum := func(record types.TwapRecord) types.TwapRecord {
+		record.GeometricTwapAccumulator = sdk.Dec{}
+		return record
+	}
+	// t = baseTime, sp0 = 10
+	// t = baseTime + 10, sp0 = 5
+	// t = baseTime + 20, sp0 = 2
+	recordsToSet := []types.TwapRecord{
+		withoutGeometricAccum(baseRecord),
+		withoutGeometricAccum(tPlus10sp5Record),
+		withoutGeometricAccum(tPlus20sp2Record),
+		withoutGeometricAccum(withPoolId(tPlus10sp5Record, 2)),
+	}
+	s.preSetRecords(recordsToSet)
+
+	err := s.twapkeeper.MigrateGeometricTwapAccumulators(s.Ctx)
+	s.Require().NoError(err)
+
+	logFive := sdk.MustNewDecFromStr("2.321928094887362347")
+	expectedRecords := []types.TwapRecord{
+		withGeometricAccum(baseRecord, sdk.ZeroDec()),
+		withGeometricAccum(tPlus10sp5Record, OneSec.MulInt64(10).Mul(logTen)),
+		withGeometricAccum(withPoolId(tPlus10sp5Record, 2), sdk.ZeroDec()),
+		withGeometricAccum(tPlus20sp2Record, OneSec.MulInt64(10).Mul(logTen).Add(OneSec.MulInt64(10).Mul(logFive))),
+	}
+	records, err := s.twapkeeper.GetAllHistoricalTimeIndexedTWAPs(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(expectedRecords, records)
+
+	// the most recent records are migrated as well.
+	mostRecentRecord, err := s.twapkeeper.GetMostRecentRecordStoreRepresentation(s.Ctx, 1, denom0, denom1)
+	s.Require().NoError(err)
+	s.Require().Equal(expectedRecords[3], mostRecentRecord)
+	mostRecentRecord, err = s.twapkeeper.GetMostRecentRecordStoreRepresentation(s.Ctx, 2, denom0, denom1)
+	s.Require().NoError(err)
+	s.Require().Equal(expectedRecords[2], mostRecentRecord)
+}
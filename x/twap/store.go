@@ -211,3 +211,78 @@ func (k Keeper) getRecordAtOrBeforeTime(ctx sdk.Context, poolId uint64, t time.T
 
 	return twap, nil
 }
+
+// storeCheckpoint writes a twap checkpoint of the given resolution to the store, in all needed indexing.
+func (k Keeper) storeCheckpoint(ctx sdk.Context, resolution types.CheckpointResolution, twap types.TwapRecord) {
+	store := ctx.KVStore(k.storeKey)
+	key1 := types.FormatCheckpointTimeIndexKey(resolution.Name, twap.Time, twap.PoolId, twap.Asset0Denom, twap.Asset1Denom)
+	key2 := types.FormatCheckpointPoolIndexKey(resolution.Name, twap.PoolId, twap.Asset0Denom, twap.Asset1Denom, twap.Time)
+	osmoutils.MustSet(store, key1, &twap)
+	osmoutils.MustSet(store, key2, &twap)
+}
+
+func (k Keeper) deleteCheckpoint(ctx sdk.Context, resolution types.CheckpointResolution, twap types.TwapRecord) {
+	store := ctx.KVStore(k.storeKey)
+	key1 := types.FormatCheckpointTimeIndexKey(resolution.Name, twap.Time, twap.PoolId, twap.Asset0Denom, twap.Asset1Denom)
+	key2 := types.FormatCheckpointPoolIndexKey(resolution.Name, twap.PoolId, twap.Asset0Denom, twap.Asset1Denom, twap.Time)
+	store.Delete(key1)
+	store.Delete(key2)
+}
+
+// pruneCheckpointsBeforeTime prunes all checkpoints of the given resolution before the given time.
+// Unlike for records, no checkpoint older than lastKeptTime is kept, as the checkpoints of the
+// next coarser resolution already cover the time range before it.
+func (k Keeper) pruneCheckpointsBeforeTime(ctx sdk.Context, resolution types.CheckpointResolution, lastKeptTime time.Time) error {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(
+		types.FormatCheckpointTimeIndexPrefix(resolution.Name),
+		types.FormatCheckpointTimeIndexKey(resolution.Name, lastKeptTime, 0, "", ""))
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		twapToRemove, err := types.ParseTwapFromBz(iter.Value())
+		if err != nil {
+			return err
+		}
+		k.deleteCheckpoint(ctx, resolution, twapToRemove)
+	}
+	return nil
+}
+
+// getAllCheckpoints returns all twap checkpoints, ordered by resolution and then by time.
+func (k Keeper) getAllCheckpoints(ctx sdk.Context) ([]types.TwapCheckpoint, error) {
+	store := ctx.KVStore(k.storeKey)
+	checkpoints := []types.TwapCheckpoint{}
+	for _, resolution := range types.CheckpointResolutions {
+		records, err := osmoutils.GatherValuesFromStorePrefix(store, types.FormatCheckpointTimeIndexPrefix(resolution.Name), types.ParseTwapFromBz)
+		if err != nil {
+			return nil, err
+		}
+		for _, record := range records {
+			checkpoints = append(checkpoints, types.TwapCheckpoint{Resolution: resolution.Name, Record: record})
+		}
+	}
+	return checkpoints, nil
+}
+
+// getCheckpointAtOrBeforeTime on a given input (id, t, asset0, asset1)
+// returns the twap checkpoint from state for (id, t', asset0, asset1),
+// where t' is the latest checkpoint time at or before t, looking through
+// checkpoint resolutions from the finest to the coarsest.
+//
+// This returns timeTooOldError if there is no checkpoint at or before t for any resolution.
+// Asset denoms must be given in lexicographical order.
+func (k Keeper) getCheckpointAtOrBeforeTime(ctx sdk.Context, poolId uint64, t time.Time, asset0Denom string, asset1Denom string) (types.TwapRecord, error) {
+	store := ctx.KVStore(k.storeKey)
+	reverseIterate := true
+	for _, resolution := range types.CheckpointResolutions {
+		// We make an iteration from time=t, to time=0 for this pool.
+		startKey := types.FormatCheckpointPoolIndexTimePrefix(resolution.Name, poolId, asset0Denom, asset1Denom)
+		endKey := types.FormatCheckpointPoolIndexTimeSuffix(resolution.Name, poolId, asset0Denom, asset1Denom, t)
+		twap, err := osmoutils.GetFirstValueInRange(store, startKey, endKey, reverseIterate, types.ParseTwapFromBz)
+		if err == nil {
+			return twap, nil
+		}
+	}
+	return types.TwapRecord{}, timeTooOldError{Time: t}
+}
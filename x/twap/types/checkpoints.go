@@ -0,0 +1,39 @@
+package types
+
+import (
+	"fmt"
+	"time"
+)
+
+// CheckpointResolution defines a granularity at which downsampled twap records, called checkpoints,
+// are kept past the record history keep period.
+// Checkpoints are taken at every multiple of Interval (in UTC), and are kept for KeepPeriod.
+type CheckpointResolution struct {
+	// Name identifies the resolution in store keys and genesis.
+	Name       string
+	Interval   time.Duration
+	KeepPeriod time.Duration
+}
+
+var (
+	HourlyCheckpointResolution = CheckpointResolution{Name: "hour", Interval: time.Hour, KeepPeriod: 30 * 24 * time.Hour}
+	DailyCheckpointResolution  = CheckpointResolution{Name: "day", Interval: 24 * time.Hour, KeepPeriod: 365 * 24 * time.Hour}
+
+	// CheckpointResolutions are all checkpoint resolutions, ordered from the finest to the coarsest.
+	CheckpointResolutions = []CheckpointResolution{HourlyCheckpointResolution, DailyCheckpointResolution}
+)
+
+// GetCheckpointResolution returns the checkpoint resolution with the given name.
+func GetCheckpointResolution(name string) (CheckpointResolution, error) {
+	for _, resolution := range CheckpointResolutions {
+		if resolution.Name == name {
+			return resolution, nil
+		}
+	}
+	return CheckpointResolution{}, fmt.Errorf("unknown twap checkpoint resolution (%s)", name)
+}
+
+// CheckpointTime returns the latest checkpoint time of this resolution at or before t.
+func (r CheckpointResolution) CheckpointTime(t time.Time) time.Time {
+	return t.UTC().Truncate(r.Interval)
+}
@@ -0,0 +1,44 @@
+package types
+
+import (
+	"testing"
+	time "time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckpointTime(t *testing.T) {
+	// 2009-11-10 23:00:00 UTC
+	fullHour := time.Unix(1257894000, 0).UTC()
+	fullDay := time.Date(2009, 11, 10, 0, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		resolution CheckpointResolution
+		time       time.Time
+		want       time.Time
+	}{
+		"hour: at checkpoint time":         {HourlyCheckpointResolution, fullHour, fullHour},
+		"hour: after checkpoint time":      {HourlyCheckpointResolution, fullHour.Add(59*time.Minute + time.Second), fullHour},
+		"hour: non UTC location":           {HourlyCheckpointResolution, fullHour.Add(time.Minute).In(time.FixedZone("", 30*60)), fullHour},
+		"day: at checkpoint time":          {DailyCheckpointResolution, fullDay, fullDay},
+		"day: after checkpoint time":       {DailyCheckpointResolution, fullHour, fullDay},
+		"day: just before checkpoint time": {DailyCheckpointResolution, fullDay.Add(-time.Nanosecond), fullDay.Add(-24 * time.Hour)},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := tt.resolution.CheckpointTime(tt.time)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGetCheckpointResolution(t *testing.T) {
+	for _, resolution := range CheckpointResolutions {
+		got, err := GetCheckpointResolution(resolution.Name)
+		require.NoError(t, err)
+		require.Equal(t, resolution, got)
+	}
+
+	_, err := GetCheckpointResolution("week")
+	require.Error(t, err)
+}
@@ -1,10 +1,15 @@
 package types
 
 import (
+	"errors"
 	"fmt"
 	time "time"
 )
 
+// ErrSpotPriceErrorInWindow is returned along with a twap when a spot price error
+// occurred between the start and end time of the twap, as the twap may then be faulty.
+var ErrSpotPriceErrorInWindow = errors.New("twap: error in pool spot price occurred between start and end time, twap result may be faulty")
+
 type EndTimeInFutureError struct {
 	EndTime   time.Time
 	BlockTime time.Time
@@ -62,3 +67,32 @@ type InvalidRecordCountError struct {
 func (e InvalidRecordCountError) Error() string {
 	return fmt.Sprintf("The number of records do not match, expected: %d\n got: %d", e.Expected, e.Actual)
 }
+
+type EmptyRouteError struct{}
+
+func (e EmptyRouteError) Error() string {
+	return "twap route must contain at least one hop"
+}
+
+// RouteHopError is returned when getting the twap of a hop of a route fails.
+// It describes the hop, along with how recent its price data is.
+// LastRecordTime is zero if the hop has no twap record.
+type RouteHopError struct {
+	HopIndex       int
+	PoolId         uint64
+	BaseAsset      string
+	QuoteAsset     string
+	LastRecordTime time.Time
+	Staleness      time.Duration
+	Err            error
+}
+
+func (e RouteHopError) Error() string {
+	return fmt.Sprintf("error getting twap for route hop %d (pool id %d, base asset %s, quote asset %s,"+
+		" last record time %s, staleness %s): %s",
+		e.HopIndex, e.PoolId, e.BaseAsset, e.QuoteAsset, e.LastRecordTime, e.Staleness, e.Err)
+}
+
+func (e RouteHopError) Unwrap() error {
+	return e.Err
+}
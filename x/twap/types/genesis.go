@@ -29,9 +29,35 @@ func (g *GenesisState) Validate() error {
 			return err
 		}
 	}
+
+	for _, checkpoint := range g.Checkpoints {
+		if err := checkpoint.validate(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+// validate validates the twap checkpoint, returns nil on success, error otherwise.
+func (c TwapCheckpoint) validate() error {
+	resolution, err := GetCheckpointResolution(c.Resolution)
+	if err != nil {
+		return err
+	}
+
+	if !resolution.CheckpointTime(c.Record.Time).Equal(c.Record.Time) {
+		return fmt.Errorf("twap checkpoint time must be a multiple of the %s resolution interval, was (%s)", resolution.Name, c.Record.Time)
+	}
+
+	// A checkpoint is interpolated from the record before it, so it carries the zero spot prices
+	// of that record if it had an error, although its time is after the error time.
+	record := c.Record
+	if record.LastErrorTime.Before(record.Time) && !record.P0LastSpotPrice.IsNil() && record.P0LastSpotPrice.IsZero() {
+		record.LastErrorTime = record.Time
+	}
+	return record.validate()
+}
+
 // validate validates the twap record, returns nil on success, error otherwise.
 func (t TwapRecord) validate() error {
 	if t.PoolId == 0 {
@@ -81,5 +107,10 @@ func (t TwapRecord) validate() error {
 	if t.P1ArithmeticTwapAccumulator.IsNil() || t.P1ArithmeticTwapAccumulator.IsNegative() {
 		return fmt.Errorf("twap record p1 accumulator cannot be negative, was (%s)", t.P1ArithmeticTwapAccumulator)
 	}
+
+	// the geometric accumulator sums logarithms of prices, so unlike the arithmetic ones it may be negative.
+	if t.GeometricTwapAccumulator.IsNil() {
+		return errors.New("twap record geometric accumulator cannot be nil")
+	}
 	return nil
 }
@@ -82,19 +82,77 @@ func (m *Params) GetRecordHistoryKeepPeriod() time.Duration {
 	return 0
 }
 
+// TwapCheckpoint is a twap record kept at a coarse resolution, past the record
+// history keep period, for serving TWAPs over longer time ranges.
+type TwapCheckpoint struct {
+	// resolution is the name of the checkpoint resolution, e.g. "hour" or "day".
+	Resolution string `protobuf:"bytes,1,opt,name=resolution,proto3" json:"resolution,omitempty"`
+	// record is the twap record at the checkpoint time.
+	Record TwapRecord `protobuf:"bytes,2,opt,name=record,proto3" json:"record"`
+}
+
+func (m *TwapCheckpoint) Reset()         { *m = TwapCheckpoint{} }
+func (m *TwapCheckpoint) String() string { return proto.CompactTextString(m) }
+func (*TwapCheckpoint) ProtoMessage()    {}
+func (*TwapCheckpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3f4bdf49b69bd63c, []int{1}
+}
+func (m *TwapCheckpoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TwapCheckpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TwapCheckpoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TwapCheckpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TwapCheckpoint.Merge(m, src)
+}
+func (m *TwapCheckpoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *TwapCheckpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_TwapCheckpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TwapCheckpoint proto.InternalMessageInfo
+
+func (m *TwapCheckpoint) GetResolution() string {
+	if m != nil {
+		return m.Resolution
+	}
+	return ""
+}
+
+func (m *TwapCheckpoint) GetRecord() TwapRecord {
+	if m != nil {
+		return m.Record
+	}
+	return TwapRecord{}
+}
+
 // GenesisState defines the twap module's genesis state.
 type GenesisState struct {
 	// twaps is the collection of all twap records.
 	Twaps []TwapRecord `protobuf:"bytes,1,rep,name=twaps,proto3" json:"twaps"`
 	// params is the container of twap parameters.
 	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// checkpoints is the collection of all twap checkpoint records.
+	Checkpoints []TwapCheckpoint `protobuf:"bytes,3,rep,name=checkpoints,proto3" json:"checkpoints"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3f4bdf49b69bd63c, []int{1}
+	return fileDescriptor_3f4bdf49b69bd63c, []int{2}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -137,8 +195,16 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetCheckpoints() []TwapCheckpoint {
+	if m != nil {
+		return m.Checkpoints
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.twap.v1beta1.Params")
+	proto.RegisterType((*TwapCheckpoint)(nil), "osmosis.twap.v1beta1.TwapCheckpoint")
 	proto.RegisterType((*GenesisState)(nil), "osmosis.twap.v1beta1.GenesisState")
 }
 
@@ -147,32 +213,36 @@ func init() {
 }
 
 var fileDescriptor_3f4bdf49b69bd63c = []byte{
-	// 395 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xbd, 0x0e, 0xd3, 0x30,
-	0x14, 0x85, 0x63, 0x7e, 0x2a, 0x91, 0x32, 0x45, 0x15, 0xb4, 0x15, 0x4a, 0x43, 0x06, 0xd4, 0xa5,
-	0x36, 0x2d, 0x4c, 0x15, 0x53, 0x05, 0xe2, 0x6f, 0xa9, 0x02, 0x13, 0x4b, 0xe4, 0x24, 0xb7, 0xa9,
-	0x45, 0x13, 0x5b, 0xb6, 0xd3, 0x92, 0x07, 0x40, 0x62, 0x64, 0xe4, 0x89, 0x50, 0xc7, 0x8e, 0x4c,
-	0x05, 0xb5, 0x6f, 0xc0, 0x13, 0xa0, 0xc4, 0x2e, 0x03, 0x2a, 0x5b, 0xae, 0xbe, 0x73, 0x8e, 0x4e,
-	0xee, 0xb5, 0x1b, 0x72, 0x55, 0x70, 0xc5, 0x14, 0xd1, 0x3b, 0x2a, 0xc8, 0x76, 0x9a, 0x80, 0xa6,
-	0x53, 0x92, 0x43, 0x09, 0x8a, 0x29, 0x2c, 0x24, 0xd7, 0xdc, 0xeb, 0x59, 0x0d, 0x6e, 0x34, 0xd8,
-	0x6a, 0x86, 0xbd, 0x9c, 0xe7, 0xbc, 0x15, 0x90, 0xe6, 0xcb, 0x68, 0x87, 0x8f, 0xae, 0xe6, 0x35,
-	0x43, 0x2c, 0x21, 0xe5, 0x32, 0xb3, 0xba, 0x41, 0xce, 0x79, 0xbe, 0x01, 0xd2, 0x4e, 0x49, 0xb5,
-	0x22, 0xb4, 0xac, 0x2f, 0x28, 0x6d, 0x33, 0x62, 0x93, 0x6d, 0x06, 0x8b, 0xfc, 0x7f, 0x5d, 0x59,
-	0x25, 0xa9, 0x66, 0xbc, 0x34, 0x3c, 0xfc, 0x8e, 0xdc, 0xce, 0x92, 0x4a, 0x5a, 0x28, 0xef, 0xa9,
-	0x7b, 0x4f, 0xc8, 0xaa, 0x84, 0x18, 0x04, 0x4f, 0xd7, 0x31, 0xcb, 0xa0, 0xd4, 0x6c, 0xc5, 0x40,
-	0xf6, 0x51, 0x80, 0xc6, 0x77, 0xa2, 0x5e, 0x4b, 0x5f, 0x34, 0xf0, 0xf5, 0x5f, 0xe6, 0x7d, 0x46,
-	0xee, 0xd0, 0xf4, 0x8c, 0xd7, 0x4c, 0x69, 0x2e, 0xeb, 0xf8, 0x23, 0x80, 0x88, 0x05, 0x48, 0xc6,
-	0xb3, 0xfe, 0x8d, 0x00, 0x8d, 0xbb, 0xb3, 0x01, 0x36, 0x35, 0xf0, 0xa5, 0x06, 0x7e, 0x6e, 0x6b,
-	0x2c, 0x26, 0xfb, 0xe3, 0xc8, 0xf9, 0x7d, 0x1c, 0x3d, 0xac, 0x69, 0xb1, 0x99, 0x87, 0xff, 0x8f,
-	0x0a, 0xbf, 0xfd, 0x1c, 0xa1, 0xe8, 0xbe, 0x11, 0xbc, 0x32, 0xfc, 0x2d, 0x80, 0x58, 0x1a, 0xfa,
-	0x05, 0xb9, 0x77, 0x5f, 0x9a, 0x23, 0xbc, 0xd3, 0x54, 0x83, 0xf7, 0xcc, 0xbd, 0xdd, 0x2c, 0x51,
-	0xf5, 0x51, 0x70, 0x73, 0xdc, 0x9d, 0x05, 0xf8, 0xda, 0x4d, 0xf0, 0xfb, 0x1d, 0x15, 0x51, 0x1b,
-	0xb9, 0xb8, 0xd5, 0x34, 0x89, 0x8c, 0xc9, 0x9b, 0xbb, 0x1d, 0xd1, 0xae, 0xc5, 0xfe, 0xc1, 0x83,
-	0xeb, 0x76, 0xb3, 0x3a, 0x6b, 0xb5, 0x8e, 0xc5, 0x9b, 0xfd, 0xc9, 0x47, 0x87, 0x93, 0x8f, 0x7e,
-	0x9d, 0x7c, 0xf4, 0xf5, 0xec, 0x3b, 0x87, 0xb3, 0xef, 0xfc, 0x38, 0xfb, 0xce, 0x87, 0xc7, 0x39,
-	0xd3, 0xeb, 0x2a, 0xc1, 0x29, 0x2f, 0x88, 0xcd, 0x9b, 0x6c, 0x68, 0xa2, 0x2e, 0x03, 0xd9, 0x4e,
-	0x67, 0xe4, 0x93, 0x79, 0x09, 0xba, 0x16, 0xa0, 0x92, 0x4e, 0xbb, 0xb1, 0x27, 0x7f, 0x02, 0x00,
-	0x00, 0xff, 0xff, 0x34, 0xde, 0xf1, 0x35, 0x76, 0x02, 0x00, 0x00,
+	// 451 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0x3f, 0x6f, 0xd3, 0x40,
+	0x14, 0xcf, 0x51, 0x88, 0xc4, 0x15, 0x31, 0x58, 0x11, 0xa4, 0x11, 0x72, 0x82, 0x85, 0x50, 0x97,
+	0xde, 0x91, 0xc2, 0x54, 0x21, 0x86, 0x00, 0xe2, 0xef, 0x50, 0x05, 0x26, 0x16, 0xeb, 0xec, 0xbc,
+	0x3a, 0xa7, 0x26, 0x7e, 0xa7, 0xbb, 0x73, 0x4b, 0x3e, 0x00, 0x3b, 0x23, 0x9f, 0x08, 0x75, 0xec,
+	0x84, 0x98, 0x0a, 0x4a, 0xbe, 0x01, 0x9f, 0x00, 0xf9, 0xee, 0x5c, 0x2a, 0x94, 0x0e, 0xdd, 0xfc,
+	0xfc, 0xfb, 0xf3, 0x7e, 0xcf, 0x3f, 0xd3, 0x04, 0xcd, 0x1c, 0x8d, 0x34, 0xdc, 0x1e, 0x0b, 0xc5,
+	0x8f, 0x86, 0x19, 0x58, 0x31, 0xe4, 0x05, 0x94, 0x60, 0xa4, 0x61, 0x4a, 0xa3, 0xc5, 0xa8, 0x13,
+	0x38, 0xac, 0xe6, 0xb0, 0xc0, 0xe9, 0x75, 0x0a, 0x2c, 0xd0, 0x11, 0x78, 0xfd, 0xe4, 0xb9, 0xbd,
+	0x87, 0x6b, 0xfd, 0xea, 0x21, 0xd5, 0x90, 0xa3, 0x9e, 0x04, 0xde, 0x56, 0x81, 0x58, 0xcc, 0x80,
+	0xbb, 0x29, 0xab, 0x0e, 0xb8, 0x28, 0x17, 0x0d, 0x94, 0x3b, 0x8f, 0xd4, 0x7b, 0xfb, 0x21, 0x40,
+	0xf1, 0xff, 0xaa, 0x49, 0xa5, 0x85, 0x95, 0x58, 0x7a, 0x3c, 0xf9, 0x4e, 0x68, 0x7b, 0x5f, 0x68,
+	0x31, 0x37, 0xd1, 0x13, 0x7a, 0x47, 0xe9, 0xaa, 0x84, 0x14, 0x14, 0xe6, 0xd3, 0x54, 0x4e, 0xa0,
+	0xb4, 0xf2, 0x40, 0x82, 0xee, 0x92, 0x01, 0xd9, 0xbe, 0x39, 0xee, 0x38, 0xf4, 0x65, 0x0d, 0xbe,
+	0x39, 0xc7, 0xa2, 0x2f, 0x84, 0xf6, 0x7c, 0xce, 0x74, 0x2a, 0x8d, 0x45, 0xbd, 0x48, 0x0f, 0x01,
+	0x54, 0xaa, 0x40, 0x4b, 0x9c, 0x74, 0xaf, 0x0d, 0xc8, 0xf6, 0xe6, 0xee, 0x16, 0xf3, 0x31, 0x58,
+	0x13, 0x83, 0xbd, 0x08, 0x31, 0x46, 0x3b, 0x27, 0x67, 0xfd, 0xd6, 0x9f, 0xb3, 0xfe, 0xfd, 0x85,
+	0x98, 0xcf, 0xf6, 0x92, 0xcb, 0xad, 0x92, 0x6f, 0xbf, 0xfa, 0x64, 0x7c, 0xd7, 0x13, 0x5e, 0x7b,
+	0xfc, 0x1d, 0x80, 0xda, 0xf7, 0xa8, 0xa2, 0xb7, 0x3f, 0x1e, 0x0b, 0xf5, 0x7c, 0x0a, 0xf9, 0xa1,
+	0x42, 0x59, 0xda, 0x28, 0xa6, 0x54, 0x83, 0xc1, 0x59, 0x55, 0xef, 0x09, 0x37, 0x5c, 0x78, 0x13,
+	0x3d, 0xa3, 0x6d, 0x6f, 0x16, 0x42, 0x0e, 0xd8, 0xba, 0xd6, 0x58, 0xed, 0x3a, 0x76, 0xbc, 0xd1,
+	0xf5, 0x3a, 0xeb, 0x38, 0xa8, 0x92, 0x1f, 0x84, 0xde, 0x7a, 0xe5, 0x6b, 0xff, 0x60, 0x85, 0x85,
+	0xe8, 0x29, 0xbd, 0x51, 0x2b, 0x4d, 0x97, 0x0c, 0x36, 0xae, 0xe0, 0xe7, 0x45, 0xd1, 0x1e, 0x6d,
+	0x2b, 0x57, 0x44, 0x88, 0x73, 0x6f, 0xbd, 0xdc, 0x97, 0xd5, 0x44, 0xf1, 0x8a, 0xe8, 0x3d, 0xdd,
+	0xcc, 0xcf, 0x0f, 0x37, 0xdd, 0x0d, 0xb7, 0xff, 0xc1, 0xe5, 0xfb, 0xff, 0x7d, 0xa5, 0x60, 0x74,
+	0x51, 0x3e, 0x7a, 0x7b, 0xb2, 0x8c, 0xc9, 0xe9, 0x32, 0x26, 0xbf, 0x97, 0x31, 0xf9, 0xba, 0x8a,
+	0x5b, 0xa7, 0xab, 0xb8, 0xf5, 0x73, 0x15, 0xb7, 0x3e, 0x3d, 0x2a, 0xa4, 0x9d, 0x56, 0x19, 0xcb,
+	0x71, 0xce, 0x83, 0xf9, 0xce, 0x4c, 0x64, 0xa6, 0x19, 0xf8, 0xd1, 0x70, 0x97, 0x7f, 0xf6, 0x7f,
+	0xb2, 0x5d, 0x28, 0x30, 0x59, 0xdb, 0x35, 0xfe, 0xf8, 0xef, 0x00, 0xf9, 0xc4, 0x76, 0xcd, 0x36,
+	0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -213,6 +283,46 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TwapCheckpoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TwapCheckpoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TwapCheckpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Resolution) > 0 {
+		i -= len(m.Resolution)
+		copy(dAtA[i:], m.Resolution)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Resolution)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -233,6 +343,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Checkpoints) > 0 {
+		for iNdEx := len(m.Checkpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Checkpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -286,6 +410,21 @@ func (m *Params) Size() (n int) {
 	return n
 }
 
+func (m *TwapCheckpoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Resolution)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Record.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
 func (m *GenesisState) Size() (n int) {
 	if m == nil {
 		return 0
@@ -300,6 +439,12 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Checkpoints) > 0 {
+		for _, e := range m.Checkpoints {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -424,6 +569,121 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TwapCheckpoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TwapCheckpoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TwapCheckpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resolution", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resolution = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -520,6 +780,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checkpoints = append(m.Checkpoints, TwapCheckpoint{})
+			if err := m.Checkpoints[len(m.Checkpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
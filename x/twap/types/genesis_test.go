@@ -28,6 +28,7 @@ var (
 		P1LastSpotPrice:             sdk.OneDec(),
 		P0ArithmeticTwapAccumulator: sdk.OneDec(),
 		P1ArithmeticTwapAccumulator: sdk.OneDec(),
+		GeometricTwapAccumulator:    sdk.ZeroDec(),
 	}
 )
 
@@ -55,6 +56,7 @@ func TestGenesisState_Validate(t *testing.T) {
 					P1LastSpotPrice:             sdk.OneDec(),
 					P0ArithmeticTwapAccumulator: sdk.OneDec(),
 					P1ArithmeticTwapAccumulator: sdk.OneDec(),
+					GeometricTwapAccumulator:    sdk.ZeroDec(),
 				},
 				{
 					PoolId:                      basePoolId,
@@ -66,6 +68,7 @@ func TestGenesisState_Validate(t *testing.T) {
 					P1LastSpotPrice:             sdk.OneDec(),
 					P0ArithmeticTwapAccumulator: sdk.OneDec(),
 					P1ArithmeticTwapAccumulator: sdk.OneDec(),
+					GeometricTwapAccumulator:    sdk.ZeroDec(),
 				},
 			})
 	)
@@ -101,6 +104,7 @@ func TestGenesisState_Validate(t *testing.T) {
 						P1LastSpotPrice:             sdk.OneDec(),
 						P0ArithmeticTwapAccumulator: sdk.OneDec(),
 						P1ArithmeticTwapAccumulator: sdk.OneDec(),
+						GeometricTwapAccumulator:    sdk.ZeroDec(),
 					},
 				}),
 
@@ -144,6 +148,75 @@ func TestGenesisState_Validate(t *testing.T) {
 	}
 }
 
+func TestTwapCheckpoint_Validate(t *testing.T) {
+	// baseTime is at a full hour, but not at a full day.
+	hourRecord := baseRecord
+	hourRecord.Time = baseTime
+
+	errorRecord := hourRecord
+	errorRecord.LastErrorTime = baseTime.Add(-time.Minute)
+	errorRecord.P0LastSpotPrice = sdk.ZeroDec()
+	errorRecord.P1LastSpotPrice = sdk.ZeroDec()
+
+	testCases := map[string]struct {
+		checkpoint TwapCheckpoint
+
+		expectedErr bool
+	}{
+		"valid hourly checkpoint": {
+			checkpoint: TwapCheckpoint{Resolution: HourlyCheckpointResolution.Name, Record: hourRecord},
+		},
+		"valid daily checkpoint": {
+			checkpoint: TwapCheckpoint{Resolution: DailyCheckpointResolution.Name, Record: withTime(hourRecord, baseTime.Add(time.Hour))},
+		},
+		"valid checkpoint interpolated from a record with an error": {
+			checkpoint: TwapCheckpoint{Resolution: HourlyCheckpointResolution.Name, Record: errorRecord},
+		},
+		"unknown resolution": {
+			checkpoint:  TwapCheckpoint{Resolution: "week", Record: hourRecord},
+			expectedErr: true,
+		},
+		"time not at a full hour": {
+			checkpoint:  TwapCheckpoint{Resolution: HourlyCheckpointResolution.Name, Record: withTime(hourRecord, baseTime.Add(time.Minute))},
+			expectedErr: true,
+		},
+		"time not at a full day": {
+			checkpoint:  TwapCheckpoint{Resolution: DailyCheckpointResolution.Name, Record: hourRecord},
+			expectedErr: true,
+		},
+		"invalid record": {
+			checkpoint:  TwapCheckpoint{Resolution: HourlyCheckpointResolution.Name, Record: withPoolId(hourRecord, 0)},
+			expectedErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			genesis := DefaultGenesis()
+			genesis.Checkpoints = []TwapCheckpoint{tc.checkpoint}
+
+			err := genesis.Validate()
+
+			if tc.expectedErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func withTime(twap TwapRecord, t time.Time) TwapRecord {
+	twap.Time = t
+	return twap
+}
+
+func withPoolId(twap TwapRecord, poolId uint64) TwapRecord {
+	twap.PoolId = poolId
+	return twap
+}
+
 func TestTWAPRecord_Validate(t *testing.T) {
 	type testcase struct {
 		twapRecord  TwapRecord
@@ -229,6 +302,7 @@ func TestTWAPRecord_Validate(t *testing.T) {
 					P1LastSpotPrice:             sdk.OneDec(),
 					P0ArithmeticTwapAccumulator: sdk.OneDec(),
 					P1ArithmeticTwapAccumulator: sdk.OneDec(),
+					GeometricTwapAccumulator:    sdk.ZeroDec(),
 				}
 				return r
 			}(),
@@ -246,6 +320,7 @@ func TestTWAPRecord_Validate(t *testing.T) {
 					P0LastSpotPrice:             sdk.OneDec(),
 					P0ArithmeticTwapAccumulator: sdk.OneDec(),
 					P1ArithmeticTwapAccumulator: sdk.OneDec(),
+					GeometricTwapAccumulator:    sdk.ZeroDec(),
 				}
 				return r
 			}(),
@@ -272,6 +347,7 @@ func TestTWAPRecord_Validate(t *testing.T) {
 					P0LastSpotPrice:             sdk.OneDec(),
 					P1LastSpotPrice:             sdk.OneDec(),
 					P1ArithmeticTwapAccumulator: sdk.OneDec(),
+					GeometricTwapAccumulator:    sdk.ZeroDec(),
 				}
 				return r
 			}(),
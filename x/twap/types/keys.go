@@ -27,6 +27,8 @@ var (
 	mostRecentTWAPsNoSeparator         = "recent_twap"
 	historicalTWAPTimeIndexNoSeparator = "historical_time_index"
 	historicalTWAPPoolIndexNoSeparator = "historical_pool_index"
+	checkpointTimeIndexNoSeparator     = "checkpoint_time_index"
+	checkpointPoolIndexNoSeparator     = "checkpoint_pool_index"
 
 	// We do key management to let us easily meet the goals of (AKA minimal iteration):
 	// * Get most recent twap for a (pool id, asset 1, asset 2) with no iteration
@@ -42,6 +44,12 @@ var (
 	// format is pool id | denom1 | denom2 | time
 	// made for efficiently getting records given (pool id, denom1, denom2) and time bounds
 	HistoricalTWAPPoolIndexPrefix = historicalTWAPPoolIndexNoSeparator + KeySeparator
+	// format is resolution | time | pool id | denom1 | denom2
+	// made for efficiently pruning checkpoints of a resolution by time
+	CheckpointTimeIndexPrefix = checkpointTimeIndexNoSeparator + KeySeparator
+	// format is resolution | pool id | denom1 | denom2 | time
+	// made for efficiently getting checkpoints given (pool id, denom1, denom2) and time bounds
+	CheckpointPoolIndexPrefix = checkpointPoolIndexNoSeparator + KeySeparator
 )
 
 // TODO: make utility command to automatically interlace separators
@@ -72,6 +80,30 @@ func FormatHistoricalPoolIndexTimeSuffix(poolId uint64, denom1, denom2 string, a
 	return []byte(fmt.Sprintf("%s%d%s%s%s%s%s%s.", HistoricalTWAPPoolIndexPrefix, poolId, KeySeparator, denom1, KeySeparator, denom2, KeySeparator, timeS))
 }
 
+func FormatCheckpointTimeIndexKey(resolution string, accumulatorWriteTime time.Time, poolId uint64, denom1, denom2 string) []byte {
+	timeS := osmoutils.FormatTimeString(accumulatorWriteTime)
+	return []byte(fmt.Sprintf("%s%s%s%s%s%d%s%s%s%s", CheckpointTimeIndexPrefix, resolution, KeySeparator, timeS, KeySeparator, poolId, KeySeparator, denom1, KeySeparator, denom2))
+}
+
+func FormatCheckpointTimeIndexPrefix(resolution string) []byte {
+	return []byte(fmt.Sprintf("%s%s%s", CheckpointTimeIndexPrefix, resolution, KeySeparator))
+}
+
+func FormatCheckpointPoolIndexKey(resolution string, poolId uint64, denom1, denom2 string, accumulatorWriteTime time.Time) []byte {
+	timeS := osmoutils.FormatTimeString(accumulatorWriteTime)
+	return []byte(fmt.Sprintf("%s%s%s%d%s%s%s%s%s%s", CheckpointPoolIndexPrefix, resolution, KeySeparator, poolId, KeySeparator, denom1, KeySeparator, denom2, KeySeparator, timeS))
+}
+
+func FormatCheckpointPoolIndexTimePrefix(resolution string, poolId uint64, denom1, denom2 string) []byte {
+	return []byte(fmt.Sprintf("%s%s%s%d%s%s%s%s%s", CheckpointPoolIndexPrefix, resolution, KeySeparator, poolId, KeySeparator, denom1, KeySeparator, denom2, KeySeparator))
+}
+
+func FormatCheckpointPoolIndexTimeSuffix(resolution string, poolId uint64, denom1, denom2 string, accumulatorWriteTime time.Time) []byte {
+	timeS := osmoutils.FormatTimeString(accumulatorWriteTime)
+	// . acts as a suffix for lexicographical orderings
+	return []byte(fmt.Sprintf("%s%s%s%d%s%s%s%s%s%s.", CheckpointPoolIndexPrefix, resolution, KeySeparator, poolId, KeySeparator, denom1, KeySeparator, denom2, KeySeparator, timeS))
+}
+
 // GetAllMostRecentTwapsForPool returns all of the most recent twap records for a pool id.
 // if the pool id doesn't exist, then this returns a blank list.
 func GetAllMostRecentTwapsForPool(store sdk.KVStore, poolId uint64) ([]TwapRecord, error) {
@@ -59,6 +59,39 @@ func TestFormatHistoricalTwapKeys(t *testing.T) {
 	}
 }
 
+func TestFormatCheckpointKeys(t *testing.T) {
+	baseTime := time.Unix(1257894000, 0).UTC()
+	tests := map[string]struct {
+		resolution    string
+		poolId        uint64
+		time          time.Time
+		denom1        string
+		denom2        string
+		wantPoolIndex string
+		wantTimeIndex string
+	}{
+		"hour": {resolution: "hour", poolId: 1, time: baseTime, denom1: "B", denom2: "A", wantTimeIndex: "checkpoint_time_index|hour|2009-11-10T23:00:00.000000000|1|B|A", wantPoolIndex: "checkpoint_pool_index|hour|1|B|A|2009-11-10T23:00:00.000000000"},
+		"day":  {resolution: "day", poolId: 1, time: baseTime, denom1: "B", denom2: "A", wantTimeIndex: "checkpoint_time_index|day|2009-11-10T23:00:00.000000000|1|B|A", wantPoolIndex: "checkpoint_pool_index|day|1|B|A|2009-11-10T23:00:00.000000000"},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotTimeKey := FormatCheckpointTimeIndexKey(tt.resolution, tt.time, tt.poolId, tt.denom1, tt.denom2)
+			gotPoolKey := FormatCheckpointPoolIndexKey(tt.resolution, tt.poolId, tt.denom1, tt.denom2, tt.time)
+			require.Equal(t, tt.wantTimeIndex, string(gotTimeKey))
+			require.Equal(t, tt.wantPoolIndex, string(gotPoolKey))
+
+			timeIndexPrefix := FormatCheckpointTimeIndexPrefix(tt.resolution)
+			require.True(t, strings.HasPrefix(string(gotTimeKey), string(timeIndexPrefix)))
+
+			poolIndexPrefix := FormatCheckpointPoolIndexTimePrefix(tt.resolution, tt.poolId, tt.denom1, tt.denom2)
+			require.True(t, strings.HasPrefix(string(gotPoolKey), string(poolIndexPrefix)), string(gotPoolKey), string(poolIndexPrefix))
+
+			poolIndexSuffix := FormatCheckpointPoolIndexTimeSuffix(tt.resolution, tt.poolId, tt.denom1, tt.denom2, tt.time)
+			require.True(t, strings.HasPrefix(string(poolIndexSuffix), string(gotPoolKey)))
+		})
+	}
+}
+
 func TestParseTwapFromBz(t *testing.T) {
 	baseTime := time.Unix(1257894000, 0).UTC()
 	tests := map[string]struct {
@@ -74,6 +107,7 @@ func TestParseTwapFromBz(t *testing.T) {
 			P1LastSpotPrice:             sdk.NewDecWithPrec(2, 5), // inconsistent value
 			P0ArithmeticTwapAccumulator: sdk.ZeroDec(),
 			P1ArithmeticTwapAccumulator: sdk.ZeroDec(),
+			GeometricTwapAccumulator:    sdk.ZeroDec(),
 		}},
 	}
 	for name, tt := range tests {
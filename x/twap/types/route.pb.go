@@ -0,0 +1,790 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/twap/v1beta1/route.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TwapRouteHop is a hop of a route of pools to get a twap across, for asset
+// pairs without a pool holding both assets. Like gamm's SwapAmountInRoute, the
+// base asset of a hop is the quote asset of the previous hop, or the route's
+// base asset for the first hop.
+type TwapRouteHop struct {
+	PoolId     uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	QuoteAsset string `protobuf:"bytes,2,opt,name=quote_asset,json=quoteAsset,proto3" json:"quote_asset,omitempty" yaml:"quote_asset"`
+}
+
+func (m *TwapRouteHop) Reset()         { *m = TwapRouteHop{} }
+func (m *TwapRouteHop) String() string { return proto.CompactTextString(m) }
+func (*TwapRouteHop) ProtoMessage()    {}
+func (*TwapRouteHop) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2ad27665ccbc8fb4, []int{0}
+}
+func (m *TwapRouteHop) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TwapRouteHop) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TwapRouteHop.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TwapRouteHop) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TwapRouteHop.Merge(m, src)
+}
+func (m *TwapRouteHop) XXX_Size() int {
+	return m.Size()
+}
+func (m *TwapRouteHop) XXX_DiscardUnknown() {
+	xxx_messageInfo_TwapRouteHop.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TwapRouteHop proto.InternalMessageInfo
+
+func (m *TwapRouteHop) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *TwapRouteHop) GetQuoteAsset() string {
+	if m != nil {
+		return m.QuoteAsset
+	}
+	return ""
+}
+
+// TwapHopInfo is the twap of a single hop of a route, along with how recent
+// the price data of the hop is.
+type TwapHopInfo struct {
+	PoolId     uint64                                 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	BaseAsset  string                                 `protobuf:"bytes,2,opt,name=base_asset,json=baseAsset,proto3" json:"base_asset,omitempty" yaml:"base_asset"`
+	QuoteAsset string                                 `protobuf:"bytes,3,opt,name=quote_asset,json=quoteAsset,proto3" json:"quote_asset,omitempty" yaml:"quote_asset"`
+	Twap       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=twap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"twap" yaml:"twap"`
+	// last_record_time is the time of the most recent twap record of the hop's
+	// pool and assets, i.e. the last block in which the pool's price could have
+	// changed.
+	LastRecordTime time.Time `protobuf:"bytes,5,opt,name=last_record_time,json=lastRecordTime,proto3,stdtime" json:"last_record_time" yaml:"last_record_time"`
+	// staleness is the time elapsed from last_record_time to the current block
+	// time.
+	Staleness time.Duration `protobuf:"bytes,6,opt,name=staleness,proto3,stdduration" json:"staleness" yaml:"staleness"`
+}
+
+func (m *TwapHopInfo) Reset()         { *m = TwapHopInfo{} }
+func (m *TwapHopInfo) String() string { return proto.CompactTextString(m) }
+func (*TwapHopInfo) ProtoMessage()    {}
+func (*TwapHopInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2ad27665ccbc8fb4, []int{1}
+}
+func (m *TwapHopInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TwapHopInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TwapHopInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TwapHopInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TwapHopInfo.Merge(m, src)
+}
+func (m *TwapHopInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *TwapHopInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_TwapHopInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TwapHopInfo proto.InternalMessageInfo
+
+func (m *TwapHopInfo) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *TwapHopInfo) GetBaseAsset() string {
+	if m != nil {
+		return m.BaseAsset
+	}
+	return ""
+}
+
+func (m *TwapHopInfo) GetQuoteAsset() string {
+	if m != nil {
+		return m.QuoteAsset
+	}
+	return ""
+}
+
+func (m *TwapHopInfo) GetLastRecordTime() time.Time {
+	if m != nil {
+		return m.LastRecordTime
+	}
+	return time.Time{}
+}
+
+func (m *TwapHopInfo) GetStaleness() time.Duration {
+	if m != nil {
+		return m.Staleness
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*TwapRouteHop)(nil), "osmosis.twap.v1beta1.TwapRouteHop")
+	proto.RegisterType((*TwapHopInfo)(nil), "osmosis.twap.v1beta1.TwapHopInfo")
+}
+
+func init() { proto.RegisterFile("osmosis/twap/v1beta1/route.proto", fileDescriptor_2ad27665ccbc8fb4) }
+
+var fileDescriptor_2ad27665ccbc8fb4 = []byte{
+	// 459 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0x8d, 0x69, 0x08, 0xca, 0x06, 0x55, 0xc5, 0x2a, 0x60, 0x22, 0xe4, 0x8d, 0x8c, 0x84, 0x22,
+	0xa1, 0x7a, 0x49, 0x41, 0x42, 0x42, 0xe2, 0x80, 0xd5, 0x43, 0xcb, 0x0d, 0xab, 0x5c, 0xb8, 0x44,
+	0xeb, 0x78, 0x6b, 0x2c, 0xec, 0xcc, 0xe2, 0x5d, 0xb7, 0xf4, 0x5f, 0xf4, 0xc8, 0xaf, 0xe1, 0xdc,
+	0x63, 0x8f, 0x88, 0x83, 0x41, 0xc9, 0x3f, 0xf0, 0x2f, 0x40, 0xfb, 0x11, 0x1a, 0xc2, 0x05, 0x4e,
+	0xbb, 0x6f, 0xe7, 0xcd, 0x7b, 0xa3, 0x7d, 0x83, 0x46, 0x20, 0x4a, 0x10, 0xb9, 0x20, 0xf2, 0x8c,
+	0x72, 0x72, 0x3a, 0x49, 0x98, 0xa4, 0x13, 0x52, 0x41, 0x2d, 0x59, 0xc8, 0x2b, 0x90, 0xe0, 0xee,
+	0x5a, 0x46, 0xa8, 0x18, 0xa1, 0x65, 0x0c, 0x77, 0x33, 0xc8, 0x40, 0x13, 0x88, 0xba, 0x19, 0xee,
+	0x10, 0x67, 0x00, 0x59, 0xc1, 0x88, 0x46, 0x49, 0x7d, 0x42, 0x64, 0x5e, 0x32, 0x21, 0x69, 0xc9,
+	0x2d, 0xc1, 0xdf, 0x24, 0xa4, 0x75, 0x45, 0x65, 0x0e, 0x73, 0x53, 0x0f, 0x24, 0xba, 0x7d, 0x7c,
+	0x46, 0x79, 0xac, 0xfc, 0x0f, 0x81, 0xbb, 0x4f, 0xd0, 0x2d, 0x0e, 0x50, 0x4c, 0xf3, 0xd4, 0x73,
+	0x46, 0xce, 0xb8, 0x1b, 0xb9, 0x6d, 0x83, 0xb7, 0xcf, 0x69, 0x59, 0xbc, 0x0c, 0x6c, 0x21, 0x88,
+	0x7b, 0xea, 0x76, 0x94, 0xba, 0x2f, 0xd0, 0xe0, 0x53, 0x0d, 0x92, 0x4d, 0xa9, 0x10, 0x4c, 0x7a,
+	0x37, 0x46, 0xce, 0xb8, 0x1f, 0xdd, 0x6b, 0x1b, 0xec, 0x9a, 0x86, 0xb5, 0x62, 0x10, 0x23, 0x8d,
+	0x5e, 0x6b, 0xf0, 0x75, 0x0b, 0x0d, 0x94, 0xed, 0x21, 0xf0, 0xa3, 0xf9, 0x09, 0xfc, 0x9f, 0xeb,
+	0x73, 0x84, 0x12, 0x2a, 0xfe, 0x34, 0xbd, 0xdb, 0x36, 0xf8, 0x8e, 0xe1, 0x5f, 0xd7, 0x82, 0xb8,
+	0xaf, 0x80, 0xb6, 0xdc, 0x9c, 0x75, 0xeb, 0x5f, 0x67, 0x75, 0xdf, 0xa2, 0xae, 0x0a, 0xc2, 0xeb,
+	0xea, 0x8e, 0x57, 0x97, 0x0d, 0xee, 0x7c, 0x6f, 0xf0, 0xe3, 0x2c, 0x97, 0x1f, 0xea, 0x24, 0x9c,
+	0x41, 0x49, 0x66, 0x3a, 0x30, 0x7b, 0xec, 0x89, 0xf4, 0x23, 0x91, 0xe7, 0x9c, 0x89, 0xf0, 0x80,
+	0xcd, 0xda, 0x06, 0x0f, 0x8c, 0xbe, 0xd2, 0x08, 0x62, 0x2d, 0xe5, 0xe6, 0x68, 0xa7, 0xa0, 0x42,
+	0x4e, 0x2b, 0x36, 0x83, 0x2a, 0x9d, 0xaa, 0xcc, 0xbc, 0x9b, 0x23, 0x67, 0x3c, 0xd8, 0x1f, 0x86,
+	0x26, 0xaf, 0x70, 0x95, 0x57, 0x78, 0xbc, 0x0a, 0x34, 0x7a, 0xa4, 0xac, 0xdb, 0x06, 0xdf, 0x37,
+	0x82, 0x9b, 0x0a, 0xc1, 0xc5, 0x0f, 0xec, 0xc4, 0xdb, 0xea, 0x39, 0xd6, 0xaf, 0xaa, 0xd3, 0x7d,
+	0x87, 0xfa, 0x42, 0xd2, 0x82, 0xcd, 0x99, 0x10, 0x5e, 0x4f, 0x7b, 0x3c, 0xf8, 0xcb, 0xe3, 0xc0,
+	0xee, 0x44, 0xf4, 0xd0, 0x5a, 0xec, 0x18, 0x8b, 0xdf, 0x9d, 0xc1, 0x17, 0xa5, 0x7d, 0xad, 0x14,
+	0xbd, 0xb9, 0x5c, 0xf8, 0xce, 0xd5, 0xc2, 0x77, 0x7e, 0x2e, 0x7c, 0xe7, 0x62, 0xe9, 0x77, 0xae,
+	0x96, 0x7e, 0xe7, 0xdb, 0xd2, 0xef, 0xbc, 0x7f, 0xba, 0xf6, 0x31, 0x76, 0x91, 0xf7, 0x0a, 0x9a,
+	0x88, 0x15, 0x20, 0xa7, 0x93, 0x7d, 0xf2, 0xd9, 0x6c, 0xbf, 0xfe, 0xa6, 0xa4, 0xa7, 0xe7, 0x78,
+	0xf6, 0x6b, 0x00, 0x2b, 0x4a, 0xa7, 0x95, 0x1a, 0x03, 0x00, 0x00,
+}
+
+func (m *TwapRouteHop) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TwapRouteHop) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TwapRouteHop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.QuoteAsset) > 0 {
+		i -= len(m.QuoteAsset)
+		copy(dAtA[i:], m.QuoteAsset)
+		i = encodeVarintRoute(dAtA, i, uint64(len(m.QuoteAsset)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintRoute(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TwapHopInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TwapHopInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TwapHopInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Staleness, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Staleness):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintRoute(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x32
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastRecordTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastRecordTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintRoute(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Twap.Size()
+		i -= size
+		if _, err := m.Twap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRoute(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.QuoteAsset) > 0 {
+		i -= len(m.QuoteAsset)
+		copy(dAtA[i:], m.QuoteAsset)
+		i = encodeVarintRoute(dAtA, i, uint64(len(m.QuoteAsset)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BaseAsset) > 0 {
+		i -= len(m.BaseAsset)
+		copy(dAtA[i:], m.BaseAsset)
+		i = encodeVarintRoute(dAtA, i, uint64(len(m.BaseAsset)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintRoute(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRoute(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRoute(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TwapRouteHop) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovRoute(uint64(m.PoolId))
+	}
+	l = len(m.QuoteAsset)
+	if l > 0 {
+		n += 1 + l + sovRoute(uint64(l))
+	}
+	return n
+}
+
+func (m *TwapHopInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovRoute(uint64(m.PoolId))
+	}
+	l = len(m.BaseAsset)
+	if l > 0 {
+		n += 1 + l + sovRoute(uint64(l))
+	}
+	l = len(m.QuoteAsset)
+	if l > 0 {
+		n += 1 + l + sovRoute(uint64(l))
+	}
+	l = m.Twap.Size()
+	n += 1 + l + sovRoute(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastRecordTime)
+	n += 1 + l + sovRoute(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Staleness)
+	n += 1 + l + sovRoute(uint64(l))
+	return n
+}
+
+func sovRoute(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRoute(x uint64) (n int) {
+	return sovRoute(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TwapRouteHop) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRoute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TwapRouteHop: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TwapRouteHop: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRoute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRoute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRoute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TwapHopInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRoute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TwapHopInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TwapHopInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRoute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRoute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Twap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRoute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Twap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRecordTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRoute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastRecordTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staleness", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRoute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Staleness, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRoute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRoute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRoute(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRoute
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRoute
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRoute
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRoute
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRoute
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRoute
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRoute        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRoute          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRoute = fmt.Errorf("proto: unexpected end of group")
+)
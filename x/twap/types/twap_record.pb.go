@@ -55,6 +55,10 @@ type TwapRecord struct {
 	P1LastSpotPrice             github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=p1_last_spot_price,json=p1LastSpotPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"p1_last_spot_price"`
 	P0ArithmeticTwapAccumulator github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=p0_arithmetic_twap_accumulator,json=p0ArithmeticTwapAccumulator,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"p0_arithmetic_twap_accumulator"`
 	P1ArithmeticTwapAccumulator github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=p1_arithmetic_twap_accumulator,json=p1ArithmeticTwapAccumulator,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"p1_arithmetic_twap_accumulator"`
+	// Accumulator of log2(p0_last_spot_price) * time elapsed, used to compute
+	// geometric TWAPs. The geometric TWAP of asset1 in terms of asset0 is the
+	// reciprocal of the asset0 one, so a single accumulator suffices.
+	GeometricTwapAccumulator github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=geometric_twap_accumulator,json=geometricTwapAccumulator,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"geometric_twap_accumulator"`
 	// This field contains the time in which the last spot price error occured.
 	// It is used to alert the caller if they are getting a potentially erroneous
 	// TWAP, due to an unforeseen underlying error.
@@ -136,8 +140,87 @@ func (m *TwapRecord) GetLastErrorTime() time.Time {
 	return time.Time{}
 }
 
+// TwapMetadata describes the twap records a twap was computed from, so that
+// callers can tell whether the twap is based on stale or faulty price data.
+type TwapMetadata struct {
+	// last_record_height is the height of the most recent twap record of the
+	// pool and assets, i.e. the last block in which the pool's price could have
+	// changed.
+	LastRecordHeight int64 `protobuf:"varint,1,opt,name=last_record_height,json=lastRecordHeight,proto3" json:"last_record_height,omitempty" yaml:"last_record_height"`
+	// last_record_time is the time of the most recent twap record.
+	LastRecordTime time.Time `protobuf:"bytes,2,opt,name=last_record_time,json=lastRecordTime,proto3,stdtime" json:"last_record_time" yaml:"last_record_time"`
+	// last_error_time is the time of the last spot price error as of the end of
+	// the twap window.
+	LastErrorTime time.Time `protobuf:"bytes,3,opt,name=last_error_time,json=lastErrorTime,proto3,stdtime" json:"last_error_time" yaml:"last_error_time"`
+	// window_has_error is true if last_error_time is within the twap window, in
+	// which case the twap may be faulty.
+	WindowHasError bool `protobuf:"varint,4,opt,name=window_has_error,json=windowHasError,proto3" json:"window_has_error,omitempty" yaml:"window_has_error"`
+}
+
+func (m *TwapMetadata) Reset()         { *m = TwapMetadata{} }
+func (m *TwapMetadata) String() string { return proto.CompactTextString(m) }
+func (*TwapMetadata) ProtoMessage()    {}
+func (*TwapMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dbf5c78678e601aa, []int{1}
+}
+func (m *TwapMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TwapMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TwapMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TwapMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TwapMetadata.Merge(m, src)
+}
+func (m *TwapMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *TwapMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_TwapMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TwapMetadata proto.InternalMessageInfo
+
+func (m *TwapMetadata) GetLastRecordHeight() int64 {
+	if m != nil {
+		return m.LastRecordHeight
+	}
+	return 0
+}
+
+func (m *TwapMetadata) GetLastRecordTime() time.Time {
+	if m != nil {
+		return m.LastRecordTime
+	}
+	return time.Time{}
+}
+
+func (m *TwapMetadata) GetLastErrorTime() time.Time {
+	if m != nil {
+		return m.LastErrorTime
+	}
+	return time.Time{}
+}
+
+func (m *TwapMetadata) GetWindowHasError() bool {
+	if m != nil {
+		return m.WindowHasError
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*TwapRecord)(nil), "osmosis.twap.v1beta1.TwapRecord")
+	proto.RegisterType((*TwapMetadata)(nil), "osmosis.twap.v1beta1.TwapMetadata")
 }
 
 func init() {
@@ -145,40 +228,47 @@ func init() {
 }
 
 var fileDescriptor_dbf5c78678e601aa = []byte{
-	// 523 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xc7, 0x63, 0x1a, 0x5c, 0xba, 0xa1, 0xaa, 0x64, 0x45, 0x60, 0x82, 0x64, 0x07, 0x1f, 0xaa,
-	0x70, 0xa8, 0x3f, 0xca, 0x8d, 0x5b, 0xa2, 0x72, 0x00, 0x21, 0x84, 0x4c, 0x4f, 0x70, 0x58, 0xad,
-	0xed, 0xad, 0x63, 0x61, 0x67, 0x57, 0xde, 0x4d, 0x4b, 0x5e, 0x81, 0x53, 0x1f, 0xab, 0xc7, 0x1e,
-	0x11, 0x07, 0x83, 0x92, 0x1b, 0xc7, 0x3e, 0x01, 0xda, 0x8f, 0x84, 0x26, 0x08, 0x2a, 0xe5, 0x64,
-	0xcf, 0xcc, 0x7f, 0x7e, 0x33, 0xb3, 0xb3, 0x36, 0x38, 0x24, 0xac, 0x22, 0xac, 0x60, 0x01, 0xbf,
-	0x40, 0x34, 0x38, 0x8f, 0x12, 0xcc, 0x51, 0x24, 0x0d, 0x58, 0xe3, 0x94, 0xd4, 0x99, 0x4f, 0x6b,
-	0xc2, 0x89, 0xd5, 0xd5, 0x3a, 0x5f, 0x84, 0x7c, 0xad, 0xeb, 0x75, 0x73, 0x92, 0x13, 0x29, 0x08,
-	0xc4, 0x9b, 0xd2, 0xf6, 0x9e, 0xe4, 0x84, 0xe4, 0x25, 0x0e, 0xa4, 0x95, 0x4c, 0xcf, 0x02, 0x34,
-	0x99, 0x2d, 0x43, 0xa9, 0xe4, 0x40, 0x95, 0xa3, 0x0c, 0x1d, 0x72, 0x94, 0x15, 0x24, 0x88, 0xe1,
-	0x55, 0x23, 0x29, 0x29, 0x26, 0x3a, 0xee, 0x6e, 0x52, 0x79, 0x51, 0x61, 0xc6, 0x51, 0x45, 0x95,
-	0xc0, 0xfb, 0x6a, 0x02, 0x70, 0x7a, 0x81, 0x68, 0x2c, 0xfb, 0xb6, 0x1e, 0x83, 0x5d, 0x4a, 0x48,
-	0x09, 0x8b, 0xcc, 0x36, 0xfa, 0xc6, 0xa0, 0x1d, 0x9b, 0xc2, 0x7c, 0x9d, 0x59, 0xcf, 0xc0, 0x43,
-	0xc4, 0x18, 0xe6, 0x21, 0xcc, 0xf0, 0x84, 0x54, 0xf6, 0xbd, 0xbe, 0x31, 0xd8, 0x8b, 0x3b, 0xca,
-	0x77, 0x22, 0x5c, 0x2b, 0x49, 0xa4, 0x25, 0x3b, 0xb7, 0x24, 0x91, 0x92, 0x0c, 0x81, 0x39, 0xc6,
-	0x45, 0x3e, 0xe6, 0x76, 0xbb, 0x6f, 0x0c, 0x76, 0x46, 0xcf, 0x7f, 0x35, 0xee, 0xbe, 0x3a, 0x32,
-	0xa8, 0x02, 0x37, 0x8d, 0xdb, 0x9d, 0xa1, 0xaa, 0x7c, 0xe9, 0xad, 0xb9, 0xbd, 0x58, 0x27, 0x5a,
-	0xef, 0x40, 0x5b, 0xcc, 0x60, 0xdf, 0xef, 0x1b, 0x83, 0xce, 0x71, 0xcf, 0x57, 0x03, 0xfa, 0xcb,
-	0x01, 0xfd, 0xd3, 0xe5, 0x80, 0x23, 0xe7, 0xaa, 0x71, 0x5b, 0x37, 0x8d, 0x6b, 0xad, 0xf1, 0x44,
-	0xb2, 0x77, 0xf9, 0xc3, 0x35, 0x62, 0xc9, 0xb1, 0x3e, 0x01, 0x8b, 0x86, 0xb0, 0x44, 0x8c, 0x43,
-	0x46, 0x09, 0x87, 0xb4, 0x2e, 0x52, 0x6c, 0x9b, 0xa2, 0xf7, 0x91, 0x2f, 0x08, 0xdf, 0x1b, 0xf7,
-	0x30, 0x2f, 0xf8, 0x78, 0x9a, 0xf8, 0x29, 0xa9, 0xf4, 0xf1, 0xeb, 0xc7, 0x11, 0xcb, 0x3e, 0x07,
-	0x7c, 0x46, 0x31, 0xf3, 0x4f, 0x70, 0x1a, 0x1f, 0xd0, 0xf0, 0x2d, 0x62, 0xfc, 0x03, 0x25, 0xfc,
-	0xbd, 0xc0, 0x48, 0x78, 0xf4, 0x17, 0x7c, 0x77, 0x4b, 0x78, 0xb4, 0x0e, 0x67, 0xc0, 0xa1, 0x21,
-	0x44, 0x75, 0xc1, 0xc7, 0x15, 0xe6, 0x45, 0x0a, 0xe5, 0x05, 0x44, 0x69, 0x3a, 0xad, 0xa6, 0x25,
-	0xe2, 0xa4, 0xb6, 0x1f, 0x6c, 0x55, 0xe8, 0x29, 0x0d, 0x87, 0x2b, 0xa8, 0xb8, 0x1b, 0xc3, 0x3f,
-	0x48, 0x59, 0x34, 0xfa, 0x6f, 0xd1, 0xbd, 0x2d, 0x8b, 0x46, 0xff, 0x2e, 0x7a, 0x06, 0x0e, 0xe4,
-	0x19, 0xe2, 0xba, 0x26, 0xb5, 0xdc, 0xa0, 0xdd, 0xb9, 0x73, 0xfd, 0x9e, 0x5e, 0xff, 0x23, 0xb5,
-	0xfe, 0x0d, 0x80, 0xba, 0x02, 0xfb, 0xc2, 0xfb, 0x4a, 0x38, 0x45, 0xde, 0xe8, 0xcd, 0xd5, 0xdc,
-	0x31, 0xae, 0xe7, 0x8e, 0xf1, 0x73, 0xee, 0x18, 0x97, 0x0b, 0xa7, 0x75, 0xbd, 0x70, 0x5a, 0xdf,
-	0x16, 0x4e, 0xeb, 0x63, 0x78, 0x6b, 0x0c, 0xfd, 0x51, 0x1f, 0x95, 0x28, 0x61, 0x4b, 0x23, 0x38,
-	0x8f, 0x8e, 0x83, 0x2f, 0xea, 0x7f, 0x20, 0x87, 0x4a, 0x4c, 0xd9, 0xd2, 0x8b, 0xdf, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0xdf, 0xff, 0x6c, 0x30, 0x2c, 0x04, 0x00, 0x00,
+	// 640 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x4b, 0x4f, 0xdc, 0x3c,
+	0x14, 0x9d, 0x30, 0x7c, 0x03, 0x98, 0xa7, 0x22, 0xf4, 0x11, 0x06, 0x35, 0x99, 0xa6, 0x12, 0x9a,
+	0x2e, 0xc8, 0x83, 0xee, 0xba, 0x23, 0x02, 0x89, 0x3e, 0x55, 0xa5, 0xac, 0xda, 0x45, 0xe4, 0x24,
+	0x26, 0x13, 0x35, 0x19, 0x5b, 0xb1, 0x87, 0x29, 0xff, 0x82, 0x9f, 0xc5, 0x92, 0x65, 0xd5, 0x45,
+	0x5a, 0xc1, 0xae, 0x4b, 0xa4, 0x4a, 0x5d, 0x56, 0xb6, 0x33, 0x4f, 0x68, 0x91, 0x46, 0xea, 0x6a,
+	0xe6, 0x5e, 0x9f, 0x7b, 0x8e, 0x8f, 0x73, 0x6c, 0xb0, 0x8b, 0x69, 0x8e, 0x69, 0x4a, 0x6d, 0xd6,
+	0x87, 0xc4, 0x3e, 0x73, 0x43, 0xc4, 0xa0, 0x2b, 0x8a, 0xa0, 0x40, 0x11, 0x2e, 0x62, 0x8b, 0x14,
+	0x98, 0x61, 0x75, 0xb3, 0xc2, 0x59, 0x7c, 0xc9, 0xaa, 0x70, 0xcd, 0xcd, 0x04, 0x27, 0x58, 0x00,
+	0x6c, 0xfe, 0x4f, 0x62, 0x9b, 0xdb, 0x09, 0xc6, 0x49, 0x86, 0x6c, 0x51, 0x85, 0xbd, 0x53, 0x1b,
+	0x76, 0xcf, 0x07, 0x4b, 0x91, 0xe0, 0x09, 0xe4, 0x8c, 0x2c, 0xaa, 0x25, 0x5d, 0x56, 0x76, 0x08,
+	0x29, 0x1a, 0x6e, 0x24, 0xc2, 0x69, 0xb7, 0x5a, 0x37, 0xa6, 0x59, 0x59, 0x9a, 0x23, 0xca, 0x60,
+	0x4e, 0x24, 0xc0, 0xfc, 0xd5, 0x00, 0xe0, 0xa4, 0x0f, 0x89, 0x2f, 0xf6, 0xad, 0x6e, 0x81, 0x05,
+	0x82, 0x71, 0x16, 0xa4, 0xb1, 0xa6, 0xb4, 0x94, 0xf6, 0xbc, 0xdf, 0xe0, 0xe5, 0x8b, 0x58, 0x7d,
+	0x0c, 0x56, 0x20, 0xa5, 0x88, 0x39, 0x41, 0x8c, 0xba, 0x38, 0xd7, 0xe6, 0x5a, 0x4a, 0x7b, 0xc9,
+	0x5f, 0x96, 0xbd, 0x43, 0xde, 0x1a, 0x42, 0xdc, 0x0a, 0x52, 0x1f, 0x83, 0xb8, 0x12, 0x72, 0x00,
+	0x1a, 0x1d, 0x94, 0x26, 0x1d, 0xa6, 0xcd, 0xb7, 0x94, 0x76, 0xdd, 0x7b, 0xfa, 0xa3, 0x34, 0x56,
+	0xe5, 0x91, 0x05, 0x72, 0xe1, 0xb6, 0x34, 0x36, 0xcf, 0x61, 0x9e, 0x3d, 0x37, 0x27, 0xda, 0xa6,
+	0x5f, 0x0d, 0xaa, 0x6f, 0xc1, 0x3c, 0xf7, 0xa0, 0xfd, 0xd7, 0x52, 0xda, 0xcb, 0xfb, 0x4d, 0x4b,
+	0x1a, 0xb4, 0x06, 0x06, 0xad, 0x93, 0x81, 0x41, 0x4f, 0xbf, 0x2c, 0x8d, 0xda, 0x6d, 0x69, 0xa8,
+	0x13, 0x7c, 0x7c, 0xd8, 0xbc, 0xf8, 0x66, 0x28, 0xbe, 0xe0, 0x51, 0x3f, 0x02, 0x95, 0x38, 0x41,
+	0x06, 0x29, 0x0b, 0x28, 0xc1, 0x2c, 0x20, 0x45, 0x1a, 0x21, 0xad, 0xc1, 0xf7, 0xee, 0x59, 0x9c,
+	0xe1, 0x6b, 0x69, 0xec, 0x26, 0x29, 0xeb, 0xf4, 0x42, 0x2b, 0xc2, 0x79, 0x75, 0xfc, 0xd5, 0xcf,
+	0x1e, 0x8d, 0x3f, 0xd9, 0xec, 0x9c, 0x20, 0x6a, 0x1d, 0xa2, 0xc8, 0x5f, 0x27, 0xce, 0x6b, 0x48,
+	0xd9, 0x7b, 0x82, 0xd9, 0x3b, 0x4e, 0x23, 0xc8, 0xdd, 0x3b, 0xe4, 0x0b, 0x33, 0x92, 0xbb, 0x93,
+	0xe4, 0x14, 0xe8, 0xc4, 0x09, 0x60, 0x91, 0xb2, 0x4e, 0x8e, 0x58, 0x1a, 0x05, 0x22, 0x80, 0x30,
+	0x8a, 0x7a, 0x79, 0x2f, 0x83, 0x0c, 0x17, 0xda, 0xe2, 0x4c, 0x42, 0x3b, 0xc4, 0x39, 0x18, 0x92,
+	0xf2, 0x6c, 0x1c, 0x8c, 0x28, 0x85, 0xa8, 0xfb, 0x57, 0xd1, 0xa5, 0x19, 0x45, 0xdd, 0x3f, 0x8b,
+	0x66, 0xa0, 0x99, 0x20, 0x9c, 0x23, 0x56, 0xdc, 0x27, 0x08, 0x66, 0x12, 0xd4, 0x86, 0x8c, 0xd3,
+	0x6a, 0xa7, 0x60, 0x5d, 0x7c, 0x31, 0x54, 0x14, 0xb8, 0x10, 0x79, 0xd1, 0x96, 0x1f, 0x0c, 0x9b,
+	0x59, 0x85, 0xed, 0x7f, 0x19, 0xb6, 0x29, 0x02, 0x19, 0xb8, 0x55, 0xde, 0x3d, 0xe2, 0x4d, 0x3e,
+	0x67, 0xfe, 0x9c, 0x03, 0x2b, 0x5c, 0xfb, 0x0d, 0x62, 0x30, 0x86, 0x0c, 0xaa, 0xaf, 0x80, 0x2a,
+	0xe6, 0x26, 0x92, 0x2f, 0xee, 0x61, 0xdd, 0x7b, 0x74, 0x5b, 0x1a, 0xdb, 0x63, 0xdc, 0x53, 0xb7,
+	0x63, 0x83, 0x37, 0xe5, 0x1d, 0x3e, 0x96, 0xf7, 0x24, 0x05, 0x1b, 0xe3, 0x40, 0x61, 0x63, 0xee,
+	0x41, 0x1b, 0x4f, 0x2a, 0x1b, 0x5b, 0x77, 0xa5, 0x46, 0x3e, 0xd6, 0x46, 0x62, 0x7c, 0xf2, 0xbe,
+	0x03, 0xab, 0xff, 0x83, 0x03, 0x53, 0x8f, 0xc0, 0x46, 0x3f, 0xed, 0xc6, 0xb8, 0x1f, 0x74, 0x20,
+	0x95, 0x60, 0xf1, 0x8e, 0x2c, 0x7a, 0x3b, 0xa3, 0x2d, 0x4f, 0x23, 0x4c, 0x7f, 0x4d, 0xb6, 0x8e,
+	0x21, 0x15, 0x54, 0xde, 0xcb, 0xcb, 0x6b, 0x5d, 0xb9, 0xba, 0xd6, 0x95, 0xef, 0xd7, 0xba, 0x72,
+	0x71, 0xa3, 0xd7, 0xae, 0x6e, 0xf4, 0xda, 0x97, 0x1b, 0xbd, 0xf6, 0xc1, 0x19, 0xcb, 0x4e, 0xf5,
+	0x74, 0xef, 0x65, 0x30, 0xa4, 0x83, 0xc2, 0x3e, 0x73, 0xf7, 0xed, 0xcf, 0xf2, 0xd5, 0x17, 0x49,
+	0x0a, 0x1b, 0xc2, 0xd9, 0xb3, 0xdf, 0x03, 0x00, 0x4b, 0x29, 0xa1, 0x2a, 0x12, 0x06, 0x00, 0x00,
 }
 
 func (m *TwapRecord) Marshal() (dAtA []byte, err error) {
@@ -209,6 +299,16 @@ func (m *TwapRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i = encodeVarintTwapRecord(dAtA, i, uint64(n1))
 	i--
 	dAtA[i] = 0x5a
+	{
+		size := m.GeometricTwapAccumulator.Size()
+		i -= size
+		if _, err := m.GeometricTwapAccumulator.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTwapRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
 	{
 		size := m.P1ArithmeticTwapAccumulator.Size()
 		i -= size
@@ -284,6 +384,60 @@ func (m *TwapRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TwapMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TwapMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TwapMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WindowHasError {
+		i--
+		if m.WindowHasError {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastErrorTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastErrorTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintTwapRecord(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x1a
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastRecordTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastRecordTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintTwapRecord(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x12
+	if m.LastRecordHeight != 0 {
+		i = encodeVarintTwapRecord(dAtA, i, uint64(m.LastRecordHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTwapRecord(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTwapRecord(v)
 	base := offset
@@ -325,8 +479,29 @@ func (m *TwapRecord) Size() (n int) {
 	n += 1 + l + sovTwapRecord(uint64(l))
 	l = m.P1ArithmeticTwapAccumulator.Size()
 	n += 1 + l + sovTwapRecord(uint64(l))
+	l = m.GeometricTwapAccumulator.Size()
+	n += 1 + l + sovTwapRecord(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastErrorTime)
+	n += 1 + l + sovTwapRecord(uint64(l))
+	return n
+}
+
+func (m *TwapMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastRecordHeight != 0 {
+		n += 1 + sovTwapRecord(uint64(m.LastRecordHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastRecordTime)
+	n += 1 + l + sovTwapRecord(uint64(l))
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastErrorTime)
 	n += 1 + l + sovTwapRecord(uint64(l))
+	if m.WindowHasError {
+		n += 2
+	}
 	return n
 }
 
@@ -636,6 +811,40 @@ func (m *TwapRecord) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GeometricTwapAccumulator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GeometricTwapAccumulator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 11:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field LastErrorTime", wireType)
@@ -690,6 +899,161 @@ func (m *TwapRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TwapMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTwapRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TwapMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TwapMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRecordHeight", wireType)
+			}
+			m.LastRecordHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastRecordHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRecordTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastRecordTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastErrorTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastErrorTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowHasError", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WindowHasError = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTwapRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTwapRecord(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
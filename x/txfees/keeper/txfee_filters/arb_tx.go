@@ -2,12 +2,15 @@ package txfee_filters
 
 import (
 	gammtypes "github.com/osmosis-labs/osmosis/v12/x/gamm/types"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v12/x/swaprouter/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // We check if a tx is an arbitrage for the mempool right now by seeing:
 // 1) does start token of a msg = final token of msg (definitionally correct)
+//   - Swaps through the swaprouter module can't be arbs of this kind, as it rejects swapping a denom for itself.
+//
 // 2) does it have multiple swap messages, with different tx ins. If so, we assume its an arb.
 //   - This has false positives, but is intended to avoid the obvious solution of splitting
 //     an arb into multiple messages.
@@ -15,8 +18,6 @@ import (
 // 3) We record all denoms seen across all swaps, and see if any duplicates. (TODO)
 // 4) Contains both JoinPool and ExitPool messages in one tx.
 //   - Has some false positives, but they seem relatively contrived.
-//
-// TODO: Move the first component to a future router module.
 func IsArbTxLoose(tx sdk.Tx) bool {
 	msgs := tx.GetMsgs()
 
@@ -33,21 +34,31 @@ func IsArbTxLoose(tx sdk.Tx) bool {
 			}
 		}
 
-		swapMsg, isSwapMsg := m.(gammtypes.SwapMsgRoute)
-		if !isSwapMsg {
+		var tokenInDenom, tokenOutDenom string
+		switch swapMsg := m.(type) {
+		case gammtypes.SwapMsgRoute:
+			tokenInDenom, tokenOutDenom = swapMsg.TokenInDenom(), swapMsg.TokenOutDenom()
+		case *gammtypes.MsgSplitRouteSwapExactAmountIn:
+			// all of the routes of a split route swap share the same denoms in and out
+			tokenInDenom, tokenOutDenom = swapMsg.TokenInDenom, gammtypes.SwapAmountInSplitRoutes(swapMsg.Routes).TokenOutDenom()
+		case *swaproutertypes.MsgSwapExactAmountIn:
+			tokenInDenom, tokenOutDenom = swapMsg.TokenIn.Denom, swapMsg.TokenOutDenom
+		case *swaproutertypes.MsgSwapExactAmountOut:
+			tokenInDenom, tokenOutDenom = swapMsg.TokenInDenom, swapMsg.TokenOut.Denom
+		default:
 			continue
 		}
 
 		// (1) Check that swap denom in != swap denom out
-		if swapMsg.TokenInDenom() == swapMsg.TokenOutDenom() {
+		if tokenInDenom == tokenOutDenom {
 			return true
 		}
 
 		// (2)
-		if swapInDenom != "" && swapMsg.TokenInDenom() != swapInDenom {
+		if swapInDenom != "" && tokenInDenom != swapInDenom {
 			return true
 		}
-		swapInDenom = swapMsg.TokenInDenom()
+		swapInDenom = tokenInDenom
 	}
 
 	return false
@@ -0,0 +1,59 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+
+	"github.com/osmosis-labs/osmosis/v12/x/validator-preference/client/queryproto"
+	"github.com/osmosis-labs/osmosis/v12/x/validator-preference/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetCmdUserValidatorPreferences(),
+	)
+
+	return cmd
+}
+
+// GetCmdUserValidatorPreferences returns the validator-set a delegator stakes with.
+func GetCmdUserValidatorPreferences() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "user-preferences [delegator] [flags]",
+		Short: "Query the validator-set preference of a delegator",
+		Long: `Query the validator-set preference of a delegator.
+If the delegator has not set a preference, it is derived from their existing delegations.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := queryproto.NewQueryClient(clientCtx)
+
+			res, err := queryClient.UserValidatorPreferences(cmd.Context(), &queryproto.UserValidatorPreferencesRequest{User: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
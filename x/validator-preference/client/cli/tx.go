@@ -0,0 +1,172 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v12/x/validator-preference/types"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		NewSetValidatorSetPreferenceCmd(),
+		NewDelegateToValidatorSetCmd(),
+		NewUndelegateFromValidatorSetCmd(),
+		NewWithdrawDelegationRewardsCmd(),
+	)
+
+	return cmd
+}
+
+// NewSetValidatorSetPreferenceCmd broadcasts MsgSetValidatorSetPreference.
+func NewSetValidatorSetPreferenceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-preference [valoper-address=weight,...] [flags]",
+		Short:   "set or update the validator-set preference of the sender",
+		Example: "osmosisd tx validatorpreference set-preference osmovaloper1abc=0.5,osmovaloper1def=0.5 --from mykey",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			preferences, err := parsePreferences(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetValidatorSetPreference(clientCtx.GetFromAddress(), preferences)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewDelegateToValidatorSetCmd broadcasts MsgDelegateToValidatorSet.
+func NewDelegateToValidatorSetCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "delegate [amount] [flags]",
+		Short:   "delegate tokens to the sender's validator-set",
+		Example: "osmosisd tx validatorpreference delegate 1000000uosmo --from mykey",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			coin, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDelegateToValidatorSet(clientCtx.GetFromAddress(), coin)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewUndelegateFromValidatorSetCmd broadcasts MsgUndelegateFromValidatorSet.
+func NewUndelegateFromValidatorSetCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "undelegate [amount] [flags]",
+		Short:   "undelegate tokens from the sender's validator-set",
+		Example: "osmosisd tx validatorpreference undelegate 1000000uosmo --from mykey",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			coin, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUndelegateFromValidatorSet(clientCtx.GetFromAddress(), coin)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewWithdrawDelegationRewardsCmd broadcasts MsgWithdrawDelegationRewards.
+func NewWithdrawDelegationRewardsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "withdraw-rewards [flags]",
+		Short:   "withdraw staking rewards from every validator in the sender's validator-set",
+		Example: "osmosisd tx validatorpreference withdraw-rewards --from mykey",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			msg := types.NewMsgWithdrawDelegationRewards(clientCtx.GetFromAddress())
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// parsePreferences parses a comma separated list of valoper=weight pairs.
+func parsePreferences(arg string) ([]types.ValidatorPreference, error) {
+	preferences := []types.ValidatorPreference{}
+	for _, pair := range strings.Split(arg, ",") {
+		parts := strings.Split(pair, "=")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid preference %q, expected valoper-address=weight", pair)
+		}
+
+		weight, err := sdk.NewDecFromStr(parts[1])
+		if err != nil {
+			return nil, err
+		}
+
+		preferences = append(preferences, types.ValidatorPreference{
+			ValOperAddress: parts[0],
+			Weight:         weight,
+		})
+	}
+	return preferences, nil
+}
@@ -0,0 +1,26 @@
+package client
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	keeper "github.com/osmosis-labs/osmosis/v12/x/validator-preference"
+	"github.com/osmosis-labs/osmosis/v12/x/validator-preference/client/queryproto"
+)
+
+// This file should evolve to being code gen'd, off of `proto/validator-preference/v1beta/query.yml`
+
+type Querier struct {
+	K keeper.Keeper
+}
+
+func (q Querier) UserValidatorPreferences(ctx sdk.Context,
+	req queryproto.UserValidatorPreferencesRequest,
+) (*queryproto.UserValidatorPreferencesResponse, error) {
+	delegator, err := sdk.AccAddressFromBech32(req.User)
+	if err != nil {
+		return nil, err
+	}
+
+	preferences, err := q.K.GetDelegationPreferences(ctx, delegator)
+	return &queryproto.UserValidatorPreferencesResponse{Preferences: preferences}, err
+}
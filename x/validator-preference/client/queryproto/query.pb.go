@@ -31,23 +31,23 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Request type for UserValidatorPreferences.
-type QueryUserValidatorPreferences struct {
+type UserValidatorPreferencesRequest struct {
 	// user account address
 	User string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
 }
 
-func (m *QueryUserValidatorPreferences) Reset()         { *m = QueryUserValidatorPreferences{} }
-func (m *QueryUserValidatorPreferences) String() string { return proto.CompactTextString(m) }
-func (*QueryUserValidatorPreferences) ProtoMessage()    {}
-func (*QueryUserValidatorPreferences) Descriptor() ([]byte, []int) {
+func (m *UserValidatorPreferencesRequest) Reset()         { *m = UserValidatorPreferencesRequest{} }
+func (m *UserValidatorPreferencesRequest) String() string { return proto.CompactTextString(m) }
+func (*UserValidatorPreferencesRequest) ProtoMessage()    {}
+func (*UserValidatorPreferencesRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_888a45acbabdd269, []int{0}
 }
-func (m *QueryUserValidatorPreferences) XXX_Unmarshal(b []byte) error {
+func (m *UserValidatorPreferencesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryUserValidatorPreferences) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UserValidatorPreferencesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryUserValidatorPreferences.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UserValidatorPreferencesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -57,35 +57,35 @@ func (m *QueryUserValidatorPreferences) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *QueryUserValidatorPreferences) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryUserValidatorPreferences.Merge(m, src)
+func (m *UserValidatorPreferencesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserValidatorPreferencesRequest.Merge(m, src)
 }
-func (m *QueryUserValidatorPreferences) XXX_Size() int {
+func (m *UserValidatorPreferencesRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryUserValidatorPreferences) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryUserValidatorPreferences.DiscardUnknown(m)
+func (m *UserValidatorPreferencesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserValidatorPreferencesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryUserValidatorPreferences proto.InternalMessageInfo
+var xxx_messageInfo_UserValidatorPreferencesRequest proto.InternalMessageInfo
 
-// Response type the QueryUserValidatorPreferences query request
-type QueryUserValidatorPreferenceResponse struct {
+// Response type the UserValidatorPreferences query request
+type UserValidatorPreferencesResponse struct {
 	Preferences []types.ValidatorPreference `protobuf:"bytes,1,rep,name=preferences,proto3" json:"preferences"`
 }
 
-func (m *QueryUserValidatorPreferenceResponse) Reset()         { *m = QueryUserValidatorPreferenceResponse{} }
-func (m *QueryUserValidatorPreferenceResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryUserValidatorPreferenceResponse) ProtoMessage()    {}
-func (*QueryUserValidatorPreferenceResponse) Descriptor() ([]byte, []int) {
+func (m *UserValidatorPreferencesResponse) Reset()         { *m = UserValidatorPreferencesResponse{} }
+func (m *UserValidatorPreferencesResponse) String() string { return proto.CompactTextString(m) }
+func (*UserValidatorPreferencesResponse) ProtoMessage()    {}
+func (*UserValidatorPreferencesResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_888a45acbabdd269, []int{1}
 }
-func (m *QueryUserValidatorPreferenceResponse) XXX_Unmarshal(b []byte) error {
+func (m *UserValidatorPreferencesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryUserValidatorPreferenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UserValidatorPreferencesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryUserValidatorPreferenceResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UserValidatorPreferencesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -95,21 +95,21 @@ func (m *QueryUserValidatorPreferenceResponse) XXX_Marshal(b []byte, determinist
 		return b[:n], nil
 	}
 }
-func (m *QueryUserValidatorPreferenceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryUserValidatorPreferenceResponse.Merge(m, src)
+func (m *UserValidatorPreferencesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserValidatorPreferencesResponse.Merge(m, src)
 }
-func (m *QueryUserValidatorPreferenceResponse) XXX_Size() int {
+func (m *UserValidatorPreferencesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryUserValidatorPreferenceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryUserValidatorPreferenceResponse.DiscardUnknown(m)
+func (m *UserValidatorPreferencesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserValidatorPreferencesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryUserValidatorPreferenceResponse proto.InternalMessageInfo
+var xxx_messageInfo_UserValidatorPreferencesResponse proto.InternalMessageInfo
 
 func init() {
-	proto.RegisterType((*QueryUserValidatorPreferences)(nil), "osmosis.validatorpreference.v1beta1.QueryUserValidatorPreferences")
-	proto.RegisterType((*QueryUserValidatorPreferenceResponse)(nil), "osmosis.validatorpreference.v1beta1.QueryUserValidatorPreferenceResponse")
+	proto.RegisterType((*UserValidatorPreferencesRequest)(nil), "osmosis.validatorpreference.v1beta1.UserValidatorPreferencesRequest")
+	proto.RegisterType((*UserValidatorPreferencesResponse)(nil), "osmosis.validatorpreference.v1beta1.UserValidatorPreferencesResponse")
 }
 
 func init() {
@@ -117,29 +117,29 @@ func init() {
 }
 
 var fileDescriptor_888a45acbabdd269 = []byte{
-	// 338 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0xc8, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0x2f, 0x4b, 0xcc, 0xc9, 0x4c, 0x49, 0x2c, 0xc9, 0x2f, 0xd2, 0x2d, 0x28,
-	0x4a, 0x4d, 0x4b, 0x2d, 0x4a, 0xcd, 0x4b, 0x4e, 0xd5, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34,
-	0xd4, 0x2f, 0x2c, 0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x52, 0x86, 0xea,
-	0xd0, 0x83, 0xeb, 0x40, 0x68, 0xd0, 0x83, 0x6a, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xab,
-	0xd7, 0x07, 0xb1, 0x20, 0x5a, 0xa5, 0x64, 0xd2, 0xf3, 0xf3, 0xd3, 0x73, 0x52, 0xf5, 0x13, 0x0b,
-	0x32, 0xf5, 0x13, 0xf3, 0xf2, 0xf2, 0x4b, 0x12, 0x4b, 0x32, 0xf3, 0xf3, 0x8a, 0xa1, 0xb2, 0xc4,
-	0x39, 0xa5, 0xb8, 0x24, 0xb1, 0x24, 0x15, 0xa2, 0x43, 0xc9, 0x98, 0x4b, 0x36, 0x10, 0xe4, 0xb2,
-	0xd0, 0xe2, 0xd4, 0xa2, 0x30, 0x98, 0xa6, 0x00, 0xb8, 0x9e, 0x62, 0x21, 0x21, 0x2e, 0x96, 0xd2,
-	0xe2, 0xd4, 0x22, 0x09, 0x46, 0x05, 0x46, 0x0d, 0xce, 0x20, 0x30, 0x5b, 0xa9, 0x83, 0x91, 0x4b,
-	0x05, 0x9f, 0xae, 0xa0, 0xd4, 0xe2, 0x82, 0xfc, 0xbc, 0xe2, 0x54, 0xa1, 0x04, 0x2e, 0x6e, 0x84,
-	0xfd, 0xc5, 0x12, 0x8c, 0x0a, 0xcc, 0x1a, 0xdc, 0x46, 0x16, 0x7a, 0x44, 0x78, 0x5f, 0x0f, 0x8b,
-	0xb1, 0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33, 0x04, 0x21, 0x1b, 0x69, 0xf4, 0x92, 0x91, 0x8b, 0x15,
-	0xec, 0x14, 0xa1, 0xfb, 0x8c, 0x5c, 0x12, 0x38, 0x7d, 0xe1, 0x44, 0x94, 0x9d, 0x78, 0x43, 0x42,
-	0xca, 0x93, 0x62, 0x33, 0x60, 0xe1, 0xa2, 0x64, 0xd2, 0x74, 0xf9, 0xc9, 0x64, 0x26, 0x3d, 0x21,
-	0x1d, 0x7d, 0xa2, 0x22, 0xac, 0x1a, 0x14, 0xea, 0xb5, 0x4e, 0x59, 0x27, 0x1e, 0xca, 0x31, 0x9c,
-	0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31,
-	0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x4f, 0x7a, 0x66, 0x49, 0x46, 0x69,
-	0x92, 0x5e, 0x72, 0x7e, 0x2e, 0xcc, 0x54, 0xdd, 0x9c, 0xc4, 0xa4, 0x62, 0x84, 0x15, 0x86, 0x46,
-	0xfa, 0x15, 0xd8, 0x2d, 0x4a, 0xce, 0xc9, 0x4c, 0xcd, 0x2b, 0x81, 0xa4, 0x51, 0x70, 0xba, 0x48,
-	0x62, 0x03, 0x53, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x44, 0xbd, 0xf5, 0xa9, 0xdd, 0x02,
-	0x00, 0x00,
+	// 343 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x92, 0xbf, 0x4a, 0x2b, 0x41,
+	0x14, 0xc6, 0x77, 0xee, 0xcd, 0xbd, 0x70, 0x27, 0xdd, 0x70, 0x8b, 0x10, 0x64, 0x12, 0x62, 0x93,
+	0xc2, 0xcc, 0x98, 0xa8, 0x60, 0x1d, 0xb4, 0xb3, 0xd0, 0x80, 0x16, 0x56, 0xce, 0xc6, 0xe3, 0xba,
+	0xb2, 0xd9, 0xd9, 0xcc, 0x99, 0x0d, 0x8a, 0xd8, 0x88, 0x0f, 0x20, 0xf8, 0x52, 0x29, 0x03, 0x36,
+	0x36, 0x8a, 0x26, 0x82, 0xaf, 0x21, 0xd9, 0xcd, 0x1f, 0x8b, 0x04, 0x17, 0xac, 0xe6, 0xc0, 0x9c,
+	0xdf, 0xf7, 0x9d, 0xf9, 0xe6, 0xd0, 0x75, 0x8d, 0x1d, 0x8d, 0x3e, 0xca, 0x9e, 0x0a, 0xfc, 0x53,
+	0x65, 0xb5, 0xa9, 0x45, 0x06, 0xce, 0xc0, 0x40, 0xd8, 0x06, 0xd9, 0xab, 0xbb, 0x60, 0x55, 0x5d,
+	0x76, 0x63, 0x30, 0x57, 0x22, 0x32, 0xda, 0x6a, 0xb6, 0x3a, 0x21, 0xc4, 0x8c, 0x98, 0x03, 0x62,
+	0x02, 0x14, 0xff, 0x7b, 0xda, 0xd3, 0x49, 0xbf, 0x1c, 0x57, 0x29, 0x5a, 0x5c, 0xf1, 0xb4, 0xf6,
+	0x02, 0x90, 0x2a, 0xf2, 0xa5, 0x0a, 0x43, 0x6d, 0x95, 0xf5, 0x75, 0x88, 0x93, 0xdb, 0x6c, 0xa3,
+	0xa0, 0x55, 0x16, 0x52, 0xa2, 0xb2, 0x45, 0x4b, 0x87, 0x08, 0xe6, 0x68, 0xda, 0xbf, 0x3f, 0x6b,
+	0xc7, 0x16, 0x74, 0x63, 0x40, 0xcb, 0x18, 0xcd, 0xc5, 0x08, 0xa6, 0x40, 0xca, 0xa4, 0xfa, 0xaf,
+	0x95, 0xd4, 0x95, 0x3b, 0x42, 0xcb, 0xcb, 0x39, 0x8c, 0x74, 0x88, 0xc0, 0x4e, 0x68, 0x7e, 0xee,
+	0x8e, 0x05, 0x52, 0xfe, 0x5d, 0xcd, 0x37, 0xb6, 0x45, 0x86, 0xc7, 0x8b, 0x05, 0xba, 0xcd, 0x5c,
+	0xff, 0xa5, 0xe4, 0xb4, 0xbe, 0x4a, 0x36, 0x3e, 0x08, 0xfd, 0x73, 0x30, 0x0e, 0x96, 0x3d, 0x13,
+	0x5a, 0x58, 0x36, 0x10, 0xdb, 0xc9, 0xe4, 0xf9, 0x4d, 0x0e, 0xc5, 0xdd, 0x1f, 0xaa, 0xa4, 0xa9,
+	0x54, 0x36, 0x6f, 0x1f, 0xdf, 0x1f, 0x7e, 0x09, 0xb6, 0x26, 0x33, 0x7d, 0xd6, 0xf5, 0x38, 0xef,
+	0x9b, 0xe6, 0x45, 0xff, 0x8d, 0x3b, 0xfd, 0x21, 0x27, 0x83, 0x21, 0x27, 0xaf, 0x43, 0x4e, 0xee,
+	0x47, 0xdc, 0x19, 0x8c, 0xb8, 0xf3, 0x34, 0xe2, 0xce, 0xf1, 0x9e, 0xe7, 0xdb, 0xf3, 0xd8, 0x15,
+	0x6d, 0xdd, 0x99, 0xaa, 0xd6, 0x02, 0xe5, 0xe2, 0xdc, 0xa2, 0xde, 0x90, 0x97, 0x8b, 0x8d, 0xda,
+	0x81, 0x0f, 0xa1, 0x4d, 0xf7, 0x33, 0xd9, 0x09, 0xf7, 0x6f, 0x72, 0x6c, 0x7c, 0x0e, 0x00, 0x1d,
+	0x57, 0x45, 0xa7, 0xd9, 0x02, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -155,7 +155,7 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type QueryClient interface {
 	// Returns the list of ValidatorPreferences for the user.
-	UserValidatorPreferences(ctx context.Context, in *QueryUserValidatorPreferences, opts ...grpc.CallOption) (*QueryUserValidatorPreferenceResponse, error)
+	UserValidatorPreferences(ctx context.Context, in *UserValidatorPreferencesRequest, opts ...grpc.CallOption) (*UserValidatorPreferencesResponse, error)
 }
 
 type queryClient struct {
@@ -166,8 +166,8 @@ func NewQueryClient(cc grpc1.ClientConn) QueryClient {
 	return &queryClient{cc}
 }
 
-func (c *queryClient) UserValidatorPreferences(ctx context.Context, in *QueryUserValidatorPreferences, opts ...grpc.CallOption) (*QueryUserValidatorPreferenceResponse, error) {
-	out := new(QueryUserValidatorPreferenceResponse)
+func (c *queryClient) UserValidatorPreferences(ctx context.Context, in *UserValidatorPreferencesRequest, opts ...grpc.CallOption) (*UserValidatorPreferencesResponse, error) {
+	out := new(UserValidatorPreferencesResponse)
 	err := c.cc.Invoke(ctx, "/osmosis.validatorpreference.v1beta1.Query/UserValidatorPreferences", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -178,14 +178,14 @@ func (c *queryClient) UserValidatorPreferences(ctx context.Context, in *QueryUse
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Returns the list of ValidatorPreferences for the user.
-	UserValidatorPreferences(context.Context, *QueryUserValidatorPreferences) (*QueryUserValidatorPreferenceResponse, error)
+	UserValidatorPreferences(context.Context, *UserValidatorPreferencesRequest) (*UserValidatorPreferencesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
 type UnimplementedQueryServer struct {
 }
 
-func (*UnimplementedQueryServer) UserValidatorPreferences(ctx context.Context, req *QueryUserValidatorPreferences) (*QueryUserValidatorPreferenceResponse, error) {
+func (*UnimplementedQueryServer) UserValidatorPreferences(ctx context.Context, req *UserValidatorPreferencesRequest) (*UserValidatorPreferencesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UserValidatorPreferences not implemented")
 }
 
@@ -194,7 +194,7 @@ func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 }
 
 func _Query_UserValidatorPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryUserValidatorPreferences)
+	in := new(UserValidatorPreferencesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -206,7 +206,7 @@ func _Query_UserValidatorPreferences_Handler(srv interface{}, ctx context.Contex
 		FullMethod: "/osmosis.validatorpreference.v1beta1.Query/UserValidatorPreferences",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).UserValidatorPreferences(ctx, req.(*QueryUserValidatorPreferences))
+		return srv.(QueryServer).UserValidatorPreferences(ctx, req.(*UserValidatorPreferencesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -224,7 +224,7 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 	Metadata: "osmosis/validator-preference/v1beta1/query.proto",
 }
 
-func (m *QueryUserValidatorPreferences) Marshal() (dAtA []byte, err error) {
+func (m *UserValidatorPreferencesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -234,12 +234,12 @@ func (m *QueryUserValidatorPreferences) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryUserValidatorPreferences) MarshalTo(dAtA []byte) (int, error) {
+func (m *UserValidatorPreferencesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryUserValidatorPreferences) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UserValidatorPreferencesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -254,7 +254,7 @@ func (m *QueryUserValidatorPreferences) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryUserValidatorPreferenceResponse) Marshal() (dAtA []byte, err error) {
+func (m *UserValidatorPreferencesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -264,12 +264,12 @@ func (m *QueryUserValidatorPreferenceResponse) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *QueryUserValidatorPreferenceResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *UserValidatorPreferencesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryUserValidatorPreferenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UserValidatorPreferencesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -302,7 +302,7 @@ func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
-func (m *QueryUserValidatorPreferences) Size() (n int) {
+func (m *UserValidatorPreferencesRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -315,7 +315,7 @@ func (m *QueryUserValidatorPreferences) Size() (n int) {
 	return n
 }
 
-func (m *QueryUserValidatorPreferenceResponse) Size() (n int) {
+func (m *UserValidatorPreferencesResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -336,7 +336,7 @@ func sovQuery(x uint64) (n int) {
 func sozQuery(x uint64) (n int) {
 	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *QueryUserValidatorPreferences) Unmarshal(dAtA []byte) error {
+func (m *UserValidatorPreferencesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -359,10 +359,10 @@ func (m *QueryUserValidatorPreferences) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUserValidatorPreferences: wiretype end group for non-group")
+			return fmt.Errorf("proto: UserValidatorPreferencesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUserValidatorPreferences: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UserValidatorPreferencesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -418,7 +418,7 @@ func (m *QueryUserValidatorPreferences) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUserValidatorPreferenceResponse) Unmarshal(dAtA []byte) error {
+func (m *UserValidatorPreferencesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -441,10 +441,10 @@ func (m *QueryUserValidatorPreferenceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUserValidatorPreferenceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UserValidatorPreferencesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUserValidatorPreferenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UserValidatorPreferencesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
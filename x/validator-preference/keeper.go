@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v12/x/validator-preference/types"
+)
+
+type Keeper struct {
+	storeKey      sdk.StoreKey
+	stakingKeeper types.StakingKeeper
+	distrKeeper   types.DistrKeeper
+}
+
+func NewKeeper(storeKey sdk.StoreKey, stakingKeeper types.StakingKeeper, distrKeeper types.DistrKeeper) Keeper {
+	return Keeper{
+		storeKey:      storeKey,
+		stakingKeeper: stakingKeeper,
+		distrKeeper:   distrKeeper,
+	}
+}
+
+// Logger returns a logger for the x/validator-preference module.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// InitGenesis initializes the validator-preference module's state from a provided genesis
+// state.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
+	if err := genState.Validate(); err != nil {
+		panic(err)
+	}
+
+	for _, record := range genState.DelegatorPreferences {
+		delegator, err := sdk.AccAddressFromBech32(record.Delegator)
+		if err != nil {
+			panic(err)
+		}
+		k.SetValidatorSetPreferences(ctx, delegator, record.Preferences)
+	}
+}
+
+// ExportGenesis returns the validator-preference module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return &types.GenesisState{
+		DelegatorPreferences: k.GetAllValidatorSetPreferences(ctx),
+	}
+}
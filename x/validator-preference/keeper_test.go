@@ -0,0 +1,64 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/osmosis-labs/osmosis/v12/app/apptesting"
+	"github.com/osmosis-labs/osmosis/v12/x/validator-preference/types"
+)
+
+type KeeperTestSuite struct {
+	apptesting.KeeperTestHelper
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.Setup()
+}
+
+// setupValidators creates n bonded validators and returns them as
+// preferences with the given weights.
+func (suite *KeeperTestSuite) setupValidators(weights ...string) []types.ValidatorPreference {
+	preferences := make([]types.ValidatorPreference, len(weights))
+	for i, weight := range weights {
+		valAddr := suite.SetupValidator(stakingtypes.Bonded)
+		preferences[i] = types.ValidatorPreference{
+			ValOperAddress: valAddr.String(),
+			Weight:         sdk.MustNewDecFromStr(weight),
+		}
+	}
+	return preferences
+}
+
+func (suite *KeeperTestSuite) TestInitExportGenesis() {
+	suite.SetupTest()
+	preferences := suite.setupValidators("0.5", "0.5")
+	delegators := suite.TestAccs[:2]
+
+	genesis := types.GenesisState{DelegatorPreferences: []types.DelegatorValidatorSetPreferences{}}
+	for _, delegator := range delegators {
+		genesis.DelegatorPreferences = append(genesis.DelegatorPreferences, types.DelegatorValidatorSetPreferences{
+			Delegator:   delegator.String(),
+			Preferences: types.ValidatorSetPreferences{Preferences: preferences},
+		})
+	}
+
+	suite.SetupTestForInitGenesis()
+	suite.App.ValidatorSetPreferenceKeeper.InitGenesis(suite.Ctx, &genesis)
+
+	for _, delegator := range delegators {
+		stored, found := suite.App.ValidatorSetPreferenceKeeper.GetValidatorSetPreferences(suite.Ctx, delegator)
+		suite.Require().True(found)
+		suite.Require().Equal(preferences, stored.Preferences)
+	}
+
+	exported := suite.App.ValidatorSetPreferenceKeeper.ExportGenesis(suite.Ctx)
+	suite.Require().ElementsMatch(genesis.DelegatorPreferences, exported.DelegatorPreferences)
+}
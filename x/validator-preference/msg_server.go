@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v12/x/validator-preference/types"
+)
+
+type msgServer struct {
+	keeper Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+func (server msgServer) SetValidatorSetPreference(goCtx context.Context, msg *types.MsgSetValidatorSetPreference) (*types.MsgSetValidatorSetPreferenceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	delegator, err := sdk.AccAddressFromBech32(msg.Delegator)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := server.keeper.SetValidatorSetPreference(ctx, delegator, msg.Preferences); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetValidatorSetPreferenceResponse{}, nil
+}
+
+func (server msgServer) DelegateToValidatorSet(goCtx context.Context, msg *types.MsgDelegateToValidatorSet) (*types.MsgDelegateToValidatorSetResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	delegator, err := sdk.AccAddressFromBech32(msg.Delegator)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := server.keeper.DelegateToValidatorSet(ctx, delegator, msg.Coin); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDelegateToValidatorSetResponse{}, nil
+}
+
+func (server msgServer) UndelegateFromValidatorSet(goCtx context.Context, msg *types.MsgUndelegateFromValidatorSet) (*types.MsgUndelegateFromValidatorSetResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	delegator, err := sdk.AccAddressFromBech32(msg.Delegator)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := server.keeper.UndelegateFromValidatorSet(ctx, delegator, msg.Coin); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUndelegateFromValidatorSetResponse{}, nil
+}
+
+func (server msgServer) WithdrawDelegationRewards(goCtx context.Context, msg *types.MsgWithdrawDelegationRewards) (*types.MsgWithdrawDelegationRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	delegator, err := sdk.AccAddressFromBech32(msg.Delegator)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := server.keeper.WithdrawDelegationRewards(ctx, delegator); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWithdrawDelegationRewardsResponse{}, nil
+}
@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v12/osmoutils"
+	"github.com/osmosis-labs/osmosis/v12/x/validator-preference/types"
+)
+
+// GetValidatorSetPreferences returns the validator-set preference stored for the delegator.
+func (k Keeper) GetValidatorSetPreferences(ctx sdk.Context, delegator sdk.AccAddress) (types.ValidatorSetPreferences, bool) {
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(types.ValidatorSetPreferenceKey(delegator)) {
+		return types.ValidatorSetPreferences{}, false
+	}
+
+	var preferences types.ValidatorSetPreferences
+	osmoutils.MustGet(store, types.ValidatorSetPreferenceKey(delegator), &preferences)
+	return preferences, true
+}
+
+// SetValidatorSetPreferences stores the delegator's validator-set preference.
+func (k Keeper) SetValidatorSetPreferences(ctx sdk.Context, delegator sdk.AccAddress, preferences types.ValidatorSetPreferences) {
+	store := ctx.KVStore(k.storeKey)
+	osmoutils.MustSet(store, types.ValidatorSetPreferenceKey(delegator), &preferences)
+}
+
+// GetAllValidatorSetPreferences returns every stored validator-set preference, ordered by delegator address.
+func (k Keeper) GetAllValidatorSetPreferences(ctx sdk.Context) []types.DelegatorValidatorSetPreferences {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.KeyPrefixValidatorSet)
+	defer iter.Close()
+
+	records := []types.DelegatorValidatorSetPreferences{}
+	for ; iter.Valid(); iter.Next() {
+		delegator := sdk.AccAddress(iter.Key()[len(types.KeyPrefixValidatorSet):])
+
+		var preferences types.ValidatorSetPreferences
+		if err := preferences.Unmarshal(iter.Value()); err != nil {
+			panic(err)
+		}
+		records = append(records, types.DelegatorValidatorSetPreferences{
+			Delegator:   delegator.String(),
+			Preferences: preferences,
+		})
+	}
+	return records
+}
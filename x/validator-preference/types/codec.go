@@ -0,0 +1,41 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	authzcodec "github.com/cosmos/cosmos-sdk/x/authz/codec"
+)
+
+func RegisterCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgSetValidatorSetPreference{}, "osmosis/validator-preference/set-validator-set-preference", nil)
+	cdc.RegisterConcrete(&MsgDelegateToValidatorSet{}, "osmosis/validator-preference/delegate-to-validator-set", nil)
+	cdc.RegisterConcrete(&MsgUndelegateFromValidatorSet{}, "osmosis/validator-preference/undelegate-from-validator-set", nil)
+	cdc.RegisterConcrete(&MsgWithdrawDelegationRewards{}, "osmosis/validator-preference/withdraw-delegation-rewards", nil)
+}
+
+func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgSetValidatorSetPreference{},
+		&MsgDelegateToValidatorSet{},
+		&MsgUndelegateFromValidatorSet{},
+		&MsgWithdrawDelegationRewards{},
+	)
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+var (
+	amino     = codec.NewLegacyAmino()
+	ModuleCdc = codec.NewAminoCodec(amino)
+)
+
+func init() {
+	RegisterCodec(amino)
+	sdk.RegisterLegacyAminoCodec(amino)
+	// Register all Amino interfaces and concrete types on the authz Amino codec so that this can later be
+	// used to properly serialize MsgGrant and MsgExec instances
+	RegisterCodec(authzcodec.Amino)
+	amino.Seal()
+}
@@ -0,0 +1,17 @@
+package types
+
+// DONTCOVER
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/validator-preference module sentinel errors
+var (
+	ErrNoValidatorSetPreference = sdkerrors.Register(ModuleName, 2, "delegator has neither a validator-set preference nor existing delegations")
+	ErrInvalidPreferences       = sdkerrors.Register(ModuleName, 3, "invalid validator-set preferences")
+	ErrValidatorNotFound        = sdkerrors.Register(ModuleName, 4, "validator not found")
+	ErrInvalidBondDenom         = sdkerrors.Register(ModuleName, 5, "coin denom is not the staking bond denom")
+	ErrInsufficientDelegation   = sdkerrors.Register(ModuleName, 6, "insufficient delegation to undelegate the requested amount")
+	ErrInvalidGenesis           = sdkerrors.Register(ModuleName, 7, "invalid genesis")
+)
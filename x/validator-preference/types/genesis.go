@@ -0,0 +1,33 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// DefaultGenesis returns the default validator-preference genesis state.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		DelegatorPreferences: []DelegatorValidatorSetPreferences{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seen := map[string]bool{}
+	for _, record := range gs.DelegatorPreferences {
+		if _, err := sdk.AccAddressFromBech32(record.Delegator); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidGenesis, "invalid delegator address (%s)", err)
+		}
+		if seen[record.Delegator] {
+			return sdkerrors.Wrapf(ErrInvalidGenesis, "duplicate delegator: %s", record.Delegator)
+		}
+		seen[record.Delegator] = true
+
+		if err := ValidatePreferences(record.Preferences.Preferences); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidGenesis, "delegator %s: %s", record.Delegator, err)
+		}
+	}
+	return nil
+}
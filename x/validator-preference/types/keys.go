@@ -0,0 +1,25 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "validatorpreference"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for validator-preference
+	RouterKey = ModuleName
+)
+
+// KeyPrefixValidatorSet defines the prefix to store validator-set preferences
+// by delegator address.
+var KeyPrefixValidatorSet = []byte{0x01}
+
+// ValidatorSetPreferenceKey returns the store key of the delegator's validator-set preference.
+func ValidatorSetPreferenceKey(delegator sdk.AccAddress) []byte {
+	return append(append([]byte{}, KeyPrefixValidatorSet...), delegator...)
+}
@@ -0,0 +1,131 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// constants
+const (
+	TypeMsgSetValidatorSetPreference  = "set_validator_set_preference"
+	TypeMsgDelegateToValidatorSet     = "delegate_to_validator_set"
+	TypeMsgUndelegateFromValidatorSet = "undelegate_from_validator_set"
+	TypeMsgWithdrawDelegationRewards  = "withdraw_delegation_rewards"
+)
+
+var _ sdk.Msg = &MsgSetValidatorSetPreference{}
+
+// NewMsgSetValidatorSetPreference creates a msg to set a validator-set preference.
+func NewMsgSetValidatorSetPreference(delegator sdk.AccAddress, preferences []ValidatorPreference) *MsgSetValidatorSetPreference {
+	return &MsgSetValidatorSetPreference{
+		Delegator:   delegator.String(),
+		Preferences: preferences,
+	}
+}
+
+func (m MsgSetValidatorSetPreference) Route() string { return RouterKey }
+func (m MsgSetValidatorSetPreference) Type() string  { return TypeMsgSetValidatorSetPreference }
+func (m MsgSetValidatorSetPreference) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Delegator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid delegator address (%s)", err)
+	}
+	return ValidatePreferences(m.Preferences)
+}
+
+func (m MsgSetValidatorSetPreference) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSetValidatorSetPreference) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(m.Delegator)
+	return []sdk.AccAddress{delegator}
+}
+
+var _ sdk.Msg = &MsgDelegateToValidatorSet{}
+
+// NewMsgDelegateToValidatorSet creates a msg to delegate to a validator-set.
+func NewMsgDelegateToValidatorSet(delegator sdk.AccAddress, coin sdk.Coin) *MsgDelegateToValidatorSet {
+	return &MsgDelegateToValidatorSet{
+		Delegator: delegator.String(),
+		Coin:      coin,
+	}
+}
+
+func (m MsgDelegateToValidatorSet) Route() string { return RouterKey }
+func (m MsgDelegateToValidatorSet) Type() string  { return TypeMsgDelegateToValidatorSet }
+func (m MsgDelegateToValidatorSet) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Delegator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid delegator address (%s)", err)
+	}
+	if !m.Coin.IsValid() || !m.Coin.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, m.Coin.String())
+	}
+	return nil
+}
+
+func (m MsgDelegateToValidatorSet) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgDelegateToValidatorSet) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(m.Delegator)
+	return []sdk.AccAddress{delegator}
+}
+
+var _ sdk.Msg = &MsgUndelegateFromValidatorSet{}
+
+// NewMsgUndelegateFromValidatorSet creates a msg to undelegate from a validator-set.
+func NewMsgUndelegateFromValidatorSet(delegator sdk.AccAddress, coin sdk.Coin) *MsgUndelegateFromValidatorSet {
+	return &MsgUndelegateFromValidatorSet{
+		Delegator: delegator.String(),
+		Coin:      coin,
+	}
+}
+
+func (m MsgUndelegateFromValidatorSet) Route() string { return RouterKey }
+func (m MsgUndelegateFromValidatorSet) Type() string  { return TypeMsgUndelegateFromValidatorSet }
+func (m MsgUndelegateFromValidatorSet) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Delegator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid delegator address (%s)", err)
+	}
+	if !m.Coin.IsValid() || !m.Coin.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, m.Coin.String())
+	}
+	return nil
+}
+
+func (m MsgUndelegateFromValidatorSet) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgUndelegateFromValidatorSet) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(m.Delegator)
+	return []sdk.AccAddress{delegator}
+}
+
+var _ sdk.Msg = &MsgWithdrawDelegationRewards{}
+
+// NewMsgWithdrawDelegationRewards creates a msg to withdraw rewards from the validator-set.
+func NewMsgWithdrawDelegationRewards(delegator sdk.AccAddress) *MsgWithdrawDelegationRewards {
+	return &MsgWithdrawDelegationRewards{
+		Delegator: delegator.String(),
+	}
+}
+
+func (m MsgWithdrawDelegationRewards) Route() string { return RouterKey }
+func (m MsgWithdrawDelegationRewards) Type() string  { return TypeMsgWithdrawDelegationRewards }
+func (m MsgWithdrawDelegationRewards) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Delegator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid delegator address (%s)", err)
+	}
+	return nil
+}
+
+func (m MsgWithdrawDelegationRewards) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgWithdrawDelegationRewards) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(m.Delegator)
+	return []sdk.AccAddress{delegator}
+}
@@ -0,0 +1,83 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	"github.com/osmosis-labs/osmosis/v12/x/validator-preference/types"
+)
+
+func TestMsgSetValidatorSetPreference(t *testing.T) {
+	delegator := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	valA := sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+	valB := sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+
+	pref := func(val, weight string) types.ValidatorPreference {
+		return types.ValidatorPreference{ValOperAddress: val, Weight: sdk.MustNewDecFromStr(weight)}
+	}
+
+	tests := map[string]struct {
+		delegator   string
+		preferences []types.ValidatorPreference
+		expectPass  bool
+	}{
+		"valid": {
+			delegator:   delegator.String(),
+			preferences: []types.ValidatorPreference{pref(valA, "0.4"), pref(valB, "0.6")},
+			expectPass:  true,
+		},
+		"invalid delegator": {
+			delegator:   "osmo1invalid",
+			preferences: []types.ValidatorPreference{pref(valA, "1")},
+		},
+		"empty preferences": {
+			delegator: delegator.String(),
+		},
+		"invalid validator address": {
+			delegator:   delegator.String(),
+			preferences: []types.ValidatorPreference{pref(delegator.String(), "1")},
+		},
+		"duplicate validator": {
+			delegator:   delegator.String(),
+			preferences: []types.ValidatorPreference{pref(valA, "0.5"), pref(valA, "0.5")},
+		},
+		"weights do not add up to one": {
+			delegator:   delegator.String(),
+			preferences: []types.ValidatorPreference{pref(valA, "0.5"), pref(valB, "0.4")},
+		},
+		"zero weight": {
+			delegator:   delegator.String(),
+			preferences: []types.ValidatorPreference{pref(valA, "1"), pref(valB, "0")},
+		},
+		"negative weight": {
+			delegator:   delegator.String(),
+			preferences: []types.ValidatorPreference{pref(valA, "1.5"), pref(valB, "-0.5")},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			msg := types.MsgSetValidatorSetPreference{Delegator: tc.delegator, Preferences: tc.preferences}
+			err := msg.ValidateBasic()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestSplitAmountByWeights(t *testing.T) {
+	preferences := []types.ValidatorPreference{
+		{Weight: sdk.MustNewDecFromStr("0.5")},
+		{Weight: sdk.MustNewDecFromStr("0.3")},
+		{Weight: sdk.MustNewDecFromStr("0.2")},
+	}
+
+	amounts := types.SplitAmountByWeights(preferences, sdk.NewInt(27))
+	require.Equal(t, []sdk.Int{sdk.NewInt(13), sdk.NewInt(8), sdk.NewInt(6)}, amounts)
+}
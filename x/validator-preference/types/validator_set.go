@@ -0,0 +1,53 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ValidatePreferences checks that every validator address is valid and unique,
+// every weight is in (0, 1] and that the weights add up to exactly one.
+func ValidatePreferences(preferences []ValidatorPreference) error {
+	if len(preferences) == 0 {
+		return sdkerrors.Wrap(ErrInvalidPreferences, "preferences can not be empty")
+	}
+
+	seen := make(map[string]bool, len(preferences))
+	total := sdk.ZeroDec()
+	for _, pref := range preferences {
+		if _, err := sdk.ValAddressFromBech32(pref.ValOperAddress); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidPreferences, "invalid validator address %s (%s)", pref.ValOperAddress, err)
+		}
+		if seen[pref.ValOperAddress] {
+			return sdkerrors.Wrapf(ErrInvalidPreferences, "duplicate validator %s", pref.ValOperAddress)
+		}
+		seen[pref.ValOperAddress] = true
+
+		if pref.Weight.IsNil() || !pref.Weight.IsPositive() || pref.Weight.GT(sdk.OneDec()) {
+			return sdkerrors.Wrapf(ErrInvalidPreferences, "weight of validator %s must be in (0, 1]", pref.ValOperAddress)
+		}
+		total = total.Add(pref.Weight)
+	}
+
+	if !total.Equal(sdk.OneDec()) {
+		return sdkerrors.Wrapf(ErrInvalidPreferences, "weights must add up to 1, got %s", total)
+	}
+	return nil
+}
+
+// SplitAmountByWeights splits amount between the preferences proportionally to
+// their weights. Truncation dust is added to the last preference, so the
+// returned amounts always add up to amount.
+func SplitAmountByWeights(preferences []ValidatorPreference, amount sdk.Int) []sdk.Int {
+	amounts := make([]sdk.Int, len(preferences))
+	remaining := amount
+	for i, pref := range preferences {
+		if i == len(preferences)-1 {
+			amounts[i] = remaining
+			break
+		}
+		amounts[i] = pref.Weight.MulInt(amount).TruncateInt()
+		remaining = remaining.Sub(amounts[i])
+	}
+	return amounts
+}
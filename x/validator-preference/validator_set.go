@@ -0,0 +1,208 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/osmosis-labs/osmosis/v12/x/validator-preference/types"
+)
+
+// maxDelegationsRetrieve bounds the delegations read when deriving a
+// preference from a delegator's existing staking position.
+const maxDelegationsRetrieve = 1000
+
+// SetValidatorSetPreference creates or updates the delegator's validator-set preference.
+// Every validator in the set must exist. Existing delegations are left untouched;
+// the new weights only apply to subsequent delegate and undelegate calls.
+func (k Keeper) SetValidatorSetPreference(ctx sdk.Context, delegator sdk.AccAddress, preferences []types.ValidatorPreference) error {
+	if err := types.ValidatePreferences(preferences); err != nil {
+		return err
+	}
+
+	for _, pref := range preferences {
+		if _, err := k.getValidator(ctx, pref.ValOperAddress); err != nil {
+			return err
+		}
+	}
+
+	k.SetValidatorSetPreferences(ctx, delegator, types.ValidatorSetPreferences{Preferences: preferences})
+	return nil
+}
+
+// GetDelegationPreferences returns the validator-set the delegator stakes with.
+// If the delegator has not set a preference, it is derived from their existing
+// delegations, weighted by delegated tokens. If the delegator has neither, an
+// error is returned.
+func (k Keeper) GetDelegationPreferences(ctx sdk.Context, delegator sdk.AccAddress) ([]types.ValidatorPreference, error) {
+	if preferences, found := k.GetValidatorSetPreferences(ctx, delegator); found {
+		return preferences.Preferences, nil
+	}
+
+	delegations := k.stakingKeeper.GetDelegatorDelegations(ctx, delegator, maxDelegationsRetrieve)
+	if len(delegations) == 0 {
+		return nil, sdkerrors.Wrapf(types.ErrNoValidatorSetPreference, "delegator %s", delegator)
+	}
+
+	tokens := make([]sdk.Dec, 0, len(delegations))
+	valAddrs := make([]string, 0, len(delegations))
+	total := sdk.ZeroDec()
+	for _, delegation := range delegations {
+		validator, found := k.stakingKeeper.GetValidator(ctx, delegation.GetValidatorAddr())
+		if !found {
+			continue
+		}
+		amount := validator.TokensFromShares(delegation.Shares)
+		if !amount.IsPositive() {
+			continue
+		}
+		tokens = append(tokens, amount)
+		valAddrs = append(valAddrs, delegation.ValidatorAddress)
+		total = total.Add(amount)
+	}
+	if total.IsZero() {
+		return nil, sdkerrors.Wrapf(types.ErrNoValidatorSetPreference, "delegator %s", delegator)
+	}
+
+	preferences := make([]types.ValidatorPreference, len(tokens))
+	weightSum := sdk.ZeroDec()
+	for i := range tokens {
+		weight := tokens[i].Quo(total)
+		// assign the rounding remainder to the last validator so weights add up to one.
+		if i == len(tokens)-1 {
+			weight = sdk.OneDec().Sub(weightSum)
+		}
+		weightSum = weightSum.Add(weight)
+		preferences[i] = types.ValidatorPreference{ValOperAddress: valAddrs[i], Weight: weight}
+	}
+	return preferences, nil
+}
+
+// DelegateToValidatorSet delegates coin from the delegator to their validator-set,
+// splitting the amount by the validator weights.
+func (k Keeper) DelegateToValidatorSet(ctx sdk.Context, delegator sdk.AccAddress, coin sdk.Coin) error {
+	if err := k.validateBondDenom(ctx, coin); err != nil {
+		return err
+	}
+
+	preferences, err := k.GetDelegationPreferences(ctx, delegator)
+	if err != nil {
+		return err
+	}
+
+	amounts := types.SplitAmountByWeights(preferences, coin.Amount)
+	for i, pref := range preferences {
+		if !amounts[i].IsPositive() {
+			continue
+		}
+
+		validator, err := k.getValidator(ctx, pref.ValOperAddress)
+		if err != nil {
+			return err
+		}
+
+		if _, err := k.stakingKeeper.Delegate(ctx, delegator, amounts[i], stakingtypes.Unbonded, validator, true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UndelegateFromValidatorSet undelegates coin from the delegator's validator-set,
+// splitting the amount by the validator weights. The whole operation fails if any
+// validator in the set does not hold enough of the delegator's stake.
+func (k Keeper) UndelegateFromValidatorSet(ctx sdk.Context, delegator sdk.AccAddress, coin sdk.Coin) error {
+	if err := k.validateBondDenom(ctx, coin); err != nil {
+		return err
+	}
+
+	preferences, err := k.GetDelegationPreferences(ctx, delegator)
+	if err != nil {
+		return err
+	}
+
+	amounts := types.SplitAmountByWeights(preferences, coin.Amount)
+	for i, pref := range preferences {
+		if !amounts[i].IsPositive() {
+			continue
+		}
+
+		validator, err := k.getValidator(ctx, pref.ValOperAddress)
+		if err != nil {
+			return err
+		}
+
+		delegation, found := k.stakingKeeper.GetDelegation(ctx, delegator, validator.GetOperator())
+		if !found {
+			return sdkerrors.Wrapf(types.ErrInsufficientDelegation, "no delegation to validator %s", pref.ValOperAddress)
+		}
+
+		shares, err := validator.SharesFromTokens(amounts[i])
+		if err != nil {
+			return err
+		}
+		if shares.GT(delegation.Shares) {
+			return sdkerrors.Wrapf(types.ErrInsufficientDelegation,
+				"validator %s: requested %s, delegated %s", pref.ValOperAddress, amounts[i], validator.TokensFromShares(delegation.Shares).TruncateInt())
+		}
+
+		if _, err := k.stakingKeeper.Undelegate(ctx, delegator, validator.GetOperator(), shares); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WithdrawDelegationRewards withdraws the delegator's staking rewards from every
+// validator in their validator-set that they hold a delegation with.
+func (k Keeper) WithdrawDelegationRewards(ctx sdk.Context, delegator sdk.AccAddress) error {
+	preferences, err := k.GetDelegationPreferences(ctx, delegator)
+	if err != nil {
+		return err
+	}
+
+	// withdraw in a deterministic order regardless of how preferences were derived.
+	valAddrs := make([]string, len(preferences))
+	for i, pref := range preferences {
+		valAddrs[i] = pref.ValOperAddress
+	}
+	sort.Strings(valAddrs)
+
+	for _, valAddrStr := range valAddrs {
+		valAddr, err := sdk.ValAddressFromBech32(valAddrStr)
+		if err != nil {
+			return err
+		}
+		if _, found := k.stakingKeeper.GetDelegation(ctx, delegator, valAddr); !found {
+			continue
+		}
+		if _, err := k.distrKeeper.WithdrawDelegationRewards(ctx, delegator, valAddr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (k Keeper) getValidator(ctx sdk.Context, valAddrStr string) (stakingtypes.Validator, error) {
+	valAddr, err := sdk.ValAddressFromBech32(valAddrStr)
+	if err != nil {
+		return stakingtypes.Validator{}, err
+	}
+
+	validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return stakingtypes.Validator{}, sdkerrors.Wrapf(types.ErrValidatorNotFound, "%s", valAddrStr)
+	}
+	return validator, nil
+}
+
+func (k Keeper) validateBondDenom(ctx sdk.Context, coin sdk.Coin) error {
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	if coin.Denom != bondDenom {
+		return sdkerrors.Wrap(types.ErrInvalidBondDenom, fmt.Sprintf("expected %s, got %s", bondDenom, coin.Denom))
+	}
+	return nil
+}
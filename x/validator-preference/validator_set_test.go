@@ -0,0 +1,180 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/osmosis-labs/osmosis/v12/x/validator-preference/types"
+)
+
+func (suite *KeeperTestSuite) TestSetValidatorSetPreference() {
+	tests := map[string]struct {
+		unknownValidator bool
+		expectPass       bool
+	}{
+		"all validators exist":     {expectPass: true},
+		"validator does not exist": {unknownValidator: true},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			delegator := suite.TestAccs[0]
+			preferences := suite.setupValidators("0.6", "0.4")
+			if tc.unknownValidator {
+				preferences[1].ValOperAddress = sdk.ValAddress(suite.TestAccs[1]).String()
+			}
+
+			err := suite.App.ValidatorSetPreferenceKeeper.SetValidatorSetPreference(suite.Ctx, delegator, preferences)
+			stored, found := suite.App.ValidatorSetPreferenceKeeper.GetValidatorSetPreferences(suite.Ctx, delegator)
+			if !tc.expectPass {
+				suite.Require().ErrorIs(err, types.ErrValidatorNotFound)
+				suite.Require().False(found)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().True(found)
+			suite.Require().Equal(preferences, stored.Preferences)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGetDelegationPreferences() {
+	suite.SetupTest()
+	keeper := suite.App.ValidatorSetPreferenceKeeper
+	delegator := suite.TestAccs[0]
+	bondDenom := suite.App.StakingKeeper.BondDenom(suite.Ctx)
+
+	// no preference and no delegations
+	_, err := keeper.GetDelegationPreferences(suite.Ctx, delegator)
+	suite.Require().ErrorIs(err, types.ErrNoValidatorSetPreference)
+
+	// no preference, fall back to existing delegations weighted by tokens
+	vals := suite.setupValidators("0.5", "0.5")
+	suite.FundAcc(delegator, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100)))
+	for i, amount := range []int64{75, 25} {
+		valAddr, err := sdk.ValAddressFromBech32(vals[i].ValOperAddress)
+		suite.Require().NoError(err)
+		validator, found := suite.App.StakingKeeper.GetValidator(suite.Ctx, valAddr)
+		suite.Require().True(found)
+		_, err = suite.App.StakingKeeper.Delegate(suite.Ctx, delegator, sdk.NewInt(amount), stakingtypes.Unbonded, validator, true)
+		suite.Require().NoError(err)
+	}
+
+	preferences, err := keeper.GetDelegationPreferences(suite.Ctx, delegator)
+	suite.Require().NoError(err)
+	suite.Require().Len(preferences, 2)
+	weights := map[string]sdk.Dec{}
+	for _, pref := range preferences {
+		weights[pref.ValOperAddress] = pref.Weight
+	}
+	suite.Require().Equal(sdk.MustNewDecFromStr("0.75"), weights[vals[0].ValOperAddress])
+	suite.Require().Equal(sdk.MustNewDecFromStr("0.25"), weights[vals[1].ValOperAddress])
+
+	// an explicit preference takes precedence
+	explicit := suite.setupValidators("1")
+	suite.Require().NoError(keeper.SetValidatorSetPreference(suite.Ctx, delegator, explicit))
+	preferences, err = keeper.GetDelegationPreferences(suite.Ctx, delegator)
+	suite.Require().NoError(err)
+	suite.Require().Equal(explicit, preferences)
+}
+
+func (suite *KeeperTestSuite) TestDelegateAndUndelegate() {
+	tests := map[string]struct {
+		delegateCoin     sdk.Coin
+		undelegateAmount int64
+		expectedDelegate []int64
+		expectedRemain   []int64
+		expectedErr      error
+	}{
+		"delegate and undelegate by weight": {
+			delegateCoin:     sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
+			undelegateAmount: 50,
+			expectedDelegate: []int64{50, 30, 20},
+			expectedRemain:   []int64{25, 15, 10},
+		},
+		"rounding remainder goes to the last validator": {
+			delegateCoin:     sdk.NewInt64Coin(sdk.DefaultBondDenom, 27),
+			undelegateAmount: 27,
+			expectedDelegate: []int64{13, 8, 6},
+			expectedRemain:   []int64{0, 0, 0},
+		},
+		"undelegate more than delegated": {
+			delegateCoin:     sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
+			undelegateAmount: 200,
+			expectedDelegate: []int64{50, 30, 20},
+			expectedErr:      types.ErrInsufficientDelegation,
+		},
+		"non bond denom": {
+			delegateCoin: sdk.NewInt64Coin("foo", 100),
+			expectedErr:  types.ErrInvalidBondDenom,
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			keeper := suite.App.ValidatorSetPreferenceKeeper
+			delegator := suite.TestAccs[0]
+			preferences := suite.setupValidators("0.5", "0.3", "0.2")
+			suite.Require().NoError(keeper.SetValidatorSetPreference(suite.Ctx, delegator, preferences))
+			suite.FundAcc(delegator, sdk.NewCoins(tc.delegateCoin))
+
+			err := keeper.DelegateToValidatorSet(suite.Ctx, delegator, tc.delegateCoin)
+			if tc.expectedDelegate == nil {
+				suite.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.assertDelegations(delegator, preferences, tc.expectedDelegate)
+
+			err = keeper.UndelegateFromValidatorSet(suite.Ctx, delegator, sdk.NewInt64Coin(sdk.DefaultBondDenom, tc.undelegateAmount))
+			if tc.expectedErr != nil {
+				suite.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.assertDelegations(delegator, preferences, tc.expectedRemain)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestWithdrawDelegationRewards() {
+	suite.SetupTest()
+	keeper := suite.App.ValidatorSetPreferenceKeeper
+	delegator := suite.TestAccs[0]
+	preferences := suite.setupValidators("0.5", "0.5")
+	suite.Require().NoError(keeper.SetValidatorSetPreference(suite.Ctx, delegator, preferences))
+
+	coin := sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000)
+	suite.FundAcc(delegator, sdk.NewCoins(coin))
+	suite.Require().NoError(keeper.DelegateToValidatorSet(suite.Ctx, delegator, coin))
+
+	for _, pref := range preferences {
+		valAddr, err := sdk.ValAddressFromBech32(pref.ValOperAddress)
+		suite.Require().NoError(err)
+		suite.AllocateRewardsToValidator(valAddr, sdk.NewInt(1_000_000))
+	}
+
+	balanceBefore := suite.App.BankKeeper.GetBalance(suite.Ctx, delegator, sdk.DefaultBondDenom)
+	suite.Require().NoError(keeper.WithdrawDelegationRewards(suite.Ctx, delegator))
+	balanceAfter := suite.App.BankKeeper.GetBalance(suite.Ctx, delegator, sdk.DefaultBondDenom)
+	suite.Require().True(balanceAfter.Amount.GT(balanceBefore.Amount))
+}
+
+func (suite *KeeperTestSuite) assertDelegations(delegator sdk.AccAddress, preferences []types.ValidatorPreference, expected []int64) {
+	for i, pref := range preferences {
+		valAddr, err := sdk.ValAddressFromBech32(pref.ValOperAddress)
+		suite.Require().NoError(err)
+		validator, found := suite.App.StakingKeeper.GetValidator(suite.Ctx, valAddr)
+		suite.Require().True(found)
+
+		delegation, found := suite.App.StakingKeeper.GetDelegation(suite.Ctx, delegator, valAddr)
+		if expected[i] == 0 {
+			suite.Require().False(found)
+			continue
+		}
+		suite.Require().True(found)
+		suite.Require().Equal(sdk.NewInt(expected[i]), validator.TokensFromShares(delegation.Shares).TruncateInt(), "validator %d", i)
+	}
+}